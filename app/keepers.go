@@ -453,6 +453,7 @@ func (a *AppKeepers) InitKeepers(
 		a.BankKeeper,
 		a.DelayedAckKeeper,
 		a.RollappKeeper,
+		a.GAMMKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
@@ -629,6 +630,7 @@ func (a *AppKeepers) SetupHooks() {
 			a.IncentivesKeeper.EpochHooks(),
 			a.TxFeesKeeper.Hooks(),
 			a.DelayedAckKeeper.GetEpochHooks(),
+			a.SequencerKeeper.EpochHooks(),
 		),
 	)
 
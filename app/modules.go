@@ -206,6 +206,7 @@ var maccPerms = map[string][]string{
 	hyperwarptypes.ModuleName:                          {authtypes.Minter, authtypes.Burner},
 	kastypes.ModuleName:                                nil,
 	ratelimittypes.ModuleName:                          nil,
+	eibcmoduletypes.ModuleName:                         nil,
 }
 
 var PreBlockers = []string{
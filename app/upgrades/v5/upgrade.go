@@ -40,6 +40,7 @@ import (
 	rollappkeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
 	rollappmoduletypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	sequencerkeeper "github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	sequencertypes "github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 	sponsorshipkeeper "github.com/dymensionxyz/dymension/v3/x/sponsorship/keeper"
 	sponsorshiptypes "github.com/dymensionxyz/dymension/v3/x/sponsorship/types"
 	streamermoduletypes "github.com/dymensionxyz/dymension/v3/x/streamer/types"
@@ -87,6 +88,11 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 
+		// count the pending packets of each address for the next unlock query
+		if err := keepers.DelayedAckKeeper.InitPendingPacketsCounts(ctx); err != nil {
+			return nil, fmt.Errorf("init pending packets counts: %w", err)
+		}
+
 		// add authorized circuit breaker
 		addAuthorizedCircuitBreaker(ctx, keepers.CircuitBreakKeeper, keepers.AccountKeeper)
 
@@ -243,6 +249,9 @@ func updateIROParams(ctx sdk.Context, k *irokeeper.Keeper) {
 	params.MinLiquidityPart = defParams.MinLiquidityPart                                     // default: at least 40% goes to the liquidity pool
 	params.MinVestingDuration = defParams.MinVestingDuration                                 // default: min 7 days
 	params.MinVestingStartTimeAfterSettlement = defParams.MinVestingStartTimeAfterSettlement // default: no enforced minimum by default
+	params.TradeHistorySize = defParams.TradeHistorySize                                     // default: last 1000 trades
+	params.CandleInterval = defParams.CandleInterval                                         // default: hourly candles
+	params.CandleHistorySize = defParams.CandleHistorySize                                   // default: candles of the last 30 days
 
 	k.SetParams(ctx, params)
 }
@@ -369,6 +378,8 @@ func migrateDeprecatedParamsKeeperSubspaces(ctx sdk.Context, keepers *upgrades.U
 		delayedackParams.EpochIdentifier,
 		delayedackParams.BridgingFee,
 		delayedackParams.DeletePacketsEpochLimit,
+		delayedacktypes.DefaultParams().AutoFinalizePacketsLimit,
+		delayedacktypes.DefaultParams().AutoFinalizeGasBudget,
 	))
 
 	// EIBC module
@@ -380,6 +391,8 @@ func migrateDeprecatedParamsKeeperSubspaces(ctx sdk.Context, keepers *upgrades.U
 		eibcParams.EpochIdentifier,
 		eibcParams.TimeoutFee,
 		eibcParams.ErrackFee,
+		eibcmoduletypes.DefaultParams().BatchMatchLimit,
+		eibcmoduletypes.DefaultParams().FulfillmentHistoryRetention,
 	))
 
 	// DymNS module
@@ -434,6 +447,13 @@ func migrateDeprecatedParamsKeeperSubspaces(ctx sdk.Context, keepers *upgrades.U
 		rollappParams.LivenessSlashInterval,
 		rollappParams.AppRegistrationFee,
 		rollappParams.MinSequencerBondGlobal,
+		rollappmoduletypes.DefaultParams().ChallengeBond,
+		rollappmoduletypes.DefaultParams().ChallengeResponseBlocks,
+		rollappmoduletypes.DefaultParams().ChallengeResolutionBlocks,
+		rollappmoduletypes.DefaultParams().MinDisputePeriodInBlocks,
+		rollappmoduletypes.DefaultParams().MaxDisputePeriodInBlocks,
+		rollappmoduletypes.DefaultParams().StateInfoRetentionBlocks,
+		rollappmoduletypes.DefaultParams().MaxPrunedStateInfosPerBlock,
 	))
 
 	// Streamer module
@@ -505,6 +525,9 @@ func updateSequencerParams(ctx sdk.Context, k *sequencerkeeper.Keeper) {
 	params.SetPenaltyLiveness(newPenaltyLiveness)
 	params.SetPenaltyKickThreshold(NewPenaltyKickThreshold)
 	params.SetPenaltyReductionStateUpdate(newPenaltyReductionStateUpdate)
+	params.HistoryLimit = sequencertypes.DefaultHistoryLimit
+	params.UnbondingTime = sequencertypes.DefaultUnbondingTime
+	params.RewardsEpochIdentifier = sequencertypes.DefaultRewardsEpochIdentifier
 	k.SetParams(ctx, params)
 }
 
@@ -191,6 +191,9 @@ func (s *UpgradeTestSuite) setIROParams() {
 	oldParams.MinLiquidityPart = math.LegacyDec{}
 	oldParams.MinVestingDuration = 0
 	oldParams.MinVestingStartTimeAfterSettlement = 0
+	oldParams.TradeHistorySize = 0
+	oldParams.CandleInterval = 0
+	oldParams.CandleHistorySize = 0
 
 	s.App.IROKeeper.SetParams(s.Ctx, oldParams)
 }
@@ -207,6 +210,10 @@ func (s *UpgradeTestSuite) validateIROParamsMigration() error {
 		return fmt.Errorf("min vesting duration or start time after settlement not set correctly")
 	}
 
+	if params.TradeHistorySize != expected.TradeHistorySize || params.CandleInterval != expected.CandleInterval || params.CandleHistorySize != expected.CandleHistorySize {
+		return fmt.Errorf("trade history params not set correctly")
+	}
+
 	return nil
 }
 
@@ -292,6 +299,11 @@ func (s *UpgradeTestSuite) validateSequencersMigration(ctx sdk.Context, k *seque
 	sequencers := k.AllSequencers(ctx)
 	s.Require().Equal(len(sequencers), 1)
 	s.Require().Equal(v5.NewPenaltyKickThreshold, sequencers[0].GetPenalty())
+
+	params := k.GetParams(ctx)
+	s.Require().Equal(sequencertypes.DefaultHistoryLimit, params.HistoryLimit)
+	s.Require().Equal(sequencertypes.DefaultUnbondingTime, params.UnbondingTime)
+	s.Require().Equal(sequencertypes.DefaultRewardsEpochIdentifier, params.RewardsEpochIdentifier)
 }
 
 func (s *UpgradeTestSuite) validateConsensusParamsMigration() {
@@ -30,6 +30,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetPacketsByStatus())
 	cmd.AddCommand(CmdGetPacketsByType())
 	cmd.AddCommand(CmdGetPendingPacketsByAddress())
+	cmd.AddCommand(CmdGetNextUnlockByAddress())
 
 	return cmd
 }
@@ -254,3 +255,36 @@ func CmdGetPendingPacketsByAddress() *cobra.Command {
 
 	return cmd
 }
+
+func CmdGetNextUnlockByAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "next-unlock [address]",
+		Short: "Get the earliest estimated finalization among the pending packets of an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			_, err = sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GetNextUnlockByAddress(cmd.Context(), &types.QueryNextUnlockByAddressRequest{
+				Address: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -24,6 +24,7 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdFinalizePacket())
+	cmd.AddCommand(CmdFinalizePackets())
 
 	return cmd
 }
@@ -73,6 +74,47 @@ func CmdFinalizePacket() *cobra.Command {
 	return cmd
 }
 
+const FlagAddress = "address"
+
+func CmdFinalizePackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "finalize-packets [rollapp-id] [limit] --from <sender>",
+		Short: "Finalize the pending packets of a rollapp whose height is finalized, up to limit",
+		Long:  "Finalize the pending packets of a rollapp whose height is finalized, up to limit. Use --address to only finalize the packets received or sent by an address",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			limit, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			address, err := cmd.Flags().GetString(FlagAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgFinalizePackets{
+				Sender:    clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+				Address:   address,
+				Limit:     limit,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().String(FlagAddress, "", "Only finalize the packets of this address")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func parsePacketType(packetType string) (commontypes.RollappPacket_Type, error) {
 	switch packetType {
 	case commontypes.RollappPacket_ON_RECV.String():
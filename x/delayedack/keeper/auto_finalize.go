@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// AfterStateFinalized queues the rollapp for auto finalization, as some of its pending packets may
+// now be finalizable. The position of a rollapp already queued is kept. It also records the hub block
+// time observed over the dispute period of the state.
+func (k Keeper) AfterStateFinalized(ctx sdk.Context, rollappID string, stateInfo *rollapptypes.StateInfo) error {
+	if err := k.recordHubBlockTime(ctx, rollappID, stateInfo); err != nil {
+		return err
+	}
+	queued, err := k.autoFinalizeQueue.Has(ctx, rollappID)
+	if err != nil || queued {
+		return err
+	}
+	return k.autoFinalizeQueue.Set(ctx, rollappID, nil)
+}
+
+// AutoFinalizePackets is called at the end of every block. It finalizes, in order of proof height, up to
+// the per block limit of the pending packets of the queued rollapps whose height is finalized, as long as
+// the gas consumed fits in the per block budget. Each rollapp resumes after the last packet attempted for
+// it, so that a packet which fails to finalize, or needs more gas than the whole budget, is skipped with
+// the error in its event and left pending. It is retried once the rollapp has no packets left after it.
+func (k Keeper) AutoFinalizePackets(ctx sdk.Context, ibc porttypes.IBCModule) error {
+	params := k.GetParams(ctx)
+	if params.AutoFinalizePacketsLimit == 0 {
+		return nil
+	}
+	limit := int(params.AutoFinalizePacketsLimit)
+
+	packets, err := k.autoFinalizablePackets(ctx, limit)
+	if err != nil {
+		return fmt.Errorf("auto finalizable packets: %w", err)
+	}
+	if len(packets) == 0 {
+		return nil
+	}
+
+	summary := types.EventAutoFinalizePackets{}
+	for _, p := range packets {
+		remaining := params.AutoFinalizeGasBudget - summary.GasUsed
+		gasUsed, outOfGas, err := k.finalizeWithinGas(ctx, ibc, p, remaining)
+		summary.GasUsed += gasUsed
+		if outOfGas && remaining < params.AutoFinalizeGasBudget {
+			// the packet is left for the next block, which has a whole budget
+			break
+		}
+		if outOfGas {
+			err = fmt.Errorf("exceeds the gas budget: %d", params.AutoFinalizeGasBudget)
+		}
+
+		if err := k.autoFinalizeQueue.Set(ctx, p.RollappId, p.RollappPacketKey()); err != nil {
+			return fmt.Errorf("advance rollapp: %s: %w", p.RollappId, err)
+		}
+
+		event := &types.EventAutoFinalizePacket{
+			RollappId:         p.RollappId,
+			PacketProofHeight: p.ProofHeight,
+			PacketType:        p.Type,
+			PacketSrcChannel:  p.Packet.SourceChannel,
+			PacketSequence:    p.Packet.Sequence,
+		}
+		if err != nil {
+			k.Logger(ctx).Error("Auto finalize packet.", "packet", p.LogString(), "err", err)
+			event.Error = err.Error()
+			summary.Failed++
+		} else {
+			summary.Finalized++
+		}
+		if err = uevent.EmitTypedEvent(ctx, event); err != nil {
+			return fmt.Errorf("emit event: %w", err)
+		}
+	}
+
+	if err = uevent.EmitTypedEvent(ctx, &summary); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+// autoFinalizablePackets returns up to limit pending packets whose height is finalized across the queued
+// rollapps, after the last packet attempted for each, in order of proof height. A rollapp without packets
+// after its last attempted one starts over, and is dequeued if it has none at all.
+func (k Keeper) autoFinalizablePackets(ctx sdk.Context, limit int) ([]commontypes.RollappPacket, error) {
+	iter, err := k.autoFinalizeQueue.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	queue, err := iter.KeyValues()
+	if err != nil {
+		return nil, err
+	}
+
+	var packets []commontypes.RollappPacket
+	for _, kv := range queue {
+		rollappID, last := kv.Key, kv.Value
+		ps, err := k.finalizablePacketsAfter(ctx, rollappID, last, limit)
+		if err == nil && len(ps) == 0 && len(last) != 0 {
+			ps, err = k.finalizablePacketsAfter(ctx, rollappID, nil, limit)
+		}
+		if err != nil {
+			k.Logger(ctx).Error("Auto finalize: finalizable packets.", "rollapp", rollappID, "err", err)
+		}
+		if len(ps) == 0 {
+			if err := k.autoFinalizeQueue.Remove(ctx, rollappID); err != nil {
+				return nil, fmt.Errorf("dequeue rollapp: %s: %w", rollappID, err)
+			}
+			continue
+		}
+		packets = append(packets, ps...)
+	}
+
+	sort.SliceStable(packets, func(i, j int) bool {
+		if packets[i].ProofHeight != packets[j].ProofHeight {
+			return packets[i].ProofHeight < packets[j].ProofHeight
+		}
+		return bytes.Compare(packets[i].RollappPacketKey(), packets[j].RollappPacketKey()) < 0
+	})
+	if limit < len(packets) {
+		packets = packets[:limit]
+	}
+	return packets, nil
+}
+
+// finalizablePacketsAfter returns up to limit pending packets of the rollapp whose height is finalized,
+// with a key after the given one
+func (k Keeper) finalizablePacketsAfter(ctx sdk.Context, rollappID string, after []byte, limit int) ([]commontypes.RollappPacket, error) {
+	latestFinalizedHeight, err := k.getRollappLatestFinalizedHeight(ctx, rollappID)
+	if err != nil {
+		return nil, fmt.Errorf("get latest finalized height: rollapp '%s': %w", rollappID, err)
+	}
+	filter := types.PendingByRollappIDByMaxHeight(rollappID, latestFinalizedHeight).After(after).Take(limit)
+	return k.ListRollappPackets(ctx, filter), nil
+}
+
+// finalizeWithinGas finalizes the packet with a gas meter limited to gasLimit. Running out of gas reverts
+// the finalization, and is reported apart from other errors.
+func (k Keeper) finalizeWithinGas(
+	ctx sdk.Context,
+	ibc porttypes.IBCModule,
+	p commontypes.RollappPacket,
+	gasLimit uint64,
+) (gasUsed uint64, outOfGas bool, err error) {
+	meter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			if ok, _ := osmoutils.IsOutOfGasError(r); !ok {
+				panic(r)
+			}
+			gasUsed, outOfGas, err = gasLimit, true, fmt.Errorf("out of gas")
+		}
+	}()
+
+	key := string(p.RollappPacketKey())
+	err = osmoutils.ApplyFuncIfNoError(ctx.WithGasMeter(meter), func(ctx sdk.Context) error {
+		_, err := k.FinalizeRollappPacket(ctx, ibc, key)
+		return err
+	})
+	return meter.GasConsumed(), false, err
+}
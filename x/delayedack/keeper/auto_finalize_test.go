@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *DelayedAckTestSuite) TestAutoFinalizePackets() {
+	rollapp := "rollapp_1234-1"
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+
+	k := s.App.DelayedAckKeeper
+	ibc := s.App.DelayedAckMiddleware.NextIBCMiddleware()
+
+	packets := []commontypes.RollappPacket{
+		{RollappId: rollapp, Status: commontypes.Status_PENDING, ProofHeight: 8, Packet: apptesting.GenerateTestPacket(s.T(), 1)},
+		{RollappId: rollapp, Status: commontypes.Status_PENDING, ProofHeight: 3, Packet: apptesting.GenerateTestPacket(s.T(), 2)},
+		{RollappId: rollapp, Status: commontypes.Status_PENDING, ProofHeight: 5, Packet: apptesting.GenerateTestPacket(s.T(), 3)},
+		// height not finalized
+		{RollappId: rollapp, Status: commontypes.Status_PENDING, ProofHeight: 15, Packet: apptesting.GenerateTestPacket(s.T(), 4)},
+	}
+	for _, p := range packets {
+		k.SetRollappPacket(s.Ctx, p)
+	}
+	finalized := func(p commontypes.RollappPacket) bool {
+		p.Status = commontypes.Status_FINALIZED
+		_, err := k.GetRollappPacket(s.Ctx, string(p.RollappPacketKey()))
+		return err == nil
+	}
+
+	// heights up to 10 are finalized
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{
+			RollappId: rollapp,
+			Index:     1,
+		},
+		StartHeight: 1,
+		NumBlocks:   10,
+		Status:      commontypes.Status_FINALIZED,
+		Sequencer:   proposer,
+	}
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, stateInfo)
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, stateInfo.StateInfoIndex)
+	s.Require().NoError(k.AfterStateFinalized(s.Ctx, rollapp, &stateInfo))
+
+	// disabled by default
+	s.Require().NoError(k.AutoFinalizePackets(s.Ctx, ibc))
+	s.Require().False(finalized(packets[1]))
+
+	// a budget which does not fit a single packet: the lowest proof height is skipped
+	params := k.GetParams(s.Ctx)
+	params.AutoFinalizePacketsLimit = 2
+	params.AutoFinalizeGasBudget = 1
+	s.Require().NoError(params.ValidateBasic())
+	k.SetParams(s.Ctx, params)
+	s.Require().NoError(k.AutoFinalizePackets(s.Ctx, ibc))
+	s.Require().False(finalized(packets[1]))
+	s.AssertEventEmitted(s.Ctx, "dymensionxyz.dymension.delayedack.EventAutoFinalizePacket", 1)
+
+	params.AutoFinalizeGasBudget = 20_000_000
+	k.SetParams(s.Ctx, params)
+
+	// the next two proof heights, past the skipped one
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	s.Require().NoError(k.AutoFinalizePackets(s.Ctx, ibc))
+	s.Require().False(finalized(packets[1]))
+	s.Require().True(finalized(packets[2]))
+	s.Require().True(finalized(packets[0]))
+	s.AssertEventEmitted(s.Ctx, "dymensionxyz.dymension.delayedack.EventAutoFinalizePacket", 2)
+
+	// the skipped packet is retried once there are none after it
+	s.Require().NoError(k.AutoFinalizePackets(s.Ctx, ibc))
+	s.Require().True(finalized(packets[1]))
+	s.Require().False(finalized(packets[3]))
+}
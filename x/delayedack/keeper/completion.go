@@ -72,9 +72,48 @@ func (k Keeper) finalizeOnRecv(ctx sdk.Context, ibc porttypes.IBCModule, p *comm
 		}
 	}
 
+	k.settleFulfillmentShares(ctx, p, ack != nil && ack.Success())
+
 	return k.finalizeCompletionHook(ctx, p)
 }
 
+// settleFulfillmentShares pays out the proceeds of an order which was funded by several partial fulfillers,
+// or refunds them if the order never got fully funded. It must be called after the transfer flow was resumed,
+// delivered tells if the transfer funds actually reached the shares escrow which fulfilled the order for them.
+// Errors are logged only, the packet is finalized regardless.
+func (k Keeper) settleFulfillmentShares(ctx sdk.Context, p *commontypes.RollappPacket, delivered bool) {
+	err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+		o, err := k.PendingOrderByPacket(ctx, p)
+		if errorsmod.IsOf(err, eibctypes.ErrDemandOrderDoesNotExist) {
+			return nil
+		}
+		if err != nil {
+			return errorsmod.Wrap(err, "pending order by packet")
+		}
+		if !o.IsPartiallyFunded() {
+			return nil
+		}
+
+		data, err := p.GetTransferPacketData()
+		if err != nil {
+			return errorsmod.Wrap(err, "get transfer packet data")
+		}
+		received, ok := math.NewIntFromString(data.Amount)
+		if !ok {
+			return gerrc.ErrInvalidArgument.Wrapf("transfer amount: %s", data.Amount)
+		}
+		if p.Type == commontypes.RollappPacket_ON_RECV {
+			// the bridge fee is taken before the funds reach the receiver
+			received = received.Sub(k.BridgingFeeFromAmt(ctx, received))
+		}
+
+		return k.SettleFulfillmentShares(ctx, o, received, delivered)
+	})
+	if err != nil {
+		k.Logger(ctx).Error("Settle fulfillment shares.", "packet", p.LogString(), "error", err)
+	}
+}
+
 // *In general* we want a way to do something whenever an ibc transfer finishes ("Hook"). It can happen
 // 1. on EIBC fulfill (transfer does not really 'happen', but there is a movement of funds from fulfiller to recipient)
 // 2. on finalize to the original recipient, for non fulfilled orders
@@ -0,0 +1,161 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// etaEstimator estimates the finalization of pending packets. The dispute period and the average hub
+// block time are looked up once per rollapp and reused for all the packets of the rollapp.
+type etaEstimator struct {
+	k   Keeper
+	ctx sdk.Context
+	// rollapp id -> dispute period of the states the rollapp submits
+	disputePeriods map[string]uint64
+	// rollapp id -> average hub block time, zero if it was not measured yet
+	blockTimes map[string]time.Duration
+}
+
+func (k Keeper) newETAEstimator(ctx sdk.Context) *etaEstimator {
+	return &etaEstimator{
+		k:              k,
+		ctx:            ctx,
+		disputePeriods: make(map[string]uint64),
+		blockTimes:     make(map[string]time.Duration),
+	}
+}
+
+// FinalizationETAs returns the estimated finalization of the pending packets among packets
+func (k Keeper) FinalizationETAs(ctx sdk.Context, packets []commontypes.RollappPacket) []types.PacketFinalizationETA {
+	e := k.newETAEstimator(ctx)
+	etas := make([]types.PacketFinalizationETA, 0, len(packets))
+	for _, p := range packets {
+		if p.Status != commontypes.Status_PENDING {
+			continue
+		}
+		etas = append(etas, e.estimate(p))
+	}
+	return etas
+}
+
+// GetNextUnlockByAddress returns the number of pending packets of the address and the estimated
+// finalization of the one which finalizes first, nil if there are none. As the packets of a rollapp
+// finalize in order of proof height, only the first pending packet of each rollapp is looked at.
+func (k Keeper) GetNextUnlockByAddress(ctx sdk.Context, address string) (uint64, *types.PacketFinalizationETA, error) {
+	n, err := k.GetPendingPacketsCount(ctx, address)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	e := k.newETAEstimator(ctx)
+	var next *types.PacketFinalizationETA
+	rng := collections.NewPrefixedPairRange[string, []byte](address)
+	for {
+		key, found, err := k.firstPendingPacketByAddress(ctx, rng)
+		if err != nil {
+			return 0, nil, err
+		}
+		if !found {
+			break
+		}
+		p, err := k.GetRollappPacket(ctx, string(key))
+		if err != nil {
+			return 0, nil, err
+		}
+		eta := e.estimate(*p)
+		if next == nil || eta.Before(*next) {
+			next = &eta
+		}
+		// skip the other packets of the rollapp
+		rollappEnd := storetypes.PrefixEndBytes(commontypes.RollappPacketByStatusByRollappIDPrefix(p.Status, p.RollappId))
+		rng = collections.NewPrefixedPairRange[string, []byte](address).StartInclusive(rollappEnd)
+	}
+	return n, next, nil
+}
+
+func (k Keeper) firstPendingPacketByAddress(ctx sdk.Context, rng collections.Ranger[collections.Pair[string, []byte]]) ([]byte, bool, error) {
+	iter, err := k.pendingPacketsByAddress.Iterate(ctx, rng)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close() // nolint: errcheck
+	if !iter.Valid() {
+		return nil, false, nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return nil, false, err
+	}
+	return key.K2(), true, nil
+}
+
+// recordHubBlockTime records the average hub block time over the dispute period of a finalized state of
+// the rollapp, from which the finalization time of its pending packets is estimated.
+func (k Keeper) recordHubBlockTime(ctx sdk.Context, rollappID string, stateInfo *rollapptypes.StateInfo) error {
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	if stateInfo == nil || h <= stateInfo.CreationHeight || stateInfo.CreatedAt.IsZero() {
+		return nil
+	}
+	d := ctx.BlockTime().Sub(stateInfo.CreatedAt) / time.Duration(h-stateInfo.CreationHeight) //nolint:gosec
+	return k.hubBlockTimes.Set(ctx, rollappID, int64(d))
+}
+
+func (e *etaEstimator) estimate(p commontypes.RollappPacket) types.PacketFinalizationETA {
+	eta := types.PacketFinalizationETA{
+		PacketKey: string(p.RollappPacketKey()),
+		RollappId: p.RollappId,
+	}
+	h := uint64(e.ctx.BlockHeight()) //nolint:gosec
+
+	if e.k.VerifyHeightFinalized(e.ctx, p.RollappId, p.ProofHeight) == nil {
+		eta.Finalizable = true
+		eta.Height = h
+		t := e.ctx.BlockTime()
+		eta.Time = &t
+		return eta
+	}
+
+	stateInfo, err := e.k.rollappKeeper.FindStateInfoByHeight(e.ctx, p.RollappId, p.ProofHeight)
+	if err != nil {
+		// the state would be finalized one dispute period after it is submitted at the earliest
+		eta.AwaitingStateUpdate = true
+		eta.Height = h + e.disputePeriod(p.RollappId)
+	} else {
+		eta.Height = max(e.k.rollappKeeper.StateFinalizationHeight(e.ctx, stateInfo), h)
+	}
+
+	if blockTime := e.blockTime(p.RollappId); blockTime > 0 {
+		t := e.ctx.BlockTime().Add(time.Duration(eta.Height-h) * blockTime) //nolint:gosec
+		eta.Time = &t
+	}
+	return eta
+}
+
+func (e *etaEstimator) disputePeriod(rollappID string) uint64 {
+	if d, ok := e.disputePeriods[rollappID]; ok {
+		return d
+	}
+	d := e.k.rollappKeeper.RollappDisputePeriodInBlocks(e.ctx, rollappID)
+	e.disputePeriods[rollappID] = d
+	return d
+}
+
+// blockTime returns the average hub block time recorded when the last state of the rollapp was finalized
+func (e *etaEstimator) blockTime(rollappID string) time.Duration {
+	if d, ok := e.blockTimes[rollappID]; ok {
+		return d
+	}
+	d, err := e.k.hubBlockTimes.Get(e.ctx, rollappID)
+	if err != nil {
+		d = 0
+	}
+	e.blockTimes[rollappID] = time.Duration(d)
+	return time.Duration(d)
+}
@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *DelayedAckTestSuite) TestFinalizationETA() {
+	rollapp := "rollapp_1234-1"
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+
+	params := s.App.RollappKeeper.GetParams(s.Ctx)
+	params.DisputePeriodInBlocks = 100
+	s.App.RollappKeeper.SetParams(s.Ctx, params)
+
+	// the state containing heights 1..10 is submitted at hub height 10
+	t0 := time.Unix(1_700_000_000, 0).UTC()
+	ctx := s.Ctx.WithBlockHeight(10).WithBlockTime(t0)
+	_, err := s.PostStateUpdate(ctx, rollapp, proposer, 1, 10)
+	s.Require().NoError(err)
+
+	k := s.App.DelayedAckKeeper
+	finalizable := commontypes.RollappPacket{
+		RollappId:   rollapp,
+		Packet:      apptesting.GenerateTestPacket(s.T(), 1),
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: 5,
+	}
+	inState := commontypes.RollappPacket{
+		RollappId:   rollapp,
+		Packet:      apptesting.GenerateTestPacket(s.T(), 2),
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: 15,
+	}
+	notInState := commontypes.RollappPacket{
+		RollappId:   rollapp,
+		Packet:      apptesting.GenerateTestPacket(s.T(), 3),
+		Status:      commontypes.Status_PENDING,
+		ProofHeight: 25,
+	}
+	for _, p := range []commontypes.RollappPacket{finalizable, inState, notInState} {
+		k.SetRollappPacket(ctx, p)
+		s.Require().NoError(k.SetPendingPacketByAddress(ctx, apptesting.TestPacketReceiver, p.RollappPacketKey()))
+	}
+	// indexing a packet twice counts it once
+	s.Require().NoError(k.SetPendingPacketByAddress(ctx, apptesting.TestPacketReceiver, inState.RollappPacketKey()))
+
+	// the state is finalized after 100 blocks of 6s
+	q := keeper.NewQuerier(k)
+	ctx = ctx.WithBlockHeight(110).WithBlockTime(t0.Add(600 * time.Second))
+	res, err := q.GetPendingPacketsByAddress(ctx, &types.QueryPendingPacketsByAddressRequest{Address: apptesting.TestPacketReceiver})
+	s.Require().NoError(err)
+	for _, eta := range res.FinalizationEtas {
+		// no state finalized yet to measure the block time with
+		s.Require().Nil(eta.Time)
+	}
+
+	stateInfo, ok := s.App.RollappKeeper.GetStateInfo(ctx, rollapp, 1)
+	s.Require().True(ok)
+	stateInfo.Status = commontypes.Status_FINALIZED
+	s.App.RollappKeeper.SetStateInfo(ctx, stateInfo)
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(ctx, rollapptypes.StateInfoIndex{RollappId: rollapp, Index: 1})
+	s.Require().NoError(k.AfterStateFinalized(ctx, rollapp, &stateInfo))
+
+	// the state containing heights 11..20 is submitted at hub height 120
+	ctx = ctx.WithBlockHeight(120).WithBlockTime(t0.Add(660 * time.Second))
+	_, err = s.PostStateUpdate(ctx, rollapp, proposer, 11, 10)
+	s.Require().NoError(err)
+
+	ctx = ctx.WithBlockHeight(130).WithBlockTime(t0.Add(720 * time.Second))
+	res, err = q.GetPendingPacketsByAddress(ctx, &types.QueryPendingPacketsByAddressRequest{Address: apptesting.TestPacketReceiver})
+	s.Require().NoError(err)
+	s.Require().Len(res.FinalizationEtas, 3)
+	etas := make(map[string]types.PacketFinalizationETA)
+	for _, eta := range res.FinalizationEtas {
+		etas[eta.PacketKey] = eta
+	}
+
+	eta := etas[string(finalizable.RollappPacketKey())]
+	s.Require().True(eta.Finalizable)
+	s.Require().Equal(uint64(130), eta.Height)
+
+	eta = etas[string(inState.RollappPacketKey())]
+	s.Require().False(eta.Finalizable)
+	s.Require().False(eta.AwaitingStateUpdate)
+	s.Require().Equal(uint64(220), eta.Height)
+	s.Require().NotNil(eta.Time)
+	s.Require().Equal(ctx.BlockTime().Add(90*6*time.Second), *eta.Time)
+
+	eta = etas[string(notInState.RollappPacketKey())]
+	s.Require().False(eta.Finalizable)
+	s.Require().True(eta.AwaitingStateUpdate)
+	s.Require().Equal(uint64(230), eta.Height)
+	s.Require().Equal(ctx.BlockTime().Add(100*6*time.Second), *eta.Time)
+
+	next, err := q.GetNextUnlockByAddress(ctx, &types.QueryNextUnlockByAddressRequest{Address: apptesting.TestPacketReceiver})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), next.PendingPackets)
+	s.Require().Equal(string(finalizable.RollappPacketKey()), next.Next.PacketKey)
+
+	// the next one once it is finalized
+	s.Require().NoError(k.DeletePendingPacketByAddress(ctx, apptesting.TestPacketReceiver, finalizable.RollappPacketKey()))
+	next, err = q.GetNextUnlockByAddress(ctx, &types.QueryNextUnlockByAddressRequest{Address: apptesting.TestPacketReceiver})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), next.PendingPackets)
+	s.Require().Equal(string(inState.RollappPacketKey()), next.Next.PacketKey)
+
+	// the counts are rebuilt from the index
+	s.Require().NoError(k.InitPendingPacketsCounts(ctx))
+	n, err := k.GetPendingPacketsCount(ctx, apptesting.TestPacketReceiver)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), n)
+
+	// no pending packets
+	next, err = q.GetNextUnlockByAddress(ctx, &types.QueryNextUnlockByAddressRequest{Address: "other"})
+	s.Require().NoError(err)
+	s.Require().Zero(next.PendingPackets)
+	s.Require().Nil(next.Next)
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/collections"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
@@ -11,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/osmoutils"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
 
 func (k Keeper) FinalizeRollappPacket(ctx sdk.Context, ibc porttypes.IBCModule, rollappPacketKey string) (*commontypes.RollappPacket, error) {
@@ -32,6 +34,64 @@ func (k Keeper) FinalizeRollappPacket(ctx sdk.Context, ibc porttypes.IBCModule,
 	return packet, nil
 }
 
+// PacketFinalization is the outcome of finalizing a packet in bulk
+type PacketFinalization struct {
+	Packet commontypes.RollappPacket
+	Err    error
+}
+
+// FinalizeRollappPackets finalizes up to limit pending packets of the rollapp whose height is finalized,
+// in order of proof height, optionally restricted to the packets of address. A packet failing to finalize
+// is left pending and reported, without reverting the others.
+func (k Keeper) FinalizeRollappPackets(ctx sdk.Context, ibc porttypes.IBCModule, rollappID, address string, limit int) ([]PacketFinalization, error) {
+	packets, err := k.finalizablePackets(ctx, rollappID, address, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]PacketFinalization, 0, len(packets))
+	for _, p := range packets {
+		key := string(p.RollappPacketKey())
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			_, err := k.FinalizeRollappPacket(ctx, ibc, key)
+			return err
+		})
+		res = append(res, PacketFinalization{Packet: p, Err: err})
+	}
+	return res, nil
+}
+
+// finalizablePackets returns up to limit pending packets of the rollapp whose height is finalized,
+// optionally restricted to the packets of address
+func (k Keeper) finalizablePackets(ctx sdk.Context, rollappID, address string, limit int) ([]commontypes.RollappPacket, error) {
+	latestFinalizedHeight, err := k.getRollappLatestFinalizedHeight(ctx, rollappID)
+	if err != nil {
+		return nil, fmt.Errorf("get latest finalized height: rollapp '%s': %w", rollappID, err)
+	}
+
+	if address == "" {
+		return k.ListRollappPackets(ctx, types.PendingByRollappIDByMaxHeight(rollappID, latestFinalizedHeight).Take(limit)), nil
+	}
+
+	var packets []commontypes.RollappPacket
+	rng := collections.NewPrefixedPairRange[string, []byte](address)
+	err = k.pendingPacketsByAddress.Walk(ctx, rng, func(key collections.Pair[string, []byte]) (stop bool, err error) {
+		packet, err := k.GetRollappPacket(ctx, string(key.K2()))
+		if err != nil {
+			return true, err
+		}
+		if packet.RollappId == rollappID && packet.ProofHeight <= latestFinalizedHeight {
+			packets = append(packets, *packet)
+		}
+		return len(packets) == limit, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get pending packets by address: %s: %w", address, err)
+	}
+	// the index is ordered by packet key, which orders the packets of a rollapp by proof height
+	return packets, nil
+}
+
 // used with osmo helper
 type wrappedFunc func(ctx sdk.Context) error
 
@@ -56,8 +116,10 @@ func (k Keeper) finalizeRollappPacket(
 		packetErr = k.finalizeOnRecv(ctx, ibc, &rollappPacket)
 	case commontypes.RollappPacket_ON_ACK:
 		packetErr = osmoutils.ApplyFuncIfNoError(ctx, k.onAckPacket(rollappPacket, ibc))
+		k.settleFulfillmentShares(ctx, &rollappPacket, packetErr == nil)
 	case commontypes.RollappPacket_ON_TIMEOUT:
 		packetErr = osmoutils.ApplyFuncIfNoError(ctx, k.onTimeoutPacket(rollappPacket, ibc))
+		k.settleFulfillmentShares(ctx, &rollappPacket, packetErr == nil)
 	default:
 		logger.Error("Unknown rollapp packet type")
 	}
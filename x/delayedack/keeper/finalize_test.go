@@ -194,3 +194,89 @@ func (s *DelayedAckTestSuite) TestFinalizePacketByPacketKey() {
 		})
 	}
 }
+
+func (s *DelayedAckTestSuite) TestFinalizePackets() {
+	rollapp := "rollapp_1234-1"
+	s.CreateRollappByName(rollapp)
+	proposer := s.CreateDefaultSequencer(s.Ctx, rollapp)
+
+	// heights up to 10 are finalized
+	stateInfo := rollapptypes.StateInfo{
+		StateInfoIndex: rollapptypes.StateInfoIndex{
+			RollappId: rollapp,
+			Index:     1,
+		},
+		StartHeight: 1,
+		NumBlocks:   10,
+		Status:      commontypes.Status_FINALIZED,
+		Sequencer:   proposer,
+	}
+	s.App.RollappKeeper.SetStateInfo(s.Ctx, stateInfo)
+	s.App.RollappKeeper.SetLatestFinalizedStateIndex(s.Ctx, stateInfo.StateInfoIndex)
+
+	addrs := apptesting.CreateRandomAccounts(2)
+	k := s.App.DelayedAckKeeper
+	packets := []struct {
+		packet commontypes.RollappPacket
+		owner  string
+	}{
+		{commontypes.RollappPacket{RollappId: rollapp, Status: commontypes.Status_PENDING, ProofHeight: 3, Packet: apptesting.GenerateTestPacket(s.T(), 1)}, addrs[0].String()},
+		{commontypes.RollappPacket{RollappId: rollapp, Status: commontypes.Status_PENDING, ProofHeight: 5, Packet: apptesting.GenerateTestPacket(s.T(), 2)}, addrs[1].String()},
+		{commontypes.RollappPacket{RollappId: rollapp, Status: commontypes.Status_PENDING, ProofHeight: 8, Packet: apptesting.GenerateTestPacket(s.T(), 3)}, addrs[0].String()},
+		// height not finalized
+		{commontypes.RollappPacket{RollappId: rollapp, Status: commontypes.Status_PENDING, ProofHeight: 15, Packet: apptesting.GenerateTestPacket(s.T(), 4)}, addrs[0].String()},
+	}
+	for _, p := range packets {
+		k.SetRollappPacket(s.Ctx, p.packet)
+		s.Require().NoError(k.SetPendingPacketByAddress(s.Ctx, p.owner, p.packet.RollappPacketKey()))
+	}
+
+	finalized := func(p commontypes.RollappPacket) bool {
+		p.Status = commontypes.Status_FINALIZED
+		_, err := k.GetRollappPacket(s.Ctx, string(p.RollappPacketKey()))
+		return err == nil
+	}
+
+	handler := s.App.MsgServiceRouter().Handler(new(types.MsgFinalizePackets))
+	sender := apptesting.CreateRandomAccounts(1)[0].String()
+
+	// only the packets of the address
+	resp, err := handler(s.Ctx, &types.MsgFinalizePackets{
+		Sender:    sender,
+		RollappId: rollapp,
+		Address:   addrs[0].String(),
+		Limit:     10,
+	})
+	s.Require().NoError(err)
+	var res types.MsgFinalizePacketsResponse
+	s.Require().NoError(s.App.AppCodec().Unmarshal(resp.MsgResponses[0].Value, &res))
+	s.Require().Equal(uint64(2), res.Finalized)
+	s.Require().Zero(res.Failed)
+	s.Require().Len(res.Results, 2)
+	s.Require().Equal(commontypes.EncodePacketKey(packets[0].packet.RollappPacketKey()), res.Results[0].PacketKey)
+	s.Require().Empty(res.Results[0].Error)
+	s.Require().True(finalized(packets[0].packet))
+	s.Require().False(finalized(packets[1].packet))
+	s.Require().True(finalized(packets[2].packet))
+	s.Require().False(finalized(packets[3].packet))
+
+	// the remaining finalizable packet of the rollapp
+	resp, err = handler(s.Ctx, &types.MsgFinalizePackets{
+		Sender:    sender,
+		RollappId: rollapp,
+		Limit:     10,
+	})
+	s.Require().NoError(err)
+	res = types.MsgFinalizePacketsResponse{}
+	s.Require().NoError(s.App.AppCodec().Unmarshal(resp.MsgResponses[0].Value, &res))
+	s.Require().Equal(uint64(1), res.Finalized)
+	s.Require().True(finalized(packets[1].packet))
+	s.Require().False(finalized(packets[3].packet))
+
+	// limit is required
+	_, err = handler(s.Ctx, &types.MsgFinalizePackets{
+		Sender:    sender,
+		RollappId: rollapp,
+	})
+	s.Require().Error(err)
+}
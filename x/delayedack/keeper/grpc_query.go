@@ -51,6 +51,8 @@ func (q Querier) GetPackets(goCtx context.Context, req *types.QueryRollappPacket
 
 	// TODO: handle pagination
 
+	res.FinalizationEtas = q.FinalizationETAs(ctx, res.RollappPackets)
+
 	return res, nil
 }
 
@@ -68,7 +70,26 @@ func (q Querier) GetPendingPacketsByAddress(goCtx context.Context, req *types.Qu
 	}
 
 	return &types.QueryPendingPacketByAddressListResponse{
-		RollappPackets: p,
-		Pagination:     pageResp,
+		RollappPackets:   p,
+		Pagination:       pageResp,
+		FinalizationEtas: q.FinalizationETAs(ctx, p),
+	}, nil
+}
+
+func (q Querier) GetNextUnlockByAddress(goCtx context.Context, req *types.QueryNextUnlockByAddressRequest) (*types.QueryNextUnlockByAddressResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	n, next, err := q.Keeper.GetNextUnlockByAddress(ctx, req.Address)
+	if err != nil {
+		return nil, fmt.Errorf("get next unlock by address %s: %w", req.Address, err)
+	}
+
+	return &types.QueryNextUnlockByAddressResponse{
+		PendingPackets: n,
+		Next:           next,
 	}, nil
 }
@@ -50,6 +50,12 @@ func (k eibcHooks) AfterDemandOrderFulfilled(ctx sdk.Context, o *eibctypes.Deman
 	return nil
 }
 
+// AfterDemandOrderClaimTransferred is called when the claim on a fulfilled order changes hands.
+// The underlying packet recipient should be updated to the new claim holder.
+func (k eibcHooks) AfterDemandOrderClaimTransferred(ctx sdk.Context, o *eibctypes.DemandOrder, receiverAddr string) error {
+	return k.UpdateRollappPacketTransferAddress(ctx, o.TrackingPacketKey, receiverAddr)
+}
+
 /* -------------------------------------------------------------------------- */
 /*                                 epoch hooks                                */
 /* -------------------------------------------------------------------------- */
@@ -32,6 +32,19 @@ type Keeper struct {
 	// Index key: receiver address + packet key.
 	pendingPacketsByAddress collections.KeySet[collections.Pair[string, []byte]]
 
+	// autoFinalizeQueue holds the rollapps which may have pending packets whose height is finalized,
+	// with the key of the last packet the end block auto finalization attempted, from which it resumes.
+	// A rollapp is added when one of its states is finalized, and removed by the end block auto
+	// finalization once it has no such packets left.
+	autoFinalizeQueue collections.Map[string, []byte]
+
+	// pendingPacketsCount is the number of pending packets of each address in pendingPacketsByAddress
+	pendingPacketsCount collections.Map[string, uint64]
+
+	// hubBlockTimes holds the average hub block time, in nanoseconds, over the dispute period of the
+	// last finalized state of each rollapp. It is used to estimate the finalization time of packets.
+	hubBlockTimes collections.Map[string, int64]
+
 	rollappKeeper types.RollappKeeper
 	porttypes.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
@@ -51,17 +64,39 @@ func NewKeeper(
 	channelKeeper types.ChannelKeeper,
 	eibcKeeper types.EIBCKeeper,
 ) *Keeper {
+	sb := collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey))
 	return &Keeper{
 		cdc:                   cdc,
 		storeKey:              storeKey,
 		channelKeeperStoreKey: channelKeeperStoreKey,
 		authority:             authority,
 		pendingPacketsByAddress: collections.NewKeySet(
-			collections.NewSchemaBuilder(collcompat.NewKVStoreService(storeKey)),
+			sb,
 			collections.NewPrefix(types.PendingPacketsByAddressKeyPrefix),
 			"pending_packets_by_receiver",
 			collections.PairKeyCodec(collections.StringKey, collcodec.NewBytesKey[[]byte]()),
 		),
+		autoFinalizeQueue: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.AutoFinalizeQueueKeyPrefix),
+			"auto_finalize_queue",
+			collections.StringKey,
+			collections.BytesValue,
+		),
+		pendingPacketsCount: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.PendingPacketsCountKeyPrefix),
+			"pending_packets_count",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		hubBlockTimes: collections.NewMap(
+			sb,
+			collections.NewPrefix(types.HubBlockTimeKeyPrefix),
+			"hub_block_times",
+			collections.StringKey,
+			collections.Int64Value,
+		),
 		rollappKeeper:   rollappKeeper,
 		ICS4Wrapper:     ics4Wrapper,
 		channelKeeper:   channelKeeper,
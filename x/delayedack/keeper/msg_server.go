@@ -10,6 +10,7 @@ import (
 	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/delayedack/types"
 )
 
@@ -115,3 +116,44 @@ func (m MsgServer) FinalizePacketByPacketKey(goCtx context.Context, msg *types.M
 
 	return &types.MsgFinalizePacketByPacketKeyResponse{}, nil
 }
+
+func (m MsgServer) FinalizePackets(goCtx context.Context, msg *types.MsgFinalizePackets) (*types.MsgFinalizePacketsResponse, error) {
+	err := msg.ValidateBasic() // TODO: remove, called by sdk
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	finalizations, err := m.k.FinalizeRollappPackets(ctx, m.ibc.NextIBCMiddleware(), msg.RollappId, msg.Address, int(msg.Limit)) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.MsgFinalizePacketsResponse{}
+	for _, f := range finalizations {
+		result := types.PacketFinalizationResult{
+			PacketKey: commontypes.EncodePacketKey(f.Packet.RollappPacketKey()),
+		}
+		if f.Err != nil {
+			result.Error = f.Err.Error()
+			res.Failed++
+		} else {
+			res.Finalized++
+			err = uevent.EmitTypedEvent(ctx, &types.EventFinalizePacket{
+				Sender:            msg.Sender,
+				RollappId:         f.Packet.RollappId,
+				PacketProofHeight: f.Packet.ProofHeight,
+				PacketType:        f.Packet.Type,
+				PacketSrcChannel:  f.Packet.Packet.SourceChannel,
+				PacketSequence:    f.Packet.Packet.Sequence,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("emit event: %w", err)
+			}
+		}
+		res.Results = append(res.Results, result)
+	}
+
+	return res, nil
+}
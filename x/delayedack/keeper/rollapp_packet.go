@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
@@ -36,7 +37,15 @@ func (k Keeper) SetRollappPacket(ctx sdk.Context, rollappPacket commontypes.Roll
 // SetPendingPacketByAddress stores a rollapp packet in the KVStore by its receiver.
 // Helper index to query all packets by receiver.
 func (k Keeper) SetPendingPacketByAddress(ctx sdk.Context, receiver string, rollappPacketKey []byte) error {
-	return k.pendingPacketsByAddress.Set(ctx, collections.Join(receiver, rollappPacketKey))
+	key := collections.Join(receiver, rollappPacketKey)
+	found, err := k.pendingPacketsByAddress.Has(ctx, key)
+	if err != nil || found {
+		return err
+	}
+	if err := k.pendingPacketsByAddress.Set(ctx, key); err != nil {
+		return err
+	}
+	return k.updatePendingPacketsCount(ctx, receiver, true)
 }
 
 // MustSetPendingPacketByAddress stores a rollapp packet in the KVStore by its receiver.
@@ -50,7 +59,63 @@ func (k Keeper) MustSetPendingPacketByAddress(ctx sdk.Context, receiver string,
 
 // DeletePendingPacketByAddress deletes a rollapp packet from the KVStore by its receiver.
 func (k Keeper) DeletePendingPacketByAddress(ctx sdk.Context, receiver string, rollappPacketKey []byte) error {
-	return k.pendingPacketsByAddress.Remove(ctx, collections.Join(receiver, rollappPacketKey))
+	key := collections.Join(receiver, rollappPacketKey)
+	found, err := k.pendingPacketsByAddress.Has(ctx, key)
+	if err != nil || !found {
+		return err
+	}
+	if err := k.pendingPacketsByAddress.Remove(ctx, key); err != nil {
+		return err
+	}
+	return k.updatePendingPacketsCount(ctx, receiver, false)
+}
+
+// GetPendingPacketsCount returns the number of pending packets of the address
+func (k Keeper) GetPendingPacketsCount(ctx sdk.Context, address string) (uint64, error) {
+	n, err := k.pendingPacketsCount.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	return n, err
+}
+
+func (k Keeper) updatePendingPacketsCount(ctx sdk.Context, address string, added bool) error {
+	n, err := k.GetPendingPacketsCount(ctx, address)
+	if err != nil {
+		return err
+	}
+	if added {
+		return k.pendingPacketsCount.Set(ctx, address, n+1)
+	}
+	if n <= 1 {
+		return k.pendingPacketsCount.Remove(ctx, address)
+	}
+	return k.pendingPacketsCount.Set(ctx, address, n-1)
+}
+
+// InitPendingPacketsCounts counts the pending packets of each address in the index
+func (k Keeper) InitPendingPacketsCounts(ctx sdk.Context) error {
+	if err := k.pendingPacketsCount.Clear(ctx, nil); err != nil {
+		return err
+	}
+	counts := make(map[string]uint64)
+	var addrs []string
+	err := k.pendingPacketsByAddress.Walk(ctx, nil, func(key collections.Pair[string, []byte]) (stop bool, err error) {
+		if _, ok := counts[key.K1()]; !ok {
+			addrs = append(addrs, key.K1())
+		}
+		counts[key.K1()]++
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, addr := range addrs {
+		if err := k.pendingPacketsCount.Set(ctx, addr, counts[addr]); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 // MustDeletePendingPacketByAddress deletes a rollapp packet from the KVStore by its receiver.
@@ -130,18 +195,18 @@ func (k Keeper) UpdateRollappPacketTransferAddress(
 
 	// Set the recipient and sender based on the rollapp packet type
 	var (
-		recipient         = transferPacketData.Receiver
-		sender            = transferPacketData.Sender
-		originalRecipient string
+		recipient        = transferPacketData.Receiver
+		sender           = transferPacketData.Sender
+		currentRecipient string
 	)
 	switch rollappPacket.Type {
 	case commontypes.RollappPacket_ON_RECV:
 		// recipient will get credited
-		originalRecipient = recipient
+		currentRecipient = recipient
 		recipient = newRecipient
 	case commontypes.RollappPacket_ON_ACK, commontypes.RollappPacket_ON_TIMEOUT:
 		// sender will get refunded
-		originalRecipient = sender
+		currentRecipient = sender
 		sender = newRecipient
 	}
 
@@ -157,10 +222,14 @@ func (k Keeper) UpdateRollappPacketTransferAddress(
 	packet := rollappPacket.Packet
 	packet.Data = newPacketData.GetBytes()
 	rollappPacket.Packet = packet
-	rollappPacket.OriginalTransferTarget = originalRecipient
+	// The target may already have been replaced by a fulfiller, in which case the claim
+	// on the funds is changing hands and the original target must be kept.
+	if rollappPacket.OriginalTransferTarget == "" {
+		rollappPacket.OriginalTransferTarget = currentRecipient
+	}
 
 	// Update index: delete the old packet and save the new one
-	k.MustDeletePendingPacketByAddress(ctx, originalRecipient, []byte(rollappPacketKey))
+	k.MustDeletePendingPacketByAddress(ctx, currentRecipient, []byte(rollappPacketKey))
 	k.MustSetPendingPacketByAddress(ctx, newRecipient, rollappPacket.RollappPacketKey())
 
 	k.SetRollappPacket(ctx, *rollappPacket)
@@ -26,7 +26,8 @@ var (
 	_ module.HasServices    = AppModule{}
 	_ module.HasInvariants  = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -144,3 +145,13 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
 func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+// EndBlock finalizes the pending packets whose height is finalized, if auto finalization is enabled
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	// next middleware is denommetadata, see transfer stack setup
+	if err := am.keeper.AutoFinalizePackets(ctx, am.ibc.NextIBCMiddleware()); err != nil {
+		am.keeper.Logger(ctx).Error("Auto finalize packets.", "err", err)
+	}
+	return nil
+}
@@ -10,4 +10,5 @@ var (
 	ErrRollappPacketAlreadyExists = errorsmod.Register(ModuleName, 3, "rollapp packet already exists")
 	ErrUnknownRequest             = errorsmod.Register(ModuleName, 8, "unknown request")
 	ErrBadEIBCFee                 = errorsmod.Register(ModuleName, 10, "provided eibc fee is invalid")
+	ErrBadAltFulfillment          = errorsmod.Register(ModuleName, 11, "provided eibc alt fulfillment is invalid")
 )
@@ -0,0 +1,9 @@
+package types
+
+// Before returns true if e is expected to finalize before other, ties being broken by packet key
+func (e PacketFinalizationETA) Before(other PacketFinalizationETA) bool {
+	if e.Height != other.Height {
+		return e.Height < other.Height
+	}
+	return e.PacketKey < other.PacketKey
+}
@@ -113,8 +113,166 @@ func (m *EventFinalizePacket) GetPacketSequence() uint64 {
 	return 0
 }
 
+// EventAutoFinalizePacket is emitted for every packet attempted by the end
+// block auto finalization.
+type EventAutoFinalizePacket struct {
+	// RollappID is the ID of the rollapp.
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// PacketProofHeight height at which the proof was retrieved.
+	PacketProofHeight uint64 `protobuf:"varint,2,opt,name=packet_proof_height,json=packetProofHeight,proto3" json:"packet_proof_height,omitempty"`
+	// PacketType is a type of the packet. Eg, RECV, ACK, TIMEOUT.
+	PacketType types.RollappPacket_Type `protobuf:"varint,3,opt,name=packet_type,json=packetType,proto3,enum=dymensionxyz.dymension.common.RollappPacket_Type" json:"packet_type,omitempty"`
+	// PacketSrcChannel identifies the channel end on the sending chain.
+	PacketSrcChannel string `protobuf:"bytes,4,opt,name=packet_src_channel,json=packetSrcChannel,proto3" json:"packet_src_channel,omitempty"`
+	// PacketSequence is a sequence number of the packet.
+	PacketSequence uint64 `protobuf:"varint,5,opt,name=packet_sequence,json=packetSequence,proto3" json:"packet_sequence,omitempty"`
+	// Error is set if the packet failed to finalize and is still pending.
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *EventAutoFinalizePacket) Reset()         { *m = EventAutoFinalizePacket{} }
+func (m *EventAutoFinalizePacket) String() string { return proto.CompactTextString(m) }
+func (*EventAutoFinalizePacket) ProtoMessage()    {}
+func (*EventAutoFinalizePacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de2c6b6165d75670, []int{1}
+}
+func (m *EventAutoFinalizePacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutoFinalizePacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutoFinalizePacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAutoFinalizePacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoFinalizePacket.Merge(m, src)
+}
+func (m *EventAutoFinalizePacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutoFinalizePacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoFinalizePacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutoFinalizePacket proto.InternalMessageInfo
+
+func (m *EventAutoFinalizePacket) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventAutoFinalizePacket) GetPacketProofHeight() uint64 {
+	if m != nil {
+		return m.PacketProofHeight
+	}
+	return 0
+}
+
+func (m *EventAutoFinalizePacket) GetPacketType() types.RollappPacket_Type {
+	if m != nil {
+		return m.PacketType
+	}
+	return types.RollappPacket_ON_RECV
+}
+
+func (m *EventAutoFinalizePacket) GetPacketSrcChannel() string {
+	if m != nil {
+		return m.PacketSrcChannel
+	}
+	return ""
+}
+
+func (m *EventAutoFinalizePacket) GetPacketSequence() uint64 {
+	if m != nil {
+		return m.PacketSequence
+	}
+	return 0
+}
+
+func (m *EventAutoFinalizePacket) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+// EventAutoFinalizePackets summarizes the end block auto finalization.
+type EventAutoFinalizePackets struct {
+	// Finalized is the number of packets which got finalized.
+	Finalized uint64 `protobuf:"varint,1,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// Failed is the number of packets which failed to finalize.
+	Failed uint64 `protobuf:"varint,2,opt,name=failed,proto3" json:"failed,omitempty"`
+	// GasUsed is the gas consumed out of the block budget.
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *EventAutoFinalizePackets) Reset()         { *m = EventAutoFinalizePackets{} }
+func (m *EventAutoFinalizePackets) String() string { return proto.CompactTextString(m) }
+func (*EventAutoFinalizePackets) ProtoMessage()    {}
+func (*EventAutoFinalizePackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_de2c6b6165d75670, []int{2}
+}
+func (m *EventAutoFinalizePackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutoFinalizePackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutoFinalizePackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAutoFinalizePackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoFinalizePackets.Merge(m, src)
+}
+func (m *EventAutoFinalizePackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutoFinalizePackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoFinalizePackets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutoFinalizePackets proto.InternalMessageInfo
+
+func (m *EventAutoFinalizePackets) GetFinalized() uint64 {
+	if m != nil {
+		return m.Finalized
+	}
+	return 0
+}
+
+func (m *EventAutoFinalizePackets) GetFailed() uint64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+func (m *EventAutoFinalizePackets) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventFinalizePacket)(nil), "dymensionxyz.dymension.delayedack.EventFinalizePacket")
+	proto.RegisterType((*EventAutoFinalizePacket)(nil), "dymensionxyz.dymension.delayedack.EventAutoFinalizePacket")
+	proto.RegisterType((*EventAutoFinalizePackets)(nil), "dymensionxyz.dymension.delayedack.EventAutoFinalizePackets")
 }
 
 func init() {
@@ -122,28 +280,34 @@ func init() {
 }
 
 var fileDescriptor_de2c6b6165d75670 = []byte{
-	// 333 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x41, 0x4f, 0xc2, 0x30,
-	0x14, 0xc7, 0x29, 0x22, 0x09, 0x35, 0x41, 0x2d, 0x89, 0x59, 0x4c, 0x5c, 0xd0, 0x8b, 0x1c, 0x4c,
-	0x17, 0xe1, 0xe0, 0x5d, 0xa3, 0xd1, 0x1b, 0x4e, 0x4f, 0x5e, 0x96, 0xd1, 0x3d, 0xd9, 0xc2, 0x68,
-	0xeb, 0x56, 0x08, 0xe3, 0x53, 0xf8, 0x2d, 0xfc, 0x2a, 0x1e, 0x39, 0x7a, 0x34, 0xf0, 0x45, 0xcc,
-	0xda, 0x22, 0x5c, 0xb8, 0xf5, 0xbd, 0xff, 0xbf, 0xbf, 0xf7, 0x7f, 0x79, 0x98, 0x46, 0xc5, 0x18,
-	0x78, 0x9e, 0x08, 0x3e, 0x2b, 0xe6, 0xde, 0x7f, 0xe1, 0x45, 0x90, 0x86, 0x05, 0x44, 0x21, 0x1b,
-	0x79, 0x30, 0x05, 0xae, 0x72, 0x2a, 0x33, 0xa1, 0x04, 0x39, 0xdf, 0xf6, 0x6f, 0x3e, 0xd3, 0x8d,
-	0xff, 0xb4, 0xbb, 0x03, 0xc9, 0xc4, 0x78, 0x2c, 0xb8, 0x97, 0x89, 0x34, 0x0d, 0xa5, 0x0c, 0x64,
-	0xc8, 0x46, 0xa0, 0x0c, 0xf6, 0xe2, 0xab, 0x8a, 0x5b, 0xf7, 0xe5, 0x9c, 0x87, 0x84, 0x87, 0x69,
-	0x32, 0x87, 0xbe, 0x56, 0xc9, 0x09, 0xae, 0xe7, 0xc0, 0x23, 0xc8, 0x1c, 0xd4, 0x46, 0x9d, 0x86,
-	0x6f, 0x2b, 0x72, 0x86, 0xf1, 0x9a, 0x93, 0x44, 0x4e, 0x55, 0x6b, 0x0d, 0xdb, 0x79, 0x8a, 0x08,
-	0xc5, 0x2d, 0x83, 0x0f, 0x64, 0x26, 0xc4, 0x7b, 0x10, 0x43, 0x32, 0x8c, 0x95, 0xb3, 0xd7, 0x46,
-	0x9d, 0x9a, 0x7f, 0x6c, 0xa4, 0x7e, 0xa9, 0x3c, 0x6a, 0x81, 0xf8, 0xf8, 0xc0, 0xfa, 0x55, 0x21,
-	0xc1, 0xa9, 0xb5, 0x51, 0xa7, 0xd9, 0xbd, 0xa6, 0x3b, 0x76, 0x35, 0x8b, 0x50, 0xdf, 0x8c, 0x33,
-	0x49, 0xe9, 0x6b, 0x21, 0xc1, 0xc7, 0x86, 0x52, 0xbe, 0xc9, 0x15, 0x26, 0x96, 0x99, 0x67, 0x2c,
-	0x60, 0x71, 0xc8, 0x39, 0xa4, 0xce, 0xbe, 0x8e, 0x7a, 0x64, 0x94, 0x97, 0x8c, 0xdd, 0x99, 0x3e,
-	0xb9, 0xc4, 0x87, 0x6b, 0x37, 0x7c, 0x4c, 0x80, 0x33, 0x70, 0xea, 0x3a, 0x6d, 0xd3, 0x5a, 0x6d,
-	0xf7, 0xf6, 0xf9, 0x7b, 0xe9, 0xa2, 0xc5, 0xd2, 0x45, 0xbf, 0x4b, 0x17, 0x7d, 0xae, 0xdc, 0xca,
-	0x62, 0xe5, 0x56, 0x7e, 0x56, 0x6e, 0xe5, 0xed, 0x66, 0x98, 0xa8, 0x78, 0x32, 0x28, 0xe3, 0x79,
-	0x3b, 0x4e, 0x30, 0xed, 0x79, 0xb3, 0xed, 0xd3, 0x96, 0xdb, 0xe6, 0x83, 0xba, 0xbe, 0x41, 0xef,
-	0x2f, 0x00, 0x00, 0xff, 0xff, 0x4b, 0xa4, 0x49, 0x5e, 0x0c, 0x02, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0x86, 0xeb, 0x6c, 0x5a, 0x88, 0x91, 0x16, 0xf0, 0x22, 0x08, 0x08, 0xa2, 0xd2, 0x0b, 0x3d,
+	0xa0, 0x44, 0xec, 0x1e, 0x38, 0x03, 0x02, 0xc1, 0x6d, 0x09, 0x70, 0xe1, 0x12, 0x79, 0xe3, 0x69,
+	0x12, 0x35, 0xb5, 0x83, 0x9d, 0xac, 0x36, 0xfb, 0x14, 0xbc, 0x05, 0xe2, 0x4d, 0x38, 0xf6, 0xc8,
+	0x11, 0xb5, 0x2f, 0x82, 0x62, 0xbb, 0xb4, 0x20, 0x22, 0xf5, 0xb2, 0xb7, 0xcc, 0xfc, 0x7f, 0x7e,
+	0x8f, 0x3f, 0x79, 0x70, 0xc8, 0xda, 0x05, 0x70, 0x55, 0x08, 0x7e, 0xd1, 0x5e, 0x46, 0x7f, 0x8a,
+	0x88, 0x41, 0x49, 0x5b, 0x60, 0x34, 0x9d, 0x47, 0x70, 0x0e, 0xbc, 0x56, 0x61, 0x25, 0x45, 0x2d,
+	0xc8, 0xe3, 0x5d, 0xff, 0xf6, 0xe7, 0x70, 0xeb, 0x7f, 0x70, 0xdc, 0x13, 0x99, 0x8a, 0xc5, 0x42,
+	0xf0, 0x48, 0x8a, 0xb2, 0xa4, 0x55, 0x95, 0x54, 0x34, 0x9d, 0x43, 0x6d, 0x62, 0x27, 0xdf, 0x1c,
+	0x7c, 0xf4, 0xba, 0x3b, 0xe7, 0x4d, 0xc1, 0x69, 0x59, 0x5c, 0xc2, 0xa9, 0x56, 0xc9, 0x5d, 0x3c,
+	0x52, 0xc0, 0x19, 0x48, 0x1f, 0x8d, 0xd1, 0xd4, 0x8b, 0x6d, 0x45, 0x1e, 0x61, 0xbc, 0xc9, 0x29,
+	0x98, 0xef, 0x68, 0xcd, 0xb3, 0x9d, 0x77, 0x8c, 0x84, 0xf8, 0xc8, 0xc4, 0x27, 0x95, 0x14, 0x62,
+	0x96, 0xe4, 0x50, 0x64, 0x79, 0xed, 0x1f, 0x8c, 0xd1, 0xd4, 0x8d, 0x6f, 0x1b, 0xe9, 0xb4, 0x53,
+	0xde, 0x6a, 0x81, 0xc4, 0xf8, 0x86, 0xf5, 0xd7, 0x6d, 0x05, 0xbe, 0x3b, 0x46, 0xd3, 0xc3, 0xe3,
+	0x67, 0x61, 0xcf, 0x5d, 0xcd, 0x45, 0xc2, 0xd8, 0x1c, 0x67, 0x26, 0x0d, 0x3f, 0xb6, 0x15, 0xc4,
+	0xd8, 0xa4, 0x74, 0xdf, 0xe4, 0x29, 0x26, 0x36, 0x53, 0xc9, 0x34, 0x49, 0x73, 0xca, 0x39, 0x94,
+	0xfe, 0x50, 0x8f, 0x7a, 0xcb, 0x28, 0x1f, 0x64, 0xfa, 0xca, 0xf4, 0xc9, 0x13, 0x7c, 0x73, 0xe3,
+	0x86, 0x2f, 0x0d, 0xf0, 0x14, 0xfc, 0x91, 0x9e, 0xf6, 0xd0, 0x5a, 0x6d, 0x77, 0xf2, 0xdd, 0xc1,
+	0xf7, 0x34, 0xa9, 0x17, 0x4d, 0x2d, 0xfe, 0xa1, 0xf5, 0x37, 0x15, 0xb4, 0x27, 0x15, 0x67, 0x4f,
+	0x2a, 0x07, 0x57, 0x47, 0xc5, 0xdd, 0x9f, 0xca, 0xf0, 0x7f, 0x54, 0xc8, 0x1d, 0x3c, 0x04, 0x29,
+	0x85, 0xd4, 0xd0, 0xbc, 0xd8, 0x14, 0x93, 0x39, 0xf6, 0x7b, 0x50, 0x29, 0xf2, 0x10, 0x7b, 0x33,
+	0xdb, 0x32, 0xa8, 0xdc, 0x78, 0xdb, 0xe8, 0xde, 0xdd, 0x8c, 0x16, 0x25, 0x30, 0x4b, 0xc7, 0x56,
+	0xe4, 0x3e, 0xbe, 0x9e, 0x51, 0x95, 0x34, 0x0a, 0x98, 0x7d, 0x4d, 0xd7, 0x32, 0xaa, 0x3e, 0x29,
+	0x60, 0x2f, 0xdf, 0xff, 0x58, 0x05, 0x68, 0xb9, 0x0a, 0xd0, 0xaf, 0x55, 0x80, 0xbe, 0xae, 0x83,
+	0xc1, 0x72, 0x1d, 0x0c, 0x7e, 0xae, 0x83, 0xc1, 0xe7, 0xe7, 0x59, 0x51, 0xe7, 0xcd, 0x59, 0x47,
+	0x28, 0xea, 0xd9, 0x8d, 0xf3, 0x93, 0xe8, 0x62, 0x77, 0xe7, 0x3a, 0xe0, 0xea, 0x6c, 0xa4, 0x97,
+	0xe3, 0xe4, 0xf7, 0x00, 0xeb, 0x56, 0xe3, 0x74, 0xa5, 0x03, 0x00, 0x00,
 }
 
 func (m *EventFinalizePacket) Marshal() (dAtA []byte, err error) {
@@ -205,6 +369,103 @@ func (m *EventFinalizePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventAutoFinalizePacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutoFinalizePacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutoFinalizePacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.PacketSequence != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PacketSequence))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PacketSrcChannel) > 0 {
+		i -= len(m.PacketSrcChannel)
+		copy(dAtA[i:], m.PacketSrcChannel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PacketSrcChannel)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PacketType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PacketType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PacketProofHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.PacketProofHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAutoFinalizePackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutoFinalizePackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutoFinalizePackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Failed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Finalized != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Finalized))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -246,6 +507,54 @@ func (m *EventFinalizePacket) Size() (n int) {
 	return n
 }
 
+func (m *EventAutoFinalizePacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PacketProofHeight != 0 {
+		n += 1 + sovEvents(uint64(m.PacketProofHeight))
+	}
+	if m.PacketType != 0 {
+		n += 1 + sovEvents(uint64(m.PacketType))
+	}
+	l = len(m.PacketSrcChannel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.PacketSequence != 0 {
+		n += 1 + sovEvents(uint64(m.PacketSequence))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventAutoFinalizePackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Finalized != 0 {
+		n += 1 + sovEvents(uint64(m.Finalized))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovEvents(uint64(m.Failed))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.GasUsed))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -455,6 +764,316 @@ func (m *EventFinalizePacket) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventAutoFinalizePacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAutoFinalizePacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAutoFinalizePacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketProofHeight", wireType)
+			}
+			m.PacketProofHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketProofHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			m.PacketType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketType |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSrcChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketSrcChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketSequence", wireType)
+			}
+			m.PacketSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PacketSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAutoFinalizePackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAutoFinalizePackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAutoFinalizePackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			m.Finalized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finalized |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
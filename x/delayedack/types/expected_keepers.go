@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
@@ -18,6 +19,10 @@ type ChannelKeeper interface {
 
 type RollappKeeper interface {
 	MustGetStateInfo(ctx sdk.Context, rollappId string, index uint64) types.StateInfo
+	GetStateInfo(ctx sdk.Context, rollappId string, index uint64) (val types.StateInfo, found bool)
+	FindStateInfoByHeight(ctx sdk.Context, rollappId string, height uint64) (*types.StateInfo, error)
+	RollappDisputePeriodInBlocks(ctx sdk.Context, rollappID string) uint64
+	StateFinalizationHeight(ctx sdk.Context, stateInfo *types.StateInfo) uint64
 	GetLatestFinalizedStateIndex(ctx sdk.Context, rollappId string) (val types.StateInfoIndex, found bool)
 	GetAllRollapps(ctx sdk.Context) (list []types.Rollapp)
 	GetValidTransfer(
@@ -30,4 +35,5 @@ type RollappKeeper interface {
 type EIBCKeeper interface {
 	EIBCDemandOrderHandler(ctx sdk.Context, rollappPacket commontypes.RollappPacket, data transfertypes.FungibleTokenPacketData) error
 	PendingOrderByPacket(ctx sdk.Context, p *commontypes.RollappPacket) (*eibctypes.DemandOrder, error)
+	SettleFulfillmentShares(ctx sdk.Context, o *eibctypes.DemandOrder, received math.Int, delivered bool) error
 }
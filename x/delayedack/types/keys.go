@@ -17,4 +17,7 @@ const (
 var (
 	ParamsKey                        = []byte{0x02}
 	PendingPacketsByAddressKeyPrefix = []byte{0x01}
+	AutoFinalizeQueueKeyPrefix       = []byte{0x03}
+	PendingPacketsCountKeyPrefix     = []byte{0x04}
+	HubBlockTimeKeyPrefix            = []byte{0x05}
 )
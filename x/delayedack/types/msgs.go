@@ -15,6 +15,7 @@ import (
 var (
 	_ sdk.Msg = &MsgFinalizePacket{}
 	_ sdk.Msg = &MsgFinalizePacketByPacketKey{}
+	_ sdk.Msg = &MsgFinalizePackets{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -72,6 +73,34 @@ func (m MsgFinalizePacketByPacketKey) MustDecodePacketKey() []byte {
 	return packetKey
 }
 
+// MaxFinalizePacketsLimit is the max number of packets finalized by a single MsgFinalizePackets
+const MaxFinalizePacketsLimit = 1000
+
+func (m MsgFinalizePackets) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Join(
+			sdkerrors.ErrInvalidAddress,
+			errorsmod.Wrapf(err, "sender must be a valid bech32 address: %s", m.Sender),
+		)
+	}
+	if len(m.RollappId) == 0 {
+		return gerrc.ErrInvalidArgument.Wrap("rollappId must be non-empty")
+	}
+	if m.Address != "" {
+		if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+			return errors.Join(
+				sdkerrors.ErrInvalidAddress,
+				errorsmod.Wrapf(err, "address must be a valid bech32 address: %s", m.Address),
+			)
+		}
+	}
+	if m.Limit == 0 || MaxFinalizePacketsLimit < m.Limit {
+		return gerrc.ErrInvalidArgument.Wrapf("limit must be in [1, %d]", MaxFinalizePacketsLimit)
+	}
+	return nil
+}
+
 func (m MsgUpdateParams) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Authority)
 	if err != nil {
@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
@@ -21,6 +23,16 @@ type EIBCMemo struct {
 	Fee string `json:"fee"`
 	// can be nil
 	OnCompletionHook []byte `json:"dym_on_completion,omitempty"`
+	// can be nil
+	AltFulfillment *AltFulfillmentMemo `json:"alt_fulfillment,omitempty"`
+}
+
+// AltFulfillmentMemo lets the order be fulfilled in denom, priced through the gamm pool
+type AltFulfillmentMemo struct {
+	Denom  string `json:"denom"`
+	PoolID uint64 `json:"pool_id"`
+	// max tolerated slippage vs the pool spot price, a decimal in [0, 1)
+	MaxSlippage string `json:"max_slippage"`
 }
 
 func DefaultEIBCMemo() EIBCMemo {
@@ -71,9 +83,35 @@ func (e EIBCMemo) ValidateBasic() error {
 	if _, err := e.GetCompletionHook(); err != nil {
 		return fmt.Errorf("get on completion hook: %w", err)
 	}
+	if e.AltFulfillment != nil {
+		if err := e.AltFulfillment.ValidateBasic(); err != nil {
+			return fmt.Errorf("alt fulfillment: %w", err)
+		}
+	}
 	return nil
 }
 
+func (a AltFulfillmentMemo) ValidateBasic() error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return errorsmod.Wrap(ErrBadAltFulfillment, err.Error())
+	}
+	if a.PoolID == 0 {
+		return errorsmod.Wrap(ErrBadAltFulfillment, "pool id")
+	}
+	if _, err := a.MaxSlippageDec(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a AltFulfillmentMemo) MaxSlippageDec() (math.LegacyDec, error) {
+	d, err := math.LegacyNewDecFromStr(a.MaxSlippage)
+	if err != nil || d.IsNegative() || d.GTE(math.LegacyOneDec()) {
+		return math.LegacyDec{}, errorsmod.Wrap(ErrBadAltFulfillment, "max slippage must be in [0, 1)")
+	}
+	return d, nil
+}
+
 func (e EIBCMemo) FeeInt() (math.Int, error) {
 	i, ok := math.NewIntFromString(e.Fee)
 	if !ok || i.IsNegative() {
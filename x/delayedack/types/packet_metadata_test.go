@@ -40,6 +40,23 @@ func Test_parsePacketMetadata(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"valid with alt fulfillment",
+			args{
+				`{"eibc":{"fee":"100","alt_fulfillment":{"denom":"adym","pool_id":1,"max_slippage":"0.05"}}}`,
+			},
+			&Memo{
+				EIBC: &EIBCMemo{
+					Fee: "100",
+					AltFulfillment: &AltFulfillmentMemo{
+						Denom:       "adym",
+						PoolID:      1,
+						MaxSlippage: "0.05",
+					},
+				},
+			},
+			false,
+		},
 		{
 			"invalid - misquoted fee",
 			args{
@@ -8,16 +8,27 @@ import (
 )
 
 const (
-	defaultEpochIdentifier         = "hour"
-	defaultDeletePacketsEpochLimit = 1000_000
+	defaultEpochIdentifier          = "hour"
+	defaultDeletePacketsEpochLimit  = 1000_000
+	defaultAutoFinalizePacketsLimit = 0 // disabled
+	defaultAutoFinalizeGasBudget    = 20_000_000
+	maxAutoFinalizePacketsLimit     = MaxFinalizePacketsLimit
 )
 
 // NewParams creates a new Params instance
-func NewParams(epochIdentifier string, bridgingFee math.LegacyDec, deletePacketsEpochLimit int32) Params {
+func NewParams(
+	epochIdentifier string,
+	bridgingFee math.LegacyDec,
+	deletePacketsEpochLimit int32,
+	autoFinalizePacketsLimit uint32,
+	autoFinalizeGasBudget uint64,
+) Params {
 	return Params{
-		EpochIdentifier:         epochIdentifier,
-		BridgingFee:             bridgingFee,
-		DeletePacketsEpochLimit: deletePacketsEpochLimit,
+		EpochIdentifier:          epochIdentifier,
+		BridgingFee:              bridgingFee,
+		DeletePacketsEpochLimit:  deletePacketsEpochLimit,
+		AutoFinalizePacketsLimit: autoFinalizePacketsLimit,
+		AutoFinalizeGasBudget:    autoFinalizeGasBudget,
 	}
 }
 
@@ -27,6 +38,8 @@ func DefaultParams() Params {
 		defaultEpochIdentifier,
 		math.LegacyNewDecWithPrec(1, 3), // 0.1%
 		defaultDeletePacketsEpochLimit,
+		defaultAutoFinalizePacketsLimit,
+		defaultAutoFinalizeGasBudget,
 	)
 }
 
@@ -53,6 +66,14 @@ func (p Params) ValidateBasic() error {
 	if p.DeletePacketsEpochLimit < 0 {
 		return fmt.Errorf("delete packet epoch limit must not be negative: %d", p.DeletePacketsEpochLimit)
 	}
+
+	// validate auto finalization
+	if maxAutoFinalizePacketsLimit < p.AutoFinalizePacketsLimit {
+		return fmt.Errorf("auto finalize packets limit must not exceed %d: %d", maxAutoFinalizePacketsLimit, p.AutoFinalizePacketsLimit)
+	}
+	if p.AutoFinalizePacketsLimit != 0 && p.AutoFinalizeGasBudget == 0 {
+		return fmt.Errorf("auto finalize gas budget must be positive when auto finalization is enabled")
+	}
 	return nil
 }
 
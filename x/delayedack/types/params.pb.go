@@ -38,6 +38,15 @@ type Params struct {
 	// piling up packets that weren't deleted but rather "postponed", to
 	// subsequent epochs.
 	DeletePacketsEpochLimit int32 `protobuf:"varint,3,opt,name=delete_packets_epoch_limit,json=deletePacketsEpochLimit,proto3" json:"delete_packets_epoch_limit,omitempty" yaml:"delete_packets_epoch_limit"`
+	// `auto_finalize_packets_limit` is the max number of pending packets whose
+	// height is finalized that are finalized at the end of every block, in order
+	// of proof height, so that users do not have to send finalize messages. Zero
+	// disables auto finalization.
+	AutoFinalizePacketsLimit uint32 `protobuf:"varint,4,opt,name=auto_finalize_packets_limit,json=autoFinalizePacketsLimit,proto3" json:"auto_finalize_packets_limit,omitempty" yaml:"auto_finalize_packets_limit"`
+	// `auto_finalize_gas_budget` is the max gas auto finalization may consume
+	// in a block. The pass stops at the first packet which does not fit in the
+	// remaining budget.
+	AutoFinalizeGasBudget uint64 `protobuf:"varint,5,opt,name=auto_finalize_gas_budget,json=autoFinalizeGasBudget,proto3" json:"auto_finalize_gas_budget,omitempty" yaml:"auto_finalize_gas_budget"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -86,6 +95,20 @@ func (m *Params) GetDeletePacketsEpochLimit() int32 {
 	return 0
 }
 
+func (m *Params) GetAutoFinalizePacketsLimit() uint32 {
+	if m != nil {
+		return m.AutoFinalizePacketsLimit
+	}
+	return 0
+}
+
+func (m *Params) GetAutoFinalizeGasBudget() uint64 {
+	if m != nil {
+		return m.AutoFinalizeGasBudget
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.delayedack.Params")
 }
@@ -95,30 +118,34 @@ func init() {
 }
 
 var fileDescriptor_9516cc08de197609 = []byte{
-	// 358 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4b, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x52, 0x73, 0x12,
-	0x2b, 0x53, 0x53, 0x12, 0x93, 0xb3, 0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0x14, 0x91, 0xd5, 0x23, 0x34, 0xeb, 0x21, 0xd4, 0x4b, 0x89, 0xa4, 0xe7,
-	0xa7, 0xe7, 0x83, 0x55, 0xeb, 0x83, 0x58, 0x10, 0x8d, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9,
-	0xc5, 0xf1, 0x10, 0x09, 0x08, 0x07, 0x22, 0xa5, 0xb4, 0x96, 0x89, 0x8b, 0x2d, 0x00, 0x6c, 0x89,
-	0x90, 0x1b, 0x97, 0x40, 0x6a, 0x41, 0x7e, 0x72, 0x46, 0x7c, 0x66, 0x4a, 0x6a, 0x5e, 0x49, 0x66,
-	0x5a, 0x66, 0x6a, 0x91, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xf4, 0xa7, 0x7b, 0xf2, 0xe2,
-	0x95, 0x89, 0xb9, 0x39, 0x56, 0x4a, 0xe8, 0x2a, 0x94, 0x82, 0xf8, 0xc1, 0x42, 0x9e, 0x70, 0x11,
-	0xa1, 0x42, 0x2e, 0x9e, 0xa4, 0xa2, 0xcc, 0x94, 0xf4, 0xcc, 0xbc, 0xf4, 0xf8, 0xb4, 0xd4, 0x54,
-	0x09, 0x26, 0xb0, 0x19, 0x7e, 0x27, 0xee, 0xc9, 0x33, 0xdc, 0xba, 0x27, 0x2f, 0x0d, 0xb1, 0xbe,
-	0x38, 0x25, 0x5b, 0x2f, 0x33, 0x5f, 0x3f, 0x37, 0xb1, 0x24, 0x43, 0xcf, 0x27, 0x35, 0x3d, 0x31,
-	0xb9, 0xd2, 0x25, 0x35, 0xf9, 0xd3, 0x3d, 0x79, 0x61, 0x88, 0x35, 0xc8, 0x06, 0x28, 0x5d, 0xda,
-	0xa2, 0x2b, 0x00, 0x75, 0x34, 0x5c, 0x69, 0x10, 0x37, 0x4c, 0x89, 0x5b, 0x6a, 0xaa, 0x50, 0x12,
-	0x97, 0x54, 0x4a, 0x6a, 0x4e, 0x6a, 0x49, 0x6a, 0x7c, 0x41, 0x62, 0x72, 0x76, 0x6a, 0x49, 0x71,
-	0x3c, 0xc4, 0x9d, 0x39, 0x99, 0xb9, 0x99, 0x25, 0x12, 0xcc, 0x0a, 0x8c, 0x1a, 0xac, 0x4e, 0xaa,
-	0x9f, 0xee, 0xc9, 0x2b, 0x42, 0x4c, 0xc7, 0xad, 0x56, 0x29, 0x48, 0x1c, 0x22, 0x19, 0x00, 0x91,
-	0x73, 0x05, 0x49, 0xf9, 0x80, 0x64, 0xac, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0x0a, 0x3c, 0xf1,
-	0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8,
-	0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0xf3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24,
-	0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0x1c, 0x11, 0x5b, 0x66, 0xac, 0x5f, 0x81, 0x1c, 0xbb, 0x25, 0x95,
-	0x05, 0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x98, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf1, 0xea,
-	0x4b, 0x2e, 0x0f, 0x02, 0x00, 0x00,
+	// 432 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0xc7, 0x33, 0x6e, 0x77, 0xc1, 0xa8, 0xb8, 0x44, 0x65, 0x63, 0x0b, 0x99, 0xee, 0x88, 0xd2,
+	0x8b, 0xc9, 0x61, 0x0f, 0xc2, 0x1e, 0x83, 0x56, 0x84, 0x45, 0xd6, 0x1c, 0x45, 0x08, 0x93, 0xcc,
+	0xeb, 0x74, 0x68, 0x92, 0x89, 0x9d, 0xa9, 0x6c, 0xf6, 0x53, 0x78, 0xf4, 0xe8, 0x87, 0xf0, 0x43,
+	0xec, 0x71, 0xf1, 0x24, 0x1e, 0x82, 0xb4, 0xdf, 0x20, 0x9f, 0x40, 0x9a, 0xa9, 0x6d, 0x15, 0xbb,
+	0xb7, 0x99, 0xf7, 0xff, 0xbd, 0xf7, 0x7b, 0x87, 0x67, 0xfb, 0xac, 0xca, 0xa1, 0x50, 0x42, 0x16,
+	0x17, 0xd5, 0x65, 0xb0, 0xfe, 0x04, 0x0c, 0x32, 0x5a, 0x01, 0xa3, 0xe9, 0x24, 0x28, 0xe9, 0x94,
+	0xe6, 0xca, 0x2f, 0xa7, 0x52, 0x4b, 0xe7, 0x78, 0x9b, 0xdf, 0x34, 0xfb, 0x1b, 0xbe, 0xfb, 0x90,
+	0x4b, 0x2e, 0x5b, 0x3a, 0x58, 0xbe, 0x4c, 0x63, 0xf7, 0x71, 0x2a, 0x55, 0x2e, 0x55, 0x6c, 0x02,
+	0xf3, 0x31, 0x11, 0x69, 0xf6, 0xec, 0x83, 0xf3, 0x56, 0xe2, 0x0c, 0xed, 0x43, 0x28, 0x65, 0x3a,
+	0x8e, 0x05, 0x83, 0x42, 0x8b, 0x91, 0x80, 0xa9, 0x8b, 0xfa, 0x68, 0x70, 0x3b, 0xec, 0x35, 0x35,
+	0x3e, 0xaa, 0x68, 0x9e, 0x9d, 0x92, 0x7f, 0x09, 0x12, 0xdd, 0x6f, 0x4b, 0x6f, 0xd6, 0x15, 0xe7,
+	0xa3, 0x7d, 0x37, 0x99, 0x0a, 0xc6, 0x45, 0xc1, 0xe3, 0x11, 0x80, 0x7b, 0xab, 0x9d, 0xf1, 0xf6,
+	0xaa, 0xc6, 0xd6, 0xcf, 0x1a, 0xf7, 0x8c, 0x5e, 0xb1, 0x89, 0x2f, 0x64, 0x90, 0x53, 0x3d, 0xf6,
+	0xcf, 0x80, 0xd3, 0xb4, 0x7a, 0x09, 0x69, 0x53, 0xe3, 0x07, 0x46, 0xb3, 0x3d, 0x80, 0x7c, 0xff,
+	0xf6, 0xfc, 0x70, 0xb5, 0xf4, 0x1a, 0x8d, 0xee, 0xfc, 0x41, 0x86, 0x00, 0x4e, 0x62, 0x77, 0x19,
+	0x64, 0xa0, 0x21, 0x2e, 0x69, 0x3a, 0x01, 0xad, 0x62, 0xb3, 0x67, 0x26, 0x72, 0xa1, 0xdd, 0xbd,
+	0x3e, 0x1a, 0xec, 0x87, 0x4f, 0x9b, 0x1a, 0x1f, 0x9b, 0xe9, 0xbb, 0x59, 0x12, 0x1d, 0x99, 0xf0,
+	0xdc, 0x64, 0xaf, 0x96, 0xd1, 0xd9, 0x32, 0x71, 0xc0, 0xee, 0xd1, 0x99, 0x96, 0xf1, 0x48, 0x14,
+	0x34, 0x13, 0x97, 0x9b, 0x76, 0x23, 0xe9, 0xf4, 0xd1, 0xe0, 0x5e, 0xf8, 0xac, 0xa9, 0x31, 0x31,
+	0x92, 0x1b, 0x60, 0x12, 0xb9, 0xcb, 0x74, 0xb8, 0x0a, 0x57, 0x2e, 0xa3, 0xf9, 0x60, 0xbb, 0x7f,
+	0x77, 0x72, 0xaa, 0xe2, 0x64, 0xc6, 0x38, 0x68, 0x77, 0xbf, 0x8f, 0x06, 0x9d, 0xf0, 0x49, 0x53,
+	0x63, 0xfc, 0x3f, 0xc7, 0x86, 0x24, 0xd1, 0xa3, 0x6d, 0xc1, 0x6b, 0xaa, 0xc2, 0xb6, 0x7e, 0xda,
+	0xf9, 0xf2, 0x15, 0x5b, 0xe1, 0xbb, 0xab, 0xb9, 0x87, 0xae, 0xe7, 0x1e, 0xfa, 0x35, 0xf7, 0xd0,
+	0xe7, 0x85, 0x67, 0x5d, 0x2f, 0x3c, 0xeb, 0xc7, 0xc2, 0xb3, 0xde, 0xbf, 0xe0, 0x42, 0x8f, 0x67,
+	0x89, 0x9f, 0xca, 0x3c, 0xd8, 0x71, 0x9d, 0x9f, 0x4e, 0x82, 0x8b, 0xed, 0x13, 0xd5, 0x55, 0x09,
+	0x2a, 0x39, 0x68, 0xcf, 0xe9, 0xe4, 0xf7, 0x00, 0xe8, 0xdd, 0x07, 0xe1, 0xd4, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -141,6 +168,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoFinalizeGasBudget != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoFinalizeGasBudget))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AutoFinalizePacketsLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoFinalizePacketsLimit))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.DeletePacketsEpochLimit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DeletePacketsEpochLimit))
 		i--
@@ -192,6 +229,12 @@ func (m *Params) Size() (n int) {
 	if m.DeletePacketsEpochLimit != 0 {
 		n += 1 + sovParams(uint64(m.DeletePacketsEpochLimit))
 	}
+	if m.AutoFinalizePacketsLimit != 0 {
+		n += 1 + sovParams(uint64(m.AutoFinalizePacketsLimit))
+	}
+	if m.AutoFinalizeGasBudget != 0 {
+		n += 1 + sovParams(uint64(m.AutoFinalizeGasBudget))
+	}
 	return n
 }
 
@@ -315,6 +358,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoFinalizePacketsLimit", wireType)
+			}
+			m.AutoFinalizePacketsLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoFinalizePacketsLimit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoFinalizeGasBudget", wireType)
+			}
+			m.AutoFinalizeGasBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoFinalizeGasBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -10,20 +10,24 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	types "github.com/dymensionxyz/dymension/v3/x/common/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -185,6 +189,8 @@ func (m *QueryRollappPacketsRequest) GetPagination() *query.PageRequest {
 type QueryRollappPacketListResponse struct {
 	RollappPackets []types.RollappPacket `protobuf:"bytes,1,rep,name=rollappPackets,proto3" json:"rollappPackets"`
 	Pagination     *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// estimated finalization of the pending packets among rollappPackets
+	FinalizationEtas []PacketFinalizationETA `protobuf:"bytes,3,rep,name=finalization_etas,json=finalizationEtas,proto3" json:"finalization_etas"`
 }
 
 func (m *QueryRollappPacketListResponse) Reset()         { *m = QueryRollappPacketListResponse{} }
@@ -234,6 +240,13 @@ func (m *QueryRollappPacketListResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+func (m *QueryRollappPacketListResponse) GetFinalizationEtas() []PacketFinalizationETA {
+	if m != nil {
+		return m.FinalizationEtas
+	}
+	return nil
+}
+
 type QueryPendingPacketsByAddressRequest struct {
 	Address    string             `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
@@ -289,6 +302,8 @@ func (m *QueryPendingPacketsByAddressRequest) GetPagination() *query.PageRequest
 type QueryPendingPacketByAddressListResponse struct {
 	RollappPackets []types.RollappPacket `protobuf:"bytes,1,rep,name=rollappPackets,proto3" json:"rollappPackets"`
 	Pagination     *query.PageResponse   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// estimated finalization of rollappPackets
+	FinalizationEtas []PacketFinalizationETA `protobuf:"bytes,3,rep,name=finalization_etas,json=finalizationEtas,proto3" json:"finalization_etas"`
 }
 
 func (m *QueryPendingPacketByAddressListResponse) Reset() {
@@ -340,6 +355,205 @@ func (m *QueryPendingPacketByAddressListResponse) GetPagination() *query.PageRes
 	return nil
 }
 
+func (m *QueryPendingPacketByAddressListResponse) GetFinalizationEtas() []PacketFinalizationETA {
+	if m != nil {
+		return m.FinalizationEtas
+	}
+	return nil
+}
+
+type QueryNextUnlockByAddressRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryNextUnlockByAddressRequest) Reset()         { *m = QueryNextUnlockByAddressRequest{} }
+func (m *QueryNextUnlockByAddressRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextUnlockByAddressRequest) ProtoMessage()    {}
+func (*QueryNextUnlockByAddressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{6}
+}
+func (m *QueryNextUnlockByAddressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextUnlockByAddressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextUnlockByAddressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextUnlockByAddressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextUnlockByAddressRequest.Merge(m, src)
+}
+func (m *QueryNextUnlockByAddressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextUnlockByAddressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextUnlockByAddressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextUnlockByAddressRequest proto.InternalMessageInfo
+
+func (m *QueryNextUnlockByAddressRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryNextUnlockByAddressResponse struct {
+	// number of pending packets of the address
+	PendingPackets uint64 `protobuf:"varint,1,opt,name=pending_packets,json=pendingPackets,proto3" json:"pending_packets,omitempty"`
+	// the pending packet which is expected to finalize first, unset if there
+	// are no pending packets
+	Next *PacketFinalizationETA `protobuf:"bytes,2,opt,name=next,proto3" json:"next,omitempty"`
+}
+
+func (m *QueryNextUnlockByAddressResponse) Reset()         { *m = QueryNextUnlockByAddressResponse{} }
+func (m *QueryNextUnlockByAddressResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextUnlockByAddressResponse) ProtoMessage()    {}
+func (*QueryNextUnlockByAddressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{7}
+}
+func (m *QueryNextUnlockByAddressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextUnlockByAddressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextUnlockByAddressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNextUnlockByAddressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextUnlockByAddressResponse.Merge(m, src)
+}
+func (m *QueryNextUnlockByAddressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextUnlockByAddressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextUnlockByAddressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextUnlockByAddressResponse proto.InternalMessageInfo
+
+func (m *QueryNextUnlockByAddressResponse) GetPendingPackets() uint64 {
+	if m != nil {
+		return m.PendingPackets
+	}
+	return 0
+}
+
+func (m *QueryNextUnlockByAddressResponse) GetNext() *PacketFinalizationETA {
+	if m != nil {
+		return m.Next
+	}
+	return nil
+}
+
+// PacketFinalizationETA is the estimated finalization of a pending packet.
+type PacketFinalizationETA struct {
+	PacketKey string `protobuf:"bytes,1,opt,name=packet_key,json=packetKey,proto3" json:"packet_key,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// the state containing the proof height of the packet is finalized, the
+	// packet can be finalized right away
+	Finalizable bool `protobuf:"varint,3,opt,name=finalizable,proto3" json:"finalizable,omitempty"`
+	// the state containing the proof height of the packet is not submitted yet,
+	// height and time are a lower bound
+	AwaitingStateUpdate bool `protobuf:"varint,4,opt,name=awaiting_state_update,json=awaitingStateUpdate,proto3" json:"awaiting_state_update,omitempty"`
+	// hub height at which the state containing the packet finalizes
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// estimated time of height, from the average hub block time observed over
+	// the dispute period of the last finalized state of the rollapp. Unset if
+	// none was finalized yet.
+	Time *time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *PacketFinalizationETA) Reset()         { *m = PacketFinalizationETA{} }
+func (m *PacketFinalizationETA) String() string { return proto.CompactTextString(m) }
+func (*PacketFinalizationETA) ProtoMessage()    {}
+func (*PacketFinalizationETA) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d5f080aa12bfc36, []int{8}
+}
+func (m *PacketFinalizationETA) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketFinalizationETA) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketFinalizationETA.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketFinalizationETA) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketFinalizationETA.Merge(m, src)
+}
+func (m *PacketFinalizationETA) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketFinalizationETA) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketFinalizationETA.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketFinalizationETA proto.InternalMessageInfo
+
+func (m *PacketFinalizationETA) GetPacketKey() string {
+	if m != nil {
+		return m.PacketKey
+	}
+	return ""
+}
+
+func (m *PacketFinalizationETA) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *PacketFinalizationETA) GetFinalizable() bool {
+	if m != nil {
+		return m.Finalizable
+	}
+	return false
+}
+
+func (m *PacketFinalizationETA) GetAwaitingStateUpdate() bool {
+	if m != nil {
+		return m.AwaitingStateUpdate
+	}
+	return false
+}
+
+func (m *PacketFinalizationETA) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PacketFinalizationETA) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.delayedack.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.delayedack.QueryParamsResponse")
@@ -347,6 +561,9 @@ func init() {
 	proto.RegisterType((*QueryRollappPacketListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryRollappPacketListResponse")
 	proto.RegisterType((*QueryPendingPacketsByAddressRequest)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketsByAddressRequest")
 	proto.RegisterType((*QueryPendingPacketByAddressListResponse)(nil), "dymensionxyz.dymension.delayedack.QueryPendingPacketByAddressListResponse")
+	proto.RegisterType((*QueryNextUnlockByAddressRequest)(nil), "dymensionxyz.dymension.delayedack.QueryNextUnlockByAddressRequest")
+	proto.RegisterType((*QueryNextUnlockByAddressResponse)(nil), "dymensionxyz.dymension.delayedack.QueryNextUnlockByAddressResponse")
+	proto.RegisterType((*PacketFinalizationETA)(nil), "dymensionxyz.dymension.delayedack.PacketFinalizationETA")
 }
 
 func init() {
@@ -354,48 +571,65 @@ func init() {
 }
 
 var fileDescriptor_0d5f080aa12bfc36 = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcf, 0x4e, 0x13, 0x41,
-	0x18, 0xef, 0x14, 0xa8, 0x61, 0x48, 0x38, 0x8c, 0x1c, 0x36, 0x1b, 0xb2, 0xe2, 0x1a, 0x05, 0x54,
-	0x66, 0xd2, 0x12, 0xf5, 0x44, 0x0c, 0x24, 0xd0, 0x68, 0x34, 0x81, 0xd5, 0x13, 0x07, 0xcd, 0xb4,
-	0x3b, 0x59, 0x37, 0xb4, 0x3b, 0xcb, 0xce, 0x94, 0xb0, 0x12, 0x2e, 0x5e, 0xf4, 0x48, 0xe2, 0x5b,
-	0xf8, 0x1e, 0x26, 0x9c, 0x0c, 0x89, 0x07, 0x3d, 0x19, 0x03, 0xbe, 0x87, 0x66, 0x67, 0xb6, 0xdb,
-	0x96, 0xb6, 0x74, 0xe9, 0xcd, 0x5b, 0x67, 0xfa, 0xfd, 0xe6, 0xf7, 0x67, 0xbe, 0x6f, 0x16, 0xae,
-	0xb8, 0x71, 0x93, 0x05, 0xc2, 0xe7, 0xc1, 0x61, 0xfc, 0x9e, 0x64, 0x0b, 0xe2, 0xb2, 0x06, 0x8d,
-	0x99, 0x4b, 0xeb, 0x7b, 0x64, 0xbf, 0xc5, 0xa2, 0x18, 0x87, 0x11, 0x97, 0x1c, 0xdd, 0xee, 0x2e,
-	0xc7, 0xd9, 0x02, 0x77, 0xca, 0xcd, 0x39, 0x8f, 0x7b, 0x5c, 0x55, 0x93, 0xe4, 0x97, 0x06, 0x9a,
-	0xf3, 0x1e, 0xe7, 0x5e, 0x83, 0x11, 0x1a, 0xfa, 0x84, 0x06, 0x01, 0x97, 0x54, 0xfa, 0x3c, 0x10,
-	0xe9, 0xbf, 0xf7, 0xeb, 0x5c, 0x34, 0xb9, 0x20, 0x35, 0x2a, 0x98, 0xe6, 0x23, 0x07, 0xe5, 0x1a,
-	0x93, 0xb4, 0x4c, 0x42, 0xea, 0xf9, 0x81, 0x2a, 0x4e, 0x6b, 0xf1, 0x68, 0xc5, 0x21, 0x8d, 0x68,
-	0x33, 0x3b, 0x7b, 0x48, 0x7d, 0x9d, 0x37, 0x9b, 0x3c, 0x20, 0x42, 0x52, 0xd9, 0x6a, 0xd7, 0x56,
-	0xae, 0xae, 0x8d, 0x78, 0xa3, 0x41, 0xc3, 0xf0, 0x6d, 0x48, 0xeb, 0x7b, 0x4c, 0x6a, 0x8c, 0x3d,
-	0x07, 0xd1, 0x4e, 0xa2, 0x78, 0x5b, 0x91, 0x3a, 0x6c, 0xbf, 0xc5, 0x84, 0xb4, 0xdf, 0xc0, 0x9b,
-	0x3d, 0xbb, 0x22, 0xe4, 0x81, 0x60, 0xa8, 0x0a, 0x4b, 0x5a, 0x9c, 0x01, 0x16, 0xc0, 0xd2, 0x4c,
-	0x65, 0x19, 0x8f, 0x0c, 0x14, 0xeb, 0x23, 0x36, 0x26, 0x4f, 0x7f, 0xdd, 0x2a, 0x38, 0x29, 0xdc,
-	0xfe, 0x54, 0x84, 0xa6, 0x22, 0x70, 0xb4, 0xa6, 0x6d, 0x25, 0xa9, 0x4d, 0x8f, 0xe6, 0xe1, 0x74,
-	0x2a, 0xf6, 0x99, 0xab, 0xa8, 0xa6, 0x9d, 0xce, 0x06, 0x5a, 0x83, 0x25, 0x6d, 0xdb, 0x28, 0x2e,
-	0x80, 0xa5, 0xd9, 0xca, 0xdd, 0x61, 0x2a, 0xb4, 0x6f, 0xfc, 0x4a, 0x15, 0x3b, 0x29, 0x08, 0x6d,
-	0xc2, 0x49, 0x19, 0x87, 0xcc, 0x98, 0x50, 0xe0, 0xf2, 0x08, 0x70, 0x8f, 0x40, 0xfc, 0x3a, 0x0e,
-	0x99, 0xa3, 0xe0, 0x68, 0x0b, 0xc2, 0xce, 0xe5, 0x1a, 0x93, 0x2a, 0x8f, 0x7b, 0x58, 0x77, 0x02,
-	0x4e, 0x3a, 0x01, 0xeb, 0xce, 0x4b, 0x3b, 0x01, 0x6f, 0x53, 0x8f, 0xa5, 0xfe, 0x9c, 0x2e, 0xa4,
-	0xfd, 0x15, 0x40, 0xab, 0x3f, 0x8a, 0x17, 0xbe, 0x90, 0x59, 0xec, 0xbb, 0x70, 0x36, 0xea, 0xc9,
-	0xc9, 0x00, 0x0b, 0x13, 0x4b, 0x33, 0x95, 0x87, 0xd7, 0xd1, 0x9e, 0xde, 0xc0, 0xa5, 0x93, 0x50,
-	0xb5, 0xc7, 0x46, 0x51, 0xd9, 0x58, 0x1c, 0x69, 0x43, 0x0b, 0xeb, 0xf1, 0xf1, 0x11, 0xc0, 0x3b,
-	0xba, 0x67, 0x58, 0xe0, 0xfa, 0x81, 0x97, 0x12, 0x6c, 0xc4, 0xeb, 0xae, 0x1b, 0x31, 0x91, 0xdd,
-	0xad, 0x01, 0x6f, 0x50, 0xbd, 0x93, 0xde, 0x6c, 0x7b, 0x79, 0x29, 0xd1, 0xe2, 0xd8, 0x89, 0x7e,
-	0x03, 0x70, 0xb1, 0x5f, 0x49, 0x26, 0xe4, 0xbf, 0x8b, 0xb6, 0x72, 0x32, 0x05, 0xa7, 0x94, 0x21,
-	0xf4, 0x05, 0xc0, 0x92, 0x1e, 0x28, 0xf4, 0x28, 0xc7, 0xec, 0xf5, 0x4f, 0xb6, 0xf9, 0xf8, 0xba,
-	0x30, 0xad, 0xc7, 0x2e, 0x7f, 0xf8, 0xfe, 0xe7, 0x73, 0xf1, 0x01, 0x5a, 0x26, 0x79, 0x1f, 0x30,
-	0xf4, 0x03, 0x40, 0x58, 0x65, 0xb2, 0x1d, 0xc7, 0x5a, 0x5e, 0xe6, 0x81, 0x6f, 0x82, 0xb9, 0x3e,
-	0x16, 0xbc, 0xfb, 0xb2, 0xed, 0xaa, 0xf2, 0xb0, 0x8e, 0x9e, 0xe6, 0xf2, 0xa0, 0xd8, 0xc9, 0x51,
-	0xf6, 0xee, 0x1c, 0x93, 0x23, 0xfd, 0x82, 0x1c, 0xa3, 0xbf, 0x00, 0x9a, 0x89, 0xb3, 0xc1, 0x9d,
-	0x8e, 0xb6, 0x72, 0x67, 0x7c, 0xe5, 0xa8, 0x98, 0xcf, 0xc7, 0x3a, 0x67, 0x60, 0xa3, 0xdb, 0x2f,
-	0x95, 0xf7, 0x2a, 0xda, 0xcc, 0xe3, 0x5d, 0x1f, 0xb7, 0x12, 0xb1, 0x3a, 0xf3, 0x0f, 0x58, 0xb4,
-	0x92, 0x85, 0x91, 0x8e, 0xea, 0xf1, 0xc6, 0xce, 0xe9, 0xb9, 0x05, 0xce, 0xce, 0x2d, 0xf0, 0xfb,
-	0xdc, 0x02, 0x27, 0x17, 0x56, 0xe1, 0xec, 0xc2, 0x2a, 0xfc, 0xbc, 0xb0, 0x0a, 0xbb, 0x4f, 0x3c,
-	0x5f, 0xbe, 0x6b, 0xd5, 0x92, 0x41, 0x19, 0x46, 0x75, 0xb0, 0x4a, 0x0e, 0xbb, 0xf9, 0x92, 0xf7,
-	0x54, 0xd4, 0x4a, 0xea, 0x83, 0xb4, 0xfa, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x19, 0xae, 0x61, 0xeb,
-	0xd4, 0x07, 0x00, 0x00,
+	// 922 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4b, 0x6f, 0xe3, 0x54,
+	0x14, 0x8e, 0xd3, 0x4c, 0x98, 0xb9, 0x95, 0x0a, 0xdc, 0x79, 0xc8, 0xb2, 0x86, 0x24, 0x18, 0x41,
+	0x3b, 0x03, 0xb1, 0x95, 0x0c, 0x8f, 0x11, 0x68, 0x04, 0x09, 0x4a, 0x22, 0x60, 0x40, 0x1d, 0xd3,
+	0xd9, 0xcc, 0x82, 0xe8, 0x26, 0xbe, 0xe3, 0x5a, 0x49, 0x7c, 0x3d, 0xf6, 0x4d, 0x89, 0x5b, 0x75,
+	0xc3, 0x06, 0x96, 0x95, 0x58, 0xf1, 0x17, 0x58, 0xb2, 0xe5, 0x0f, 0x74, 0x59, 0x89, 0x05, 0xac,
+	0x00, 0x35, 0xfc, 0x06, 0x24, 0x56, 0xa0, 0xfb, 0x70, 0xe2, 0xb4, 0x49, 0xe3, 0x86, 0xed, 0xec,
+	0x7a, 0x7d, 0x5e, 0xdf, 0x77, 0xce, 0xe9, 0x77, 0x02, 0xca, 0x76, 0x34, 0xc0, 0x5e, 0xe8, 0x12,
+	0x6f, 0x14, 0xed, 0x9b, 0x93, 0x87, 0x69, 0xe3, 0x3e, 0x8a, 0xb0, 0x8d, 0xba, 0x3d, 0xf3, 0xd9,
+	0x10, 0x07, 0x91, 0xe1, 0x07, 0x84, 0x12, 0xf8, 0x6a, 0xd2, 0xdd, 0x98, 0x3c, 0x8c, 0xa9, 0xbb,
+	0x76, 0xc3, 0x21, 0x0e, 0xe1, 0xde, 0x26, 0xfb, 0x4b, 0x04, 0x6a, 0xb7, 0x1d, 0x42, 0x9c, 0x3e,
+	0x36, 0x91, 0xef, 0x9a, 0xc8, 0xf3, 0x08, 0x45, 0xd4, 0x25, 0x5e, 0x28, 0xad, 0x45, 0x69, 0xe5,
+	0xaf, 0xce, 0xf0, 0xa9, 0x49, 0xdd, 0x01, 0x0e, 0x29, 0x1a, 0xf8, 0xd2, 0xe1, 0x6e, 0x97, 0x84,
+	0x03, 0x12, 0x9a, 0x1d, 0x14, 0x62, 0x01, 0xc8, 0xdc, 0xab, 0x74, 0x30, 0x45, 0x15, 0xd3, 0x47,
+	0x8e, 0xeb, 0xf1, 0x6c, 0xd2, 0xd7, 0x58, 0x4e, 0xc9, 0x47, 0x01, 0x1a, 0xc4, 0xc5, 0xef, 0x2e,
+	0xf0, 0xef, 0x92, 0xc1, 0x80, 0x78, 0x66, 0x48, 0x11, 0x1d, 0xc6, 0xbe, 0xd5, 0x8b, 0x7d, 0x03,
+	0xd2, 0xef, 0x23, 0xdf, 0x6f, 0xfb, 0xa8, 0xdb, 0xc3, 0x54, 0xc4, 0xe8, 0x37, 0x00, 0x7c, 0xc4,
+	0x10, 0x6f, 0xf3, 0xa2, 0x16, 0x7e, 0x36, 0xc4, 0x21, 0xd5, 0xbf, 0x02, 0xd7, 0x67, 0xbe, 0x86,
+	0x3e, 0xf1, 0x42, 0x0c, 0x5b, 0x20, 0x2f, 0xc0, 0xa9, 0x4a, 0x49, 0xd9, 0x5a, 0xaf, 0xde, 0x31,
+	0x96, 0x76, 0xdc, 0x10, 0x29, 0xea, 0xb9, 0xe3, 0xdf, 0x8b, 0x19, 0x4b, 0x86, 0xeb, 0xdf, 0x65,
+	0x81, 0xc6, 0x0b, 0x58, 0x02, 0xd3, 0x36, 0x87, 0x14, 0x97, 0x87, 0xb7, 0xc1, 0x35, 0x09, 0xf6,
+	0x13, 0x9b, 0x97, 0xba, 0x66, 0x4d, 0x3f, 0xc0, 0x07, 0x20, 0x2f, 0x68, 0xab, 0xd9, 0x92, 0xb2,
+	0xb5, 0x51, 0x7d, 0x7d, 0x11, 0x0a, 0xc1, 0xdb, 0xf8, 0x92, 0x3b, 0x5b, 0x32, 0x08, 0x36, 0x40,
+	0x8e, 0x46, 0x3e, 0x56, 0xd7, 0x78, 0x70, 0x65, 0x49, 0xf0, 0x0c, 0x40, 0x63, 0x27, 0xf2, 0xb1,
+	0xc5, 0xc3, 0x61, 0x13, 0x80, 0xe9, 0x70, 0xd5, 0x1c, 0xef, 0xc7, 0x1b, 0x86, 0xd8, 0x04, 0x83,
+	0x6d, 0x82, 0x21, 0x56, 0x53, 0x6e, 0x82, 0xb1, 0x8d, 0x1c, 0x2c, 0xf9, 0x59, 0x89, 0x48, 0xfd,
+	0xa7, 0x2c, 0x28, 0x9c, 0x6f, 0xc5, 0x43, 0x37, 0xa4, 0x93, 0xb6, 0x3f, 0x01, 0x1b, 0x41, 0xd2,
+	0xc8, 0xda, 0xbf, 0xb6, 0xb5, 0x5e, 0x7d, 0xeb, 0x32, 0xd8, 0xe5, 0x04, 0xce, 0x64, 0x82, 0xad,
+	0x19, 0x1a, 0x59, 0x4e, 0x63, 0x73, 0x29, 0x0d, 0x01, 0x2c, 0xc9, 0x03, 0xf6, 0xc0, 0xcb, 0x4f,
+	0x5d, 0x0f, 0xf5, 0xdd, 0x7d, 0xfe, 0x6e, 0x63, 0x8a, 0x42, 0x75, 0x8d, 0xe3, 0xbc, 0x9f, 0x6a,
+	0x4d, 0x18, 0x9e, 0x66, 0x22, 0x43, 0x63, 0xa7, 0x26, 0x31, 0xbf, 0x94, 0x4c, 0xdc, 0xa0, 0x28,
+	0xd4, 0xbf, 0x55, 0xc0, 0x6b, 0x62, 0x41, 0xb1, 0x67, 0xbb, 0x9e, 0x23, 0xd9, 0xd4, 0xa3, 0x9a,
+	0x6d, 0x07, 0x38, 0x9c, 0x2c, 0x92, 0x0a, 0x5e, 0x40, 0xe2, 0x8b, 0x5c, 0xa3, 0xf8, 0x09, 0x9b,
+	0x73, 0x78, 0xaf, 0x32, 0xbe, 0x9f, 0xb3, 0x60, 0xf3, 0x3c, 0x92, 0x09, 0x90, 0xe7, 0x73, 0xbc,
+	0x70, 0x8e, 0x1f, 0x80, 0x22, 0x6f, 0xde, 0x17, 0x78, 0x44, 0x1f, 0x7b, 0x7d, 0xd2, 0xed, 0xa5,
+	0x1f, 0xa1, 0xfe, 0x83, 0x02, 0x4a, 0x8b, 0xa3, 0x65, 0xcf, 0x37, 0xc1, 0x8b, 0xbe, 0x98, 0x8c,
+	0xd4, 0x3d, 0x91, 0x26, 0x67, 0x6d, 0xf8, 0x33, 0xab, 0x03, 0x1f, 0x82, 0x9c, 0x87, 0x47, 0x54,
+	0xb6, 0x6e, 0x65, 0xaa, 0x16, 0xcf, 0xa2, 0xff, 0xa3, 0x80, 0x9b, 0x73, 0xed, 0xf0, 0x15, 0x36,
+	0x28, 0x66, 0x68, 0xf7, 0x70, 0x14, 0x8b, 0x9b, 0xf8, 0xf2, 0x19, 0x8e, 0x98, 0x39, 0xd6, 0x69,
+	0xd7, 0x56, 0xb3, 0x67, 0xb5, 0xaf, 0x04, 0xd6, 0xe3, 0x26, 0x76, 0xfa, 0x42, 0xc3, 0xae, 0x5a,
+	0xc9, 0x4f, 0xb0, 0x0a, 0x6e, 0xa2, 0xaf, 0x91, 0x4b, 0x19, 0x63, 0xa6, 0x78, 0xb8, 0x3d, 0xf4,
+	0x6d, 0x44, 0x31, 0x97, 0xa8, 0xab, 0xd6, 0xf5, 0xd8, 0xc8, 0x54, 0x11, 0x3f, 0xe6, 0x26, 0x78,
+	0x0b, 0xe4, 0x77, 0xb1, 0xeb, 0xec, 0x52, 0xf5, 0x0a, 0xef, 0x8d, 0x7c, 0xc1, 0xb7, 0x41, 0x8e,
+	0xdd, 0x3a, 0x35, 0xcf, 0x7b, 0xa2, 0x19, 0xe2, 0x10, 0x1a, 0xf1, 0x21, 0x34, 0x76, 0xe2, 0x43,
+	0x58, 0xcf, 0x1d, 0xfd, 0x51, 0x54, 0x2c, 0xee, 0x5d, 0xfd, 0x3b, 0x0f, 0xae, 0xf0, 0xb9, 0xc0,
+	0x1f, 0x15, 0x90, 0x17, 0xfa, 0x0f, 0xdf, 0x49, 0xd1, 0xd0, 0xf3, 0x87, 0x48, 0x7b, 0xf7, 0xb2,
+	0x61, 0x62, 0xec, 0x7a, 0xe5, 0x9b, 0x5f, 0xfe, 0xfa, 0x3e, 0xfb, 0x26, 0xbc, 0x63, 0xa6, 0xbd,
+	0xb7, 0xf0, 0x57, 0x05, 0x80, 0x16, 0xa6, 0xf1, 0x3e, 0x3c, 0x48, 0x5b, 0x79, 0xee, 0x09, 0xd3,
+	0x6a, 0x2b, 0x85, 0x27, 0xe5, 0x42, 0x6f, 0x71, 0x0e, 0x35, 0xf8, 0x61, 0x2a, 0x0e, 0xbc, 0xba,
+	0x79, 0x30, 0x59, 0x95, 0x43, 0xf3, 0x40, 0x1c, 0xbc, 0x43, 0xf8, 0xaf, 0x02, 0x34, 0xc6, 0x6c,
+	0xbe, 0x56, 0xc2, 0x66, 0xea, 0x1e, 0x5f, 0x28, 0xb6, 0xda, 0xa7, 0x2b, 0xe5, 0x99, 0x2b, 0x95,
+	0xfa, 0xe7, 0x9c, 0x7b, 0x0b, 0x36, 0xd2, 0x70, 0x17, 0xe9, 0xca, 0x01, 0xee, 0x62, 0x77, 0x0f,
+	0x07, 0xe5, 0x49, 0x33, 0xa4, 0x52, 0x1c, 0xc2, 0xb1, 0x02, 0x6e, 0xb5, 0x30, 0x9d, 0x23, 0x14,
+	0xb0, 0x9e, 0x16, 0xf5, 0x62, 0x8d, 0xd2, 0x3e, 0xfe, 0x5f, 0x39, 0x24, 0xe5, 0x8f, 0x38, 0xe5,
+	0xf7, 0xe1, 0xfd, 0x14, 0x94, 0x99, 0xc6, 0x94, 0x87, 0x3c, 0xd1, 0x94, 0x65, 0xfd, 0xd1, 0xf1,
+	0x69, 0x41, 0x39, 0x39, 0x2d, 0x28, 0x7f, 0x9e, 0x16, 0x94, 0xa3, 0x71, 0x21, 0x73, 0x32, 0x2e,
+	0x64, 0x7e, 0x1b, 0x17, 0x32, 0x4f, 0xde, 0x73, 0x5c, 0xba, 0x3b, 0xec, 0xb0, 0x83, 0xb2, 0x28,
+	0xfb, 0xde, 0x3d, 0x73, 0x94, 0x2c, 0xc1, 0x7e, 0xe4, 0x84, 0x9d, 0x3c, 0xff, 0x5f, 0xbf, 0xf7,
+	0xdf, 0x00, 0x67, 0xab, 0x7d, 0x24, 0x8a, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -416,6 +650,8 @@ type QueryClient interface {
 	GetPackets(ctx context.Context, in *QueryRollappPacketsRequest, opts ...grpc.CallOption) (*QueryRollappPacketListResponse, error)
 	// Queries a list of pending RollappPacket items by rollappID and receiver.
 	GetPendingPacketsByAddress(ctx context.Context, in *QueryPendingPacketsByAddressRequest, opts ...grpc.CallOption) (*QueryPendingPacketByAddressListResponse, error)
+	// Queries the earliest finalization among the pending packets of an address.
+	GetNextUnlockByAddress(ctx context.Context, in *QueryNextUnlockByAddressRequest, opts ...grpc.CallOption) (*QueryNextUnlockByAddressResponse, error)
 }
 
 type queryClient struct {
@@ -453,6 +689,15 @@ func (c *queryClient) GetPendingPacketsByAddress(ctx context.Context, in *QueryP
 	return out, nil
 }
 
+func (c *queryClient) GetNextUnlockByAddress(ctx context.Context, in *QueryNextUnlockByAddressRequest, opts ...grpc.CallOption) (*QueryNextUnlockByAddressResponse, error) {
+	out := new(QueryNextUnlockByAddressResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Query/GetNextUnlockByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -461,6 +706,8 @@ type QueryServer interface {
 	GetPackets(context.Context, *QueryRollappPacketsRequest) (*QueryRollappPacketListResponse, error)
 	// Queries a list of pending RollappPacket items by rollappID and receiver.
 	GetPendingPacketsByAddress(context.Context, *QueryPendingPacketsByAddressRequest) (*QueryPendingPacketByAddressListResponse, error)
+	// Queries the earliest finalization among the pending packets of an address.
+	GetNextUnlockByAddress(context.Context, *QueryNextUnlockByAddressRequest) (*QueryNextUnlockByAddressResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -476,6 +723,9 @@ func (*UnimplementedQueryServer) GetPackets(ctx context.Context, req *QueryRolla
 func (*UnimplementedQueryServer) GetPendingPacketsByAddress(ctx context.Context, req *QueryPendingPacketsByAddressRequest) (*QueryPendingPacketByAddressListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPendingPacketsByAddress not implemented")
 }
+func (*UnimplementedQueryServer) GetNextUnlockByAddress(ctx context.Context, req *QueryNextUnlockByAddressRequest) (*QueryNextUnlockByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextUnlockByAddress not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -535,6 +785,25 @@ func _Query_GetPendingPacketsByAddress_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GetNextUnlockByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextUnlockByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetNextUnlockByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Query/GetNextUnlockByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetNextUnlockByAddress(ctx, req.(*QueryNextUnlockByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.delayedack.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -551,6 +820,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPendingPacketsByAddress",
 			Handler:    _Query_GetPendingPacketsByAddress_Handler,
 		},
+		{
+			MethodName: "GetNextUnlockByAddress",
+			Handler:    _Query_GetNextUnlockByAddress_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/delayedack/query.proto",
@@ -684,6 +957,20 @@ func (m *QueryRollappPacketListResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if len(m.FinalizationEtas) > 0 {
+		for iNdEx := len(m.FinalizationEtas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizationEtas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -775,6 +1062,20 @@ func (m *QueryPendingPacketByAddressListResponse) MarshalToSizedBuffer(dAtA []by
 	_ = i
 	var l int
 	_ = l
+	if len(m.FinalizationEtas) > 0 {
+		for iNdEx := len(m.FinalizationEtas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FinalizationEtas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -804,84 +1105,232 @@ func (m *QueryPendingPacketByAddressListResponse) MarshalToSizedBuffer(dAtA []by
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNextUnlockByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryNextUnlockByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRollappPacketsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryNextUnlockByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryRollappPacketListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryNextUnlockByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextUnlockByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextUnlockByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.RollappPackets) > 0 {
-		for _, e := range m.RollappPackets {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Next != nil {
+		{
+			size, err := m.Next.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PendingPackets != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PendingPackets))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPendingPacketsByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
+func (m *PacketFinalizationETA) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFinalizationETA) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFinalizationETA) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintQuery(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AwaitingStateUpdate {
+		i--
+		if m.AwaitingStateUpdate {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Finalizable {
+		i--
+		if m.Finalizable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRollappPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRollappPacketListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RollappPackets) > 0 {
+		for _, e := range m.RollappPackets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.FinalizationEtas) > 0 {
+		for _, e := range m.FinalizationEtas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingPacketsByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	l = len(m.Address)
 	if l > 0 {
@@ -910,6 +1359,71 @@ func (m *QueryPendingPacketByAddressListResponse) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if len(m.FinalizationEtas) > 0 {
+		for _, e := range m.FinalizationEtas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextUnlockByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNextUnlockByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingPackets != 0 {
+		n += 1 + sovQuery(uint64(m.PendingPackets))
+	}
+	if m.Next != nil {
+		l = m.Next.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PacketFinalizationETA) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Finalizable {
+		n += 2
+	}
+	if m.AwaitingStateUpdate {
+		n += 2
+	}
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -1307,6 +1821,40 @@ func (m *QueryRollappPacketListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationEtas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizationEtas = append(m.FinalizationEtas, PacketFinalizationETA{})
+			if err := m.FinalizationEtas[len(m.FinalizationEtas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1545,6 +2093,436 @@ func (m *QueryPendingPacketByAddressListResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinalizationEtas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FinalizationEtas = append(m.FinalizationEtas, PacketFinalizationETA{})
+			if err := m.FinalizationEtas[len(m.FinalizationEtas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextUnlockByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextUnlockByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextUnlockByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextUnlockByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextUnlockByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextUnlockByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPackets", wireType)
+			}
+			m.PendingPackets = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingPackets |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Next", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Next == nil {
+				m.Next = &PacketFinalizationETA{}
+			}
+			if err := m.Next.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFinalizationETA) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFinalizationETA: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFinalizationETA: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalizable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Finalizable = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AwaitingStateUpdate", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AwaitingStateUpdate = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
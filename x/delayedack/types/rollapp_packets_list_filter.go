@@ -1,7 +1,11 @@
 package types
 
 import (
+	"bytes"
 	math "math"
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
@@ -100,3 +104,29 @@ func (f RollappPacketListFilter) Take(limit int) RollappPacketListFilter {
 	f.Limit = limit
 	return f
 }
+
+// After restricts the filter to the packets whose key is after key
+func (f RollappPacketListFilter) After(key []byte) RollappPacketListFilter {
+	if len(key) == 0 {
+		return f
+	}
+	start := append(slices.Clone(key), 0)
+	prefixes := make([]Prefix, 0, len(f.Prefixes))
+	for _, p := range f.Prefixes {
+		if len(p.Start) == 0 {
+			p.Start = commontypes.AllRollappPacketKeyPrefix
+		}
+		if len(p.End) == 0 {
+			p.End = storetypes.PrefixEndBytes(p.Start)
+		}
+		if bytes.Compare(p.Start, start) < 0 {
+			p.Start = start
+		}
+		if bytes.Compare(p.End, p.Start) <= 0 {
+			continue
+		}
+		prefixes = append(prefixes, p)
+	}
+	f.Prefixes = prefixes
+	return f
+}
@@ -340,6 +340,199 @@ func (m *MsgFinalizePacketByPacketKeyResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFinalizePacketByPacketKeyResponse proto.InternalMessageInfo
 
+// MsgFinalizePackets finalizes the pending packets of a rollapp whose height is
+// finalized, in order of proof height.
+type MsgFinalizePackets struct {
+	// Sender is the signer of the message.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// RollappID is the ID of the rollapp.
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// Address optionally restricts the packets to those of an address: the
+	// receiver of ON_RECV packets, the sender of ON_ACK and ON_TIMEOUT packets.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// Limit is the max number of packets to finalize.
+	Limit uint64 `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *MsgFinalizePackets) Reset()         { *m = MsgFinalizePackets{} }
+func (m *MsgFinalizePackets) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizePackets) ProtoMessage()    {}
+func (*MsgFinalizePackets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{6}
+}
+func (m *MsgFinalizePackets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizePackets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizePackets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizePackets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizePackets.Merge(m, src)
+}
+func (m *MsgFinalizePackets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizePackets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizePackets.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizePackets proto.InternalMessageInfo
+
+func (m *MsgFinalizePackets) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgFinalizePackets) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *MsgFinalizePackets) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgFinalizePackets) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type MsgFinalizePacketsResponse struct {
+	// Results holds the outcome of every packet that was attempted.
+	Results []PacketFinalizationResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	// Finalized is the number of packets which got finalized.
+	Finalized uint64 `protobuf:"varint,2,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	// Failed is the number of packets which failed to finalize and are still
+	// pending.
+	Failed uint64 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+}
+
+func (m *MsgFinalizePacketsResponse) Reset()         { *m = MsgFinalizePacketsResponse{} }
+func (m *MsgFinalizePacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFinalizePacketsResponse) ProtoMessage()    {}
+func (*MsgFinalizePacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{7}
+}
+func (m *MsgFinalizePacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFinalizePacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFinalizePacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFinalizePacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFinalizePacketsResponse.Merge(m, src)
+}
+func (m *MsgFinalizePacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFinalizePacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFinalizePacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFinalizePacketsResponse proto.InternalMessageInfo
+
+func (m *MsgFinalizePacketsResponse) GetResults() []PacketFinalizationResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *MsgFinalizePacketsResponse) GetFinalized() uint64 {
+	if m != nil {
+		return m.Finalized
+	}
+	return 0
+}
+
+func (m *MsgFinalizePacketsResponse) GetFailed() uint64 {
+	if m != nil {
+		return m.Failed
+	}
+	return 0
+}
+
+type PacketFinalizationResult struct {
+	// PacketKey is the base64 encoded key of the pending packet.
+	PacketKey string `protobuf:"bytes,1,opt,name=packet_key,json=packetKey,proto3" json:"packet_key,omitempty"`
+	// Error is set if the packet failed to finalize.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *PacketFinalizationResult) Reset()         { *m = PacketFinalizationResult{} }
+func (m *PacketFinalizationResult) String() string { return proto.CompactTextString(m) }
+func (*PacketFinalizationResult) ProtoMessage()    {}
+func (*PacketFinalizationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_604a74c1ca57f5ed, []int{8}
+}
+func (m *PacketFinalizationResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketFinalizationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketFinalizationResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketFinalizationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketFinalizationResult.Merge(m, src)
+}
+func (m *PacketFinalizationResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketFinalizationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketFinalizationResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketFinalizationResult proto.InternalMessageInfo
+
+func (m *PacketFinalizationResult) GetPacketKey() string {
+	if m != nil {
+		return m.PacketKey
+	}
+	return ""
+}
+
+func (m *PacketFinalizationResult) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.delayedack.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.delayedack.MsgUpdateParamsResponse")
@@ -347,6 +540,9 @@ func init() {
 	proto.RegisterType((*MsgFinalizePacketResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketResponse")
 	proto.RegisterType((*MsgFinalizePacketByPacketKey)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketByPacketKey")
 	proto.RegisterType((*MsgFinalizePacketByPacketKeyResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketByPacketKeyResponse")
+	proto.RegisterType((*MsgFinalizePackets)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePackets")
+	proto.RegisterType((*MsgFinalizePacketsResponse)(nil), "dymensionxyz.dymension.delayedack.MsgFinalizePacketsResponse")
+	proto.RegisterType((*PacketFinalizationResult)(nil), "dymensionxyz.dymension.delayedack.PacketFinalizationResult")
 }
 
 func init() {
@@ -354,45 +550,54 @@ func init() {
 }
 
 var fileDescriptor_604a74c1ca57f5ed = []byte{
-	// 608 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xc1, 0x6e, 0xd3, 0x4c,
-	0x10, 0xce, 0x36, 0x69, 0xa4, 0x6c, 0x7e, 0xa5, 0x7f, 0x97, 0x8a, 0x3a, 0x06, 0x4c, 0x88, 0x10,
-	0x84, 0x08, 0x6c, 0x35, 0x45, 0x20, 0x45, 0x48, 0x88, 0x20, 0x51, 0x10, 0x8a, 0x14, 0x5c, 0xb8,
-	0x70, 0x89, 0x1c, 0x7b, 0x71, 0xac, 0xc4, 0xde, 0xc5, 0xeb, 0x54, 0x71, 0x0f, 0x08, 0x21, 0x1e,
-	0x80, 0x67, 0x40, 0xe2, 0x5e, 0x21, 0x6e, 0xbc, 0x40, 0x8f, 0x15, 0x27, 0x4e, 0x08, 0x25, 0x87,
-	0xbe, 0x06, 0xb2, 0x77, 0x9d, 0x34, 0xa9, 0xd2, 0x96, 0x72, 0xb2, 0x67, 0xbe, 0x99, 0x6f, 0x3e,
-	0x7f, 0x3b, 0x5e, 0x58, 0xb5, 0x42, 0x17, 0x7b, 0xcc, 0x21, 0xde, 0x30, 0xdc, 0xd5, 0x26, 0x81,
-	0x66, 0xe1, 0xbe, 0x11, 0x62, 0xcb, 0x30, 0x7b, 0x5a, 0x30, 0x54, 0xa9, 0x4f, 0x02, 0x82, 0xae,
-	0x1d, 0xad, 0x55, 0x27, 0x81, 0x3a, 0xad, 0x95, 0xd7, 0x4d, 0xc2, 0x5c, 0xc2, 0x34, 0x97, 0xd9,
-	0xda, 0xce, 0x46, 0xf4, 0xe0, 0xbd, 0x72, 0x91, 0x03, 0xed, 0x38, 0xd2, 0x78, 0x20, 0xa0, 0x35,
-	0x9b, 0xd8, 0x84, 0xe7, 0xa3, 0x37, 0x91, 0xad, 0x2d, 0x10, 0x66, 0x12, 0xd7, 0x25, 0x9e, 0xe6,
-	0x93, 0x7e, 0xdf, 0xa0, 0xb4, 0x4d, 0x0d, 0xb3, 0x87, 0x03, 0xd1, 0xa3, 0x9e, 0xfe, 0x31, 0xd4,
-	0xf0, 0x0d, 0x57, 0x4c, 0x2e, 0x7f, 0x06, 0x70, 0xa5, 0xc9, 0xec, 0x57, 0xd4, 0x32, 0x02, 0xdc,
-	0x8a, 0x11, 0x74, 0x0f, 0xe6, 0x8c, 0x41, 0xd0, 0x25, 0xbe, 0x13, 0x84, 0x12, 0x28, 0x81, 0x4a,
-	0xae, 0x21, 0xfd, 0xf8, 0x76, 0x67, 0x4d, 0x48, 0x7e, 0x64, 0x59, 0x3e, 0x66, 0x6c, 0x3b, 0xf0,
-	0x1d, 0xcf, 0xd6, 0xa7, 0xa5, 0x68, 0x0b, 0x66, 0x39, 0xb7, 0xb4, 0x54, 0x02, 0x95, 0x7c, 0xed,
-	0x96, 0x7a, 0xaa, 0x5b, 0x2a, 0x1f, 0xd9, 0xc8, 0xec, 0xff, 0xba, 0x9a, 0xd2, 0x45, 0x7b, 0xbd,
-	0xf0, 0xe1, 0x70, 0xaf, 0x3a, 0x25, 0x2e, 0x17, 0xe1, 0xfa, 0x9c, 0x46, 0x1d, 0x33, 0x4a, 0x3c,
-	0x86, 0xcb, 0x5f, 0x97, 0xe0, 0x6a, 0x93, 0xd9, 0x4f, 0x1c, 0xcf, 0xe8, 0x3b, 0xbb, 0xb8, 0x15,
-	0x7b, 0x81, 0x2e, 0xc2, 0x2c, 0xc3, 0x9e, 0x85, 0x7d, 0x2e, 0x5f, 0x17, 0x11, 0xba, 0x02, 0x61,
-	0xe2, 0x9a, 0x63, 0xc5, 0x2a, 0x73, 0x7a, 0x4e, 0x64, 0x9e, 0x59, 0x48, 0x85, 0x17, 0xb8, 0x99,
-	0xd1, 0x19, 0x91, 0x37, 0xed, 0x2e, 0x76, 0xec, 0x6e, 0x20, 0xa5, 0x4b, 0xa0, 0x92, 0xd1, 0x57,
-	0x39, 0xd4, 0x8a, 0x90, 0xa7, 0x31, 0x80, 0x74, 0x98, 0x17, 0xf5, 0x41, 0x48, 0xb1, 0x94, 0x29,
-	0x81, 0x4a, 0xa1, 0xb6, 0xb1, 0xe8, 0xab, 0xf9, 0xb1, 0xa9, 0x3a, 0x1f, 0xc7, 0x95, 0xaa, 0x2f,
-	0x43, 0x8a, 0x75, 0xc8, 0x59, 0xa2, 0x77, 0x74, 0x1b, 0x22, 0xc1, 0xc9, 0x7c, 0xb3, 0x6d, 0x76,
-	0x0d, 0xcf, 0xc3, 0x7d, 0x69, 0x39, 0x96, 0xfa, 0x3f, 0x47, 0xb6, 0x7d, 0xf3, 0x31, 0xcf, 0xa3,
-	0x9b, 0x70, 0x25, 0xa9, 0xc6, 0x6f, 0x07, 0xd8, 0x33, 0xb1, 0x94, 0x8d, 0xd5, 0x16, 0x44, 0xa9,
-	0xc8, 0xd6, 0xf3, 0x91, 0xa5, 0xc2, 0x86, 0xf2, 0x25, 0x58, 0x3c, 0xe6, 0xd9, 0xc4, 0xd1, 0x0e,
-	0xbc, 0x7c, 0x0c, 0x6c, 0x84, 0xfc, 0xf9, 0x1c, 0x87, 0x27, 0x79, 0x2b, 0xa4, 0xf4, 0x70, 0x98,
-	0x78, 0x4b, 0x93, 0xb6, 0x59, 0x01, 0x37, 0xe0, 0xf5, 0x93, 0x66, 0x24, 0x5a, 0x6a, 0xdf, 0xd3,
-	0x30, 0xdd, 0x64, 0x36, 0x7a, 0x07, 0xff, 0x9b, 0xd9, 0xd0, 0xda, 0x19, 0x36, 0x6b, 0x6e, 0x63,
-	0xe4, 0xfa, 0xdf, 0xf7, 0x24, 0x3a, 0xd0, 0x47, 0x00, 0x0b, 0x73, 0x2b, 0x76, 0xf7, 0x6c, 0x74,
-	0xb3, 0x5d, 0xf2, 0x83, 0xf3, 0x74, 0x4d, 0x64, 0x7c, 0x01, 0xb0, 0xb8, 0xf8, 0x60, 0x1e, 0x9e,
-	0x87, 0xfb, 0x08, 0x81, 0xbc, 0xf5, 0x8f, 0x04, 0x89, 0x4e, 0x79, 0xf9, 0xfd, 0xe1, 0x5e, 0x15,
-	0x34, 0x5e, 0xec, 0x8f, 0x14, 0x70, 0x30, 0x52, 0xc0, 0xef, 0x91, 0x02, 0x3e, 0x8d, 0x95, 0xd4,
-	0xc1, 0x58, 0x49, 0xfd, 0x1c, 0x2b, 0xa9, 0xd7, 0xf7, 0x6d, 0x27, 0xe8, 0x0e, 0x3a, 0xd1, 0x2f,
-	0xa1, 0x2d, 0xb8, 0xb0, 0x76, 0x36, 0xb5, 0xe1, 0xcc, 0x15, 0x1c, 0x52, 0xcc, 0x3a, 0xd9, 0xf8,
-	0xd6, 0xda, 0xfc, 0x13, 0x00, 0x00, 0xff, 0xff, 0x7a, 0xe0, 0x03, 0x99, 0xb4, 0x05, 0x00, 0x00,
+	// 737 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5f, 0x6b, 0xd3, 0x5e,
+	0x18, 0xee, 0xd9, 0xba, 0x8e, 0xbe, 0xfd, 0xd1, 0xfd, 0x76, 0x1c, 0x2e, 0x8d, 0xb3, 0xd6, 0x22,
+	0x5a, 0x87, 0x26, 0xac, 0xf3, 0x0f, 0x4c, 0x45, 0xac, 0xe0, 0x14, 0x19, 0xce, 0x4c, 0x6f, 0xf4,
+	0xa2, 0x64, 0xc9, 0x59, 0x1a, 0x96, 0xe6, 0xc4, 0x9c, 0x74, 0x2c, 0xbb, 0x10, 0x11, 0x41, 0x2f,
+	0xfd, 0x0c, 0x82, 0xd7, 0x0e, 0xf1, 0x2b, 0x08, 0xbb, 0x1c, 0x5e, 0x79, 0x25, 0xb2, 0x5d, 0xec,
+	0x6b, 0x48, 0x72, 0x4e, 0xda, 0xb5, 0xa5, 0xdb, 0xac, 0x57, 0xc9, 0xfb, 0xef, 0x79, 0x9f, 0xf3,
+	0xbc, 0x79, 0x73, 0x60, 0xd6, 0x0c, 0x9b, 0xc4, 0x65, 0x36, 0x75, 0x37, 0xc3, 0x2d, 0xb5, 0x6d,
+	0xa8, 0x26, 0x71, 0xf4, 0x90, 0x98, 0xba, 0xb1, 0xae, 0x06, 0x9b, 0x8a, 0xe7, 0xd3, 0x80, 0xe2,
+	0xf3, 0x87, 0x73, 0x95, 0xb6, 0xa1, 0x74, 0x72, 0xe5, 0x69, 0x83, 0xb2, 0x26, 0x65, 0x6a, 0x93,
+	0x59, 0xea, 0xc6, 0x5c, 0xf4, 0xe0, 0xb5, 0x72, 0x81, 0x07, 0xea, 0xb1, 0xa5, 0x72, 0x43, 0x84,
+	0xa6, 0x2c, 0x6a, 0x51, 0xee, 0x8f, 0xde, 0x84, 0xb7, 0x3a, 0x80, 0x98, 0x41, 0x9b, 0x4d, 0xea,
+	0xaa, 0x3e, 0x75, 0x1c, 0xdd, 0xf3, 0xea, 0x9e, 0x6e, 0xac, 0x93, 0x40, 0xd4, 0x28, 0xc7, 0x1f,
+	0xc6, 0xd3, 0x7d, 0xbd, 0x29, 0x3a, 0x97, 0x3f, 0x21, 0x98, 0x58, 0x62, 0xd6, 0x73, 0xcf, 0xd4,
+	0x03, 0xb2, 0x1c, 0x47, 0xf0, 0x0d, 0xc8, 0xea, 0xad, 0xa0, 0x41, 0x7d, 0x3b, 0x08, 0x25, 0x54,
+	0x42, 0x95, 0x6c, 0x4d, 0xfa, 0xf1, 0xed, 0xea, 0x94, 0xa0, 0x7c, 0xcf, 0x34, 0x7d, 0xc2, 0xd8,
+	0x4a, 0xe0, 0xdb, 0xae, 0xa5, 0x75, 0x52, 0xf1, 0x22, 0x64, 0x38, 0xb6, 0x34, 0x52, 0x42, 0x95,
+	0x5c, 0xf5, 0xb2, 0x72, 0xac, 0x5a, 0x0a, 0x6f, 0x59, 0x4b, 0xef, 0xfc, 0x3a, 0x97, 0xd2, 0x44,
+	0xf9, 0x42, 0xfe, 0xed, 0xc1, 0xf6, 0x6c, 0x07, 0xb8, 0x5c, 0x80, 0xe9, 0x1e, 0x8e, 0x1a, 0x61,
+	0x1e, 0x75, 0x19, 0x29, 0x7f, 0x1d, 0x81, 0xc9, 0x25, 0x66, 0x3d, 0xb0, 0x5d, 0xdd, 0xb1, 0xb7,
+	0xc8, 0x72, 0xac, 0x05, 0x3e, 0x0d, 0x19, 0x46, 0x5c, 0x93, 0xf8, 0x9c, 0xbe, 0x26, 0x2c, 0x7c,
+	0x16, 0x20, 0x51, 0xcd, 0x36, 0x63, 0x96, 0x59, 0x2d, 0x2b, 0x3c, 0x8f, 0x4c, 0xac, 0xc0, 0x29,
+	0x2e, 0x66, 0x34, 0x23, 0xba, 0x56, 0x6f, 0x10, 0xdb, 0x6a, 0x04, 0xd2, 0x68, 0x09, 0x55, 0xd2,
+	0xda, 0x24, 0x0f, 0x2d, 0x47, 0x91, 0x87, 0x71, 0x00, 0x6b, 0x90, 0x13, 0xf9, 0x41, 0xe8, 0x11,
+	0x29, 0x5d, 0x42, 0x95, 0x7c, 0x75, 0x6e, 0xd0, 0xa9, 0xf9, 0xd8, 0x14, 0x8d, 0xb7, 0xe3, 0x4c,
+	0x95, 0x67, 0xa1, 0x47, 0x34, 0xe0, 0x28, 0xd1, 0x3b, 0xbe, 0x02, 0x58, 0x60, 0x32, 0xdf, 0xa8,
+	0x1b, 0x0d, 0xdd, 0x75, 0x89, 0x23, 0x8d, 0xc5, 0x54, 0xff, 0xe7, 0x91, 0x15, 0xdf, 0xb8, 0xcf,
+	0xfd, 0xf8, 0x12, 0x4c, 0x24, 0xd9, 0xe4, 0x55, 0x8b, 0xb8, 0x06, 0x91, 0x32, 0x31, 0xdb, 0xbc,
+	0x48, 0x15, 0xde, 0x85, 0x5c, 0x24, 0xa9, 0x90, 0xa1, 0x7c, 0x06, 0x0a, 0x7d, 0x9a, 0xb5, 0x15,
+	0x5d, 0x85, 0x99, 0xbe, 0x60, 0x2d, 0xe4, 0xcf, 0xc7, 0x24, 0x3c, 0x4a, 0x5b, 0x41, 0x65, 0x9d,
+	0x84, 0x89, 0xb6, 0x5e, 0x52, 0xd6, 0x4d, 0xe0, 0x22, 0x5c, 0x38, 0xaa, 0x47, 0x9b, 0xcb, 0x07,
+	0x04, 0xb8, 0x2f, 0x91, 0x0d, 0x3b, 0x5e, 0x09, 0xc6, 0x75, 0xfe, 0xed, 0xc6, 0x23, 0xcd, 0x6a,
+	0x89, 0x89, 0xa7, 0x60, 0xcc, 0xb1, 0x9b, 0x76, 0x10, 0x8f, 0x30, 0xad, 0x71, 0xa3, 0x9b, 0xf2,
+	0x17, 0x04, 0x72, 0x3f, 0x95, 0x84, 0x29, 0x7e, 0x09, 0xe3, 0x3e, 0x61, 0x2d, 0x27, 0x60, 0x12,
+	0x2a, 0x8d, 0x56, 0x72, 0xd5, 0x5b, 0x27, 0xfa, 0xf8, 0x23, 0x10, 0x01, 0xa9, 0x07, 0x36, 0x75,
+	0xb5, 0x18, 0x43, 0xac, 0x43, 0x82, 0x88, 0x67, 0x20, 0xbb, 0x26, 0xfa, 0xf2, 0x63, 0xa5, 0xb5,
+	0x8e, 0x23, 0x52, 0x63, 0x4d, 0xb7, 0x1d, 0x62, 0x8a, 0x0f, 0x55, 0x58, 0xe5, 0x27, 0x20, 0x0d,
+	0x6a, 0xd0, 0x33, 0x2c, 0xd4, 0x33, 0xac, 0x48, 0x0f, 0xe2, 0xfb, 0xd4, 0x17, 0x1a, 0x72, 0xa3,
+	0xfa, 0x3d, 0x0d, 0xa3, 0x4b, 0xcc, 0xc2, 0xaf, 0xe1, 0xbf, 0xae, 0xff, 0x45, 0xf5, 0x04, 0x47,
+	0xed, 0xd9, 0x5f, 0x79, 0xe1, 0xef, 0x6b, 0xda, 0x5a, 0xbf, 0x43, 0x90, 0xef, 0x59, 0xf8, 0x6b,
+	0x27, 0x83, 0xeb, 0xae, 0x92, 0x6f, 0x0f, 0x53, 0xd5, 0xa6, 0xf1, 0x19, 0x41, 0x61, 0xf0, 0x9a,
+	0xdc, 0x1d, 0x06, 0xfb, 0x10, 0x80, 0xbc, 0xf8, 0x8f, 0x00, 0x6d, 0x9e, 0xef, 0x11, 0x4c, 0xf4,
+	0x6e, 0xd0, 0xf5, 0x61, 0xc0, 0x99, 0x7c, 0x67, 0xa8, 0xb2, 0x84, 0x89, 0x3c, 0xf6, 0xe6, 0x60,
+	0x7b, 0x16, 0xd5, 0x9e, 0xee, 0xec, 0x15, 0xd1, 0xee, 0x5e, 0x11, 0xfd, 0xde, 0x2b, 0xa2, 0x8f,
+	0xfb, 0xc5, 0xd4, 0xee, 0x7e, 0x31, 0xf5, 0x73, 0xbf, 0x98, 0x7a, 0x71, 0xd3, 0xb2, 0x83, 0x46,
+	0x6b, 0x35, 0xfa, 0x55, 0xaa, 0x03, 0x2e, 0xb2, 0x8d, 0x79, 0x75, 0xb3, 0xeb, 0x6a, 0x0e, 0x3d,
+	0xc2, 0x56, 0x33, 0xf1, 0x6d, 0x36, 0xff, 0x67, 0x00, 0x32, 0x56, 0x92, 0x72, 0xcc, 0x07, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -412,6 +617,9 @@ type MsgClient interface {
 	// FinalizePacket finalizes a singe packet.
 	FinalizePacket(ctx context.Context, in *MsgFinalizePacket, opts ...grpc.CallOption) (*MsgFinalizePacketResponse, error)
 	FinalizePacketByPacketKey(ctx context.Context, in *MsgFinalizePacketByPacketKey, opts ...grpc.CallOption) (*MsgFinalizePacketByPacketKeyResponse, error)
+	// FinalizePackets finalizes the pending packets of a rollapp whose height is
+	// finalized, up to a limit.
+	FinalizePackets(ctx context.Context, in *MsgFinalizePackets, opts ...grpc.CallOption) (*MsgFinalizePacketsResponse, error)
 }
 
 type msgClient struct {
@@ -449,6 +657,15 @@ func (c *msgClient) FinalizePacketByPacketKey(ctx context.Context, in *MsgFinali
 	return out, nil
 }
 
+func (c *msgClient) FinalizePackets(ctx context.Context, in *MsgFinalizePackets, opts ...grpc.CallOption) (*MsgFinalizePacketsResponse, error) {
+	out := new(MsgFinalizePacketsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.delayedack.Msg/FinalizePackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams is used for updating module params.
@@ -456,6 +673,9 @@ type MsgServer interface {
 	// FinalizePacket finalizes a singe packet.
 	FinalizePacket(context.Context, *MsgFinalizePacket) (*MsgFinalizePacketResponse, error)
 	FinalizePacketByPacketKey(context.Context, *MsgFinalizePacketByPacketKey) (*MsgFinalizePacketByPacketKeyResponse, error)
+	// FinalizePackets finalizes the pending packets of a rollapp whose height is
+	// finalized, up to a limit.
+	FinalizePackets(context.Context, *MsgFinalizePackets) (*MsgFinalizePacketsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -471,6 +691,9 @@ func (*UnimplementedMsgServer) FinalizePacket(ctx context.Context, req *MsgFinal
 func (*UnimplementedMsgServer) FinalizePacketByPacketKey(ctx context.Context, req *MsgFinalizePacketByPacketKey) (*MsgFinalizePacketByPacketKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizePacketByPacketKey not implemented")
 }
+func (*UnimplementedMsgServer) FinalizePackets(ctx context.Context, req *MsgFinalizePackets) (*MsgFinalizePacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizePackets not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -530,6 +753,25 @@ func _Msg_FinalizePacketByPacketKey_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_FinalizePackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFinalizePackets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FinalizePackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.delayedack.Msg/FinalizePackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FinalizePackets(ctx, req.(*MsgFinalizePackets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.delayedack.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -546,6 +788,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FinalizePacketByPacketKey",
 			Handler:    _Msg_FinalizePacketByPacketKey_Handler,
 		},
+		{
+			MethodName: "FinalizePackets",
+			Handler:    _Msg_FinalizePackets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/delayedack/tx.proto",
@@ -756,6 +1002,139 @@ func (m *MsgFinalizePacketByPacketKeyResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFinalizePackets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizePackets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizePackets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFinalizePacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFinalizePacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFinalizePacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Failed != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Failed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Finalized != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Finalized))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PacketFinalizationResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketFinalizationResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketFinalizationResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PacketKey) > 0 {
+		i -= len(m.PacketKey)
+		copy(dAtA[i:], m.PacketKey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PacketKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -856,11 +1235,73 @@ func (m *MsgFinalizePacketByPacketKeyResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgFinalizePackets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgFinalizePacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Finalized != 0 {
+		n += 1 + sovTx(uint64(m.Finalized))
+	}
+	if m.Failed != 0 {
+		n += 1 + sovTx(uint64(m.Failed))
+	}
+	return n
+}
+
+func (m *PacketFinalizationResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PacketKey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -1444,6 +1885,407 @@ func (m *MsgFinalizePacketByPacketKeyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgFinalizePackets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizePackets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizePackets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFinalizePacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFinalizePacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFinalizePacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, PacketFinalizationResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			m.Finalized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finalized |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
+			}
+			m.Failed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PacketFinalizationResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketFinalizationResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketFinalizationResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
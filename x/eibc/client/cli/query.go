@@ -28,6 +28,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdListDemandOrdersByStatus())
 	cmd.AddCommand(CmdQueryOnDemandLPs())
 	cmd.AddCommand(CmdQueryOnDemandLPsAddr())
+	cmd.AddCommand(CmdQueryBatchClearingResults())
+	cmd.AddCommand(CmdQueryFulfillmentsByFulfiller())
+	cmd.AddCommand(CmdQueryFulfillmentsByLP())
+	cmd.AddCommand(CmdQueryClaimOrders())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
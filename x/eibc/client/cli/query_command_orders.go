@@ -149,3 +149,25 @@ func parseAndFormat(amount sdk.Coins) string {
 	}
 	return fmt.Sprintf("%s %s", amount[0].Amount, amount[0].Denom)
 }
+
+func CmdQueryClaimOrders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-orders [order-id]",
+		Short: "Query the holder, sell order and buy orders of the claim on a fulfilled order",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ClaimOrders(cmd.Context(), &types.QueryClaimOrdersRequest{OrderId: args[0]})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -64,3 +64,97 @@ func CmdQueryOnDemandLPsAddr() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryBatchClearingResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-clearing-results [rollapp] [denom]",
+		Short: "Query the latest batch auction clearing results, optionally filtered by rollapp and denom",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			m := &types.QueryBatchClearingResultsRequest{}
+			if len(args) > 0 {
+				m.Rollapp = args[0]
+			}
+			if len(args) > 1 {
+				m.Denom = args[1]
+			}
+
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.BatchClearingResults(cmd.Context(), m)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryFulfillmentsByFulfiller() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fulfillments [fulfiller-addr]",
+		Short: "Query the fulfillment history and profit and loss of a fulfiller",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FulfillmentsByFulfiller(cmd.Context(), &types.QueryFulfillmentsByFulfillerRequest{
+				Fulfiller:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	return cmd
+}
+
+func CmdQueryFulfillmentsByLP() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lp-fulfillments [lp-id]",
+		Short: "Query the fulfillment history and profit and loss of an on demand lp",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FulfillmentsByLP(cmd.Context(), &types.QueryFulfillmentsByLPRequest{
+				LpId:       id,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strconv"
+	"time"
 
 	math "cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/client"
@@ -25,12 +26,22 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(NewFulfillOrderTxCmd())
+	cmd.AddCommand(NewFulfillOrderPartialTxCmd())
+	cmd.AddCommand(NewFulfillOrderAltDenomTxCmd())
 	cmd.AddCommand(NewFulfillOrderAuthorizedTxCmd())
 	cmd.AddCommand(NewUpdateDemandOrderTxCmd())
+	cmd.AddCommand(NewSetDemandOrderFeeCurveTxCmd())
 	cmd.AddCommand(NewCmdGrantAuthorization())
 	cmd.AddCommand(NewCmdTryFulfillOnDemand())
 	cmd.AddCommand(NewCmdCreateOnDemandLP())
 	cmd.AddCommand(NewCmdDeleteOnDemandLP())
+	cmd.AddCommand(NewCmdTransferOrderClaim())
+	cmd.AddCommand(NewCmdPlaceClaimSellOrder())
+	cmd.AddCommand(NewCmdCancelClaimSellOrder())
+	cmd.AddCommand(NewCmdPurchaseClaim())
+	cmd.AddCommand(NewCmdPlaceClaimBuyOrder())
+	cmd.AddCommand(NewCmdCancelClaimBuyOrder())
+	cmd.AddCommand(NewCmdAcceptClaimBuyOrder())
 	return cmd
 }
 
@@ -69,13 +80,101 @@ func NewFulfillOrderTxCmd() *cobra.Command {
 	return cmd
 }
 
+func NewFulfillOrderPartialTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fulfill-order-partial [order-id] [expected-fee-amount] [amount]",
+		Short:   "Fund a part of an eibc order",
+		Example: "dymd tx eibc fulfill-order-partial <order-id> <expected-fee-amount> <amount>",
+		Long: `Fund a part of an eibc order price by providing the order ID, the expected fee amount and the amount to fund.
+		The order is fulfilled once the funded parts cover the whole price, and each part is paid out pro-rata on finalization.
+		`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			orderId := args[0]
+			fee := args[1]
+
+			amount, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid amount: %s", args[2])
+			}
+
+			msg := types.NewMsgFulfillOrderPartial(
+				clientCtx.GetFromAddress().String(),
+				orderId,
+				fee,
+				amount,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 const (
 	FlagOperatorFeeAddress = "operator-fee-address"
 	FlagRollappId          = "rollapp-id"
 	FlagPrice              = "price"
 	FlagAmount             = "amount"
+	FlagMaxFills           = "max-fills"
+	FlagWindowSpendLimit   = "window-spend-limit"
+	FlagWindowBlocks       = "window-blocks"
+	FlagExpiryHeight       = "expiry-height"
+	FlagExpiryTime         = "expiry-time"
+	FlagMaxPendingExposure = "max-pending-exposure"
 )
 
+func NewFulfillOrderAltDenomTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fulfill-order-alt-denom [order-id] [expected-fee-amount] [max-amount]",
+		Short:   "Fulfill an eibc order in its alternative denom",
+		Example: "dymd tx eibc fulfill-order-alt-denom <order-id> <expected-fee-amount> <max-amount>",
+		Long: `Fulfill an eibc order which accepts an alternative denom by providing the order ID, the expected fee amount and the max amount to pay in the alternative denom.
+		The amount paid to the recipient is priced through the pool set in the order, the original denom is received on finalization.
+		`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			orderId := args[0]
+			fee := args[1]
+
+			maxAmount, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid max amount: %s", args[2])
+			}
+
+			msg := types.NewMsgFulfillOrderAltDenom(
+				clientCtx.GetFromAddress().String(),
+				orderId,
+				fee,
+				maxAmount,
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewFulfillOrderAuthorizedTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "fulfill-order-authorized [order-id] [expected-fee-amount]",
@@ -201,32 +300,75 @@ func NewUpdateDemandOrderTxCmd() *cobra.Command {
 	return cmd
 }
 
+func NewSetDemandOrderFeeCurveTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-demand-order-fee-curve [order-id] [start-fee-amount] [end-fee-amount] [start-height] [end-height]",
+		Short:   "Make the fee of a demand order move linearly from start to end fee between the given hub heights",
+		Example: "dymd tx eibc set-demand-order-fee-curve <order-id> 10 100 1000 2000",
+		Args:    cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			startFee, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid start fee: %s", args[1])
+			}
+			endFee, ok := math.NewIntFromString(args[2])
+			if !ok {
+				return fmt.Errorf("invalid end fee: %s", args[2])
+			}
+			startHeight, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid start height: %w", err)
+			}
+			endHeight, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid end height: %w", err)
+			}
+
+			msg := types.NewMsgUpdateDemandOrderFeeCurve(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				types.FeeCurve{
+					StartFee:    startFee,
+					EndFee:      endFee,
+					StartHeight: startHeight,
+					EndHeight:   endHeight,
+				},
+			)
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewCmdTryFulfillOnDemand() *cobra.Command {
 	short := "Try to find a fulfiller for a given order and fulfill on the spot"
 	cmd := &cobra.Command{
-		Use:   "try-fulfill-on-demand [order-id] [rng]",
+		Use:   "try-fulfill-on-demand [order-id]",
 		Short: short,
-		Long:  short + " Can provide rng to avoid choosing same fulfiller multiple times (number). ",
+		Long:  short + " The eligible fulfiller asking the lowest fee is chosen.",
 
-		Args: cobra.MinimumNArgs(1),
+		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 			orderId := args[0]
-			rng := 0
-			if len(args) > 1 {
-				rng, err = strconv.Atoi(args[1])
-				if err != nil {
-					return err
-				}
-			}
 
 			msg := &types.MsgTryFulfillOnDemand{
 				Signer:  clientCtx.GetFromAddress().String(),
 				OrderId: orderId,
-				Rng:     int64(rng),
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -248,7 +390,7 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 		Use:     "create-demand-lp [rollapp] [denom] [max-price] [min-fee] [spend-limit] [order-min-age-blocks]",
 		Short:   short,
 		Long:    long,
-		Example: "dymd tx eibc create-demand-lp rollapp1 foo 1000 0.005 500 100",
+		Example: "dymd tx eibc create-demand-lp rollapp1 foo 1000 0.005 500 100 --max-fills 10 --window-spend-limit 200 --window-blocks 14400",
 
 		Args: cobra.ExactArgs(6),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -280,16 +422,47 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 				return fmt.Errorf("invalid order min age blocks: %w", err)
 			}
 
+			lp := &types.OnDemandLP{
+				FundsAddr:          clientCtx.GetFromAddress().String(),
+				Rollapp:            rollapp,
+				Denom:              denom,
+				MaxPrice:           maxPrice,
+				MinFee:             minFee,
+				SpendLimit:         spendLimit,
+				OrderMinAgeBlocks:  orderMinAgeBlocks,
+				WindowSpendLimit:   math.ZeroInt(),
+				MaxPendingExposure: math.ZeroInt(),
+			}
+
+			if lp.MaxFills, err = cmd.Flags().GetUint64(FlagMaxFills); err != nil {
+				return err
+			}
+			if lp.WindowBlocks, err = cmd.Flags().GetUint64(FlagWindowBlocks); err != nil {
+				return err
+			}
+			if lp.ExpiryHeight, err = cmd.Flags().GetUint64(FlagExpiryHeight); err != nil {
+				return err
+			}
+			if s, _ := cmd.Flags().GetString(FlagWindowSpendLimit); s != "" {
+				if lp.WindowSpendLimit, ok = math.NewIntFromString(s); !ok {
+					return fmt.Errorf("invalid window spend limit")
+				}
+			}
+			if s, _ := cmd.Flags().GetString(FlagMaxPendingExposure); s != "" {
+				if lp.MaxPendingExposure, ok = math.NewIntFromString(s); !ok {
+					return fmt.Errorf("invalid max pending exposure")
+				}
+			}
+			if s, _ := cmd.Flags().GetString(FlagExpiryTime); s != "" {
+				t, err := time.Parse(time.RFC3339, s)
+				if err != nil {
+					return fmt.Errorf("invalid expiry time: %w", err)
+				}
+				lp.ExpiryTime = &t
+			}
+
 			msg := &types.MsgCreateOnDemandLP{
-				Lp: &types.OnDemandLP{
-					FundsAddr:         clientCtx.GetFromAddress().String(),
-					Rollapp:           rollapp,
-					Denom:             denom,
-					MaxPrice:          maxPrice,
-					MinFee:            minFee,
-					SpendLimit:        spendLimit,
-					OrderMinAgeBlocks: orderMinAgeBlocks,
-				},
+				Lp:     lp,
 				Signer: clientCtx.GetFromAddress().String(),
 			}
 
@@ -300,6 +473,12 @@ func NewCmdCreateOnDemandLP() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Uint64(FlagMaxFills, 0, "Max number of orders to fulfill (0 means no limit)")
+	cmd.Flags().String(FlagWindowSpendLimit, "", "Max amount to spend per window of --window-blocks blocks")
+	cmd.Flags().Uint64(FlagWindowBlocks, 0, "Length of the spend window in blocks")
+	cmd.Flags().Uint64(FlagExpiryHeight, 0, "Height at which the lp is deleted (0 means never)")
+	cmd.Flags().String(FlagExpiryTime, "", "Time at which the lp is deleted, RFC3339")
+	cmd.Flags().String(FlagMaxPendingExposure, "", "Max amount fronted for orders not yet finalized")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+type validatedMsg interface {
+	sdk.Msg
+	ValidateBasic() error
+}
+
+// claimTxCmd builds a command sending the msg made from the sender and args
+func claimTxCmd(use, short string, nArgs int, makeMsg func(from string, args []string) (validatedMsg, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use,
+		Short: short,
+		Args:  cobra.ExactArgs(nArgs),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			msg, err := makeMsg(clientCtx.GetFromAddress().String(), args)
+			if err != nil {
+				return err
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewCmdTransferOrderClaim() *cobra.Command {
+	return claimTxCmd("transfer-order-claim [order-id] [new-holder]",
+		"Hand the claim on a fulfilled order to another account, which is paid on finalization", 2,
+		func(from string, args []string) (validatedMsg, error) {
+			return &types.MsgTransferOrderClaim{Holder: from, OrderId: args[0], NewHolder: args[1]}, nil
+		})
+}
+
+func NewCmdPlaceClaimSellOrder() *cobra.Command {
+	return claimTxCmd("sell-order-claim [order-id] [price]",
+		"List the claim on a fulfilled order for sale", 2,
+		func(from string, args []string) (validatedMsg, error) {
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgPlaceClaimSellOrder{Holder: from, OrderId: args[0], Price: price}, nil
+		})
+}
+
+func NewCmdCancelClaimSellOrder() *cobra.Command {
+	return claimTxCmd("cancel-sell-order-claim [order-id]",
+		"Cancel the sale of the claim on a fulfilled order", 1,
+		func(from string, args []string) (validatedMsg, error) {
+			return &types.MsgCancelClaimSellOrder{Holder: from, OrderId: args[0]}, nil
+		})
+}
+
+func NewCmdPurchaseClaim() *cobra.Command {
+	return claimTxCmd("purchase-order-claim [order-id] [expected-price]",
+		"Buy the claim on a fulfilled order listed for sale", 2,
+		func(from string, args []string) (validatedMsg, error) {
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgPurchaseClaim{Buyer: from, OrderId: args[0], ExpectedPrice: price}, nil
+		})
+}
+
+func NewCmdPlaceClaimBuyOrder() *cobra.Command {
+	return claimTxCmd("buy-order-claim [order-id] [price]",
+		"Offer to buy the claim on a fulfilled order, the price is escrowed until the offer is accepted or cancelled", 2,
+		func(from string, args []string) (validatedMsg, error) {
+			price, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgPlaceClaimBuyOrder{Buyer: from, OrderId: args[0], Price: price}, nil
+		})
+}
+
+func NewCmdCancelClaimBuyOrder() *cobra.Command {
+	return claimTxCmd("cancel-buy-order-claim [order-id]",
+		"Cancel an offer to buy the claim on a fulfilled order and get the escrow back", 1,
+		func(from string, args []string) (validatedMsg, error) {
+			return &types.MsgCancelClaimBuyOrder{Buyer: from, OrderId: args[0]}, nil
+		})
+}
+
+func NewCmdAcceptClaimBuyOrder() *cobra.Command {
+	return claimTxCmd("accept-buy-order-claim [order-id] [buyer] [min-price]",
+		"Sell the claim on a fulfilled order to an account which offered to buy it", 3,
+		func(from string, args []string) (validatedMsg, error) {
+			price, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return nil, err
+			}
+			return &types.MsgAcceptClaimBuyOrder{Holder: from, OrderId: args[0], Buyer: args[1], MinPrice: price}, nil
+		})
+}
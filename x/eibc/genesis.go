@@ -31,6 +31,18 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+
+	for _, so := range genState.ClaimSellOrders {
+		if err := k.Claims.SetSellOrder(ctx, so); err != nil {
+			panic(err)
+		}
+	}
+	// the escrow of the buy orders is held by the module account, restored by the bank genesis
+	for _, bo := range genState.ClaimBuyOrders {
+		if err := k.Claims.SetBuyOrder(ctx, bo); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -58,5 +70,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		genesis.DemandOrders[i] = orderCopy
 	}
 
+	genesis.ClaimSellOrders, err = k.Claims.GetAllSellOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ClaimBuyOrders, err = k.Claims.GetAllBuyOrders(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return genesis
 }
@@ -100,3 +100,48 @@ func TestExportGenesis(t *testing.T) {
 
 	require.ElementsMatch(t, expectedDemandOrders, got.DemandOrders, "DemandOrders should match after encoding TrackingPacketKey")
 }
+
+func TestInitExportGenesisClaims(t *testing.T) {
+	const (
+		holder = "dym19pas0pqwje540u5ptwnffjxeamdxc9tajmdrfa"
+		buyer  = "dym15saxgqw6kvhv6k5sg6r45kmdf4sf88kfw2adcw"
+	)
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		DemandOrders: []types.DemandOrder{
+			{
+				Id:                   "1",
+				TrackingPacketKey:    base64.StdEncoding.EncodeToString([]byte("11/22/33")),
+				Price:                sdk.Coins{sdk.Coin{Denom: "adym", Amount: math.NewInt(150)}},
+				Fee:                  sdk.Coins{sdk.Coin{Denom: "adym", Amount: math.NewInt(50)}},
+				Recipient:            "dym17g9cn4ss0h0dz5qhg2cg4zfnee6z3ftg3q6v58",
+				FulfillerAddress:     holder,
+				TrackingPacketStatus: commontypes.Status_PENDING,
+				CreationHeight:       1,
+			},
+		},
+		ClaimSellOrders: []types.ClaimSellOrder{
+			{OrderId: "1", Seller: holder, Price: sdk.NewInt64Coin("adym", 180)},
+		},
+		ClaimBuyOrders: []types.ClaimBuyOrder{
+			{OrderId: "1", Buyer: buyer, Price: sdk.NewInt64Coin("adym", 170)},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.EIBCKeeper(t)
+	eibc.InitGenesis(ctx, *k, genesisState)
+
+	so, err := k.Claims.GetSellOrder(ctx, "1")
+	require.NoError(t, err)
+	require.Equal(t, genesisState.ClaimSellOrders[0], *so)
+	bo, err := k.Claims.GetBuyOrder(ctx, "1", buyer)
+	require.NoError(t, err)
+	require.Equal(t, genesisState.ClaimBuyOrders[0], *bo)
+
+	got := eibc.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+	require.NoError(t, got.Validate())
+	require.ElementsMatch(t, genesisState.ClaimSellOrders, got.ClaimSellOrders)
+	require.ElementsMatch(t, genesisState.ClaimBuyOrders, got.ClaimBuyOrders)
+}
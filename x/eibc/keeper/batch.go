@@ -0,0 +1,252 @@
+package keeper
+
+import (
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+)
+
+var (
+	BatchClearingResultsPrefix = collections.NewPrefix("batch0")
+	BatchCursorPrefix          = collections.NewPrefix("batch1")
+)
+
+func makeBatchClearingResultsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) collections.Map[collections.Pair[string, string], types.BatchClearingResult] {
+	return collections.NewMap(
+		sb, BatchClearingResultsPrefix, "batchClearingResults",
+		collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		codec.CollValue[types.BatchClearingResult](cdc),
+	)
+}
+
+func makeBatchCursorStore(sb *collections.SchemaBuilder) collections.Item[string] {
+	return collections.NewItem(
+		sb, BatchCursorPrefix, "batchCursor",
+		collections.StringValue,
+	)
+}
+
+type batchKey struct {
+	rollapp string
+	denom   string
+}
+
+// MatchBatch runs the end block batch auction. Pending unfulfilled orders are grouped by (rollapp, denom)
+// and matched against the on demand lps of the same pair. Orders paying the highest fee are served first,
+// and each one goes to the compatible lp asking for the lowest fee. Ties are broken by order id and lp id,
+// so the outcome does not depend on anything but state. Each batch continues after the last order of the
+// previous one, so orders left unmatched do not keep the others out of the limit. A group failing to match
+// is logged and does not affect the other groups.
+func (k Keeper) MatchBatch(ctx sdk.Context) error {
+	limit := k.BatchMatchLimit(ctx)
+	if limit == 0 {
+		return nil
+	}
+	orders, err := k.batchOrders(ctx, int(limit)) //nolint:gosec
+	if err != nil {
+		return errorsmod.Wrap(err, "list pending orders")
+	}
+
+	groups := make(map[batchKey][]*types.DemandOrder)
+	var keys []batchKey
+	for _, o := range orders {
+		key := batchKey{rollapp: o.RollappId, denom: o.Denom()}
+		if _, ok := groups[key]; !ok {
+			keys = append(keys, key)
+		}
+		groups[key] = append(groups[key], o)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].rollapp != keys[j].rollapp {
+			return keys[i].rollapp < keys[j].rollapp
+		}
+		return keys[i].denom < keys[j].denom
+	})
+
+	for _, key := range keys {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			res, err := k.matchBatchGroup(ctx, key, groups[key])
+			if err != nil {
+				return errorsmod.Wrap(err, "match batch group")
+			}
+			return errorsmod.Wrap(k.batchResults.Set(ctx, collections.Join(key.rollapp, key.denom), res), "set clearing result")
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Batch match group.", "rollapp", key.rollapp, "denom", key.denom, "err", err)
+		}
+	}
+	return nil
+}
+
+// batchOrders returns up to limit pending unfulfilled orders following the cursor, wrapping around to the
+// first ones, and moves the cursor to the last of them.
+func (k Keeper) batchOrders(ctx sdk.Context, limit int) ([]*types.DemandOrder, error) {
+	cursor, err := k.batchCursor.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(err, "get cursor")
+	}
+
+	first, err := types.GetDemandOrderKey(commontypes.Status_PENDING, "")
+	if err != nil {
+		return nil, err
+	}
+	after, err := types.GetDemandOrderKey(commontypes.Status_PENDING, cursor)
+	if err != nil {
+		return nil, err
+	}
+	after = append(after, 0)
+
+	orders := k.unfulfilledOrdersInRange(ctx, after, storetypes.PrefixEndBytes(first), limit)
+	if cursor != "" && len(orders) < limit {
+		orders = append(orders, k.unfulfilledOrdersInRange(ctx, first, after, limit-len(orders))...)
+	}
+
+	if len(orders) == 0 {
+		return nil, errorsmod.Wrap(k.batchCursor.Remove(ctx), "remove cursor")
+	}
+	return orders, errorsmod.Wrap(k.batchCursor.Set(ctx, orders[len(orders)-1].Id), "set cursor")
+}
+
+func (k Keeper) unfulfilledOrdersInRange(ctx sdk.Context, start, end []byte, limit int) (list []*types.DemandOrder) {
+	iterator := ctx.KVStore(k.storeKey).Iterator(start, end)
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid() && len(list) < limit; iterator.Next() {
+		var val types.DemandOrder
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		if !val.IsFulfilled() {
+			list = append(list, &val)
+		}
+	}
+	return
+}
+
+func (k Keeper) matchBatchGroup(ctx sdk.Context, key batchKey, orders []*types.DemandOrder) (types.BatchClearingResult, error) {
+	res := types.BatchClearingResult{
+		Rollapp: key.rollapp,
+		Denom:   key.denom,
+		Height:  ctx.BlockHeight(),
+	}
+
+	lps, err := k.LPs.getByRollAppDenom(ctx, key.rollapp, key.denom)
+	if err != nil {
+		return res, errorsmod.Wrap(err, "get lps")
+	}
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	for _, o := range orders {
+		o.ApplyFeeCurve(h)
+	}
+	sortLPsByBestFee(lps)
+	sortOrdersByBestFee(orders)
+
+	for _, o := range orders {
+		// orders which are about to be finalized are not worth fulfilling
+		o, err := k.GetOutstandingOrder(ctx, o.Id)
+		if err != nil {
+			continue
+		}
+		matched := false
+		for i := 0; i < len(lps) && !matched; i++ {
+			lp := &lps[i]
+			if !lp.Accepts(h, ctx.BlockTime(), o) {
+				continue
+			}
+			err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.fulfillBasic(ctx, o, lp.Lp.MustAddr())
+			})
+			if errorsmod.IsOf(err, sdkerrors.ErrInsufficientFunds) {
+				if err := k.LPs.Del(ctx, lp.Id, "out of funds"); err != nil {
+					return res, errorsmod.Wrapf(err, "delete lp: %d", lp.Id)
+				}
+				lps = append(lps[:i], lps[i+1:]...)
+				i--
+				continue
+			}
+			if err != nil {
+				ctx.Logger().Error("Batch match: fulfill.", "order", o.Id, "lp", lp.Id, "err", err)
+				break
+			}
+			if err = uevent.EmitTypedEvent(ctx, &types.EventMatchedOnDemandLP{
+				OrderId:   o.Id,
+				LpId:      lp.Id,
+				Fulfiller: lp.Lp.FundsAddr,
+			}); err != nil {
+				return res, errorsmod.Wrap(err, "emit event")
+			}
+			if err = k.recordLPFill(ctx, lp, o); err != nil {
+				return res, errorsmod.Wrap(err, "record fill")
+			}
+			res.Matches = append(res.Matches, types.BatchMatch{
+				OrderId:    o.Id,
+				LpId:       lp.Id,
+				Fulfiller:  lp.Lp.FundsAddr,
+				Price:      o.PriceAmount(),
+				Fee:        o.GetFeeAmount(),
+				FeePercent: o.GetFeePercent(),
+				LpMinFee:   lp.Lp.MinFee,
+			})
+			matched = true
+		}
+		if !matched {
+			res.Unmatched++
+		}
+	}
+	return res, nil
+}
+
+// highest fee first, then lowest id
+func sortOrdersByBestFee(orders []*types.DemandOrder) {
+	sort.SliceStable(orders, func(i, j int) bool {
+		fi, fj := orders[i].GetFeePercent(), orders[j].GetFeePercent()
+		if !fi.Equal(fj) {
+			return fi.GT(fj)
+		}
+		return orders[i].Id < orders[j].Id
+	})
+}
+
+// lowest min fee first, then lowest id
+func sortLPsByBestFee(lps []types.OnDemandLPRecord) {
+	sort.SliceStable(lps, func(i, j int) bool {
+		fi, fj := lps[i].Lp.MinFee, lps[j].Lp.MinFee
+		if !fi.Equal(fj) {
+			return fi.LT(fj)
+		}
+		return lps[i].Id < lps[j].Id
+	})
+}
+
+func (k Keeper) GetBatchClearingResult(ctx sdk.Context, rollapp, denom string) (*types.BatchClearingResult, error) {
+	res, err := k.batchResults.Get(ctx, collections.Join(rollapp, denom))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(gerrc.ErrNotFound, "clearing result: rollapp: %s: denom: %s", rollapp, denom)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// GetBatchClearingResults returns the latest clearing results, optionally restricted to a rollapp.
+func (k Keeper) GetBatchClearingResults(ctx sdk.Context, rollapp string) ([]types.BatchClearingResult, error) {
+	var rng collections.Ranger[collections.Pair[string, string]]
+	if rollapp != "" {
+		rng = collections.NewPrefixedPairRange[string, string](rollapp)
+	}
+	iter, err := k.batchResults.Iterate(ctx, rng)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
@@ -0,0 +1,131 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// the best paying order goes to the lp asking for the lowest fee, ties are broken by lp id
+func (suite *KeeperTestSuite) TestMatchBatch() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx.WithBlockHeight(10)
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, ctx, 4, math.NewInt(1000))
+	orderAddr, lpAddrA, lpAddrB, lpAddrC := addrs[0], addrs[1], addrs[2], addrs[3]
+
+	createLP := func(addr sdk.AccAddress, minFee string) uint64 {
+		id, err := k.LPs.Create(ctx, &types.OnDemandLP{
+			FundsAddr:  addr.String(),
+			Rollapp:    rollappPacket.RollappId,
+			Denom:      denom,
+			MaxPrice:   math.NewInt(500),
+			MinFee:     math.LegacyMustNewDecFromStr(minFee),
+			SpendLimit: math.NewInt(500),
+		})
+		suite.Require().NoError(err)
+		return id
+	}
+	createLP(lpAddrA, "0.1")
+	best := createLP(lpAddrB, "0.05")
+	createLP(lpAddrC, "0.05")
+
+	// pays a 20% fee
+	suite.App.DelayedAckKeeper.SetRollappPacket(ctx, *rollappPacket)
+	order := types.NewDemandOrder(*rollappPacket, math.NewInt(100), math.NewInt(20), denom, orderAddr.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(ctx, order))
+
+	// pays a 1% fee, too low for any lp
+	cheapPacket := packet
+	cheapPacket.Sequence = 2
+	cheapRollappPacket := *rollappPacket
+	cheapRollappPacket.Packet = &cheapPacket
+	suite.App.DelayedAckKeeper.SetRollappPacket(ctx, cheapRollappPacket)
+	cheapOrder := types.NewDemandOrder(cheapRollappPacket, math.NewInt(100), math.NewInt(1), denom, orderAddr.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(ctx, cheapOrder))
+
+	err := k.MatchBatch(ctx)
+	suite.Require().NoError(err)
+
+	order, err = k.GetDemandOrder(ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lpAddrB.String(), order.FulfillerAddress)
+	suite.Require().Equal(math.NewInt(900), suite.App.BankKeeper.GetBalance(ctx, lpAddrB, denom).Amount)
+
+	cheapOrder, err = k.GetDemandOrder(ctx, commontypes.Status_PENDING, cheapOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().False(cheapOrder.IsFulfilled())
+
+	lp, err := k.LPs.Get(ctx, best)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(100), lp.Spent)
+
+	res, err := suite.queryClient.BatchClearingResults(ctx, &types.QueryBatchClearingResultsRequest{
+		Rollapp: rollappPacket.RollappId,
+		Denom:   denom,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Results, 1)
+	result := res.Results[0]
+	suite.Require().Equal(int64(10), result.Height)
+	suite.Require().Equal(uint64(1), result.Unmatched)
+	suite.Require().Len(result.Matches, 1)
+	suite.Require().Equal(order.Id, result.Matches[0].OrderId)
+	suite.Require().Equal(best, result.Matches[0].LpId)
+	suite.Require().Equal(math.LegacyMustNewDecFromStr("0.05"), result.Matches[0].LpMinFee)
+}
+
+// orders left unmatched do not keep the next ones out of the batch limit
+func (suite *KeeperTestSuite) TestMatchBatchCursor() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx.WithBlockHeight(10)
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, ctx, 2, math.NewInt(1000))
+	orderAddr, lpAddr := addrs[0], addrs[1]
+
+	params := k.GetParams(ctx)
+	params.BatchMatchLimit = 1
+	k.SetParams(ctx, params)
+
+	_, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		FundsAddr:  lpAddr.String(),
+		Rollapp:    rollappPacket.RollappId,
+		Denom:      denom,
+		MaxPrice:   math.NewInt(500),
+		MinFee:     math.LegacyMustNewDecFromStr("0.05"),
+		SpendLimit: math.NewInt(500),
+	})
+	suite.Require().NoError(err)
+
+	// pays a 20% fee
+	suite.App.DelayedAckKeeper.SetRollappPacket(ctx, *rollappPacket)
+	order := types.NewDemandOrder(*rollappPacket, math.NewInt(100), math.NewInt(20), denom, orderAddr.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(ctx, order))
+
+	// pays a 1% fee, too low for the lp
+	cheapPacket := packet
+	cheapPacket.Sequence = 2
+	cheapRollappPacket := *rollappPacket
+	cheapRollappPacket.Packet = &cheapPacket
+	suite.App.DelayedAckKeeper.SetRollappPacket(ctx, cheapRollappPacket)
+	cheapOrder := types.NewDemandOrder(cheapRollappPacket, math.NewInt(100), math.NewInt(1), denom, orderAddr.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(ctx, cheapOrder))
+
+	// whichever order comes first, both are considered within two batches
+	for range 2 {
+		suite.Require().NoError(k.MatchBatch(ctx))
+	}
+	order, err = k.GetDemandOrder(ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lpAddr.String(), order.FulfillerAddress)
+
+	// the cheap order keeps being retried alone
+	suite.Require().NoError(k.MatchBatch(ctx))
+	res, err := k.GetBatchClearingResult(ctx, rollappPacket.RollappId, denom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Unmatched)
+	suite.Require().Empty(res.Matches)
+}
@@ -0,0 +1,266 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+var (
+	ClaimSellOrdersPrefix = collections.NewPrefix("claim0")
+	ClaimBuyOrdersPrefix  = collections.NewPrefix("claim1")
+)
+
+// Claims is the market for the claims on fulfilled orders, i.e. the right to receive the
+// packet funds on finalization.
+type Claims struct {
+	// order id -> sell order
+	sellOrders collections.Map[string, types.ClaimSellOrder]
+	// <order id,buyer> -> buy order
+	buyOrders collections.Map[collections.Pair[string, string], types.ClaimBuyOrder]
+}
+
+func makeClaimsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) Claims {
+	return Claims{
+		sellOrders: collections.NewMap(
+			sb, ClaimSellOrdersPrefix, "claimSellOrders",
+			collections.StringKey, codec.CollValue[types.ClaimSellOrder](cdc),
+		),
+		buyOrders: collections.NewMap(
+			sb, ClaimBuyOrdersPrefix, "claimBuyOrders",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.ClaimBuyOrder](cdc),
+		),
+	}
+}
+
+func (s Claims) GetSellOrder(ctx sdk.Context, orderID string) (*types.ClaimSellOrder, error) {
+	so, err := s.sellOrders.Get(ctx, orderID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(gerrc.ErrNotFound, "claim sell order: %s", orderID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &so, nil
+}
+
+func (s Claims) GetBuyOrder(ctx sdk.Context, orderID, buyer string) (*types.ClaimBuyOrder, error) {
+	bo, err := s.buyOrders.Get(ctx, collections.Join(orderID, buyer))
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrapf(gerrc.ErrNotFound, "claim buy order: %s: buyer: %s", orderID, buyer)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &bo, nil
+}
+
+func (s Claims) GetBuyOrders(ctx sdk.Context, orderID string) ([]types.ClaimBuyOrder, error) {
+	iter, err := s.buyOrders.Iterate(ctx, collections.NewPrefixedPairRange[string, string](orderID))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (s Claims) SetSellOrder(ctx sdk.Context, so types.ClaimSellOrder) error {
+	return s.sellOrders.Set(ctx, so.OrderId, so)
+}
+
+func (s Claims) SetBuyOrder(ctx sdk.Context, bo types.ClaimBuyOrder) error {
+	return s.buyOrders.Set(ctx, collections.Join(bo.OrderId, bo.Buyer), bo)
+}
+
+func (s Claims) GetAllSellOrders(ctx sdk.Context) ([]types.ClaimSellOrder, error) {
+	iter, err := s.sellOrders.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (s Claims) GetAllBuyOrders(ctx sdk.Context) ([]types.ClaimBuyOrder, error) {
+	iter, err := s.buyOrders.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// getClaim returns the order if its claim can change hands and is held by holder
+func (k Keeper) getClaim(ctx sdk.Context, orderID string, holder string) (*types.DemandOrder, error) {
+	o, err := k.getTransferableClaim(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if o.FulfillerAddress != holder {
+		return nil, errorsmod.Wrapf(types.ErrNotClaimHolder, "holder: %s", o.FulfillerAddress)
+	}
+	return o, nil
+}
+
+// getTransferableClaim returns the order if it is fulfilled and its packet is not settled yet. Claims
+// of partially funded orders are held by the module on behalf of the shares and cannot change hands.
+func (k Keeper) getTransferableClaim(ctx sdk.Context, orderID string) (*types.DemandOrder, error) {
+	o, err := k.GetDemandOrder(ctx, commontypes.Status_PENDING, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if !o.IsFulfilled() {
+		return nil, errorsmod.Wrap(types.ErrClaimNotTransferable, "order not fulfilled")
+	}
+	if o.IsPartiallyFunded() {
+		return nil, errorsmod.Wrap(types.ErrClaimNotTransferable, "order partially funded")
+	}
+	return o, nil
+}
+
+// transferClaim hands the claim on the order to the new holder, who then receives the packet funds on
+// finalization, and its fulfillment record. The sell order of the previous holder is closed and the buy
+// order of the new holder, if any, is refunded. price is only used for the event.
+func (k Keeper) transferClaim(ctx sdk.Context, o *types.DemandOrder, to sdk.AccAddress, price *sdk.Coin) error {
+	from := o.FulfillerAddress
+	if err := k.ensureAccount(ctx, to); err != nil {
+		return errorsmod.Wrap(err, "ensure new holder account")
+	}
+
+	o.FulfillerAddress = to.String()
+	if err := k.SetDemandOrder(ctx, o); err != nil {
+		return err
+	}
+	if err := k.hooks.AfterDemandOrderClaimTransferred(ctx, o, to.String()); err != nil {
+		return errorsmod.Wrap(err, "after claim transferred")
+	}
+
+	// an lp selling the claim is no longer exposed to the order
+	if err := k.LPs.ReleaseFill(ctx, o.Id); err != nil {
+		return errorsmod.Wrap(err, "release lp fill")
+	}
+	if err := k.Fulfillments.Transfer(ctx, o.Id, to.String()); err != nil {
+		return errorsmod.Wrap(err, "transfer fulfillment")
+	}
+
+	if err := k.Claims.sellOrders.Remove(ctx, o.Id); err != nil {
+		return errorsmod.Wrap(err, "remove sell order")
+	}
+	if err := k.cancelClaimBuyOrder(ctx, o.Id, to); err != nil && !errorsmod.IsOf(err, gerrc.ErrNotFound) {
+		return errorsmod.Wrap(err, "cancel buy order of new holder")
+	}
+
+	event := &types.EventOrderClaimTransferred{
+		OrderId: o.Id,
+		From:    from,
+		To:      to.String(),
+	}
+	if price != nil {
+		event.Price = price.String()
+	}
+	if err := uevent.EmitTypedEvent(ctx, event); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+func (k Keeper) placeClaimSellOrder(ctx sdk.Context, o *types.DemandOrder, price sdk.Coin) error {
+	return k.Claims.SetSellOrder(ctx, types.ClaimSellOrder{
+		OrderId: o.Id,
+		Seller:  o.FulfillerAddress,
+		Price:   price,
+	})
+}
+
+// purchaseClaim pays the seller the price of the sell order and transfers the claim to the buyer
+func (k Keeper) purchaseClaim(ctx sdk.Context, o *types.DemandOrder, buyer sdk.AccAddress, expectedPrice sdk.Coin) error {
+	so, err := k.Claims.GetSellOrder(ctx, o.Id)
+	if err != nil {
+		return err
+	}
+	if !so.Price.Equal(expectedPrice) {
+		return errorsmod.Wrapf(types.ErrClaimPriceMismatch, "price: %s", so.Price)
+	}
+	if buyer.String() == so.Seller {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "buyer is the seller")
+	}
+	err = k.bk.SendCoins(ctx, buyer, sdk.MustAccAddressFromBech32(so.Seller), sdk.NewCoins(so.Price))
+	if err != nil {
+		return errorsmod.Wrap(err, "pay seller")
+	}
+	return k.transferClaim(ctx, o, buyer, &so.Price)
+}
+
+// placeClaimBuyOrder escrows the offered price in the module account. An existing offer of the
+// buyer is replaced, its escrow being refunded first.
+func (k Keeper) placeClaimBuyOrder(ctx sdk.Context, o *types.DemandOrder, buyer sdk.AccAddress, price sdk.Coin) error {
+	if buyer.String() == o.FulfillerAddress {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "buyer is the holder")
+	}
+	if err := k.cancelClaimBuyOrder(ctx, o.Id, buyer); err != nil && !errorsmod.IsOf(err, gerrc.ErrNotFound) {
+		return errorsmod.Wrap(err, "cancel previous buy order")
+	}
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	if err := k.bk.SendCoins(ctx, buyer, escrow, sdk.NewCoins(price)); err != nil {
+		return errorsmod.Wrap(err, "send coins to escrow")
+	}
+	return k.Claims.SetBuyOrder(ctx, types.ClaimBuyOrder{
+		OrderId: o.Id,
+		Buyer:   buyer.String(),
+		Price:   price,
+	})
+}
+
+// cancelClaimBuyOrder deletes the buy order and refunds its escrow
+func (k Keeper) cancelClaimBuyOrder(ctx sdk.Context, orderID string, buyer sdk.AccAddress) error {
+	bo, err := k.Claims.GetBuyOrder(ctx, orderID, buyer.String())
+	if err != nil {
+		return err
+	}
+	if err := k.Claims.buyOrders.Remove(ctx, collections.Join(orderID, bo.Buyer)); err != nil {
+		return errorsmod.Wrap(err, "remove buy order")
+	}
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	if err := k.bk.SendCoins(ctx, escrow, buyer, sdk.NewCoins(bo.Price)); err != nil {
+		return errorsmod.Wrap(err, "refund buy order")
+	}
+	return nil
+}
+
+// acceptClaimBuyOrder pays the holder the escrowed offer of the buyer and transfers the claim to them
+func (k Keeper) acceptClaimBuyOrder(ctx sdk.Context, o *types.DemandOrder, buyer sdk.AccAddress, minPrice sdk.Coin) error {
+	bo, err := k.Claims.GetBuyOrder(ctx, o.Id, buyer.String())
+	if err != nil {
+		return err
+	}
+	if bo.Price.Denom != minPrice.Denom || bo.Price.IsLT(minPrice) {
+		return errorsmod.Wrapf(types.ErrClaimPriceMismatch, "price: %s", bo.Price)
+	}
+	if err := k.Claims.buyOrders.Remove(ctx, collections.Join(o.Id, bo.Buyer)); err != nil {
+		return errorsmod.Wrap(err, "remove buy order")
+	}
+	escrow := authtypes.NewModuleAddress(types.ModuleName)
+	err = k.bk.SendCoins(ctx, escrow, sdk.MustAccAddressFromBech32(o.FulfillerAddress), sdk.NewCoins(bo.Price))
+	if err != nil {
+		return errorsmod.Wrap(err, "pay holder")
+	}
+	return k.transferClaim(ctx, o, buyer, &bo.Price)
+}
+
+// closeClaimSellOrder is called once the packet of the order is settled, and the claim can no longer
+// change hands. The sell order is deleted. The buy orders are left for their buyers to cancel, which
+// refunds them, so that settling a packet does not depend on how many offers its claim got.
+func (k Keeper) closeClaimSellOrder(ctx sdk.Context, orderID string) error {
+	if err := k.Claims.sellOrders.Remove(ctx, orderID); err != nil {
+		return errorsmod.Wrap(err, "remove sell order")
+	}
+	return nil
+}
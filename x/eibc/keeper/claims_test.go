@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// the claim on a fulfilled order is sold twice, and the packet follows the last holder
+func (suite *KeeperTestSuite) TestOrderClaimMarket() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 5, math.NewInt(1000))
+	recipient, fulfiller, buyer, bidder, loser := addrs[0], addrs[1], addrs[2], addrs[3], addrs[4]
+	balance := func(addr sdk.AccAddress) math.Int {
+		return suite.App.BankKeeper.GetBalance(suite.Ctx, addr, denom).Amount
+	}
+	packetReceiver := func() string {
+		p, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(rollappPacket.RollappPacketKey()))
+		suite.Require().NoError(err)
+		data, err := p.GetTransferPacketData()
+		suite.Require().NoError(err)
+		return data.Receiver
+	}
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	o := types.NewDemandOrder(*rollappPacket, math.NewInt(200), math.NewInt(20), denom, recipient.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, o))
+
+	// only fulfilled orders have a claim
+	_, err := suite.msgServer.PlaceClaimSellOrder(suite.Ctx, &types.MsgPlaceClaimSellOrder{
+		Holder: fulfiller.String(), OrderId: o.Id, Price: sdk.NewInt64Coin(denom, 150),
+	})
+	suite.Require().ErrorIs(err, types.ErrClaimNotTransferable)
+
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), o.Id, "20"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(fulfiller.String(), packetReceiver())
+
+	// only the holder can sell
+	_, err = suite.msgServer.PlaceClaimSellOrder(suite.Ctx, &types.MsgPlaceClaimSellOrder{
+		Holder: buyer.String(), OrderId: o.Id, Price: sdk.NewInt64Coin(denom, 150),
+	})
+	suite.Require().ErrorIs(err, types.ErrNotClaimHolder)
+
+	_, err = suite.msgServer.PlaceClaimSellOrder(suite.Ctx, &types.MsgPlaceClaimSellOrder{
+		Holder: fulfiller.String(), OrderId: o.Id, Price: sdk.NewInt64Coin(denom, 150),
+	})
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.PurchaseClaim(suite.Ctx, &types.MsgPurchaseClaim{
+		Buyer: buyer.String(), OrderId: o.Id, ExpectedPrice: sdk.NewInt64Coin(denom, 100),
+	})
+	suite.Require().ErrorIs(err, types.ErrClaimPriceMismatch)
+
+	_, err = suite.msgServer.PurchaseClaim(suite.Ctx, &types.MsgPurchaseClaim{
+		Buyer: buyer.String(), OrderId: o.Id, ExpectedPrice: sdk.NewInt64Coin(denom, 150),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000-200+150), balance(fulfiller))
+	suite.Require().Equal(math.NewInt(1000-150), balance(buyer))
+	suite.Require().Equal(buyer.String(), packetReceiver())
+
+	// two offers, the best one is accepted and the other is left to be cancelled
+	for _, bo := range []*types.MsgPlaceClaimBuyOrder{
+		{Buyer: bidder.String(), OrderId: o.Id, Price: sdk.NewInt64Coin(denom, 170)},
+		{Buyer: loser.String(), OrderId: o.Id, Price: sdk.NewInt64Coin(denom, 160)},
+	} {
+		_, err = suite.msgServer.PlaceClaimBuyOrder(suite.Ctx, bo)
+		suite.Require().NoError(err)
+	}
+	suite.Require().Equal(math.NewInt(1000-160), balance(loser))
+
+	res, err := suite.queryClient.ClaimOrders(suite.Ctx, &types.QueryClaimOrdersRequest{OrderId: o.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(buyer.String(), res.Holder)
+	suite.Require().Nil(res.SellOrder)
+	suite.Require().Len(res.BuyOrders, 2)
+
+	_, err = suite.msgServer.AcceptClaimBuyOrder(suite.Ctx, &types.MsgAcceptClaimBuyOrder{
+		Holder: buyer.String(), OrderId: o.Id, Buyer: bidder.String(), MinPrice: sdk.NewInt64Coin(denom, 170),
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000-150+170), balance(buyer))
+	suite.Require().Equal(math.NewInt(1000-170), balance(bidder))
+	suite.Require().Equal(bidder.String(), packetReceiver())
+
+	p, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(rollappPacket.RollappPacketKey()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(eibcReceiverAddr.String(), p.OriginalTransferTarget)
+
+	// the fulfillment record follows the claim
+	f, err := k.Fulfillments.Get(suite.Ctx, o.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(bidder.String(), f.Fulfiller)
+	pnl, err := k.Fulfillments.GetFulfillerPnL(suite.Ctx, fulfiller.String())
+	suite.Require().NoError(err)
+	suite.Require().Zero(pnl.Fills)
+	suite.Require().True(pnl.Outstanding.IsZero())
+
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *p)
+	suite.Require().NoError(err)
+	pnl, err = k.Fulfillments.GetFulfillerPnL(suite.Ctx, bidder.String())
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), pnl.Finalized)
+
+	// the other offer is refunded when cancelled
+	suite.Require().Equal(math.NewInt(1000-160), balance(loser))
+	_, err = suite.msgServer.CancelClaimBuyOrder(suite.Ctx, &types.MsgCancelClaimBuyOrder{Buyer: loser.String(), OrderId: o.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000), balance(loser))
+
+	got, err := k.GetDemandOrder(suite.Ctx, commontypes.Status_FINALIZED, o.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(bidder.String(), got.FulfillerAddress)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
@@ -14,6 +15,8 @@ func (k Keeper) fulfillBasic(ctx sdk.Context,
 	o *types.DemandOrder,
 	fulfiller sdk.AccAddress,
 ) error {
+	o.ApplyFeeCurve(uint64(ctx.BlockHeight())) //nolint:gosec
+
 	err := k.fulfill(ctx, o, fulfillArgs{
 		FundsSource: fulfiller,
 		Fulfiller:   fulfiller,
@@ -29,9 +32,81 @@ func (k Keeper) fulfillBasic(ctx sdk.Context,
 	return nil
 }
 
+// fulfillAltDenom fulfills the order paying the recipient in the alternative denom accepted by the order.
+// The fulfiller still receives the original denom on finalization.
+func (k Keeper) fulfillAltDenom(ctx sdk.Context,
+	o *types.DemandOrder,
+	fulfiller sdk.AccAddress,
+	maxAmount math.Int,
+) (sdk.Coin, error) {
+	o.ApplyFeeCurve(uint64(ctx.BlockHeight())) //nolint:gosec
+
+	paid, err := k.QuoteAltFulfillment(ctx, o)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if paid.Amount.GT(maxAmount) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrAltAmountTooHigh, "amount: %s: max: %s", paid.Amount, maxAmount)
+	}
+
+	err = k.fulfill(ctx, o, fulfillArgs{
+		FundsSource: fulfiller,
+		Fulfiller:   fulfiller,
+		Payment:     sdk.NewCoins(paid),
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	event := types.GetFulfilledEvent(o)
+	event.Paid = paid.String()
+	event.PriceSource = o.AltFulfillment.PriceSource()
+	if err = uevent.EmitTypedEvent(ctx, event); err != nil {
+		return sdk.Coin{}, fmt.Errorf("emit event: %w", err)
+	}
+
+	return paid, nil
+}
+
+// QuoteAltFulfillment returns what the recipient of the order is owed in its alternative denom,
+// that is what swapping the price through the pool of the order would yield, swap fee included.
+// It fails if that is worse than the reference price of the order, the pool spot price when it was
+// created, by more than the max slippage of the order. So that the pool cannot be moved before
+// the reference is taken, it also fails in the block the order was created in.
+func (k Keeper) QuoteAltFulfillment(ctx sdk.Context, o *types.DemandOrder) (sdk.Coin, error) {
+	alt := o.AltFulfillment
+	if alt == nil {
+		return sdk.Coin{}, types.ErrNoAltFulfillment
+	}
+	if uint64(ctx.BlockHeight()) <= o.CreationHeight { //nolint:gosec
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrAltFulfillmentTooEarly, "created at: %d", o.CreationHeight)
+	}
+
+	pool, err := k.gk.GetPoolAndPoke(ctx, alt.PoolId)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrapf(err, "get pool: %d", alt.PoolId)
+	}
+	out, err := pool.CalcOutAmtGivenIn(ctx, o.Price, alt.Denom, pool.GetSwapFee(ctx))
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(err, "calc out amount")
+	}
+
+	ideal := alt.ReferencePrice.MulInt(o.PriceAmount())
+	if !ideal.IsPositive() || !out.Amount.IsPositive() {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrAltFulfillmentSlippage, "price rounds to zero")
+	}
+	slippage := math.LegacyOneDec().Sub(math.LegacyNewDecFromInt(out.Amount).Quo(ideal))
+	if slippage.GT(alt.MaxSlippage) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrAltFulfillmentSlippage, "slippage: %s: max: %s", slippage, alt.MaxSlippage)
+	}
+	return out, nil
+}
+
 type fulfillArgs struct {
 	FundsSource sdk.AccAddress
 	Fulfiller   sdk.AccAddress
+	// what is sent to the recipient, the order price if empty
+	Payment sdk.Coins
 }
 
 func (k Keeper) fulfill(ctx sdk.Context,
@@ -42,7 +117,16 @@ func (k Keeper) fulfill(ctx sdk.Context,
 		return errorsmod.Wrap(err, "ensure fulfiller account")
 	}
 
-	err := k.bk.SendCoins(ctx, args.FundsSource, o.GetRecipientBech32Address(), o.Price)
+	// a full fulfillment buys out the partial fulfillers
+	if err := k.refundFulfillmentShares(ctx, o); err != nil {
+		return errorsmod.Wrap(err, "refund fulfillment shares")
+	}
+
+	payment := o.Price
+	if !args.Payment.Empty() {
+		payment = args.Payment
+	}
+	err := k.bk.SendCoins(ctx, args.FundsSource, o.GetRecipientBech32Address(), payment)
 	if err != nil {
 		return errorsmod.Wrap(err, "send coins")
 	}
@@ -58,5 +142,159 @@ func (k Keeper) fulfill(ctx sdk.Context,
 		return err
 	}
 
+	if err = k.Fulfillments.Record(ctx, o, args.FundsSource); err != nil {
+		return errorsmod.Wrap(err, "record fulfillment")
+	}
+
+	return nil
+}
+
+// fulfillPartial escrows amount from the fulfiller in the shares escrow and records it as a share of
+// the order. A share must be at least the min share, unless it funds the rest of the price. When the
+// shares cover the whole price, the escrow is released to the recipient and the order is fulfilled on
+// behalf of the escrow, which then receives the packet funds on finalization and pays them out pro-rata
+// to the shares. Until then, a full fulfillment or an update of the order refunds the shares.
+// Returns true if the order got fulfilled.
+func (k Keeper) fulfillPartial(ctx sdk.Context,
+	o *types.DemandOrder,
+	fulfiller sdk.AccAddress,
+	amount math.Int,
+) (bool, error) {
+	if err := k.ensureAccount(ctx, fulfiller); err != nil {
+		return false, errorsmod.Wrap(err, "ensure fulfiller account")
+	}
+
+	remaining := o.PriceAmount().Sub(o.FundedAmount())
+	if amount.GT(remaining) {
+		return false, errorsmod.Wrapf(types.ErrPartialAmountTooHigh, "amount: %s: remaining: %s", amount, remaining)
+	}
+	if minShare := o.MinFulfillmentShare(); amount.LT(minShare) && !amount.Equal(remaining) {
+		return false, errorsmod.Wrapf(types.ErrPartialAmountTooLow, "amount: %s: min: %s", amount, minShare)
+	}
+
+	o.AddFulfillmentShare(fulfiller.String(), amount)
+	if len(o.FulfillmentShares) > types.MaxFulfillmentShares {
+		return false, types.ErrTooManyFulfillmentShares
+	}
+
+	escrow := types.FulfillmentSharesEscrow
+	err := k.bk.SendCoins(ctx, fulfiller, escrow, sdk.NewCoins(sdk.NewCoin(o.Denom(), amount)))
+	if err != nil {
+		return false, errorsmod.Wrap(err, "send coins to escrow")
+	}
+
+	if err = uevent.EmitTypedEvent(ctx, types.GetPartiallyFulfilledEvent(o, fulfiller.String(), amount.String())); err != nil {
+		return false, fmt.Errorf("emit event: %w", err)
+	}
+
+	if o.FundedAmount().LT(o.PriceAmount()) {
+		return false, k.SetDemandOrder(ctx, o)
+	}
+
+	err = k.bk.SendCoins(ctx, escrow, o.GetRecipientBech32Address(), o.Price)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "send coins")
+	}
+
+	o.FulfillerAddress = escrow.String()
+	err = k.SetDemandOrder(ctx, o)
+	if err != nil {
+		return false, err
+	}
+
+	err = k.hooks.AfterDemandOrderFulfilled(ctx, o, escrow.String())
+	if err != nil {
+		return false, err
+	}
+
+	if err = k.Fulfillments.Record(ctx, o, escrow); err != nil {
+		return false, errorsmod.Wrap(err, "record fulfillment")
+	}
+
+	if err = uevent.EmitTypedEvent(ctx, types.GetFulfilledEvent(o)); err != nil {
+		return false, fmt.Errorf("emit event: %w", err)
+	}
+
+	return true, nil
+}
+
+// SettleFulfillmentShares is called by delayedack when the packet behind a partially funded order
+// is finalized or reverted, before the order itself is updated. If the order was fully funded and
+// the packet funds were delivered to the shares escrow, received is paid out pro-rata to the shares.
+// If the order was never fully funded, the escrowed shares are refunded.
+func (k Keeper) SettleFulfillmentShares(ctx sdk.Context, o *types.DemandOrder, received math.Int, delivered bool) error {
+	if !o.IsPartiallyFunded() || o.FulfillmentSharesSettled {
+		return nil
+	}
+
+	refund := !o.IsFulfilled()
+	var (
+		total math.Int
+		parts []math.Int
+	)
+	if refund {
+		total = o.FundedAmount()
+		for _, s := range o.FulfillmentShares {
+			parts = append(parts, s.Amount)
+		}
+	} else {
+		if !delivered {
+			// the packet funds never reached the escrow, there is nothing to pay out
+			k.Logger(ctx).Error("Packet of partially fulfilled order not delivered.", "order", o.Id)
+			return nil
+		}
+		total = received
+		parts = o.SplitByShares(total)
+	}
+
+	escrow := types.FulfillmentSharesEscrow
+	for i, s := range o.FulfillmentShares {
+		if !parts[i].IsPositive() {
+			continue
+		}
+		err := k.bk.SendCoins(ctx, escrow, sdk.MustAccAddressFromBech32(s.Fulfiller), sdk.NewCoins(sdk.NewCoin(o.Denom(), parts[i])))
+		if err != nil {
+			return errorsmod.Wrapf(err, "pay share: %s", s.Fulfiller)
+		}
+	}
+
+	o.FulfillmentSharesSettled = true
+	if err := k.SetDemandOrder(ctx, o); err != nil {
+		return err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventFulfillmentSharesPaid{
+		OrderId: o.Id,
+		Total:   total.String(),
+		Refund:  refund,
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+
+	return nil
+}
+
+// refundFulfillmentShares refunds the shares of an order not fully funded yet, which is then no longer
+// partially funded. The caller saves the order.
+func (k Keeper) refundFulfillmentShares(ctx sdk.Context, o *types.DemandOrder) error {
+	if !o.IsPartiallyFunded() {
+		return nil
+	}
+	total := o.FundedAmount()
+	for _, s := range o.FulfillmentShares {
+		err := k.bk.SendCoins(ctx, types.FulfillmentSharesEscrow, sdk.MustAccAddressFromBech32(s.Fulfiller), sdk.NewCoins(sdk.NewCoin(o.Denom(), s.Amount)))
+		if err != nil {
+			return errorsmod.Wrapf(err, "refund share: %s", s.Fulfiller)
+		}
+	}
+	o.FulfillmentShares = nil
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventFulfillmentSharesPaid{
+		OrderId: o.Id,
+		Total:   total.String(),
+		Refund:  true,
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// an order accepting an alternative denom is paid in that denom, priced through the pool
+func (suite *KeeperTestSuite) TestFulfillOrderAltDenom() {
+	k := suite.App.EIBCKeeper
+	suite.Ctx = suite.Ctx.WithBlockHeight(10) // after the orders are created
+	denom, altDenom := sdk.DefaultBondDenom, "adym"
+	poolID := suite.PreparePoolWithCoins(sdk.NewCoins(
+		sdk.NewCoin(denom, math.NewInt(500_000)),
+		sdk.NewCoin(altDenom, math.NewInt(500_000)),
+	))
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	recipient, fulfiller := addrs[0], addrs[1]
+	suite.FundAcc(fulfiller, sdk.NewCoins(sdk.NewCoin(altDenom, math.NewInt(1000))))
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	o := types.NewDemandOrder(*rollappPacket, math.NewInt(200), math.NewInt(20), denom, recipient.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, o))
+
+	// not accepted by the order
+	msg := types.NewMsgFulfillOrderAltDenom(fulfiller.String(), o.Id, "20", math.NewInt(1000))
+	_, err := suite.msgServer.FulfillOrderAltDenom(suite.Ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrNoAltFulfillment)
+
+	spot, err := suite.App.GAMMKeeper.CalculateSpotPrice(suite.Ctx, poolID, altDenom, denom)
+	suite.Require().NoError(err)
+	o.AltFulfillment = &types.AltFulfillment{
+		Denom:          altDenom,
+		PoolId:         poolID,
+		MaxSlippage:    math.LegacyNewDecWithPrec(1, 2),
+		ReferencePrice: spot,
+	}
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, o))
+
+	// not in the block the order was created in
+	_, err = k.QuoteAltFulfillment(suite.Ctx.WithBlockHeight(int64(o.CreationHeight)), o) //nolint:gosec
+	suite.Require().ErrorIs(err, types.ErrAltFulfillmentTooEarly)
+
+	quote, err := k.QuoteAltFulfillment(suite.Ctx, o)
+	suite.Require().NoError(err)
+	suite.Require().Equal(altDenom, quote.Denom)
+	suite.Require().True(quote.Amount.IsPositive())
+
+	// more than the fulfiller is willing to pay
+	msg.MaxAmount = quote.Amount.SubRaw(1)
+	_, err = suite.msgServer.FulfillOrderAltDenom(suite.Ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrAltAmountTooHigh)
+
+	msg.MaxAmount = quote.Amount
+	res, err := suite.msgServer.FulfillOrderAltDenom(suite.Ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(quote, res.Paid)
+	suite.Require().Equal(quote.Amount, suite.App.BankKeeper.GetBalance(suite.Ctx, recipient, altDenom).Amount)
+	suite.Require().Equal(math.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+
+	got, err := k.GetDemandOrder(suite.Ctx, o.TrackingPacketStatus, o.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fulfiller.String(), got.FulfillerAddress)
+}
+
+func (suite *KeeperTestSuite) TestQuoteAltFulfillmentSlippage() {
+	k := suite.App.EIBCKeeper
+	suite.Ctx = suite.Ctx.WithBlockHeight(10) // after the orders are created
+	denom, altDenom := sdk.DefaultBondDenom, "adym"
+	poolID := suite.PreparePoolWithCoins(sdk.NewCoins(
+		sdk.NewCoin(denom, math.NewInt(1000)),
+		sdk.NewCoin(altDenom, math.NewInt(1000)),
+	))
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 1, math.NewInt(1000))
+
+	o := types.NewDemandOrder(*rollappPacket, math.NewInt(200), math.NewInt(20), denom, addrs[0].String(), 1, nil)
+	o.AltFulfillment = &types.AltFulfillment{
+		Denom:          altDenom,
+		PoolId:         poolID,
+		MaxSlippage:    math.LegacyNewDecWithPrec(5, 2),
+		ReferencePrice: math.LegacyOneDec(),
+	}
+	// swapping a fifth of the pool moves the price by far more than 5%
+	_, err := k.QuoteAltFulfillment(suite.Ctx, o)
+	suite.Require().ErrorIs(err, types.ErrAltFulfillmentSlippage)
+
+	o.AltFulfillment.MaxSlippage = math.LegacyNewDecWithPrec(50, 2)
+	_, err = k.QuoteAltFulfillment(suite.Ctx, o)
+	suite.Require().NoError(err)
+
+	// the pool moving away from the reference price since the order was created counts as slippage
+	o.AltFulfillment.ReferencePrice = math.LegacyNewDec(2)
+	_, err = k.QuoteAltFulfillment(suite.Ctx, o)
+	suite.Require().ErrorIs(err, types.ErrAltFulfillmentSlippage)
+}
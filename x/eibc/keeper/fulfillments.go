@@ -0,0 +1,357 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+var (
+	FulfillmentsByOrderPrefix     = collections.NewPrefix("ful0")
+	FulfillmentsByFulfillerPrefix = collections.NewPrefix("ful1")
+	FulfillmentsByLPPrefix        = collections.NewPrefix("ful2")
+	FulfillerPnLPrefix            = collections.NewPrefix("ful3")
+	LPPnLPrefix                   = collections.NewPrefix("ful4")
+	FulfillmentsBySettledPrefix   = collections.NewPrefix("ful5")
+)
+
+// Fulfillments is the history of fulfilled orders, with running profit and loss totals
+// per fulfiller and per on demand lp. Settled fulfillments are pruned after the retention
+// set in the params, together with the totals of the fulfillers and lps left without any.
+type Fulfillments struct {
+	// order id -> fulfillment
+	byOrder collections.Map[string, types.Fulfillment]
+	// <fulfiller,order id>
+	byFulfiller collections.KeySet[collections.Pair[string, string]]
+	// <lp id,order id>
+	byLP collections.KeySet[collections.Pair[uint64, string]]
+	// fulfiller -> totals
+	fulfillerPnL collections.Map[string, types.FulfillmentPnL]
+	// lp id -> totals
+	lpPnL collections.Map[uint64, types.FulfillmentPnL]
+	// <settled height,order id>
+	bySettled collections.KeySet[collections.Pair[int64, string]]
+}
+
+func makeFulfillmentsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) Fulfillments {
+	return Fulfillments{
+		byOrder: collections.NewMap(
+			sb, FulfillmentsByOrderPrefix, "fulfillmentsByOrder",
+			collections.StringKey, codec.CollValue[types.Fulfillment](cdc),
+		),
+		byFulfiller: collections.NewKeySet(
+			sb, FulfillmentsByFulfillerPrefix, "fulfillmentsByFulfiller",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		byLP: collections.NewKeySet(
+			sb, FulfillmentsByLPPrefix, "fulfillmentsByLP",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		fulfillerPnL: collections.NewMap(
+			sb, FulfillerPnLPrefix, "fulfillerPnL",
+			collections.StringKey, codec.CollValue[types.FulfillmentPnL](cdc),
+		),
+		lpPnL: collections.NewMap(
+			sb, LPPnLPrefix, "lpPnL",
+			collections.Uint64Key, codec.CollValue[types.FulfillmentPnL](cdc),
+		),
+		bySettled: collections.NewKeySet(
+			sb, FulfillmentsBySettledPrefix, "fulfillmentsBySettled",
+			collections.PairKeyCodec(collections.Int64Key, collections.StringKey),
+		),
+	}
+}
+
+// Record adds a new pending fulfillment of the order, fronted by fulfiller. If the order was funded
+// by partial fulfillers, fulfiller is their escrow and the fulfillment is recorded for each of them.
+func (s Fulfillments) Record(ctx sdk.Context, o *types.DemandOrder, fulfiller sdk.AccAddress) error {
+	f := types.Fulfillment{
+		OrderId:   o.Id,
+		RollappId: o.RollappId,
+		Fulfiller: fulfiller.String(),
+		Price:     sdk.NewCoin(o.Denom(), o.PriceAmount()),
+		Fee:       sdk.NewCoin(o.Denom(), o.GetFeeAmount()),
+		Height:    ctx.BlockHeight(),
+		Outcome:   types.FulfillmentOutcome_FULFILLMENT_OUTCOME_PENDING,
+		Shares:    o.FulfillmentShares,
+	}
+	if err := s.byOrder.Set(ctx, f.OrderId, f); err != nil {
+		return errorsmod.Wrap(err, "set by order")
+	}
+	for _, h := range f.Holders() {
+		if err := s.byFulfiller.Set(ctx, collections.Join(h.Fulfiller, f.OrderId)); err != nil {
+			return errorsmod.Wrap(err, "set by fulfiller")
+		}
+		pnl, err := s.GetFulfillerPnL(ctx, h.Fulfiller)
+		if err != nil {
+			return err
+		}
+		pnl.AddFill(h)
+		if err := s.fulfillerPnL.Set(ctx, h.Fulfiller, pnl); err != nil {
+			return errorsmod.Wrap(err, "set fulfiller pnl")
+		}
+	}
+	return nil
+}
+
+// SetLP attributes the fulfillment of the order to the on demand lp
+func (s Fulfillments) SetLP(ctx sdk.Context, orderID string, lpID uint64) error {
+	f, err := s.byOrder.Get(ctx, orderID)
+	if err != nil {
+		return errorsmod.Wrap(err, "get by order")
+	}
+	f.ByLp = true
+	f.LpId = lpID
+	if err := s.byOrder.Set(ctx, orderID, f); err != nil {
+		return errorsmod.Wrap(err, "set by order")
+	}
+	if err := s.byLP.Set(ctx, collections.Join(lpID, orderID)); err != nil {
+		return errorsmod.Wrap(err, "set by lp")
+	}
+	pnl, err := s.GetLPPnL(ctx, lpID)
+	if err != nil {
+		return err
+	}
+	pnl.AddFill(f)
+	return s.lpPnL.Set(ctx, lpID, pnl)
+}
+
+// Transfer moves a pending fulfillment of the order, if there is one, to the new holder of its claim. The
+// previous holder, and the on demand lp it was attributed to, are no longer exposed to it.
+func (s Fulfillments) Transfer(ctx sdk.Context, orderID string, to string) error {
+	f, err := s.byOrder.Get(ctx, orderID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get by order")
+	}
+	// the shares of partial fulfillers are not transferable
+	if f.Outcome != types.FulfillmentOutcome_FULFILLMENT_OUTCOME_PENDING || 0 < len(f.Shares) {
+		return nil
+	}
+
+	pnl, err := s.GetFulfillerPnL(ctx, f.Fulfiller)
+	if err != nil {
+		return err
+	}
+	pnl.RemoveFill(f)
+	if err := s.fulfillerPnL.Set(ctx, f.Fulfiller, pnl); err != nil {
+		return errorsmod.Wrap(err, "set fulfiller pnl")
+	}
+	if err := s.byFulfiller.Remove(ctx, collections.Join(f.Fulfiller, orderID)); err != nil {
+		return errorsmod.Wrap(err, "remove by fulfiller")
+	}
+
+	if f.ByLp {
+		pnl, err := s.GetLPPnL(ctx, f.LpId)
+		if err != nil {
+			return err
+		}
+		pnl.RemoveFill(f)
+		if err := s.lpPnL.Set(ctx, f.LpId, pnl); err != nil {
+			return errorsmod.Wrap(err, "set lp pnl")
+		}
+		if err := s.byLP.Remove(ctx, collections.Join(f.LpId, orderID)); err != nil {
+			return errorsmod.Wrap(err, "remove by lp")
+		}
+		f.ByLp = false
+		f.LpId = 0
+	}
+
+	f.Fulfiller = to
+	if err := s.byOrder.Set(ctx, orderID, f); err != nil {
+		return errorsmod.Wrap(err, "set by order")
+	}
+	if err := s.byFulfiller.Set(ctx, collections.Join(f.Fulfiller, orderID)); err != nil {
+		return errorsmod.Wrap(err, "set by fulfiller")
+	}
+	pnl, err = s.GetFulfillerPnL(ctx, f.Fulfiller)
+	if err != nil {
+		return err
+	}
+	pnl.AddFill(f)
+	return s.fulfillerPnL.Set(ctx, f.Fulfiller, pnl)
+}
+
+// Settle records the outcome of a pending fulfillment of the order, if there is one
+func (s Fulfillments) Settle(ctx sdk.Context, orderID string, outcome types.FulfillmentOutcome) error {
+	f, err := s.byOrder.Get(ctx, orderID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get by order")
+	}
+	if f.Outcome != types.FulfillmentOutcome_FULFILLMENT_OUTCOME_PENDING {
+		return nil
+	}
+	f.Outcome = outcome
+	f.SettledHeight = ctx.BlockHeight()
+	if err := s.byOrder.Set(ctx, orderID, f); err != nil {
+		return errorsmod.Wrap(err, "set by order")
+	}
+	if err := s.bySettled.Set(ctx, collections.Join(f.SettledHeight, orderID)); err != nil {
+		return errorsmod.Wrap(err, "set by settled")
+	}
+
+	for _, h := range f.Holders() {
+		pnl, err := s.GetFulfillerPnL(ctx, h.Fulfiller)
+		if err != nil {
+			return err
+		}
+		pnl.Settle(h)
+		if err := s.fulfillerPnL.Set(ctx, h.Fulfiller, pnl); err != nil {
+			return errorsmod.Wrap(err, "set fulfiller pnl")
+		}
+	}
+
+	if !f.ByLp {
+		return nil
+	}
+	pnl, err := s.GetLPPnL(ctx, f.LpId)
+	if err != nil {
+		return err
+	}
+	pnl.Settle(f)
+	if err := s.lpPnL.Set(ctx, f.LpId, pnl); err != nil {
+		return errorsmod.Wrap(err, "set lp pnl")
+	}
+	return nil
+}
+
+// Prune removes up to limit fulfillments settled before the height, oldest first. The totals of
+// the fulfillers and lps left without any fulfillment are removed as well.
+func (s Fulfillments) Prune(ctx sdk.Context, before int64, limit int) error {
+	rng := new(collections.Range[collections.Pair[int64, string]]).
+		EndExclusive(collections.Join(before, ""))
+	iter, err := s.bySettled.Iterate(ctx, rng)
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate by settled")
+	}
+	var keys []collections.Pair[int64, string]
+	for ; iter.Valid() && len(keys) < limit; iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			iter.Close() // nolint: errcheck
+			return errorsmod.Wrap(err, "key")
+		}
+		keys = append(keys, key)
+	}
+	iter.Close() // nolint: errcheck
+
+	for _, key := range keys {
+		if err := s.remove(ctx, key.K2()); err != nil {
+			return errorsmod.Wrapf(err, "remove: order: %s", key.K2())
+		}
+		if err := s.bySettled.Remove(ctx, key); err != nil {
+			return errorsmod.Wrap(err, "remove by settled")
+		}
+	}
+	return nil
+}
+
+func (s Fulfillments) remove(ctx sdk.Context, orderID string) error {
+	f, err := s.byOrder.Get(ctx, orderID)
+	if err != nil {
+		return errorsmod.Wrap(err, "get by order")
+	}
+	if err := s.byOrder.Remove(ctx, orderID); err != nil {
+		return errorsmod.Wrap(err, "remove by order")
+	}
+
+	for _, h := range f.Holders() {
+		if err := s.byFulfiller.Remove(ctx, collections.Join(h.Fulfiller, orderID)); err != nil {
+			return errorsmod.Wrap(err, "remove by fulfiller")
+		}
+		empty, err := isEmpty(ctx, s.byFulfiller, collections.NewPrefixedPairRange[string, string](h.Fulfiller))
+		if err != nil {
+			return err
+		}
+		if empty {
+			if err := s.fulfillerPnL.Remove(ctx, h.Fulfiller); err != nil {
+				return errorsmod.Wrap(err, "remove fulfiller pnl")
+			}
+		}
+	}
+
+	if !f.ByLp {
+		return nil
+	}
+	if err := s.byLP.Remove(ctx, collections.Join(f.LpId, orderID)); err != nil {
+		return errorsmod.Wrap(err, "remove by lp")
+	}
+	empty, err := isEmpty(ctx, s.byLP, collections.NewPrefixedPairRange[uint64, string](f.LpId))
+	if err != nil {
+		return err
+	}
+	if empty {
+		if err := s.lpPnL.Remove(ctx, f.LpId); err != nil {
+			return errorsmod.Wrap(err, "remove lp pnl")
+		}
+	}
+	return nil
+}
+
+func isEmpty[K any](ctx sdk.Context, set collections.KeySet[K], rng collections.Ranger[K]) (bool, error) {
+	iter, err := set.Iterate(ctx, rng)
+	if err != nil {
+		return false, errorsmod.Wrap(err, "iterate")
+	}
+	defer iter.Close() // nolint: errcheck
+	return !iter.Valid(), nil
+}
+
+func (s Fulfillments) Get(ctx sdk.Context, orderID string) (types.Fulfillment, error) {
+	return s.byOrder.Get(ctx, orderID)
+}
+
+func (s Fulfillments) GetFulfillerPnL(ctx sdk.Context, fulfiller string) (types.FulfillmentPnL, error) {
+	pnl, err := s.fulfillerPnL.Get(ctx, fulfiller)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FulfillmentPnL{}, nil
+	}
+	return pnl, err
+}
+
+func (s Fulfillments) GetLPPnL(ctx sdk.Context, lpID uint64) (types.FulfillmentPnL, error) {
+	pnl, err := s.lpPnL.Get(ctx, lpID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.FulfillmentPnL{}, nil
+	}
+	return pnl, err
+}
+
+func (s Fulfillments) GetByFulfillerPaginated(ctx sdk.Context, fulfiller string, pageReq *query.PageRequest) ([]types.Fulfillment, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, s.byFulfiller, pageReq,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.Fulfillment, error) {
+			return s.byOrder.Get(ctx, key.K2())
+		}, collcompat.WithCollectionPaginationPairPrefix[string, string](fulfiller),
+	)
+}
+
+func (s Fulfillments) GetByLPPaginated(ctx sdk.Context, lpID uint64, pageReq *query.PageRequest) ([]types.Fulfillment, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, s.byLP, pageReq,
+		func(key collections.Pair[uint64, string], _ collections.NoValue) (types.Fulfillment, error) {
+			return s.byOrder.Get(ctx, key.K2())
+		}, collcompat.WithCollectionPaginationPairPrefix[uint64, string](lpID),
+	)
+}
+
+// maxFulfillmentsPrunedPerBlock bounds the work of the end blocker, the rest is pruned in the next blocks
+const maxFulfillmentsPrunedPerBlock = 100
+
+// PruneFulfillments removes the fulfillments settled before the retention set in the params
+func (k Keeper) PruneFulfillments(ctx sdk.Context) error {
+	retention := int64(k.FulfillmentHistoryRetention(ctx)) //nolint:gosec
+	if retention == 0 || ctx.BlockHeight() <= retention {
+		return nil
+	}
+	return k.Fulfillments.Prune(ctx, ctx.BlockHeight()-retention, maxFulfillmentsPrunedPerBlock)
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+)
+
+// a fulfiller earns the fee of a finalized order and loses the price of a reverted one
+func (suite *KeeperTestSuite) TestFulfillmentsPnL() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	orderAddr, fulfiller := addrs[0], addrs[1]
+
+	finalizedPacket := *rollappPacket
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, finalizedPacket)
+	finalizedOrder := types.NewDemandOrder(finalizedPacket, math.NewInt(100), math.NewInt(10), denom, orderAddr.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, finalizedOrder))
+
+	revertedIBCPacket := packet
+	revertedIBCPacket.Sequence = 2
+	revertedPacket := *rollappPacket
+	revertedPacket.Packet = &revertedIBCPacket
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, revertedPacket)
+	revertedOrder := types.NewDemandOrder(revertedPacket, math.NewInt(200), math.NewInt(20), denom, orderAddr.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, revertedOrder))
+
+	for _, o := range []*types.DemandOrder{finalizedOrder, revertedOrder} {
+		_, err := suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller.String(), o.Id, o.GetFeeAmount().String()))
+		suite.Require().NoError(err)
+	}
+
+	req := &types.QueryFulfillmentsByFulfillerRequest{Fulfiller: fulfiller.String()}
+	res, err := suite.queryClient.FulfillmentsByFulfiller(suite.Ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Fulfillments, 2)
+	suite.Require().Equal(uint64(2), res.Pnl.Fills)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(300))), res.Pnl.Outstanding)
+
+	// fulfillment rewrote the packets, so reload them
+	p, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(finalizedPacket.RollappPacketKey()))
+	suite.Require().NoError(err)
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *p)
+	suite.Require().NoError(err)
+	p, err = suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(revertedPacket.RollappPacketKey()))
+	suite.Require().NoError(err)
+	suite.App.DelayedAckKeeper.DeleteRollappPacket(suite.Ctx, p)
+
+	res, err = suite.queryClient.FulfillmentsByFulfiller(suite.Ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), res.Pnl.Finalized)
+	suite.Require().Equal(uint64(1), res.Pnl.Reverted)
+	suite.Require().True(res.Pnl.Outstanding.IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(10))), res.Pnl.EarnedFees)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(200))), res.Pnl.Losses)
+
+	f, err := k.Fulfillments.Get(suite.Ctx, revertedOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FulfillmentOutcome_FULFILLMENT_OUTCOME_REVERTED, f.Outcome)
+
+	// the settled fulfillments are kept for the retention, then pruned together with the totals
+	params := k.GetParams(suite.Ctx)
+	params.FulfillmentHistoryRetention = 10
+	k.SetParams(suite.Ctx, params)
+	h := suite.Ctx.BlockHeight()
+
+	suite.Require().NoError(k.PruneFulfillments(suite.Ctx.WithBlockHeight(h + 10)))
+	res, err = suite.queryClient.FulfillmentsByFulfiller(suite.Ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Fulfillments, 2)
+
+	suite.Require().NoError(k.PruneFulfillments(suite.Ctx.WithBlockHeight(h + 11)))
+	res, err = suite.queryClient.FulfillmentsByFulfiller(suite.Ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Fulfillments)
+	suite.Require().Equal(types.FulfillmentPnL{}, res.Pnl)
+}
+
+// each partial fulfiller is accounted for its share of the price and of the fee
+func (suite *KeeperTestSuite) TestFulfillmentsPnLPartial() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1000))
+	orderAddr, fulfiller1, fulfiller2 := addrs[0], addrs[1], addrs[2]
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	order := types.NewDemandOrder(*rollappPacket, math.NewInt(100), math.NewInt(20), denom, orderAddr.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(suite.Ctx, order))
+
+	_, err := suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller1.String(), order.Id, "20", math.NewInt(30)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller2.String(), order.Id, "20", math.NewInt(70)))
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		fulfiller sdk.AccAddress
+		price     int64
+	}{{fulfiller1, 30}, {fulfiller2, 70}} {
+		res, err := suite.queryClient.FulfillmentsByFulfiller(suite.Ctx, &types.QueryFulfillmentsByFulfillerRequest{Fulfiller: tc.fulfiller.String()})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.Fulfillments, 1)
+		suite.Require().Equal(uint64(1), res.Pnl.Fills)
+		suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(tc.price))), res.Pnl.Outstanding)
+	}
+
+	p, err := suite.App.DelayedAckKeeper.GetRollappPacket(suite.Ctx, string(rollappPacket.RollappPacketKey()))
+	suite.Require().NoError(err)
+	_, err = suite.App.DelayedAckKeeper.UpdateRollappPacketAfterFinalization(suite.Ctx, *p)
+	suite.Require().NoError(err)
+
+	for _, tc := range []struct {
+		fulfiller sdk.AccAddress
+		fee       int64
+	}{{fulfiller1, 6}, {fulfiller2, 14}} {
+		res, err := suite.queryClient.FulfillmentsByFulfiller(suite.Ctx, &types.QueryFulfillmentsByFulfillerRequest{Fulfiller: tc.fulfiller.String()})
+		suite.Require().NoError(err)
+		suite.Require().True(res.Pnl.Outstanding.IsZero())
+		suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(tc.fee))), res.Pnl.EarnedFees)
+	}
+}
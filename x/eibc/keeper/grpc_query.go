@@ -9,6 +9,7 @@ import (
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -47,6 +48,7 @@ func (q Querier) DemandOrderById(goCtx context.Context, req *types.QueryGetDeman
 	for _, status := range statuses {
 		demandOrder, err = q.GetDemandOrder(ctx, status, req.Id)
 		if err == nil && demandOrder != nil {
+			demandOrder.ApplyFeeCurve(uint64(ctx.BlockHeight())) //nolint:gosec
 			return &types.QueryGetDemandOrderResponse{DemandOrder: demandOrder}, nil
 		}
 	}
@@ -57,11 +59,16 @@ func (q Querier) DemandOrdersByStatus(goCtx context.Context, req *types.QueryDem
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "empty request")
 	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
 	// Get the demand orders by status, with optional filters
-	demandOrders, pageResp, err := q.ListDemandOrdersByStatusPaginated(sdk.UnwrapSDKContext(goCtx), req.Status, req.Pagination, filterOpts(req)...)
+	demandOrders, pageResp, err := q.ListDemandOrdersByStatusPaginated(ctx, req.Status, req.Pagination, filterOpts(req)...)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	// Report the fees effective now for orders following a fee curve
+	for _, o := range demandOrders {
+		o.ApplyFeeCurve(uint64(ctx.BlockHeight())) //nolint:gosec
+	}
 	// Construct the response
 	return &types.QueryDemandOrdersByStatusResponse{
 		DemandOrders: demandOrders,
@@ -145,7 +152,7 @@ func (q Querier) OnDemandLPs(gctx context.Context, r *types.QueryOnDemandLPsRequ
 		if err != nil {
 			return nil, err
 		}
-		ret.Lps = lps
+		ret.Lps = withCurrentUsage(ctx, lps)
 		return ret, nil
 	}
 
@@ -159,10 +166,21 @@ func (q Querier) OnDemandLPs(gctx context.Context, r *types.QueryOnDemandLPsRequ
 		}
 		ret.Lps = append(ret.Lps, lp)
 	}
+	ret.Lps = withCurrentUsage(ctx, ret.Lps)
 
 	return ret, nil
 }
 
+// withCurrentUsage reports the spend of the window current at the query height,
+// as the stored one is only reset by the next fill
+func withCurrentUsage(ctx sdk.Context, lps []*types.OnDemandLPRecord) []*types.OnDemandLPRecord {
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	for _, lp := range lps {
+		lp.WindowSpent = lp.WindowSpentAt(h)
+	}
+	return lps
+}
+
 func (q Querier) OnDemandLPsByByAddr(gctx context.Context, r *types.QueryOnDemandLPsByAddrRequest) (*types.QueryOnDemandLPsByAddrResponse, error) {
 	ctx := sdk.UnwrapSDKContext(gctx)
 	acc, err := sdk.AccAddressFromBech32(r.Addr)
@@ -173,5 +191,97 @@ func (q Querier) OnDemandLPsByByAddr(gctx context.Context, r *types.QueryOnDeman
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "get by addr")
 	}
-	return &types.QueryOnDemandLPsByAddrResponse{Lps: lps}, nil
+	return &types.QueryOnDemandLPsByAddrResponse{Lps: withCurrentUsage(ctx, lps)}, nil
+}
+
+func (q Querier) BatchClearingResults(gctx context.Context, r *types.QueryBatchClearingResultsRequest) (*types.QueryBatchClearingResultsResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(gctx)
+
+	if r.Denom != "" {
+		if r.Rollapp == "" {
+			return nil, status.Error(codes.InvalidArgument, "denom filter requires rollapp")
+		}
+		res, err := q.GetBatchClearingResult(ctx, r.Rollapp, r.Denom)
+		if errorsmod.IsOf(err, gerrc.ErrNotFound) {
+			return &types.QueryBatchClearingResultsResponse{}, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryBatchClearingResultsResponse{Results: []types.BatchClearingResult{*res}}, nil
+	}
+
+	results, err := q.GetBatchClearingResults(ctx, r.Rollapp)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get clearing results")
+	}
+	return &types.QueryBatchClearingResultsResponse{Results: results}, nil
+}
+
+func (q Querier) FulfillmentsByFulfiller(gctx context.Context, r *types.QueryFulfillmentsByFulfillerRequest) (*types.QueryFulfillmentsResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(gctx)
+	if _, err := sdk.AccAddressFromBech32(r.Fulfiller); err != nil {
+		return nil, errorsmod.Wrap(err, "acc address from bech32")
+	}
+	pnl, err := q.Fulfillments.GetFulfillerPnL(ctx, r.Fulfiller)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get pnl")
+	}
+	fulfillments, pageResp, err := q.Fulfillments.GetByFulfillerPaginated(ctx, r.Fulfiller, r.Pagination)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get fulfillments")
+	}
+	return &types.QueryFulfillmentsResponse{
+		Pnl:          pnl,
+		Fulfillments: fulfillments,
+		Pagination:   pageResp,
+	}, nil
+}
+
+func (q Querier) FulfillmentsByLP(gctx context.Context, r *types.QueryFulfillmentsByLPRequest) (*types.QueryFulfillmentsResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(gctx)
+	pnl, err := q.Fulfillments.GetLPPnL(ctx, r.LpId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get pnl")
+	}
+	fulfillments, pageResp, err := q.Fulfillments.GetByLPPaginated(ctx, r.LpId, r.Pagination)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get fulfillments")
+	}
+	return &types.QueryFulfillmentsResponse{
+		Pnl:          pnl,
+		Fulfillments: fulfillments,
+		Pagination:   pageResp,
+	}, nil
+}
+
+func (q Querier) ClaimOrders(gctx context.Context, r *types.QueryClaimOrdersRequest) (*types.QueryClaimOrdersResponse, error) {
+	if r == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(gctx)
+	o, err := q.getTransferableClaim(ctx, r.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.QueryClaimOrdersResponse{Holder: o.FulfillerAddress}
+	so, err := q.Claims.GetSellOrder(ctx, r.OrderId)
+	if err != nil && !errorsmod.IsOf(err, gerrc.ErrNotFound) {
+		return nil, errorsmod.Wrap(err, "get sell order")
+	}
+	res.SellOrder = so
+	res.BuyOrders, err = q.Claims.GetBuyOrders(ctx, r.OrderId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get buy orders")
+	}
+	return res, nil
 }
@@ -95,6 +95,19 @@ func (k *Keeper) CreateDemandOrderOnRecv(ctx sdk.Context, fungibleTokenPacketDat
 	}
 
 	order := types.NewDemandOrder(*rollappPacket, demandOrderPrice, fee, demandOrderDenom, demandOrderRecipient, creationHeight, onComplete)
+	if alt := memoEIBC.AltFulfillment; alt != nil {
+		maxSlippage, _ := alt.MaxSlippageDec() // guaranteed ok by above validation
+		spot, err := k.gk.CalculateSpotPrice(ctx, alt.PoolID, alt.Denom, demandOrderDenom)
+		if err != nil {
+			return nil, fmt.Errorf("alt fulfillment reference price: %w", err)
+		}
+		order.AltFulfillment = &types.AltFulfillment{
+			Denom:          alt.Denom,
+			PoolId:         alt.PoolID,
+			MaxSlippage:    maxSlippage,
+			ReferencePrice: spot,
+		}
+	}
 	return order, nil
 }
 
@@ -3,6 +3,8 @@ package keeper
 import (
 	"errors"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 
@@ -50,6 +52,20 @@ func (d delayedAckHooks) AfterPacketStatusUpdated(ctx sdk.Context, packet *commo
 		return err
 	}
 
+	if err := d.LPs.ReleaseFill(ctx, demandOrderID); err != nil {
+		return errorsmod.Wrap(err, "release lp fill")
+	}
+
+	if err := d.closeClaimSellOrder(ctx, demandOrderID); err != nil {
+		return errorsmod.Wrap(err, "close claim sell order")
+	}
+
+	if packet.Status == commontypes.Status_FINALIZED {
+		if err := d.Fulfillments.Settle(ctx, demandOrderID, types.FulfillmentOutcome_FULFILLMENT_OUTCOME_FINALIZED); err != nil {
+			return errorsmod.Wrap(err, "settle fulfillment")
+		}
+	}
+
 	return nil
 }
 
@@ -63,6 +79,26 @@ func (d delayedAckHooks) AfterPacketDeleted(ctx sdk.Context, rollappPacket *comm
 	packetKey := rollappPacket.RollappPacketKey()
 	demandOrderID := types.BuildDemandIDFromPacketKey(string(packetKey))
 
+	// The packet is reverted, give the escrowed shares of a not yet fully funded order back
+	if o, err := d.GetDemandOrder(ctx, commontypes.Status_PENDING, demandOrderID); err == nil && !o.IsFulfilled() {
+		if err := d.SettleFulfillmentShares(ctx, o, math.ZeroInt(), false); err != nil {
+			d.Logger(ctx).Error("settle fulfillment shares", "order", demandOrderID, "error", err)
+		}
+	}
+
+	if err := d.LPs.ReleaseFill(ctx, demandOrderID); err != nil {
+		d.Logger(ctx).Error("release lp fill", "order", demandOrderID, "error", err)
+	}
+
+	if err := d.closeClaimSellOrder(ctx, demandOrderID); err != nil {
+		d.Logger(ctx).Error("close claim sell order", "order", demandOrderID, "error", err)
+	}
+
+	// Finalized fulfillments are already settled, so this only applies to packets reverted by a hard fork
+	if err := d.Fulfillments.Settle(ctx, demandOrderID, types.FulfillmentOutcome_FULFILLMENT_OUTCOME_REVERTED); err != nil {
+		d.Logger(ctx).Error("settle fulfillment", "order", demandOrderID, "error", err)
+	}
+
 	statuses := []commontypes.Status{commontypes.Status_PENDING, commontypes.Status_FINALIZED}
 	for _, status := range statuses {
 		d.deleteDemandOrder(ctx, status, demandOrderID)
@@ -18,6 +18,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "demand-order-count", DemandOrderCountInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "underlying-packet-exist", UnderlyingPacketExistInvariant(k))
 	ir.RegisterRoute(types.ModuleName, "coins", CoinsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "fulfillment-shares", FulfillmentSharesInvariant(k))
 }
 
 // DO NOT DELETE
@@ -27,6 +28,7 @@ func AllInvariants(k Keeper) sdk.Invariant {
 			DemandOrderCountInvariant(k),
 			UnderlyingPacketExistInvariant(k),
 			CoinsInvariant(k),
+			FulfillmentSharesInvariant(k),
 		} {
 			res, stop := inv(ctx)
 			if stop {
@@ -123,3 +125,33 @@ func CoinsInvariant(k Keeper) sdk.Invariant {
 		return sdk.FormatInvariant(types.ModuleName, "coins", msg), broken
 	}
 }
+
+// shares never exceed the price, and cover it exactly once the order is fulfilled
+func FulfillmentSharesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			broken bool
+			msg    string
+		)
+		allDemandOrders, err := k.ListAllDemandOrders(ctx)
+		if err != nil {
+			msg += fmt.Sprintf("list all demand orders failed: %v\n", err)
+			broken = true
+		}
+		for _, do := range allDemandOrders {
+			if !do.IsPartiallyFunded() {
+				continue
+			}
+			funded := do.FundedAmount()
+			if funded.GT(do.PriceAmount()) {
+				msg += fmt.Sprintf("funded amount exceeds price: order: %s: funded: %s\n", do.Id, funded)
+				broken = true
+			}
+			if do.IsFulfilled() && !funded.Equal(do.PriceAmount()) {
+				msg += fmt.Sprintf("fulfilled order not fully funded: order: %s: funded: %s\n", do.Id, funded)
+				broken = true
+			}
+		}
+		return sdk.FormatInvariant(types.ModuleName, "fulfillment-shares", msg), broken
+	}
+}
@@ -30,9 +30,18 @@ type (
 		bk        types.BankKeeper
 		dack      types.DelayedAckKeeper
 		rk        types.RollappKeeper
+		gk        types.GammKeeper
 		Schema    collections.Schema
 		LPs       LPs
 		authority string
+
+		Fulfillments Fulfillments
+		Claims       Claims
+
+		// <rollapp,denom> -> latest batch auction outcome
+		batchResults collections.Map[collections.Pair[string, string], types.BatchClearingResult]
+		// id of the last order considered by the batch auction
+		batchCursor collections.Item[string]
 	}
 )
 
@@ -44,11 +53,16 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	delayedAckKeeper types.DelayedAckKeeper,
 	rk types.RollappKeeper,
+	gk types.GammKeeper,
 	authority string,
 ) *Keeper {
 	service := collcompat.NewKVStoreService(storeKey)
 	sb := collections.NewSchemaBuilder(service)
 	lps := makeLPsStore(sb, cdc)
+	batchResults := makeBatchClearingResultsStore(sb, cdc)
+	batchCursor := makeBatchCursorStore(sb)
+	fulfillments := makeFulfillmentsStore(sb, cdc)
+	claims := makeClaimsStore(sb, cdc)
 
 	schema, err := sb.Build()
 	if err != nil {
@@ -56,16 +70,21 @@ func NewKeeper(
 	}
 
 	return &Keeper{
-		cdc:       cdc,
-		storeKey:  storeKey,
-		memKey:    memKey,
-		ak:        accountKeeper,
-		bk:        bankKeeper,
-		dack:      delayedAckKeeper,
-		rk:        rk,
-		Schema:    schema,
-		LPs:       lps,
-		authority: authority,
+		cdc:          cdc,
+		storeKey:     storeKey,
+		memKey:       memKey,
+		ak:           accountKeeper,
+		bk:           bankKeeper,
+		dack:         delayedAckKeeper,
+		rk:           rk,
+		gk:           gk,
+		Schema:       schema,
+		LPs:          lps,
+		authority:    authority,
+		Fulfillments: fulfillments,
+		Claims:       claims,
+		batchResults: batchResults,
+		batchCursor:  batchCursor,
 	}
 }
 
@@ -161,6 +180,8 @@ func (k Keeper) GetOutstandingOrder(ctx sdk.Context, orderId string) (*types.Dem
 		return nil, types.ErrDemandOrderInactive
 	}
 
+	demandOrder.ApplyFeeCurve(uint64(ctx.BlockHeight())) //nolint:gosec
+
 	return demandOrder, nil
 }
 
@@ -1,8 +1,14 @@
 package keeper_test
 
 import (
+	"time"
+
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/eibc/types"
 )
 
@@ -176,3 +182,134 @@ func (suite *KeeperTestSuite) TestLPQueriesByAddr() {
 		}
 	}
 }
+
+// fill count, spend window and pending exposure limits stop an lp from being compatible
+func (suite *KeeperTestSuite) TestLPLimits() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx.WithBlockHeight(100)
+	newOrder := func(id string) *types.DemandOrder {
+		return &types.DemandOrder{
+			Id:        id,
+			RollappId: "1",
+			Price:     sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(10))),
+			Fee:       sdk.NewCoins(sdk.NewCoin("aaa", math.NewInt(1))),
+		}
+	}
+	compatible := func(ctx sdk.Context) bool {
+		lps, err := k.LPs.GetOrderCompatibleLPs(ctx, *newOrder("x"))
+		suite.Require().NoError(err)
+		return len(lps) == 1
+	}
+	fill := func(ctx sdk.Context, id uint64, order string) {
+		lp, err := k.LPs.Get(ctx, id)
+		suite.Require().NoError(err)
+		suite.Require().NoError(k.LPs.RecordFill(ctx, lp, newOrder(order)))
+	}
+
+	id, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		Rollapp:            "1",
+		Denom:              "aaa",
+		MaxPrice:           math.NewInt(100),
+		MinFee:             math.LegacyZeroDec(),
+		SpendLimit:         math.NewInt(1000),
+		MaxFills:           3,
+		WindowSpendLimit:   math.NewInt(20),
+		WindowBlocks:       10,
+		MaxPendingExposure: math.NewInt(30),
+	})
+	suite.Require().NoError(err)
+
+	// the window limit is reached after two fills
+	suite.Require().True(compatible(ctx))
+	fill(ctx, id, "a")
+	fill(ctx, id, "b")
+	suite.Require().False(compatible(ctx))
+
+	// the next window frees it up, until the fill and exposure limits are reached
+	ctx = ctx.WithBlockHeight(110)
+	suite.Require().True(compatible(ctx))
+	fill(ctx, id, "c")
+	suite.Require().False(compatible(ctx))
+
+	// finalization of an order releases exposure, but the fill count is exhausted
+	suite.Require().NoError(k.LPs.ReleaseFill(ctx, "a"))
+	lp, err := k.LPs.Get(ctx, id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), lp.Fills)
+	suite.Require().Equal(math.NewInt(20), lp.PendingExposure)
+	suite.Require().Equal(math.NewInt(10), lp.WindowSpent)
+	suite.Require().False(compatible(ctx))
+}
+
+func (suite *KeeperTestSuite) TestLPExpiry() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx.WithBlockHeight(100)
+	expiryTime := ctx.BlockTime().Add(time.Hour)
+
+	byHeight, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		Rollapp:      "1",
+		Denom:        "aaa",
+		ExpiryHeight: 105,
+	})
+	suite.Require().NoError(err)
+	byTime, err := k.LPs.Create(ctx, &types.OnDemandLP{
+		Rollapp:    "1",
+		Denom:      "aaa",
+		ExpiryTime: &expiryTime,
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(k.LPs.DeleteExpired(ctx))
+	_, err = k.LPs.Get(ctx, byHeight)
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockHeight(105)
+	suite.Require().NoError(k.LPs.DeleteExpired(ctx))
+	_, err = k.LPs.Get(ctx, byHeight)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+	_, err = k.LPs.Get(ctx, byTime)
+	suite.Require().NoError(err)
+
+	ctx = ctx.WithBlockTime(expiryTime)
+	suite.Require().NoError(k.LPs.DeleteExpired(ctx))
+	_, err = k.LPs.Get(ctx, byTime)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+}
+
+// an order fulfilled on demand goes to the compatible lp asking for the lowest fee, ties are broken by lp id
+func (suite *KeeperTestSuite) TestFulfillByOnDemandLP() {
+	k := suite.App.EIBCKeeper
+	ctx := suite.Ctx.WithBlockHeight(10)
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, ctx, 4, math.NewInt(1000))
+	orderAddr, lpAddrA, lpAddrB, lpAddrC := addrs[0], addrs[1], addrs[2], addrs[3]
+
+	for _, lp := range []struct {
+		addr   sdk.AccAddress
+		minFee string
+	}{
+		{lpAddrA, "0.1"},
+		{lpAddrB, "0.05"},
+		{lpAddrC, "0.05"},
+	} {
+		_, err := k.LPs.Create(ctx, &types.OnDemandLP{
+			FundsAddr:  lp.addr.String(),
+			Rollapp:    rollappPacket.RollappId,
+			Denom:      denom,
+			MaxPrice:   math.NewInt(500),
+			MinFee:     math.LegacyMustNewDecFromStr(lp.minFee),
+			SpendLimit: math.NewInt(500),
+		})
+		suite.Require().NoError(err)
+	}
+
+	// pays a 20% fee
+	suite.App.DelayedAckKeeper.SetRollappPacket(ctx, *rollappPacket)
+	order := types.NewDemandOrder(*rollappPacket, math.NewInt(100), math.NewInt(20), denom, orderAddr.String(), 1, nil)
+	suite.Require().NoError(k.SetDemandOrder(ctx, order))
+
+	suite.Require().NoError(k.FulfillByOnDemandLP(ctx, order.Id))
+	order, err := k.GetDemandOrder(ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(lpAddrB.String(), order.FulfillerAddress)
+}
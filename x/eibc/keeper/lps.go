@@ -2,7 +2,8 @@ package keeper
 
 import (
 	"errors"
-	"math/rand/v2"
+	stdmath "math"
+	"time"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
@@ -20,6 +21,9 @@ var (
 	LPsByIDPrefix           = collections.NewPrefix("lps1")
 	LPsNextIDPrefix         = collections.NewPrefix("lps2")
 	LPsByAddrPrefix         = collections.NewPrefix("lps3")
+	LPsPendingFillsPrefix   = collections.NewPrefix("lps4")
+	LPsByExpiryHeightPrefix = collections.NewPrefix("lps5")
+	LPsByExpiryTimePrefix   = collections.NewPrefix("lps6")
 )
 
 type LPs struct {
@@ -30,6 +34,12 @@ type LPs struct {
 	// <addr,id>
 	byAddr collections.KeySet[collections.Pair[string, uint64]]
 	nextID collections.Sequence
+	// order id -> fill, for orders not yet finalized
+	pendingFills collections.Map[string, types.OnDemandLPPendingFill]
+	// <height,id>
+	byExpiryHeight collections.KeySet[collections.Pair[uint64, uint64]]
+	// <time,id>
+	byExpiryTime collections.KeySet[collections.Pair[time.Time, uint64]]
 }
 
 func makeLPsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) LPs {
@@ -53,6 +63,24 @@ func makeLPsStore(sb *collections.SchemaBuilder, cdc codec.BinaryCodec) LPs {
 			),
 		),
 		nextID: collections.NewSequence(sb, LPsNextIDPrefix, "nextID"),
+		pendingFills: collections.NewMap(
+			sb, LPsPendingFillsPrefix, "pendingFills",
+			collections.StringKey, codec.CollValue[types.OnDemandLPPendingFill](cdc),
+		),
+		byExpiryHeight: collections.NewKeySet(
+			sb, LPsByExpiryHeightPrefix, "byExpiryHeight",
+			collections.PairKeyCodec(
+				collections.Uint64Key,
+				collections.Uint64Key,
+			),
+		),
+		byExpiryTime: collections.NewKeySet(
+			sb, LPsByExpiryTimePrefix, "byExpiryTime",
+			collections.PairKeyCodec(
+				sdk.TimeKey,
+				collections.Uint64Key,
+			),
+		),
 	}
 }
 
@@ -63,9 +91,11 @@ func (s LPs) Create(ctx sdk.Context, lp *types.OnDemandLP) (uint64, error) {
 		return 0, errorsmod.Wrap(err, "next id")
 	}
 	if err := s.Set(ctx, types.OnDemandLPRecord{
-		Id:    id,
-		Lp:    lp,
-		Spent: math.ZeroInt(),
+		Id:              id,
+		Lp:              lp,
+		Spent:           math.ZeroInt(),
+		WindowSpent:     math.ZeroInt(),
+		PendingExposure: math.ZeroInt(),
 	}); err != nil {
 		return 0, errorsmod.Wrap(err, "set")
 	}
@@ -91,6 +121,18 @@ func (s LPs) Set(ctx sdk.Context, lp types.OnDemandLPRecord) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "set by rollapp denom")
 	}
+	if lp.Lp.ExpiryHeight != 0 {
+		err = s.byExpiryHeight.Set(ctx, collections.Join(lp.Lp.ExpiryHeight, lp.Id))
+		if err != nil {
+			return errorsmod.Wrap(err, "set by expiry height")
+		}
+	}
+	if lp.Lp.ExpiryTime != nil {
+		err = s.byExpiryTime.Set(ctx, collections.Join(*lp.Lp.ExpiryTime, lp.Id))
+		if err != nil {
+			return errorsmod.Wrap(err, "set by expiry time")
+		}
+	}
 	return nil
 }
 
@@ -133,6 +175,18 @@ func (s LPs) Del(ctx sdk.Context, id uint64, reason string) error {
 	if err != nil {
 		return errorsmod.Wrap(err, "remove by addr")
 	}
+	if lp.Lp.ExpiryHeight != 0 {
+		err = s.byExpiryHeight.Remove(ctx, collections.Join(lp.Lp.ExpiryHeight, lp.Id))
+		if err != nil {
+			return errorsmod.Wrap(err, "remove by expiry height")
+		}
+	}
+	if lp.Lp.ExpiryTime != nil {
+		err = s.byExpiryTime.Remove(ctx, collections.Join(*lp.Lp.ExpiryTime, lp.Id))
+		if err != nil {
+			return errorsmod.Wrap(err, "remove by expiry time")
+		}
+	}
 	if err := uevent.EmitTypedEvent(ctx, &types.EventDeletedOnDemandLP{
 		Id:        id,
 		FundsAddr: lp.Lp.FundsAddr,
@@ -165,6 +219,29 @@ func (s LPs) GetByAddr(ctx sdk.Context, addr sdk.AccAddress) ([]*types.OnDemandL
 	return ret, err
 }
 
+func (s LPs) getByRollAppDenom(ctx sdk.Context, rollapp, denom string) ([]types.OnDemandLPRecord, error) {
+	ranger := collections.NewSuperPrefixedTripleRange[string, string, uint64](rollapp, denom)
+	iter, err := s.byRollAppDenom.Iterate(ctx, ranger)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	var ret []types.OnDemandLPRecord
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.Key()
+		if err != nil {
+			return nil, err
+		}
+		lpr, err := s.byID.Get(ctx, key.K3())
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, lpr)
+	}
+	return ret, nil
+}
+
 func (s LPs) GetOrderCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]types.OnDemandLPRecord, error) {
 	rol := o.RollappId
 	denom := o.Denom()
@@ -188,14 +265,15 @@ func (s LPs) GetOrderCompatibleLPs(ctx sdk.Context, o types.DemandOrder) ([]type
 			return nil, err
 		}
 		h := uint64(ctx.BlockHeight()) //nolint:gosec
-		if lpr.Accepts(h, &o) {
+		if lpr.Accepts(h, ctx.BlockTime(), &o) {
 			compat = append(compat, lpr)
 		}
 	}
 	return compat, nil
 }
 
-func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) error {
+// FulfillByOnDemandLP fulfills the order by the first compatible lp able to, the lps asking the lowest fee first
+func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string) error {
 	o, err := k.GetOutstandingOrder(ctx, order)
 	if err != nil {
 		return errorsmod.Wrap(err, "get outstanding order")
@@ -204,10 +282,7 @@ func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) e
 	if err != nil {
 		return errorsmod.Wrap(err, "get compatible lp")
 	}
-	r := rand.New(rand.NewPCG(rng, 0)) //nolint:gosec // This is used for deterministic shuffling in order processing
-	r.Shuffle(len(lps), func(i, j int) {
-		lps[i], lps[j] = lps[j], lps[i]
-	})
+	sortLPsByBestFee(lps)
 	for _, lp := range lps {
 		err := k.fulfillBasic(ctx, o, lp.Lp.MustAddr())
 		if err != nil {
@@ -228,15 +303,112 @@ func (k Keeper) FulfillByOnDemandLP(ctx sdk.Context, order string, rng uint64) e
 		}); err != nil {
 			return errorsmod.Wrap(err, "emit event")
 		}
-		lp.Spent = lp.Spent.Add(o.PriceAmount())
-		if err = k.LPs.Set(ctx, lp); err != nil {
-			return errorsmod.Wrap(err, "set lp")
+		if err = k.recordLPFill(ctx, &lp, o); err != nil {
+			return errorsmod.Wrap(err, "record fill")
 		}
 		return nil
 	}
 	return errorsmod.Wrap(gerrc.ErrNotFound, "no compatible lp")
 }
 
+// RecordFill updates the lp usage after it fulfilled the order, and tracks the order until it is finalized
+func (s LPs) RecordFill(ctx sdk.Context, lp *types.OnDemandLPRecord, o *types.DemandOrder) error {
+	lp.RecordFill(uint64(ctx.BlockHeight()), o.PriceAmount()) //nolint:gosec
+	if err := s.Set(ctx, *lp); err != nil {
+		return errorsmod.Wrap(err, "set lp")
+	}
+	err := s.pendingFills.Set(ctx, o.Id, types.OnDemandLPPendingFill{
+		LpId:   lp.Id,
+		Amount: o.PriceAmount(),
+	})
+	if err != nil {
+		return errorsmod.Wrap(err, "set pending fill")
+	}
+	return nil
+}
+
+// ReleaseFill frees the exposure taken by the lp which fulfilled the order, if any.
+// Called once the order is not pending anymore.
+func (s LPs) ReleaseFill(ctx sdk.Context, orderID string) error {
+	fill, err := s.pendingFills.Get(ctx, orderID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get pending fill")
+	}
+	if err := s.pendingFills.Remove(ctx, orderID); err != nil {
+		return errorsmod.Wrap(err, "remove pending fill")
+	}
+	lp, err := s.byID.Get(ctx, fill.LpId)
+	if errors.Is(err, collections.ErrNotFound) {
+		// lp was deleted in the meantime
+		return nil
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "get lp")
+	}
+	lp.ReleaseExposure(fill.Amount)
+	return s.Set(ctx, lp)
+}
+
+// DeleteExpired deletes all lps which reached their expiry height or time
+func (s LPs) DeleteExpired(ctx sdk.Context) error {
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	var ids []uint64
+
+	heightRng := new(collections.Range[collections.Pair[uint64, uint64]]).
+		EndInclusive(collections.Join(h, uint64(stdmath.MaxUint64)))
+	heightKeys, err := s.byExpiryHeight.Iterate(ctx, heightRng)
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate by expiry height")
+	}
+	keys, err := heightKeys.Keys()
+	if err != nil {
+		return errorsmod.Wrap(err, "keys by expiry height")
+	}
+	for _, key := range keys {
+		ids = append(ids, key.K2())
+	}
+
+	timeRng := new(collections.Range[collections.Pair[time.Time, uint64]]).
+		EndInclusive(collections.Join(ctx.BlockTime(), uint64(stdmath.MaxUint64)))
+	timeKeys, err := s.byExpiryTime.Iterate(ctx, timeRng)
+	if err != nil {
+		return errorsmod.Wrap(err, "iterate by expiry time")
+	}
+	tkeys, err := timeKeys.Keys()
+	if err != nil {
+		return errorsmod.Wrap(err, "keys by expiry time")
+	}
+	for _, key := range tkeys {
+		ids = append(ids, key.K2())
+	}
+
+	for _, id := range ids {
+		ok, err := s.byID.Has(ctx, id)
+		if err != nil {
+			return errorsmod.Wrap(err, "has")
+		}
+		if !ok {
+			// expired by both height and time
+			continue
+		}
+		if err := s.Del(ctx, id, "expired"); err != nil {
+			return errorsmod.Wrapf(err, "delete lp: %d", id)
+		}
+	}
+	return nil
+}
+
+// recordLPFill accounts for the order against the lp limits and in the lp fulfillment history
+func (k Keeper) recordLPFill(ctx sdk.Context, lp *types.OnDemandLPRecord, o *types.DemandOrder) error {
+	if err := k.LPs.RecordFill(ctx, lp, o); err != nil {
+		return err
+	}
+	return k.Fulfillments.SetLP(ctx, o.Id, lp.Id)
+}
+
 func (k Keeper) CreateLP(ctx sdk.Context, lp *types.OnDemandLP) (uint64, error) {
 	return k.LPs.Create(ctx, lp)
 }
@@ -75,6 +75,62 @@ func (m msgServer) FulfillOrder(goCtx context.Context, msg *types.MsgFulfillOrde
 	return &types.MsgFulfillOrderResponse{}, nil
 }
 
+func (m msgServer) FulfillOrderPartial(goCtx context.Context, msg *types.MsgFulfillOrderPartial) (*types.MsgFulfillOrderPartialResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	demandOrder, err := m.GetOutstandingOrder(ctx, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the fulfiller expected fee is equal to the demand order fee
+	expectedFee, _ := math.NewIntFromString(msg.ExpectedFee)
+	orderFee := demandOrder.GetFeeAmount()
+	if !orderFee.Equal(expectedFee) {
+		return nil, types.ErrExpectedFeeNotMet
+	}
+
+	fulfilled, err := m.fulfillPartial(ctx, demandOrder, msg.GetFulfillerBech32Address(), msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "fulfill partial")
+	}
+
+	return &types.MsgFulfillOrderPartialResponse{Fulfilled: fulfilled}, nil
+}
+
+func (m msgServer) FulfillOrderAltDenom(goCtx context.Context, msg *types.MsgFulfillOrderAltDenom) (*types.MsgFulfillOrderAltDenomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	demandOrder, err := m.GetOutstandingOrder(ctx, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check that the fulfiller expected fee is equal to the demand order fee
+	expectedFee, _ := math.NewIntFromString(msg.ExpectedFee)
+	orderFee := demandOrder.GetFeeAmount()
+	if !orderFee.Equal(expectedFee) {
+		return nil, types.ErrExpectedFeeNotMet
+	}
+
+	paid, err := m.fulfillAltDenom(ctx, demandOrder, msg.GetFulfillerBech32Address(), msg.MaxAmount)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "fulfill alt denom")
+	}
+
+	return &types.MsgFulfillOrderAltDenomResponse{Paid: paid}, nil
+}
+
 func (m msgServer) FulfillOrderAuthorized(goCtx context.Context, msg *types.MsgFulfillOrderAuthorized) (*types.MsgFulfillOrderAuthorizedResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	logger := ctx.Logger()
@@ -205,6 +261,11 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the recipient can update the order")
 	}
 
+	// Partial fulfillers funded the order at the current price, they are refunded
+	if err := m.refundFulfillmentShares(ctx, demandOrder); err != nil {
+		return nil, errorsmod.Wrap(err, "refund fulfillment shares")
+	}
+
 	raPacket, err := m.dack.GetRollappPacket(ctx, demandOrder.TrackingPacketKey)
 	if err != nil {
 		// TODO: isn't this internal error?
@@ -224,17 +285,30 @@ func (m msgServer) UpdateDemandOrder(goCtx context.Context, msg *types.MsgUpdate
 		bridgingFeeMultiplier = math.LegacyZeroDec()
 	}
 
-	// calculate the new price: transferTotal - newFee - bridgingFee
-	newFeeInt, _ := math.NewIntFromString(msg.NewFee)
 	transferTotal, _ := math.NewIntFromString(data.Amount)
-	newPrice, err := types.CalcPriceWithBridgingFee(transferTotal, newFeeInt, bridgingFeeMultiplier)
-	if err != nil {
-		return nil, err
-	}
-
 	denom := demandOrder.Price[0].Denom
-	demandOrder.Fee = sdk.NewCoins(sdk.NewCoin(denom, newFeeInt))
-	demandOrder.Price = sdk.NewCoins(sdk.NewCoin(denom, newPrice))
+
+	if msg.FeeCurve != nil {
+		// both ends of the curve must leave a positive price
+		for _, fee := range []math.Int{msg.FeeCurve.StartFee, msg.FeeCurve.EndFee} {
+			if _, err := types.CalcPriceWithBridgingFee(transferTotal, fee, bridgingFeeMultiplier); err != nil {
+				return nil, errorsmod.Wrap(err, "fee curve")
+			}
+		}
+		demandOrder.FeeCurve = msg.FeeCurve
+		demandOrder.ApplyFeeCurve(uint64(ctx.BlockHeight())) //nolint:gosec
+	} else {
+		// calculate the new price: transferTotal - newFee - bridgingFee
+		newFeeInt, _ := math.NewIntFromString(msg.NewFee)
+		newPrice, err := types.CalcPriceWithBridgingFee(transferTotal, newFeeInt, bridgingFeeMultiplier)
+		if err != nil {
+			return nil, err
+		}
+
+		demandOrder.FeeCurve = nil
+		demandOrder.Fee = sdk.NewCoins(sdk.NewCoin(denom, newFeeInt))
+		demandOrder.Price = sdk.NewCoins(sdk.NewCoin(denom, newPrice))
+	}
 
 	if err = m.SetDemandOrder(ctx, demandOrder); err != nil {
 		return nil, err
@@ -255,8 +329,7 @@ func (m msgServer) TryFulfillOnDemand(goCtx context.Context, msg *types.MsgTryFu
 		return nil, errorsmod.Wrap(err, "vbasic")
 	}
 
-	shuffleSeed := uint64(msg.Rng) //nolint:gosec
-	err = m.FulfillByOnDemandLP(ctx, msg.OrderId, shuffleSeed)
+	err = m.FulfillByOnDemandLP(ctx, msg.OrderId)
 	if err != nil {
 		return nil, err
 	}
@@ -297,3 +370,148 @@ func (m msgServer) DeleteOnDemandLP(goCtx context.Context, msg *types.MsgDeleteO
 
 	return &types.MsgDeleteOnDemandLPResponse{}, nil
 }
+
+func (m msgServer) TransferOrderClaim(goCtx context.Context, msg *types.MsgTransferOrderClaim) (*types.MsgTransferOrderClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	o, err := m.getClaim(ctx, msg.OrderId, msg.Holder)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get claim")
+	}
+
+	err = m.transferClaim(ctx, o, sdk.MustAccAddressFromBech32(msg.NewHolder), nil)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "transfer claim")
+	}
+
+	return &types.MsgTransferOrderClaimResponse{}, nil
+}
+
+func (m msgServer) PlaceClaimSellOrder(goCtx context.Context, msg *types.MsgPlaceClaimSellOrder) (*types.MsgPlaceClaimSellOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	o, err := m.getClaim(ctx, msg.OrderId, msg.Holder)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get claim")
+	}
+
+	err = m.placeClaimSellOrder(ctx, o, msg.Price)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "place sell order")
+	}
+
+	return &types.MsgPlaceClaimSellOrderResponse{}, nil
+}
+
+func (m msgServer) CancelClaimSellOrder(goCtx context.Context, msg *types.MsgCancelClaimSellOrder) (*types.MsgCancelClaimSellOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	so, err := m.Claims.GetSellOrder(ctx, msg.OrderId)
+	if err != nil {
+		return nil, err
+	}
+	if so.Seller != msg.Holder {
+		return nil, types.ErrNotClaimHolder
+	}
+
+	err = m.Claims.sellOrders.Remove(ctx, msg.OrderId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "remove sell order")
+	}
+
+	return &types.MsgCancelClaimSellOrderResponse{}, nil
+}
+
+func (m msgServer) PurchaseClaim(goCtx context.Context, msg *types.MsgPurchaseClaim) (*types.MsgPurchaseClaimResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	o, err := m.getTransferableClaim(ctx, msg.OrderId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get claim")
+	}
+
+	err = m.purchaseClaim(ctx, o, sdk.MustAccAddressFromBech32(msg.Buyer), msg.ExpectedPrice)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "purchase claim")
+	}
+
+	return &types.MsgPurchaseClaimResponse{}, nil
+}
+
+func (m msgServer) PlaceClaimBuyOrder(goCtx context.Context, msg *types.MsgPlaceClaimBuyOrder) (*types.MsgPlaceClaimBuyOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	o, err := m.getTransferableClaim(ctx, msg.OrderId)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get claim")
+	}
+
+	err = m.placeClaimBuyOrder(ctx, o, sdk.MustAccAddressFromBech32(msg.Buyer), msg.Price)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "place buy order")
+	}
+
+	return &types.MsgPlaceClaimBuyOrderResponse{}, nil
+}
+
+func (m msgServer) CancelClaimBuyOrder(goCtx context.Context, msg *types.MsgCancelClaimBuyOrder) (*types.MsgCancelClaimBuyOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	err = m.cancelClaimBuyOrder(ctx, msg.OrderId, sdk.MustAccAddressFromBech32(msg.Buyer))
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "cancel buy order")
+	}
+
+	return &types.MsgCancelClaimBuyOrderResponse{}, nil
+}
+
+func (m msgServer) AcceptClaimBuyOrder(goCtx context.Context, msg *types.MsgAcceptClaimBuyOrder) (*types.MsgAcceptClaimBuyOrderResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "vbasic")
+	}
+
+	o, err := m.getClaim(ctx, msg.OrderId, msg.Holder)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "get claim")
+	}
+
+	err = m.acceptClaimBuyOrder(ctx, o, sdk.MustAccAddressFromBech32(msg.Buyer), msg.MinPrice)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "accept buy order")
+	}
+
+	return &types.MsgAcceptClaimBuyOrderResponse{}, nil
+}
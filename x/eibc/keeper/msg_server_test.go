@@ -195,6 +195,120 @@ func (suite *KeeperTestSuite) TestMsgFulfillOrder() {
 	}
 }
 
+// fund an order from two partial fulfillers, then pay out the proceeds on finalization
+func (suite *KeeperTestSuite) TestMsgFulfillOrderPartial() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1000))
+	orderAddr, fulfiller1, fulfiller2 := addrs[0], addrs[1], addrs[2]
+	escrow := types.FulfillmentSharesEscrow
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	order := types.NewDemandOrder(*rollappPacket, math.NewInt(100), math.NewInt(20), denom, orderAddr.String(), 1, nil)
+	err := k.SetDemandOrder(suite.Ctx, order)
+	suite.Require().NoError(err)
+
+	res, err := suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller1.String(), order.Id, "20", math.NewInt(30)))
+	suite.Require().NoError(err)
+	suite.Require().False(res.Fulfilled)
+	suite.Require().Equal(math.NewInt(30), suite.App.BankKeeper.GetBalance(suite.Ctx, escrow, denom).Amount)
+	suite.Require().Equal(math.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, orderAddr, denom).Amount)
+
+	// dust shares are rejected
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller2.String(), order.Id, "20", math.NewInt(1)))
+	suite.Require().True(errorsmod.IsOf(err, types.ErrPartialAmountTooLow))
+
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller2.String(), order.Id, "20", math.NewInt(80)))
+	suite.Require().True(errorsmod.IsOf(err, types.ErrPartialAmountTooHigh))
+
+	res, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller2.String(), order.Id, "20", math.NewInt(70)))
+	suite.Require().NoError(err)
+	suite.Require().True(res.Fulfilled)
+	suite.Require().Equal(math.NewInt(1100), suite.App.BankKeeper.GetBalance(suite.Ctx, orderAddr, denom).Amount)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, escrow, denom).Amount.IsZero())
+
+	order, err = k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(order.IsFulfilled())
+	suite.Require().Equal(escrow.String(), order.FulfillerAddress)
+	suite.Require().Len(order.FulfillmentShares, 2)
+
+	// simulate the packet funds reaching the escrow on finalization
+	suite.FundAcc(escrow, sdk.NewCoins(sdk.NewCoin(denom, math.NewInt(120))))
+	err = k.SettleFulfillmentShares(suite.Ctx, order, math.NewInt(120), true)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000-30+36), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller1, denom).Amount)
+	suite.Require().Equal(math.NewInt(1000-70+84), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller2, denom).Amount)
+
+	// settling twice is a no-op
+	order, err = k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(order.FulfillmentSharesSettled)
+	err = k.SettleFulfillmentShares(suite.Ctx, order, math.NewInt(120), true)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000-30+36), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller1, denom).Amount)
+}
+
+// an order which never gets fully funded refunds the escrowed shares
+func (suite *KeeperTestSuite) TestFulfillOrderPartialRefund() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(1000))
+	orderAddr, fulfiller := addrs[0], addrs[1]
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	order := types.NewDemandOrder(*rollappPacket, math.NewInt(100), math.NewInt(20), denom, orderAddr.String(), 1, nil)
+	err := k.SetDemandOrder(suite.Ctx, order)
+	suite.Require().NoError(err)
+
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller.String(), order.Id, "20", math.NewInt(40)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(960), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+
+	// the packet is reverted
+	suite.App.DelayedAckKeeper.DeleteRollappPacket(suite.Ctx, rollappPacket)
+	suite.Require().Equal(math.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller, denom).Amount)
+	suite.Require().Equal(math.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, orderAddr, denom).Amount)
+}
+
+// a partially funded order is bought out by a full fulfillment or an update of the order
+func (suite *KeeperTestSuite) TestFulfillOrderPartialBuyout() {
+	k := suite.App.EIBCKeeper
+	denom := sdk.DefaultBondDenom
+	addrs := apptesting.AddTestAddrs(suite.App, suite.Ctx, 3, math.NewInt(1000))
+	orderAddr, fulfiller1, fulfiller2 := addrs[0], addrs[1], addrs[2]
+
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	order := types.NewDemandOrder(*rollappPacket, math.NewInt(100), math.NewInt(20), denom, orderAddr.String(), 1, nil)
+	err := k.SetDemandOrder(suite.Ctx, order)
+	suite.Require().NoError(err)
+
+	// the recipient updating the order refunds the shares
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller1.String(), order.Id, "20", math.NewInt(40)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(960), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller1, denom).Amount)
+	_, err = suite.msgServer.UpdateDemandOrder(suite.Ctx, types.NewMsgUpdateDemandOrder(orderAddr.String(), order.Id, "30"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller1, denom).Amount)
+	order, err = k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().False(order.IsPartiallyFunded())
+
+	// a full fulfillment refunds them as well
+	_, err = suite.msgServer.FulfillOrderPartial(suite.Ctx, types.NewMsgFulfillOrderPartial(fulfiller1.String(), order.Id, "30", math.NewInt(40)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.FulfillOrder(suite.Ctx, types.NewMsgFulfillOrder(fulfiller2.String(), order.Id, "30"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(1000), suite.App.BankKeeper.GetBalance(suite.Ctx, fulfiller1, denom).Amount)
+	suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, types.FulfillmentSharesEscrow, denom).Amount.IsZero())
+
+	order, err = k.GetDemandOrder(suite.Ctx, commontypes.Status_PENDING, order.Id)
+	suite.Require().NoError(err)
+	suite.Require().True(order.IsFulfilled())
+	suite.Require().Equal(fulfiller2.String(), order.FulfillerAddress)
+	suite.Require().Empty(order.FulfillmentShares)
+}
+
 func (suite *KeeperTestSuite) TestMsgFulfillOrderAuthorized() {
 	tests := []struct {
 		name                              string
@@ -614,7 +728,7 @@ func (suite *KeeperTestSuite) TestMsgUpdateDemandOrder() {
 	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(100_000))
 	eibcSupplyAddr := testAddresses[0]
 
-	dackParams := dacktypes.NewParams("hour", math.LegacyNewDecWithPrec(1, 2), 0) // 1%
+	dackParams := dacktypes.NewParams("hour", math.LegacyNewDecWithPrec(1, 2), 0, 0, 0) // 1%
 	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
 	denom, err := suite.App.StakingKeeper.BondDenom(suite.Ctx)
 	suite.Require().NoError(err)
@@ -687,7 +801,7 @@ func (suite *KeeperTestSuite) TestUpdateDemandOrderOnAckOrTimeout() {
 	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(100_000))
 	eibcSupplyAddr := testAddresses[0]
 
-	dackParams := dacktypes.NewParams("hour", math.LegacyNewDecWithPrec(1, 2), 0) // 1%
+	dackParams := dacktypes.NewParams("hour", math.LegacyNewDecWithPrec(1, 2), 0, 0, 0) // 1%
 	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
 
 	denom, err := suite.App.StakingKeeper.BondDenom(suite.Ctx)
@@ -798,7 +912,6 @@ func (suite *KeeperTestSuite) TestMsgOnDemandLPFlow() {
 			msgF := &types.MsgTryFulfillOnDemand{
 				Signer:  orderAddr.String(),
 				OrderId: order.Id,
-				Rng:     0,
 			}
 			_, err = suite.msgServer.TryFulfillOnDemand(suite.Ctx, msgF)
 			orderBalAft := suite.App.BankKeeper.GetBalance(suite.Ctx, orderAddr, sdk.DefaultBondDenom).Amount
@@ -830,3 +943,61 @@ func (suite *KeeperTestSuite) TestMsgOnDemandLPFlow() {
 		})
 	}
 }
+
+// an order following a fee curve reprices itself as blocks go by
+func (suite *KeeperTestSuite) TestMsgUpdateDemandOrderFeeCurve() {
+	testAddresses := apptesting.AddTestAddrs(suite.App, suite.Ctx, 2, math.NewInt(100_000))
+	eibcSupplyAddr, fulfillerAddr := testAddresses[0], testAddresses[1]
+
+	dackParams := dacktypes.NewParams("hour", math.LegacyNewDecWithPrec(1, 2), 0, 0, 0) // 1%
+	suite.App.DelayedAckKeeper.SetParams(suite.Ctx, dackParams)
+	denom, err := suite.App.StakingKeeper.BondDenom(suite.Ctx)
+	suite.Require().NoError(err)
+
+	// total amount 1000 with 1% bridge fee
+	suite.App.DelayedAckKeeper.SetRollappPacket(suite.Ctx, *rollappPacket)
+	demandOrder := types.NewDemandOrder(*rollappPacket, math.NewInt(890), math.NewInt(100), denom, eibcSupplyAddr.String(), 1, nil)
+	err = suite.App.EIBCKeeper.SetDemandOrder(suite.Ctx, demandOrder)
+	suite.Require().NoError(err)
+
+	querier := keeper.NewQuerier(suite.App.EIBCKeeper)
+	ctx := suite.Ctx.WithBlockHeight(100)
+	curve := types.FeeCurve{
+		StartFee:    math.NewInt(10),
+		EndFee:      math.NewInt(110),
+		StartHeight: 100,
+		EndHeight:   200,
+	}
+
+	// the end fee would leave no price
+	tooHigh := curve
+	tooHigh.EndFee = math.NewInt(990)
+	_, err = suite.msgServer.UpdateDemandOrder(ctx, types.NewMsgUpdateDemandOrderFeeCurve(eibcSupplyAddr.String(), demandOrder.Id, tooHigh))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.UpdateDemandOrder(ctx, types.NewMsgUpdateDemandOrderFeeCurve(eibcSupplyAddr.String(), demandOrder.Id, curve))
+	suite.Require().NoError(err)
+
+	res, err := querier.DemandOrderById(ctx, &types.QueryGetDemandOrderRequest{Id: demandOrder.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(10), res.DemandOrder.GetFeeAmount())
+	suite.Require().Equal(math.NewInt(980), res.DemandOrder.PriceAmount())
+
+	// half way through the curve
+	ctx = ctx.WithBlockHeight(150)
+	demandOrder, err = suite.App.EIBCKeeper.GetOutstandingOrder(ctx, demandOrder.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(60), demandOrder.GetFeeAmount())
+
+	_, err = suite.msgServer.FulfillOrder(ctx, types.NewMsgFulfillOrder(fulfillerAddr.String(), demandOrder.Id, "10"))
+	suite.Require().ErrorIs(err, types.ErrExpectedFeeNotMet)
+	_, err = suite.msgServer.FulfillOrder(ctx, types.NewMsgFulfillOrder(fulfillerAddr.String(), demandOrder.Id, "60"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(100_000-930), suite.App.BankKeeper.GetBalance(ctx, fulfillerAddr, denom).Amount)
+
+	// the fulfilled order keeps the price it was fulfilled at
+	ctx = ctx.WithBlockHeight(200)
+	res, err = querier.DemandOrderById(ctx, &types.QueryGetDemandOrderRequest{Id: demandOrder.Id})
+	suite.Require().NoError(err)
+	suite.Require().Equal(math.NewInt(60), res.DemandOrder.GetFeeAmount())
+}
@@ -33,3 +33,11 @@ func (k Keeper) TimeoutFee(ctx sdk.Context) (res math.LegacyDec) {
 func (k Keeper) ErrAckFee(ctx sdk.Context) (res math.LegacyDec) {
 	return k.GetParams(ctx).ErrackFee
 }
+
+func (k Keeper) BatchMatchLimit(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).BatchMatchLimit
+}
+
+func (k Keeper) FulfillmentHistoryRetention(ctx sdk.Context) (res uint64) {
+	return k.GetParams(ctx).FulfillmentHistoryRetention
+}
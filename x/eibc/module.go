@@ -26,7 +26,8 @@ var (
 	_ module.HasServices    = AppModule{}
 	_ module.HasInvariants  = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -147,3 +148,19 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 
 // ConsensusVersion is a sequence number for state-breaking change of the module. It should be incremented on each consensus-breaking change introduced by the module. To avoid wrong/empty versions, the initial version should be set to 1
 func (AppModule) ConsensusVersion() uint64 { return 1 }
+
+// EndBlock deletes expired on demand lps, matches pending demand orders against the remaining ones
+// in a batch auction, and prunes the old fulfillment history.
+func (am AppModule) EndBlock(goCtx context.Context) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := am.keeper.LPs.DeleteExpired(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("Delete expired on demand lps.", "err", err)
+	}
+	if err := am.keeper.MatchBatch(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("Batch match demand orders.", "err", err)
+	}
+	if err := am.keeper.PruneFulfillments(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("Prune fulfillments.", "err", err)
+	}
+	return nil
+}
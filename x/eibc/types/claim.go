@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgTransferOrderClaim{}
+	_ sdk.Msg = &MsgPlaceClaimSellOrder{}
+	_ sdk.Msg = &MsgCancelClaimSellOrder{}
+	_ sdk.Msg = &MsgPurchaseClaim{}
+	_ sdk.Msg = &MsgPlaceClaimBuyOrder{}
+	_ sdk.Msg = &MsgCancelClaimBuyOrder{}
+	_ sdk.Msg = &MsgAcceptClaimBuyOrder{}
+)
+
+func (m *MsgTransferOrderClaim) ValidateBasic() error {
+	if err := validateClaimMsg(m.OrderId, m.Holder, m.NewHolder); err != nil {
+		return err
+	}
+	if m.Holder == m.NewHolder {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new holder is the holder")
+	}
+	return nil
+}
+
+func (m *MsgPlaceClaimSellOrder) ValidateBasic() error {
+	if err := validateClaimMsg(m.OrderId, m.Holder); err != nil {
+		return err
+	}
+	return validateClaimPrice(m.Price)
+}
+
+func (m *MsgCancelClaimSellOrder) ValidateBasic() error {
+	return validateClaimMsg(m.OrderId, m.Holder)
+}
+
+func (m *MsgPurchaseClaim) ValidateBasic() error {
+	if err := validateClaimMsg(m.OrderId, m.Buyer); err != nil {
+		return err
+	}
+	return validateClaimPrice(m.ExpectedPrice)
+}
+
+func (m *MsgPlaceClaimBuyOrder) ValidateBasic() error {
+	if err := validateClaimMsg(m.OrderId, m.Buyer); err != nil {
+		return err
+	}
+	return validateClaimPrice(m.Price)
+}
+
+func (m *MsgCancelClaimBuyOrder) ValidateBasic() error {
+	return validateClaimMsg(m.OrderId, m.Buyer)
+}
+
+func (m *MsgAcceptClaimBuyOrder) ValidateBasic() error {
+	if err := validateClaimMsg(m.OrderId, m.Holder, m.Buyer); err != nil {
+		return err
+	}
+	if m.Holder == m.Buyer {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "buyer is the holder")
+	}
+	return validateClaimPrice(m.MinPrice)
+}
+
+// Validate checks the seller and the price, the order is checked by the caller
+func (so ClaimSellOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(so.Seller); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return validateClaimPrice(so.Price)
+}
+
+// Validate checks the buyer and the price, the order is checked by the caller
+func (bo ClaimBuyOrder) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(bo.Buyer); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	return validateClaimPrice(bo.Price)
+}
+
+func validateClaimMsg(orderId string, address ...string) error {
+	if !isValidOrderId(orderId) {
+		return fmt.Errorf("%w: %s", ErrInvalidOrderID, orderId)
+	}
+	for _, addr := range address {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+		}
+	}
+	return nil
+}
+
+func validateClaimPrice(price sdk.Coin) error {
+	if err := price.Validate(); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if !price.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "price must be positive")
+	}
+	return nil
+}
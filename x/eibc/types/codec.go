@@ -10,12 +10,21 @@ import (
 
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgFulfillOrder{}, "eibc/MsgFulfillOrder", nil)
+	cdc.RegisterConcrete(&MsgFulfillOrderPartial{}, "eibc/MsgFulfillOrderPartial", nil)
+	cdc.RegisterConcrete(&MsgFulfillOrderAltDenom{}, "eibc/MsgFulfillOrderAltDenom", nil)
 	cdc.RegisterConcrete(&MsgFulfillOrderAuthorized{}, "eibc/MsgFulfillOrderAuthorized", nil)
 	cdc.RegisterConcrete(&MsgUpdateDemandOrder{}, "eibc/MsgUpdateDemandOrder", nil)
 	cdc.RegisterConcrete(&MsgCreateOnDemandLP{}, "eibc/CreateOnDemandLP", nil)
 	cdc.RegisterConcrete(&MsgDeleteOnDemandLP{}, "eibc/DeleteOnDemandLP", nil)
 	cdc.RegisterConcrete(&MsgTryFulfillOnDemand{}, "eibc/TryFulfillOnDemand", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "eibc/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgTransferOrderClaim{}, "eibc/TransferOrderClaim", nil)
+	cdc.RegisterConcrete(&MsgPlaceClaimSellOrder{}, "eibc/PlaceClaimSellOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelClaimSellOrder{}, "eibc/CancelClaimSellOrder", nil)
+	cdc.RegisterConcrete(&MsgPurchaseClaim{}, "eibc/PurchaseClaim", nil)
+	cdc.RegisterConcrete(&MsgPlaceClaimBuyOrder{}, "eibc/PlaceClaimBuyOrder", nil)
+	cdc.RegisterConcrete(&MsgCancelClaimBuyOrder{}, "eibc/CancelClaimBuyOrder", nil)
+	cdc.RegisterConcrete(&MsgAcceptClaimBuyOrder{}, "eibc/AcceptClaimBuyOrder", nil)
 	cdc.RegisterConcrete(Params{}, "eibc/Params", nil)
 	cdc.RegisterConcrete(&FulfillOrderAuthorization{}, "eibc/FulfillOrderAuthorization", nil)
 }
@@ -25,12 +34,21 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgFulfillOrder{},
+		&MsgFulfillOrderPartial{},
+		&MsgFulfillOrderAltDenom{},
 		&MsgFulfillOrderAuthorized{},
 		&MsgUpdateDemandOrder{},
 		&MsgCreateOnDemandLP{},
 		&MsgDeleteOnDemandLP{},
 		&MsgTryFulfillOnDemand{},
 		&MsgUpdateParams{},
+		&MsgTransferOrderClaim{},
+		&MsgPlaceClaimSellOrder{},
+		&MsgCancelClaimSellOrder{},
+		&MsgPurchaseClaim{},
+		&MsgPlaceClaimBuyOrder{},
+		&MsgCancelClaimBuyOrder{},
+		&MsgAcceptClaimBuyOrder{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
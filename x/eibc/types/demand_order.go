@@ -4,14 +4,25 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	ibctransfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
 
+// MaxFulfillmentShares is the maximum number of partial fulfillers of a single demand order.
+// It bounds the work done when the proceeds are paid out on finalization.
+const MaxFulfillmentShares = 50
+
+// FulfillmentSharesEscrow holds the shares of the partial fulfillers, and fulfills the orders they fully
+// fund, receiving the packet funds to pay out on finalization. It is not a module account, so that the
+// packet transfers can reach it.
+var FulfillmentSharesEscrow = sdk.AccAddress(address.Module(ModuleName, []byte("fulfillment_shares")))
+
 // NewDemandOrder creates a new demand order.
 // Price is the cost to a market maker to buy the option, (recipient receives straight away).
 // Fee is what the market maker gets in return.
@@ -66,6 +77,45 @@ func (m *DemandOrder) ValidateBasic() error {
 		return ErrInvalidCreationHeight
 	}
 
+	if err := m.validateFulfillmentShares(); err != nil {
+		return err
+	}
+
+	if m.FeeCurve != nil {
+		if err := m.FeeCurve.Validate(m.PriceAmount().Add(m.GetFeeAmount())); err != nil {
+			return err
+		}
+	}
+
+	if m.AltFulfillment != nil {
+		if err := m.AltFulfillment.Validate(denom); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *DemandOrder) validateFulfillmentShares() error {
+	if len(m.FulfillmentShares) > MaxFulfillmentShares {
+		return ErrTooManyFulfillmentShares
+	}
+	seen := make(map[string]struct{}, len(m.FulfillmentShares))
+	for _, s := range m.FulfillmentShares {
+		if _, err := sdk.AccAddressFromBech32(s.Fulfiller); err != nil {
+			return errors.Join(ErrInvalidFulfillmentShare, err)
+		}
+		if _, ok := seen[s.Fulfiller]; ok {
+			return errors.Join(ErrInvalidFulfillmentShare, errors.New("duplicate fulfiller"))
+		}
+		seen[s.Fulfiller] = struct{}{}
+		if s.Amount.IsNil() || !s.Amount.IsPositive() {
+			return errors.Join(ErrInvalidFulfillmentShare, errors.New("amount must be positive"))
+		}
+	}
+	if m.FundedAmount().GT(m.PriceAmount()) {
+		return errors.Join(ErrInvalidFulfillmentShare, errors.New("funded amount exceeds price"))
+	}
 	return nil
 }
 
@@ -112,6 +162,137 @@ func (m *DemandOrder) ValidateOrderIsOutstanding() error {
 	return nil
 }
 
+// FundedAmount returns the part of the price funded by partial fulfillers.
+func (m *DemandOrder) FundedAmount() math.Int {
+	funded := math.ZeroInt()
+	for _, s := range m.FulfillmentShares {
+		funded = funded.Add(s.Amount)
+	}
+	return funded
+}
+
+// IsPartiallyFunded returns true if at least one slice of the price was funded by a partial fulfiller.
+func (m *DemandOrder) IsPartiallyFunded() bool {
+	return len(m.FulfillmentShares) != 0
+}
+
+// MinFulfillmentShare returns the smallest share a partial fulfiller can fund, unless it funds the rest of
+// the price. It lets at most MaxFulfillmentShares fund the order.
+func (m *DemandOrder) MinFulfillmentShare() math.Int {
+	return m.PriceAmount().AddRaw(MaxFulfillmentShares - 1).QuoRaw(MaxFulfillmentShares)
+}
+
+// AddFulfillmentShare adds amount to the share of the fulfiller, creating it if needed.
+func (m *DemandOrder) AddFulfillmentShare(fulfiller string, amount math.Int) {
+	for i := range m.FulfillmentShares {
+		if m.FulfillmentShares[i].Fulfiller == fulfiller {
+			m.FulfillmentShares[i].Amount = m.FulfillmentShares[i].Amount.Add(amount)
+			return
+		}
+	}
+	m.FulfillmentShares = append(m.FulfillmentShares, FulfillmentShare{Fulfiller: fulfiller, Amount: amount})
+}
+
+// SplitByShares splits total between the fulfillment shares pro-rata to the funded amount.
+// Any rounding remainder goes to the first share, so the parts always sum up to total.
+func (m *DemandOrder) SplitByShares(total math.Int) []math.Int {
+	funded := m.FundedAmount()
+	parts := make([]math.Int, len(m.FulfillmentShares))
+	if len(parts) == 0 || !funded.IsPositive() {
+		return parts
+	}
+	rem := total
+	for i, s := range m.FulfillmentShares {
+		parts[i] = total.Mul(s.Amount).Quo(funded)
+		rem = rem.Sub(parts[i])
+	}
+	parts[0] = parts[0].Add(rem)
+	return parts
+}
+
+// EffectiveFee returns the fee of the order at the given hub height.
+func (m *DemandOrder) EffectiveFee(height uint64) math.Int {
+	if m.FeeCurve == nil {
+		return m.GetFeeAmount()
+	}
+	return m.FeeCurve.FeeAt(height)
+}
+
+// ApplyFeeCurve sets the fee and price of the order to the ones effective at the given hub height.
+// Orders which are funded, even partially, keep the price they were funded at.
+func (m *DemandOrder) ApplyFeeCurve(height uint64) {
+	if m.FeeCurve == nil || m.IsFulfilled() || m.IsPartiallyFunded() {
+		return
+	}
+	denom := m.Denom()
+	total := m.PriceAmount().Add(m.GetFeeAmount())
+	fee := m.FeeCurve.FeeAt(height)
+	m.Fee = sdk.NewCoins(sdk.NewCoin(denom, fee))
+	m.Price = sdk.NewCoins(sdk.NewCoin(denom, total.Sub(fee)))
+}
+
+// Validate checks the curve is well-formed and never leaves the order without a price.
+// total is the sum of the price and the fee of the order.
+func (c FeeCurve) Validate(total math.Int) error {
+	if err := c.ValidateBasic(); err != nil {
+		return err
+	}
+	if c.StartFee.GTE(total) || c.EndFee.GTE(total) {
+		return errors.Join(ErrInvalidFeeCurve, ErrFeeTooHigh)
+	}
+	return nil
+}
+
+func (c FeeCurve) ValidateBasic() error {
+	if c.StartFee.IsNil() || c.StartFee.IsNegative() {
+		return errors.Join(ErrInvalidFeeCurve, ErrNegativeFee)
+	}
+	if c.EndFee.IsNil() || c.EndFee.IsNegative() {
+		return errors.Join(ErrInvalidFeeCurve, ErrNegativeFee)
+	}
+	if c.EndHeight <= c.StartHeight {
+		return errors.Join(ErrInvalidFeeCurve, errors.New("end height must be after start height"))
+	}
+	return nil
+}
+
+// FeeAt interpolates the fee linearly between the start and end of the curve.
+func (c FeeCurve) FeeAt(height uint64) math.Int {
+	if height <= c.StartHeight {
+		return c.StartFee
+	}
+	if height >= c.EndHeight {
+		return c.EndFee
+	}
+	elapsed := math.NewIntFromUint64(height - c.StartHeight)
+	span := math.NewIntFromUint64(c.EndHeight - c.StartHeight)
+	return c.StartFee.Add(c.EndFee.Sub(c.StartFee).Mul(elapsed).Quo(span))
+}
+
+func (a AltFulfillment) Validate(orderDenom string) error {
+	if err := sdk.ValidateDenom(a.Denom); err != nil {
+		return errors.Join(ErrInvalidAltFulfillment, err)
+	}
+	if a.Denom == orderDenom {
+		return errors.Join(ErrInvalidAltFulfillment, errors.New("denom must differ from the order denom"))
+	}
+	if a.PoolId == 0 {
+		return errors.Join(ErrInvalidAltFulfillment, errors.New("pool id"))
+	}
+	if a.MaxSlippage.IsNil() || a.MaxSlippage.IsNegative() || a.MaxSlippage.GTE(math.LegacyOneDec()) {
+		return errors.Join(ErrInvalidAltFulfillment, errors.New("max slippage must be in [0, 1)"))
+	}
+	if a.ReferencePrice.IsNil() || !a.ReferencePrice.IsPositive() {
+		return errors.Join(ErrInvalidAltFulfillment, errors.New("reference price must be positive"))
+	}
+	return nil
+}
+
+// PriceSource describes the pool used to price the alternative denom
+func (a AltFulfillment) PriceSource() string {
+	return fmt.Sprintf("gamm/pool/%d", a.PoolId)
+}
+
 func (m *DemandOrder) IsFulfilled() bool {
 	return m.FulfillerAddress != "" || m.DeprecatedIsFulfilled
 }
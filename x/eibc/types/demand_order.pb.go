@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -56,6 +58,22 @@ type DemandOrder struct {
 	CreationHeight uint64 `protobuf:"varint,12,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
 	// an optional hook which uses the funds when the order is fulfilled
 	CompletionHook *types1.CompletionHookCall `protobuf:"bytes,13,opt,name=completion_hook,json=completionHook,proto3" json:"completion_hook,omitempty"`
+	// fulfillment_shares are the slices of the price funded by partial
+	// fulfillers. Non-empty only for orders funded through
+	// MsgFulfillOrderPartial. Once the shares cover the whole price, the order is
+	// fulfilled by the module account and the proceeds are paid out pro-rata on
+	// finalization.
+	FulfillmentShares []FulfillmentShare `protobuf:"bytes,14,rep,name=fulfillment_shares,json=fulfillmentShares,proto3" json:"fulfillment_shares"`
+	// fulfillment_shares_settled is set once the fulfillment shares were paid
+	// out or refunded.
+	FulfillmentSharesSettled bool `protobuf:"varint,15,opt,name=fulfillment_shares_settled,json=fulfillmentSharesSettled,proto3" json:"fulfillment_shares_settled,omitempty"`
+	// fee_curve is an optional schedule which reprices the order over time.
+	// While set, the fee and price above are the ones effective at the last
+	// update of the order, the up to date values follow the curve.
+	FeeCurve *FeeCurve `protobuf:"bytes,16,opt,name=fee_curve,json=feeCurve,proto3" json:"fee_curve,omitempty"`
+	// alt_fulfillment optionally lets the order be fulfilled in another denom,
+	// as requested by the eIBC memo of the packet.
+	AltFulfillment *AltFulfillment `protobuf:"bytes,17,opt,name=alt_fulfillment,json=altFulfillment,proto3" json:"alt_fulfillment,omitempty"`
 }
 
 func (m *DemandOrder) Reset()         { *m = DemandOrder{} }
@@ -176,8 +194,210 @@ func (m *DemandOrder) GetCompletionHook() *types1.CompletionHookCall {
 	return nil
 }
 
+func (m *DemandOrder) GetFulfillmentShares() []FulfillmentShare {
+	if m != nil {
+		return m.FulfillmentShares
+	}
+	return nil
+}
+
+func (m *DemandOrder) GetFulfillmentSharesSettled() bool {
+	if m != nil {
+		return m.FulfillmentSharesSettled
+	}
+	return false
+}
+
+func (m *DemandOrder) GetFeeCurve() *FeeCurve {
+	if m != nil {
+		return m.FeeCurve
+	}
+	return nil
+}
+
+func (m *DemandOrder) GetAltFulfillment() *AltFulfillment {
+	if m != nil {
+		return m.AltFulfillment
+	}
+	return nil
+}
+
+// AltFulfillment allows a demand order to be fulfilled in a denom other than
+// the packet denom. The amount due is what swapping the price through the pool
+// would yield, and fulfillment fails if that is worse than the reference price
+// by more than max_slippage.
+type AltFulfillment struct {
+	Denom       string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	PoolId      uint64                      `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	MaxSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_slippage,json=maxSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_slippage"`
+	// reference_price is the spot price of the pool, in denom per order denom,
+	// when the order was created. Fulfillment is checked against it rather than
+	// against the spot price at fulfillment, which the fulfiller could move in
+	// the same transaction.
+	ReferencePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=reference_price,json=referencePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"reference_price"`
+}
+
+func (m *AltFulfillment) Reset()         { *m = AltFulfillment{} }
+func (m *AltFulfillment) String() string { return proto.CompactTextString(m) }
+func (*AltFulfillment) ProtoMessage()    {}
+func (*AltFulfillment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{1}
+}
+func (m *AltFulfillment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AltFulfillment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AltFulfillment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AltFulfillment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AltFulfillment.Merge(m, src)
+}
+func (m *AltFulfillment) XXX_Size() int {
+	return m.Size()
+}
+func (m *AltFulfillment) XXX_DiscardUnknown() {
+	xxx_messageInfo_AltFulfillment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AltFulfillment proto.InternalMessageInfo
+
+func (m *AltFulfillment) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *AltFulfillment) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+// FeeCurve moves the fee of a demand order linearly from start_fee to end_fee
+// between start_height and end_height. The sum of fee and price is constant,
+// so a higher fee means a lower price for the fulfiller.
+type FeeCurve struct {
+	// start_fee is the fee up to and including start_height.
+	StartFee cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=start_fee,json=startFee,proto3,customtype=cosmossdk.io/math.Int" json:"start_fee"`
+	// end_fee is the fee from end_height onwards.
+	EndFee      cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=end_fee,json=endFee,proto3,customtype=cosmossdk.io/math.Int" json:"end_fee"`
+	StartHeight uint64                `protobuf:"varint,3,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight   uint64                `protobuf:"varint,4,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+}
+
+func (m *FeeCurve) Reset()         { *m = FeeCurve{} }
+func (m *FeeCurve) String() string { return proto.CompactTextString(m) }
+func (*FeeCurve) ProtoMessage()    {}
+func (*FeeCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{2}
+}
+func (m *FeeCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeCurve.Merge(m, src)
+}
+func (m *FeeCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeCurve proto.InternalMessageInfo
+
+func (m *FeeCurve) GetStartHeight() uint64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *FeeCurve) GetEndHeight() uint64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+// FulfillmentShare is the part of a demand order price funded by a single
+// fulfiller.
+type FulfillmentShare struct {
+	// fulfiller is the bech32-encoded address of the account which funded the
+	// share. It receives its part of the proceeds on finalization.
+	Fulfiller string `protobuf:"bytes,1,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// amount is the part of the order price funded by the fulfiller.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *FulfillmentShare) Reset()         { *m = FulfillmentShare{} }
+func (m *FulfillmentShare) String() string { return proto.CompactTextString(m) }
+func (*FulfillmentShare) ProtoMessage()    {}
+func (*FulfillmentShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2fc99140861fbacd, []int{3}
+}
+func (m *FulfillmentShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FulfillmentShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FulfillmentShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FulfillmentShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FulfillmentShare.Merge(m, src)
+}
+func (m *FulfillmentShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *FulfillmentShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_FulfillmentShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FulfillmentShare proto.InternalMessageInfo
+
+func (m *FulfillmentShare) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*DemandOrder)(nil), "dymensionxyz.dymension.eibc.DemandOrder")
+	proto.RegisterType((*AltFulfillment)(nil), "dymensionxyz.dymension.eibc.AltFulfillment")
+	proto.RegisterType((*FeeCurve)(nil), "dymensionxyz.dymension.eibc.FeeCurve")
+	proto.RegisterType((*FulfillmentShare)(nil), "dymensionxyz.dymension.eibc.FulfillmentShare")
 }
 
 func init() {
@@ -185,42 +405,63 @@ func init() {
 }
 
 var fileDescriptor_2fc99140861fbacd = []byte{
-	// 551 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x6e, 0xd4, 0x3c,
-	0x18, 0x9d, 0x4c, 0x2f, 0x6a, 0x3d, 0xff, 0x3f, 0xa5, 0xa6, 0x80, 0x29, 0x90, 0x46, 0x48, 0x88,
-	0x08, 0x84, 0xc3, 0xb4, 0x3b, 0x76, 0xb4, 0x80, 0x5a, 0x75, 0x01, 0x0a, 0xac, 0x8a, 0x50, 0xe4,
-	0xb1, 0xbf, 0x4e, 0xad, 0x5c, 0x1c, 0xc5, 0x6e, 0xd5, 0xf0, 0x14, 0x3c, 0x07, 0x4f, 0xd2, 0x65,
-	0x97, 0xac, 0x0a, 0x6a, 0x5f, 0x04, 0xc5, 0xce, 0xf4, 0x26, 0x4d, 0xbb, 0x61, 0x65, 0xe7, 0xf8,
-	0x1c, 0x1f, 0x7f, 0xc7, 0x9f, 0x83, 0xa8, 0xa8, 0x73, 0x28, 0xb4, 0x54, 0xc5, 0x61, 0xfd, 0x3d,
-	0x3a, 0xff, 0x88, 0x40, 0x0e, 0x79, 0x24, 0x20, 0x67, 0x85, 0x48, 0x54, 0x25, 0xa0, 0xa2, 0x65,
-	0xa5, 0x8c, 0xc2, 0x8f, 0x2e, 0xf3, 0x2f, 0xc4, 0xb4, 0xe1, 0x2f, 0xfb, 0x5c, 0xe9, 0x5c, 0xe9,
-	0x68, 0xc8, 0x34, 0x44, 0x07, 0x83, 0x21, 0x18, 0x36, 0x88, 0xb8, 0x92, 0x85, 0x13, 0x2f, 0xaf,
-	0x4d, 0x30, 0xe3, 0x2a, 0xcf, 0xdd, 0x50, 0x66, 0x60, 0xa4, 0x2a, 0x92, 0x3d, 0xa5, 0xd2, 0x56,
-	0xb4, 0x7a, 0xb3, 0xa8, 0x52, 0x59, 0xc6, 0xca, 0x32, 0x29, 0x19, 0x4f, 0xc1, 0xb4, 0x9a, 0x17,
-	0x37, 0x6b, 0xb4, 0x61, 0x66, 0x5f, 0xb7, 0xdc, 0xa5, 0x91, 0x1a, 0x29, 0x3b, 0x8d, 0x9a, 0x99,
-	0x43, 0x9f, 0x9e, 0xcc, 0xa0, 0xde, 0x3b, 0x5b, 0xfe, 0xc7, 0xa6, 0x7a, 0xdc, 0x47, 0x5d, 0x29,
-	0x88, 0x17, 0x78, 0xe1, 0x7c, 0xdc, 0x95, 0x02, 0x53, 0x74, 0xd7, 0x54, 0x8c, 0xa7, 0xb2, 0x18,
-	0xb5, 0xd6, 0x49, 0x0a, 0x35, 0xe9, 0x5a, 0xc2, 0xe2, 0x78, 0xe9, 0x93, 0x5d, 0xd9, 0x86, 0x1a,
-	0x33, 0x34, 0x53, 0x56, 0x92, 0x03, 0x99, 0x0a, 0xa6, 0xc2, 0xde, 0xea, 0x43, 0xea, 0xa2, 0xa2,
-	0x4d, 0x54, 0xb4, 0x8d, 0x8a, 0x6e, 0x28, 0x59, 0xac, 0xbf, 0x3e, 0x3a, 0x59, 0xe9, 0xfc, 0xfc,
-	0xbd, 0x12, 0x8e, 0xa4, 0xd9, 0xdb, 0x1f, 0x52, 0xae, 0xf2, 0xa8, 0xcd, 0xd5, 0x0d, 0xaf, 0xb4,
-	0x48, 0x23, 0x53, 0x97, 0xa0, 0xad, 0x40, 0xc7, 0x6e, 0x67, 0xfc, 0x0d, 0x4d, 0xed, 0x02, 0x90,
-	0xe9, 0x7f, 0x6f, 0xd0, 0xec, 0x8b, 0x1f, 0xa3, 0xf9, 0x0a, 0xb8, 0x2c, 0x25, 0x14, 0x86, 0xcc,
-	0xd8, 0x3a, 0x2f, 0x00, 0xfc, 0x06, 0x3d, 0x10, 0x50, 0x56, 0xc0, 0x99, 0x01, 0x91, 0x48, 0x9d,
-	0xec, 0xee, 0x67, 0xbb, 0x32, 0xcb, 0x40, 0x90, 0xd9, 0xc0, 0x0b, 0xe7, 0xd6, 0xbb, 0xc4, 0x8b,
-	0xef, 0x5d, 0x50, 0xb6, 0xf4, 0x87, 0x31, 0x01, 0x7f, 0x45, 0xf7, 0xaf, 0x67, 0xe9, 0x6e, 0x88,
-	0xcc, 0x05, 0x5e, 0xd8, 0x5f, 0x7d, 0x46, 0x27, 0x34, 0x9d, 0xbb, 0x4e, 0xfa, 0xd9, 0x92, 0xe3,
-	0xa5, 0xab, 0xa9, 0x3b, 0x14, 0x3f, 0x41, 0x68, 0xdc, 0x22, 0x52, 0x90, 0xf9, 0xf6, 0xdc, 0x0e,
-	0xd9, 0x12, 0xf8, 0x3d, 0x9a, 0x6e, 0x2a, 0x25, 0xc8, 0x3a, 0x0d, 0x6e, 0x71, 0x8a, 0x9d, 0xce,
-	0x19, 0xd0, 0x2f, 0x75, 0x09, 0xb1, 0x95, 0xe3, 0x97, 0x68, 0x71, 0x5c, 0x70, 0x95, 0x30, 0x21,
-	0x2a, 0xd0, 0x9a, 0xf4, 0xac, 0xd9, 0x9d, 0xf3, 0x85, 0xb7, 0x0e, 0xc7, 0xcf, 0xd1, 0x02, 0xaf,
-	0x80, 0xb9, 0x46, 0x07, 0x39, 0xda, 0x33, 0xe4, 0xbf, 0xc0, 0x0b, 0xa7, 0xe3, 0xfe, 0x18, 0xde,
-	0xb4, 0x28, 0xde, 0x41, 0x0b, 0xd7, 0xde, 0x04, 0xf9, 0x3f, 0xf0, 0xc2, 0xde, 0xad, 0xe7, 0xdc,
-	0x38, 0x57, 0x6d, 0x2a, 0x95, 0x6e, 0xb0, 0x2c, 0x8b, 0xfb, 0xfc, 0x0a, 0xb6, 0xbe, 0x7d, 0x74,
-	0xea, 0x7b, 0xc7, 0xa7, 0xbe, 0xf7, 0xe7, 0xd4, 0xf7, 0x7e, 0x9c, 0xf9, 0x9d, 0xe3, 0x33, 0xbf,
-	0xf3, 0xeb, 0xcc, 0xef, 0xec, 0x0c, 0x2e, 0xf5, 0xc5, 0x84, 0x77, 0x74, 0xb0, 0x16, 0x1d, 0xba,
-	0x5f, 0x84, 0x6d, 0x93, 0xe1, 0xac, 0x7d, 0x34, 0x6b, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x72,
-	0xa5, 0x5b, 0xb0, 0x4e, 0x04, 0x00, 0x00,
+	// 892 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xce, 0x3a, 0x8e, 0x6b, 0x8f, 0x83, 0x93, 0x0c, 0x29, 0xdd, 0xa6, 0xd4, 0x31, 0x91, 0x2a,
+	0x2c, 0xa2, 0xec, 0xe2, 0xe4, 0x0e, 0x71, 0x53, 0x3b, 0x44, 0xb1, 0x8a, 0x44, 0xb5, 0xc9, 0x55,
+	0x11, 0x5a, 0x8d, 0x77, 0x8e, 0xed, 0x91, 0x77, 0x77, 0x56, 0x3b, 0xe3, 0x28, 0xe6, 0x29, 0xb8,
+	0xe6, 0x11, 0xb8, 0xe6, 0x21, 0x7a, 0x59, 0x71, 0x85, 0x10, 0x2a, 0x28, 0x79, 0x02, 0xde, 0x00,
+	0xcd, 0x8f, 0x7f, 0x92, 0x28, 0xae, 0x88, 0xb8, 0xda, 0x3d, 0x3f, 0xdf, 0x77, 0x7e, 0xe6, 0xcc,
+	0x19, 0xe4, 0xd1, 0x49, 0x02, 0xa9, 0x60, 0x3c, 0xbd, 0x9c, 0xfc, 0xe8, 0xcf, 0x04, 0x1f, 0x58,
+	0x2f, 0xf2, 0x29, 0x24, 0x24, 0xa5, 0x21, 0xcf, 0x29, 0xe4, 0x5e, 0x96, 0x73, 0xc9, 0xf1, 0xb3,
+	0x45, 0xff, 0x39, 0xd8, 0x53, 0xfe, 0x3b, 0xf5, 0x88, 0x8b, 0x84, 0x0b, 0xbf, 0x47, 0x04, 0xf8,
+	0x17, 0xad, 0x1e, 0x48, 0xd2, 0xf2, 0x23, 0xce, 0x52, 0x03, 0xde, 0x79, 0x6a, 0xec, 0xa1, 0x96,
+	0x7c, 0x23, 0x58, 0xd3, 0xd1, 0x3d, 0x79, 0x44, 0x3c, 0x49, 0xcc, 0x27, 0x8b, 0x41, 0x32, 0x9e,
+	0x86, 0x43, 0xce, 0x47, 0x16, 0x74, 0xb8, 0x1c, 0x94, 0xf3, 0x38, 0x26, 0x59, 0x16, 0x66, 0x24,
+	0x1a, 0x81, 0xb4, 0x98, 0x2f, 0x96, 0x63, 0x84, 0x24, 0x72, 0x3c, 0x4d, 0x6a, 0x7b, 0xc0, 0x07,
+	0xdc, 0x24, 0xab, 0xfe, 0x8c, 0x76, 0xef, 0xe7, 0x32, 0xaa, 0x1e, 0xeb, 0xce, 0x7c, 0xa7, 0x1a,
+	0x83, 0x6b, 0xa8, 0xc0, 0xa8, 0xeb, 0x34, 0x9c, 0x66, 0x25, 0x28, 0x30, 0x8a, 0x3d, 0xf4, 0xb1,
+	0xcc, 0x49, 0x34, 0x62, 0xe9, 0xc0, 0x86, 0x0e, 0x47, 0x30, 0x71, 0x0b, 0xda, 0x61, 0x6b, 0x6a,
+	0x7a, 0xad, 0x2d, 0xaf, 0x60, 0x82, 0x09, 0x5a, 0xcb, 0x72, 0x16, 0x81, 0xbb, 0xda, 0x58, 0x6d,
+	0x56, 0x0f, 0x9f, 0x7a, 0xb6, 0x31, 0xaa, 0x8b, 0x9e, 0xed, 0xa2, 0xd7, 0xe1, 0x2c, 0x6d, 0x7f,
+	0xf9, 0xf6, 0xfd, 0xee, 0xca, 0x2f, 0x7f, 0xed, 0x36, 0x07, 0x4c, 0x0e, 0xc7, 0x3d, 0x2f, 0xe2,
+	0x89, 0xed, 0xa2, 0xfd, 0x1c, 0x08, 0x3a, 0xf2, 0xe5, 0x24, 0x03, 0xa1, 0x01, 0x22, 0x30, 0xcc,
+	0xf8, 0x07, 0xb4, 0xda, 0x07, 0x70, 0x8b, 0xff, 0x7f, 0x00, 0xc5, 0x8b, 0x3f, 0x45, 0x95, 0x1c,
+	0x22, 0x96, 0x31, 0x48, 0xa5, 0xbb, 0xa6, 0xeb, 0x9c, 0x2b, 0xf0, 0x57, 0xe8, 0x09, 0x85, 0x2c,
+	0x87, 0x88, 0x48, 0xa0, 0x21, 0x13, 0x61, 0x7f, 0x1c, 0xf7, 0x59, 0x1c, 0x03, 0x75, 0x4b, 0x0d,
+	0xa7, 0x59, 0x6e, 0x17, 0x5c, 0x27, 0x78, 0x3c, 0x77, 0xe9, 0x8a, 0x93, 0xa9, 0x03, 0xfe, 0x1e,
+	0x7d, 0x72, 0xbb, 0x97, 0xe6, 0x84, 0xdc, 0x72, 0xc3, 0x69, 0xd6, 0x0e, 0x5f, 0x78, 0xf7, 0xcc,
+	0xa3, 0x39, 0x4e, 0xef, 0x4c, 0x3b, 0x07, 0xdb, 0x37, 0xbb, 0x6e, 0xb4, 0xf8, 0x39, 0x42, 0xd3,
+	0x11, 0x61, 0xd4, 0xad, 0xd8, 0xbc, 0x8d, 0xa6, 0x4b, 0xf1, 0x37, 0xa8, 0xa8, 0x2a, 0x75, 0x91,
+	0x8e, 0xd4, 0xfa, 0x40, 0xa4, 0xc0, 0xe0, 0x4c, 0x00, 0xef, 0x7c, 0x92, 0x41, 0xa0, 0xe1, 0x78,
+	0x1f, 0x6d, 0x4d, 0x0b, 0xce, 0x43, 0x42, 0x69, 0x0e, 0x42, 0xb8, 0x55, 0x1d, 0x6c, 0x73, 0x66,
+	0x78, 0x69, 0xf4, 0xf8, 0x73, 0xb4, 0x11, 0xe5, 0x40, 0xcc, 0xa0, 0x03, 0x1b, 0x0c, 0xa5, 0xbb,
+	0xde, 0x70, 0x9a, 0xc5, 0xa0, 0x36, 0x55, 0x9f, 0x6a, 0x2d, 0x7e, 0x83, 0x36, 0x6e, 0xdd, 0x09,
+	0xf7, 0xa3, 0x86, 0xd3, 0xac, 0x7e, 0x30, 0xcf, 0xce, 0x0c, 0x75, 0xca, 0xf9, 0xa8, 0x43, 0xe2,
+	0x38, 0xa8, 0x45, 0x37, 0x74, 0xb8, 0x87, 0xb0, 0x4d, 0x2c, 0x81, 0x54, 0x86, 0x62, 0x48, 0x72,
+	0x10, 0x6e, 0x4d, 0x0f, 0xcf, 0x81, 0xb7, 0x64, 0x01, 0x78, 0x27, 0x73, 0xd8, 0x99, 0x42, 0xb5,
+	0x8b, 0x6a, 0xa0, 0x82, 0xad, 0xfe, 0x2d, 0xbd, 0xc0, 0x5f, 0xa3, 0x9d, 0xbb, 0x31, 0x42, 0x01,
+	0x52, 0xaa, 0xb9, 0xd8, 0x50, 0x73, 0x11, 0xb8, 0x77, 0x60, 0x67, 0xc6, 0x8e, 0xdb, 0xa8, 0xd2,
+	0x07, 0x08, 0xa3, 0x71, 0x7e, 0x01, 0xee, 0xa6, 0xae, 0xfb, 0xc5, 0xf2, 0xc4, 0x00, 0x3a, 0xca,
+	0x39, 0x28, 0xf7, 0xed, 0x1f, 0x3e, 0x47, 0x1b, 0x24, 0x96, 0xe1, 0x42, 0x0c, 0x77, 0x4b, 0x33,
+	0xed, 0x2f, 0x65, 0x7a, 0x19, 0xcb, 0x85, 0x2a, 0x83, 0x1a, 0xb9, 0x21, 0xef, 0xfd, 0xe3, 0xa0,
+	0xda, 0x4d, 0x17, 0xbc, 0x8d, 0xd6, 0x28, 0xa4, 0x3c, 0xb1, 0x2b, 0xc2, 0x08, 0xf8, 0x09, 0x7a,
+	0x94, 0x71, 0x1e, 0xab, 0xc9, 0x2b, 0xe8, 0x13, 0x2e, 0x29, 0xb1, 0x4b, 0xf1, 0x39, 0x5a, 0x4f,
+	0xc8, 0x65, 0x28, 0x62, 0x96, 0x65, 0x64, 0xa0, 0xb6, 0x82, 0xd3, 0xac, 0xb4, 0x5b, 0xaa, 0x91,
+	0x7f, 0xbc, 0xdf, 0x7d, 0x66, 0xee, 0xa1, 0xa0, 0x23, 0x8f, 0x71, 0x3f, 0x21, 0x72, 0xe8, 0x7d,
+	0x0b, 0x03, 0x12, 0x4d, 0x8e, 0x21, 0xfa, 0xed, 0xd7, 0x03, 0x64, 0xcc, 0xde, 0x31, 0x44, 0x41,
+	0x35, 0x21, 0x97, 0x67, 0x96, 0x45, 0xcd, 0x4b, 0x0e, 0x7d, 0xc8, 0x21, 0x8d, 0x20, 0x34, 0xeb,
+	0xa6, 0xf8, 0x50, 0xe2, 0xda, 0x8c, 0xe9, 0xb5, 0x22, 0xda, 0xfb, 0xd3, 0x41, 0xe5, 0x69, 0x83,
+	0xf1, 0x29, 0xaa, 0x08, 0x49, 0x72, 0x19, 0xaa, 0x85, 0xa3, 0x2b, 0x6e, 0xef, 0xdb, 0x10, 0x8f,
+	0xef, 0x86, 0xe8, 0xa6, 0x72, 0x81, 0xbc, 0x9b, 0xca, 0xa0, 0xac, 0xd1, 0x27, 0x00, 0xf8, 0x18,
+	0x3d, 0x82, 0x94, 0x6a, 0x9e, 0xc2, 0x7f, 0xe7, 0x29, 0x41, 0x4a, 0x15, 0xcb, 0x67, 0x68, 0xdd,
+	0xe4, 0x63, 0xaf, 0xd3, 0xaa, 0x6e, 0x76, 0x55, 0xeb, 0xec, 0x5d, 0x7a, 0x8e, 0x90, 0x0a, 0x64,
+	0x1d, 0x8a, 0xda, 0xa1, 0x02, 0x29, 0x35, 0xe6, 0xbd, 0x31, 0xda, 0xbc, 0x3d, 0xd7, 0x6a, 0xe3,
+	0xcd, 0xee, 0xae, 0x3d, 0xd7, 0xb9, 0x02, 0x77, 0x50, 0x89, 0x24, 0x7c, 0x9c, 0xca, 0x07, 0x25,
+	0x6e, 0xa0, 0xed, 0x57, 0x6f, 0xaf, 0xea, 0xce, 0xbb, 0xab, 0xba, 0xf3, 0xf7, 0x55, 0xdd, 0xf9,
+	0xe9, 0xba, 0xbe, 0xf2, 0xee, 0xba, 0xbe, 0xf2, 0xfb, 0x75, 0x7d, 0xe5, 0x4d, 0x6b, 0x61, 0x3b,
+	0xdf, 0xf3, 0x9a, 0x5d, 0x1c, 0xf9, 0x97, 0xe6, 0x0d, 0xd7, 0xcb, 0xba, 0x57, 0xd2, 0x4f, 0xd7,
+	0xd1, 0xbf, 0x03, 0x00, 0xa1, 0xa9, 0xb7, 0x94, 0xef, 0x07, 0x00, 0x00,
 }
 
 func (m *DemandOrder) Marshal() (dAtA []byte, err error) {
@@ -243,6 +484,58 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AltFulfillment != nil {
+		{
+			size, err := m.AltFulfillment.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.FeeCurve != nil {
+		{
+			size, err := m.FeeCurve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.FulfillmentSharesSettled {
+		i--
+		if m.FulfillmentSharesSettled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.FulfillmentShares) > 0 {
+		for iNdEx := len(m.FulfillmentShares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FulfillmentShares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	if m.CompletionHook != nil {
 		{
 			size, err := m.CompletionHook.MarshalToSizedBuffer(dAtA[:i])
@@ -346,6 +639,154 @@ func (m *DemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AltFulfillment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AltFulfillment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AltFulfillment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ReferencePrice.Size()
+		i -= size
+		if _, err := m.ReferencePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolId != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndHeight != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintDemandOrder(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.EndFee.Size()
+		i -= size
+		if _, err := m.EndFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StartFee.Size()
+		i -= size
+		if _, err := m.StartFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FulfillmentShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FulfillmentShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FulfillmentShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintDemandOrder(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintDemandOrder(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintDemandOrder(dAtA []byte, offset int, v uint64) int {
 	offset -= sovDemandOrder(v)
 	base := offset
@@ -411,16 +852,604 @@ func (m *DemandOrder) Size() (n int) {
 		l = m.CompletionHook.Size()
 		n += 1 + l + sovDemandOrder(uint64(l))
 	}
-	return n
-}
+	if len(m.FulfillmentShares) > 0 {
+		for _, e := range m.FulfillmentShares {
+			l = e.Size()
+			n += 1 + l + sovDemandOrder(uint64(l))
+		}
+	}
+	if m.FulfillmentSharesSettled {
+		n += 2
+	}
+	if m.FeeCurve != nil {
+		l = m.FeeCurve.Size()
+		n += 2 + l + sovDemandOrder(uint64(l))
+	}
+	if m.AltFulfillment != nil {
+		l = m.AltFulfillment.Size()
+		n += 2 + l + sovDemandOrder(uint64(l))
+	}
+	return n
+}
+
+func (m *AltFulfillment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovDemandOrder(uint64(m.PoolId))
+	}
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	l = m.ReferencePrice.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	return n
+}
+
+func (m *FeeCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartFee.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	l = m.EndFee.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	if m.StartHeight != 0 {
+		n += 1 + sovDemandOrder(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovDemandOrder(uint64(m.EndHeight))
+	}
+	return n
+}
+
+func (m *FulfillmentShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovDemandOrder(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovDemandOrder(uint64(l))
+	return n
+}
+
+func sovDemandOrder(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozDemandOrder(x uint64) (n int) {
+	return sovDemandOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DemandOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DemandOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingPacketKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TrackingPacketKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedIsFulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DeprecatedIsFulfilled = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TrackingPacketStatus", wireType)
+			}
+			m.TrackingPacketStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TrackingPacketStatus |= types1.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types1.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CompletionHook == nil {
+				m.CompletionHook = &types1.CompletionHookCall{}
+			}
+			if err := m.CompletionHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentShares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillmentShares = append(m.FulfillmentShares, FulfillmentShare{})
+			if err := m.FulfillmentShares[len(m.FulfillmentShares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentSharesSettled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FulfillmentSharesSettled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeCurve == nil {
+				m.FeeCurve = &FeeCurve{}
+			}
+			if err := m.FeeCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AltFulfillment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDemandOrder
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AltFulfillment == nil {
+				m.AltFulfillment = &AltFulfillment{}
+			}
+			if err := m.AltFulfillment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovDemandOrder(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozDemandOrder(x uint64) (n int) {
-	return sovDemandOrder(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *DemandOrder) Unmarshal(dAtA []byte) error {
+func (m *AltFulfillment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -443,15 +1472,15 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DemandOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: AltFulfillment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AltFulfillment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -479,13 +1508,13 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrackingPacketKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDemandOrder
@@ -495,29 +1524,16 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDemandOrder
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthDemandOrder
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TrackingPacketKey = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDemandOrder
@@ -527,31 +1543,31 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDemandOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDemandOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = append(m.Price, types.Coin{})
-			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReferencePrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDemandOrder
@@ -561,29 +1577,79 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDemandOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDemandOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fee = append(m.Fee, types.Coin{})
-			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ReferencePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -611,13 +1677,15 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			if err := m.StartFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeprecatedIsFulfilled", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndFee", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDemandOrder
@@ -627,17 +1695,31 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.DeprecatedIsFulfilled = bool(v != 0)
-		case 8:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDemandOrder
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TrackingPacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
 			}
-			m.TrackingPacketStatus = 0
+			m.StartHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDemandOrder
@@ -647,16 +1729,16 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TrackingPacketStatus |= types1.Status(b&0x7F) << shift
+				m.StartHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
 			}
-			var stringLen uint64
+			m.EndHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDemandOrder
@@ -666,46 +1748,64 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EndHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthDemandOrder
+		default:
+			iNdEx = preIndex
+			skippy, err := skipDemandOrder(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthDemandOrder
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FulfillmentShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowDemandOrder
 			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDemandOrder
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types1.RollappPacket_Type(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 11:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FulfillmentShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FulfillmentShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -733,32 +1833,13 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
-			}
-			m.CreationHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowDemandOrder
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreationHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowDemandOrder
@@ -768,25 +1849,23 @@ func (m *DemandOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthDemandOrder
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthDemandOrder
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CompletionHook == nil {
-				m.CompletionHook = &types1.CompletionHookCall{}
-			}
-			if err := m.CompletionHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
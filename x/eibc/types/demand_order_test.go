@@ -0,0 +1,48 @@
+package types
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeeCurveFeeAt(t *testing.T) {
+	c := FeeCurve{
+		StartFee:    math.NewInt(10),
+		EndFee:      math.NewInt(100),
+		StartHeight: 100,
+		EndHeight:   200,
+	}
+	require.NoError(t, c.Validate(math.NewInt(1000)))
+	require.Error(t, c.Validate(math.NewInt(100)))
+
+	require.Equal(t, math.NewInt(10), c.FeeAt(0))
+	require.Equal(t, math.NewInt(10), c.FeeAt(100))
+	require.Equal(t, math.NewInt(55), c.FeeAt(150))
+	require.Equal(t, math.NewInt(100), c.FeeAt(200))
+	require.Equal(t, math.NewInt(100), c.FeeAt(300))
+
+	// decreasing curves work too
+	c.StartFee, c.EndFee = c.EndFee, c.StartFee
+	require.Equal(t, math.NewInt(55), c.FeeAt(150))
+	require.Equal(t, math.NewInt(91), c.FeeAt(110))
+}
+
+func TestAltFulfillmentValidate(t *testing.T) {
+	valid := AltFulfillment{Denom: "adym", PoolId: 1, MaxSlippage: math.LegacyNewDecWithPrec(5, 2), ReferencePrice: math.LegacyOneDec()}
+	require.NoError(t, valid.Validate("stake"))
+	require.ErrorIs(t, valid.Validate("adym"), ErrInvalidAltFulfillment)
+
+	noPool := valid
+	noPool.PoolId = 0
+	require.ErrorIs(t, noPool.Validate("stake"), ErrInvalidAltFulfillment)
+
+	badSlippage := valid
+	badSlippage.MaxSlippage = math.LegacyOneDec()
+	require.ErrorIs(t, badSlippage.Validate("stake"), ErrInvalidAltFulfillment)
+
+	noReference := valid
+	noReference.ReferencePrice = math.LegacyZeroDec()
+	require.ErrorIs(t, noReference.Validate("stake"), ErrInvalidAltFulfillment)
+}
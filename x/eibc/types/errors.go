@@ -27,4 +27,17 @@ var (
 	ErrOrderNotSettlementValidated = errorsmod.Register(ModuleName, 20, "demand order not settlement validated")
 	ErrRollappIdMismatch           = errorsmod.Register(ModuleName, 21, "rollapp ID mismatch")
 	ErrPriceMismatch               = errorsmod.Register(ModuleName, 22, "price mismatch")
+	ErrPartialAmountTooHigh        = gerrc.ErrInvalidArgument.Wrap("amount exceeds unfunded part of the price")
+	ErrPartialAmountTooLow         = gerrc.ErrInvalidArgument.Wrap("amount below the min fulfillment share")
+	ErrTooManyFulfillmentShares    = gerrc.ErrResourceExhausted.Wrap("too many fulfillment shares")
+	ErrInvalidFulfillmentShare     = gerrc.ErrInvalidArgument.Wrap("fulfillment share")
+	ErrInvalidFeeCurve             = gerrc.ErrInvalidArgument.Wrap("fee curve")
+	ErrInvalidAltFulfillment       = gerrc.ErrInvalidArgument.Wrap("alt fulfillment")
+	ErrNoAltFulfillment            = gerrc.ErrFailedPrecondition.Wrap("demand order does not accept an alternative denom")
+	ErrAltFulfillmentSlippage      = gerrc.ErrFailedPrecondition.Wrap("alt fulfillment slippage exceeds the max")
+	ErrAltAmountTooHigh            = gerrc.ErrFailedPrecondition.Wrap("alt fulfillment amount exceeds the max")
+	ErrAltFulfillmentTooEarly      = gerrc.ErrFailedPrecondition.Wrap("alt fulfillment is only possible after the block the order was created in")
+	ErrNotClaimHolder              = gerrc.ErrPermissionDenied.Wrap("not the holder of the order claim")
+	ErrClaimNotTransferable        = gerrc.ErrFailedPrecondition.Wrap("order claim not transferable")
+	ErrClaimPriceMismatch          = gerrc.ErrFailedPrecondition.Wrap("order claim price mismatch")
 )
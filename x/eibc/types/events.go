@@ -32,6 +32,16 @@ func GetFulfilledEvent(m *DemandOrder) *EventDemandOrderFulfilled {
 	}
 }
 
+func GetPartiallyFulfilledEvent(m *DemandOrder, fulfiller, amount string) *EventDemandOrderPartiallyFulfilled {
+	return &EventDemandOrderPartiallyFulfilled{
+		OrderId:   m.Id,
+		Fulfiller: fulfiller,
+		Amount:    amount,
+		Funded:    m.FundedAmount().String(),
+		Price:     m.Price.String(),
+	}
+}
+
 func GetFulfilledAuthorizedEvent(m *DemandOrder,
 	creationHeight uint64,
 	lpAddress, operatorAddress, operatorFee string,
@@ -345,6 +345,12 @@ type EventDemandOrderFulfilled struct {
 	Fulfiller string `protobuf:"bytes,9,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
 	// packet_type is the type of the packet.
 	PacketType string `protobuf:"bytes,10,opt,name=packet_type,json=packetType,proto3" json:"packet_type,omitempty"`
+	// paid is what the fulfiller paid the recipient in the alternative denom.
+	// Empty if the order was fulfilled by paying the price.
+	Paid string `protobuf:"bytes,11,opt,name=paid,proto3" json:"paid,omitempty"`
+	// price_source is the pool used to price paid. Empty if the order was
+	// fulfilled by paying the price.
+	PriceSource string `protobuf:"bytes,12,opt,name=price_source,json=priceSource,proto3" json:"price_source,omitempty"`
 }
 
 func (m *EventDemandOrderFulfilled) Reset()         { *m = EventDemandOrderFulfilled{} }
@@ -429,6 +435,169 @@ func (m *EventDemandOrderFulfilled) GetPacketType() string {
 	return ""
 }
 
+func (m *EventDemandOrderFulfilled) GetPaid() string {
+	if m != nil {
+		return m.Paid
+	}
+	return ""
+}
+
+func (m *EventDemandOrderFulfilled) GetPriceSource() string {
+	if m != nil {
+		return m.PriceSource
+	}
+	return ""
+}
+
+// EventDemandOrderPartiallyFulfilled is emitted when a slice of the demand
+// order price is funded.
+type EventDemandOrderPartiallyFulfilled struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// fulfiller is the address of the account which funded the slice.
+	Fulfiller string `protobuf:"bytes,2,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// amount is the funded slice of the price.
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// funded is the total funded part of the price, including this slice.
+	Funded string `protobuf:"bytes,4,opt,name=funded,proto3" json:"funded,omitempty"`
+	// price is the price of the demand order.
+	Price string `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) Reset()         { *m = EventDemandOrderPartiallyFulfilled{} }
+func (m *EventDemandOrderPartiallyFulfilled) String() string { return proto.CompactTextString(m) }
+func (*EventDemandOrderPartiallyFulfilled) ProtoMessage()    {}
+func (*EventDemandOrderPartiallyFulfilled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{4}
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDemandOrderPartiallyFulfilled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDemandOrderPartiallyFulfilled.Merge(m, src)
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDemandOrderPartiallyFulfilled) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDemandOrderPartiallyFulfilled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDemandOrderPartiallyFulfilled proto.InternalMessageInfo
+
+func (m *EventDemandOrderPartiallyFulfilled) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetFunded() string {
+	if m != nil {
+		return m.Funded
+	}
+	return ""
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
+// EventFulfillmentSharesPaid is emitted when the proceeds of a partially
+// fulfilled order are paid out, or the escrowed shares of an order which was
+// never fully funded are refunded.
+type EventFulfillmentSharesPaid struct {
+	// order_id is the unique identifier of the demand order.
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// total is the total amount distributed between the shares.
+	Total string `protobuf:"bytes,2,opt,name=total,proto3" json:"total,omitempty"`
+	// refund is true if the shares were refunded rather than paid out.
+	Refund bool `protobuf:"varint,3,opt,name=refund,proto3" json:"refund,omitempty"`
+}
+
+func (m *EventFulfillmentSharesPaid) Reset()         { *m = EventFulfillmentSharesPaid{} }
+func (m *EventFulfillmentSharesPaid) String() string { return proto.CompactTextString(m) }
+func (*EventFulfillmentSharesPaid) ProtoMessage()    {}
+func (*EventFulfillmentSharesPaid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{5}
+}
+func (m *EventFulfillmentSharesPaid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFulfillmentSharesPaid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFulfillmentSharesPaid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFulfillmentSharesPaid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFulfillmentSharesPaid.Merge(m, src)
+}
+func (m *EventFulfillmentSharesPaid) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFulfillmentSharesPaid) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFulfillmentSharesPaid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFulfillmentSharesPaid proto.InternalMessageInfo
+
+func (m *EventFulfillmentSharesPaid) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventFulfillmentSharesPaid) GetTotal() string {
+	if m != nil {
+		return m.Total
+	}
+	return ""
+}
+
+func (m *EventFulfillmentSharesPaid) GetRefund() bool {
+	if m != nil {
+		return m.Refund
+	}
+	return false
+}
+
 // EventDemandOrderFulfilledAuthorized is emitted when the demand order is
 // fulfilled from an authorization.
 type EventDemandOrderFulfilledAuthorized struct {
@@ -460,7 +629,7 @@ func (m *EventDemandOrderFulfilledAuthorized) Reset()         { *m = EventDemand
 func (m *EventDemandOrderFulfilledAuthorized) String() string { return proto.CompactTextString(m) }
 func (*EventDemandOrderFulfilledAuthorized) ProtoMessage()    {}
 func (*EventDemandOrderFulfilledAuthorized) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{4}
+	return fileDescriptor_87abe4479c806cf7, []int{6}
 }
 func (m *EventDemandOrderFulfilledAuthorized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +752,7 @@ func (m *EventDemandOrderDeleted) Reset()         { *m = EventDemandOrderDeleted
 func (m *EventDemandOrderDeleted) String() string { return proto.CompactTextString(m) }
 func (*EventDemandOrderDeleted) ProtoMessage()    {}
 func (*EventDemandOrderDeleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{5}
+	return fileDescriptor_87abe4479c806cf7, []int{7}
 }
 func (m *EventDemandOrderDeleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,7 +827,7 @@ func (m *EventMatchedOnDemandLP) Reset()         { *m = EventMatchedOnDemandLP{}
 func (m *EventMatchedOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*EventMatchedOnDemandLP) ProtoMessage()    {}
 func (*EventMatchedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{6}
+	return fileDescriptor_87abe4479c806cf7, []int{8}
 }
 func (m *EventMatchedOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -717,7 +886,7 @@ func (m *EventCreatedOnDemandLP) Reset()         { *m = EventCreatedOnDemandLP{}
 func (m *EventCreatedOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*EventCreatedOnDemandLP) ProtoMessage()    {}
 func (*EventCreatedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{7}
+	return fileDescriptor_87abe4479c806cf7, []int{9}
 }
 func (m *EventCreatedOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -771,7 +940,7 @@ func (m *EventDeletedOnDemandLP) Reset()         { *m = EventDeletedOnDemandLP{}
 func (m *EventDeletedOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*EventDeletedOnDemandLP) ProtoMessage()    {}
 func (*EventDeletedOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87abe4479c806cf7, []int{8}
+	return fileDescriptor_87abe4479c806cf7, []int{10}
 }
 func (m *EventDeletedOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -821,16 +990,92 @@ func (m *EventDeletedOnDemandLP) GetReason() string {
 	return ""
 }
 
+// EventOrderClaimTransferred is emitted when the claim on a fulfilled demand
+// order changes hands.
+type EventOrderClaimTransferred struct {
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// from is the previous claim holder.
+	From string `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	// to is the new claim holder, paid on finalization.
+	To string `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	// price is what the new holder paid, empty for a plain transfer.
+	Price string `protobuf:"bytes,4,opt,name=price,proto3" json:"price,omitempty"`
+}
+
+func (m *EventOrderClaimTransferred) Reset()         { *m = EventOrderClaimTransferred{} }
+func (m *EventOrderClaimTransferred) String() string { return proto.CompactTextString(m) }
+func (*EventOrderClaimTransferred) ProtoMessage()    {}
+func (*EventOrderClaimTransferred) Descriptor() ([]byte, []int) {
+	return fileDescriptor_87abe4479c806cf7, []int{11}
+}
+func (m *EventOrderClaimTransferred) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOrderClaimTransferred) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOrderClaimTransferred.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventOrderClaimTransferred) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOrderClaimTransferred.Merge(m, src)
+}
+func (m *EventOrderClaimTransferred) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOrderClaimTransferred) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOrderClaimTransferred.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventOrderClaimTransferred proto.InternalMessageInfo
+
+func (m *EventOrderClaimTransferred) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *EventOrderClaimTransferred) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *EventOrderClaimTransferred) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *EventOrderClaimTransferred) GetPrice() string {
+	if m != nil {
+		return m.Price
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventDemandOrderCreated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderCreated")
 	proto.RegisterType((*EventDemandOrderPacketStatusUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPacketStatusUpdated")
 	proto.RegisterType((*EventDemandOrderFeeUpdated)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFeeUpdated")
 	proto.RegisterType((*EventDemandOrderFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilled")
+	proto.RegisterType((*EventDemandOrderPartiallyFulfilled)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderPartiallyFulfilled")
+	proto.RegisterType((*EventFulfillmentSharesPaid)(nil), "dymensionxyz.dymension.eibc.EventFulfillmentSharesPaid")
 	proto.RegisterType((*EventDemandOrderFulfilledAuthorized)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderFulfilledAuthorized")
 	proto.RegisterType((*EventDemandOrderDeleted)(nil), "dymensionxyz.dymension.eibc.EventDemandOrderDeleted")
 	proto.RegisterType((*EventMatchedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventMatchedOnDemandLP")
 	proto.RegisterType((*EventCreatedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventCreatedOnDemandLP")
 	proto.RegisterType((*EventDeletedOnDemandLP)(nil), "dymensionxyz.dymension.eibc.EventDeletedOnDemandLP")
+	proto.RegisterType((*EventOrderClaimTransferred)(nil), "dymensionxyz.dymension.eibc.EventOrderClaimTransferred")
 }
 
 func init() {
@@ -838,55 +1083,64 @@ func init() {
 }
 
 var fileDescriptor_87abe4479c806cf7 = []byte{
-	// 762 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0x4f, 0x4f, 0xdb, 0x4a,
-	0x10, 0xc7, 0xf9, 0x9f, 0x49, 0x08, 0x3c, 0x3f, 0x04, 0x26, 0x0f, 0xf2, 0x20, 0x08, 0xbd, 0xbc,
-	0x1e, 0x62, 0x51, 0x3e, 0x01, 0x94, 0xa6, 0x45, 0xb4, 0x82, 0xa6, 0xed, 0xa5, 0x97, 0xc8, 0xb1,
-	0x27, 0xc9, 0x0a, 0x67, 0xd7, 0xb2, 0x37, 0x40, 0xb8, 0xf7, 0xde, 0x4f, 0x53, 0xf5, 0x23, 0xf4,
-	0xc8, 0xb1, 0x47, 0x04, 0xe2, 0x7b, 0x54, 0xbb, 0x5e, 0x87, 0xc4, 0xe1, 0x4f, 0x85, 0x7a, 0xea,
-	0x2d, 0xf3, 0xdb, 0xf1, 0xcc, 0xec, 0xfc, 0x7e, 0x33, 0x1b, 0xa8, 0x39, 0xc3, 0x3e, 0xd2, 0x80,
-	0x30, 0x7a, 0x36, 0x3c, 0x37, 0x47, 0x86, 0x89, 0xa4, 0x6d, 0x9b, 0x78, 0x82, 0x94, 0x07, 0x75,
-	0xcf, 0x67, 0x9c, 0xe9, 0xff, 0x8c, 0x7b, 0xd6, 0x47, 0x46, 0x5d, 0x78, 0x96, 0x17, 0xba, 0xac,
-	0xcb, 0xa4, 0x9f, 0x29, 0x7e, 0x85, 0x9f, 0x94, 0x9f, 0xdd, 0x13, 0xdc, 0x66, 0xfd, 0x3e, 0xa3,
-	0x66, 0xc0, 0x2d, 0x3e, 0x50, 0xe1, 0xcb, 0x15, 0x9b, 0x05, 0x7d, 0x16, 0x98, 0x6d, 0x2b, 0x40,
-	0xf3, 0x64, 0xab, 0x8d, 0xdc, 0xda, 0x32, 0x6d, 0x46, 0x68, 0x78, 0x5e, 0xbd, 0x4c, 0xc0, 0xd2,
-	0x4b, 0x51, 0xcf, 0x1e, 0xf6, 0x2d, 0xea, 0x1c, 0xfa, 0x0e, 0xfa, 0x2f, 0x7c, 0xb4, 0x38, 0x3a,
-	0xfa, 0x32, 0xe4, 0x98, 0xb0, 0x5b, 0xc4, 0x31, 0xb4, 0x35, 0xad, 0x96, 0x6f, 0x66, 0xa5, 0xbd,
-	0xef, 0xe8, 0x0b, 0x90, 0xf6, 0x7c, 0x62, 0xa3, 0x91, 0x90, 0x78, 0x68, 0xe8, 0xf3, 0x90, 0xec,
-	0x20, 0x1a, 0x49, 0x89, 0x89, 0x9f, 0xfa, 0x26, 0x14, 0x49, 0xd0, 0xea, 0x0c, 0xdc, 0x0e, 0x71,
-	0x5d, 0x74, 0x8c, 0xd4, 0x9a, 0x56, 0xcb, 0xed, 0x26, 0x0c, 0xad, 0x59, 0x20, 0x41, 0x23, 0x82,
-	0xf5, 0x0d, 0x98, 0xf5, 0x2c, 0xfb, 0x18, 0x79, 0x2b, 0x2c, 0xde, 0x48, 0xcb, 0x10, 0xc5, 0x10,
-	0x7c, 0x2f, 0x31, 0x7d, 0x15, 0x40, 0x39, 0x1d, 0xe3, 0xd0, 0xc8, 0x48, 0x8f, 0x7c, 0x88, 0x1c,
-	0xe0, 0x50, 0x1c, 0xfb, 0xcc, 0x75, 0x2d, 0xcf, 0x13, 0xf5, 0x66, 0xc3, 0x63, 0x85, 0xec, 0x3b,
-	0xfa, 0x0a, 0xe4, 0x7d, 0xb4, 0x89, 0x47, 0x90, 0x72, 0x23, 0xa7, 0x4e, 0x23, 0x40, 0xff, 0x17,
-	0x0a, 0x2a, 0x36, 0x1f, 0x7a, 0x68, 0xe4, 0xe5, 0xb9, 0x4a, 0xf7, 0x61, 0xe8, 0xa1, 0xbe, 0x0e,
-	0x45, 0xcf, 0x67, 0xac, 0xd3, 0xea, 0x21, 0xe9, 0xf6, 0xb8, 0x01, 0x6b, 0x5a, 0x2d, 0xd5, 0x2c,
-	0x48, 0xec, 0xb5, 0x84, 0xf4, 0x45, 0xc8, 0x58, 0x7d, 0x36, 0xa0, 0xdc, 0x28, 0xc8, 0xcf, 0x95,
-	0x55, 0xfd, 0xaa, 0xc1, 0x46, 0xbc, 0xc5, 0x47, 0x63, 0x17, 0xfb, 0xe8, 0x39, 0x8f, 0xb5, 0xfb,
-	0x1d, 0xfc, 0x45, 0xf1, 0xb4, 0x35, 0xd9, 0x23, 0xd1, 0xfa, 0xd2, 0xf3, 0xcd, 0xfa, 0x3d, 0x02,
-	0x0a, 0xd5, 0x50, 0x0f, 0x73, 0x34, 0xe7, 0x28, 0x9e, 0x8e, 0x27, 0x15, 0x17, 0x9a, 0x60, 0x46,
-	0x90, 0x96, 0x9b, 0x60, 0xa5, 0x7a, 0xa3, 0x41, 0x39, 0x5e, 0x78, 0x03, 0xf1, 0x17, 0xea, 0x5d,
-	0x82, 0xac, 0xa8, 0x57, 0x88, 0x21, 0x14, 0x48, 0x86, 0xe2, 0x69, 0x03, 0xf1, 0x56, 0x37, 0xc9,
-	0x71, 0xdd, 0x4c, 0xd1, 0x9f, 0xba, 0x9b, 0xfe, 0x31, 0x7e, 0xd3, 0x71, 0x7e, 0xe3, 0x04, 0x65,
-	0x1e, 0x22, 0x28, 0x3b, 0x41, 0xd0, 0x8d, 0x06, 0xcb, 0x53, 0xf7, 0x1c, 0x69, 0xf3, 0x37, 0x4c,
-	0xc1, 0xfa, 0x5d, 0x53, 0xf0, 0x84, 0x09, 0x58, 0x81, 0x7c, 0x14, 0xc4, 0x57, 0x1a, 0xbd, 0x05,
-	0xe2, 0x1a, 0x86, 0xb8, 0x86, 0xab, 0x9f, 0x93, 0xd3, 0x42, 0x1c, 0x55, 0xb0, 0x33, 0xe0, 0x3d,
-	0xe6, 0x93, 0xf3, 0x3f, 0xe9, 0xc6, 0xfa, 0x7f, 0x30, 0x67, 0x8b, 0x65, 0x46, 0x18, 0x8d, 0x74,
-	0x51, 0x90, 0xba, 0x28, 0x45, 0xb0, 0x92, 0xc6, 0x2a, 0x80, 0xeb, 0xb5, 0x2c, 0xc7, 0xf1, 0x31,
-	0x08, 0x8c, 0x62, 0x98, 0xc8, 0xf5, 0x76, 0x42, 0x40, 0xff, 0x1f, 0xe6, 0x99, 0x87, 0xbe, 0xc5,
-	0x99, 0x3f, 0x72, 0x9a, 0x95, 0x4e, 0x73, 0x11, 0x1e, 0xb9, 0xae, 0x43, 0x71, 0xe4, 0x2a, 0x9a,
-	0x52, 0x92, 0x6e, 0x85, 0x08, 0x6b, 0x20, 0x56, 0xbf, 0x69, 0xd3, 0x3b, 0x77, 0x0f, 0x5d, 0x7c,
-	0x64, 0xa8, 0x26, 0xf7, 0x5f, 0x22, 0xbe, 0xff, 0xa6, 0xfa, 0x99, 0x7c, 0x74, 0x88, 0x52, 0xf1,
-	0x21, 0x8a, 0x35, 0x34, 0x3d, 0x25, 0xa1, 0x0e, 0x2c, 0xca, 0xca, 0xdf, 0x5a, 0xdc, 0xee, 0xa1,
-	0x73, 0x48, 0xc3, 0x2b, 0xbc, 0x39, 0x7a, 0xa8, 0xf0, 0xbf, 0x21, 0xed, 0xca, 0x7c, 0x09, 0xd9,
-	0xfb, 0x94, 0xab, 0xf6, 0xf1, 0x2d, 0xb3, 0xc9, 0x18, 0xb3, 0xd5, 0x57, 0x2a, 0x8f, 0x7a, 0x8a,
-	0xc6, 0xf2, 0x94, 0x20, 0xa1, 0x32, 0xa4, 0x9a, 0x09, 0x22, 0xbb, 0xd2, 0x19, 0x50, 0x27, 0x90,
-	0xbc, 0x44, 0x5d, 0x91, 0x88, 0x60, 0xa4, 0xda, 0x52, 0x81, 0x54, 0x7f, 0x9f, 0x1c, 0x48, 0x2c,
-	0x0f, 0x1f, 0xad, 0x80, 0x51, 0x55, 0xac, 0xb2, 0x76, 0x0f, 0xbe, 0x5f, 0x55, 0xb4, 0x8b, 0xab,
-	0x8a, 0x76, 0x79, 0x55, 0xd1, 0xbe, 0x5c, 0x57, 0x66, 0x2e, 0xae, 0x2b, 0x33, 0x3f, 0xae, 0x2b,
-	0x33, 0x9f, 0xb6, 0xba, 0x84, 0xf7, 0x06, 0x6d, 0xb1, 0x88, 0xcd, 0x7b, 0x5e, 0xec, 0x93, 0x6d,
-	0xf3, 0x2c, 0xfc, 0x4f, 0x20, 0xfa, 0x1d, 0xb4, 0x33, 0xf2, 0x51, 0xde, 0xfe, 0x19, 0x00, 0x00,
-	0xff, 0xff, 0xde, 0x6e, 0x60, 0x33, 0x3f, 0x08, 0x00, 0x00,
+	// 903 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x29, 0x4a, 0xb6, 0x46, 0x8a, 0x9d, 0xb2, 0x46, 0xc2, 0xb8, 0x89, 0x6a, 0x33, 0x08,
+	0xea, 0xf6, 0x20, 0xc2, 0xcd, 0x13, 0x24, 0x4d, 0xdd, 0x06, 0x69, 0x11, 0x57, 0x4e, 0x2f, 0xbd,
+	0x08, 0x2b, 0x72, 0x64, 0x2d, 0x42, 0xee, 0xb2, 0xcb, 0x95, 0x1d, 0xe5, 0xde, 0x7b, 0x1f, 0xa0,
+	0xb7, 0xbe, 0x43, 0xd1, 0x47, 0xe8, 0x31, 0xc7, 0x1e, 0x03, 0x1b, 0x7d, 0x8f, 0x62, 0x7f, 0x28,
+	0x51, 0x54, 0x6c, 0x07, 0x41, 0x4f, 0xbd, 0x71, 0xbe, 0x1d, 0xee, 0x0c, 0xbf, 0xef, 0x1b, 0xee,
+	0xc2, 0x7e, 0x32, 0xcb, 0x90, 0x15, 0x94, 0xb3, 0x57, 0xb3, 0xd7, 0xd1, 0x3c, 0x88, 0x90, 0x8e,
+	0xe2, 0x08, 0x4f, 0x91, 0xc9, 0xa2, 0x9f, 0x0b, 0x2e, 0xb9, 0xff, 0x49, 0x35, 0xb3, 0x3f, 0x0f,
+	0xfa, 0x2a, 0x73, 0x67, 0xfb, 0x84, 0x9f, 0x70, 0x9d, 0x17, 0xa9, 0x27, 0xf3, 0xca, 0xce, 0x17,
+	0x97, 0x6c, 0x1e, 0xf3, 0x2c, 0xe3, 0x2c, 0x2a, 0x24, 0x91, 0x53, 0xbb, 0xfd, 0x4e, 0x2f, 0xe6,
+	0x45, 0xc6, 0x8b, 0x68, 0x44, 0x0a, 0x8c, 0x4e, 0x0f, 0x46, 0x28, 0xc9, 0x41, 0x14, 0x73, 0xca,
+	0xcc, 0x7a, 0xf8, 0xd6, 0x85, 0xdb, 0x5f, 0xab, 0x7e, 0x9e, 0x60, 0x46, 0x58, 0xf2, 0x5c, 0x24,
+	0x28, 0xbe, 0x12, 0x48, 0x24, 0x26, 0xfe, 0x1d, 0xd8, 0xe0, 0x2a, 0x1e, 0xd2, 0x24, 0x70, 0x76,
+	0x9d, 0xfd, 0xf6, 0x60, 0x5d, 0xc7, 0x4f, 0x13, 0x7f, 0x1b, 0x9a, 0xb9, 0xa0, 0x31, 0x06, 0xae,
+	0xc6, 0x4d, 0xe0, 0xdf, 0x84, 0xc6, 0x18, 0x31, 0x68, 0x68, 0x4c, 0x3d, 0xfa, 0x0f, 0xa0, 0x4b,
+	0x8b, 0xe1, 0x78, 0x9a, 0x8e, 0x69, 0x9a, 0x62, 0x12, 0x78, 0xbb, 0xce, 0xfe, 0xc6, 0x63, 0x37,
+	0x70, 0x06, 0x1d, 0x5a, 0x1c, 0x96, 0xb0, 0x7f, 0x1f, 0x6e, 0xe4, 0x24, 0x7e, 0x89, 0x72, 0x68,
+	0x9a, 0x0f, 0x9a, 0x7a, 0x8b, 0xae, 0x01, 0x8f, 0x35, 0xe6, 0xdf, 0x03, 0xb0, 0x49, 0x2f, 0x71,
+	0x16, 0xb4, 0x74, 0x46, 0xdb, 0x20, 0xcf, 0x70, 0xa6, 0x96, 0x05, 0x4f, 0x53, 0x92, 0xe7, 0xaa,
+	0xdf, 0x75, 0xb3, 0x6c, 0x91, 0xa7, 0x89, 0x7f, 0x17, 0xda, 0x02, 0x63, 0x9a, 0x53, 0x64, 0x32,
+	0xd8, 0xb0, 0xab, 0x25, 0xe0, 0x7f, 0x0a, 0x1d, 0xbb, 0xb7, 0x9c, 0xe5, 0x18, 0xb4, 0xf5, 0xba,
+	0x2d, 0xf7, 0x62, 0x96, 0xa3, 0xbf, 0x07, 0xdd, 0x5c, 0x70, 0x3e, 0x1e, 0x4e, 0x90, 0x9e, 0x4c,
+	0x64, 0x00, 0xbb, 0xce, 0xbe, 0x37, 0xe8, 0x68, 0xec, 0x5b, 0x0d, 0xf9, 0xb7, 0xa0, 0x45, 0x32,
+	0x3e, 0x65, 0x32, 0xe8, 0xe8, 0xd7, 0x6d, 0x14, 0xfe, 0xe1, 0xc0, 0xfd, 0x3a, 0xc5, 0x47, 0x95,
+	0x0f, 0xfb, 0x31, 0x4f, 0xae, 0xa3, 0xfb, 0x07, 0xf8, 0x88, 0xe1, 0xd9, 0x70, 0x99, 0x23, 0x45,
+	0xfd, 0xe6, 0x97, 0x0f, 0xfa, 0x97, 0x18, 0xc8, 0xb8, 0xa1, 0x6f, 0x6a, 0x0c, 0xb6, 0x18, 0x9e,
+	0x55, 0x8b, 0xfa, 0x7b, 0x35, 0x65, 0x94, 0x68, 0x1b, 0x4b, 0xaa, 0x84, 0xff, 0x38, 0xb0, 0x53,
+	0x6f, 0xfc, 0x10, 0xf1, 0x3d, 0xfa, 0xbd, 0x0d, 0xeb, 0xaa, 0x5f, 0x65, 0x06, 0x63, 0x90, 0x16,
+	0xc3, 0xb3, 0x43, 0xc4, 0x85, 0x6f, 0x1a, 0x55, 0xdf, 0xac, 0xc8, 0xef, 0xbd, 0x5b, 0xfe, 0x8a,
+	0xbe, 0xcd, 0xba, 0xbe, 0x75, 0x81, 0x5a, 0x57, 0x09, 0xb4, 0xbe, 0x24, 0xd0, 0x6f, 0x2e, 0xdc,
+	0x59, 0xf9, 0xce, 0xb9, 0x37, 0xff, 0x83, 0x29, 0xd8, 0x7b, 0xd7, 0x14, 0x7c, 0xc0, 0x04, 0xdc,
+	0x85, 0x76, 0xb9, 0x89, 0xb0, 0x1e, 0x5d, 0x00, 0x75, 0x0f, 0xc3, 0x8a, 0x87, 0x7d, 0xf0, 0x72,
+	0x42, 0x13, 0x6b, 0x4f, 0xfd, 0x6c, 0x68, 0xa3, 0x31, 0x0e, 0x0b, 0x3e, 0x15, 0x31, 0x06, 0x5d,
+	0xbd, 0xd6, 0xd1, 0xd8, 0xb1, 0x86, 0xc2, 0xdf, 0x1d, 0x08, 0x57, 0xfd, 0x2b, 0x24, 0x25, 0x69,
+	0x3a, 0x7b, 0x2f, 0x9e, 0x96, 0xfa, 0x76, 0xeb, 0x7d, 0x2f, 0x64, 0x69, 0x54, 0x65, 0x51, 0xf8,
+	0x78, 0xca, 0x12, 0xcb, 0x57, 0x7b, 0x60, 0xa3, 0x05, 0xeb, 0xcd, 0x0a, 0xeb, 0x21, 0x5a, 0xaf,
+	0xda, 0x86, 0x32, 0x64, 0xf2, 0x78, 0x42, 0x04, 0x16, 0x47, 0x84, 0x5e, 0xd9, 0xdc, 0x36, 0x34,
+	0x25, 0x97, 0x24, 0x2d, 0x45, 0xd4, 0x81, 0x2a, 0x2e, 0x50, 0x15, 0xb4, 0x83, 0x61, 0xa3, 0xf0,
+	0x97, 0xc6, 0xea, 0x30, 0xcf, 0x39, 0x78, 0x34, 0x95, 0x13, 0x2e, 0xe8, 0xeb, 0xff, 0x95, 0x6b,
+	0x3e, 0x83, 0xad, 0x58, 0x1d, 0x08, 0x94, 0xb3, 0x72, 0xb6, 0x3a, 0x7a, 0xb6, 0x36, 0x4b, 0xd8,
+	0x8e, 0xd7, 0x3d, 0x80, 0x34, 0x1f, 0x92, 0x24, 0x11, 0x58, 0x14, 0xd6, 0x48, 0xed, 0x34, 0x7f,
+	0x64, 0x00, 0xff, 0x73, 0xb8, 0xc9, 0x73, 0x14, 0x44, 0x72, 0x31, 0x4f, 0xba, 0xa1, 0x93, 0xb6,
+	0x4a, 0xbc, 0x4c, 0xdd, 0x83, 0xee, 0x3c, 0x55, 0x91, 0xb2, 0x69, 0x4c, 0x59, 0x62, 0x87, 0x88,
+	0xe1, 0x9f, 0xce, 0xea, 0xb9, 0xf5, 0x04, 0x53, 0xbc, 0xe6, 0xc7, 0xb4, 0x7c, 0x86, 0xb8, 0xf5,
+	0x33, 0x64, 0x85, 0xcf, 0xc6, 0xb5, 0x3f, 0x22, 0xaf, 0xfe, 0x23, 0xaa, 0x11, 0xda, 0xac, 0x13,
+	0x1a, 0x8e, 0xe1, 0x96, 0xee, 0xfc, 0x7b, 0x22, 0xe3, 0x09, 0x26, 0xcf, 0x99, 0xf9, 0x84, 0xef,
+	0x8e, 0xae, 0x6a, 0xfc, 0x63, 0x68, 0xa6, 0xba, 0x9e, 0xab, 0xb9, 0xf7, 0xd2, 0xbc, 0x3e, 0x57,
+	0x8d, 0x9a, 0xb2, 0xe1, 0x37, 0xb6, 0x8e, 0x3d, 0xce, 0x2b, 0x75, 0x36, 0xc1, 0xb5, 0x15, 0xbc,
+	0x81, 0x4b, 0x35, 0x2b, 0xca, 0xdc, 0x85, 0xd6, 0x65, 0x31, 0xa0, 0x2c, 0x29, 0x94, 0x22, 0xe1,
+	0xd0, 0x6e, 0x64, 0xf9, 0xfd, 0xe0, 0x8d, 0xcc, 0x50, 0x91, 0x82, 0xb3, 0x72, 0xd2, 0x4d, 0x14,
+	0xfe, 0x6c, 0x67, 0xd7, 0xdc, 0x3e, 0x52, 0x42, 0xb3, 0x17, 0x82, 0xb0, 0x62, 0x8c, 0x42, 0x5c,
+	0x2d, 0xa7, 0x0f, 0xde, 0x58, 0xf0, 0xcc, 0x56, 0xd2, 0xcf, 0xaa, 0x27, 0xc9, 0x6d, 0x01, 0x57,
+	0xf2, 0xc5, 0xb8, 0x79, 0x95, 0x71, 0x7b, 0xfc, 0xec, 0xaf, 0xf3, 0x9e, 0xf3, 0xe6, 0xbc, 0xe7,
+	0xbc, 0x3d, 0xef, 0x39, 0xbf, 0x5e, 0xf4, 0xd6, 0xde, 0x5c, 0xf4, 0xd6, 0xfe, 0xbe, 0xe8, 0xad,
+	0xfd, 0x74, 0x70, 0x42, 0xe5, 0x64, 0x3a, 0x52, 0xe7, 0x67, 0x74, 0xc9, 0x45, 0xeb, 0xf4, 0x61,
+	0xf4, 0xca, 0x5c, 0xe5, 0x94, 0xc4, 0xc5, 0xa8, 0xa5, 0xef, 0x52, 0x0f, 0xff, 0x1d, 0x00, 0x19,
+	0x70, 0xee, 0x39, 0xf6, 0x09, 0x00, 0x00,
 }
 
 func (m *EventDemandOrderCreated) Marshal() (dAtA []byte, err error) {
@@ -1125,6 +1379,20 @@ func (m *EventDemandOrderFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if len(m.PriceSource) > 0 {
+		i -= len(m.PriceSource)
+		copy(dAtA[i:], m.PriceSource)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PriceSource)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Paid) > 0 {
+		i -= len(m.Paid)
+		copy(dAtA[i:], m.Paid)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Paid)))
+		i--
+		dAtA[i] = 0x5a
+	}
 	if len(m.PacketType) > 0 {
 		i -= len(m.PacketType)
 		copy(dAtA[i:], m.PacketType)
@@ -1180,6 +1448,111 @@ func (m *EventDemandOrderFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDemandOrderPartiallyFulfilled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Funded) > 0 {
+		i -= len(m.Funded)
+		copy(dAtA[i:], m.Funded)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Funded)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventFulfillmentSharesPaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFulfillmentSharesPaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFulfillmentSharesPaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Refund {
+		i--
+		if m.Refund {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Total) > 0 {
+		i -= len(m.Total)
+		copy(dAtA[i:], m.Total)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Total)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventDemandOrderFulfilledAuthorized) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1458,11 +1831,62 @@ func (m *EventDeletedOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
+func (m *EventOrderClaimTransferred) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOrderClaimTransferred) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOrderClaimTransferred) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Price) > 0 {
+		i -= len(m.Price)
+		copy(dAtA[i:], m.Price)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Price)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
 		v >>= 7
 		offset++
 	}
@@ -1608,6 +2032,63 @@ func (m *EventDemandOrderFulfilled) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	l = len(m.Paid)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PriceSource)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventDemandOrderPartiallyFulfilled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Funded)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventFulfillmentSharesPaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Total)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Refund {
+		n += 2
+	}
 	return n
 }
 
@@ -1747,6 +2228,31 @@ func (m *EventDeletedOnDemandLP) Size() (n int) {
 	return n
 }
 
+func (m *EventOrderClaimTransferred) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Price)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2656,10 +3162,348 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsFulfilled = bool(v != 0)
+			m.IsFulfilled = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PacketType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceSource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventDemandOrderPartiallyFulfilled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDemandOrderPartiallyFulfilled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDemandOrderPartiallyFulfilled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funded = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2687,11 +3531,61 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketStatus = string(dAtA[iNdEx:postIndex])
+			m.Price = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFulfillmentSharesPaid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFulfillmentSharesPaid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFulfillmentSharesPaid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2719,11 +3613,11 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PacketType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2751,8 +3645,28 @@ func (m *EventDemandOrderFulfilled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PacketType = string(dAtA[iNdEx:postIndex])
+			m.Total = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Refund = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3728,6 +4642,184 @@ func (m *EventDeletedOnDemandLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventOrderClaimTransferred) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOrderClaimTransferred: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOrderClaimTransferred: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -8,6 +8,7 @@ import (
 
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	gammtypes "github.com/osmosis-labs/osmosis/v15/x/gamm/types"
 )
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -32,3 +33,9 @@ type DelayedAckKeeper interface {
 type RollappKeeper interface {
 	GetLatestStateInfo(ctx sdk.Context, rollappId string) (rollapptypes.StateInfo, bool)
 }
+
+// GammKeeper is used to price alternative denom fulfillments
+type GammKeeper interface {
+	GetPoolAndPoke(ctx sdk.Context, poolId uint64) (gammtypes.CFMMPoolI, error)
+	CalculateSpotPrice(ctx sdk.Context, poolID uint64, quoteAssetDenom string, baseAssetDenom string) (math.LegacyDec, error)
+}
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultIndex is the default global index
 const DefaultIndex uint64 = 1
 
@@ -13,7 +15,7 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
-	demandOrdersMap := make(map[string]struct{})
+	demandOrdersMap := make(map[string]DemandOrder)
 	for _, demandOrder := range gs.GetDemandOrders() {
 		if err := demandOrder.Validate(); err != nil {
 			return err
@@ -21,7 +23,38 @@ func (gs GenesisState) Validate() error {
 		if _, ok := demandOrdersMap[demandOrder.Id]; ok {
 			return ErrDemandOrderAlreadyExist
 		}
-		demandOrdersMap[demandOrder.Id] = struct{}{}
+		demandOrdersMap[demandOrder.Id] = demandOrder
+	}
+
+	// claims are only traded on fulfilled orders, and only their holder sells them
+	sellOrdersMap := make(map[string]struct{})
+	for _, so := range gs.ClaimSellOrders {
+		if err := so.Validate(); err != nil {
+			return fmt.Errorf("claim sell order: %s: %w", so.OrderId, err)
+		}
+		if _, ok := sellOrdersMap[so.OrderId]; ok {
+			return fmt.Errorf("duplicated claim sell order: %s", so.OrderId)
+		}
+		sellOrdersMap[so.OrderId] = struct{}{}
+		o, ok := demandOrdersMap[so.OrderId]
+		if !ok || !o.IsFulfilled() || o.FulfillerAddress != so.Seller {
+			return fmt.Errorf("claim sell order not by the holder of a fulfilled order: %s", so.OrderId)
+		}
 	}
+	buyOrdersMap := make(map[string]struct{})
+	for _, bo := range gs.ClaimBuyOrders {
+		if err := bo.Validate(); err != nil {
+			return fmt.Errorf("claim buy order: %s: %w", bo.OrderId, err)
+		}
+		index := fmt.Sprintf("%s/%s", bo.OrderId, bo.Buyer)
+		if _, ok := buyOrdersMap[index]; ok {
+			return fmt.Errorf("duplicated claim buy order: %s: buyer: %s", bo.OrderId, bo.Buyer)
+		}
+		buyOrdersMap[index] = struct{}{}
+		if o, ok := demandOrdersMap[bo.OrderId]; !ok || !o.IsFulfilled() {
+			return fmt.Errorf("claim buy order not on a fulfilled order: %s", bo.OrderId)
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
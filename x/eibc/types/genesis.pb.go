@@ -27,6 +27,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	Params       Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	DemandOrders []DemandOrder `protobuf:"bytes,2,rep,name=demand_orders,json=demandOrders,proto3" json:"demand_orders"`
+	// claim_sell_orders are the open offers to sell the claims on fulfilled
+	// orders
+	ClaimSellOrders []ClaimSellOrder `protobuf:"bytes,3,rep,name=claim_sell_orders,json=claimSellOrders,proto3" json:"claim_sell_orders"`
+	// claim_buy_orders are the open offers to buy the claims on fulfilled
+	// orders. Their prices are escrowed in the module account, whose balance is
+	// part of the bank genesis.
+	ClaimBuyOrders []ClaimBuyOrder `protobuf:"bytes,4,rep,name=claim_buy_orders,json=claimBuyOrders,proto3" json:"claim_buy_orders"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,6 +83,20 @@ func (m *GenesisState) GetDemandOrders() []DemandOrder {
 	return nil
 }
 
+func (m *GenesisState) GetClaimSellOrders() []ClaimSellOrder {
+	if m != nil {
+		return m.ClaimSellOrders
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimBuyOrders() []ClaimBuyOrder {
+	if m != nil {
+		return m.ClaimBuyOrders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.eibc.GenesisState")
 }
@@ -85,23 +106,27 @@ func init() {
 }
 
 var fileDescriptor_cfd2504316b5c400 = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
+	// 316 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4c, 0xa9, 0xcc, 0x4d,
 	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
 	0xf5, 0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4,
-	0x91, 0x95, 0xea, 0xc1, 0x39, 0x7a, 0x20, 0xa5, 0x52, 0x7a, 0xf8, 0xcc, 0x49, 0x49, 0xcd, 0x4d,
-	0xcc, 0x4b, 0x89, 0xcf, 0x2f, 0x4a, 0x49, 0x2d, 0x82, 0x18, 0x26, 0xa5, 0x81, 0x4f, 0x7d, 0x41,
-	0x62, 0x51, 0x62, 0x2e, 0xd4, 0x5a, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4,
-	0x82, 0x88, 0x2a, 0x2d, 0x63, 0xe4, 0xe2, 0x71, 0x87, 0x38, 0x2f, 0xb8, 0x24, 0xb1, 0x24, 0x55,
-	0xc8, 0x91, 0x8b, 0x0d, 0xa2, 0x4d, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x59, 0x0f, 0x8f,
-	0x73, 0xf5, 0x02, 0xc0, 0x4a, 0x9d, 0x58, 0x4e, 0xdc, 0x93, 0x67, 0x08, 0x82, 0x6a, 0x14, 0x0a,
-	0xe6, 0xe2, 0x45, 0x76, 0x69, 0xb1, 0x04, 0x93, 0x02, 0xb3, 0x06, 0xb7, 0x91, 0x06, 0x5e, 0x93,
-	0x5c, 0xc0, 0x3a, 0xfc, 0x41, 0x1a, 0xa0, 0xc6, 0xf1, 0xa4, 0x20, 0x84, 0x8a, 0x9d, 0xbc, 0x4f,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x30, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0x47, 0x68, 0x94, 0x19, 0xeb, 0x57, 0x40, 0x82, 0xa4, 0xa4,
-	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0xec, 0x79, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x00,
-	0xd1, 0x56, 0x0a, 0xb6, 0x01, 0x00, 0x00,
+	0x91, 0x95, 0xea, 0xc1, 0x39, 0x7a, 0x20, 0xa5, 0x52, 0xea, 0xf8, 0xcc, 0x49, 0xce, 0x49, 0xcc,
+	0xcc, 0x85, 0x98, 0x22, 0xa5, 0x87, 0x4f, 0x61, 0x4a, 0x6a, 0x6e, 0x62, 0x5e, 0x4a, 0x7c, 0x7e,
+	0x51, 0x4a, 0x6a, 0x11, 0x54, 0xbd, 0x06, 0x3e, 0xf5, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0xf7,
+	0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x99, 0xfa, 0x20, 0x16, 0x44, 0x54, 0xe9, 0x21, 0x13,
+	0x17, 0x8f, 0x3b, 0xc4, 0x1f, 0xc1, 0x25, 0x89, 0x25, 0xa9, 0x42, 0x8e, 0x5c, 0x6c, 0x10, 0x6d,
+	0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0xca, 0x7a, 0x78, 0xfc, 0xa5, 0x17, 0x00, 0x56, 0xea,
+	0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0xa3, 0x50, 0x30, 0x17, 0x2f, 0xb2, 0x4b, 0x8b,
+	0x25, 0x98, 0x14, 0x98, 0x35, 0xb8, 0x8d, 0x34, 0xf0, 0x9a, 0xe4, 0x02, 0xd6, 0xe1, 0x0f, 0xd2,
+	0x00, 0x35, 0x8e, 0x27, 0x05, 0x21, 0x54, 0x2c, 0x14, 0xcb, 0x25, 0x08, 0x0e, 0xa7, 0xf8, 0xe2,
+	0xd4, 0x9c, 0x1c, 0x98, 0xc1, 0xcc, 0x60, 0x83, 0xb5, 0xf1, 0x1a, 0xec, 0x0c, 0xd2, 0x15, 0x9c,
+	0x9a, 0x93, 0x83, 0x6c, 0x36, 0x7f, 0x32, 0x8a, 0x68, 0xb1, 0x50, 0x14, 0x97, 0x00, 0xc4, 0xf8,
+	0xa4, 0xd2, 0x4a, 0x98, 0xe9, 0x2c, 0x60, 0xd3, 0xb5, 0x08, 0x9b, 0xee, 0x54, 0x5a, 0x89, 0x6c,
+	0x38, 0x5f, 0x32, 0xb2, 0x60, 0xb1, 0x93, 0xf7, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31,
+	0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb,
+	0x31, 0x44, 0x19, 0xa6, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea, 0xe3, 0x88,
+	0xc8, 0x32, 0x63, 0xfd, 0x0a, 0x48, 0x6c, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xe3,
+	0xcd, 0x18, 0x30, 0x00, 0x22, 0x70, 0x1f, 0xcf, 0x9a, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +149,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClaimBuyOrders) > 0 {
+		for iNdEx := len(m.ClaimBuyOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimBuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClaimSellOrders) > 0 {
+		for iNdEx := len(m.ClaimSellOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimSellOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.DemandOrders) > 0 {
 		for iNdEx := len(m.DemandOrders) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -176,6 +229,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ClaimSellOrders) > 0 {
+		for _, e := range m.ClaimSellOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimBuyOrders) > 0 {
+		for _, e := range m.ClaimBuyOrders {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -281,6 +346,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimSellOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimSellOrders = append(m.ClaimSellOrders, ClaimSellOrder{})
+			if err := m.ClaimSellOrders[len(m.ClaimSellOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimBuyOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimBuyOrders = append(m.ClaimBuyOrders, ClaimBuyOrder{})
+			if err := m.ClaimBuyOrders[len(m.ClaimBuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -20,6 +20,19 @@ func TestGenesisState_Validate(t *testing.T) {
 		CreationHeight: 1,
 	}
 
+	fulfilledDemandOrder := validDemandOrder
+	fulfilledDemandOrder.FulfillerAddress = sample.AccAddress()
+	sellOrder := types.ClaimSellOrder{
+		OrderId: fulfilledDemandOrder.Id,
+		Seller:  fulfilledDemandOrder.FulfillerAddress,
+		Price:   sdk.NewInt64Coin("denom", 2),
+	}
+	buyOrder := types.ClaimBuyOrder{
+		OrderId: fulfilledDemandOrder.Id,
+		Buyer:   sample.AccAddress(),
+		Price:   sdk.NewInt64Coin("denom", 2),
+	}
+
 	validParams := types.Params{
 		EpochIdentifier: "hour",
 		TimeoutFee:      math.LegacyNewDecWithPrec(1, 1),
@@ -62,6 +75,62 @@ func TestGenesisState_Validate(t *testing.T) {
 				validDemandOrder,
 			}, Params: types.DefaultParams()},
 			valid: false,
+		}, {
+			desc: "valid claim orders",
+			genState: &types.GenesisState{
+				Params:          validParams,
+				DemandOrders:    []types.DemandOrder{fulfilledDemandOrder},
+				ClaimSellOrders: []types.ClaimSellOrder{sellOrder},
+				ClaimBuyOrders:  []types.ClaimBuyOrder{buyOrder},
+			},
+			valid: true,
+		}, {
+			desc: "claim sell order on an unfulfilled order",
+			genState: &types.GenesisState{
+				Params:          validParams,
+				DemandOrders:    []types.DemandOrder{validDemandOrder},
+				ClaimSellOrders: []types.ClaimSellOrder{sellOrder},
+			},
+			valid: false,
+		}, {
+			desc: "claim sell order not by the holder",
+			genState: &types.GenesisState{
+				Params:       validParams,
+				DemandOrders: []types.DemandOrder{fulfilledDemandOrder},
+				ClaimSellOrders: []types.ClaimSellOrder{{
+					OrderId: sellOrder.OrderId,
+					Seller:  sample.AccAddress(),
+					Price:   sellOrder.Price,
+				}},
+			},
+			valid: false,
+		}, {
+			desc: "claim buy order of unknown order",
+			genState: &types.GenesisState{
+				Params:         validParams,
+				ClaimBuyOrders: []types.ClaimBuyOrder{buyOrder},
+			},
+			valid: false,
+		}, {
+			desc: "duplicate claim buy order",
+			genState: &types.GenesisState{
+				Params:         validParams,
+				DemandOrders:   []types.DemandOrder{fulfilledDemandOrder},
+				ClaimBuyOrders: []types.ClaimBuyOrder{buyOrder, buyOrder},
+			},
+			valid: false,
+		}, {
+			desc: "claim buy order without price",
+			genState: &types.GenesisState{
+				Params:       validParams,
+				DemandOrders: []types.DemandOrder{fulfilledDemandOrder},
+				ClaimBuyOrders: []types.ClaimBuyOrder{{
+					OrderId: buyOrder.OrderId,
+					Buyer:   buyOrder.Buyer,
+					Price:   sdk.NewInt64Coin("denom", 0),
+				}},
+			},
+			valid: false,
 		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
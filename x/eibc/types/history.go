@@ -0,0 +1,59 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AddFill accounts for a new pending fulfillment
+func (p *FulfillmentPnL) AddFill(f Fulfillment) {
+	p.Fills++
+	p.Outstanding = p.Outstanding.Add(f.Price)
+}
+
+// RemoveFill removes a pending fulfillment, whose claim changed hands
+func (p *FulfillmentPnL) RemoveFill(f Fulfillment) {
+	if 0 < p.Fills {
+		p.Fills--
+	}
+	if outstanding, neg := p.Outstanding.SafeSub(f.Price); !neg {
+		p.Outstanding = outstanding
+	}
+}
+
+// Settle moves a fulfillment from outstanding to earned fees or losses, depending on its outcome
+func (p *FulfillmentPnL) Settle(f Fulfillment) {
+	if outstanding, neg := p.Outstanding.SafeSub(f.Price); !neg {
+		p.Outstanding = outstanding
+	}
+	switch f.Outcome {
+	case FulfillmentOutcome_FULFILLMENT_OUTCOME_FINALIZED:
+		p.Finalized++
+		p.EarnedFees = p.EarnedFees.Add(f.Fee)
+	case FulfillmentOutcome_FULFILLMENT_OUTCOME_REVERTED:
+		p.Reverted++
+		p.Losses = p.Losses.Add(f.Price)
+	default:
+	}
+}
+
+// Holders returns the fulfillment as seen by each account which fronted its price: the fulfillment itself
+// for a single fulfiller, or the part of each share if it was funded by partial fulfillers, with the fee
+// split pro-rata to the shares. Any rounding remainder goes to the first share.
+func (f Fulfillment) Holders() []Fulfillment {
+	if len(f.Shares) == 0 {
+		return []Fulfillment{f}
+	}
+	holders := make([]Fulfillment, len(f.Shares))
+	rem := f.Fee.Amount
+	for i, s := range f.Shares {
+		h := f
+		h.Fulfiller = s.Fulfiller
+		h.Shares = nil
+		h.Price = sdk.NewCoin(f.Price.Denom, s.Amount)
+		h.Fee = sdk.NewCoin(f.Fee.Denom, f.Fee.Amount.Mul(s.Amount).Quo(f.Price.Amount))
+		rem = rem.Sub(h.Fee.Amount)
+		holders[i] = h
+	}
+	holders[0].Fee = holders[0].Fee.AddAmount(rem)
+	return holders
+}
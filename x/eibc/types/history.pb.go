@@ -0,0 +1,1252 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/eibc/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type FulfillmentOutcome int32
+
+const (
+	// the underlying packet is not finalized yet
+	FulfillmentOutcome_FULFILLMENT_OUTCOME_PENDING FulfillmentOutcome = 0
+	// the fulfiller received price and fee
+	FulfillmentOutcome_FULFILLMENT_OUTCOME_FINALIZED FulfillmentOutcome = 1
+	// the packet was reverted by a hard fork, the price is lost
+	FulfillmentOutcome_FULFILLMENT_OUTCOME_REVERTED FulfillmentOutcome = 2
+)
+
+var FulfillmentOutcome_name = map[int32]string{
+	0: "FULFILLMENT_OUTCOME_PENDING",
+	1: "FULFILLMENT_OUTCOME_FINALIZED",
+	2: "FULFILLMENT_OUTCOME_REVERTED",
+}
+
+var FulfillmentOutcome_value = map[string]int32{
+	"FULFILLMENT_OUTCOME_PENDING":   0,
+	"FULFILLMENT_OUTCOME_FINALIZED": 1,
+	"FULFILLMENT_OUTCOME_REVERTED":  2,
+}
+
+func (x FulfillmentOutcome) String() string {
+	return proto.EnumName(FulfillmentOutcome_name, int32(x))
+}
+
+func (FulfillmentOutcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7e9ab8ad7c1196ca, []int{0}
+}
+
+// A demand order fulfillment, kept after the order itself is pruned.
+type Fulfillment struct {
+	OrderId   string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// bech32-encoded address which fronted the price
+	Fulfiller string `protobuf:"bytes,3,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	// set if the order was fulfilled through an on demand lp
+	ByLp  bool       `protobuf:"varint,4,opt,name=by_lp,json=byLp,proto3" json:"by_lp,omitempty"`
+	LpId  uint64     `protobuf:"varint,5,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	Price types.Coin `protobuf:"bytes,6,opt,name=price,proto3" json:"price"`
+	Fee   types.Coin `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee"`
+	// hub height of the fulfillment
+	Height  int64              `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+	Outcome FulfillmentOutcome `protobuf:"varint,9,opt,name=outcome,proto3,enum=dymensionxyz.dymension.eibc.FulfillmentOutcome" json:"outcome,omitempty"`
+	// set if the order was funded by partial fulfillers, the fulfiller is then
+	// their escrow
+	Shares []FulfillmentShare `protobuf:"bytes,10,rep,name=shares,proto3" json:"shares"`
+	// hub height of the outcome
+	SettledHeight int64 `protobuf:"varint,11,opt,name=settled_height,json=settledHeight,proto3" json:"settled_height,omitempty"`
+}
+
+func (m *Fulfillment) Reset()         { *m = Fulfillment{} }
+func (m *Fulfillment) String() string { return proto.CompactTextString(m) }
+func (*Fulfillment) ProtoMessage()    {}
+func (*Fulfillment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e9ab8ad7c1196ca, []int{0}
+}
+func (m *Fulfillment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Fulfillment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Fulfillment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Fulfillment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Fulfillment.Merge(m, src)
+}
+func (m *Fulfillment) XXX_Size() int {
+	return m.Size()
+}
+func (m *Fulfillment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Fulfillment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Fulfillment proto.InternalMessageInfo
+
+func (m *Fulfillment) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *Fulfillment) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *Fulfillment) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+func (m *Fulfillment) GetByLp() bool {
+	if m != nil {
+		return m.ByLp
+	}
+	return false
+}
+
+func (m *Fulfillment) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
+	}
+	return 0
+}
+
+func (m *Fulfillment) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Fulfillment) GetFee() types.Coin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.Coin{}
+}
+
+func (m *Fulfillment) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Fulfillment) GetOutcome() FulfillmentOutcome {
+	if m != nil {
+		return m.Outcome
+	}
+	return FulfillmentOutcome_FULFILLMENT_OUTCOME_PENDING
+}
+
+func (m *Fulfillment) GetShares() []FulfillmentShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+func (m *Fulfillment) GetSettledHeight() int64 {
+	if m != nil {
+		return m.SettledHeight
+	}
+	return 0
+}
+
+// Running totals over the fulfillments of a fulfiller or lp.
+type FulfillmentPnL struct {
+	// fees of finalized fulfillments
+	EarnedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=earned_fees,json=earnedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"earned_fees"`
+	// prices fronted for fulfillments which are not finalized yet
+	Outstanding github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=outstanding,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"outstanding"`
+	// prices fronted for fulfillments reverted by a hard fork
+	Losses    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=losses,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"losses"`
+	Fills     uint64                                   `protobuf:"varint,4,opt,name=fills,proto3" json:"fills,omitempty"`
+	Finalized uint64                                   `protobuf:"varint,5,opt,name=finalized,proto3" json:"finalized,omitempty"`
+	Reverted  uint64                                   `protobuf:"varint,6,opt,name=reverted,proto3" json:"reverted,omitempty"`
+}
+
+func (m *FulfillmentPnL) Reset()         { *m = FulfillmentPnL{} }
+func (m *FulfillmentPnL) String() string { return proto.CompactTextString(m) }
+func (*FulfillmentPnL) ProtoMessage()    {}
+func (*FulfillmentPnL) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e9ab8ad7c1196ca, []int{1}
+}
+func (m *FulfillmentPnL) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FulfillmentPnL) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FulfillmentPnL.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FulfillmentPnL) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FulfillmentPnL.Merge(m, src)
+}
+func (m *FulfillmentPnL) XXX_Size() int {
+	return m.Size()
+}
+func (m *FulfillmentPnL) XXX_DiscardUnknown() {
+	xxx_messageInfo_FulfillmentPnL.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FulfillmentPnL proto.InternalMessageInfo
+
+func (m *FulfillmentPnL) GetEarnedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EarnedFees
+	}
+	return nil
+}
+
+func (m *FulfillmentPnL) GetOutstanding() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Outstanding
+	}
+	return nil
+}
+
+func (m *FulfillmentPnL) GetLosses() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Losses
+	}
+	return nil
+}
+
+func (m *FulfillmentPnL) GetFills() uint64 {
+	if m != nil {
+		return m.Fills
+	}
+	return 0
+}
+
+func (m *FulfillmentPnL) GetFinalized() uint64 {
+	if m != nil {
+		return m.Finalized
+	}
+	return 0
+}
+
+func (m *FulfillmentPnL) GetReverted() uint64 {
+	if m != nil {
+		return m.Reverted
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.eibc.FulfillmentOutcome", FulfillmentOutcome_name, FulfillmentOutcome_value)
+	proto.RegisterType((*Fulfillment)(nil), "dymensionxyz.dymension.eibc.Fulfillment")
+	proto.RegisterType((*FulfillmentPnL)(nil), "dymensionxyz.dymension.eibc.FulfillmentPnL")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/history.proto", fileDescriptor_7e9ab8ad7c1196ca)
+}
+
+var fileDescriptor_7e9ab8ad7c1196ca = []byte{
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcb, 0x4e, 0xdb, 0x40,
+	0x14, 0x8d, 0xb1, 0x13, 0x92, 0x89, 0x8a, 0xd0, 0x14, 0x55, 0xe6, 0x65, 0x5c, 0xa4, 0x4a, 0x6e,
+	0x25, 0xec, 0x06, 0xd4, 0x0f, 0x28, 0xe0, 0xb4, 0x16, 0x21, 0x20, 0x17, 0xba, 0x60, 0x63, 0xf9,
+	0x71, 0x93, 0x8c, 0x6a, 0x7b, 0x2c, 0xcf, 0x04, 0x11, 0xbe, 0xa2, 0xdf, 0xd0, 0x65, 0xbf, 0x84,
+	0x25, 0xcb, 0xae, 0xda, 0x0a, 0x7e, 0xa4, 0xf2, 0xd8, 0xa4, 0x91, 0x4a, 0x11, 0x0b, 0x56, 0xc9,
+	0x3d, 0xf7, 0x9c, 0x73, 0x67, 0xae, 0x8f, 0x06, 0xbd, 0x8e, 0x26, 0x09, 0xa4, 0x8c, 0xd0, 0xf4,
+	0x62, 0x72, 0x69, 0x4d, 0x0b, 0x0b, 0x48, 0x10, 0x5a, 0x23, 0xc2, 0x38, 0xcd, 0x27, 0x66, 0x96,
+	0x53, 0x4e, 0xf1, 0xea, 0x2c, 0xd5, 0x9c, 0x16, 0x66, 0x41, 0x5d, 0x59, 0x1a, 0xd2, 0x21, 0x15,
+	0x3c, 0xab, 0xf8, 0x57, 0x4a, 0x56, 0xb4, 0x90, 0xb2, 0x84, 0x32, 0x2b, 0xf0, 0x19, 0x58, 0xe7,
+	0x9d, 0x00, 0xb8, 0xdf, 0xb1, 0x42, 0x4a, 0xd2, 0xaa, 0x6f, 0x3e, 0x34, 0x3d, 0x82, 0xc4, 0x4f,
+	0x23, 0x8f, 0xe6, 0x11, 0xe4, 0x25, 0x7f, 0xf3, 0x5a, 0x46, 0xed, 0xee, 0x38, 0x1e, 0x90, 0x38,
+	0x4e, 0x20, 0xe5, 0x78, 0x19, 0x35, 0x45, 0xdb, 0x23, 0x91, 0x2a, 0xe9, 0x92, 0xd1, 0x72, 0xe7,
+	0x45, 0xed, 0x44, 0x78, 0x1d, 0xa1, 0x9c, 0xc6, 0xb1, 0x9f, 0x65, 0x45, 0x73, 0x4e, 0x34, 0x5b,
+	0x15, 0xe2, 0x44, 0x78, 0x0d, 0xb5, 0x06, 0xa5, 0x11, 0xe4, 0xaa, 0x5c, 0x76, 0xa7, 0x00, 0x7e,
+	0x8e, 0xea, 0xc1, 0xc4, 0x8b, 0x33, 0x55, 0xd1, 0x25, 0xa3, 0xe9, 0x2a, 0xc1, 0xa4, 0x97, 0x15,
+	0x60, 0x2c, 0xcc, 0xea, 0xba, 0x64, 0x28, 0xae, 0x12, 0x17, 0x3e, 0xef, 0x50, 0x3d, 0xcb, 0x49,
+	0x08, 0x6a, 0x43, 0x97, 0x8c, 0xf6, 0xf6, 0xb2, 0x59, 0xde, 0xd8, 0x2c, 0x6e, 0x6c, 0x56, 0x37,
+	0x36, 0xf7, 0x28, 0x49, 0x77, 0x95, 0xab, 0x9f, 0x1b, 0x35, 0xb7, 0x64, 0xe3, 0x0e, 0x92, 0x07,
+	0x00, 0xea, 0xfc, 0xe3, 0x44, 0x05, 0x17, 0xbf, 0x40, 0x8d, 0x11, 0x90, 0xe1, 0x88, 0xab, 0x4d,
+	0x5d, 0x32, 0x64, 0xb7, 0xaa, 0xb0, 0x83, 0xe6, 0xe9, 0x98, 0x87, 0x34, 0x01, 0xb5, 0xa5, 0x4b,
+	0xc6, 0xc2, 0xb6, 0x65, 0x3e, 0xf0, 0xa1, 0xcc, 0x99, 0xf5, 0x1d, 0x95, 0x32, 0xf7, 0x4e, 0x8f,
+	0x0f, 0x50, 0x83, 0x8d, 0xfc, 0x1c, 0x98, 0x8a, 0x74, 0xd9, 0x68, 0x6f, 0x6f, 0x3d, 0xd6, 0xe9,
+	0x53, 0xa1, 0xaa, 0x0e, 0x5b, 0x59, 0xe0, 0x57, 0x68, 0x81, 0x01, 0xe7, 0x31, 0x44, 0x5e, 0x75,
+	0xee, 0xb6, 0x38, 0xf7, 0xb3, 0x0a, 0xfd, 0x28, 0xc0, 0xcd, 0x6f, 0x32, 0x5a, 0x98, 0x71, 0x3a,
+	0x4e, 0x7b, 0x38, 0x46, 0x6d, 0xf0, 0xf3, 0x14, 0x22, 0x6f, 0x00, 0xc0, 0x54, 0x49, 0x97, 0x1f,
+	0x5e, 0xd2, 0xdb, 0x62, 0xee, 0xf7, 0x5f, 0x1b, 0xc6, 0x90, 0xf0, 0xd1, 0x38, 0x30, 0x43, 0x9a,
+	0x58, 0x55, 0xf0, 0xca, 0x9f, 0x2d, 0x16, 0x7d, 0xb1, 0xf8, 0x24, 0x03, 0x26, 0x04, 0xcc, 0x45,
+	0xa5, 0x7f, 0x17, 0x80, 0xe1, 0x04, 0xb5, 0xe9, 0x98, 0x33, 0xee, 0xa7, 0x11, 0x49, 0x87, 0xea,
+	0xdc, 0xd3, 0x4f, 0x9b, 0xf5, 0xc7, 0x21, 0x6a, 0xc4, 0x94, 0x31, 0x60, 0xaa, 0xfc, 0xf4, 0x93,
+	0x2a, 0x6b, 0xbc, 0x84, 0xea, 0xc5, 0x42, 0x99, 0xc8, 0xaf, 0xe2, 0x96, 0x85, 0xc8, 0x3c, 0x49,
+	0xfd, 0x98, 0x5c, 0xc2, 0x5d, 0x88, 0xff, 0x02, 0x78, 0x05, 0x35, 0x73, 0x38, 0x87, 0x9c, 0x43,
+	0x24, 0xc2, 0xac, 0xb8, 0xd3, 0xfa, 0xcd, 0x25, 0xc2, 0xff, 0xe6, 0x06, 0x6f, 0xa0, 0xd5, 0xee,
+	0x69, 0xaf, 0xeb, 0xf4, 0x7a, 0x87, 0x76, 0xff, 0xc4, 0x3b, 0x3a, 0x3d, 0xd9, 0x3b, 0x3a, 0xb4,
+	0xbd, 0x63, 0xbb, 0xbf, 0xef, 0xf4, 0x3f, 0x2c, 0xd6, 0xf0, 0x4b, 0xb4, 0x7e, 0x1f, 0xa1, 0xeb,
+	0xf4, 0xdf, 0xf7, 0x9c, 0x33, 0x7b, 0x7f, 0x51, 0xc2, 0x3a, 0x5a, 0xbb, 0x8f, 0xe2, 0xda, 0x9f,
+	0x6d, 0xf7, 0xc4, 0xde, 0x5f, 0x9c, 0xdb, 0x3d, 0xb8, 0xba, 0xd1, 0xa4, 0xeb, 0x1b, 0x4d, 0xfa,
+	0x7d, 0xa3, 0x49, 0x5f, 0x6f, 0xb5, 0xda, 0xf5, 0xad, 0x56, 0xfb, 0x71, 0xab, 0xd5, 0xce, 0x3a,
+	0x33, 0x7b, 0xf9, 0xcf, 0x43, 0x72, 0xbe, 0x63, 0x5d, 0x94, 0xaf, 0x89, 0x58, 0x53, 0xd0, 0x10,
+	0xef, 0xc8, 0xce, 0x9f, 0x01, 0x00, 0xae, 0x6a, 0x66, 0x7e, 0xf7, 0x04, 0x00, 0x00,
+}
+
+func (m *Fulfillment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Fulfillment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Fulfillment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettledHeight != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.SettledHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.Outcome != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Outcome))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintHistory(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.LpId != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ByLp {
+		i--
+		if m.ByLp {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FulfillmentPnL) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FulfillmentPnL) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FulfillmentPnL) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Reverted != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Reverted))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Finalized != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Finalized))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Fills != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Fills))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Losses) > 0 {
+		for iNdEx := len(m.Losses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Losses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Outstanding) > 0 {
+		for iNdEx := len(m.Outstanding) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outstanding[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.EarnedFees) > 0 {
+		for iNdEx := len(m.EarnedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EarnedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Fulfillment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.ByLp {
+		n += 2
+	}
+	if m.LpId != 0 {
+		n += 1 + sovHistory(uint64(m.LpId))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovHistory(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	if m.Outcome != 0 {
+		n += 1 + sovHistory(uint64(m.Outcome))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	if m.SettledHeight != 0 {
+		n += 1 + sovHistory(uint64(m.SettledHeight))
+	}
+	return n
+}
+
+func (m *FulfillmentPnL) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EarnedFees) > 0 {
+		for _, e := range m.EarnedFees {
+			l = e.Size()
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	if len(m.Outstanding) > 0 {
+		for _, e := range m.Outstanding {
+			l = e.Size()
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	if len(m.Losses) > 0 {
+		for _, e := range m.Losses {
+			l = e.Size()
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	if m.Fills != 0 {
+		n += 1 + sovHistory(uint64(m.Fills))
+	}
+	if m.Finalized != 0 {
+		n += 1 + sovHistory(uint64(m.Finalized))
+	}
+	if m.Reverted != 0 {
+		n += 1 + sovHistory(uint64(m.Reverted))
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Fulfillment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Fulfillment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Fulfillment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByLp", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ByLp = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
+			}
+			m.LpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LpId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outcome", wireType)
+			}
+			m.Outcome = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Outcome |= FulfillmentOutcome(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shares = append(m.Shares, FulfillmentShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledHeight", wireType)
+			}
+			m.SettledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SettledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FulfillmentPnL) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FulfillmentPnL: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FulfillmentPnL: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarnedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EarnedFees = append(m.EarnedFees, types.Coin{})
+			if err := m.EarnedFees[len(m.EarnedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outstanding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outstanding = append(m.Outstanding, types.Coin{})
+			if err := m.Outstanding[len(m.Outstanding)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Losses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Losses = append(m.Losses, types.Coin{})
+			if err := m.Losses[len(m.Losses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			m.Fills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finalized", wireType)
+			}
+			m.Finalized = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Finalized |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reverted", wireType)
+			}
+			m.Reverted = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Reverted |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
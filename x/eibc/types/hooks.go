@@ -6,6 +6,8 @@ import (
 
 type EIBCHooks interface {
 	AfterDemandOrderFulfilled(ctx sdk.Context, demandOrder *DemandOrder, newTransferRecipient string) error
+	// AfterDemandOrderClaimTransferred is called when the claim on a fulfilled order changes hands
+	AfterDemandOrderClaimTransferred(ctx sdk.Context, demandOrder *DemandOrder, newTransferRecipient string) error
 }
 
 type MultiEIBCHooks []EIBCHooks
@@ -26,6 +28,16 @@ func (h MultiEIBCHooks) AfterDemandOrderFulfilled(ctx sdk.Context, o *DemandOrde
 	return nil
 }
 
+func (h MultiEIBCHooks) AfterDemandOrderClaimTransferred(ctx sdk.Context, o *DemandOrder, newTransferRecipient string) error {
+	for i := range h {
+		err := h[i].AfterDemandOrderClaimTransferred(ctx, o, newTransferRecipient)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 type BaseEIBCHook struct{}
 
 var _ EIBCHooks = BaseEIBCHook{}
@@ -33,3 +45,7 @@ var _ EIBCHooks = BaseEIBCHook{}
 func (b BaseEIBCHook) AfterDemandOrderFulfilled(ctx sdk.Context, o *DemandOrder, newTransferRecipient string) error {
 	return nil
 }
+
+func (b BaseEIBCHook) AfterDemandOrderClaimTransferred(ctx sdk.Context, o *DemandOrder, newTransferRecipient string) error {
+	return nil
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -39,9 +41,38 @@ func (d OnDemandLP) Validate() error {
 	if d.SpendLimit.IsNil() || !d.SpendLimit.IsPositive() {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "spend limit")
 	}
+	if !d.WindowSpendLimit.IsNil() && d.WindowSpendLimit.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "window spend limit")
+	}
+	if isSet(d.WindowSpendLimit) != (d.WindowBlocks != 0) {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "window spend limit and window blocks must be set together")
+	}
+	if !d.MaxPendingExposure.IsNil() && d.MaxPendingExposure.IsNegative() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "max pending exposure")
+	}
 	return nil
 }
 
+// Expired returns true if the lp should not fulfill anymore and be deleted
+func (d OnDemandLP) Expired(nowHeight uint64, nowTime time.Time) bool {
+	if d.ExpiryHeight != 0 && d.ExpiryHeight <= nowHeight {
+		return true
+	}
+	return d.ExpiryTime != nil && !nowTime.Before(*d.ExpiryTime)
+}
+
+// nil is treated as not set, for lps created before the field existed
+func isSet(x math.Int) bool {
+	return !x.IsNil() && x.IsPositive()
+}
+
+func orZero(x math.Int) math.Int {
+	if x.IsNil() {
+		return math.ZeroInt()
+	}
+	return x
+}
+
 func (r OnDemandLPRecord) Validate() error {
 	if r.Lp == nil {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "empty lp")
@@ -62,9 +93,39 @@ func (r OnDemandLPRecord) MaxSpend() math.Int {
 	return math.MinInt(r.Lp.MaxPrice, r.Lp.SpendLimit.Sub(r.Spent))
 }
 
-func (r OnDemandLPRecord) Accepts(nowHeight uint64, o *DemandOrder) bool {
-	priceOK := o.PriceAmount().LTE(r.MaxSpend())
+// WindowSpentAt returns the amt spent in the spend window which is current at the given height
+func (r OnDemandLPRecord) WindowSpentAt(nowHeight uint64) math.Int {
+	if r.Lp.WindowBlocks == 0 || r.WindowStart+r.Lp.WindowBlocks <= nowHeight {
+		return math.ZeroInt()
+	}
+	return orZero(r.WindowSpent)
+}
+
+func (r OnDemandLPRecord) Accepts(nowHeight uint64, nowTime time.Time, o *DemandOrder) bool {
+	price := o.PriceAmount()
+	priceOK := price.LTE(r.MaxSpend())
 	feeOK := r.Lp.MinFee.LTE(o.GetFeePercent())
 	ageOK := r.Lp.OrderMinAgeBlocks <= nowHeight-o.CreationHeight
-	return priceOK && feeOK && ageOK
+	fillsOK := r.Lp.MaxFills == 0 || r.Fills < r.Lp.MaxFills
+	windowOK := !isSet(r.Lp.WindowSpendLimit) || r.WindowSpentAt(nowHeight).Add(price).LTE(r.Lp.WindowSpendLimit)
+	exposureOK := !isSet(r.Lp.MaxPendingExposure) || orZero(r.PendingExposure).Add(price).LTE(r.Lp.MaxPendingExposure)
+	liveOK := !r.Lp.Expired(nowHeight, nowTime)
+	return priceOK && feeOK && ageOK && fillsOK && windowOK && exposureOK && liveOK
+}
+
+// RecordFill updates the usage of the lp limits after it fulfilled an order for amt
+func (r *OnDemandLPRecord) RecordFill(nowHeight uint64, amt math.Int) {
+	if r.WindowSpentAt(nowHeight).IsZero() {
+		r.WindowStart = nowHeight
+		r.WindowSpent = math.ZeroInt()
+	}
+	r.Spent = r.Spent.Add(amt)
+	r.Fills++
+	r.WindowSpent = orZero(r.WindowSpent).Add(amt)
+	r.PendingExposure = orZero(r.PendingExposure).Add(amt)
+}
+
+// ReleaseExposure is called once an order fulfilled by the lp is not pending anymore
+func (r *OnDemandLPRecord) ReleaseExposure(amt math.Int) {
+	r.PendingExposure = math.MaxInt(orZero(r.PendingExposure).Sub(amt), math.ZeroInt())
 }
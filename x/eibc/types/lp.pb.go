@@ -10,16 +10,20 @@ import (
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "github.com/dymensionxyz/dymension/v3/x/common/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -44,6 +48,21 @@ type OnDemandLP struct {
 	// 4,
 	//      then fulfill if this field is 3 or less
 	OrderMinAgeBlocks uint64 `protobuf:"varint,7,opt,name=orderMinAgeBlocks,proto3" json:"orderMinAgeBlocks,omitempty"`
+	// will not fulfill more than this many orders (0 means no limit)
+	MaxFills uint64 `protobuf:"varint,8,opt,name=maxFills,proto3" json:"maxFills,omitempty"`
+	// will not fulfill if brings amt spent in the current window above this (0
+	// means no limit)
+	WindowSpendLimit cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=windowSpendLimit,proto3,customtype=cosmossdk.io/math.Int" json:"windowSpendLimit"`
+	// length of the spend window in blocks, a window starts with the first fill
+	// after the previous one ended
+	WindowBlocks uint64 `protobuf:"varint,10,opt,name=windowBlocks,proto3" json:"windowBlocks,omitempty"`
+	// deleted once this height is reached (0 means never)
+	ExpiryHeight uint64 `protobuf:"varint,11,opt,name=expiryHeight,proto3" json:"expiryHeight,omitempty"`
+	// deleted once block time reaches this (unset means never)
+	ExpiryTime *time.Time `protobuf:"bytes,12,opt,name=expiryTime,proto3,stdtime" json:"expiryTime,omitempty"`
+	// will not fulfill if brings amt fronted for orders of the rollapp which are
+	// not yet finalized above this (0 means no limit)
+	MaxPendingExposure cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=maxPendingExposure,proto3,customtype=cosmossdk.io/math.Int" json:"maxPendingExposure"`
 }
 
 func (m *OnDemandLP) Reset()         { *m = OnDemandLP{} }
@@ -107,11 +126,47 @@ func (m *OnDemandLP) GetOrderMinAgeBlocks() uint64 {
 	return 0
 }
 
+func (m *OnDemandLP) GetMaxFills() uint64 {
+	if m != nil {
+		return m.MaxFills
+	}
+	return 0
+}
+
+func (m *OnDemandLP) GetWindowBlocks() uint64 {
+	if m != nil {
+		return m.WindowBlocks
+	}
+	return 0
+}
+
+func (m *OnDemandLP) GetExpiryHeight() uint64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *OnDemandLP) GetExpiryTime() *time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return nil
+}
+
 type OnDemandLPRecord struct {
 	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	// amt spent so far
 	Spent cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=spent,proto3,customtype=cosmossdk.io/math.Int" json:"spent"`
 	Lp    *OnDemandLP           `protobuf:"bytes,3,opt,name=lp,proto3" json:"lp,omitempty"`
+	// number of orders fulfilled so far
+	Fills uint64 `protobuf:"varint,4,opt,name=fills,proto3" json:"fills,omitempty"`
+	// height of the first fill of the current spend window
+	WindowStart uint64 `protobuf:"varint,5,opt,name=windowStart,proto3" json:"windowStart,omitempty"`
+	// amt spent in the current spend window
+	WindowSpent cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=windowSpent,proto3,customtype=cosmossdk.io/math.Int" json:"windowSpent"`
+	// amt fronted for orders which are not yet finalized
+	PendingExposure cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=pendingExposure,proto3,customtype=cosmossdk.io/math.Int" json:"pendingExposure"`
 }
 
 func (m *OnDemandLPRecord) Reset()         { *m = OnDemandLPRecord{} }
@@ -161,9 +216,218 @@ func (m *OnDemandLPRecord) GetLp() *OnDemandLP {
 	return nil
 }
 
+func (m *OnDemandLPRecord) GetFills() uint64 {
+	if m != nil {
+		return m.Fills
+	}
+	return 0
+}
+
+func (m *OnDemandLPRecord) GetWindowStart() uint64 {
+	if m != nil {
+		return m.WindowStart
+	}
+	return 0
+}
+
+// An order fulfilled by an lp which is not yet finalized.
+type OnDemandLPPendingFill struct {
+	LpId   uint64                `protobuf:"varint,1,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *OnDemandLPPendingFill) Reset()         { *m = OnDemandLPPendingFill{} }
+func (m *OnDemandLPPendingFill) String() string { return proto.CompactTextString(m) }
+func (*OnDemandLPPendingFill) ProtoMessage()    {}
+func (*OnDemandLPPendingFill) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13de3de2ae42eb80, []int{2}
+}
+func (m *OnDemandLPPendingFill) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OnDemandLPPendingFill) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OnDemandLPPendingFill.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OnDemandLPPendingFill) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OnDemandLPPendingFill.Merge(m, src)
+}
+func (m *OnDemandLPPendingFill) XXX_Size() int {
+	return m.Size()
+}
+func (m *OnDemandLPPendingFill) XXX_DiscardUnknown() {
+	xxx_messageInfo_OnDemandLPPendingFill.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OnDemandLPPendingFill proto.InternalMessageInfo
+
+func (m *OnDemandLPPendingFill) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
+	}
+	return 0
+}
+
+// A single order matched to an lp by the end block batch matcher.
+type BatchMatch struct {
+	OrderId   string                `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	LpId      uint64                `protobuf:"varint,2,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	Fulfiller string                `protobuf:"bytes,3,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	Price     cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=price,proto3,customtype=cosmossdk.io/math.Int" json:"price"`
+	Fee       cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
+	// fee as a percentage of the order amount
+	FeePercent cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=fee_percent,json=feePercent,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fee_percent"`
+	// the min fee of the matched lp
+	LpMinFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=lp_min_fee,json=lpMinFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"lp_min_fee"`
+}
+
+func (m *BatchMatch) Reset()         { *m = BatchMatch{} }
+func (m *BatchMatch) String() string { return proto.CompactTextString(m) }
+func (*BatchMatch) ProtoMessage()    {}
+func (*BatchMatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13de3de2ae42eb80, []int{3}
+}
+func (m *BatchMatch) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchMatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchMatch.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchMatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchMatch.Merge(m, src)
+}
+func (m *BatchMatch) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchMatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchMatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchMatch proto.InternalMessageInfo
+
+func (m *BatchMatch) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *BatchMatch) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
+	}
+	return 0
+}
+
+func (m *BatchMatch) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+// The outcome of the latest batch for a (rollapp, denom) pair.
+type BatchClearingResult struct {
+	Rollapp string `protobuf:"bytes,1,opt,name=rollapp,proto3" json:"rollapp,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// block height of the batch
+	Height  int64        `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Matches []BatchMatch `protobuf:"bytes,4,rep,name=matches,proto3" json:"matches"`
+	// number of orders which were considered but found no compatible lp
+	Unmatched uint64 `protobuf:"varint,5,opt,name=unmatched,proto3" json:"unmatched,omitempty"`
+}
+
+func (m *BatchClearingResult) Reset()         { *m = BatchClearingResult{} }
+func (m *BatchClearingResult) String() string { return proto.CompactTextString(m) }
+func (*BatchClearingResult) ProtoMessage()    {}
+func (*BatchClearingResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_13de3de2ae42eb80, []int{4}
+}
+func (m *BatchClearingResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchClearingResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchClearingResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchClearingResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchClearingResult.Merge(m, src)
+}
+func (m *BatchClearingResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchClearingResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchClearingResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchClearingResult proto.InternalMessageInfo
+
+func (m *BatchClearingResult) GetRollapp() string {
+	if m != nil {
+		return m.Rollapp
+	}
+	return ""
+}
+
+func (m *BatchClearingResult) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *BatchClearingResult) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BatchClearingResult) GetMatches() []BatchMatch {
+	if m != nil {
+		return m.Matches
+	}
+	return nil
+}
+
+func (m *BatchClearingResult) GetUnmatched() uint64 {
+	if m != nil {
+		return m.Unmatched
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*OnDemandLP)(nil), "dymensionxyz.dymension.eibc.OnDemandLP")
 	proto.RegisterType((*OnDemandLPRecord)(nil), "dymensionxyz.dymension.eibc.OnDemandLPRecord")
+	proto.RegisterType((*OnDemandLPPendingFill)(nil), "dymensionxyz.dymension.eibc.OnDemandLPPendingFill")
+	proto.RegisterType((*BatchMatch)(nil), "dymensionxyz.dymension.eibc.BatchMatch")
+	proto.RegisterType((*BatchClearingResult)(nil), "dymensionxyz.dymension.eibc.BatchClearingResult")
 }
 
 func init() {
@@ -171,37 +435,61 @@ func init() {
 }
 
 var fileDescriptor_13de3de2ae42eb80 = []byte{
-	// 469 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xcf, 0x4a, 0x1b, 0x41,
-	0x18, 0xcf, 0x6e, 0x93, 0x58, 0xa7, 0x50, 0xec, 0x60, 0x61, 0xab, 0x74, 0x15, 0x29, 0x54, 0xda,
-	0xba, 0x43, 0xf4, 0xe0, 0x39, 0x41, 0x5a, 0xc4, 0x14, 0x65, 0x8f, 0xbd, 0x84, 0xd9, 0x99, 0xe9,
-	0x3a, 0x64, 0x67, 0xbe, 0x61, 0x67, 0x22, 0x49, 0x9f, 0xa2, 0x4f, 0xd0, 0xa7, 0xf0, 0x21, 0x3c,
-	0x8a, 0xa7, 0xd2, 0x83, 0x94, 0xe4, 0x05, 0xfa, 0x08, 0x25, 0x3b, 0xdb, 0x34, 0x20, 0x0a, 0xde,
-	0xf6, 0xf7, 0xfd, 0xfe, 0xec, 0x8f, 0x6f, 0xbf, 0x45, 0x6f, 0xf8, 0x44, 0x09, 0x6d, 0x25, 0xe8,
-	0xf1, 0xe4, 0x1b, 0x59, 0x00, 0x22, 0x64, 0xc6, 0x48, 0x61, 0x12, 0x53, 0x82, 0x03, 0xbc, 0xb9,
-	0xac, 0x4a, 0x16, 0x20, 0x99, 0xab, 0x36, 0xd6, 0x73, 0xc8, 0xa1, 0xd2, 0x91, 0xf9, 0x93, 0xb7,
-	0x6c, 0xbc, 0xbb, 0x27, 0x98, 0x81, 0x52, 0xa0, 0x89, 0x75, 0xd4, 0x8d, 0x6c, 0xad, 0xdd, 0x7f,
-	0x58, 0x5b, 0x42, 0x51, 0x50, 0x63, 0x06, 0x86, 0xb2, 0xa1, 0x70, 0xb5, 0x27, 0x66, 0x60, 0x15,
-	0x58, 0x92, 0x51, 0x2b, 0xc8, 0x45, 0x27, 0x13, 0x8e, 0x76, 0x08, 0x03, 0xa9, 0x6b, 0xfe, 0x95,
-	0xe7, 0x07, 0xbe, 0x98, 0x07, 0x9e, 0xda, 0xf9, 0x13, 0x22, 0x74, 0xaa, 0x8f, 0x84, 0xa2, 0x9a,
-	0xf7, 0xcf, 0xf0, 0x6b, 0x84, 0xbe, 0x8e, 0x34, 0xb7, 0x03, 0xca, 0x79, 0x19, 0x05, 0xdb, 0xc1,
-	0xee, 0x6a, 0xba, 0x5a, 0x4d, 0xba, 0x9c, 0x97, 0x38, 0x42, 0x2b, 0x75, 0x81, 0x28, 0xac, 0xb8,
-	0x7f, 0x10, 0xaf, 0xa3, 0x16, 0x17, 0x1a, 0x54, 0xf4, 0xa4, 0x9a, 0x7b, 0x80, 0x3f, 0xa1, 0xa7,
-	0x8a, 0x8e, 0xcf, 0x4a, 0xc9, 0x44, 0xd4, 0x9c, 0x13, 0xbd, 0xf7, 0x57, 0xb7, 0x5b, 0x8d, 0x5f,
-	0xb7, 0x5b, 0x2f, 0x7d, 0x0b, 0xcb, 0x87, 0x89, 0x04, 0xa2, 0xa8, 0x3b, 0x4f, 0x8e, 0xb5, 0xbb,
-	0xb9, 0xdc, 0x43, 0x75, 0xbd, 0x63, 0xed, 0xd2, 0x85, 0x19, 0x9f, 0xa2, 0xb6, 0x92, 0xfa, 0xa3,
-	0x10, 0x51, 0xab, 0x8a, 0x39, 0xac, 0x63, 0x36, 0xef, 0xc6, 0xf4, 0x45, 0x4e, 0xd9, 0xe4, 0x48,
-	0xb0, 0x9b, 0xcb, 0xbd, 0xb5, 0x3a, 0x6c, 0x31, 0x4b, 0xeb, 0x18, 0x7c, 0x82, 0x90, 0x35, 0x42,
-	0xf3, 0xbe, 0x54, 0xd2, 0x45, 0xed, 0xc7, 0x77, 0x5b, 0xb2, 0xe3, 0x0f, 0xe8, 0x05, 0x94, 0x5c,
-	0x94, 0x9f, 0xa5, 0xee, 0xe6, 0xa2, 0x57, 0x00, 0x1b, 0xda, 0x68, 0x65, 0x3b, 0xd8, 0x6d, 0xa6,
-	0x77, 0x89, 0x9d, 0x1f, 0x01, 0x5a, 0xfb, 0xbf, 0xf2, 0x54, 0x30, 0x28, 0x39, 0x7e, 0x8e, 0x42,
-	0xc9, 0xab, 0x85, 0x37, 0xd3, 0x50, 0x72, 0xdc, 0x45, 0xad, 0xf9, 0x0b, 0x9c, 0xdf, 0xf3, 0xe3,
-	0xaa, 0x79, 0x27, 0x3e, 0x44, 0x61, 0x61, 0xaa, 0xef, 0xf1, 0x6c, 0xff, 0x6d, 0xf2, 0xc0, 0xd5,
-	0x26, 0x4b, 0x6d, 0xc2, 0xc2, 0xf4, 0x4e, 0xae, 0xa6, 0x71, 0x70, 0x3d, 0x8d, 0x83, 0xdf, 0xd3,
-	0x38, 0xf8, 0x3e, 0x8b, 0x1b, 0xd7, 0xb3, 0xb8, 0xf1, 0x73, 0x16, 0x37, 0xbe, 0x74, 0x72, 0xe9,
-	0xce, 0x47, 0x59, 0xc2, 0x40, 0x91, 0x7b, 0xee, 0xf4, 0xe2, 0x80, 0x8c, 0xfd, 0x1f, 0xe3, 0x26,
-	0x46, 0xd8, 0xac, 0x5d, 0xdd, 0xd9, 0xc1, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe5, 0x5c, 0x11,
-	0xce, 0x5d, 0x03, 0x00, 0x00,
+	// 859 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xae, 0xff, 0xc5, 0xcf, 0x05, 0xc2, 0xb4, 0x45, 0xdb, 0x14, 0x9c, 0xc8, 0x42, 0x22,
+	0x02, 0xba, 0xab, 0xa4, 0x87, 0x9e, 0x90, 0x88, 0x5b, 0x5a, 0xa2, 0x26, 0x6a, 0xb4, 0xb4, 0x02,
+	0xc1, 0xc1, 0x5a, 0xef, 0x3c, 0xaf, 0x47, 0xd9, 0x9d, 0x59, 0x76, 0x67, 0x5b, 0x9b, 0xef, 0x80,
+	0xd4, 0x0f, 0xd3, 0x8f, 0xc0, 0xa1, 0x07, 0x0e, 0x55, 0x4f, 0x88, 0x43, 0x41, 0xc9, 0xd7, 0xe0,
+	0x80, 0x76, 0x66, 0x6c, 0x2f, 0x0d, 0x49, 0x65, 0x5f, 0x2c, 0xff, 0xde, 0xbc, 0xf7, 0x9b, 0xdf,
+	0xf8, 0xf7, 0xe6, 0x8d, 0xe1, 0x53, 0x3a, 0x4d, 0x90, 0xe7, 0x4c, 0xf0, 0xc9, 0xf4, 0x17, 0x6f,
+	0x0e, 0x3c, 0x64, 0xc3, 0xd0, 0x8b, 0x53, 0x37, 0xcd, 0x84, 0x14, 0xe4, 0x66, 0x35, 0xcb, 0x9d,
+	0x03, 0xb7, 0xcc, 0xda, 0xbc, 0x16, 0x89, 0x48, 0xa8, 0x3c, 0xaf, 0xfc, 0xa6, 0x4b, 0x36, 0x3f,
+	0xbf, 0x80, 0x38, 0x14, 0x49, 0x22, 0xb8, 0x97, 0xcb, 0x40, 0x16, 0xb9, 0xc9, 0xdd, 0xbb, 0x3c,
+	0x37, 0x13, 0x71, 0x1c, 0xa4, 0xe9, 0x20, 0x0d, 0xc2, 0x13, 0x94, 0xa6, 0xa6, 0x1b, 0x8a, 0x3c,
+	0x11, 0xb9, 0x37, 0x0c, 0x72, 0xf4, 0x9e, 0xee, 0x0e, 0x51, 0x06, 0xbb, 0x5e, 0x28, 0x18, 0x37,
+	0xeb, 0x37, 0xf4, 0xfa, 0x40, 0x0b, 0xd3, 0xc0, 0x2c, 0x6d, 0x45, 0x42, 0x44, 0x31, 0x7a, 0x0a,
+	0x0d, 0x8b, 0x91, 0x27, 0x59, 0x82, 0xb9, 0x0c, 0x12, 0x73, 0xdc, 0xde, 0xef, 0x0d, 0x80, 0x47,
+	0xfc, 0x1e, 0x26, 0x01, 0xa7, 0x87, 0xc7, 0xe4, 0x13, 0x80, 0x51, 0xc1, 0x69, 0x3e, 0x08, 0x28,
+	0xcd, 0x1c, 0x6b, 0xdb, 0xda, 0x69, 0xfb, 0x6d, 0x15, 0xd9, 0xa7, 0x34, 0x23, 0x0e, 0xb4, 0x8c,
+	0x42, 0xc7, 0x56, 0x6b, 0x33, 0x48, 0xae, 0x41, 0x83, 0x22, 0x17, 0x89, 0x53, 0x53, 0x71, 0x0d,
+	0xc8, 0x03, 0x58, 0x4f, 0x82, 0xc9, 0x71, 0xc6, 0x42, 0x74, 0xea, 0xe5, 0x42, 0xff, 0x8b, 0x97,
+	0x6f, 0xb6, 0xd6, 0xfe, 0x7c, 0xb3, 0x75, 0x5d, 0xcb, 0xcc, 0xe9, 0x89, 0xcb, 0x84, 0x97, 0x04,
+	0x72, 0xec, 0x1e, 0x70, 0xf9, 0xfa, 0xc5, 0x2d, 0x30, 0xfa, 0x0f, 0xb8, 0xf4, 0xe7, 0xc5, 0xe4,
+	0x11, 0x34, 0x13, 0xc6, 0xef, 0x23, 0x3a, 0x0d, 0x45, 0x73, 0xc7, 0xd0, 0xdc, 0x3c, 0x4f, 0x73,
+	0x88, 0x51, 0x10, 0x4e, 0xef, 0x61, 0xf8, 0xfa, 0xc5, 0xad, 0x0d, 0x43, 0x36, 0x8f, 0xf9, 0x86,
+	0x86, 0x3c, 0x04, 0xc8, 0x53, 0xe4, 0xf4, 0x90, 0x25, 0x4c, 0x3a, 0xcd, 0xe5, 0xb5, 0x55, 0xca,
+	0xc9, 0x97, 0xf0, 0xa1, 0xc8, 0x28, 0x66, 0x47, 0x8c, 0xef, 0x47, 0xd8, 0x8f, 0x45, 0x78, 0x92,
+	0x3b, 0xad, 0x6d, 0x6b, 0xa7, 0xee, 0x9f, 0x5f, 0x20, 0x9b, 0xea, 0x47, 0xb9, 0xcf, 0xe2, 0x38,
+	0x77, 0xd6, 0x55, 0xd2, 0x1c, 0x93, 0xef, 0x61, 0xe3, 0x19, 0xe3, 0x54, 0x3c, 0xfb, 0x6e, 0x21,
+	0xae, 0xbd, 0xbc, 0xb8, 0x73, 0x24, 0xa4, 0x07, 0x57, 0x74, 0xcc, 0xa8, 0x03, 0xb5, 0xf1, 0x7f,
+	0x62, 0x65, 0x0e, 0x4e, 0x52, 0x96, 0x4d, 0xbf, 0x45, 0x16, 0x8d, 0xa5, 0xd3, 0xd1, 0x39, 0xd5,
+	0x18, 0xf9, 0x1a, 0x40, 0xe3, 0xc7, 0x2c, 0x41, 0xe7, 0xca, 0xb6, 0xb5, 0xd3, 0xd9, 0xdb, 0x74,
+	0x75, 0x97, 0xb9, 0xb3, 0x2e, 0x73, 0x1f, 0xcf, 0xba, 0xac, 0x5f, 0x7f, 0xfe, 0xd7, 0x96, 0xe5,
+	0x57, 0x6a, 0xc8, 0x4f, 0x40, 0x4a, 0x5b, 0x91, 0x53, 0xc6, 0xa3, 0x6f, 0x26, 0xa9, 0xc8, 0x8b,
+	0x0c, 0x9d, 0xf7, 0x96, 0x3f, 0xe4, 0xff, 0xd0, 0xf4, 0xfe, 0xb1, 0x61, 0x63, 0xd1, 0xce, 0x3e,
+	0x86, 0x22, 0xa3, 0xe4, 0x7d, 0xb0, 0x19, 0x55, 0xcd, 0x5c, 0xf7, 0x6d, 0x46, 0xc9, 0x3e, 0x34,
+	0x4a, 0xf3, 0xa4, 0x63, 0x2f, 0xbf, 0xa9, 0xae, 0x24, 0x77, 0xc0, 0x8e, 0x53, 0xd5, 0xeb, 0x9d,
+	0xbd, 0xcf, 0xdc, 0x4b, 0x46, 0x86, 0x5b, 0x51, 0x63, 0xc7, 0xea, 0x9e, 0x8c, 0x94, 0xf3, 0x75,
+	0x25, 0x47, 0x03, 0xb2, 0x0d, 0x1d, 0xe3, 0x98, 0x0c, 0x32, 0xa9, 0x7a, 0xbc, 0xee, 0x57, 0x43,
+	0xe4, 0x68, 0x9e, 0xa1, 0x94, 0xaf, 0xd0, 0xb0, 0xd5, 0x7a, 0xf2, 0x04, 0x3e, 0x48, 0xdf, 0x72,
+	0xa0, 0xb5, 0x3c, 0xe5, 0xdb, 0x1c, 0xbd, 0x9f, 0xe1, 0xfa, 0xe2, 0xbc, 0xc6, 0x9b, 0xb2, 0xb1,
+	0xc9, 0x55, 0x68, 0xc4, 0xe9, 0x60, 0xee, 0x42, 0x3d, 0x4e, 0x0f, 0x28, 0xb9, 0x0b, 0xcd, 0x20,
+	0x11, 0xc5, 0x6a, 0x46, 0x98, 0xd2, 0xde, 0xaf, 0x35, 0x80, 0x7e, 0x20, 0xc3, 0xf1, 0x51, 0xf9,
+	0x41, 0x6e, 0xc0, 0xba, 0xba, 0x71, 0xb3, 0xbd, 0xda, 0x7e, 0x4b, 0xe1, 0x03, 0xba, 0xd0, 0x60,
+	0x57, 0x34, 0x7c, 0x0c, 0xed, 0x51, 0x11, 0x97, 0x2e, 0x60, 0x66, 0x66, 0xd7, 0x22, 0x50, 0x76,
+	0x4a, 0xba, 0xea, 0xf0, 0xd2, 0x95, 0xe4, 0x2b, 0xa8, 0x8d, 0xe6, 0x63, 0x6b, 0x29, 0x82, 0xb2,
+	0x8e, 0xfc, 0x00, 0x9d, 0x11, 0xe2, 0x20, 0xc5, 0x2c, 0x5c, 0xf8, 0xbe, 0xf2, 0xf4, 0x83, 0x11,
+	0xe2, 0xb1, 0xa6, 0x22, 0x4f, 0x00, 0xe2, 0x74, 0x90, 0x30, 0x3e, 0x18, 0xe1, 0xcc, 0xfd, 0x95,
+	0x89, 0xd7, 0xe3, 0xf4, 0x48, 0x0d, 0xd6, 0xde, 0x6f, 0x16, 0x5c, 0x55, 0x7e, 0xdc, 0x8d, 0x31,
+	0xc8, 0x18, 0x8f, 0x7c, 0xcc, 0x8b, 0x58, 0x56, 0x9f, 0x0e, 0xeb, 0x82, 0xa7, 0xc3, 0xae, 0x3e,
+	0x1d, 0x1f, 0x41, 0x73, 0xac, 0xc7, 0x50, 0xe9, 0x4a, 0xcd, 0x37, 0x88, 0x3c, 0x80, 0x56, 0x52,
+	0xd2, 0x63, 0x79, 0x85, 0x6a, 0xef, 0xbc, 0x7e, 0x8b, 0xd6, 0xe8, 0xd7, 0xcb, 0xc3, 0xf9, 0xb3,
+	0xea, 0xd2, 0xf9, 0x82, 0x6b, 0x40, 0xcd, 0x8d, 0x5b, 0x04, 0xfa, 0x0f, 0x5f, 0x9e, 0x76, 0xad,
+	0x57, 0xa7, 0x5d, 0xeb, 0xef, 0xd3, 0xae, 0xf5, 0xfc, 0xac, 0xbb, 0xf6, 0xea, 0xac, 0xbb, 0xf6,
+	0xc7, 0x59, 0x77, 0xed, 0xc7, 0xdd, 0x88, 0xc9, 0x71, 0x31, 0x74, 0x43, 0x91, 0x78, 0x17, 0x3c,
+	0xe6, 0x4f, 0x6f, 0x7b, 0x13, 0xfd, 0xb7, 0x42, 0x4e, 0x53, 0xcc, 0x87, 0x4d, 0x35, 0x18, 0x6f,
+	0xff, 0x3b, 0x00, 0x54, 0x18, 0xa0, 0xdb, 0x82, 0x08, 0x00, 0x00,
 }
 
 func (m *OnDemandLP) Marshal() (dAtA []byte, err error) {
@@ -224,6 +512,51 @@ func (m *OnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxPendingExposure.Size()
+		i -= size
+		if _, err := m.MaxPendingExposure.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.ExpiryTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ExpiryTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintLp(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.WindowBlocks != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.WindowBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.WindowSpendLimit.Size()
+		i -= size
+		if _, err := m.WindowSpendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MaxFills != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.MaxFills))
+		i--
+		dAtA[i] = 0x40
+	}
 	if m.OrderMinAgeBlocks != 0 {
 		i = encodeVarintLp(dAtA, i, uint64(m.OrderMinAgeBlocks))
 		i--
@@ -303,6 +636,36 @@ func (m *OnDemandLPRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.PendingExposure.Size()
+		i -= size
+		if _, err := m.PendingExposure.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.WindowSpent.Size()
+		i -= size
+		if _, err := m.WindowSpent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.WindowStart != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.WindowStart))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Fills != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.Fills))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.Lp != nil {
 		{
 			size, err := m.Lp.MarshalToSizedBuffer(dAtA[:i])
@@ -333,72 +696,1024 @@ func (m *OnDemandLPRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintLp(dAtA []byte, offset int, v uint64) int {
-	offset -= sovLp(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OnDemandLPPendingFill) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *OnDemandLP) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OnDemandLPPendingFill) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OnDemandLPPendingFill) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FundsAddr)
-	if l > 0 {
-		n += 1 + l + sovLp(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
 	}
-	l = len(m.Rollapp)
-	if l > 0 {
-		n += 1 + l + sovLp(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if m.LpId != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovLp(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchMatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.MaxPrice.Size()
-	n += 1 + l + sovLp(uint64(l))
-	l = m.MinFee.Size()
-	n += 1 + l + sovLp(uint64(l))
-	l = m.SpendLimit.Size()
-	n += 1 + l + sovLp(uint64(l))
-	if m.OrderMinAgeBlocks != 0 {
-		n += 1 + sovLp(uint64(m.OrderMinAgeBlocks))
+	return dAtA[:n], nil
+}
+
+func (m *BatchMatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchMatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LpMinFee.Size()
+		i -= size
+		if _, err := m.LpMinFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.FeePercent.Size()
+		i -= size
+		if _, err := m.FeePercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintLp(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintLp(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LpId != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintLp(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchClearingResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchClearingResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchClearingResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Unmatched != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.Unmatched))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Matches) > 0 {
+		for iNdEx := len(m.Matches) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Matches[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLp(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintLp(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintLp(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Rollapp) > 0 {
+		i -= len(m.Rollapp)
+		copy(dAtA[i:], m.Rollapp)
+		i = encodeVarintLp(dAtA, i, uint64(len(m.Rollapp)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLp(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLp(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FundsAddr)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = len(m.Rollapp)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = m.MaxPrice.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.MinFee.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.SpendLimit.Size()
+	n += 1 + l + sovLp(uint64(l))
+	if m.OrderMinAgeBlocks != 0 {
+		n += 1 + sovLp(uint64(m.OrderMinAgeBlocks))
+	}
+	if m.MaxFills != 0 {
+		n += 1 + sovLp(uint64(m.MaxFills))
+	}
+	l = m.WindowSpendLimit.Size()
+	n += 1 + l + sovLp(uint64(l))
+	if m.WindowBlocks != 0 {
+		n += 1 + sovLp(uint64(m.WindowBlocks))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovLp(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ExpiryTime)
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = m.MaxPendingExposure.Size()
+	n += 1 + l + sovLp(uint64(l))
+	return n
+}
+
+func (m *OnDemandLPRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovLp(uint64(m.Id))
+	}
+	l = m.Spent.Size()
+	n += 1 + l + sovLp(uint64(l))
+	if m.Lp != nil {
+		l = m.Lp.Size()
+		n += 1 + l + sovLp(uint64(l))
+	}
+	if m.Fills != 0 {
+		n += 1 + sovLp(uint64(m.Fills))
+	}
+	if m.WindowStart != 0 {
+		n += 1 + sovLp(uint64(m.WindowStart))
+	}
+	l = m.WindowSpent.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.PendingExposure.Size()
+	n += 1 + l + sovLp(uint64(l))
+	return n
+}
+
+func (m *OnDemandLPPendingFill) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LpId != 0 {
+		n += 1 + sovLp(uint64(m.LpId))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovLp(uint64(l))
+	return n
+}
+
+func (m *BatchMatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	if m.LpId != 0 {
+		n += 1 + sovLp(uint64(m.LpId))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.FeePercent.Size()
+	n += 1 + l + sovLp(uint64(l))
+	l = m.LpMinFee.Size()
+	n += 1 + l + sovLp(uint64(l))
+	return n
+}
+
+func (m *BatchClearingResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rollapp)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovLp(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovLp(uint64(m.Height))
+	}
+	if len(m.Matches) > 0 {
+		for _, e := range m.Matches {
+			l = e.Size()
+			n += 1 + l + sovLp(uint64(l))
+		}
+	}
+	if m.Unmatched != 0 {
+		n += 1 + sovLp(uint64(m.Unmatched))
+	}
+	return n
+}
+
+func sovLp(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLp(x uint64) (n int) {
+	return sovLp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnDemandLP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundsAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FundsAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollapp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderMinAgeBlocks", wireType)
+			}
+			m.OrderMinAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OrderMinAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFills", wireType)
+			}
+			m.MaxFills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSpendLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowSpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowBlocks", wireType)
+			}
+			m.WindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryTime == nil {
+				m.ExpiryTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPendingExposure", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxPendingExposure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OnDemandLPRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OnDemandLPRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lp == nil {
+				m.Lp = &OnDemandLP{}
+			}
+			if err := m.Lp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fills", wireType)
+			}
+			m.Fills = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Fills |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStart", wireType)
+			}
+			m.WindowStart = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStart |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSpent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WindowSpent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingExposure", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingExposure.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *OnDemandLPRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovLp(uint64(m.Id))
-	}
-	l = m.Spent.Size()
-	n += 1 + l + sovLp(uint64(l))
-	if m.Lp != nil {
-		l = m.Lp.Size()
-		n += 1 + l + sovLp(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovLp(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozLp(x uint64) (n int) {
-	return sovLp(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
+func (m *OnDemandLPPendingFill) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -421,15 +1736,34 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OnDemandLP: wiretype end group for non-group")
+			return fmt.Errorf("proto: OnDemandLPPendingFill: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OnDemandLPPendingFill: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
+			}
+			m.LpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LpId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FundsAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -457,11 +1791,63 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FundsAddr = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLp(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLp
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchMatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLp
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchMatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchMatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -489,11 +1875,30 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rollapp = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
+			}
+			m.LpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LpId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -521,11 +1926,11 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -553,13 +1958,13 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -587,13 +1992,13 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePercent", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -621,15 +2026,15 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FeePercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderMinAgeBlocks", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpMinFee", wireType)
 			}
-			m.OrderMinAgeBlocks = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLp
@@ -639,11 +2044,26 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OrderMinAgeBlocks |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LpMinFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLp(dAtA[iNdEx:])
@@ -665,7 +2085,7 @@ func (m *OnDemandLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
+func (m *BatchClearingResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -688,17 +2108,17 @@ func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OnDemandLPRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: BatchClearingResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OnDemandLPRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BatchClearingResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowLp
@@ -708,14 +2128,27 @@ func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rollapp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -743,13 +2176,30 @@ func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Matches", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -776,13 +2226,30 @@ func (m *OnDemandLPRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Lp == nil {
-				m.Lp = &OnDemandLP{}
-			}
-			if err := m.Lp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Matches = append(m.Matches, BatchMatch{})
+			if err := m.Matches[len(m.Matches)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unmatched", wireType)
+			}
+			m.Unmatched = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Unmatched |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipLp(dAtA[iNdEx:])
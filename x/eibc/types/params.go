@@ -11,20 +11,26 @@ const (
 	defaultEpochIdentifier = "hour"
 	defaultTimeoutFee      = "0.0015"
 	defaultErrAckFee       = "0.0015"
+	defaultBatchMatchLimit = 100
+	maxBatchMatchLimit     = 1000
+
+	defaultFulfillmentHistoryRetention = 1_000_000
 )
 
 // NewParams creates a new Params instance
-func NewParams(epochIdentifier string, timeoutFee math.LegacyDec, errAckFee math.LegacyDec) Params {
+func NewParams(epochIdentifier string, timeoutFee math.LegacyDec, errAckFee math.LegacyDec, batchMatchLimit, fulfillmentHistoryRetention uint64) Params {
 	return Params{
-		EpochIdentifier: epochIdentifier,
-		TimeoutFee:      timeoutFee,
-		ErrackFee:       errAckFee,
+		EpochIdentifier:             epochIdentifier,
+		TimeoutFee:                  timeoutFee,
+		ErrackFee:                   errAckFee,
+		BatchMatchLimit:             batchMatchLimit,
+		FulfillmentHistoryRetention: fulfillmentHistoryRetention,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(defaultEpochIdentifier, math.LegacyMustNewDecFromStr(defaultTimeoutFee), math.LegacyMustNewDecFromStr(defaultErrAckFee))
+	return NewParams(defaultEpochIdentifier, math.LegacyMustNewDecFromStr(defaultTimeoutFee), math.LegacyMustNewDecFromStr(defaultErrAckFee), defaultBatchMatchLimit, defaultFulfillmentHistoryRetention)
 }
 
 // Validate validates the set of params
@@ -38,6 +44,9 @@ func (p Params) ValidateBasic() error {
 	if err := validateErrAckFee(p.ErrackFee); err != nil {
 		return fmt.Errorf("error acknowledgement fee: %w", err)
 	}
+	if err := validateBatchMatchLimit(p.BatchMatchLimit); err != nil {
+		return fmt.Errorf("batch match limit: %w", err)
+	}
 	return nil
 }
 
@@ -83,3 +92,10 @@ func validateErrAckFee(v math.LegacyDec) error {
 
 	return nil
 }
+
+func validateBatchMatchLimit(v uint64) error {
+	if v > maxBatchMatchLimit {
+		return fmt.Errorf("must not exceed %d: %d", maxBatchMatchLimit, v)
+	}
+	return nil
+}
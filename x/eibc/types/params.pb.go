@@ -30,6 +30,12 @@ type Params struct {
 	EpochIdentifier string                      `protobuf:"bytes,1,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty" yaml:"epoch_identifier"`
 	TimeoutFee      cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=timeout_fee,json=timeoutFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"timeout_fee" yaml:"timeout_fee"`
 	ErrackFee       cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=errack_fee,json=errackFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"errack_fee" yaml:"errack_fee"`
+	// max number of pending orders collected by the end block batch matcher
+	// (0 disables batch matching)
+	BatchMatchLimit uint64 `protobuf:"varint,4,opt,name=batch_match_limit,json=batchMatchLimit,proto3" json:"batch_match_limit,omitempty" yaml:"batch_match_limit"`
+	// number of hub blocks the history of a settled fulfillment is kept for
+	// (0 keeps it forever)
+	FulfillmentHistoryRetention uint64 `protobuf:"varint,5,opt,name=fulfillment_history_retention,json=fulfillmentHistoryRetention,proto3" json:"fulfillment_history_retention,omitempty" yaml:"fulfillment_history_retention"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -71,6 +77,20 @@ func (m *Params) GetEpochIdentifier() string {
 	return ""
 }
 
+func (m *Params) GetBatchMatchLimit() uint64 {
+	if m != nil {
+		return m.BatchMatchLimit
+	}
+	return 0
+}
+
+func (m *Params) GetFulfillmentHistoryRetention() uint64 {
+	if m != nil {
+		return m.FulfillmentHistoryRetention
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.eibc.Params")
 }
@@ -80,28 +100,33 @@ func init() {
 }
 
 var fileDescriptor_fa18b53f607a3f90 = []byte{
-	// 328 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x53, 0x33, 0x93, 0x92,
-	0xf5, 0x0b, 0x12, 0x8b, 0x12, 0x73, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x91,
-	0x55, 0xea, 0xc1, 0x39, 0x7a, 0x20, 0x95, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x75, 0xfa,
-	0x20, 0x16, 0x44, 0x8b, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02, 0xc2,
-	0x81, 0x48, 0x29, 0x6d, 0x66, 0xe2, 0x62, 0x0b, 0x00, 0x1b, 0x2f, 0xe4, 0xc6, 0x25, 0x90, 0x5a,
-	0x90, 0x9f, 0x9c, 0x11, 0x9f, 0x99, 0x92, 0x9a, 0x57, 0x92, 0x99, 0x96, 0x99, 0x5a, 0x24, 0xc1,
-	0xa8, 0xc0, 0xa8, 0xc1, 0xe9, 0x24, 0xfd, 0xe9, 0x9e, 0xbc, 0x78, 0x65, 0x62, 0x6e, 0x8e, 0x95,
-	0x12, 0xba, 0x0a, 0xa5, 0x20, 0x7e, 0xb0, 0x90, 0x27, 0x5c, 0x44, 0x28, 0x8f, 0x8b, 0xbb, 0x24,
-	0x33, 0x37, 0x35, 0xbf, 0xb4, 0x24, 0x3e, 0x2d, 0x35, 0x55, 0x82, 0x09, 0x6c, 0x84, 0xef, 0x89,
-	0x7b, 0xf2, 0x0c, 0xb7, 0xee, 0xc9, 0x4b, 0x43, 0x6c, 0x2f, 0x4e, 0xc9, 0xd6, 0xcb, 0xcc, 0xd7,
-	0xcf, 0x4d, 0x2c, 0xc9, 0xd0, 0xf3, 0x49, 0x4d, 0x4f, 0x4c, 0xae, 0x74, 0x49, 0x4d, 0xfe, 0x74,
-	0x4f, 0x5e, 0x08, 0x62, 0x0b, 0x92, 0x7e, 0xa5, 0x4b, 0x5b, 0x74, 0x05, 0xa0, 0x4e, 0x86, 0xab,
-	0x0c, 0xe2, 0x82, 0xaa, 0x70, 0x4b, 0x4d, 0x15, 0xca, 0xe6, 0xe2, 0x4a, 0x2d, 0x2a, 0x4a, 0x4c,
-	0xce, 0x06, 0x5b, 0xc7, 0x0c, 0xb6, 0xce, 0x87, 0x38, 0xeb, 0x04, 0xa1, 0x9e, 0x82, 0x6b, 0xc7,
-	0x6e, 0x1b, 0x27, 0x44, 0x81, 0x5b, 0x6a, 0xaa, 0x15, 0xcb, 0x8c, 0x05, 0xf2, 0x0c, 0x4e, 0xde,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x65, 0x98, 0x9e, 0x59, 0x92, 0x51,
-	0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x8f, 0x23, 0x4a, 0xcb, 0x8c, 0xf5, 0x2b, 0x20, 0xf1, 0x5a,
-	0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x8e, 0x09, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0xd1, 0xfa, 0x51, 0xb8, 0x03, 0x02, 0x00, 0x00,
+	// 414 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xcf, 0xae, 0x93, 0x40,
+	0x14, 0xc6, 0xc1, 0x5b, 0x6f, 0x72, 0xc7, 0xc5, 0xbd, 0x97, 0x98, 0x88, 0x45, 0xa1, 0x21, 0x2e,
+	0xd8, 0x08, 0x31, 0xdd, 0x75, 0xd9, 0x98, 0xa6, 0xc6, 0x36, 0x31, 0x2c, 0xdd, 0x10, 0x98, 0x1e,
+	0x60, 0x52, 0x86, 0x21, 0x30, 0x35, 0xc5, 0xa7, 0x70, 0xe9, 0xd2, 0x87, 0xf0, 0x21, 0xba, 0x6c,
+	0x5c, 0x19, 0x17, 0xc4, 0xb4, 0x4b, 0x77, 0x3c, 0x81, 0x61, 0xa6, 0x92, 0xc6, 0x7f, 0xb9, 0x9b,
+	0x13, 0xce, 0xf9, 0x7e, 0xe7, 0xfb, 0x80, 0x1c, 0xe4, 0xac, 0x6a, 0x0a, 0x79, 0x45, 0x58, 0xbe,
+	0xad, 0xdf, 0x7b, 0x7d, 0xe3, 0x01, 0x89, 0xb0, 0x57, 0x84, 0x65, 0x48, 0x2b, 0xb7, 0x28, 0x19,
+	0x67, 0x9a, 0x71, 0x4e, 0xba, 0x7d, 0xe3, 0x76, 0xe4, 0xf0, 0x61, 0xc2, 0x12, 0x26, 0x38, 0xaf,
+	0x7b, 0x92, 0x2b, 0xc3, 0xc7, 0x98, 0x55, 0x94, 0x55, 0x81, 0x14, 0x64, 0x23, 0x25, 0xfb, 0xc7,
+	0x05, 0xba, 0x7c, 0x23, 0xec, 0xb5, 0x19, 0xba, 0x81, 0x82, 0xe1, 0x34, 0x20, 0x2b, 0xc8, 0x39,
+	0x89, 0x09, 0x94, 0xba, 0x3a, 0x52, 0x9d, 0xab, 0xa9, 0xd1, 0x36, 0xd6, 0xa3, 0x3a, 0xa4, 0xd9,
+	0xc4, 0xfe, 0x9d, 0xb0, 0xfd, 0x6b, 0x31, 0x7a, 0xd5, 0x4f, 0xb4, 0x1c, 0x3d, 0xe0, 0x84, 0x02,
+	0xdb, 0xf0, 0x20, 0x06, 0xd0, 0xef, 0x09, 0x8b, 0xe5, 0xae, 0xb1, 0x94, 0x6f, 0x8d, 0x65, 0xc8,
+	0xf4, 0x6a, 0xb5, 0x76, 0x09, 0xf3, 0x68, 0xc8, 0x53, 0x77, 0x01, 0x49, 0x88, 0xeb, 0x97, 0x80,
+	0xdb, 0xc6, 0xd2, 0x64, 0xca, 0xd9, 0xbe, 0xfd, 0xe5, 0xf3, 0xf3, 0x9b, 0xd3, 0x2b, 0xf7, 0xa4,
+	0x8f, 0x4e, 0xc4, 0x0c, 0x40, 0x5b, 0x23, 0x04, 0x65, 0x19, 0xe2, 0xb5, 0x88, 0xbb, 0x10, 0x71,
+	0x8b, 0xbb, 0xc5, 0xdd, 0x9e, 0x3e, 0xaa, 0x5f, 0xff, 0x7b, 0xda, 0x95, 0x04, 0xba, 0xb0, 0x39,
+	0xba, 0x8d, 0x42, 0x8e, 0xd3, 0x80, 0x8a, 0x9a, 0x11, 0x4a, 0xb8, 0x3e, 0x18, 0xa9, 0xce, 0x60,
+	0xfa, 0xa4, 0x6d, 0x2c, 0x5d, 0x1a, 0xfe, 0x81, 0xd8, 0xfe, 0xb5, 0x98, 0x2d, 0xbb, 0xb2, 0xe8,
+	0x26, 0x5a, 0x86, 0x9e, 0xc6, 0x9b, 0x2c, 0x26, 0x59, 0x46, 0x21, 0xe7, 0x41, 0x4a, 0x2a, 0xce,
+	0xca, 0x3a, 0x28, 0x81, 0x77, 0x7f, 0x92, 0xe5, 0xfa, 0x7d, 0xe1, 0xea, 0xb4, 0x8d, 0xf5, 0x4c,
+	0xba, 0xfe, 0x17, 0xb7, 0x7d, 0xe3, 0x4c, 0x9f, 0x4b, 0xd9, 0xff, 0xa5, 0x4e, 0x06, 0x1f, 0x3f,
+	0x59, 0xca, 0xf4, 0xf5, 0xee, 0x60, 0xaa, 0xfb, 0x83, 0xa9, 0x7e, 0x3f, 0x98, 0xea, 0x87, 0xa3,
+	0xa9, 0xec, 0x8f, 0xa6, 0xf2, 0xf5, 0x68, 0x2a, 0x6f, 0x5f, 0x24, 0x84, 0xa7, 0x9b, 0xc8, 0xc5,
+	0x8c, 0x7a, 0xff, 0x38, 0xc5, 0x77, 0x63, 0x6f, 0x2b, 0xef, 0x91, 0xd7, 0x05, 0x54, 0xd1, 0xa5,
+	0xb8, 0xa0, 0xf1, 0xcf, 0x01, 0x00, 0xc4, 0xb9, 0x75, 0x34, 0xbb, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +149,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FulfillmentHistoryRetention != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.FulfillmentHistoryRetention))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BatchMatchLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BatchMatchLimit))
+		i--
+		dAtA[i] = 0x20
+	}
 	{
 		size := m.ErrackFee.Size()
 		i -= size
@@ -179,6 +214,12 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.ErrackFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.BatchMatchLimit != 0 {
+		n += 1 + sovParams(uint64(m.BatchMatchLimit))
+	}
+	if m.FulfillmentHistoryRetention != 0 {
+		n += 1 + sovParams(uint64(m.FulfillmentHistoryRetention))
+	}
 	return n
 }
 
@@ -317,6 +358,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchMatchLimit", wireType)
+			}
+			m.BatchMatchLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchMatchLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentHistoryRetention", wireType)
+			}
+			m.FulfillmentHistoryRetention = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FulfillmentHistoryRetention |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -585,6 +585,375 @@ func (m *QueryOnDemandLPsByAddrResponse) GetLps() []*OnDemandLPRecord {
 	return nil
 }
 
+type QueryBatchClearingResultsRequest struct {
+	// optional, filter by rollapp
+	Rollapp string `protobuf:"bytes,1,opt,name=rollapp,proto3" json:"rollapp,omitempty"`
+	// optional, filter by denom, requires rollapp
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *QueryBatchClearingResultsRequest) Reset()         { *m = QueryBatchClearingResultsRequest{} }
+func (m *QueryBatchClearingResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchClearingResultsRequest) ProtoMessage()    {}
+func (*QueryBatchClearingResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{10}
+}
+func (m *QueryBatchClearingResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatchClearingResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchClearingResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBatchClearingResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchClearingResultsRequest.Merge(m, src)
+}
+func (m *QueryBatchClearingResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatchClearingResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchClearingResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatchClearingResultsRequest proto.InternalMessageInfo
+
+func (m *QueryBatchClearingResultsRequest) GetRollapp() string {
+	if m != nil {
+		return m.Rollapp
+	}
+	return ""
+}
+
+func (m *QueryBatchClearingResultsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type QueryBatchClearingResultsResponse struct {
+	Results []BatchClearingResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+}
+
+func (m *QueryBatchClearingResultsResponse) Reset()         { *m = QueryBatchClearingResultsResponse{} }
+func (m *QueryBatchClearingResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBatchClearingResultsResponse) ProtoMessage()    {}
+func (*QueryBatchClearingResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{11}
+}
+func (m *QueryBatchClearingResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBatchClearingResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBatchClearingResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBatchClearingResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBatchClearingResultsResponse.Merge(m, src)
+}
+func (m *QueryBatchClearingResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBatchClearingResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBatchClearingResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBatchClearingResultsResponse proto.InternalMessageInfo
+
+func (m *QueryBatchClearingResultsResponse) GetResults() []BatchClearingResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+type QueryFulfillmentsByFulfillerRequest struct {
+	Fulfiller  string             `protobuf:"bytes,1,opt,name=fulfiller,proto3" json:"fulfiller,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFulfillmentsByFulfillerRequest) Reset()         { *m = QueryFulfillmentsByFulfillerRequest{} }
+func (m *QueryFulfillmentsByFulfillerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillmentsByFulfillerRequest) ProtoMessage()    {}
+func (*QueryFulfillmentsByFulfillerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{12}
+}
+func (m *QueryFulfillmentsByFulfillerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillmentsByFulfillerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillmentsByFulfillerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFulfillmentsByFulfillerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillmentsByFulfillerRequest.Merge(m, src)
+}
+func (m *QueryFulfillmentsByFulfillerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillmentsByFulfillerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillmentsByFulfillerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFulfillmentsByFulfillerRequest proto.InternalMessageInfo
+
+func (m *QueryFulfillmentsByFulfillerRequest) GetFulfiller() string {
+	if m != nil {
+		return m.Fulfiller
+	}
+	return ""
+}
+
+func (m *QueryFulfillmentsByFulfillerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFulfillmentsByLPRequest struct {
+	LpId       uint64             `protobuf:"varint,1,opt,name=lp_id,json=lpId,proto3" json:"lp_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFulfillmentsByLPRequest) Reset()         { *m = QueryFulfillmentsByLPRequest{} }
+func (m *QueryFulfillmentsByLPRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillmentsByLPRequest) ProtoMessage()    {}
+func (*QueryFulfillmentsByLPRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{13}
+}
+func (m *QueryFulfillmentsByLPRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillmentsByLPRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillmentsByLPRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFulfillmentsByLPRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillmentsByLPRequest.Merge(m, src)
+}
+func (m *QueryFulfillmentsByLPRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillmentsByLPRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillmentsByLPRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFulfillmentsByLPRequest proto.InternalMessageInfo
+
+func (m *QueryFulfillmentsByLPRequest) GetLpId() uint64 {
+	if m != nil {
+		return m.LpId
+	}
+	return 0
+}
+
+func (m *QueryFulfillmentsByLPRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryFulfillmentsResponse struct {
+	// totals over all fulfillments, not only the returned page
+	Pnl          FulfillmentPnL      `protobuf:"bytes,1,opt,name=pnl,proto3" json:"pnl"`
+	Fulfillments []Fulfillment       `protobuf:"bytes,2,rep,name=fulfillments,proto3" json:"fulfillments"`
+	Pagination   *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryFulfillmentsResponse) Reset()         { *m = QueryFulfillmentsResponse{} }
+func (m *QueryFulfillmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFulfillmentsResponse) ProtoMessage()    {}
+func (*QueryFulfillmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{14}
+}
+func (m *QueryFulfillmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFulfillmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFulfillmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFulfillmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFulfillmentsResponse.Merge(m, src)
+}
+func (m *QueryFulfillmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFulfillmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFulfillmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFulfillmentsResponse proto.InternalMessageInfo
+
+func (m *QueryFulfillmentsResponse) GetPnl() FulfillmentPnL {
+	if m != nil {
+		return m.Pnl
+	}
+	return FulfillmentPnL{}
+}
+
+func (m *QueryFulfillmentsResponse) GetFulfillments() []Fulfillment {
+	if m != nil {
+		return m.Fulfillments
+	}
+	return nil
+}
+
+func (m *QueryFulfillmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryClaimOrdersRequest struct {
+	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+}
+
+func (m *QueryClaimOrdersRequest) Reset()         { *m = QueryClaimOrdersRequest{} }
+func (m *QueryClaimOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimOrdersRequest) ProtoMessage()    {}
+func (*QueryClaimOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{15}
+}
+func (m *QueryClaimOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimOrdersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimOrdersRequest.Merge(m, src)
+}
+func (m *QueryClaimOrdersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimOrdersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimOrdersRequest proto.InternalMessageInfo
+
+func (m *QueryClaimOrdersRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+type QueryClaimOrdersResponse struct {
+	// holder is the current claim holder.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// sell_order is nil if the claim is not for sale.
+	SellOrder *ClaimSellOrder `protobuf:"bytes,2,opt,name=sell_order,json=sellOrder,proto3" json:"sell_order,omitempty"`
+	BuyOrders []ClaimBuyOrder `protobuf:"bytes,3,rep,name=buy_orders,json=buyOrders,proto3" json:"buy_orders"`
+}
+
+func (m *QueryClaimOrdersResponse) Reset()         { *m = QueryClaimOrdersResponse{} }
+func (m *QueryClaimOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimOrdersResponse) ProtoMessage()    {}
+func (*QueryClaimOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d85bfe71ceb5f8dc, []int{16}
+}
+func (m *QueryClaimOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimOrdersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimOrdersResponse.Merge(m, src)
+}
+func (m *QueryClaimOrdersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimOrdersResponse proto.InternalMessageInfo
+
+func (m *QueryClaimOrdersResponse) GetHolder() string {
+	if m != nil {
+		return m.Holder
+	}
+	return ""
+}
+
+func (m *QueryClaimOrdersResponse) GetSellOrder() *ClaimSellOrder {
+	if m != nil {
+		return m.SellOrder
+	}
+	return nil
+}
+
+func (m *QueryClaimOrdersResponse) GetBuyOrders() []ClaimBuyOrder {
+	if m != nil {
+		return m.BuyOrders
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterEnum("dymensionxyz.dymension.eibc.FulfillmentState", FulfillmentState_name, FulfillmentState_value)
 	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.eibc.QueryParamsRequest")
@@ -597,6 +966,13 @@ func init() {
 	proto.RegisterType((*QueryOnDemandLPsResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsResponse")
 	proto.RegisterType((*QueryOnDemandLPsByAddrRequest)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsByAddrRequest")
 	proto.RegisterType((*QueryOnDemandLPsByAddrResponse)(nil), "dymensionxyz.dymension.eibc.QueryOnDemandLPsByAddrResponse")
+	proto.RegisterType((*QueryBatchClearingResultsRequest)(nil), "dymensionxyz.dymension.eibc.QueryBatchClearingResultsRequest")
+	proto.RegisterType((*QueryBatchClearingResultsResponse)(nil), "dymensionxyz.dymension.eibc.QueryBatchClearingResultsResponse")
+	proto.RegisterType((*QueryFulfillmentsByFulfillerRequest)(nil), "dymensionxyz.dymension.eibc.QueryFulfillmentsByFulfillerRequest")
+	proto.RegisterType((*QueryFulfillmentsByLPRequest)(nil), "dymensionxyz.dymension.eibc.QueryFulfillmentsByLPRequest")
+	proto.RegisterType((*QueryFulfillmentsResponse)(nil), "dymensionxyz.dymension.eibc.QueryFulfillmentsResponse")
+	proto.RegisterType((*QueryClaimOrdersRequest)(nil), "dymensionxyz.dymension.eibc.QueryClaimOrdersRequest")
+	proto.RegisterType((*QueryClaimOrdersResponse)(nil), "dymensionxyz.dymension.eibc.QueryClaimOrdersResponse")
 }
 
 func init() {
@@ -604,65 +980,90 @@ func init() {
 }
 
 var fileDescriptor_d85bfe71ceb5f8dc = []byte{
-	// 928 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x5d, 0x6f, 0x1b, 0x45,
-	0x14, 0xf5, 0xda, 0x8e, 0x8b, 0x6f, 0xfa, 0x61, 0xa6, 0x91, 0x58, 0xb9, 0xad, 0x09, 0x5b, 0x4a,
-	0xa3, 0x16, 0x76, 0x12, 0x87, 0x96, 0x88, 0xaa, 0x45, 0xb1, 0x12, 0x57, 0x51, 0x43, 0x1a, 0x16,
-	0x22, 0xa1, 0xf2, 0x60, 0xad, 0x3d, 0x13, 0x33, 0xb0, 0xbb, 0xb3, 0xdd, 0x5d, 0x57, 0x35, 0x51,
-	0x5e, 0xf8, 0x05, 0x48, 0xbc, 0xf0, 0x43, 0x78, 0x03, 0xf1, 0xdc, 0x27, 0x54, 0xd1, 0x17, 0x9e,
-	0x10, 0x4a, 0xf8, 0x21, 0x68, 0x3e, 0x6c, 0xaf, 0xf3, 0xb1, 0x8e, 0xab, 0xbe, 0x44, 0x3b, 0xd7,
-	0xe7, 0xcc, 0x3d, 0xe7, 0xce, 0xbd, 0x33, 0x81, 0x9b, 0xa4, 0xef, 0xd3, 0x20, 0x66, 0x3c, 0x78,
-	0xde, 0xff, 0x01, 0x0f, 0x17, 0x98, 0xb2, 0x76, 0x07, 0x3f, 0xed, 0xd1, 0xa8, 0x6f, 0x87, 0x11,
-	0x4f, 0x38, 0xba, 0x92, 0x06, 0xda, 0xc3, 0x85, 0x2d, 0x80, 0xd5, 0xb9, 0x2e, 0xef, 0x72, 0x89,
-	0xc3, 0xe2, 0x4b, 0x51, 0xaa, 0x57, 0xbb, 0x9c, 0x77, 0x3d, 0x8a, 0xdd, 0x90, 0x61, 0x37, 0x08,
-	0x78, 0xe2, 0x26, 0x8c, 0x07, 0xb1, 0xfe, 0xf5, 0x56, 0x87, 0xc7, 0x3e, 0x8f, 0x71, 0xdb, 0x8d,
-	0xa9, 0xca, 0x84, 0x9f, 0x2d, 0xb5, 0x69, 0xe2, 0x2e, 0xe1, 0xd0, 0xed, 0xb2, 0x40, 0x82, 0x35,
-	0x76, 0x21, 0x4b, 0x65, 0xe8, 0x46, 0xae, 0x3f, 0xdc, 0xf5, 0x14, 0x64, 0x87, 0xfb, 0x3e, 0x0f,
-	0x70, 0x9c, 0xb8, 0x49, 0x6f, 0x80, 0xad, 0x67, 0x63, 0x23, 0xee, 0x79, 0x6e, 0x18, 0xb6, 0x42,
-	0xb7, 0xf3, 0x3d, 0x4d, 0x34, 0xc7, 0xce, 0x52, 0x42, 0xa8, 0xef, 0x06, 0xa4, 0xc5, 0x23, 0x42,
-	0x23, 0x8d, 0x7f, 0x3f, 0x0b, 0xef, 0x85, 0x0a, 0x65, 0xcd, 0x01, 0xfa, 0x42, 0x54, 0x60, 0x5b,
-	0x5a, 0x71, 0xe8, 0xd3, 0x1e, 0x8d, 0x13, 0xeb, 0x6b, 0xb8, 0x3c, 0x16, 0x8d, 0x43, 0x1e, 0xc4,
-	0x14, 0xad, 0x42, 0x49, 0x59, 0x36, 0x8d, 0x79, 0x63, 0x61, 0xb6, 0x7e, 0xdd, 0xce, 0x38, 0x1a,
-	0x5b, 0x91, 0x1b, 0xc5, 0x17, 0xff, 0xbc, 0x9b, 0x73, 0x34, 0xd1, 0xfa, 0x10, 0xaa, 0x72, 0xe7,
-	0x87, 0x34, 0x59, 0x93, 0x9a, 0x1f, 0x0b, 0xc9, 0x3a, 0x2f, 0xba, 0x08, 0x79, 0x46, 0xe4, 0xe6,
-	0x65, 0x27, 0xcf, 0x88, 0xf5, 0xaa, 0x00, 0xf3, 0x12, 0x9e, 0xc2, 0xc6, 0x8d, 0xfe, 0x97, 0xb2,
-	0x96, 0x03, 0xd2, 0x7d, 0x28, 0xa9, 0xe2, 0x4a, 0xe2, 0xc5, 0xfa, 0x8d, 0xd3, 0x54, 0xa9, 0xea,
-	0xda, 0x9a, 0xad, 0x49, 0x68, 0x1d, 0x8a, 0x49, 0x3f, 0xa4, 0x66, 0x5e, 0x92, 0x97, 0x26, 0x90,
-	0x1d, 0x75, 0x34, 0xdb, 0xea, 0x64, 0xbe, 0xea, 0x87, 0xd4, 0x91, 0x74, 0x74, 0x0d, 0x60, 0x70,
-	0x6c, 0x8c, 0x98, 0x05, 0x69, 0xa1, 0xac, 0x23, 0x1b, 0x04, 0xcd, 0xc1, 0x8c, 0xc7, 0x7c, 0x96,
-	0x98, 0xc5, 0x79, 0x63, 0x61, 0xc6, 0x51, 0x0b, 0xf4, 0x04, 0xde, 0xde, 0xed, 0x79, 0xbb, 0xcc,
-	0xf3, 0x7c, 0x1a, 0x24, 0x2d, 0xa1, 0x88, 0x9a, 0x33, 0x52, 0xc8, 0x47, 0x99, 0xb5, 0x6d, 0x8e,
-	0x58, 0xc2, 0x0e, 0x75, 0x2a, 0xbb, 0x47, 0x22, 0xe8, 0x2a, 0x94, 0x75, 0x8c, 0x46, 0x66, 0x49,
-	0xe9, 0x19, 0x06, 0x84, 0x1e, 0x42, 0x03, 0xee, 0x9b, 0xe7, 0xe4, 0x2f, 0x6a, 0x21, 0x38, 0x11,
-	0xed, 0xb0, 0x90, 0xd1, 0x20, 0x31, 0xdf, 0xd2, 0x1e, 0x06, 0x01, 0xd4, 0x04, 0x18, 0xcd, 0x87,
-	0x59, 0x96, 0x2d, 0xf0, 0x81, 0xad, 0x86, 0xc9, 0x16, 0xc3, 0x64, 0xab, 0xb1, 0xd5, 0xc3, 0x64,
-	0x6f, 0xbb, 0x5d, 0xaa, 0x0f, 0xc9, 0x49, 0x31, 0xad, 0xef, 0xe0, 0xca, 0x89, 0x3d, 0xa0, 0xbb,
-	0xec, 0x11, 0x9c, 0x4f, 0xb7, 0xb3, 0xee, 0xb5, 0x85, 0xcc, 0x7a, 0xa4, 0xf7, 0x99, 0x25, 0xa3,
-	0x85, 0xf5, 0x9b, 0x01, 0xef, 0x65, 0x74, 0x90, 0x4e, 0xf9, 0x39, 0x5c, 0x48, 0xa7, 0x14, 0x9d,
-	0x54, 0x98, 0x2a, 0xe7, 0xf9, 0x54, 0xce, 0x18, 0x3d, 0x1c, 0x2b, 0x54, 0x5e, 0xea, 0xbf, 0x39,
-	0xb1, 0x50, 0x4a, 0xcb, 0x58, 0xa5, 0x6e, 0xc3, 0x3b, 0x52, 0xfc, 0xe3, 0x40, 0x25, 0xdb, 0xdc,
-	0x1e, 0x76, 0x7d, 0x05, 0x0a, 0x8c, 0x28, 0xa1, 0x45, 0x47, 0x7c, 0x5a, 0xdf, 0x80, 0x79, 0x1c,
-	0xac, 0x0d, 0x7e, 0x06, 0x05, 0x2f, 0x1c, 0xd8, 0xca, 0x6e, 0xad, 0x11, 0xdd, 0xa1, 0x1d, 0x1e,
-	0x11, 0x47, 0x30, 0xad, 0x65, 0xb8, 0x76, 0x74, 0xf3, 0x46, 0x7f, 0x95, 0x90, 0xe1, 0xe8, 0x22,
-	0x28, 0xba, 0x84, 0x44, 0x7a, 0x78, 0xe5, 0xb7, 0xe5, 0x42, 0xed, 0x34, 0xd2, 0x1b, 0xd2, 0x75,
-	0x6b, 0x15, 0x2a, 0x47, 0x67, 0x01, 0x5d, 0x80, 0xf2, 0xce, 0xd6, 0xda, 0x7a, 0x73, 0x63, 0x6b,
-	0x7d, 0xad, 0x92, 0x13, 0xcb, 0xe6, 0xce, 0x66, 0x73, 0x63, 0x73, 0x73, 0x7d, 0xad, 0x62, 0xa0,
-	0x4b, 0x30, 0xbb, 0xb3, 0x35, 0x0a, 0xe4, 0xeb, 0x7f, 0x9c, 0x83, 0x19, 0x29, 0x13, 0xfd, 0x62,
-	0x40, 0x49, 0xdd, 0x5a, 0x08, 0x67, 0x6a, 0x39, 0x7e, 0x65, 0x56, 0x17, 0xcf, 0x4e, 0x50, 0xde,
-	0xad, 0xdb, 0x3f, 0xbe, 0xfa, 0xef, 0xe7, 0xfc, 0x0d, 0x74, 0x1d, 0x4f, 0x7e, 0x63, 0xd0, 0xef,
-	0x06, 0x5c, 0x4a, 0x35, 0x5c, 0xa3, 0xbf, 0x41, 0xd0, 0x27, 0x93, 0x53, 0x9e, 0x78, 0xcd, 0x56,
-	0x57, 0xa6, 0x27, 0x6a, 0xcd, 0x77, 0xa5, 0xe6, 0x45, 0x64, 0xe3, 0xb3, 0xbe, 0x46, 0x78, 0x8f,
-	0x91, 0x7d, 0xf4, 0x97, 0x01, 0x73, 0x27, 0x4d, 0x20, 0xba, 0x3f, 0x59, 0x4a, 0xc6, 0xdd, 0x5f,
-	0x7d, 0xf0, 0xba, 0x74, 0xed, 0xe7, 0x9e, 0xf4, 0x73, 0x07, 0x2d, 0x9f, 0xd9, 0x4f, 0x8c, 0xf7,
-	0xd4, 0xc3, 0xb1, 0x8f, 0x7e, 0x35, 0x60, 0x36, 0xd5, 0xda, 0xe8, 0xe3, 0xc9, 0x62, 0x8e, 0x0f,
-	0x72, 0xf5, 0xce, 0x94, 0x2c, 0xad, 0x7c, 0x45, 0x2a, 0xaf, 0xa3, 0xc5, 0x4c, 0xe5, 0x3c, 0x68,
-	0x69, 0xf1, 0x5e, 0x18, 0x8b, 0xa3, 0x88, 0xf7, 0xd1, 0x9f, 0x06, 0x5c, 0x1e, 0x9b, 0x48, 0x35,
-	0x93, 0xe8, 0xd3, 0xa9, 0x84, 0x8c, 0x4d, 0x7f, 0xf5, 0xde, 0x6b, 0x71, 0xb5, 0x95, 0x07, 0xd2,
-	0xca, 0x0a, 0xba, 0x7b, 0x76, 0x2b, 0x2d, 0x71, 0xbf, 0xe0, 0x3d, 0xf1, 0x77, 0xbf, 0xf1, 0xe8,
-	0xc5, 0x41, 0xcd, 0x78, 0x79, 0x50, 0x33, 0xfe, 0x3d, 0xa8, 0x19, 0x3f, 0x1d, 0xd6, 0x72, 0x2f,
-	0x0f, 0x6b, 0xb9, 0xbf, 0x0f, 0x6b, 0xb9, 0x27, 0x4b, 0x5d, 0x96, 0x7c, 0xdb, 0x6b, 0x8b, 0xc7,
-	0xfb, 0xb4, 0xbd, 0x9f, 0x2d, 0xe3, 0xe7, 0x2a, 0x81, 0x78, 0xc6, 0xe3, 0x76, 0x49, 0xfe, 0x5f,
-	0xb4, 0xfc, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc4, 0x9e, 0x50, 0x94, 0x9f, 0x0a, 0x00, 0x00,
+	// 1321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4b, 0x73, 0xdb, 0xd4,
+	0x17, 0x8f, 0x6c, 0xc7, 0xa9, 0x4f, 0xfa, 0xf0, 0xff, 0x36, 0xf3, 0xaf, 0xaa, 0xb6, 0xa6, 0x55,
+	0x29, 0x0d, 0x2d, 0x48, 0x79, 0x34, 0x69, 0xa1, 0xb4, 0x50, 0x37, 0x71, 0x27, 0xd4, 0x24, 0x46,
+	0x25, 0x33, 0x4c, 0x59, 0x78, 0x64, 0xeb, 0xc6, 0x11, 0xc8, 0x92, 0x2a, 0xc9, 0x9d, 0x9a, 0x8c,
+	0x37, 0x6c, 0xd9, 0x30, 0xc3, 0x86, 0x0f, 0xc2, 0x82, 0x19, 0xd8, 0xb1, 0xe9, 0x0a, 0x3a, 0x74,
+	0xc3, 0x8a, 0x61, 0x12, 0x36, 0x7c, 0x08, 0x66, 0x98, 0xfb, 0x90, 0x2c, 0x3f, 0x22, 0xdb, 0xa1,
+	0x9b, 0x8c, 0xee, 0xf5, 0xf9, 0x9d, 0xfb, 0x3b, 0xe7, 0x9e, 0xd7, 0x0d, 0x5c, 0x35, 0xda, 0x4d,
+	0x6c, 0xfb, 0xa6, 0x63, 0x3f, 0x6b, 0x7f, 0xa9, 0x46, 0x0b, 0x15, 0x9b, 0xb5, 0xba, 0xfa, 0xa4,
+	0x85, 0xbd, 0xb6, 0xe2, 0x7a, 0x4e, 0xe0, 0xa0, 0x73, 0x71, 0x41, 0x25, 0x5a, 0x28, 0x44, 0x50,
+	0x9a, 0x6b, 0x38, 0x0d, 0x87, 0xca, 0xa9, 0xe4, 0x8b, 0x41, 0xa4, 0xf3, 0x0d, 0xc7, 0x69, 0x58,
+	0x58, 0xd5, 0x5d, 0x53, 0xd5, 0x6d, 0xdb, 0x09, 0xf4, 0xc0, 0x74, 0x6c, 0x9f, 0xff, 0x7a, 0xad,
+	0xee, 0xf8, 0x4d, 0xc7, 0x57, 0x6b, 0xba, 0x8f, 0xd9, 0x49, 0xea, 0xd3, 0xc5, 0x1a, 0x0e, 0xf4,
+	0x45, 0xd5, 0xd5, 0x1b, 0xa6, 0x4d, 0x85, 0xb9, 0xec, 0x7c, 0x12, 0x4b, 0x57, 0xf7, 0xf4, 0x66,
+	0xa4, 0xf5, 0x10, 0xc9, 0xba, 0xd3, 0x6c, 0x3a, 0xb6, 0xea, 0x07, 0x7a, 0xd0, 0x0a, 0x65, 0x97,
+	0x92, 0x65, 0x3d, 0xc7, 0xb2, 0x74, 0xd7, 0xad, 0xba, 0x7a, 0xfd, 0x0b, 0x1c, 0x70, 0x8c, 0x92,
+	0xc4, 0xc4, 0xc0, 0x4d, 0xdd, 0x36, 0xaa, 0x8e, 0x67, 0x60, 0x8f, 0xcb, 0xbf, 0x9e, 0x24, 0x6f,
+	0xb9, 0x5c, 0xea, 0xcd, 0x24, 0xa9, 0x5d, 0xd3, 0x0f, 0x9c, 0xf0, 0x1e, 0xa4, 0xc4, 0x0b, 0xab,
+	0x5b, 0xba, 0xd9, 0x64, 0x82, 0xf2, 0x1c, 0xa0, 0x8f, 0x89, 0x57, 0x2b, 0xd4, 0x3d, 0x1a, 0x7e,
+	0xd2, 0xc2, 0x7e, 0x20, 0x7f, 0x0a, 0xa7, 0x7b, 0x76, 0x7d, 0xd7, 0xb1, 0x7d, 0x8c, 0xee, 0x41,
+	0x96, 0xb9, 0x51, 0x14, 0x2e, 0x0a, 0xf3, 0xb3, 0x4b, 0x97, 0x95, 0x84, 0xeb, 0x56, 0x18, 0xb8,
+	0x98, 0x79, 0xfe, 0xc7, 0x6b, 0x53, 0x1a, 0x07, 0xca, 0x6f, 0x81, 0x44, 0x35, 0x3f, 0xc0, 0xc1,
+	0x1a, 0xf5, 0xc3, 0x16, 0x71, 0x03, 0x3f, 0x17, 0x9d, 0x84, 0x94, 0x69, 0x50, 0xe5, 0x39, 0x2d,
+	0x65, 0x1a, 0xf2, 0xcb, 0x34, 0x5c, 0xa4, 0xe2, 0x31, 0x59, 0xbf, 0xd8, 0x7e, 0x44, 0xef, 0x27,
+	0x04, 0xdd, 0x81, 0x2c, 0xbb, 0x30, 0x0a, 0x3c, 0xb9, 0x74, 0xe5, 0x30, 0x56, 0xec, 0xc6, 0x14,
+	0x8e, 0xe6, 0x20, 0xb4, 0x0e, 0x99, 0xa0, 0xed, 0x62, 0x31, 0x45, 0xc1, 0x8b, 0x23, 0xc0, 0x1a,
+	0xbb, 0xee, 0x0a, 0xbb, 0xed, 0x4f, 0xda, 0x2e, 0xd6, 0x28, 0x1c, 0x5d, 0x00, 0x08, 0x43, 0xc1,
+	0x34, 0xc4, 0x34, 0x35, 0x21, 0xc7, 0x77, 0x36, 0x0c, 0x34, 0x07, 0xd3, 0x96, 0xd9, 0x34, 0x03,
+	0x31, 0x73, 0x51, 0x98, 0x9f, 0xd6, 0xd8, 0x02, 0x3d, 0x86, 0xff, 0xed, 0xb4, 0xac, 0x1d, 0xd3,
+	0xb2, 0x9a, 0xd8, 0x0e, 0xaa, 0x84, 0x11, 0x16, 0xa7, 0x29, 0x91, 0xb7, 0x13, 0x7d, 0x5b, 0xea,
+	0xa2, 0x88, 0x39, 0x58, 0xcb, 0xef, 0xf4, 0xed, 0xa0, 0xf3, 0x90, 0xe3, 0x7b, 0xd8, 0x13, 0xb3,
+	0x8c, 0x4f, 0xb4, 0x41, 0xf8, 0x18, 0xd8, 0x76, 0x9a, 0xe2, 0x0c, 0xfd, 0x85, 0x2d, 0x08, 0xc6,
+	0xc3, 0x75, 0xd3, 0x35, 0xb1, 0x1d, 0x88, 0xc7, 0xb8, 0x0d, 0xe1, 0x06, 0x2a, 0x01, 0x74, 0x73,
+	0x4e, 0xcc, 0xd1, 0x10, 0x78, 0x43, 0x61, 0x09, 0xaa, 0x90, 0x04, 0x55, 0x58, 0x29, 0xe0, 0x09,
+	0xaa, 0x54, 0xf4, 0x06, 0xe6, 0x97, 0xa4, 0xc5, 0x90, 0xf2, 0xe7, 0x70, 0x6e, 0x68, 0x0c, 0xf0,
+	0x28, 0x7b, 0x08, 0xc7, 0xe3, 0x29, 0xc2, 0x63, 0x6d, 0x3e, 0xd1, 0x1f, 0x71, 0x3d, 0xb3, 0x46,
+	0x77, 0x21, 0xff, 0x28, 0xc0, 0xa5, 0x84, 0x08, 0xe2, 0x47, 0x7e, 0x04, 0x27, 0xe2, 0x47, 0x92,
+	0x48, 0x4a, 0x4f, 0x74, 0xe6, 0xf1, 0xd8, 0x99, 0x3e, 0x7a, 0xd0, 0xe3, 0xa8, 0x14, 0xe5, 0x7f,
+	0x75, 0xa4, 0xa3, 0x18, 0x97, 0x1e, 0x4f, 0x5d, 0x87, 0x33, 0x94, 0xfc, 0x96, 0xcd, 0x0e, 0x2b,
+	0x57, 0xa2, 0xa8, 0xcf, 0x43, 0xda, 0x34, 0x18, 0xd1, 0x8c, 0x46, 0x3e, 0xe5, 0xcf, 0x40, 0x1c,
+	0x14, 0xe6, 0x06, 0xbe, 0x0f, 0x69, 0xcb, 0x0d, 0xcd, 0x4a, 0x0e, 0xad, 0x2e, 0x5c, 0xc3, 0x75,
+	0xc7, 0x33, 0x34, 0x82, 0x94, 0x97, 0xe1, 0x42, 0xbf, 0xf2, 0x62, 0xfb, 0x9e, 0x61, 0x44, 0xa9,
+	0x8b, 0x20, 0xa3, 0x1b, 0x86, 0xc7, 0x93, 0x97, 0x7e, 0xcb, 0x3a, 0x14, 0x0e, 0x03, 0xbd, 0x2a,
+	0x5e, 0x1a, 0x2f, 0x10, 0x45, 0x3d, 0xa8, 0xef, 0xde, 0xb7, 0xb0, 0xee, 0x99, 0x76, 0x43, 0xc3,
+	0x7e, 0xcb, 0x0a, 0x22, 0x57, 0x89, 0x30, 0xc3, 0x13, 0x91, 0xb3, 0x0b, 0x97, 0xdd, 0x2c, 0x48,
+	0xc5, 0xb2, 0x40, 0x6e, 0xc1, 0xa5, 0x04, 0x9d, 0x9c, 0x79, 0x05, 0x66, 0x3c, 0xb6, 0xc5, 0xd9,
+	0x2f, 0x24, 0xb2, 0x1f, 0xa2, 0x8b, 0x57, 0xc6, 0x50, 0x8d, 0xfc, 0xb5, 0x00, 0x97, 0xe9, 0xb9,
+	0xb1, 0xe4, 0xf6, 0x8b, 0xe1, 0xaa, 0x5b, 0x24, 0x7b, 0x12, 0x5b, 0xe8, 0x4f, 0xec, 0xd2, 0x90,
+	0xd8, 0x3b, 0x4a, 0x92, 0xee, 0xc1, 0xf9, 0x21, 0x64, 0xca, 0x15, 0x2e, 0x8b, 0x4e, 0xc3, 0xb4,
+	0x45, 0x4b, 0x1d, 0x61, 0x90, 0xd1, 0x32, 0x16, 0xa9, 0x72, 0xaf, 0xea, 0xf0, 0x7f, 0x04, 0x38,
+	0x3b, 0x70, 0x7a, 0xe4, 0xfa, 0xfb, 0x90, 0x76, 0x6d, 0x8b, 0xd7, 0x85, 0xeb, 0xe3, 0xd6, 0xc9,
+	0x8a, 0x5d, 0xe6, 0x1e, 0x27, 0x68, 0xa4, 0xc1, 0xf1, 0x58, 0xc9, 0xf4, 0xc5, 0xd4, 0x18, 0x19,
+	0x1f, 0xd3, 0xc6, 0x55, 0xf5, 0xe8, 0xe8, 0xcb, 0xfb, 0xf4, 0xd1, 0xf3, 0xfe, 0x06, 0xcf, 0xfb,
+	0xfb, 0xa4, 0x53, 0xb3, 0xa2, 0x12, 0xfa, 0xfd, 0x2c, 0x1c, 0xa3, 0x35, 0xaa, 0x1a, 0x35, 0xca,
+	0x19, 0xba, 0xde, 0x30, 0xe4, 0x5f, 0x05, 0x10, 0x07, 0x61, 0xdc, 0x69, 0xff, 0x87, 0xec, 0xae,
+	0x63, 0x19, 0x51, 0xc8, 0xf0, 0x15, 0xfa, 0x10, 0xc0, 0xc7, 0x96, 0xc5, 0x6b, 0x6d, 0x6a, 0x0c,
+	0x9f, 0x52, 0xed, 0x8f, 0xb0, 0x65, 0xb1, 0xd2, 0x97, 0xf3, 0xc3, 0x4f, 0xb4, 0x05, 0x50, 0x6b,
+	0xb5, 0xc3, 0x1a, 0x9a, 0xa6, 0x1e, 0xbd, 0x36, 0x5a, 0x57, 0xb1, 0xd5, 0xa6, 0x78, 0xee, 0xd3,
+	0x5c, 0x8d, 0xaf, 0xfd, 0x6b, 0xf7, 0x20, 0xdf, 0xdf, 0xe9, 0xd0, 0x09, 0xc8, 0x6d, 0x6f, 0xae,
+	0xad, 0x97, 0x36, 0x36, 0xd7, 0xd7, 0xf2, 0x53, 0x64, 0x59, 0xda, 0x2e, 0x97, 0x36, 0xca, 0xe5,
+	0xf5, 0xb5, 0xbc, 0x80, 0x4e, 0xc1, 0xec, 0xf6, 0x66, 0x77, 0x23, 0xb5, 0xf4, 0xf7, 0x09, 0x98,
+	0xa6, 0x4e, 0x41, 0xdf, 0x09, 0x90, 0x65, 0x33, 0x09, 0x52, 0x13, 0x49, 0x0d, 0x0e, 0x44, 0xd2,
+	0xc2, 0xf8, 0x00, 0xe6, 0x6f, 0xf9, 0xfa, 0x57, 0x2f, 0xff, 0xfa, 0x36, 0x75, 0x05, 0x5d, 0x56,
+	0x47, 0x4f, 0xa5, 0xe8, 0x27, 0x01, 0x4e, 0xc5, 0xda, 0x49, 0xb1, 0xbd, 0x61, 0xa0, 0x9b, 0xa3,
+	0x8f, 0x1c, 0x3a, 0x44, 0x49, 0xb7, 0x26, 0x07, 0x72, 0xce, 0xab, 0x94, 0xf3, 0x02, 0x52, 0xd4,
+	0x71, 0xe7, 0x57, 0x75, 0xcf, 0x34, 0x3a, 0xe8, 0x37, 0x01, 0xe6, 0x86, 0xf5, 0x57, 0x74, 0x67,
+	0x34, 0x95, 0x84, 0xc9, 0x4e, 0xba, 0x7b, 0x54, 0x38, 0xb7, 0xe7, 0x36, 0xb5, 0x67, 0x05, 0x2d,
+	0x8f, 0x6d, 0x8f, 0xaf, 0xee, 0xb1, 0xb1, 0xb0, 0x83, 0xbe, 0x17, 0x60, 0x36, 0xd6, 0xb8, 0xd0,
+	0x8d, 0xd1, 0x64, 0x06, 0xdb, 0xb4, 0xb4, 0x32, 0x21, 0x8a, 0x33, 0xbf, 0x45, 0x99, 0x2f, 0xa1,
+	0x85, 0x44, 0xe6, 0x8e, 0x5d, 0xe5, 0xe4, 0x2d, 0xd7, 0x27, 0x57, 0xe1, 0x77, 0xd0, 0x2f, 0x02,
+	0x9c, 0xee, 0xe9, 0xb7, 0xac, 0xe3, 0xa2, 0x77, 0x27, 0x22, 0xd2, 0xd3, 0xdb, 0xa5, 0xdb, 0x47,
+	0xc2, 0x72, 0x53, 0xee, 0x52, 0x53, 0x6e, 0xa1, 0xd5, 0xf1, 0x4d, 0xa9, 0x92, 0xe9, 0x41, 0xdd,
+	0x23, 0x7f, 0x59, 0x70, 0x0d, 0xeb, 0xc4, 0xe3, 0x04, 0x57, 0xc2, 0x54, 0x20, 0xdd, 0x3d, 0x2a,
+	0x7c, 0xa2, 0xe0, 0xaa, 0x11, 0x15, 0xd5, 0x3a, 0xd7, 0x51, 0xe5, 0xbd, 0x9e, 0x18, 0x75, 0xe6,
+	0x90, 0x36, 0x8f, 0x3e, 0x18, 0x4d, 0x2c, 0x79, 0x42, 0x90, 0x56, 0x27, 0xd3, 0x10, 0x99, 0x74,
+	0x87, 0x9a, 0x74, 0x13, 0xad, 0x24, 0x9a, 0x14, 0x6f, 0x79, 0xea, 0x5e, 0x34, 0x79, 0x74, 0xd0,
+	0xcf, 0x02, 0xe4, 0xfb, 0xc7, 0x05, 0xf4, 0xce, 0xa4, 0xd6, 0x94, 0x2b, 0xff, 0xd5, 0x8c, 0xf7,
+	0xa8, 0x19, 0xab, 0xe8, 0x86, 0x9a, 0xfc, 0xac, 0xae, 0xf6, 0x5a, 0x42, 0xc7, 0x99, 0x0e, 0xfa,
+	0x41, 0x80, 0xd9, 0x58, 0x03, 0x1d, 0x27, 0xef, 0x07, 0xdb, 0xb4, 0xb4, 0x32, 0x21, 0x6a, 0x22,
+	0xea, 0xf4, 0x01, 0x1f, 0x15, 0xac, 0x70, 0x1c, 0xe8, 0x14, 0x1f, 0x3e, 0xdf, 0x2f, 0x08, 0x2f,
+	0xf6, 0x0b, 0xc2, 0x9f, 0xfb, 0x05, 0xe1, 0x9b, 0x83, 0xc2, 0xd4, 0x8b, 0x83, 0xc2, 0xd4, 0xef,
+	0x07, 0x85, 0xa9, 0xc7, 0x8b, 0x0d, 0x33, 0xd8, 0x6d, 0xd5, 0xc8, 0x2b, 0xf6, 0x30, 0xcd, 0x4f,
+	0x97, 0xd5, 0x67, 0x4c, 0x3d, 0x79, 0xcf, 0xfa, 0xb5, 0x2c, 0xfd, 0x07, 0xc1, 0xf2, 0xbf, 0x03,
+	0x00, 0x1d, 0xde, 0x75, 0x02, 0xfc, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -685,6 +1086,14 @@ type QueryClient interface {
 	DemandOrdersByStatus(ctx context.Context, in *QueryDemandOrdersByStatusRequest, opts ...grpc.CallOption) (*QueryDemandOrdersByStatusResponse, error)
 	OnDemandLPs(ctx context.Context, in *QueryOnDemandLPsRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsResponse, error)
 	OnDemandLPsByByAddr(ctx context.Context, in *QueryOnDemandLPsByAddrRequest, opts ...grpc.CallOption) (*QueryOnDemandLPsByAddrResponse, error)
+	// Queries the latest batch auction clearing results.
+	BatchClearingResults(ctx context.Context, in *QueryBatchClearingResultsRequest, opts ...grpc.CallOption) (*QueryBatchClearingResultsResponse, error)
+	// Queries the fulfillment history and profit and loss of a fulfiller.
+	FulfillmentsByFulfiller(ctx context.Context, in *QueryFulfillmentsByFulfillerRequest, opts ...grpc.CallOption) (*QueryFulfillmentsResponse, error)
+	// Queries the fulfillment history and profit and loss of an on demand lp.
+	FulfillmentsByLP(ctx context.Context, in *QueryFulfillmentsByLPRequest, opts ...grpc.CallOption) (*QueryFulfillmentsResponse, error)
+	// Queries the sell order and buy orders for the claim on a fulfilled order.
+	ClaimOrders(ctx context.Context, in *QueryClaimOrdersRequest, opts ...grpc.CallOption) (*QueryClaimOrdersResponse, error)
 }
 
 type queryClient struct {
@@ -740,6 +1149,42 @@ func (c *queryClient) OnDemandLPsByByAddr(ctx context.Context, in *QueryOnDemand
 	return out, nil
 }
 
+func (c *queryClient) BatchClearingResults(ctx context.Context, in *QueryBatchClearingResultsRequest, opts ...grpc.CallOption) (*QueryBatchClearingResultsResponse, error) {
+	out := new(QueryBatchClearingResultsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/BatchClearingResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FulfillmentsByFulfiller(ctx context.Context, in *QueryFulfillmentsByFulfillerRequest, opts ...grpc.CallOption) (*QueryFulfillmentsResponse, error) {
+	out := new(QueryFulfillmentsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/FulfillmentsByFulfiller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FulfillmentsByLP(ctx context.Context, in *QueryFulfillmentsByLPRequest, opts ...grpc.CallOption) (*QueryFulfillmentsResponse, error) {
+	out := new(QueryFulfillmentsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/FulfillmentsByLP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimOrders(ctx context.Context, in *QueryClaimOrdersRequest, opts ...grpc.CallOption) (*QueryClaimOrdersResponse, error) {
+	out := new(QueryClaimOrdersResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Query/ClaimOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
@@ -750,6 +1195,14 @@ type QueryServer interface {
 	DemandOrdersByStatus(context.Context, *QueryDemandOrdersByStatusRequest) (*QueryDemandOrdersByStatusResponse, error)
 	OnDemandLPs(context.Context, *QueryOnDemandLPsRequest) (*QueryOnDemandLPsResponse, error)
 	OnDemandLPsByByAddr(context.Context, *QueryOnDemandLPsByAddrRequest) (*QueryOnDemandLPsByAddrResponse, error)
+	// Queries the latest batch auction clearing results.
+	BatchClearingResults(context.Context, *QueryBatchClearingResultsRequest) (*QueryBatchClearingResultsResponse, error)
+	// Queries the fulfillment history and profit and loss of a fulfiller.
+	FulfillmentsByFulfiller(context.Context, *QueryFulfillmentsByFulfillerRequest) (*QueryFulfillmentsResponse, error)
+	// Queries the fulfillment history and profit and loss of an on demand lp.
+	FulfillmentsByLP(context.Context, *QueryFulfillmentsByLPRequest) (*QueryFulfillmentsResponse, error)
+	// Queries the sell order and buy orders for the claim on a fulfilled order.
+	ClaimOrders(context.Context, *QueryClaimOrdersRequest) (*QueryClaimOrdersResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -771,6 +1224,18 @@ func (*UnimplementedQueryServer) OnDemandLPs(ctx context.Context, req *QueryOnDe
 func (*UnimplementedQueryServer) OnDemandLPsByByAddr(ctx context.Context, req *QueryOnDemandLPsByAddrRequest) (*QueryOnDemandLPsByAddrResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OnDemandLPsByByAddr not implemented")
 }
+func (*UnimplementedQueryServer) BatchClearingResults(ctx context.Context, req *QueryBatchClearingResultsRequest) (*QueryBatchClearingResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchClearingResults not implemented")
+}
+func (*UnimplementedQueryServer) FulfillmentsByFulfiller(ctx context.Context, req *QueryFulfillmentsByFulfillerRequest) (*QueryFulfillmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillmentsByFulfiller not implemented")
+}
+func (*UnimplementedQueryServer) FulfillmentsByLP(ctx context.Context, req *QueryFulfillmentsByLPRequest) (*QueryFulfillmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillmentsByLP not implemented")
+}
+func (*UnimplementedQueryServer) ClaimOrders(ctx context.Context, req *QueryClaimOrdersRequest) (*QueryClaimOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimOrders not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -866,13 +1331,86 @@ func _Query_OnDemandLPsByByAddr_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.eibc.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
+func _Query_BatchClearingResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBatchClearingResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BatchClearingResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/BatchClearingResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BatchClearingResults(ctx, req.(*QueryBatchClearingResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FulfillmentsByFulfiller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFulfillmentsByFulfillerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FulfillmentsByFulfiller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/FulfillmentsByFulfiller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FulfillmentsByFulfiller(ctx, req.(*QueryFulfillmentsByFulfillerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FulfillmentsByLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFulfillmentsByLPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FulfillmentsByLP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/FulfillmentsByLP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FulfillmentsByLP(ctx, req.(*QueryFulfillmentsByLPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Query/ClaimOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimOrders(ctx, req.(*QueryClaimOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.eibc.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
 		},
 		{
 			MethodName: "DemandOrderById",
@@ -890,6 +1428,22 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OnDemandLPsByByAddr",
 			Handler:    _Query_OnDemandLPsByByAddr_Handler,
 		},
+		{
+			MethodName: "BatchClearingResults",
+			Handler:    _Query_BatchClearingResults_Handler,
+		},
+		{
+			MethodName: "FulfillmentsByFulfiller",
+			Handler:    _Query_FulfillmentsByFulfiller_Handler,
+		},
+		{
+			MethodName: "FulfillmentsByLP",
+			Handler:    _Query_FulfillmentsByLP_Handler,
+		},
+		{
+			MethodName: "ClaimOrders",
+			Handler:    _Query_ClaimOrders_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/eibc/query.proto",
@@ -1293,189 +1847,1323 @@ func (m *QueryOnDemandLPsByAddrResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBatchClearingResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBatchClearingResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetDemandOrderRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBatchClearingResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Rollapp) > 0 {
+		i -= len(m.Rollapp)
+		copy(dAtA[i:], m.Rollapp)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Rollapp)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryDemandOrdersByStatusRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovQuery(uint64(m.Status))
-	}
-	if m.Type != 0 {
-		n += 1 + sovQuery(uint64(m.Type))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Limit != 0 {
-		n += 1 + sovQuery(uint64(m.Limit))
-	}
-	if m.FulfillmentState != 0 {
-		n += 1 + sovQuery(uint64(m.FulfillmentState))
-	}
-	l = len(m.Fulfiller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBatchClearingResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryGetDemandOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.DemandOrder != nil {
-		l = m.DemandOrder.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryBatchClearingResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryDemandOrdersByStatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBatchClearingResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.DemandOrders) > 0 {
-		for _, e := range m.DemandOrders {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOnDemandLPsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+func (m *QueryFulfillmentsByFulfillerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOnDemandLPsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryFulfillmentsByFulfillerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillmentsByFulfillerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Lps) > 0 {
-		for _, e := range m.Lps {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Fulfiller) > 0 {
+		i -= len(m.Fulfiller)
+		copy(dAtA[i:], m.Fulfiller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fulfiller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOnDemandLPsByAddrRequest) Size() (n int) {
+func (m *QueryFulfillmentsByLPRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillmentsByLPRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillmentsByLPRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.LpId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LpId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFulfillmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFulfillmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFulfillmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Fulfillments) > 0 {
+		for iNdEx := len(m.Fulfillments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fulfillments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Pnl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimOrdersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimOrdersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimOrdersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimOrdersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimOrdersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimOrdersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BuyOrders) > 0 {
+		for iNdEx := len(m.BuyOrders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyOrders[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SellOrder != nil {
+		{
+			size, err := m.SellOrder.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Addr)
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDemandOrderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
 	}
 	return n
 }
 
-func (m *QueryOnDemandLPsByAddrResponse) Size() (n int) {
+func (m *QueryDemandOrdersByStatusRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Lps) > 0 {
-		for _, e := range m.Lps {
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Type != 0 {
+		n += 1 + sovQuery(uint64(m.Type))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	if m.FulfillmentState != 0 {
+		n += 1 + sovQuery(uint64(m.FulfillmentState))
+	}
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DemandOrder != nil {
+		l = m.DemandOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDemandOrdersByStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DemandOrders) > 0 {
+		for _, e := range m.DemandOrders {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOnDemandLPsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryOnDemandLPsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lps) > 0 {
+		for _, e := range m.Lps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryOnDemandLPsByAddrRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Addr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOnDemandLPsByAddrResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Lps) > 0 {
+		for _, e := range m.Lps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryBatchClearingResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Rollapp)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBatchClearingResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryFulfillmentsByFulfillerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fulfiller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFulfillmentsByLPRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LpId != 0 {
+		n += 1 + sovQuery(uint64(m.LpId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFulfillmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Pnl.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Fulfillments) > 0 {
+		for _, e := range m.Fulfillments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimOrdersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimOrdersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.SellOrder != nil {
+		l = m.SellOrder.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.BuyOrders) > 0 {
+		for _, e := range m.BuyOrders {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDemandOrderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= types.Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
+			}
+			m.FulfillmentState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDemandOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrder", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DemandOrder == nil {
+				m.DemandOrder = &DemandOrder{}
+			}
+			if err := m.DemandOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
+			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOnDemandLPsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1498,12 +3186,88 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOnDemandLPsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOnDemandLPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1525,7 +3289,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOnDemandLPsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1548,15 +3312,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOnDemandLPsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOnDemandLPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1576,16 +3340,99 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lps = append(m.Lps, &OnDemandLPRecord{})
+			if err := m.Lps[len(m.Lps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOnDemandLPsByAddrRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOnDemandLPsByAddrRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOnDemandLPsByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Addr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1608,7 +3455,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOnDemandLPsByAddrResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1631,17 +3478,17 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDemandOrderRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOnDemandLPsByAddrResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDemandOrderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOnDemandLPsByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lps", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1651,23 +3498,25 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Lps = append(m.Lps, &OnDemandLPRecord{})
+			if err := m.Lps[len(m.Lps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1690,7 +3539,7 @@ func (m *QueryGetDemandOrderRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBatchClearingResultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1713,53 +3562,15 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBatchClearingResultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBatchClearingResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= types.Status(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			m.Type = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Type |= types.RollappPacket_Type(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rollapp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1787,49 +3598,11 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Rollapp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FulfillmentState", wireType)
-			}
-			m.FulfillmentState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.FulfillmentState |= FulfillmentState(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1857,13 +3630,63 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fulfiller = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBatchClearingResultsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBatchClearingResultsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBatchClearingResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1873,27 +3696,79 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Results = append(m.Results, BatchClearingResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFulfillmentsByFulfillerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFulfillmentsByFulfillerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFulfillmentsByFulfillerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfiller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1921,9 +3796,9 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
+			m.Fulfiller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -1980,7 +3855,7 @@ func (m *QueryDemandOrdersByStatusRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFulfillmentsByLPRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2003,15 +3878,34 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDemandOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFulfillmentsByLPRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFulfillmentsByLPRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpId", wireType)
+			}
+			m.LpId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LpId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2038,10 +3932,10 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.DemandOrder == nil {
-				m.DemandOrder = &DemandOrder{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.DemandOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2066,7 +3960,7 @@ func (m *QueryGetDemandOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFulfillmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2089,15 +3983,15 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFulfillmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryDemandOrdersByStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFulfillmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DemandOrders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pnl", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2124,14 +4018,13 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DemandOrders = append(m.DemandOrders, &DemandOrder{})
-			if err := m.DemandOrders[len(m.DemandOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pnl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfillments", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2158,139 +4051,47 @@ func (m *QueryDemandOrdersByStatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fulfillments = append(m.Fulfillments, Fulfillment{})
+			if err := m.Fulfillments[len(m.Fulfillments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOnDemandLPsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOnDemandLPsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOnDemandLPsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowQuery
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthQuery
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthQuery
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowQuery
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2312,7 +4113,7 @@ func (m *QueryOnDemandLPsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOnDemandLPsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimOrdersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2335,17 +4136,17 @@ func (m *QueryOnDemandLPsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOnDemandLPsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimOrdersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOnDemandLPsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimOrdersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2355,25 +4156,23 @@ func (m *QueryOnDemandLPsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lps = append(m.Lps, &OnDemandLPRecord{})
-			if err := m.Lps[len(m.Lps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2396,7 +4195,7 @@ func (m *QueryOnDemandLPsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOnDemandLPsByAddrRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimOrdersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2419,15 +4218,15 @@ func (m *QueryOnDemandLPsByAddrRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOnDemandLPsByAddrRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimOrdersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOnDemandLPsByAddrRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimOrdersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Addr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2455,61 +4254,47 @@ func (m *QueryOnDemandLPsByAddrRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Addr = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SellOrder", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOnDemandLPsByAddrResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.SellOrder == nil {
+				m.SellOrder = &ClaimSellOrder{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOnDemandLPsByAddrResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOnDemandLPsByAddrResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.SellOrder.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyOrders", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2536,8 +4321,8 @@ func (m *QueryOnDemandLPsByAddrResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Lps = append(m.Lps, &OnDemandLPRecord{})
-			if err := m.Lps[len(m.Lps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BuyOrders = append(m.BuyOrders, ClaimBuyOrder{})
+			if err := m.BuyOrders[len(m.BuyOrders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -18,6 +18,8 @@ import (
 var (
 	_ sdk.Msg = &MsgUpdateParams{}
 	_ sdk.Msg = &MsgFulfillOrder{}
+	_ sdk.Msg = &MsgFulfillOrderPartial{}
+	_ sdk.Msg = &MsgFulfillOrderAltDenom{}
 	_ sdk.Msg = &MsgFulfillOrderAuthorized{}
 	_ sdk.Msg = &MsgUpdateDemandOrder{}
 	_ sdk.Msg = &MsgTryFulfillOnDemand{}
@@ -45,6 +47,54 @@ func (msg *MsgFulfillOrder) GetFulfillerBech32Address() []byte {
 	return sdk.MustAccAddressFromBech32(msg.FulfillerAddress)
 }
 
+func NewMsgFulfillOrderPartial(fulfillerAddress, orderId, expectedFee string, amount math.Int) *MsgFulfillOrderPartial {
+	return &MsgFulfillOrderPartial{
+		FulfillerAddress: fulfillerAddress,
+		OrderId:          orderId,
+		ExpectedFee:      expectedFee,
+		Amount:           amount,
+	}
+}
+
+func (msg *MsgFulfillOrderPartial) ValidateBasic() error {
+	err := validateCommon(msg.OrderId, msg.ExpectedFee, msg.FulfillerAddress)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	return nil
+}
+
+func (msg *MsgFulfillOrderPartial) GetFulfillerBech32Address() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.FulfillerAddress)
+}
+
+func NewMsgFulfillOrderAltDenom(fulfillerAddress, orderId, expectedFee string, maxAmount math.Int) *MsgFulfillOrderAltDenom {
+	return &MsgFulfillOrderAltDenom{
+		FulfillerAddress: fulfillerAddress,
+		OrderId:          orderId,
+		ExpectedFee:      expectedFee,
+		MaxAmount:        maxAmount,
+	}
+}
+
+func (msg *MsgFulfillOrderAltDenom) ValidateBasic() error {
+	err := validateCommon(msg.OrderId, msg.ExpectedFee, msg.FulfillerAddress)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if msg.MaxAmount.IsNil() || !msg.MaxAmount.IsPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max amount must be positive")
+	}
+	return nil
+}
+
+func (msg *MsgFulfillOrderAltDenom) GetFulfillerBech32Address() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(msg.FulfillerAddress)
+}
+
 func NewMsgFulfillOrderAuthorized(
 	orderId,
 	rollappId,
@@ -113,7 +163,30 @@ func NewMsgUpdateDemandOrder(ownerAddr, orderId, newFee string) *MsgUpdateDemand
 	}
 }
 
+func NewMsgUpdateDemandOrderFeeCurve(ownerAddr, orderId string, curve FeeCurve) *MsgUpdateDemandOrder {
+	return &MsgUpdateDemandOrder{
+		OrderId:      orderId,
+		OwnerAddress: ownerAddr,
+		FeeCurve:     &curve,
+	}
+}
+
 func (m *MsgUpdateDemandOrder) ValidateBasic() error {
+	if m.FeeCurve != nil {
+		if m.NewFee != "" {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "new fee and fee curve are mutually exclusive")
+		}
+		// the curve is checked against the order amount by the handler
+		if err := m.FeeCurve.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		err := validateCommon(m.OrderId, m.FeeCurve.StartFee.String(), m.OwnerAddress)
+		if err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+		return nil
+	}
+
 	err := validateCommon(m.OrderId, m.NewFee, m.OwnerAddress)
 	if err != nil {
 		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
@@ -227,6 +227,236 @@ func (m *MsgFulfillOrderResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgFulfillOrderResponse proto.InternalMessageInfo
 
+// MsgFulfillOrderPartial funds a slice of a demand order price. The order is
+// fulfilled once the funded slices cover the whole price.
+type MsgFulfillOrderPartial struct {
+	// fulfiller_address is the bech32-encoded address of the account which the
+	// message was sent from.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// order_id is the unique identifier of the order to be funded.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// expected_fee is the nominal fee set in the order.
+	ExpectedFee string `protobuf:"bytes,3,opt,name=expected_fee,json=expectedFee,proto3" json:"expected_fee,omitempty"`
+	// amount is the part of the order price to fund. It must not exceed the
+	// unfunded remainder of the price.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MsgFulfillOrderPartial) Reset()         { *m = MsgFulfillOrderPartial{} }
+func (m *MsgFulfillOrderPartial) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrderPartial) ProtoMessage()    {}
+func (*MsgFulfillOrderPartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{4}
+}
+func (m *MsgFulfillOrderPartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrderPartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrderPartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrderPartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrderPartial.Merge(m, src)
+}
+func (m *MsgFulfillOrderPartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrderPartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrderPartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillOrderPartial proto.InternalMessageInfo
+
+func (m *MsgFulfillOrderPartial) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
+func (m *MsgFulfillOrderPartial) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *MsgFulfillOrderPartial) GetExpectedFee() string {
+	if m != nil {
+		return m.ExpectedFee
+	}
+	return ""
+}
+
+type MsgFulfillOrderPartialResponse struct {
+	// fulfilled is true if this message completed the funding of the order.
+	Fulfilled bool `protobuf:"varint,1,opt,name=fulfilled,proto3" json:"fulfilled,omitempty"`
+}
+
+func (m *MsgFulfillOrderPartialResponse) Reset()         { *m = MsgFulfillOrderPartialResponse{} }
+func (m *MsgFulfillOrderPartialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrderPartialResponse) ProtoMessage()    {}
+func (*MsgFulfillOrderPartialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{5}
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrderPartialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrderPartialResponse.Merge(m, src)
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrderPartialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrderPartialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillOrderPartialResponse proto.InternalMessageInfo
+
+func (m *MsgFulfillOrderPartialResponse) GetFulfilled() bool {
+	if m != nil {
+		return m.Fulfilled
+	}
+	return false
+}
+
+// MsgFulfillOrderAltDenom fulfills a demand order which accepts an alternative
+// denom. The fulfiller pays the recipient in the alternative denom, the amount
+// being priced through the pool named by the order, and still receives the
+// original denom on finalization.
+type MsgFulfillOrderAltDenom struct {
+	// fulfiller_address is the bech32-encoded address of the account which the
+	// message was sent from.
+	FulfillerAddress string `protobuf:"bytes,1,opt,name=fulfiller_address,json=fulfillerAddress,proto3" json:"fulfiller_address,omitempty"`
+	// order_id is the unique identifier of the order to be fulfilled.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// expected_fee is the nominal fee set in the order.
+	ExpectedFee string `protobuf:"bytes,3,opt,name=expected_fee,json=expectedFee,proto3" json:"expected_fee,omitempty"`
+	// max_amount is the most the fulfiller is willing to pay in the alternative
+	// denom.
+	MaxAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount"`
+}
+
+func (m *MsgFulfillOrderAltDenom) Reset()         { *m = MsgFulfillOrderAltDenom{} }
+func (m *MsgFulfillOrderAltDenom) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrderAltDenom) ProtoMessage()    {}
+func (*MsgFulfillOrderAltDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{6}
+}
+func (m *MsgFulfillOrderAltDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrderAltDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrderAltDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrderAltDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrderAltDenom.Merge(m, src)
+}
+func (m *MsgFulfillOrderAltDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrderAltDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrderAltDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillOrderAltDenom proto.InternalMessageInfo
+
+func (m *MsgFulfillOrderAltDenom) GetFulfillerAddress() string {
+	if m != nil {
+		return m.FulfillerAddress
+	}
+	return ""
+}
+
+func (m *MsgFulfillOrderAltDenom) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *MsgFulfillOrderAltDenom) GetExpectedFee() string {
+	if m != nil {
+		return m.ExpectedFee
+	}
+	return ""
+}
+
+type MsgFulfillOrderAltDenomResponse struct {
+	// paid is the amount paid to the recipient in the alternative denom.
+	Paid types.Coin `protobuf:"bytes,1,opt,name=paid,proto3" json:"paid"`
+}
+
+func (m *MsgFulfillOrderAltDenomResponse) Reset()         { *m = MsgFulfillOrderAltDenomResponse{} }
+func (m *MsgFulfillOrderAltDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFulfillOrderAltDenomResponse) ProtoMessage()    {}
+func (*MsgFulfillOrderAltDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{7}
+}
+func (m *MsgFulfillOrderAltDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFulfillOrderAltDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFulfillOrderAltDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFulfillOrderAltDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFulfillOrderAltDenomResponse.Merge(m, src)
+}
+func (m *MsgFulfillOrderAltDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFulfillOrderAltDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFulfillOrderAltDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFulfillOrderAltDenomResponse proto.InternalMessageInfo
+
+func (m *MsgFulfillOrderAltDenomResponse) GetPaid() types.Coin {
+	if m != nil {
+		return m.Paid
+	}
+	return types.Coin{}
+}
+
 // MsgFulfillOrderAuthorized defines the FulfillOrderAuthorized request type.
 type MsgFulfillOrderAuthorized struct {
 	// order_id is the unique identifier of the order to be fulfilled.
@@ -261,7 +491,7 @@ func (m *MsgFulfillOrderAuthorized) Reset()         { *m = MsgFulfillOrderAuthor
 func (m *MsgFulfillOrderAuthorized) String() string { return proto.CompactTextString(m) }
 func (*MsgFulfillOrderAuthorized) ProtoMessage()    {}
 func (*MsgFulfillOrderAuthorized) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{4}
+	return fileDescriptor_47537f11f512b254, []int{8}
 }
 func (m *MsgFulfillOrderAuthorized) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -346,7 +576,7 @@ func (m *MsgFulfillOrderAuthorizedResponse) Reset()         { *m = MsgFulfillOrd
 func (m *MsgFulfillOrderAuthorizedResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFulfillOrderAuthorizedResponse) ProtoMessage()    {}
 func (*MsgFulfillOrderAuthorizedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{5}
+	return fileDescriptor_47537f11f512b254, []int{9}
 }
 func (m *MsgFulfillOrderAuthorizedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,14 +612,17 @@ type MsgUpdateDemandOrder struct {
 	// order_id is the unique identifier of the order to be updated.
 	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	// new_fee is the new fee amount to be set in the order.
+	// Must be empty if fee_curve is set. Setting it removes any fee curve.
 	NewFee string `protobuf:"bytes,3,opt,name=new_fee,json=newFee,proto3" json:"new_fee,omitempty"`
+	// fee_curve is an optional schedule which reprices the order over time.
+	FeeCurve *FeeCurve `protobuf:"bytes,4,opt,name=fee_curve,json=feeCurve,proto3" json:"fee_curve,omitempty"`
 }
 
 func (m *MsgUpdateDemandOrder) Reset()         { *m = MsgUpdateDemandOrder{} }
 func (m *MsgUpdateDemandOrder) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDemandOrder) ProtoMessage()    {}
 func (*MsgUpdateDemandOrder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{6}
+	return fileDescriptor_47537f11f512b254, []int{10}
 }
 func (m *MsgUpdateDemandOrder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,6 +672,13 @@ func (m *MsgUpdateDemandOrder) GetNewFee() string {
 	return ""
 }
 
+func (m *MsgUpdateDemandOrder) GetFeeCurve() *FeeCurve {
+	if m != nil {
+		return m.FeeCurve
+	}
+	return nil
+}
+
 type MsgUpdateDemandOrderResponse struct {
 }
 
@@ -446,7 +686,7 @@ func (m *MsgUpdateDemandOrderResponse) Reset()         { *m = MsgUpdateDemandOrd
 func (m *MsgUpdateDemandOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateDemandOrderResponse) ProtoMessage()    {}
 func (*MsgUpdateDemandOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{7}
+	return fileDescriptor_47537f11f512b254, []int{11}
 }
 func (m *MsgUpdateDemandOrderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -479,15 +719,13 @@ var xxx_messageInfo_MsgUpdateDemandOrderResponse proto.InternalMessageInfo
 type MsgTryFulfillOnDemand struct {
 	Signer  string `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
 	OrderId string `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	// rng to choose fulfiller from eligible randomly
-	Rng int64 `protobuf:"varint,3,opt,name=rng,proto3" json:"rng,omitempty"`
 }
 
 func (m *MsgTryFulfillOnDemand) Reset()         { *m = MsgTryFulfillOnDemand{} }
 func (m *MsgTryFulfillOnDemand) String() string { return proto.CompactTextString(m) }
 func (*MsgTryFulfillOnDemand) ProtoMessage()    {}
 func (*MsgTryFulfillOnDemand) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{8}
+	return fileDescriptor_47537f11f512b254, []int{12}
 }
 func (m *MsgTryFulfillOnDemand) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,13 +768,6 @@ func (m *MsgTryFulfillOnDemand) GetOrderId() string {
 	return ""
 }
 
-func (m *MsgTryFulfillOnDemand) GetRng() int64 {
-	if m != nil {
-		return m.Rng
-	}
-	return 0
-}
-
 type MsgTryFulfillOnDemandResponse struct {
 }
 
@@ -544,7 +775,7 @@ func (m *MsgTryFulfillOnDemandResponse) Reset()         { *m = MsgTryFulfillOnDe
 func (m *MsgTryFulfillOnDemandResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgTryFulfillOnDemandResponse) ProtoMessage()    {}
 func (*MsgTryFulfillOnDemandResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{9}
+	return fileDescriptor_47537f11f512b254, []int{13}
 }
 func (m *MsgTryFulfillOnDemandResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -582,7 +813,7 @@ func (m *MsgCreateOnDemandLP) Reset()         { *m = MsgCreateOnDemandLP{} }
 func (m *MsgCreateOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateOnDemandLP) ProtoMessage()    {}
 func (*MsgCreateOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{10}
+	return fileDescriptor_47537f11f512b254, []int{14}
 }
 func (m *MsgCreateOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -633,7 +864,7 @@ func (m *MsgCreateOnDemandLPResponse) Reset()         { *m = MsgCreateOnDemandLP
 func (m *MsgCreateOnDemandLPResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateOnDemandLPResponse) ProtoMessage()    {}
 func (*MsgCreateOnDemandLPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{11}
+	return fileDescriptor_47537f11f512b254, []int{15}
 }
 func (m *MsgCreateOnDemandLPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -678,7 +909,7 @@ func (m *MsgDeleteOnDemandLP) Reset()         { *m = MsgDeleteOnDemandLP{} }
 func (m *MsgDeleteOnDemandLP) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOnDemandLP) ProtoMessage()    {}
 func (*MsgDeleteOnDemandLP) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{12}
+	return fileDescriptor_47537f11f512b254, []int{16}
 }
 func (m *MsgDeleteOnDemandLP) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -728,7 +959,7 @@ func (m *MsgDeleteOnDemandLPResponse) Reset()         { *m = MsgDeleteOnDemandLP
 func (m *MsgDeleteOnDemandLPResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDeleteOnDemandLPResponse) ProtoMessage()    {}
 func (*MsgDeleteOnDemandLPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_47537f11f512b254, []int{13}
+	return fileDescriptor_47537f11f512b254, []int{17}
 }
 func (m *MsgDeleteOnDemandLPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -757,1161 +988,4811 @@ func (m *MsgDeleteOnDemandLPResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteOnDemandLPResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgFulfillOrder)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrder")
-	proto.RegisterType((*MsgFulfillOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderResponse")
-	proto.RegisterType((*MsgFulfillOrderAuthorized)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorized")
-	proto.RegisterType((*MsgFulfillOrderAuthorizedResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorizedResponse")
-	proto.RegisterType((*MsgUpdateDemandOrder)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrder")
-	proto.RegisterType((*MsgUpdateDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrderResponse")
-	proto.RegisterType((*MsgTryFulfillOnDemand)(nil), "dymensionxyz.dymension.eibc.MsgTryFulfillOnDemand")
-	proto.RegisterType((*MsgTryFulfillOnDemandResponse)(nil), "dymensionxyz.dymension.eibc.MsgTryFulfillOnDemandResponse")
-	proto.RegisterType((*MsgCreateOnDemandLP)(nil), "dymensionxyz.dymension.eibc.MsgCreateOnDemandLP")
-	proto.RegisterType((*MsgCreateOnDemandLPResponse)(nil), "dymensionxyz.dymension.eibc.MsgCreateOnDemandLPResponse")
-	proto.RegisterType((*MsgDeleteOnDemandLP)(nil), "dymensionxyz.dymension.eibc.MsgDeleteOnDemandLP")
-	proto.RegisterType((*MsgDeleteOnDemandLPResponse)(nil), "dymensionxyz.dymension.eibc.MsgDeleteOnDemandLPResponse")
+// MsgTransferOrderClaim reassigns the claim on a fulfilled demand order. The
+// new holder receives the packet funds on finalization.
+type MsgTransferOrderClaim struct {
+	// holder is the bech32-encoded address of the current claim holder.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// order_id is the id of the fulfilled demand order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// new_holder is the bech32-encoded address of the account receiving the
+	// claim.
+	NewHolder string `protobuf:"bytes,3,opt,name=new_holder,json=newHolder,proto3" json:"new_holder,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/eibc/tx.proto", fileDescriptor_47537f11f512b254)
+func (m *MsgTransferOrderClaim) Reset()         { *m = MsgTransferOrderClaim{} }
+func (m *MsgTransferOrderClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOrderClaim) ProtoMessage()    {}
+func (*MsgTransferOrderClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{18}
 }
-
-var fileDescriptor_47537f11f512b254 = []byte{
-	// 1015 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0xc7, 0x45, 0x4b, 0x96, 0xad, 0x63, 0x7f, 0x89, 0xc2, 0x28, 0xb6, 0x24, 0x7f, 0x96, 0x1d,
-	0xb9, 0x40, 0x85, 0xb4, 0x26, 0x2d, 0xa7, 0x48, 0x5b, 0x2d, 0x0a, 0xc4, 0x11, 0x8c, 0x1a, 0xb5,
-	0x51, 0x83, 0x69, 0xbb, 0x28, 0x0a, 0x08, 0x94, 0x78, 0x4c, 0x13, 0xa1, 0x38, 0x04, 0x67, 0x7c,
-	0x51, 0x16, 0x45, 0xd0, 0x00, 0x5d, 0x17, 0x45, 0x1f, 0xa0, 0x40, 0x77, 0x5e, 0x65, 0x91, 0x87,
-	0xc8, 0xaa, 0x08, 0xb2, 0x2a, 0xba, 0x88, 0x0b, 0x7b, 0x91, 0xd7, 0x28, 0x86, 0x1c, 0x51, 0xa4,
-	0xae, 0x51, 0x57, 0xe4, 0xcc, 0xb9, 0xcc, 0xef, 0xcf, 0x39, 0x67, 0x86, 0xf0, 0x81, 0xd1, 0x69,
-	0xa3, 0x43, 0x2d, 0xe2, 0x9c, 0x77, 0x9e, 0xaa, 0xe1, 0x40, 0x45, 0xab, 0xd9, 0x52, 0xd9, 0xb9,
-	0xe2, 0x7a, 0x84, 0x11, 0x79, 0x25, 0xea, 0xa5, 0x84, 0x03, 0x85, 0x7b, 0x15, 0x97, 0x5b, 0x84,
-	0xb6, 0x09, 0x55, 0xdb, 0xd4, 0x54, 0x4f, 0xab, 0xfc, 0x11, 0x44, 0x15, 0x0b, 0x81, 0xa1, 0xe1,
-	0x8f, 0xd4, 0x60, 0x20, 0x4c, 0x39, 0x93, 0x98, 0x24, 0x98, 0xe7, 0x6f, 0x62, 0xb6, 0x24, 0x32,
-	0x35, 0x75, 0x8a, 0xea, 0x69, 0xb5, 0x89, 0x4c, 0xaf, 0xaa, 0x2d, 0x62, 0x39, 0xc2, 0x3e, 0x16,
-	0xd6, 0x76, 0x85, 0x57, 0x65, 0x9c, 0x97, 0xab, 0x7b, 0x7a, 0x5b, 0x50, 0x94, 0xff, 0x90, 0xe0,
-	0xe6, 0x01, 0x35, 0xbf, 0x75, 0x0d, 0x9d, 0xe1, 0xa1, 0x6f, 0x91, 0x1f, 0x40, 0x46, 0x3f, 0x61,
-	0xc7, 0xc4, 0xb3, 0x58, 0x27, 0x2f, 0xad, 0x4b, 0x95, 0xcc, 0x4e, 0xfe, 0xcd, 0xcb, 0xcd, 0x9c,
-	0xc0, 0x7f, 0x68, 0x18, 0x1e, 0x52, 0xfa, 0x98, 0x79, 0x96, 0x63, 0x6a, 0x3d, 0x57, 0xf9, 0x4b,
-	0x00, 0x07, 0xcf, 0x1a, 0x41, 0xfe, 0xfc, 0xcc, 0xba, 0x54, 0x59, 0xd8, 0xde, 0x50, 0xc6, 0x7c,
-	0x37, 0x25, 0x58, 0x70, 0x27, 0xf5, 0xea, 0xed, 0x5a, 0x42, 0xcb, 0x38, 0x78, 0x16, 0x4c, 0xd4,
-	0x6e, 0xfc, 0xf4, 0xee, 0xc5, 0xbd, 0x5e, 0xe6, 0x72, 0x01, 0x96, 0xfb, 0x20, 0x35, 0xa4, 0x2e,
-	0x71, 0x28, 0x96, 0x7f, 0x0b, 0x04, 0xec, 0x9e, 0xd8, 0x47, 0x96, 0x6d, 0x7f, 0xed, 0x19, 0xe8,
-	0xc9, 0x1f, 0xc1, 0xad, 0xa3, 0x60, 0x8c, 0x5e, 0x43, 0x0f, 0x70, 0x03, 0x21, 0x5a, 0x36, 0x34,
-	0x08, 0x19, 0x72, 0x01, 0xe6, 0x09, 0x8f, 0x6a, 0x58, 0x86, 0xcf, 0x9c, 0xd1, 0xe6, 0xfc, 0xf1,
-	0x9e, 0x21, 0xdf, 0x85, 0x45, 0x3c, 0x77, 0xb1, 0xc5, 0xd0, 0x68, 0x1c, 0x21, 0xe6, 0x93, 0xbe,
-	0x79, 0xa1, 0x3b, 0xb7, 0x8b, 0x58, 0x5b, 0xe2, 0xa4, 0x83, 0xab, 0x09, 0xe2, 0x28, 0x55, 0x48,
-	0xfc, 0x67, 0x0a, 0x0a, 0x7d, 0xb6, 0x87, 0x81, 0xd2, 0xa7, 0x68, 0xc4, 0x70, 0xa4, 0x38, 0xce,
-	0x2a, 0x80, 0x47, 0x6c, 0x5b, 0x77, 0xdd, 0x1e, 0x6b, 0x46, 0xcc, 0xec, 0x19, 0xb2, 0x0e, 0xb3,
-	0xae, 0x67, 0xb5, 0x38, 0x66, 0xb2, 0xb2, 0xb0, 0x5d, 0x50, 0xc4, 0x7e, 0xf1, 0x52, 0x52, 0x44,
-	0x29, 0x29, 0x8f, 0x88, 0xe5, 0xec, 0x6c, 0xf1, 0xef, 0x7d, 0x71, 0xb9, 0x56, 0x31, 0x2d, 0x76,
-	0x7c, 0xd2, 0x54, 0x5a, 0xa4, 0x2d, 0x6a, 0x53, 0x3c, 0x36, 0xa9, 0xf1, 0x44, 0x65, 0x1d, 0x17,
-	0xa9, 0x1f, 0x40, 0xb5, 0x20, 0xb3, 0xfc, 0x03, 0xa4, 0xf5, 0x36, 0x39, 0x71, 0x58, 0x3e, 0xe5,
-	0x97, 0x45, 0x9d, 0x27, 0xfa, 0xfb, 0xed, 0xda, 0x9d, 0x20, 0x8c, 0x1a, 0x4f, 0x14, 0x8b, 0xa8,
-	0x6d, 0x9d, 0x1d, 0x2b, 0x7b, 0x0e, 0xbb, 0xb8, 0x1c, 0x61, 0x78, 0xf3, 0x72, 0x13, 0x04, 0xdc,
-	0x9e, 0xc3, 0x34, 0x91, 0x93, 0xeb, 0xb3, 0xdd, 0x70, 0xbf, 0x66, 0x03, 0x7d, 0xb6, 0xdb, 0xdd,
-	0xa8, 0x2d, 0xc8, 0x11, 0x17, 0x3d, 0x9d, 0x11, 0x8f, 0xef, 0x46, 0xe8, 0x98, 0xf6, 0x1d, 0xe5,
-	0xae, 0x6d, 0x17, 0xb1, 0x1b, 0xd1, 0xbf, 0x7f, 0x73, 0x03, 0xfb, 0x27, 0xff, 0x08, 0x72, 0x2c,
-	0x29, 0x3d, 0xd6, 0x3d, 0xcc, 0xcf, 0xfb, 0xea, 0x0e, 0x85, 0xba, 0x95, 0x41, 0x11, 0xfb, 0x68,
-	0xea, 0xad, 0x4e, 0x1d, 0x5b, 0x17, 0x97, 0x63, 0xcd, 0x11, 0xa5, 0x75, 0x6c, 0x69, 0xd9, 0x08,
-	0xe4, 0x63, 0xbe, 0x92, 0x5c, 0x85, 0x1c, 0x45, 0xc6, 0x6c, 0x6c, 0xa3, 0xc3, 0x1a, 0xa7, 0xba,
-	0x6d, 0xf1, 0x1a, 0x37, 0xf2, 0x99, 0x75, 0xa9, 0x32, 0xaf, 0xdd, 0xee, 0xd9, 0xbe, 0xeb, 0x9a,
-	0x6a, 0x37, 0x79, 0xc9, 0x45, 0xbe, 0x54, 0x79, 0x03, 0xee, 0x8e, 0xac, 0xa7, 0xb0, 0xea, 0x9e,
-	0x4b, 0x90, 0x0b, 0x7b, 0xa8, 0x8e, 0x6d, 0xdd, 0x31, 0x82, 0x66, 0xd9, 0x80, 0xff, 0x91, 0x33,
-	0x67, 0xa0, 0x51, 0x16, 0xfd, 0xc9, 0xf7, 0x68, 0x92, 0x65, 0x98, 0xe3, 0x5d, 0xdf, 0xeb, 0x8f,
-	0xb4, 0x83, 0x67, 0xbc, 0x35, 0x64, 0xce, 0x19, 0xcf, 0x5d, 0x2e, 0xc1, 0xff, 0x87, 0x41, 0x84,
-	0x94, 0x16, 0xdc, 0x39, 0xa0, 0xe6, 0x37, 0x5e, 0xa7, 0xab, 0xc6, 0x09, 0xbc, 0xe4, 0x25, 0x48,
-	0x53, 0xcb, 0x74, 0xd0, 0x13, 0xcb, 0x8b, 0xd1, 0xb8, 0x76, 0xc9, 0x42, 0xd2, 0x73, 0x4c, 0x1f,
-	0x2a, 0xa9, 0xf1, 0xd7, 0xda, 0x02, 0x27, 0x12, 0x91, 0xe5, 0x35, 0x58, 0x1d, 0xba, 0x54, 0xc8,
-	0x42, 0xe1, 0xf6, 0x01, 0x35, 0x1f, 0x79, 0xa8, 0x33, 0xec, 0x1a, 0xf7, 0x0f, 0x23, 0x24, 0xc9,
-	0x18, 0xc9, 0xa7, 0x30, 0x63, 0xbb, 0xe2, 0xd4, 0xfb, 0x70, 0xec, 0xa9, 0xd7, 0x4b, 0xa6, 0xcd,
-	0xd8, 0x6e, 0x9c, 0x6a, 0x13, 0x56, 0x86, 0x2c, 0xda, 0x65, 0x92, 0x6f, 0xc0, 0x8c, 0x10, 0x9a,
-	0xd2, 0x66, 0x2c, 0xa3, 0xbc, 0xef, 0x33, 0xd6, 0xd1, 0xc6, 0x11, 0x8c, 0x52, 0x8c, 0x31, 0x0b,
-	0x49, 0xcb, 0xe0, 0x47, 0x73, 0xb2, 0x92, 0xd2, 0xf8, 0x6b, 0x7c, 0xf1, 0x55, 0x7f, 0xf1, 0xfe,
-	0x6c, 0xdd, 0xc5, 0xb7, 0x7f, 0x9f, 0x83, 0xe4, 0x01, 0x35, 0x65, 0x0f, 0x16, 0x63, 0xf7, 0xc5,
-	0xc7, 0x63, 0xd5, 0xf6, 0x1d, 0xdc, 0xc5, 0x4f, 0xa6, 0xf1, 0x0e, 0x85, 0xff, 0x2c, 0x81, 0x3c,
-	0xa4, 0x2c, 0xb6, 0x27, 0x25, 0x1b, 0x8c, 0x29, 0xd6, 0xa6, 0x8f, 0x09, 0x6b, 0x22, 0x21, 0x33,
-	0x58, 0x8c, 0xdd, 0x35, 0x13, 0xc5, 0x47, 0xbd, 0x27, 0x8b, 0x1f, 0x7a, 0x63, 0x24, 0xe4, 0x5f,
-	0x25, 0x58, 0x1a, 0x71, 0x61, 0x3c, 0x98, 0x26, 0x65, 0x2f, 0xae, 0xf8, 0xc5, 0x7f, 0x8b, 0x8b,
-	0x40, 0x3d, 0x97, 0xe0, 0xd6, 0xe0, 0x79, 0x52, 0x7d, 0xbf, 0xfd, 0x8d, 0x84, 0x14, 0x3f, 0x9f,
-	0x3a, 0x24, 0x42, 0xf1, 0x4c, 0x82, 0xec, 0x40, 0x93, 0x6e, 0x4d, 0xca, 0xd8, 0x1f, 0x51, 0xfc,
-	0x6c, 0xda, 0x88, 0x3e, 0x84, 0x81, 0x1e, 0x9c, 0x88, 0xd0, 0x1f, 0x31, 0x19, 0x61, 0x54, 0x67,
-	0x96, 0x13, 0xc5, 0xd9, 0x67, 0xef, 0x5e, 0xdc, 0x93, 0x76, 0xbe, 0x7a, 0x75, 0x55, 0x92, 0x5e,
-	0x5f, 0x95, 0xa4, 0x7f, 0xae, 0x4a, 0xd2, 0x2f, 0xd7, 0xa5, 0xc4, 0xeb, 0xeb, 0x52, 0xe2, 0xaf,
-	0xeb, 0x52, 0xe2, 0xfb, 0x6a, 0xe4, 0xae, 0x1f, 0xf1, 0x77, 0x78, 0x7a, 0x5f, 0x3d, 0x17, 0x7f,
-	0xbd, 0xfc, 0xea, 0x6f, 0xa6, 0xfd, 0x5f, 0xc4, 0xfb, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x26,
-	0x33, 0xd9, 0xee, 0x21, 0x0b, 0x00, 0x00,
+func (m *MsgTransferOrderClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams is used for updating module params.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	TryFulfillOnDemand(ctx context.Context, in *MsgTryFulfillOnDemand, opts ...grpc.CallOption) (*MsgTryFulfillOnDemandResponse, error)
-	FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error)
-	FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error)
-	UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error)
-	CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error)
-	DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandLP, opts ...grpc.CallOption) (*MsgDeleteOnDemandLPResponse, error)
+func (m *MsgTransferOrderClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOrderClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgTransferOrderClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOrderClaim.Merge(m, src)
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgTransferOrderClaim) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgTransferOrderClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOrderClaim.DiscardUnknown(m)
 }
 
-func (c *msgClient) TryFulfillOnDemand(ctx context.Context, in *MsgTryFulfillOnDemand, opts ...grpc.CallOption) (*MsgTryFulfillOnDemandResponse, error) {
-	out := new(MsgTryFulfillOnDemandResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/TryFulfillOnDemand", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferOrderClaim proto.InternalMessageInfo
+
+func (m *MsgTransferOrderClaim) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error) {
-	out := new(MsgFulfillOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferOrderClaim) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error) {
-	out := new(MsgFulfillOrderAuthorizedResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgTransferOrderClaim) GetNewHolder() string {
+	if m != nil {
+		return m.NewHolder
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error) {
-	out := new(MsgUpdateDemandOrderResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+type MsgTransferOrderClaimResponse struct {
+}
+
+func (m *MsgTransferOrderClaimResponse) Reset()         { *m = MsgTransferOrderClaimResponse{} }
+func (m *MsgTransferOrderClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferOrderClaimResponse) ProtoMessage()    {}
+func (*MsgTransferOrderClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{19}
+}
+func (m *MsgTransferOrderClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferOrderClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferOrderClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgTransferOrderClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferOrderClaimResponse.Merge(m, src)
+}
+func (m *MsgTransferOrderClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferOrderClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferOrderClaimResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error) {
-	out := new(MsgCreateOnDemandLPResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/CreateOnDemandLP", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgTransferOrderClaimResponse proto.InternalMessageInfo
+
+type MsgPlaceClaimSellOrder struct {
+	// holder is the bech32-encoded address of the current claim holder.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// order_id is the id of the fulfilled demand order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// price is what a buyer must pay to take over the claim. It replaces the
+	// price of an existing sell order.
+	Price types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+}
+
+func (m *MsgPlaceClaimSellOrder) Reset()         { *m = MsgPlaceClaimSellOrder{} }
+func (m *MsgPlaceClaimSellOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceClaimSellOrder) ProtoMessage()    {}
+func (*MsgPlaceClaimSellOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{20}
+}
+func (m *MsgPlaceClaimSellOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceClaimSellOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceClaimSellOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgPlaceClaimSellOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceClaimSellOrder.Merge(m, src)
+}
+func (m *MsgPlaceClaimSellOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceClaimSellOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceClaimSellOrder.DiscardUnknown(m)
 }
 
-func (c *msgClient) DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandLP, opts ...grpc.CallOption) (*MsgDeleteOnDemandLPResponse, error) {
-	out := new(MsgDeleteOnDemandLPResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/DeleteOnDemandLP", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPlaceClaimSellOrder proto.InternalMessageInfo
+
+func (m *MsgPlaceClaimSellOrder) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams is used for updating module params.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	TryFulfillOnDemand(context.Context, *MsgTryFulfillOnDemand) (*MsgTryFulfillOnDemandResponse, error)
-	FulfillOrder(context.Context, *MsgFulfillOrder) (*MsgFulfillOrderResponse, error)
-	FulfillOrderAuthorized(context.Context, *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error)
-	UpdateDemandOrder(context.Context, *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error)
-	CreateOnDemandLP(context.Context, *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error)
-	DeleteOnDemandLP(context.Context, *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error)
+func (m *MsgPlaceClaimSellOrder) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgPlaceClaimSellOrder) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+type MsgPlaceClaimSellOrderResponse struct {
 }
-func (*UnimplementedMsgServer) TryFulfillOnDemand(ctx context.Context, req *MsgTryFulfillOnDemand) (*MsgTryFulfillOnDemandResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TryFulfillOnDemand not implemented")
+
+func (m *MsgPlaceClaimSellOrderResponse) Reset()         { *m = MsgPlaceClaimSellOrderResponse{} }
+func (m *MsgPlaceClaimSellOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceClaimSellOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceClaimSellOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{21}
 }
-func (*UnimplementedMsgServer) FulfillOrder(ctx context.Context, req *MsgFulfillOrder) (*MsgFulfillOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
+func (m *MsgPlaceClaimSellOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) FulfillOrderAuthorized(ctx context.Context, req *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderAuthorized not implemented")
+func (m *MsgPlaceClaimSellOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceClaimSellOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateDemandOrder(ctx context.Context, req *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDemandOrder not implemented")
+func (m *MsgPlaceClaimSellOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceClaimSellOrderResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreateOnDemandLP(ctx context.Context, req *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateOnDemandLP not implemented")
+func (m *MsgPlaceClaimSellOrderResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) DeleteOnDemandLP(ctx context.Context, req *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteOnDemandLP not implemented")
+func (m *MsgPlaceClaimSellOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceClaimSellOrderResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgPlaceClaimSellOrderResponse proto.InternalMessageInfo
+
+type MsgCancelClaimSellOrder struct {
+	// holder is the bech32-encoded address of the current claim holder.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// order_id is the id of the fulfilled demand order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgCancelClaimSellOrder) Reset()         { *m = MsgCancelClaimSellOrder{} }
+func (m *MsgCancelClaimSellOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelClaimSellOrder) ProtoMessage()    {}
+func (*MsgCancelClaimSellOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{22}
+}
+func (m *MsgCancelClaimSellOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelClaimSellOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelClaimSellOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelClaimSellOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelClaimSellOrder.Merge(m, src)
+}
+func (m *MsgCancelClaimSellOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelClaimSellOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelClaimSellOrder.DiscardUnknown(m)
 }
 
-func _Msg_TryFulfillOnDemand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTryFulfillOnDemand)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TryFulfillOnDemand(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/TryFulfillOnDemand",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TryFulfillOnDemand(ctx, req.(*MsgTryFulfillOnDemand))
+var xxx_messageInfo_MsgCancelClaimSellOrder proto.InternalMessageInfo
+
+func (m *MsgCancelClaimSellOrder) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFulfillOrder)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FulfillOrder(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrder",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FulfillOrder(ctx, req.(*MsgFulfillOrder))
+func (m *MsgCancelClaimSellOrder) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_FulfillOrderAuthorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgFulfillOrderAuthorized)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).FulfillOrderAuthorized(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).FulfillOrderAuthorized(ctx, req.(*MsgFulfillOrderAuthorized))
+type MsgCancelClaimSellOrderResponse struct {
+}
+
+func (m *MsgCancelClaimSellOrderResponse) Reset()         { *m = MsgCancelClaimSellOrderResponse{} }
+func (m *MsgCancelClaimSellOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelClaimSellOrderResponse) ProtoMessage()    {}
+func (*MsgCancelClaimSellOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{23}
+}
+func (m *MsgCancelClaimSellOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelClaimSellOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelClaimSellOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCancelClaimSellOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelClaimSellOrderResponse.Merge(m, src)
+}
+func (m *MsgCancelClaimSellOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelClaimSellOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelClaimSellOrderResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateDemandOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDemandOrder)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCancelClaimSellOrderResponse proto.InternalMessageInfo
+
+type MsgPurchaseClaim struct {
+	// buyer is the bech32-encoded address of the account buying the claim.
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// order_id is the id of the fulfilled demand order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// expected_price must match the price of the sell order, to protect the
+	// buyer against a repricing.
+	ExpectedPrice types.Coin `protobuf:"bytes,3,opt,name=expected_price,json=expectedPrice,proto3" json:"expected_price"`
+}
+
+func (m *MsgPurchaseClaim) Reset()         { *m = MsgPurchaseClaim{} }
+func (m *MsgPurchaseClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseClaim) ProtoMessage()    {}
+func (*MsgPurchaseClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{24}
+}
+func (m *MsgPurchaseClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPurchaseClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDemandOrder(ctx, in)
+}
+func (m *MsgPurchaseClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseClaim.Merge(m, src)
+}
+func (m *MsgPurchaseClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPurchaseClaim proto.InternalMessageInfo
+
+func (m *MsgPurchaseClaim) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder",
+	return ""
+}
+
+func (m *MsgPurchaseClaim) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDemandOrder(ctx, req.(*MsgUpdateDemandOrder))
+	return ""
+}
+
+func (m *MsgPurchaseClaim) GetExpectedPrice() types.Coin {
+	if m != nil {
+		return m.ExpectedPrice
 	}
-	return interceptor(ctx, in, info, handler)
+	return types.Coin{}
 }
 
-func _Msg_CreateOnDemandLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateOnDemandLP)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateOnDemandLP(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/CreateOnDemandLP",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateOnDemandLP(ctx, req.(*MsgCreateOnDemandLP))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgPurchaseClaimResponse struct {
 }
 
-func _Msg_DeleteOnDemandLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteOnDemandLP)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteOnDemandLP(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.eibc.Msg/DeleteOnDemandLP",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteOnDemandLP(ctx, req.(*MsgDeleteOnDemandLP))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgPurchaseClaimResponse) Reset()         { *m = MsgPurchaseClaimResponse{} }
+func (m *MsgPurchaseClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPurchaseClaimResponse) ProtoMessage()    {}
+func (*MsgPurchaseClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{25}
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.eibc.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "TryFulfillOnDemand",
-			Handler:    _Msg_TryFulfillOnDemand_Handler,
-		},
-		{
-			MethodName: "FulfillOrder",
-			Handler:    _Msg_FulfillOrder_Handler,
-		},
-		{
-			MethodName: "FulfillOrderAuthorized",
-			Handler:    _Msg_FulfillOrderAuthorized_Handler,
-		},
-		{
-			MethodName: "UpdateDemandOrder",
-			Handler:    _Msg_UpdateDemandOrder_Handler,
-		},
-		{
-			MethodName: "CreateOnDemandLP",
-			Handler:    _Msg_CreateOnDemandLP_Handler,
-		},
-		{
-			MethodName: "DeleteOnDemandLP",
-			Handler:    _Msg_DeleteOnDemandLP_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/eibc/tx.proto",
+func (m *MsgPurchaseClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPurchaseClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPurchaseClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgPurchaseClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPurchaseClaimResponse.Merge(m, src)
+}
+func (m *MsgPurchaseClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPurchaseClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPurchaseClaimResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgPurchaseClaimResponse proto.InternalMessageInfo
+
+type MsgPlaceClaimBuyOrder struct {
+	// buyer is the bech32-encoded address of the account making the offer.
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// order_id is the id of the fulfilled demand order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// price is the offered amount. It replaces the offer of an existing buy
+	// order of the buyer, the difference being escrowed or refunded.
+	Price types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgPlaceClaimBuyOrder) Reset()         { *m = MsgPlaceClaimBuyOrder{} }
+func (m *MsgPlaceClaimBuyOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceClaimBuyOrder) ProtoMessage()    {}
+func (*MsgPlaceClaimBuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{26}
+}
+func (m *MsgPlaceClaimBuyOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceClaimBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceClaimBuyOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgPlaceClaimBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceClaimBuyOrder.Merge(m, src)
+}
+func (m *MsgPlaceClaimBuyOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceClaimBuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceClaimBuyOrder.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPlaceClaimBuyOrder proto.InternalMessageInfo
+
+func (m *MsgPlaceClaimBuyOrder) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceClaimBuyOrder) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPlaceClaimBuyOrder) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgPlaceClaimBuyOrderResponse struct {
 }
 
-func (m *MsgFulfillOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgPlaceClaimBuyOrderResponse) Reset()         { *m = MsgPlaceClaimBuyOrderResponse{} }
+func (m *MsgPlaceClaimBuyOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPlaceClaimBuyOrderResponse) ProtoMessage()    {}
+func (*MsgPlaceClaimBuyOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{27}
+}
+func (m *MsgPlaceClaimBuyOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPlaceClaimBuyOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPlaceClaimBuyOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgFulfillOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPlaceClaimBuyOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPlaceClaimBuyOrderResponse.Merge(m, src)
+}
+func (m *MsgPlaceClaimBuyOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPlaceClaimBuyOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPlaceClaimBuyOrderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgFulfillOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ExpectedFee) > 0 {
-		i -= len(m.ExpectedFee)
-		copy(dAtA[i:], m.ExpectedFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FulfillerAddress) > 0 {
-		i -= len(m.FulfillerAddress)
-		copy(dAtA[i:], m.FulfillerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgPlaceClaimBuyOrderResponse proto.InternalMessageInfo
+
+type MsgCancelClaimBuyOrder struct {
+	// buyer is the bech32-encoded address of the account which made the offer.
+	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// order_id is the id of the fulfilled demand order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 }
 
-func (m *MsgFulfillOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelClaimBuyOrder) Reset()         { *m = MsgCancelClaimBuyOrder{} }
+func (m *MsgCancelClaimBuyOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelClaimBuyOrder) ProtoMessage()    {}
+func (*MsgCancelClaimBuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{28}
+}
+func (m *MsgCancelClaimBuyOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelClaimBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelClaimBuyOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgFulfillOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelClaimBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelClaimBuyOrder.Merge(m, src)
+}
+func (m *MsgCancelClaimBuyOrder) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelClaimBuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelClaimBuyOrder.DiscardUnknown(m)
 }
 
-func (m *MsgFulfillOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgCancelClaimBuyOrder proto.InternalMessageInfo
+
+func (m *MsgCancelClaimBuyOrder) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
 }
 
-func (m *MsgFulfillOrderAuthorized) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelClaimBuyOrder) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgFulfillOrderAuthorized) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCancelClaimBuyOrderResponse struct {
 }
 
-func (m *MsgFulfillOrderAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SettlementValidated {
-		i--
-		if m.SettlementValidated {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x48
-	}
-	{
-		size := m.OperatorFeeShare.Size()
-		i -= size
-		if _, err := m.OperatorFeeShare.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x42
-	if len(m.ExpectedFee) > 0 {
-		i -= len(m.ExpectedFee)
-		copy(dAtA[i:], m.ExpectedFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.OperatorFeeAddress) > 0 {
-		i -= len(m.OperatorFeeAddress)
-		copy(dAtA[i:], m.OperatorFeeAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorFeeAddress)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.LpAddress) > 0 {
-		i -= len(m.LpAddress)
-		copy(dAtA[i:], m.LpAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.LpAddress)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Price) > 0 {
-		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *MsgCancelClaimBuyOrderResponse) Reset()         { *m = MsgCancelClaimBuyOrderResponse{} }
+func (m *MsgCancelClaimBuyOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelClaimBuyOrderResponse) ProtoMessage()    {}
+func (*MsgCancelClaimBuyOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{29}
+}
+func (m *MsgCancelClaimBuyOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelClaimBuyOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelClaimBuyOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgFulfillOrderAuthorizedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelClaimBuyOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelClaimBuyOrderResponse.Merge(m, src)
 }
-
-func (m *MsgFulfillOrderAuthorizedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelClaimBuyOrderResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgFulfillOrderAuthorizedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCancelClaimBuyOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelClaimBuyOrderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDemandOrder) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgCancelClaimBuyOrderResponse proto.InternalMessageInfo
 
-func (m *MsgUpdateDemandOrder) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAcceptClaimBuyOrder struct {
+	// holder is the bech32-encoded address of the current claim holder.
+	Holder string `protobuf:"bytes,1,opt,name=holder,proto3" json:"holder,omitempty"`
+	// order_id is the id of the fulfilled demand order.
+	OrderId string `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// buyer is the bech32-encoded address of the account which made the offer.
+	Buyer string `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// min_price is the least the holder accepts, to protect against a lowered
+	// offer.
+	MinPrice types.Coin `protobuf:"bytes,4,opt,name=min_price,json=minPrice,proto3" json:"min_price"`
 }
 
-func (m *MsgUpdateDemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewFee) > 0 {
-		i -= len(m.NewFee)
-		copy(dAtA[i:], m.NewFee)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFee)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgAcceptClaimBuyOrder) Reset()         { *m = MsgAcceptClaimBuyOrder{} }
+func (m *MsgAcceptClaimBuyOrder) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptClaimBuyOrder) ProtoMessage()    {}
+func (*MsgAcceptClaimBuyOrder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{30}
 }
-
-func (m *MsgUpdateDemandOrderResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptClaimBuyOrder) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptClaimBuyOrder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptClaimBuyOrder.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgUpdateDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptClaimBuyOrder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptClaimBuyOrder.Merge(m, src)
 }
-
-func (m *MsgUpdateDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAcceptClaimBuyOrder) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgTryFulfillOnDemand) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgAcceptClaimBuyOrder) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptClaimBuyOrder.DiscardUnknown(m)
 }
 
-func (m *MsgTryFulfillOnDemand) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_MsgAcceptClaimBuyOrder proto.InternalMessageInfo
 
-func (m *MsgTryFulfillOnDemand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Rng != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Rng))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.OrderId) > 0 {
-		i -= len(m.OrderId)
-		copy(dAtA[i:], m.OrderId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgAcceptClaimBuyOrder) GetHolder() string {
+	if m != nil {
+		return m.Holder
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgTryFulfillOnDemandResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptClaimBuyOrder) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgTryFulfillOnDemandResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *MsgTryFulfillOnDemandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgAcceptClaimBuyOrder) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
 }
 
-func (m *MsgCreateOnDemandLP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptClaimBuyOrder) GetMinPrice() types.Coin {
+	if m != nil {
+		return m.MinPrice
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgCreateOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgAcceptClaimBuyOrderResponse struct {
 }
 
-func (m *MsgCreateOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Lp != nil {
-		{
-			size, err := m.Lp.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgAcceptClaimBuyOrderResponse) Reset()         { *m = MsgAcceptClaimBuyOrderResponse{} }
+func (m *MsgAcceptClaimBuyOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptClaimBuyOrderResponse) ProtoMessage()    {}
+func (*MsgAcceptClaimBuyOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_47537f11f512b254, []int{31}
+}
+func (m *MsgAcceptClaimBuyOrderResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptClaimBuyOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptClaimBuyOrderResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAcceptClaimBuyOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptClaimBuyOrderResponse.Merge(m, src)
+}
+func (m *MsgAcceptClaimBuyOrderResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptClaimBuyOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptClaimBuyOrderResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateOnDemandLPResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgAcceptClaimBuyOrderResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgFulfillOrder)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrder")
+	proto.RegisterType((*MsgFulfillOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderResponse")
+	proto.RegisterType((*MsgFulfillOrderPartial)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderPartial")
+	proto.RegisterType((*MsgFulfillOrderPartialResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderPartialResponse")
+	proto.RegisterType((*MsgFulfillOrderAltDenom)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAltDenom")
+	proto.RegisterType((*MsgFulfillOrderAltDenomResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAltDenomResponse")
+	proto.RegisterType((*MsgFulfillOrderAuthorized)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorized")
+	proto.RegisterType((*MsgFulfillOrderAuthorizedResponse)(nil), "dymensionxyz.dymension.eibc.MsgFulfillOrderAuthorizedResponse")
+	proto.RegisterType((*MsgUpdateDemandOrder)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrder")
+	proto.RegisterType((*MsgUpdateDemandOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgUpdateDemandOrderResponse")
+	proto.RegisterType((*MsgTryFulfillOnDemand)(nil), "dymensionxyz.dymension.eibc.MsgTryFulfillOnDemand")
+	proto.RegisterType((*MsgTryFulfillOnDemandResponse)(nil), "dymensionxyz.dymension.eibc.MsgTryFulfillOnDemandResponse")
+	proto.RegisterType((*MsgCreateOnDemandLP)(nil), "dymensionxyz.dymension.eibc.MsgCreateOnDemandLP")
+	proto.RegisterType((*MsgCreateOnDemandLPResponse)(nil), "dymensionxyz.dymension.eibc.MsgCreateOnDemandLPResponse")
+	proto.RegisterType((*MsgDeleteOnDemandLP)(nil), "dymensionxyz.dymension.eibc.MsgDeleteOnDemandLP")
+	proto.RegisterType((*MsgDeleteOnDemandLPResponse)(nil), "dymensionxyz.dymension.eibc.MsgDeleteOnDemandLPResponse")
+	proto.RegisterType((*MsgTransferOrderClaim)(nil), "dymensionxyz.dymension.eibc.MsgTransferOrderClaim")
+	proto.RegisterType((*MsgTransferOrderClaimResponse)(nil), "dymensionxyz.dymension.eibc.MsgTransferOrderClaimResponse")
+	proto.RegisterType((*MsgPlaceClaimSellOrder)(nil), "dymensionxyz.dymension.eibc.MsgPlaceClaimSellOrder")
+	proto.RegisterType((*MsgPlaceClaimSellOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgPlaceClaimSellOrderResponse")
+	proto.RegisterType((*MsgCancelClaimSellOrder)(nil), "dymensionxyz.dymension.eibc.MsgCancelClaimSellOrder")
+	proto.RegisterType((*MsgCancelClaimSellOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgCancelClaimSellOrderResponse")
+	proto.RegisterType((*MsgPurchaseClaim)(nil), "dymensionxyz.dymension.eibc.MsgPurchaseClaim")
+	proto.RegisterType((*MsgPurchaseClaimResponse)(nil), "dymensionxyz.dymension.eibc.MsgPurchaseClaimResponse")
+	proto.RegisterType((*MsgPlaceClaimBuyOrder)(nil), "dymensionxyz.dymension.eibc.MsgPlaceClaimBuyOrder")
+	proto.RegisterType((*MsgPlaceClaimBuyOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgPlaceClaimBuyOrderResponse")
+	proto.RegisterType((*MsgCancelClaimBuyOrder)(nil), "dymensionxyz.dymension.eibc.MsgCancelClaimBuyOrder")
+	proto.RegisterType((*MsgCancelClaimBuyOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgCancelClaimBuyOrderResponse")
+	proto.RegisterType((*MsgAcceptClaimBuyOrder)(nil), "dymensionxyz.dymension.eibc.MsgAcceptClaimBuyOrder")
+	proto.RegisterType((*MsgAcceptClaimBuyOrderResponse)(nil), "dymensionxyz.dymension.eibc.MsgAcceptClaimBuyOrderResponse")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/eibc/tx.proto", fileDescriptor_47537f11f512b254)
+}
+
+var fileDescriptor_47537f11f512b254 = []byte{
+	// 1518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0xdf, 0x6f, 0xd3, 0xd6,
+	0x17, 0xaf, 0x9b, 0xb4, 0x34, 0xa7, 0x05, 0x82, 0x09, 0x25, 0x75, 0x69, 0x0a, 0xe1, 0xfb, 0xd5,
+	0x2a, 0x58, 0x13, 0xda, 0x02, 0x63, 0x29, 0x42, 0xea, 0x0f, 0x55, 0x94, 0x51, 0x2d, 0x32, 0x03,
+	0x69, 0xd3, 0xa6, 0xe8, 0xd6, 0xbe, 0x4d, 0x2d, 0xfc, 0x4b, 0xb6, 0xd3, 0x36, 0x3c, 0x4c, 0x68,
+	0x93, 0x26, 0x4d, 0x9b, 0xb4, 0x69, 0x9a, 0xb4, 0x3f, 0x60, 0x6f, 0x68, 0x0f, 0x3c, 0xf0, 0x3f,
+	0x8c, 0xa7, 0x09, 0xf1, 0x34, 0xed, 0x01, 0x10, 0x3c, 0xb0, 0x7f, 0x61, 0x6f, 0x93, 0x7d, 0xaf,
+	0x6f, 0x6c, 0xc7, 0x71, 0xea, 0x4c, 0xe2, 0x29, 0xb9, 0xbe, 0xe7, 0x73, 0xce, 0xe7, 0x7c, 0xee,
+	0xb9, 0xf7, 0x1e, 0x1b, 0xfe, 0x27, 0xb7, 0x35, 0xac, 0xdb, 0x8a, 0xa1, 0x1f, 0xb4, 0x1f, 0x54,
+	0xd9, 0xa0, 0x8a, 0x95, 0x6d, 0xa9, 0xea, 0x1c, 0x54, 0x4c, 0xcb, 0x70, 0x0c, 0x7e, 0x3a, 0x68,
+	0x55, 0x61, 0x83, 0x8a, 0x6b, 0x25, 0x9c, 0x96, 0x0c, 0x5b, 0x33, 0xec, 0xaa, 0x66, 0x37, 0xab,
+	0x7b, 0x0b, 0xee, 0x0f, 0x41, 0x09, 0x53, 0x64, 0xa2, 0xe1, 0x8d, 0xaa, 0x64, 0x40, 0xa7, 0x0a,
+	0x4d, 0xa3, 0x69, 0x90, 0xe7, 0xee, 0x3f, 0xfa, 0xb4, 0x44, 0x3d, 0x6d, 0x23, 0x1b, 0x57, 0xf7,
+	0x16, 0xb6, 0xb1, 0x83, 0x16, 0xaa, 0x92, 0xa1, 0xe8, 0x74, 0xbe, 0x92, 0x44, 0x56, 0xc6, 0x1a,
+	0xd2, 0xe5, 0x86, 0x61, 0xc9, 0xd8, 0xa2, 0xf6, 0x89, 0xc9, 0xa9, 0x26, 0xb5, 0x9a, 0x4b, 0xb2,
+	0x32, 0x91, 0x85, 0x34, 0xca, 0xba, 0xfc, 0x2b, 0x07, 0xc7, 0xb7, 0xec, 0xe6, 0x5d, 0x53, 0x46,
+	0x0e, 0xae, 0x7b, 0x33, 0xfc, 0x55, 0xc8, 0xa1, 0x96, 0xb3, 0x6b, 0x58, 0x8a, 0xd3, 0x2e, 0x72,
+	0x67, 0xb9, 0xb9, 0xdc, 0x6a, 0xf1, 0xf9, 0x93, 0xf9, 0x02, 0x4d, 0x77, 0x45, 0x96, 0x2d, 0x6c,
+	0xdb, 0x77, 0x1c, 0x4b, 0xd1, 0x9b, 0x62, 0xc7, 0x94, 0xbf, 0x09, 0xa0, 0xe3, 0xfd, 0x06, 0xf1,
+	0x5f, 0x1c, 0x3e, 0xcb, 0xcd, 0x8d, 0x2f, 0x9e, 0xaf, 0x24, 0xe8, 0x5c, 0x21, 0x01, 0x57, 0xb3,
+	0x4f, 0x5f, 0xcc, 0x0e, 0x89, 0x39, 0x1d, 0xef, 0x93, 0x07, 0xb5, 0x63, 0x5f, 0xbd, 0x7d, 0x7c,
+	0xa1, 0xe3, 0xb9, 0x3c, 0x05, 0xa7, 0x23, 0x24, 0x45, 0x6c, 0x9b, 0x86, 0x6e, 0xe3, 0xf2, 0xcf,
+	0x24, 0x81, 0x8d, 0x96, 0xba, 0xa3, 0xa8, 0xea, 0xc7, 0xae, 0x54, 0xfc, 0x45, 0x38, 0xb1, 0x43,
+	0xc6, 0xd8, 0x6a, 0x20, 0x42, 0x97, 0x24, 0x22, 0xe6, 0xd9, 0x04, 0x4d, 0x83, 0x9f, 0x82, 0x31,
+	0x4f, 0xe0, 0x86, 0x22, 0x7b, 0x9c, 0x73, 0xe2, 0x11, 0x6f, 0xbc, 0x29, 0xf3, 0xe7, 0x60, 0x02,
+	0x1f, 0x98, 0x58, 0x72, 0xb0, 0xdc, 0xd8, 0xc1, 0xb8, 0x98, 0xf1, 0xa6, 0xc7, 0xfd, 0x67, 0x1b,
+	0x18, 0xd7, 0x26, 0x5d, 0xa6, 0xdd, 0xd1, 0x28, 0xe3, 0x20, 0x2b, 0xc6, 0xf8, 0x15, 0x07, 0x93,
+	0x91, 0xb9, 0x3a, 0xb2, 0x1c, 0x05, 0xa9, 0xef, 0x90, 0x38, 0xbf, 0x06, 0xa3, 0x48, 0x33, 0x5a,
+	0xba, 0x53, 0xcc, 0x7a, 0x2b, 0x7c, 0xd1, 0x5d, 0x83, 0xbf, 0x5e, 0xcc, 0x9e, 0x22, 0xab, 0x6c,
+	0xcb, 0xf7, 0x2b, 0x8a, 0x51, 0xd5, 0x90, 0xb3, 0x5b, 0xd9, 0xd4, 0x9d, 0xe7, 0x4f, 0xe6, 0x81,
+	0x2e, 0xff, 0xa6, 0xee, 0x88, 0x14, 0xda, 0x33, 0xfb, 0x1b, 0x50, 0x8a, 0xcf, 0xd0, 0x17, 0x81,
+	0x3f, 0x03, 0x39, 0x1f, 0x26, 0x7b, 0x19, 0x8e, 0x89, 0x9d, 0x07, 0xe5, 0xbf, 0xb9, 0x2e, 0xf9,
+	0x56, 0x54, 0x67, 0x1d, 0xeb, 0x86, 0xf6, 0x2e, 0x35, 0xba, 0x05, 0xa0, 0xa1, 0x83, 0xc6, 0xe0,
+	0x3a, 0xe5, 0x34, 0x74, 0xb0, 0x92, 0x2c, 0xd5, 0x3d, 0x98, 0xed, 0x91, 0x29, 0xd3, 0x6a, 0x09,
+	0xb2, 0x26, 0x52, 0x88, 0x4c, 0xe3, 0x8b, 0x53, 0x15, 0x1a, 0xc0, 0x3d, 0x52, 0x2a, 0xf4, 0x48,
+	0xa9, 0xac, 0x19, 0x8a, 0x4e, 0xf7, 0x91, 0x67, 0x5c, 0xfe, 0x23, 0x0b, 0x53, 0x51, 0xc7, 0x64,
+	0x3f, 0x3d, 0xc0, 0x72, 0x48, 0x17, 0x2e, 0xac, 0xcb, 0x0c, 0x80, 0x65, 0xa8, 0x2a, 0x32, 0xcd,
+	0x8e, 0x68, 0x39, 0xfa, 0x64, 0x53, 0xe6, 0x11, 0x8c, 0x98, 0x96, 0x22, 0xb9, 0x7a, 0x65, 0x92,
+	0xd9, 0x5c, 0x72, 0xd9, 0x3c, 0x7a, 0x39, 0x3b, 0xd7, 0x54, 0x9c, 0xdd, 0xd6, 0x76, 0x45, 0x32,
+	0x34, 0x7a, 0x62, 0xd2, 0x9f, 0x79, 0x5b, 0xbe, 0x5f, 0x75, 0xda, 0x26, 0xb6, 0x3d, 0x80, 0x2d,
+	0x12, 0xcf, 0xfc, 0xe7, 0x91, 0xd2, 0x5c, 0x4f, 0x94, 0xfc, 0xd1, 0xcb, 0x54, 0x35, 0xeb, 0xe6,
+	0xa7, 0x9a, 0xac, 0x70, 0x46, 0x48, 0x7e, 0xaa, 0xe9, 0x57, 0xcc, 0x25, 0x28, 0x18, 0x26, 0xb6,
+	0x90, 0x63, 0x58, 0x6e, 0x59, 0x30, 0xc3, 0x51, 0xcf, 0x90, 0xf7, 0xe7, 0x36, 0x30, 0xf6, 0x11,
+	0xd1, 0x42, 0x3a, 0xd2, 0x5d, 0x48, 0x5f, 0x02, 0x1f, 0x72, 0x6a, 0xef, 0x22, 0x0b, 0x17, 0xc7,
+	0xbc, 0xec, 0xea, 0x34, 0xbb, 0xe9, 0xee, 0x24, 0x6e, 0xe3, 0x26, 0x92, 0xda, 0xeb, 0x58, 0x7a,
+	0xf4, 0x32, 0x71, 0x3a, 0x90, 0xe9, 0x3a, 0x96, 0xc4, 0x7c, 0x80, 0xe4, 0x1d, 0x37, 0x12, 0xbf,
+	0x00, 0x05, 0x1b, 0x3b, 0x8e, 0x8a, 0x35, 0xac, 0x3b, 0x8d, 0x3d, 0xa4, 0x2a, 0xee, 0x49, 0x2a,
+	0x17, 0x73, 0xde, 0xc6, 0x3b, 0xd9, 0x99, 0xbb, 0xe7, 0x4f, 0xd5, 0x8e, 0xbb, 0xf5, 0x1a, 0x50,
+	0xaa, 0x7c, 0x1e, 0xce, 0xf5, 0xac, 0x27, 0x76, 0xb6, 0xfd, 0xce, 0x41, 0x81, 0x9d, 0xd4, 0xeb,
+	0xde, 0xf5, 0x45, 0x8e, 0xe4, 0xf3, 0x70, 0xd4, 0xd8, 0xd7, 0xbb, 0x76, 0xec, 0x84, 0xf7, 0xf0,
+	0x10, 0xbb, 0xf5, 0x34, 0x1c, 0x71, 0xef, 0x96, 0xce, 0x46, 0x1d, 0xd5, 0xf1, 0xbe, 0x2b, 0xed,
+	0x2a, 0xe4, 0x5c, 0x45, 0xa5, 0x96, 0xb5, 0x87, 0xbd, 0x7a, 0x19, 0x5f, 0xfc, 0x7f, 0xe2, 0x9d,
+	0xb3, 0x81, 0xf1, 0x9a, 0x6b, 0x2c, 0x8e, 0xed, 0xd0, 0x7f, 0x35, 0xde, 0xcd, 0x35, 0xcc, 0xaf,
+	0x5c, 0x82, 0x33, 0x71, 0x89, 0xb0, 0x4c, 0xbf, 0x80, 0x53, 0x5b, 0x76, 0xf3, 0x13, 0xab, 0xed,
+	0x2b, 0xa2, 0x13, 0x2b, 0x7e, 0x12, 0x46, 0x6d, 0xa5, 0xa9, 0x63, 0x8b, 0xa6, 0x40, 0x47, 0x09,
+	0x5b, 0xae, 0x36, 0xee, 0xc6, 0xa7, 0x76, 0xb7, 0xb2, 0x63, 0x99, 0x7c, 0xb6, 0x3c, 0x0b, 0x33,
+	0xb1, 0xee, 0x59, 0x7c, 0x1b, 0x4e, 0x6e, 0xd9, 0xcd, 0x35, 0x0b, 0x23, 0x07, 0xfb, 0x93, 0xb7,
+	0xeb, 0x81, 0xe8, 0x99, 0x50, 0xf4, 0x0f, 0x60, 0x58, 0x35, 0xe9, 0x9d, 0xfc, 0x5e, 0xa2, 0x3e,
+	0x1d, 0x67, 0xe2, 0xb0, 0x6a, 0x86, 0xb8, 0x95, 0xe7, 0x61, 0x3a, 0x26, 0x28, 0x3b, 0xa8, 0x8e,
+	0xc1, 0x30, 0x4d, 0x2e, 0x2b, 0x0e, 0x2b, 0x72, 0xf9, 0xb6, 0xc7, 0x71, 0x1d, 0xab, 0xb8, 0x07,
+	0x47, 0x2e, 0xc4, 0x31, 0x0f, 0x19, 0x45, 0x76, 0x1b, 0x87, 0xcc, 0x5c, 0x56, 0x74, 0xff, 0x86,
+	0x83, 0xcf, 0xc0, 0x74, 0x8c, 0x37, 0x26, 0xc8, 0x1e, 0x5d, 0x10, 0xa4, 0xdb, 0x3b, 0xd8, 0xf2,
+	0x16, 0x6b, 0x4d, 0x45, 0x8a, 0xe6, 0x86, 0xdb, 0x35, 0x54, 0xb9, 0x13, 0x8e, 0x8c, 0x92, 0xaa,
+	0x6d, 0x86, 0x74, 0x32, 0x14, 0x46, 0x94, 0x74, 0xdb, 0x93, 0x9b, 0xde, 0x03, 0x4a, 0x8b, 0xcc,
+	0xb2, 0x95, 0x8a, 0xc6, 0x65, 0xc4, 0x7e, 0x20, 0xf7, 0x7d, 0x5d, 0x45, 0x12, 0xf6, 0x66, 0xee,
+	0x60, 0xbf, 0x51, 0x19, 0x80, 0xda, 0x95, 0xce, 0xf9, 0x7b, 0xa8, 0xdb, 0x80, 0x58, 0x87, 0x29,
+	0x9f, 0x85, 0x52, 0x3c, 0x21, 0xc6, 0xf9, 0x53, 0xef, 0xfe, 0x5d, 0x43, 0xba, 0x84, 0xd5, 0xff,
+	0xcc, 0x39, 0x1c, 0xfc, 0x1c, 0xcc, 0xf6, 0x70, 0xcd, 0xa2, 0xff, 0xc2, 0x41, 0xde, 0x25, 0xd8,
+	0xb2, 0xa4, 0x5d, 0x64, 0x13, 0x8e, 0x7c, 0x01, 0x46, 0xb6, 0x5b, 0x6d, 0x16, 0x96, 0x0c, 0x92,
+	0x94, 0xda, 0x80, 0x63, 0xec, 0x5c, 0x4e, 0x25, 0xd9, 0x51, 0x1f, 0x56, 0xf7, 0xa4, 0x03, 0x97,
+	0x3d, 0x09, 0x57, 0x16, 0xa0, 0x18, 0x25, 0xc6, 0x58, 0x7f, 0xc7, 0xc1, 0xa9, 0x90, 0xac, 0xab,
+	0xad, 0x36, 0x91, 0x2c, 0x35, 0xf5, 0x01, 0x17, 0x39, 0xc8, 0x94, 0x94, 0x65, 0x37, 0x19, 0x46,
+	0xf7, 0x2e, 0x4c, 0x86, 0xd7, 0x61, 0x60, 0xba, 0xa1, 0xb8, 0xa4, 0xb6, 0x62, 0xdc, 0xb2, 0xc0,
+	0xbf, 0x91, 0xfd, 0xb0, 0x22, 0x49, 0xd8, 0x74, 0xc2, 0x91, 0x07, 0xd8, 0x0f, 0x8c, 0x6c, 0x26,
+	0x48, 0xf6, 0x3a, 0xe4, 0x34, 0x45, 0xa7, 0xcb, 0x9e, 0x3d, 0x9c, 0x88, 0x63, 0x9a, 0xa2, 0xd7,
+	0x7b, 0x6d, 0x96, 0x18, 0xb6, 0x7e, 0x42, 0x8b, 0xff, 0xe4, 0x21, 0xb3, 0x65, 0x37, 0x79, 0x0b,
+	0x26, 0x42, 0xef, 0x51, 0xef, 0x27, 0x9e, 0xb3, 0x91, 0x17, 0x1a, 0xe1, 0x72, 0x1a, 0x6b, 0x76,
+	0xe4, 0x7e, 0xc3, 0x01, 0x1f, 0x73, 0x09, 0x2d, 0xf6, 0x73, 0xd6, 0x8d, 0x11, 0x6a, 0xe9, 0x31,
+	0x6c, 0x4d, 0x87, 0x78, 0x07, 0x26, 0x42, 0xef, 0x60, 0x7d, 0x93, 0x0f, 0x5a, 0x0b, 0x97, 0xd3,
+	0x58, 0x07, 0xa2, 0x7e, 0xcb, 0xc1, 0xc9, 0xb8, 0x17, 0xa9, 0xa5, 0x34, 0xfe, 0x28, 0x48, 0x58,
+	0x1e, 0x00, 0x14, 0xe0, 0xf2, 0x3d, 0x07, 0x85, 0xd8, 0x37, 0x96, 0x54, 0xc9, 0xf9, 0x28, 0xe1,
+	0xfa, 0x20, 0xa8, 0x00, 0x9d, 0x9f, 0x38, 0x98, 0xec, 0xd1, 0xfd, 0x5f, 0x4d, 0xe5, 0x9a, 0xe1,
+	0x84, 0x1b, 0x83, 0xe1, 0x02, 0xa4, 0xbe, 0xe6, 0xe0, 0x44, 0x77, 0x73, 0xb8, 0x70, 0xb8, 0xd2,
+	0x0f, 0x40, 0x84, 0x0f, 0x53, 0x43, 0x02, 0x2c, 0x1e, 0x72, 0x90, 0xef, 0xea, 0x9c, 0x2e, 0xf5,
+	0xf3, 0x18, 0x45, 0x08, 0xd7, 0xd2, 0x22, 0x22, 0x14, 0xba, 0x1a, 0xa3, 0xbe, 0x14, 0xa2, 0x08,
+	0xe1, 0x5a, 0x5a, 0x44, 0x80, 0x02, 0x39, 0x3a, 0xba, 0xda, 0xa5, 0x43, 0x1c, 0x1d, 0x51, 0x8c,
+	0x50, 0x4b, 0x8f, 0x89, 0x6c, 0xe2, 0xb8, 0xee, 0xa8, 0xef, 0x26, 0x8e, 0x01, 0x09, 0xcb, 0x03,
+	0x80, 0x22, 0x9b, 0x38, 0xb6, 0xed, 0xe9, 0xbb, 0x89, 0xe3, 0x50, 0xc2, 0xf5, 0x41, 0x50, 0x01,
+	0x3a, 0xfb, 0x70, 0x34, 0xdc, 0x05, 0xcd, 0xf7, 0x4d, 0x2f, 0x68, 0x2e, 0x5c, 0x49, 0x65, 0x1e,
+	0x29, 0x8e, 0x98, 0x4e, 0x66, 0xf1, 0xf0, 0xea, 0xfa, 0x18, 0xa1, 0x96, 0x1e, 0x13, 0x29, 0x8e,
+	0xb8, 0x26, 0x65, 0x29, 0x85, 0xb2, 0x8c, 0xca, 0xf2, 0x00, 0xa0, 0x08, 0x97, 0xb8, 0xb6, 0xa5,
+	0x2f, 0x97, 0x18, 0x90, 0xb0, 0x3c, 0x00, 0xa8, 0xc3, 0x45, 0x18, 0x79, 0xf8, 0xf6, 0xf1, 0x05,
+	0x6e, 0xf5, 0xa3, 0xa7, 0xaf, 0x4b, 0xdc, 0xb3, 0xd7, 0x25, 0xee, 0xd5, 0xeb, 0x12, 0xf7, 0xe3,
+	0x9b, 0xd2, 0xd0, 0xb3, 0x37, 0xa5, 0xa1, 0x3f, 0xdf, 0x94, 0x86, 0x3e, 0x5b, 0x08, 0x7c, 0x76,
+	0xe9, 0xf1, 0x39, 0x78, 0x6f, 0xa9, 0x7a, 0x40, 0x3f, 0x8b, 0xb7, 0x4d, 0x6c, 0x6f, 0x8f, 0x7a,
+	0xdf, 0x84, 0x97, 0xfe, 0x1d, 0x00, 0x94, 0x02, 0x53, 0xde, 0x42, 0x17, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams is used for updating module params.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	TryFulfillOnDemand(ctx context.Context, in *MsgTryFulfillOnDemand, opts ...grpc.CallOption) (*MsgTryFulfillOnDemandResponse, error)
+	FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error)
+	FulfillOrderPartial(ctx context.Context, in *MsgFulfillOrderPartial, opts ...grpc.CallOption) (*MsgFulfillOrderPartialResponse, error)
+	FulfillOrderAltDenom(ctx context.Context, in *MsgFulfillOrderAltDenom, opts ...grpc.CallOption) (*MsgFulfillOrderAltDenomResponse, error)
+	FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error)
+	UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error)
+	CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error)
+	DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandLP, opts ...grpc.CallOption) (*MsgDeleteOnDemandLPResponse, error)
+	// TransferOrderClaim hands the claim on a fulfilled order to another account.
+	TransferOrderClaim(ctx context.Context, in *MsgTransferOrderClaim, opts ...grpc.CallOption) (*MsgTransferOrderClaimResponse, error)
+	// PlaceClaimSellOrder lists the claim on a fulfilled order for sale.
+	PlaceClaimSellOrder(ctx context.Context, in *MsgPlaceClaimSellOrder, opts ...grpc.CallOption) (*MsgPlaceClaimSellOrderResponse, error)
+	CancelClaimSellOrder(ctx context.Context, in *MsgCancelClaimSellOrder, opts ...grpc.CallOption) (*MsgCancelClaimSellOrderResponse, error)
+	// PurchaseClaim buys a claim listed for sale.
+	PurchaseClaim(ctx context.Context, in *MsgPurchaseClaim, opts ...grpc.CallOption) (*MsgPurchaseClaimResponse, error)
+	// PlaceClaimBuyOrder offers to buy the claim on a fulfilled order.
+	PlaceClaimBuyOrder(ctx context.Context, in *MsgPlaceClaimBuyOrder, opts ...grpc.CallOption) (*MsgPlaceClaimBuyOrderResponse, error)
+	CancelClaimBuyOrder(ctx context.Context, in *MsgCancelClaimBuyOrder, opts ...grpc.CallOption) (*MsgCancelClaimBuyOrderResponse, error)
+	// AcceptClaimBuyOrder sells the claim to the account which made the offer.
+	AcceptClaimBuyOrder(ctx context.Context, in *MsgAcceptClaimBuyOrder, opts ...grpc.CallOption) (*MsgAcceptClaimBuyOrderResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateOnDemandLPResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) TryFulfillOnDemand(ctx context.Context, in *MsgTryFulfillOnDemand, opts ...grpc.CallOption) (*MsgTryFulfillOnDemandResponse, error) {
+	out := new(MsgTryFulfillOnDemandResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/TryFulfillOnDemand", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateOnDemandLPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Id != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+func (c *msgClient) FulfillOrder(ctx context.Context, in *MsgFulfillOrder, opts ...grpc.CallOption) (*MsgFulfillOrderResponse, error) {
+	out := new(MsgFulfillOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteOnDemandLP) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FulfillOrderPartial(ctx context.Context, in *MsgFulfillOrderPartial, opts ...grpc.CallOption) (*MsgFulfillOrderPartialResponse, error) {
+	out := new(MsgFulfillOrderPartialResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderPartial", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) FulfillOrderAltDenom(ctx context.Context, in *MsgFulfillOrderAltDenom, opts ...grpc.CallOption) (*MsgFulfillOrderAltDenomResponse, error) {
+	out := new(MsgFulfillOrderAltDenomResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAltDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA4 := make([]byte, len(m.Ids)*10)
-		var j3 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
-		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintTx(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) FulfillOrderAuthorized(ctx context.Context, in *MsgFulfillOrderAuthorized, opts ...grpc.CallOption) (*MsgFulfillOrderAuthorizedResponse, error) {
+	out := new(MsgFulfillOrderAuthorizedResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgDeleteOnDemandLPResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateDemandOrder(ctx context.Context, in *MsgUpdateDemandOrder, opts ...grpc.CallOption) (*MsgUpdateDemandOrderResponse, error) {
+	out := new(MsgUpdateDemandOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgDeleteOnDemandLPResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CreateOnDemandLP(ctx context.Context, in *MsgCreateOnDemandLP, opts ...grpc.CallOption) (*MsgCreateOnDemandLPResponse, error) {
+	out := new(MsgCreateOnDemandLPResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/CreateOnDemandLP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgDeleteOnDemandLPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) DeleteOnDemandLP(ctx context.Context, in *MsgDeleteOnDemandLP, opts ...grpc.CallOption) (*MsgDeleteOnDemandLPResponse, error) {
+	out := new(MsgDeleteOnDemandLPResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/DeleteOnDemandLP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *msgClient) TransferOrderClaim(ctx context.Context, in *MsgTransferOrderClaim, opts ...grpc.CallOption) (*MsgTransferOrderClaimResponse, error) {
+	out := new(MsgTransferOrderClaimResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/TransferOrderClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+func (c *msgClient) PlaceClaimSellOrder(ctx context.Context, in *MsgPlaceClaimSellOrder, opts ...grpc.CallOption) (*MsgPlaceClaimSellOrderResponse, error) {
+	out := new(MsgPlaceClaimSellOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/PlaceClaimSellOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.NewParams.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CancelClaimSellOrder(ctx context.Context, in *MsgCancelClaimSellOrder, opts ...grpc.CallOption) (*MsgCancelClaimSellOrderResponse, error) {
+	out := new(MsgCancelClaimSellOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/CancelClaimSellOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgFulfillOrder) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FulfillerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExpectedFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (c *msgClient) PurchaseClaim(ctx context.Context, in *MsgPurchaseClaim, opts ...grpc.CallOption) (*MsgPurchaseClaimResponse, error) {
+	out := new(MsgPurchaseClaimResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/PurchaseClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgFulfillOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) PlaceClaimBuyOrder(ctx context.Context, in *MsgPlaceClaimBuyOrder, opts ...grpc.CallOption) (*MsgPlaceClaimBuyOrderResponse, error) {
+	out := new(MsgPlaceClaimBuyOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/PlaceClaimBuyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgFulfillOrderAuthorized) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Price) > 0 {
-		for _, e := range m.Price {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.LpAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorFeeAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ExpectedFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.OperatorFeeShare.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.SettlementValidated {
-		n += 2
+func (c *msgClient) CancelClaimBuyOrder(ctx context.Context, in *MsgCancelClaimBuyOrder, opts ...grpc.CallOption) (*MsgCancelClaimBuyOrderResponse, error) {
+	out := new(MsgCancelClaimBuyOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/CancelClaimBuyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgFulfillOrderAuthorizedResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) AcceptClaimBuyOrder(ctx context.Context, in *MsgAcceptClaimBuyOrder, opts ...grpc.CallOption) (*MsgAcceptClaimBuyOrderResponse, error) {
+	out := new(MsgAcceptClaimBuyOrderResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.eibc.Msg/AcceptClaimBuyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *MsgUpdateDemandOrder) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams is used for updating module params.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	TryFulfillOnDemand(context.Context, *MsgTryFulfillOnDemand) (*MsgTryFulfillOnDemandResponse, error)
+	FulfillOrder(context.Context, *MsgFulfillOrder) (*MsgFulfillOrderResponse, error)
+	FulfillOrderPartial(context.Context, *MsgFulfillOrderPartial) (*MsgFulfillOrderPartialResponse, error)
+	FulfillOrderAltDenom(context.Context, *MsgFulfillOrderAltDenom) (*MsgFulfillOrderAltDenomResponse, error)
+	FulfillOrderAuthorized(context.Context, *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error)
+	UpdateDemandOrder(context.Context, *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error)
+	CreateOnDemandLP(context.Context, *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error)
+	DeleteOnDemandLP(context.Context, *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error)
+	// TransferOrderClaim hands the claim on a fulfilled order to another account.
+	TransferOrderClaim(context.Context, *MsgTransferOrderClaim) (*MsgTransferOrderClaimResponse, error)
+	// PlaceClaimSellOrder lists the claim on a fulfilled order for sale.
+	PlaceClaimSellOrder(context.Context, *MsgPlaceClaimSellOrder) (*MsgPlaceClaimSellOrderResponse, error)
+	CancelClaimSellOrder(context.Context, *MsgCancelClaimSellOrder) (*MsgCancelClaimSellOrderResponse, error)
+	// PurchaseClaim buys a claim listed for sale.
+	PurchaseClaim(context.Context, *MsgPurchaseClaim) (*MsgPurchaseClaimResponse, error)
+	// PlaceClaimBuyOrder offers to buy the claim on a fulfilled order.
+	PlaceClaimBuyOrder(context.Context, *MsgPlaceClaimBuyOrder) (*MsgPlaceClaimBuyOrderResponse, error)
+	CancelClaimBuyOrder(context.Context, *MsgCancelClaimBuyOrder) (*MsgCancelClaimBuyOrderResponse, error)
+	// AcceptClaimBuyOrder sells the claim to the account which made the offer.
+	AcceptClaimBuyOrder(context.Context, *MsgAcceptClaimBuyOrder) (*MsgAcceptClaimBuyOrderResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) TryFulfillOnDemand(ctx context.Context, req *MsgTryFulfillOnDemand) (*MsgTryFulfillOnDemandResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TryFulfillOnDemand not implemented")
+}
+func (*UnimplementedMsgServer) FulfillOrder(ctx context.Context, req *MsgFulfillOrder) (*MsgFulfillOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrder not implemented")
+}
+func (*UnimplementedMsgServer) FulfillOrderPartial(ctx context.Context, req *MsgFulfillOrderPartial) (*MsgFulfillOrderPartialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderPartial not implemented")
+}
+func (*UnimplementedMsgServer) FulfillOrderAltDenom(ctx context.Context, req *MsgFulfillOrderAltDenom) (*MsgFulfillOrderAltDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderAltDenom not implemented")
+}
+func (*UnimplementedMsgServer) FulfillOrderAuthorized(ctx context.Context, req *MsgFulfillOrderAuthorized) (*MsgFulfillOrderAuthorizedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FulfillOrderAuthorized not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDemandOrder(ctx context.Context, req *MsgUpdateDemandOrder) (*MsgUpdateDemandOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDemandOrder not implemented")
+}
+func (*UnimplementedMsgServer) CreateOnDemandLP(ctx context.Context, req *MsgCreateOnDemandLP) (*MsgCreateOnDemandLPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOnDemandLP not implemented")
+}
+func (*UnimplementedMsgServer) DeleteOnDemandLP(ctx context.Context, req *MsgDeleteOnDemandLP) (*MsgDeleteOnDemandLPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOnDemandLP not implemented")
+}
+func (*UnimplementedMsgServer) TransferOrderClaim(ctx context.Context, req *MsgTransferOrderClaim) (*MsgTransferOrderClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferOrderClaim not implemented")
+}
+func (*UnimplementedMsgServer) PlaceClaimSellOrder(ctx context.Context, req *MsgPlaceClaimSellOrder) (*MsgPlaceClaimSellOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceClaimSellOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelClaimSellOrder(ctx context.Context, req *MsgCancelClaimSellOrder) (*MsgCancelClaimSellOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelClaimSellOrder not implemented")
+}
+func (*UnimplementedMsgServer) PurchaseClaim(ctx context.Context, req *MsgPurchaseClaim) (*MsgPurchaseClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PurchaseClaim not implemented")
+}
+func (*UnimplementedMsgServer) PlaceClaimBuyOrder(ctx context.Context, req *MsgPlaceClaimBuyOrder) (*MsgPlaceClaimBuyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlaceClaimBuyOrder not implemented")
+}
+func (*UnimplementedMsgServer) CancelClaimBuyOrder(ctx context.Context, req *MsgCancelClaimBuyOrder) (*MsgCancelClaimBuyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelClaimBuyOrder not implemented")
+}
+func (*UnimplementedMsgServer) AcceptClaimBuyOrder(ctx context.Context, req *MsgAcceptClaimBuyOrder) (*MsgAcceptClaimBuyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptClaimBuyOrder not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateParams",
 	}
-	l = len(m.NewFee)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateDemandOrderResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_TryFulfillOnDemand_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTryFulfillOnDemand)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).TryFulfillOnDemand(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/TryFulfillOnDemand",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TryFulfillOnDemand(ctx, req.(*MsgTryFulfillOnDemand))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTryFulfillOnDemand) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FulfillOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrder)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.OrderId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrder(ctx, in)
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrder",
 	}
-	if m.Rng != 0 {
-		n += 1 + sovTx(uint64(m.Rng))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrder(ctx, req.(*MsgFulfillOrder))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgTryFulfillOnDemandResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FulfillOrderPartial_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrderPartial)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCreateOnDemandLP) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrderPartial(ctx, in)
 	}
-	var l int
-	_ = l
-	if m.Lp != nil {
-		l = m.Lp.Size()
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderPartial",
 	}
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrderPartial(ctx, req.(*MsgFulfillOrderPartial))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateOnDemandLPResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FulfillOrderAltDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrderAltDenom)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovTx(uint64(m.Id))
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrderAltDenom(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAltDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrderAltDenom(ctx, req.(*MsgFulfillOrderAltDenom))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteOnDemandLP) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_FulfillOrderAuthorized_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFulfillOrderAuthorized)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).FulfillOrderAuthorized(ctx, in)
 	}
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/FulfillOrderAuthorized",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FulfillOrderAuthorized(ctx, req.(*MsgFulfillOrderAuthorized))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDeleteOnDemandLPResponse) Size() (n int) {
-	if m == nil {
+func _Msg_UpdateDemandOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDemandOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDemandOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/UpdateDemandOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDemandOrder(ctx, req.(*MsgUpdateDemandOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateOnDemandLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOnDemandLP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOnDemandLP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/CreateOnDemandLP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOnDemandLP(ctx, req.(*MsgCreateOnDemandLP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteOnDemandLP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteOnDemandLP)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteOnDemandLP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/DeleteOnDemandLP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteOnDemandLP(ctx, req.(*MsgDeleteOnDemandLP))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferOrderClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferOrderClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferOrderClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/TransferOrderClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferOrderClaim(ctx, req.(*MsgTransferOrderClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceClaimSellOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceClaimSellOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceClaimSellOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/PlaceClaimSellOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceClaimSellOrder(ctx, req.(*MsgPlaceClaimSellOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelClaimSellOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelClaimSellOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelClaimSellOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/CancelClaimSellOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelClaimSellOrder(ctx, req.(*MsgCancelClaimSellOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PurchaseClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPurchaseClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PurchaseClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/PurchaseClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PurchaseClaim(ctx, req.(*MsgPurchaseClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PlaceClaimBuyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPlaceClaimBuyOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PlaceClaimBuyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/PlaceClaimBuyOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PlaceClaimBuyOrder(ctx, req.(*MsgPlaceClaimBuyOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelClaimBuyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelClaimBuyOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelClaimBuyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/CancelClaimBuyOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelClaimBuyOrder(ctx, req.(*MsgCancelClaimBuyOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptClaimBuyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptClaimBuyOrder)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptClaimBuyOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.eibc.Msg/AcceptClaimBuyOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptClaimBuyOrder(ctx, req.(*MsgAcceptClaimBuyOrder))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.eibc.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "TryFulfillOnDemand",
+			Handler:    _Msg_TryFulfillOnDemand_Handler,
+		},
+		{
+			MethodName: "FulfillOrder",
+			Handler:    _Msg_FulfillOrder_Handler,
+		},
+		{
+			MethodName: "FulfillOrderPartial",
+			Handler:    _Msg_FulfillOrderPartial_Handler,
+		},
+		{
+			MethodName: "FulfillOrderAltDenom",
+			Handler:    _Msg_FulfillOrderAltDenom_Handler,
+		},
+		{
+			MethodName: "FulfillOrderAuthorized",
+			Handler:    _Msg_FulfillOrderAuthorized_Handler,
+		},
+		{
+			MethodName: "UpdateDemandOrder",
+			Handler:    _Msg_UpdateDemandOrder_Handler,
+		},
+		{
+			MethodName: "CreateOnDemandLP",
+			Handler:    _Msg_CreateOnDemandLP_Handler,
+		},
+		{
+			MethodName: "DeleteOnDemandLP",
+			Handler:    _Msg_DeleteOnDemandLP_Handler,
+		},
+		{
+			MethodName: "TransferOrderClaim",
+			Handler:    _Msg_TransferOrderClaim_Handler,
+		},
+		{
+			MethodName: "PlaceClaimSellOrder",
+			Handler:    _Msg_PlaceClaimSellOrder_Handler,
+		},
+		{
+			MethodName: "CancelClaimSellOrder",
+			Handler:    _Msg_CancelClaimSellOrder_Handler,
+		},
+		{
+			MethodName: "PurchaseClaim",
+			Handler:    _Msg_PurchaseClaim_Handler,
+		},
+		{
+			MethodName: "PlaceClaimBuyOrder",
+			Handler:    _Msg_PlaceClaimBuyOrder_Handler,
+		},
+		{
+			MethodName: "CancelClaimBuyOrder",
+			Handler:    _Msg_CancelClaimBuyOrder_Handler,
+		},
+		{
+			MethodName: "AcceptClaimBuyOrder",
+			Handler:    _Msg_AcceptClaimBuyOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/eibc/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderPartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderPartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderPartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderPartialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderPartialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderPartialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Fulfilled {
+		i--
+		if m.Fulfilled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderAltDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderAltDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderAltDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmount.Size()
+		i -= size
+		if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FulfillerAddress) > 0 {
+		i -= len(m.FulfillerAddress)
+		copy(dAtA[i:], m.FulfillerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FulfillerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderAltDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderAltDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderAltDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Paid.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderAuthorized) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderAuthorized) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderAuthorized) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SettlementValidated {
+		i--
+		if m.SettlementValidated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.OperatorFeeShare.Size()
+		i -= size
+		if _, err := m.OperatorFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.ExpectedFee) > 0 {
+		i -= len(m.ExpectedFee)
+		copy(dAtA[i:], m.ExpectedFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpectedFee)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.OperatorFeeAddress) > 0 {
+		i -= len(m.OperatorFeeAddress)
+		copy(dAtA[i:], m.OperatorFeeAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorFeeAddress)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.LpAddress) > 0 {
+		i -= len(m.LpAddress)
+		copy(dAtA[i:], m.LpAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.LpAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Price) > 0 {
+		for iNdEx := len(m.Price) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Price[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDemandOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDemandOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDemandOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FeeCurve != nil {
+		{
+			size, err := m.FeeCurve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewFee) > 0 {
+		i -= len(m.NewFee)
+		copy(dAtA[i:], m.NewFee)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewFee)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDemandOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDemandOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDemandOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTryFulfillOnDemand) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTryFulfillOnDemand) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTryFulfillOnDemand) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTryFulfillOnDemandResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTryFulfillOnDemandResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTryFulfillOnDemandResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOnDemandLP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Lp != nil {
+		{
+			size, err := m.Lp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOnDemandLPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOnDemandLPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOnDemandLPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteOnDemandLP) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOnDemandLP) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOnDemandLP) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA6 := make([]byte, len(m.Ids)*10)
+		var j5 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteOnDemandLPResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteOnDemandLPResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteOnDemandLPResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOrderClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOrderClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOrderClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewHolder) > 0 {
+		i -= len(m.NewHolder)
+		copy(dAtA[i:], m.NewHolder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewHolder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferOrderClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferOrderClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferOrderClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceClaimSellOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceClaimSellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceClaimSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceClaimSellOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceClaimSellOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceClaimSellOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelClaimSellOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelClaimSellOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelClaimSellOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelClaimSellOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelClaimSellOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelClaimSellOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ExpectedPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPurchaseClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPurchaseClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPurchaseClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceClaimBuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceClaimBuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceClaimBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPlaceClaimBuyOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPlaceClaimBuyOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPlaceClaimBuyOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelClaimBuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelClaimBuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelClaimBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelClaimBuyOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelClaimBuyOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelClaimBuyOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptClaimBuyOrder) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptClaimBuyOrder) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptClaimBuyOrder) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.MinPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OrderId) > 0 {
+		i -= len(m.OrderId)
+		copy(dAtA[i:], m.OrderId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OrderId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Holder) > 0 {
+		i -= len(m.Holder)
+		copy(dAtA[i:], m.Holder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Holder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptClaimBuyOrderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptClaimBuyOrderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptClaimBuyOrderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.NewParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFulfillOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFulfillOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFulfillOrderPartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFulfillOrderPartialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fulfilled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgFulfillOrderAltDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FulfillerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFulfillOrderAltDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Paid.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFulfillOrderAuthorized) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Price) > 0 {
+		for _, e := range m.Price {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.LpAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorFeeAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpectedFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.OperatorFeeShare.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SettlementValidated {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgFulfillOrderAuthorizedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateDemandOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewFee)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FeeCurve != nil {
+		l = m.FeeCurve.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDemandOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTryFulfillOnDemand) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTryFulfillOnDemandResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Lp != nil {
+		l = m.Lp.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateOnDemandLPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeleteOnDemandLP) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgDeleteOnDemandLPResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferOrderClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewHolder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferOrderClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceClaimSellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceClaimSellOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelClaimSellOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelClaimSellOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPurchaseClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.ExpectedPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPurchaseClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPlaceClaimBuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgPlaceClaimBuyOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelClaimBuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelClaimBuyOrderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptClaimBuyOrder) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Holder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OrderId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptClaimBuyOrderResponse) Size() (n int) {
+	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	return n
-}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderPartial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderPartialResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartialResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderPartialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fulfilled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Fulfilled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderAltDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderAltDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderAltDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderAltDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderAltDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderAltDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Price = append(m.Price, types.Coin{})
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LpAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorFeeAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OperatorFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettlementValidated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SettlementValidated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewFee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeeCurve == nil {
+				m.FeeCurve = &FeeCurve{}
+			}
+			if err := m.FeeCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTryFulfillOnDemand: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTryFulfillOnDemand: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTryFulfillOnDemandResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTryFulfillOnDemandResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTryFulfillOnDemandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1934,15 +5815,51 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateOnDemandLP: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Lp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Lp == nil {
+				m.Lp = &OnDemandLP{}
+			}
+			if err := m.Lp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1970,13 +5887,132 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOnDemandLPResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOnDemandLPResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOnDemandLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteOnDemandLP) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteOnDemandLP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1986,25 +6022,100 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.NewParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2026,7 +6137,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeleteOnDemandLPResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2049,10 +6160,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeleteOnDemandLPResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeleteOnDemandLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2076,7 +6187,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOrderClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2099,15 +6210,15 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOrderClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOrderClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FulfillerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2135,7 +6246,7 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FulfillerAddress = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2171,7 +6282,7 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewHolder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2199,7 +6310,7 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			m.NewHolder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2222,7 +6333,7 @@ func (m *MsgFulfillOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTransferOrderClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2245,10 +6356,10 @@ func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTransferOrderClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTransferOrderClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2272,7 +6383,7 @@ func (m *MsgFulfillOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceClaimSellOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2295,15 +6406,15 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceClaimSellOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorized: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceClaimSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2331,11 +6442,11 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2363,7 +6474,7 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -2394,112 +6505,113 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Price = append(m.Price, types.Coin{})
-			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPlaceClaimSellOrderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LpAddress", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPlaceClaimSellOrderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPlaceClaimSellOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LpAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelClaimSellOrder) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorFeeAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelClaimSellOrder: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelClaimSellOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2527,11 +6639,11 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExpectedFee = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorFeeShare", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2559,30 +6671,8 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.OperatorFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettlementValidated", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.SettlementValidated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2604,7 +6694,7 @@ func (m *MsgFulfillOrderAuthorized) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelClaimSellOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2627,10 +6717,10 @@ func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelClaimSellOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgFulfillOrderAuthorizedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelClaimSellOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2654,7 +6744,7 @@ func (m *MsgFulfillOrderAuthorizedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
+func (m *MsgPurchaseClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2677,15 +6767,15 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrder: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurchaseClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrder: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurchaseClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2713,7 +6803,7 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -2749,9 +6839,9 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpectedPrice", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2761,23 +6851,24 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewFee = string(dAtA[iNdEx:postIndex])
+			if err := m.ExpectedPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2800,7 +6891,7 @@ func (m *MsgUpdateDemandOrder) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPurchaseClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2823,10 +6914,10 @@ func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPurchaseClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDemandOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPurchaseClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2850,7 +6941,7 @@ func (m *MsgUpdateDemandOrderResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceClaimBuyOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2873,15 +6964,15 @@ func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTryFulfillOnDemand: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceClaimBuyOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTryFulfillOnDemand: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceClaimBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2909,11 +7000,11 @@ func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrderId = string(dAtA[iNdEx:postIndex])
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2941,13 +7032,13 @@ func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rng", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.Rng = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2957,11 +7048,25 @@ func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Rng |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2983,7 +7088,7 @@ func (m *MsgTryFulfillOnDemand) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgTryFulfillOnDemandResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPlaceClaimBuyOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3006,10 +7111,10 @@ func (m *MsgTryFulfillOnDemandResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTryFulfillOnDemandResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPlaceClaimBuyOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTryFulfillOnDemandResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPlaceClaimBuyOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3033,7 +7138,7 @@ func (m *MsgTryFulfillOnDemandResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelClaimBuyOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3056,17 +7161,17 @@ func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOnDemandLP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelClaimBuyOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelClaimBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Lp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3076,31 +7181,27 @@ func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Lp == nil {
-				m.Lp = &OnDemandLP{}
-			}
-			if err := m.Lp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3128,7 +7229,7 @@ func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.OrderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3151,7 +7252,7 @@ func (m *MsgCreateOnDemandLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateOnDemandLPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelClaimBuyOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3174,31 +7275,12 @@ func (m *MsgCreateOnDemandLPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateOnDemandLPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelClaimBuyOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateOnDemandLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelClaimBuyOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3220,7 +7302,7 @@ func (m *MsgCreateOnDemandLPResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteOnDemandLP) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptClaimBuyOrder) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3243,15 +7325,15 @@ func (m *MsgDeleteOnDemandLP) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOnDemandLP: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptClaimBuyOrder: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOnDemandLP: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptClaimBuyOrder: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Holder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3279,84 +7361,105 @@ func (m *MsgDeleteOnDemandLP) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.Holder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3378,7 +7481,7 @@ func (m *MsgDeleteOnDemandLP) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteOnDemandLPResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAcceptClaimBuyOrderResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3401,10 +7504,10 @@ func (m *MsgDeleteOnDemandLPResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteOnDemandLPResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAcceptClaimBuyOrderResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteOnDemandLPResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAcceptClaimBuyOrderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -18,6 +18,18 @@ const (
 	FlagVestingDuration                        = "vesting-duration"
 	FlagVestingStartTimeAfterSettlement        = "vesting-start-time"
 	FlagTradingDisabled                        = "trading-disabled"
+	FlagDutchAuction                           = "dutch-auction"
+	FlagFixedPrice                             = "fixed-price"
+	FlagWhitelistAddresses                     = "whitelist-addresses"
+	FlagWhitelistMerkleRoot                    = "whitelist-merkle-root"
+	FlagWhitelistCap                           = "whitelist-cap"
+	FlagWhitelistDuration                      = "whitelist-duration"
+	FlagPublicCap                              = "public-cap"
+	FlagWhitelistProof                         = "whitelist-proof"
+	FlagSoftCap                                = "soft-cap"
+	FlagSoftCapDeadline                        = "soft-cap-deadline"
+	FlagBuyerVestingCliff                      = "buyer-vesting-cliff"
+	FlagBuyerVestingDuration                   = "buyer-vesting-duration"
 )
 
 // FIXME: add plan duration
@@ -41,6 +53,26 @@ func FlagSetCreatePlan() *flag.FlagSet {
 	fs.Float64(FlagLiquidityPart, defaultLiquidityPart, "The part of the total liquidity to allocate to the plan.")
 	fs.Duration(FlagVestingDuration, defaultVestingDuration, "The duration of the vesting period.")
 	fs.Duration(FlagVestingStartTimeAfterSettlement, defaultVestingStartTime, "The start time of the vesting period after the plan is settled.")
+	fs.String(FlagDutchAuction, "", "Sell in a Dutch auction instead of on the bonding curve, in the format \"START_PRICE,END_PRICE,DURATION\".")
+	fs.String(FlagFixedPrice, "", "Sell in a fixed price sale at the given price instead of on the bonding curve.")
+	fs.String(FlagSoftCap, "", "The min amount of liquidity to raise by the soft cap deadline, refunding the buyers if missed.")
+	fs.String(FlagSoftCapDeadline, "", "The deadline of the soft cap, as a Unix timestamp or in RFC3339 format.")
+	fs.Duration(FlagBuyerVestingCliff, 0, "The cliff of the vesting of the buyer tokens after the plan is settled.")
+	fs.Duration(FlagBuyerVestingDuration, 0, "The duration of the vesting of the buyer tokens after the plan is settled. Zero for no vesting.")
+	fs.AddFlagSet(FlagSetWhitelist())
+
+	return fs
+}
+
+// FlagSetWhitelist returns flags for setting the whitelist of a plan.
+func FlagSetWhitelist() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.StringSlice(FlagWhitelistAddresses, nil, "The addresses whitelisted to buy in the whitelist phase.")
+	fs.String(FlagWhitelistMerkleRoot, "", "The hex Merkle root of the addresses whitelisted to buy in the whitelist phase, instead of the addresses.")
+	fs.String(FlagWhitelistCap, "", "The max amount of tokens each whitelisted account can buy in the whitelist phase. Required to set a whitelist.")
+	fs.Duration(FlagWhitelistDuration, 0, "The duration of the whitelist phase from the start time.")
+	fs.String(FlagPublicCap, "0", "The max amount of tokens each account can buy in total once the sale is public. Zero for no cap.")
 
 	return fs
 }
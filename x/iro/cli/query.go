@@ -31,6 +31,11 @@ func GetQueryCmd() *cobra.Command {
 		CmdQuerySpotPrice(),
 		CmdQueryCost(),
 		CmdQueryClaimed(),
+		CmdQuerySubscription(),
+		CmdQueryRemainingCap(),
+		CmdQueryBuyerVesting(),
+		CmdQueryTrades(),
+		CmdQueryCandles(),
 	)
 
 	return iroQueryCmd
@@ -223,3 +228,128 @@ func CmdQueryClaimed() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQuerySubscription() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "subscription [plan-id] [buyer]",
+		Short: "Query the subscription of a buyer to a fixed price sale, with its allocation and refund once settled",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QuerySubscription(cmd.Context(), &types.QuerySubscriptionRequest{PlanId: args[0], Buyer: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryRemainingCap() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remaining-cap [plan-id] [account]",
+		Short: "Query how many tokens an account can still buy from an IRO plan with a whitelist",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryRemainingCap(cmd.Context(), &types.QueryRemainingCapRequest{PlanId: args[0], Account: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryBuyerVesting() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "buyer-vesting [plan-id] [account]",
+		Short: "Query the vested and claimable tokens of an account from a settled IRO plan",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryBuyerVesting(cmd.Context(), &types.QueryBuyerVestingRequest{PlanId: args[0], Account: args[1]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryTrades() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trades [plan-id]",
+		Short: "Query the last trades of an IRO plan which is not settled",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryTrades(cmd.Context(), &types.QueryTradesRequest{PlanId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryCandles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "candles [plan-id]",
+		Short: "Query the price candles of an IRO plan which is not settled",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.QueryCandles(cmd.Context(), &types.QueryCandlesRequest{PlanId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -23,6 +23,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdBuy())
 	cmd.AddCommand(CmdSell())
 	cmd.AddCommand(CmdClaim())
+	cmd.AddCommand(CmdRefund())
 
 	return cmd
 }
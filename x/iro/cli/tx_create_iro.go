@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"strconv"
@@ -46,11 +47,27 @@ Optional Flags:
                       Default: 0m
   --trading-disabled: Disables trading for the plan. Will require MsgEnableTrading to be executed later on.
                       Default: false
+  --dutch-auction   : Sells in a Dutch auction in the format "START_PRICE,END_PRICE,DURATION", the price descending
+                      linearly from the start time. The curve is only used for its decimals.
+  --fixed-price     : Sells in a fixed price sale at the given price, allocated pro rata if oversubscribed.
+                      The curve is only used for its decimals.
+  --whitelist-cap   : Sets a whitelist, the max amount of tokens each whitelisted account can buy in the whitelist phase.
+                      The accounts are set with --whitelist-addresses or --whitelist-merkle-root.
+  --whitelist-duration: The duration of the whitelist phase, after which the sale is public.
+  --public-cap      : The max amount of tokens each account of a plan with a whitelist can buy in total once public.
+                      Default: 0, no cap
+  --soft-cap        : The min liquidity to raise by --soft-cap-deadline, refunding the buyers if missed.
+  --soft-cap-deadline: The deadline of the soft cap, as a Unix timestamp or in RFC3339 format.
+  --buyer-vesting-duration: Vests the tokens of the buyers linearly over the duration after settlement.
+                      Default: 0, no vesting
+  --buyer-vesting-cliff: The duration after settlement before which no vested tokens of the buyers can be claimed.
 
 Examples:
   dymd tx iro create-iro myrollapp1 1000000000 24h --curve "1.2,0.4,0" --from mykey
   dymd tx iro create-iro myrollapp2 500000000 30m --curve "1.5,0.5,100" --start-time "2023-10-01T00:00:00Z" --incentives-start 24h --incentives-epochs 3000 --from mykey
   dymd tx iro create-iro myrollapp3 2000000000 48h --curve "1.3,0.3,50" --trading-disabled=true --from mykey
+  dymd tx iro create-iro myrollapp4 2000000000 48h --curve "0,1,1" --dutch-auction "2,0.5,24h" --from mykey
+  dymd tx iro create-iro myrollapp5 2000000000 48h --curve "1.3,0.3,0" --whitelist-addresses dym1...,dym1... --whitelist-cap 1000 --whitelist-duration 12h --from mykey
 `,
 		Args: cobra.ExactArgs(3),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -98,11 +115,7 @@ Examples:
 
 			if timeStr == "" { // empty start time
 				startTime = time.Unix(0, 0)
-			} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
-				startTime = time.Unix(timeUnix, 0)
-			} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
-				startTime = timeRFC
-			} else { // invalid input
+			} else if startTime, err = ParseTime(timeStr); err != nil {
 				return errors.New("invalid start time format")
 			}
 
@@ -153,6 +166,69 @@ Examples:
 				VestingStartTimeAfterSettlement: vestingStartTimeAfterSettlement,
 				TradingEnabled:                  !tradingDisabled,
 			}
+
+			dutchStr, err := cmd.Flags().GetString(FlagDutchAuction)
+			if err != nil {
+				return err
+			}
+			if dutchStr != "" {
+				auction, err := ParseDutchAuction(dutchStr)
+				if err != nil {
+					return err
+				}
+				msg.DutchAuction = &auction
+			}
+
+			fixedStr, err := cmd.Flags().GetString(FlagFixedPrice)
+			if err != nil {
+				return err
+			}
+			if fixedStr != "" {
+				price, err := math.LegacyNewDecFromStr(fixedStr)
+				if err != nil {
+					return fmt.Errorf("invalid fixed price: %w", err)
+				}
+				msg.FixedPriceSale = &types.FixedPriceSale{Price: price}
+			}
+
+			msg.Whitelist, err = ParseWhitelistFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			softCapStr, err := cmd.Flags().GetString(FlagSoftCap)
+			if err != nil {
+				return err
+			}
+			if softCapStr != "" {
+				amount, ok := math.NewIntFromString(softCapStr)
+				if !ok {
+					return fmt.Errorf("invalid soft cap: %s", softCapStr)
+				}
+				deadlineStr, err := cmd.Flags().GetString(FlagSoftCapDeadline)
+				if err != nil {
+					return err
+				}
+				deadline, err := ParseTime(deadlineStr)
+				if err != nil {
+					return errors.New("invalid soft cap deadline format")
+				}
+				softCap := types.NewSoftCap(amount, deadline)
+				msg.SoftCap = &softCap
+			}
+
+			buyerVestingDuration, err := cmd.Flags().GetDuration(FlagBuyerVestingDuration)
+			if err != nil {
+				return err
+			}
+			buyerVestingCliff, err := cmd.Flags().GetDuration(FlagBuyerVestingCliff)
+			if err != nil {
+				return err
+			}
+			if buyerVestingDuration != 0 || buyerVestingCliff != 0 {
+				msg.BuyerVestingPlan = &types.BuyerVestingPlan{Cliff: buyerVestingCliff, Duration: buyerVestingDuration}
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -167,6 +243,14 @@ Examples:
 	return cmd
 }
 
+// ParseTime parses a Unix timestamp or a time in RFC3339 format
+func ParseTime(s string) (time.Time, error) {
+	if timeUnix, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Unix(timeUnix, 0), nil
+	}
+	return time.Parse(time.RFC3339, s)
+}
+
 // ParseBondingCurve parses the bonding curve string into a BondingCurve struct
 // expected format: "M,N,C" for p(x) = M * x^N + C
 func ParseBondingCurve(curveStr string) (types.BondingCurve, error) {
@@ -195,3 +279,67 @@ func ParseBondingCurve(curveStr string) (types.BondingCurve, error) {
 	curve = types.NewBondingCurve(M, N, C, 18, 18)
 	return curve, curve.ValidateBasic()
 }
+
+// ParseDutchAuction parses the Dutch auction string "START_PRICE,END_PRICE,DURATION" into a DutchAuction struct
+func ParseDutchAuction(s string) (types.DutchAuction, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 3 {
+		return types.DutchAuction{}, fmt.Errorf("invalid dutch auction format: expected START_PRICE,END_PRICE,DURATION")
+	}
+	start, err := math.LegacyNewDecFromStr(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return types.DutchAuction{}, fmt.Errorf("invalid start price: %w", err)
+	}
+	end, err := math.LegacyNewDecFromStr(strings.TrimSpace(parts[1]))
+	if err != nil {
+		return types.DutchAuction{}, fmt.Errorf("invalid end price: %w", err)
+	}
+	duration, err := time.ParseDuration(strings.TrimSpace(parts[2]))
+	if err != nil {
+		return types.DutchAuction{}, fmt.Errorf("invalid duration: %w", err)
+	}
+	return types.DutchAuction{StartPrice: start, EndPrice: end, Duration: duration}, nil
+}
+
+// ParseWhitelistFlags parses the whitelist flags, returning nil if no whitelist cap is set
+func ParseWhitelistFlags(cmd *cobra.Command) (*types.Whitelist, error) {
+	capStr, err := cmd.Flags().GetString(FlagWhitelistCap)
+	if err != nil || capStr == "" {
+		return nil, err
+	}
+	limit, ok := math.NewIntFromString(capStr)
+	if !ok {
+		return nil, fmt.Errorf("invalid whitelist cap: %s", capStr)
+	}
+	publicCapStr, err := cmd.Flags().GetString(FlagPublicCap)
+	if err != nil {
+		return nil, err
+	}
+	publicCap, ok := math.NewIntFromString(publicCapStr)
+	if !ok {
+		return nil, fmt.Errorf("invalid public cap: %s", publicCapStr)
+	}
+	duration, err := cmd.Flags().GetDuration(FlagWhitelistDuration)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := cmd.Flags().GetStringSlice(FlagWhitelistAddresses)
+	if err != nil {
+		return nil, err
+	}
+	rootStr, err := cmd.Flags().GetString(FlagWhitelistMerkleRoot)
+	if err != nil {
+		return nil, err
+	}
+	root, err := hex.DecodeString(rootStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid whitelist merkle root: %w", err)
+	}
+	return &types.Whitelist{
+		Addresses:  addrs,
+		MerkleRoot: root,
+		Cap:        limit,
+		Duration:   duration,
+		PublicCap:  publicCap,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"encoding/hex"
 	"fmt"
 
 	"cosmossdk.io/math"
@@ -56,11 +57,16 @@ func createBuySellCmd(use string, short string, isBuy bool) *cobra.Command {
 
 			var msg sdk.Msg
 			if isBuy {
+				proof, err := parseWhitelistProof(cmd)
+				if err != nil {
+					return err
+				}
 				msg = &types.MsgBuy{
-					Buyer:         clientCtx.GetFromAddress().String(),
-					PlanId:        planID,
-					Amount:        amount,
-					MaxCostAmount: expectedAmount,
+					Buyer:          clientCtx.GetFromAddress().String(),
+					PlanId:         planID,
+					Amount:         amount,
+					MaxCostAmount:  expectedAmount,
+					WhitelistProof: proof,
 				}
 			} else {
 				msg = &types.MsgSell{
@@ -76,9 +82,28 @@ func createBuySellCmd(use string, short string, isBuy bool) *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	if isBuy {
+		cmd.Flags().StringSlice(FlagWhitelistProof, nil, "The hex nodes of the proof that the buyer is in the Merkle tree of the whitelist.")
+	}
 	return cmd
 }
 
+func parseWhitelistProof(cmd *cobra.Command) ([][]byte, error) {
+	nodes, err := cmd.Flags().GetStringSlice(FlagWhitelistProof)
+	if err != nil {
+		return nil, err
+	}
+	var proof [][]byte
+	for _, n := range nodes {
+		b, err := hex.DecodeString(n)
+		if err != nil {
+			return nil, fmt.Errorf("invalid whitelist proof node: %s: %w", n, err)
+		}
+		proof = append(proof, b)
+	}
+	return proof, nil
+}
+
 func CmdEnableTrading() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "enable-trading [plan-id]",
@@ -97,6 +122,11 @@ func CmdEnableTrading() *cobra.Command {
 				Owner:  clientCtx.GetFromAddress().String(),
 			}
 
+			msg.Whitelist, err = ParseWhitelistFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -106,6 +136,7 @@ func CmdEnableTrading() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().AddFlagSet(FlagSetWhitelist())
 
 	return cmd
 }
@@ -27,6 +27,26 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 		}
 	}
 	k.SetLastPlanId(ctx, lastPlanId)
+
+	for _, sub := range genState.Subscriptions {
+		k.SetSubscription(ctx, sub)
+	}
+
+	for _, p := range genState.Purchases {
+		k.SetPurchases(ctx, p)
+	}
+
+	for _, v := range genState.BuyerVestings {
+		k.SetBuyerVesting(ctx, v)
+	}
+
+	for _, t := range genState.Trades {
+		k.SetTrade(ctx, t)
+	}
+
+	for _, c := range genState.Candles {
+		k.SetCandle(ctx, c)
+	}
 }
 
 // ExportGenesis returns the module's exported genesis
@@ -34,6 +54,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis := types.GenesisState{}
 	genesis.Params = k.GetParams(ctx)
 	genesis.Plans = append(genesis.Plans, k.GetAllPlans(ctx, false)...)
+	genesis.Subscriptions = append(genesis.Subscriptions, k.GetAllSubscriptions(ctx)...)
+	genesis.Purchases = append(genesis.Purchases, k.GetAllPurchases(ctx)...)
+	genesis.BuyerVestings = append(genesis.BuyerVestings, k.GetAllBuyerVestings(ctx)...)
+	genesis.Trades = append(genesis.Trades, k.GetAllTrades(ctx)...)
+	genesis.Candles = append(genesis.Candles, k.GetAllCandles(ctx)...)
 
 	return &genesis
 }
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+/*
+A plan can have a buyer vesting plan. The tokens of a buyer are then not all released when it claims after the
+settlement: the IRO tokens it has, or the tokens allocated to its subscription to a fixed price sale, are
+recorded as vesting for the account, and only the vested part is sent. The next claims send what vested since.
+The record is removed once all its tokens are claimed.
+*/
+
+// SetBuyerVesting sets the tokens of an account vesting under the buyer vesting plan of a plan
+func (k Keeper) SetBuyerVesting(ctx sdk.Context, v types.BuyerVesting) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&v)
+	store.Set(types.BuyerVestingKey(fmt.Sprintf("%d", v.PlanId), v.Account), b)
+}
+
+// GetBuyerVesting returns the tokens of the account vesting under the plan, empty if none
+func (k Keeper) GetBuyerVesting(ctx sdk.Context, planId uint64, account string) types.BuyerVesting {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BuyerVestingKey(fmt.Sprintf("%d", planId), account))
+	if b == nil {
+		return types.NewBuyerVesting(planId, account)
+	}
+
+	var val types.BuyerVesting
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// RemoveBuyerVesting removes the tokens of the account vesting under the plan
+func (k Keeper) RemoveBuyerVesting(ctx sdk.Context, planId uint64, account string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BuyerVestingKey(fmt.Sprintf("%d", planId), account))
+}
+
+// GetPlanBuyerVestings returns the tokens vesting under the plan
+func (k Keeper) GetPlanBuyerVestings(ctx sdk.Context, planId string) []types.BuyerVesting {
+	return k.getBuyerVestings(ctx, types.PlanBuyerVestingsKey(planId))
+}
+
+// GetAllBuyerVestings returns the tokens vesting under all the plans
+func (k Keeper) GetAllBuyerVestings(ctx sdk.Context) []types.BuyerVesting {
+	return k.getBuyerVestings(ctx, types.BuyerVestingKeyPrefix)
+}
+
+func (k Keeper) getBuyerVestings(ctx sdk.Context, pref []byte) (list []types.BuyerVesting) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pref)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.BuyerVesting
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// release adds the tokens claimed by the claimer to its vesting tokens if the plan has a buyer vesting plan,
+// and sends what is vested of them. It returns the amount sent, and the amount still vesting.
+func (k Keeper) release(ctx sdk.Context, plan types.Plan, claimer sdk.AccAddress, tokens math.Int) (claimed, unvested math.Int, err error) {
+	claimed, unvested = tokens, math.ZeroInt()
+	if plan.BuyerVestingPlan != nil {
+		v := k.GetBuyerVesting(ctx, plan.Id, claimer.String())
+		v.Amount = v.Amount.Add(tokens)
+		claimed = plan.BuyerVested(v.Amount, ctx.BlockTime()).Sub(v.Claimed)
+		v.Claimed = v.Claimed.Add(claimed)
+		unvested = v.Unclaimed()
+		if unvested.IsZero() {
+			k.RemoveBuyerVesting(ctx, plan.Id, v.Account)
+		} else {
+			k.SetBuyerVesting(ctx, v)
+		}
+	}
+
+	if claimed.IsPositive() {
+		err = k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimer, sdk.NewCoins(sdk.NewCoin(plan.SettledDenom, claimed)))
+		if err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+	}
+	return claimed, unvested, nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func (s *KeeperTestSuite) TestBuyerVesting() {
+	k := s.App.IROKeeper
+	startTime := time.Now()
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	rollappDenom := "rollapp_denom"
+	rollappId, planId := s.createPlanFromMsg(startTime, allocation, func(m *types.MsgCreatePlan) {
+		m.BuyerVestingPlan = &types.BuyerVestingPlan{Cliff: time.Hour, Duration: 4 * time.Hour}
+	})
+
+	buyer := sample.Acc()
+	buyAmt := math.NewInt(1_000).MulRaw(1e18)
+	s.BuySomeTokens(planId, buyer, buyAmt)
+
+	settledTime := startTime.Add(time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(settledTime)
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, allocation)))
+	err := k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+
+	// the IRO tokens not claimed yet are vesting as well
+	res, err := s.App.IROKeeper.QueryBuyerVesting(s.Ctx, &types.QueryBuyerVestingRequest{PlanId: planId, Account: buyer.String()})
+	s.Require().NoError(err)
+	s.Require().True(buyAmt.Equal(res.Total))
+	s.Require().True(res.ClaimableAmount.IsZero())
+
+	// the first claim burns the IRO tokens, but releases nothing before the cliff
+	err = k.Claim(s.Ctx, planId, buyer)
+	s.Require().NoError(err)
+	plan := k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, buyer, plan.TotalAllocation.Denom).IsZero())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, buyer, rollappDenom).IsZero())
+
+	s.Ctx = s.Ctx.WithBlockTime(settledTime.Add(30 * time.Minute))
+	err = k.Claim(s.Ctx, planId, buyer)
+	utest.IsErr(s.Require(), err, types.ErrNoTokensToClaim)
+
+	// half is vested halfway
+	s.Ctx = s.Ctx.WithBlockTime(settledTime.Add(2 * time.Hour))
+	res, err = s.App.IROKeeper.QueryBuyerVesting(s.Ctx, &types.QueryBuyerVestingRequest{PlanId: planId, Account: buyer.String()})
+	s.Require().NoError(err)
+	s.Require().True(buyAmt.QuoRaw(2).Equal(res.VestedAmount))
+	s.Require().True(buyAmt.QuoRaw(2).Equal(res.ClaimableAmount))
+
+	err = k.Claim(s.Ctx, planId, buyer)
+	s.Require().NoError(err)
+	s.Require().True(buyAmt.QuoRaw(2).Equal(s.App.BankKeeper.GetBalance(s.Ctx, buyer, rollappDenom).Amount))
+
+	s.Require().NoError(keeper.InvariantAccounting(*k)(s.Ctx))
+
+	// all is vested at the end
+	s.Ctx = s.Ctx.WithBlockTime(settledTime.Add(5 * time.Hour))
+	err = k.Claim(s.Ctx, planId, buyer)
+	s.Require().NoError(err)
+	s.Require().True(buyAmt.Equal(s.App.BankKeeper.GetBalance(s.Ctx, buyer, rollappDenom).Amount))
+	s.Require().Empty(k.GetPlanBuyerVestings(s.Ctx, planId))
+
+	res, err = s.App.IROKeeper.QueryBuyerVesting(s.Ctx, &types.QueryBuyerVestingRequest{PlanId: planId, Account: buyer.String()})
+	s.Require().NoError(err)
+	s.Require().True(res.ClaimableAmount.IsZero())
+
+	s.Require().NoError(keeper.InvariantPlan(*k)(s.Ctx))
+	s.Require().NoError(keeper.InvariantAccounting(*k)(s.Ctx))
+}
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"fmt"
+
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
@@ -13,6 +16,9 @@ import (
 //
 // This function allows a user to claim their RA tokens by burning their FUT tokens.
 // It burns *all* the FUT tokens the claimer has, and sends the equivalent amount of RA tokens to the claimer.
+// In a fixed price sale, it sends the RA tokens allocated to the subscription of the claimer instead.
+// If the plan has a buyer vesting plan, only the vested RA tokens are sent, the rest on the next claims.
+// A plan which missed its soft cap is never settled, its buyers are refunded instead, see Refund.
 func (k Keeper) Claim(ctx sdk.Context, planId string, claimer sdk.AccAddress) error {
 	plan, found := k.GetPlan(ctx, planId)
 	if !found {
@@ -23,29 +29,32 @@ func (k Keeper) Claim(ctx sdk.Context, planId string, claimer sdk.AccAddress) er
 		return types.ErrPlanNotSettled
 	}
 
-	availableTokens := k.BK.GetBalance(ctx, claimer, plan.TotalAllocation.Denom)
-	if availableTokens.IsZero() {
-		return types.ErrNoTokensToClaim
+	var (
+		tokens = math.ZeroInt()
+		refund = math.ZeroInt()
+		err    error
+	)
+	if plan.FixedPriceSale != nil {
+		tokens, refund, err = k.claimSubscription(ctx, &plan, claimer)
+	} else {
+		tokens, err = k.burnIRO(ctx, plan, claimer)
 	}
-
-	// Burn all the FUT tokens the user have
-	err := k.BK.SendCoinsFromAccountToModule(ctx, claimer, types.ModuleName, sdk.NewCoins(availableTokens))
 	if err != nil {
 		return err
 	}
-	err = k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(availableTokens))
+
+	// Give the user the RA token in return, as it vests
+	claimed, unvested, err := k.release(ctx, plan, claimer, tokens)
 	if err != nil {
 		return err
 	}
 
-	// Give the user the RA token in return (same amount as the FUT token)
-	err = k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, claimer, sdk.NewCoins(sdk.NewCoin(plan.SettledDenom, availableTokens.Amount)))
-	if err != nil {
-		return err
+	if claimed.IsZero() && tokens.IsZero() && refund.IsZero() {
+		return types.ErrNoTokensToClaim
 	}
 
 	// Update the plan
-	plan.ClaimedAmt = plan.ClaimedAmt.Add(availableTokens.Amount)
+	plan.ClaimedAmt = plan.ClaimedAmt.Add(claimed)
 	k.SetPlan(ctx, plan)
 
 	// Emit event
@@ -53,7 +62,9 @@ func (k Keeper) Claim(ctx sdk.Context, planId string, claimer sdk.AccAddress) er
 		Claimer:   claimer.String(),
 		PlanId:    planId,
 		RollappId: plan.RollappId,
-		Claim:     sdk.NewCoin(plan.SettledDenom, availableTokens.Amount),
+		Claim:     sdk.NewCoin(plan.SettledDenom, claimed),
+		Refund:    sdk.NewCoin(plan.LiquidityDenom, refund),
+		Unvested:  sdk.NewCoin(plan.SettledDenom, unvested),
 	})
 	if err != nil {
 		return err
@@ -62,6 +73,50 @@ func (k Keeper) Claim(ctx sdk.Context, planId string, claimer sdk.AccAddress) er
 	return nil
 }
 
+// burnIRO burns all the FUT tokens the claimer has, and returns their amount
+func (k Keeper) burnIRO(ctx sdk.Context, plan types.Plan, claimer sdk.AccAddress) (math.Int, error) {
+	availableTokens := k.BK.GetBalance(ctx, claimer, plan.TotalAllocation.Denom)
+	if availableTokens.IsZero() {
+		return math.ZeroInt(), nil
+	}
+
+	err := k.BK.SendCoinsFromAccountToModule(ctx, claimer, types.ModuleName, sdk.NewCoins(availableTokens))
+	if err != nil {
+		return math.Int{}, err
+	}
+	err = k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(availableTokens))
+	if err != nil {
+		return math.Int{}, err
+	}
+	return availableTokens.Amount, nil
+}
+
+// claimSubscription removes the subscription of the claimer to the fixed price sale, if any, and refunds the
+// liquidity paid for the part which was not allocated. It returns the RA tokens allocated to the subscription
+// and the refund.
+func (k Keeper) claimSubscription(ctx sdk.Context, plan *types.Plan, claimer sdk.AccAddress) (tokens, refund math.Int, err error) {
+	planId := fmt.Sprintf("%d", plan.Id)
+	sub, found := k.GetSubscription(ctx, planId, claimer.String())
+	if !found {
+		return math.ZeroInt(), math.ZeroInt(), nil
+	}
+
+	tokens, refund = plan.FixedPriceSale.Allocation(sub)
+	// rounding down ensures the refunds sum to at most the reserve
+	refund = math.MinInt(refund, plan.FixedPriceSale.RefundReserve)
+
+	if refund.IsPositive() {
+		err = k.BK.SendCoins(ctx, plan.GetAddress(), claimer, sdk.NewCoins(sdk.NewCoin(plan.LiquidityDenom, refund)))
+		if err != nil {
+			return math.Int{}, math.Int{}, err
+		}
+	}
+
+	k.RemoveSubscription(ctx, planId, sub.Buyer)
+	plan.FixedPriceSale.RefundReserve = plan.FixedPriceSale.RefundReserve.Sub(refund)
+	return tokens, refund, nil
+}
+
 // ClaimVested allows the owner of a RollApp to claim vested tokens.
 // The function performs the following checks and operations:
 // - Verifies that the plan exists and is settled.
@@ -109,7 +109,7 @@ func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (
 		return nil, errorsmod.Wrap(gerrc.ErrFailedPrecondition, "denom not allowed")
 	}
 
-	planId, err := m.Keeper.CreatePlan(ctx, req.LiquidityDenom, req.AllocatedAmount, req.IroPlanDuration, req.StartTime, req.TradingEnabled, rollapp, req.BondingCurve, req.IncentivePlanParams, req.LiquidityPart, req.VestingDuration, req.VestingStartTimeAfterSettlement)
+	planId, err := m.Keeper.CreatePlanFromMsg(ctx, req, rollapp)
 	if err != nil {
 		return nil, err
 	}
@@ -119,24 +119,65 @@ func (m msgServer) CreatePlan(goCtx context.Context, req *types.MsgCreatePlan) (
 	}, nil
 }
 
-// CreatePlan creates a new IRO plan for a rollapp
+// CreatePlan creates a new IRO plan for a rollapp, selling on the bonding curve
+func (k Keeper) CreatePlan(ctx sdk.Context, liquidityDenom string, allocatedAmount math.Int, planDuration time.Duration, startTime time.Time, tradingEnabled bool, rollapp rollapptypes.Rollapp, curve types.BondingCurve, incentivesParams types.IncentivePlanParams, liquidityPart math.LegacyDec, vestingDuration, vestingStartTimeAfterSettlement time.Duration) (string, error) {
+	return k.CreatePlanFromMsg(ctx, &types.MsgCreatePlan{
+		Owner:                           rollapp.Owner,
+		RollappId:                       rollapp.RollappId,
+		AllocatedAmount:                 allocatedAmount,
+		BondingCurve:                    curve,
+		TradingEnabled:                  tradingEnabled,
+		StartTime:                       startTime,
+		IroPlanDuration:                 planDuration,
+		IncentivePlanParams:             incentivesParams,
+		LiquidityPart:                   liquidityPart,
+		LiquidityDenom:                  liquidityDenom,
+		VestingDuration:                 vestingDuration,
+		VestingStartTimeAfterSettlement: vestingStartTimeAfterSettlement,
+	}, rollapp)
+}
+
+// CreatePlanFromMsg creates a new IRO plan for a rollapp
 // This function performs the following steps:
 // 1. Sets the IRO plan to the rollapp with the specified pre-launch time.
 // 2. Mints the allocated amount of tokens for the rollapp.
-// 3. Creates a new plan with the provided parameters and validates it.
+// 3. Creates a new plan with the provided parameters and sale mechanism, and validates it.
 // 4. Creates a new module account for the IRO plan.
 // 5. Charges the creation fee from the rollapp owner to the plan's module account.
 // 6. Stores the plan in the keeper.
-func (k Keeper) CreatePlan(ctx sdk.Context, liquidityDenom string, allocatedAmount math.Int, planDuration time.Duration, startTime time.Time, tradingEnabled bool, rollapp rollapptypes.Rollapp, curve types.BondingCurve, incentivesParams types.IncentivePlanParams, liquidityPart math.LegacyDec, vestingDuration, vestingStartTimeAfterSettlement time.Duration) (string, error) {
-	allocation, err := k.MintAllocation(ctx, allocatedAmount, rollapp.RollappId, rollapp.GenesisInfo.NativeDenom.Display, uint64(rollapp.GenesisInfo.NativeDenom.Exponent))
+func (k Keeper) CreatePlanFromMsg(ctx sdk.Context, req *types.MsgCreatePlan, rollapp rollapptypes.Rollapp) (string, error) {
+	allocation, err := k.MintAllocation(ctx, req.AllocatedAmount, rollapp.RollappId, rollapp.GenesisInfo.NativeDenom.Display, uint64(rollapp.GenesisInfo.NativeDenom.Exponent))
 	if err != nil {
 		return "", err
 	}
 
-	plan := types.NewPlan(k.GetNextPlanIdAndIncrement(ctx), rollapp.RollappId, liquidityDenom, allocation, curve, planDuration, incentivesParams, liquidityPart, vestingDuration, vestingStartTimeAfterSettlement)
+	plan := types.NewPlan(k.GetNextPlanIdAndIncrement(ctx), rollapp.RollappId, req.LiquidityDenom, allocation, req.BondingCurve, req.IroPlanDuration, req.IncentivePlanParams, req.LiquidityPart, req.VestingDuration, req.VestingStartTimeAfterSettlement)
+
+	// set the sale mechanism, the bonding curve is used if none is set
+	if req.DutchAuction != nil {
+		a := *req.DutchAuction
+		plan.DutchAuction = &a
+	}
+	if req.FixedPriceSale != nil {
+		sale := types.NewFixedPriceSale(req.FixedPriceSale.Price)
+		plan.FixedPriceSale = &sale
+	}
+	plan.MaxAmountToSell = plan.SaleMechanism().MaxAmountToSell(plan.TotalAllocation.Amount, plan.LiquidityPart)
+	plan.Whitelist = req.Whitelist
+	if req.SoftCap != nil {
+		if !req.SoftCap.Deadline.After(ctx.BlockTime()) {
+			return "", errorsmod.Wrap(gerrc.ErrInvalidArgument, "soft cap deadline must be in the future")
+		}
+		softCap := types.NewSoftCap(req.SoftCap.Amount, req.SoftCap.Deadline)
+		plan.SoftCap = &softCap
+	}
+	if req.BuyerVestingPlan != nil {
+		plan.BuyerVestingPlan = &types.BuyerVestingPlan{Cliff: req.BuyerVestingPlan.Cliff, Duration: req.BuyerVestingPlan.Duration}
+	}
 
 	// if trading enabled initially, set start time and pre-launch time
-	if tradingEnabled {
+	if req.TradingEnabled {
+		startTime := req.StartTime
 		if startTime.Before(ctx.BlockTime()) {
 			startTime = ctx.BlockTime()
 		}
@@ -160,7 +201,7 @@ func (k Keeper) CreatePlan(ctx sdk.Context, liquidityDenom string, allocatedAmou
 
 	// charge creation fee
 	feeAmt := k.GetParams(ctx).CreationFee
-	cost := plan.BondingCurve.Cost(math.ZeroInt(), feeAmt)
+	cost := plan.SaleMechanism().Cost(math.ZeroInt(), feeAmt, ctx.BlockTime())
 	if !cost.IsPositive() {
 		cost = math.NewInt(1) // charge minimum creation fee
 	}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/utils/uinv"
 	"github.com/dymensionxyz/dymension/v3/x/iro/types"
@@ -13,6 +14,7 @@ import (
 var invs = uinv.NamedFuncsList[Keeper]{
 	{Name: "plan", Func: InvariantPlan},
 	{Name: "accounting", Func: InvariantAccounting},
+	{Name: "subscriptions", Func: InvariantSubscriptions},
 }
 
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
@@ -72,6 +74,10 @@ func checkPlan(plan types.Plan) error {
 	if plan.ClaimedAmt.GT(plan.SoldAmt) {
 		return fmt.Errorf("claimed amount greater than sold amount: planID: %d, claimedAmt: %s, soldAmt: %s", plan.Id, plan.ClaimedAmt, plan.SoldAmt)
 	}
+
+	if plan.IsRefunding() && plan.IsSettled() {
+		return fmt.Errorf("refunding plan is settled: planID: %d", plan.Id)
+	}
 	return nil
 }
 
@@ -82,6 +88,21 @@ func InvariantAccounting(k Keeper) uinv.Func {
 		var errs []error
 
 		for _, plan := range plans {
+			if plan.IsRefunding() {
+				// the plan should hold exactly the liquidity left to refund
+				funds := k.BK.GetBalance(ctx, plan.GetAddress(), plan.LiquidityDenom).Amount
+				if !funds.Equal(plan.SoftCap.RefundLiquidity) {
+					errs = append(errs, fmt.Errorf("incorrect refund funds: planID: %d, expected: %s, available: %s",
+						plan.Id, plan.SoftCap.RefundLiquidity, funds))
+				}
+
+				// module should have no more IRO, the buyers hold what is left to refund
+				iroBalance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.GetIRODenom())
+				if !iroBalance.IsZero() {
+					errs = append(errs, fmt.Errorf("iro tokens left in module, refunding: planID: %d, balance: %s", plan.Id, iroBalance))
+				}
+			}
+
 			if plan.IsSettled() {
 				// module should have no more IRO
 				iroBalance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.GetIRODenom())
@@ -97,8 +118,22 @@ func InvariantAccounting(k Keeper) uinv.Func {
 						plan.Id, claimable, moduleBal.Amount))
 				}
 
+				// the tokens vesting under the buyer vesting plan are claimable
+				vesting := math.ZeroInt()
+				for _, v := range k.GetPlanBuyerVestings(ctx, fmt.Sprintf("%d", plan.Id)) {
+					vesting = vesting.Add(v.Unclaimed())
+				}
+				if claimable.LT(vesting) {
+					errs = append(errs, fmt.Errorf("buyer vesting exceeds claimable: planID: %d, claimable: %s, vesting: %s",
+						plan.Id, claimable, vesting))
+				}
+
 				founderFunds := k.BK.GetBalance(ctx, plan.GetAddress(), plan.LiquidityDenom)
 				expectedFunds := plan.VestingPlan.Amount.Sub(plan.VestingPlan.Claimed)
+				if plan.FixedPriceSale != nil {
+					// the liquidity not refunded yet to oversubscribed buyers
+					expectedFunds = expectedFunds.Add(plan.FixedPriceSale.RefundReserve)
+				}
 				if !founderFunds.Amount.Equal(expectedFunds) {
 					errs = append(errs, fmt.Errorf("incorrect founder funds: planID: %d, expected: %s, available: %s",
 						plan.Id, expectedFunds, founderFunds.Amount))
@@ -109,3 +144,41 @@ func InvariantAccounting(k Keeper) uinv.Func {
 		return errors.Join(errs...)
 	})
 }
+
+// the subscriptions to a fixed price sale should add up to the subscribed amount of the sale until it is settled,
+// or to the amount left to refund if it missed its soft cap, and only exist for fixed price sales
+func InvariantSubscriptions(k Keeper) uinv.Func {
+	return uinv.AnyErrorIsBreaking(func(ctx sdk.Context) error {
+		var errs []error
+		for _, plan := range k.GetAllPlans(ctx, false) {
+			subs := k.GetPlanSubscriptions(ctx, fmt.Sprintf("%d", plan.Id))
+			if plan.FixedPriceSale == nil {
+				if len(subs) != 0 {
+					errs = append(errs, fmt.Errorf("subscriptions to plan which is not a fixed price sale: planID: %d", plan.Id))
+				}
+				continue
+			}
+			if plan.IsSettled() {
+				continue
+			}
+
+			subscribed, paid := math.ZeroInt(), math.ZeroInt()
+			for _, sub := range subs {
+				subscribed = subscribed.Add(sub.Amount)
+				paid = paid.Add(sub.Paid)
+			}
+			if plan.IsRefunding() {
+				if !subscribed.Equal(plan.SoftCap.RefundSupply) {
+					errs = append(errs, fmt.Errorf("subscriptions mismatch, refunding: planID: %d, subscribed: %s, expected: %s",
+						plan.Id, subscribed, plan.SoftCap.RefundSupply))
+				}
+				continue
+			}
+			if !subscribed.Equal(plan.FixedPriceSale.Subscribed) || !paid.Equal(plan.FixedPriceSale.Paid) {
+				errs = append(errs, fmt.Errorf("subscriptions mismatch: planID: %d, subscribed: %s, expected: %s, paid: %s, expected: %s",
+					plan.Id, subscribed, plan.FixedPriceSale.Subscribed, paid, plan.FixedPriceSale.Paid))
+			}
+		}
+		return errors.Join(errs...)
+	})
+}
@@ -26,7 +26,7 @@ func (m msgServer) EnableTrading(ctx context.Context, req *types.MsgEnableTradin
 		return nil, err
 	}
 
-	err = m.Keeper.EnableTrading(sdk.UnwrapSDKContext(ctx), req.PlanId, owner)
+	err = m.Keeper.EnableTradingWithWhitelist(sdk.UnwrapSDKContext(ctx), req.PlanId, owner, req.Whitelist)
 	if err != nil {
 		return nil, err
 	}
@@ -41,6 +41,13 @@ func (m msgServer) Buy(ctx context.Context, req *types.MsgBuy) (*types.MsgBuyRes
 		return nil, err
 	}
 
+	if len(req.WhitelistProof) != 0 {
+		err = m.Keeper.ProveWhitelisted(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.WhitelistProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = m.Keeper.Buy(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.Amount, req.MaxCostAmount)
 	if err != nil {
 		return nil, err
@@ -56,6 +63,13 @@ func (m msgServer) BuyExactSpend(ctx context.Context, req *types.MsgBuyExactSpen
 		return nil, err
 	}
 
+	if len(req.WhitelistProof) != 0 {
+		err = m.Keeper.ProveWhitelisted(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.WhitelistProof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	err = m.Keeper.BuyExactSpend(sdk.UnwrapSDKContext(ctx), req.PlanId, buyer, req.Spend, req.MinOutTokensAmount)
 	if err != nil {
 		return nil, err
@@ -98,3 +112,13 @@ func (m msgServer) ClaimVested(ctx context.Context, req *types.MsgClaimVested) (
 
 	return &types.MsgClaimVestedResponse{}, nil
 }
+
+func (m msgServer) Refund(ctx context.Context, req *types.MsgRefund) (*types.MsgRefundResponse, error) {
+	refunderAddr := sdk.MustAccAddressFromBech32(req.Refunder)
+	err := m.Keeper.Refund(sdk.UnwrapSDKContext(ctx), req.PlanId, refunderAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRefundResponse{}, nil
+}
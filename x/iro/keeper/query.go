@@ -54,11 +54,15 @@ func (k Keeper) QueryCost(goCtx context.Context, req *types.QueryCostRequest) (*
 		return nil, status.Error(codes.NotFound, "plan not found")
 	}
 
+	sale := plan.SaleMechanism()
 	var costAmt math.Int
 	if req.Sell {
-		costAmt = plan.BondingCurve.Cost(plan.SoldAmt, plan.SoldAmt.Sub(req.Amt))
+		if !sale.Sellable() {
+			return nil, status.Error(codes.FailedPrecondition, "tokens of the sale cannot be sold back")
+		}
+		costAmt = sale.Cost(plan.SoldAmt, plan.SoldAmt.Sub(req.Amt), ctx.BlockTime())
 	} else {
-		costAmt = plan.BondingCurve.Cost(plan.SoldAmt, plan.SoldAmt.Add(req.Amt))
+		costAmt = sale.Cost(plan.SoldAmt, plan.SoldAmt.Add(req.Amt), ctx.BlockTime())
 	}
 	cost := sdk.NewCoin(plan.LiquidityDenom, costAmt)
 	return &types.QueryCostResponse{Cost: &cost}, nil
@@ -76,7 +80,7 @@ func (k Keeper) QueryTokensForExactInAmount(goCtx context.Context, req *types.Qu
 		return nil, status.Error(codes.NotFound, "plan not found")
 	}
 
-	tokensAmt, err := plan.BondingCurve.TokensForExactInAmount(plan.SoldAmt, req.Amt)
+	tokensAmt, err := plan.SaleMechanism().TokensForExactInAmount(plan.SoldAmt, req.Amt, ctx.BlockTime())
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -142,7 +146,7 @@ func (k Keeper) QuerySpotPrice(goCtx context.Context, req *types.QuerySpotPriceR
 	}
 
 	return &types.QuerySpotPriceResponse{
-		Price: plan.SpotPrice(),
+		Price: plan.SpotPrice(ctx.BlockTime()),
 	}, nil
 }
 
@@ -170,3 +174,134 @@ func (k Keeper) QueryVesting(goCtx context.Context, req *types.QueryVestingReque
 	}
 	return response, nil
 }
+
+// QueryBuyerVesting implements types.QueryServer.
+func (k Keeper) QueryBuyerVesting(goCtx context.Context, req *types.QueryBuyerVestingRequest) (*types.QueryBuyerVestingResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	plan, found := k.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+	if !plan.IsSettled() {
+		return nil, status.Error(codes.FailedPrecondition, "plan not settled")
+	}
+	account, err := sdk.AccAddressFromBech32(req.Account)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid account address")
+	}
+
+	// the tokens not claimed yet vest as well
+	pending := k.BK.GetBalance(ctx, account, plan.TotalAllocation.Denom).Amount
+	if plan.FixedPriceSale != nil {
+		pending = math.ZeroInt()
+		if sub, found := k.GetSubscription(ctx, req.PlanId, req.Account); found {
+			pending, _ = plan.FixedPriceSale.Allocation(sub)
+		}
+	}
+
+	v := k.GetBuyerVesting(ctx, plan.Id, req.Account)
+	total := v.Amount.Add(pending)
+	vested := plan.BuyerVested(total, ctx.BlockTime())
+
+	return &types.QueryBuyerVestingResponse{
+		Total:           total,
+		VestedAmount:    vested,
+		Claimed:         v.Claimed,
+		ClaimableAmount: vested.Sub(v.Claimed),
+	}, nil
+}
+
+// QueryTrades implements types.QueryServer.
+func (k Keeper) QueryTrades(goCtx context.Context, req *types.QueryTradesRequest) (*types.QueryTradesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPlan(ctx, req.PlanId); !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	return &types.QueryTradesResponse{Trades: k.GetPlanTrades(ctx, req.PlanId)}, nil
+}
+
+// QueryCandles implements types.QueryServer.
+func (k Keeper) QueryCandles(goCtx context.Context, req *types.QueryCandlesRequest) (*types.QueryCandlesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetPlan(ctx, req.PlanId); !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+
+	return &types.QueryCandlesResponse{
+		Candles:  k.GetPlanCandles(ctx, req.PlanId),
+		Interval: k.GetParams(ctx).CandleInterval,
+	}, nil
+}
+
+// QuerySubscription implements types.QueryServer.
+func (k Keeper) QuerySubscription(goCtx context.Context, req *types.QuerySubscriptionRequest) (*types.QuerySubscriptionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	plan, found := k.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+	if plan.FixedPriceSale == nil {
+		return nil, status.Error(codes.FailedPrecondition, "plan is not a fixed price sale")
+	}
+
+	sub, found := k.GetSubscription(ctx, req.PlanId, req.Buyer)
+	if !found {
+		return nil, status.Error(codes.NotFound, "subscription not found")
+	}
+
+	response := &types.QuerySubscriptionResponse{
+		Subscription: sub,
+		Allocation:   sdk.NewCoin(plan.GetIRODenom(), math.ZeroInt()),
+		Refund:       sdk.NewCoin(plan.LiquidityDenom, math.ZeroInt()),
+	}
+	if plan.IsSettled() {
+		tokens, refund := plan.FixedPriceSale.Allocation(sub)
+		response.Allocation = sdk.NewCoin(plan.SettledDenom, tokens)
+		response.Refund = sdk.NewCoin(plan.LiquidityDenom, math.MinInt(refund, plan.FixedPriceSale.RefundReserve))
+	}
+	return response, nil
+}
+
+// QueryRemainingCap implements types.QueryServer.
+func (k Keeper) QueryRemainingCap(goCtx context.Context, req *types.QueryRemainingCapRequest) (*types.QueryRemainingCapResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	plan, found := k.GetPlan(ctx, req.PlanId)
+	if !found {
+		return nil, status.Error(codes.NotFound, "plan not found")
+	}
+	if plan.Whitelist == nil {
+		return nil, status.Error(codes.FailedPrecondition, "plan has no whitelist")
+	}
+
+	response := &types.QueryRemainingCapResponse{
+		WhitelistPhase: plan.InWhitelistPhase(ctx.BlockTime()),
+		Whitelisted:    whitelisted(plan, k.GetPurchases(ctx, plan.Id, req.Account)),
+		Remaining:      sdk.NewCoin(plan.GetIRODenom(), math.ZeroInt()),
+	}
+	if remaining, ok := k.RemainingCap(ctx, plan, req.Account); ok {
+		response.Capped = true
+		response.Remaining.Amount = remaining
+	}
+	return response, nil
+}
@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+/*
+A plan can have a soft cap: a minimum amount of liquidity to raise by a deadline. The liquidity raised is
+tracked on the soft cap as the cost of the buys less the revenue of the sells, so neither the creation fee nor
+funds sent to the plan account count toward it. Once the deadline passed short of the soft cap, or if the plan is settled short
+of it before, trading stops and the plan enters the refund state instead of being settled. Since there is no
+block hook, the state is entered by the first refund or by the settlement. A soft cap reached by the deadline
+cannot be lost by selling afterward.
+
+In the refund state, the buyers burn their IRO tokens, or give up their subscriptions to a fixed price sale,
+for their pro rata share of the liquidity in the plan, including the creation fee. The taker fees are not
+refunded, and refunding charges none.
+*/
+
+// startRefund puts the plan in the refund state, burning the unsold tokens. The caller must set the plan.
+func (k Keeper) startRefund(ctx sdk.Context, plan *types.Plan) error {
+	unsold := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.TotalAllocation.Denom)
+	if unsold.IsPositive() {
+		err := k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(unsold))
+		if err != nil {
+			return err
+		}
+	}
+
+	supply := plan.SoldAmt.Sub(plan.ClaimedAmt)
+	if plan.FixedPriceSale != nil {
+		supply = plan.FixedPriceSale.Subscribed
+	}
+	liquidity := k.BK.GetBalance(ctx, plan.GetAddress(), plan.LiquidityDenom).Amount
+
+	plan.SoftCap.Refunding = true
+	plan.SoftCap.RefundSupply = supply
+	plan.SoftCap.RefundLiquidity = liquidity
+
+	return uevent.EmitTypedEvent(ctx, &types.EventRefundStarted{
+		PlanId:    fmt.Sprintf("%d", plan.Id),
+		RollappId: plan.RollappId,
+		Raised:    sdk.NewCoin(plan.LiquidityDenom, plan.SoftCap.Raised),
+		SoftCap:   plan.SoftCap.Amount,
+	})
+}
+
+// Refund refunds the refunder from a plan which missed its soft cap
+//
+// It burns *all* the IRO tokens the refunder has, or removes its subscription to a fixed price sale, and
+// sends its pro rata share of the liquidity left in the plan.
+func (k Keeper) Refund(ctx sdk.Context, planId string, refunder sdk.AccAddress) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return types.ErrPlanNotFound
+	}
+
+	if plan.IsSettled() {
+		return types.ErrPlanSettled
+	}
+
+	if !plan.SoftCapMissed(ctx.BlockTime(), false) {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "soft cap not missed")
+	}
+
+	if !plan.IsRefunding() {
+		err := k.startRefund(ctx, &plan)
+		if err != nil {
+			return err
+		}
+	}
+
+	var amt sdk.Coin
+	if plan.FixedPriceSale != nil {
+		sub, found := k.GetSubscription(ctx, planId, refunder.String())
+		if !found {
+			return types.ErrNoTokensToClaim
+		}
+		k.RemoveSubscription(ctx, planId, sub.Buyer)
+		amt = sdk.NewCoin(plan.TotalAllocation.Denom, sub.Amount)
+	} else {
+		amt = k.BK.GetBalance(ctx, refunder, plan.TotalAllocation.Denom)
+		if amt.IsZero() {
+			return types.ErrNoTokensToClaim
+		}
+
+		// Burn all the IRO tokens the refunder has
+		err := k.BK.SendCoinsFromAccountToModule(ctx, refunder, types.ModuleName, sdk.NewCoins(amt))
+		if err != nil {
+			return err
+		}
+		err = k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amt))
+		if err != nil {
+			return err
+		}
+	}
+
+	if amt.Amount.GT(plan.SoftCap.RefundSupply) {
+		return errorsmod.Wrapf(gerrc.ErrInternal, "refund more than supply: amount: %s, supply: %s", amt.Amount, plan.SoftCap.RefundSupply)
+	}
+
+	refund := sdk.NewCoin(plan.LiquidityDenom, plan.SoftCap.Refund(amt.Amount))
+	if refund.IsPositive() {
+		err := k.BK.SendCoins(ctx, plan.GetAddress(), refunder, sdk.NewCoins(refund))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Update the plan
+	plan.SoftCap.RefundSupply = plan.SoftCap.RefundSupply.Sub(amt.Amount)
+	plan.SoftCap.RefundLiquidity = plan.SoftCap.RefundLiquidity.Sub(refund.Amount)
+	k.SetPlan(ctx, plan)
+
+	// Emit event
+	err := uevent.EmitTypedEvent(ctx, &types.EventRefund{
+		Refunder:  refunder.String(),
+		PlanId:    planId,
+		RollappId: plan.RollappId,
+		Amount:    amt,
+		Refund:    refund,
+	})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// createPlanFromMsg creates a plan for a new rollapp, with the options set on the msg by setup
+func (s *KeeperTestSuite) createPlanFromMsg(startTime time.Time, allocation math.Int, setup func(*types.MsgCreatePlan)) (string, string) {
+	rollappId := s.CreateDefaultRollapp()
+	rollapp := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId)
+	msg := &types.MsgCreatePlan{
+		Owner:                           rollapp.Owner,
+		RollappId:                       rollappId,
+		AllocatedAmount:                 allocation,
+		BondingCurve:                    types.DefaultBondingCurve(),
+		TradingEnabled:                  true,
+		StartTime:                       startTime,
+		IroPlanDuration:                 time.Hour,
+		IncentivePlanParams:             types.DefaultIncentivePlanParams(),
+		LiquidityPart:                   types.DefaultParams().MinLiquidityPart,
+		LiquidityDenom:                  "adym",
+		VestingDuration:                 time.Hour,
+		VestingStartTimeAfterSettlement: 0,
+	}
+	setup(msg)
+	s.Require().NoError(msg.ValidateBasic())
+	planId, err := s.App.IROKeeper.CreatePlanFromMsg(s.Ctx, msg, rollapp)
+	s.Require().NoError(err)
+	return rollappId, planId
+}
+
+func (s *KeeperTestSuite) TestDutchAuction() {
+	k := s.App.IROKeeper
+	startTime := time.Now()
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	auction := types.DutchAuction{
+		StartPrice: math.LegacyNewDec(2),
+		EndPrice:   math.LegacyNewDec(1),
+		Duration:   10 * time.Hour,
+	}
+	_, planId := s.createPlanFromMsg(startTime, allocation, func(m *types.MsgCreatePlan) {
+		m.DutchAuction = &auction
+	})
+
+	plan := k.MustGetPlan(s.Ctx, planId)
+	liquidityPart := types.DefaultParams().MinLiquidityPart
+	s.Require().Equal(types.FindEquilibrium(types.BondingCurve{M: math.LegacyZeroDec(), C: auction.EndPrice}, allocation, liquidityPart), plan.MaxAmountToSell)
+
+	// the price descends linearly over the duration, then stays at the end price
+	for _, tc := range []struct {
+		elapsed time.Duration
+		price   math.LegacyDec
+	}{
+		{0, math.LegacyNewDec(2)},
+		{5 * time.Hour, math.LegacyMustNewDecFromStr("1.5")},
+		{10 * time.Hour, math.LegacyNewDec(1)},
+		{20 * time.Hour, math.LegacyNewDec(1)},
+	} {
+		s.Ctx = s.Ctx.WithBlockTime(startTime.Add(tc.elapsed))
+		res, err := s.App.IROKeeper.QuerySpotPrice(s.Ctx, &types.QuerySpotPriceRequest{PlanId: planId})
+		s.Require().NoError(err)
+		s.Require().True(tc.price.Equal(res.Price), "elapsed: %s: price: %s", tc.elapsed, res.Price)
+	}
+
+	// buy at the price at the time
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(5 * time.Hour))
+	buyer := sample.Acc()
+	buyAmt := math.NewInt(100).MulRaw(1e18)
+	s.BuySomeTokens(planId, buyer, buyAmt)
+	s.Require().True(buyAmt.Equal(s.App.BankKeeper.GetBalance(s.Ctx, buyer, plan.TotalAllocation.Denom).Amount))
+	raised := s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), "adym").Amount
+	creationFee := k.GetParams(s.Ctx).CreationFee.MulRaw(2) // paid at the start price
+	s.Require().True(math.NewInt(150).MulRaw(1e18).Add(creationFee).Equal(raised), "raised: %s", raised)
+
+	// tokens cannot be sold back
+	err := k.Sell(s.Ctx, planId, buyer, buyAmt, math.ZeroInt())
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	s.Require().NoError(keeper.InvariantPlan(*k)(s.Ctx))
+	s.Require().NoError(keeper.InvariantAccounting(*k)(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestFixedPriceSale() {
+	k := s.App.IROKeeper
+	startTime := time.Now()
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	rollappDenom := "rollapp_denom"
+	rollappId, planId := s.createPlanFromMsg(startTime, allocation, func(m *types.MsgCreatePlan) {
+		m.FixedPriceSale = &types.FixedPriceSale{Price: math.LegacyMustNewDecFromStr("0.1")}
+	})
+	plan := k.MustGetPlan(s.Ctx, planId)
+	available := plan.MaxAmountToSell.Sub(plan.SoldAmt)
+
+	// a single subscription cannot exceed what is left to sell
+	buyer1, buyer2 := sample.Acc(), sample.Acc()
+	s.FundAcc(buyer1, sdk.NewCoins(sdk.NewCoin("adym", allocation)))
+	err := k.Buy(s.Ctx, planId, buyer1, available.AddRaw(1), allocation)
+	utest.IsErr(s.Require(), err, types.ErrInsufficientTokens)
+
+	// subscribe to 150% of what is left to sell in total
+	subAmt := available.MulRaw(3).QuoRaw(4)
+	s.BuySomeTokens(planId, buyer1, subAmt)
+	s.BuySomeTokens(planId, buyer2, subAmt)
+
+	// no tokens are sent until settlement
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, buyer1, plan.TotalAllocation.Denom).IsZero())
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(subAmt.MulRaw(2).Equal(plan.FixedPriceSale.Subscribed))
+	s.Require().True(available.Equal(plan.MaxAmountToSell.Sub(plan.SoldAmt)))
+
+	// tokens cannot be sold back
+	err = k.Sell(s.Ctx, planId, buyer1, subAmt, math.ZeroInt())
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	s.Require().NoError(keeper.InvariantSubscriptions(*k)(s.Ctx))
+	s.Require().NoError(keeper.InvariantAccounting(*k)(s.Ctx))
+
+	// settle, allocating pro rata
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, allocation)))
+	err = k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(available.Equal(plan.FixedPriceSale.Allocated))
+	s.Require().True(plan.MaxAmountToSell.Equal(plan.SoldAmt))
+	s.Require().True(plan.FixedPriceSale.RefundReserve.IsPositive())
+
+	s.Require().NoError(keeper.InvariantPlan(*k)(s.Ctx))
+	s.Require().NoError(keeper.InvariantAccounting(*k)(s.Ctx))
+
+	// each buyer gets half of what was left to sell, and a third of what they paid back
+	for _, buyer := range []sdk.AccAddress{buyer1, buyer2} {
+		res, err := s.App.IROKeeper.QuerySubscription(s.Ctx, &types.QuerySubscriptionRequest{PlanId: planId, Buyer: buyer.String()})
+		s.Require().NoError(err)
+		s.Require().True(available.QuoRaw(2).Sub(res.Allocation.Amount).LTE(math.OneInt()), "allocation: %s", res.Allocation)
+		s.Require().True(res.Subscription.Paid.QuoRaw(3).Sub(res.Refund.Amount).Abs().LTE(math.OneInt()), "refund: %s", res.Refund)
+
+		liqBefore := s.App.BankKeeper.GetBalance(s.Ctx, buyer, "adym")
+		err = k.Claim(s.Ctx, planId, buyer)
+		s.Require().NoError(err)
+		s.Require().True(res.Allocation.IsEqual(s.App.BankKeeper.GetBalance(s.Ctx, buyer, rollappDenom)))
+		s.Require().True(liqBefore.Add(res.Refund).IsEqual(s.App.BankKeeper.GetBalance(s.Ctx, buyer, "adym")))
+
+		// claimed only once
+		err = k.Claim(s.Ctx, planId, buyer)
+		utest.IsErr(s.Require(), err, types.ErrNoTokensToClaim)
+	}
+
+	s.Require().NoError(keeper.InvariantAccounting(*k)(s.Ctx))
+	s.Require().NoError(keeper.InvariantSubscriptions(*k)(s.Ctx))
+	s.Require().Empty(k.GetPlanSubscriptions(s.Ctx, fmt.Sprintf("%d", plan.Id)))
+}
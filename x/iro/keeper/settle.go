@@ -26,9 +26,12 @@ func (k Keeper) AfterTransfersEnabled(ctx sdk.Context, rollappId, rollappIBCDeno
 //
 // This function performs the following steps:
 // - Validates that the "TotalAllocation.Amount" of the RA token are available in the module account.
+// - If the plan missed its soft cap, puts it in the refund state and returns the RA tokens to the rollapp owner instead.
 // - Burns any unsold FUT tokens in the module account.
+// - Allocates the tokens subscribed to a fixed price sale, reserving the liquidity to refund if oversubscribed.
+// - Removes the trade history of the plan.
 // - Marks the plan as settled, allowing users to claim tokens.
-// - Starts the vesting schedule for the owner tokens.
+// - Starts the vesting schedule for the owner tokens, and for the buyer tokens if the plan has one.
 // - Uses the raised liquidity and unsold tokens to bootstrap the rollapp's liquidity pool.
 func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error {
 	plan, found := k.GetPlanByRollapp(ctx, rollappId)
@@ -47,6 +50,14 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 		return errorsmod.Wrapf(gerrc.ErrInternal, "required: %s, available: %s", plan.TotalAllocation.String(), balance.String())
 	}
 
+	// trading continues on the liquidity pool, or never if refunded
+	k.PruneTradeHistory(ctx, fmt.Sprintf("%d", plan.Id))
+
+	// a plan short of its soft cap is refunded instead of settled
+	if plan.SoftCapMissed(ctx.BlockTime(), true) {
+		return k.settleMissedSoftCap(ctx, plan, balance)
+	}
+
 	// burn all the remaining IRO token.
 	iroTokenBalance := k.BK.GetBalance(ctx, k.AK.GetModuleAddress(types.ModuleName), plan.TotalAllocation.Denom)
 	err := k.BK.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(iroTokenBalance))
@@ -54,7 +65,16 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 		return err
 	}
 
-	raisedLiquidityAmt := k.BK.GetBalance(ctx, plan.GetAddress(), plan.LiquidityDenom).Amount
+	// allocate the tokens subscribed to a fixed price sale, up to the amount left to sell.
+	// the liquidity paid for the rest is refunded on claim, so it is not raised.
+	refundReserve := math.ZeroInt()
+	if plan.FixedPriceSale != nil {
+		plan.FixedPriceSale.Settle(plan.MaxAmountToSell.Sub(plan.SoldAmt))
+		plan.SoldAmt = plan.SoldAmt.Add(plan.FixedPriceSale.Allocated)
+		refundReserve = plan.FixedPriceSale.RefundReserve
+	}
+
+	raisedLiquidityAmt := k.BK.GetBalance(ctx, plan.GetAddress(), plan.LiquidityDenom).Amount.Sub(refundReserve)
 	poolTokens := raisedLiquidityAmt.ToLegacyDec().Mul(plan.LiquidityPart).TruncateInt()
 	ownerTokens := raisedLiquidityAmt.Sub(poolTokens)
 
@@ -63,6 +83,11 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 	plan.VestingPlan.StartTime = ctx.BlockHeader().Time.Add(plan.VestingPlan.StartTimeAfterSettlement)
 	plan.VestingPlan.EndTime = plan.VestingPlan.StartTime.Add(plan.VestingPlan.VestingDuration)
 
+	// start the vesting of the buyer tokens
+	if plan.BuyerVestingPlan != nil {
+		plan.BuyerVestingPlan.StartTime = ctx.BlockTime()
+	}
+
 	// mark the plan as `settled`, allowing users to claim tokens
 	plan.SettledDenom = rollappIBCDenom
 	k.SetPlan(ctx, plan)
@@ -89,6 +114,21 @@ func (k Keeper) Settle(ctx sdk.Context, rollappId, rollappIBCDenom string) error
 	return nil
 }
 
+// settleMissedSoftCap puts the plan in the refund state if it is not yet, and returns the RA tokens to the
+// rollapp owner. The plan is not settled.
+func (k Keeper) settleMissedSoftCap(ctx sdk.Context, plan types.Plan, allocation sdk.Coin) error {
+	if !plan.IsRefunding() {
+		err := k.startRefund(ctx, &plan)
+		if err != nil {
+			return err
+		}
+		k.SetPlan(ctx, plan)
+	}
+
+	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
+	return k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(allocation))
+}
+
 // bootstrapLiquidityPool bootstraps the liquidity pool with the raised liquidity and unsold tokens.
 //
 // This function performs the following steps:
@@ -109,8 +149,8 @@ func (k Keeper) bootstrapLiquidityPool(ctx sdk.Context, plan types.Plan, poolTok
 		return 0, 0, err
 	}
 
-	// find the raTokens needed to bootstrap the pool, to fulfill last price
-	raTokens, liquidityTokens := types.CalcLiquidityPoolTokens(unallocatedTokens, poolTokens, plan.SpotPrice())
+	// find the raTokens needed to bootstrap the pool, to fulfill last price of the sale
+	raTokens, liquidityTokens := types.CalcLiquidityPoolTokens(unallocatedTokens, poolTokens, plan.SpotPrice(ctx.BlockTime()))
 	rollappLiquidityCoin := sdk.NewCoin(plan.SettledDenom, raTokens)
 	baseLiquidityCoin := sdk.NewCoin(plan.LiquidityDenom, liquidityTokens)
 
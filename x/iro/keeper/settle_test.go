@@ -153,7 +153,7 @@ func (s *KeeperTestSuite) TestBootstrapLiquidityPool() {
 			s.Require().Equal(expectedTokensInPool, poolCoins.AmountOf(rollappDenom))
 
 			// Assert pool price
-			lastIROPrice := plan.SpotPrice()
+			lastIROPrice := plan.SpotPrice(s.Ctx.BlockTime())
 			price, err := pool.SpotPrice(s.Ctx, "adym", rollappDenom)
 			s.Require().NoError(err)
 			s.Require().Equal(lastIROPrice, price)
@@ -0,0 +1,171 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func (s *KeeperTestSuite) TestSoftCapRefund() {
+	k := s.App.IROKeeper
+	startTime := time.Now()
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	deadline := startTime.Add(time.Hour)
+	rollappDenom := "rollapp_denom"
+	rollappId, planId := s.createPlanFromMsg(startTime, allocation, func(m *types.MsgCreatePlan) {
+		softCap := types.NewSoftCap(math.NewInt(1_000_000).MulRaw(1e18), deadline)
+		m.SoftCap = &softCap
+	})
+	plan := k.MustGetPlan(s.Ctx, planId)
+	creationFee := s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), "adym")
+
+	buyer1, buyer2 := sample.Acc(), sample.Acc()
+	// the price is above the buffer funded by BuySomeTokens
+	s.FundAcc(buyer1, sdk.NewCoins(sdk.NewCoin("adym", allocation)))
+	s.FundAcc(buyer2, sdk.NewCoins(sdk.NewCoin("adym", allocation)))
+	s.BuySomeTokens(planId, buyer1, math.NewInt(1_000).MulRaw(1e18))
+	s.BuySomeTokens(planId, buyer2, math.NewInt(3_000).MulRaw(1e18))
+
+	// neither the creation fee nor donations count toward the soft cap
+	s.FundAcc(plan.GetAddress(), sdk.NewCoins(sdk.NewCoin("adym", allocation)))
+	liquidity := s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), "adym")
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(liquidity.Sub(creationFee).Amount.Sub(allocation).Equal(plan.SoftCap.Raised))
+	raised := liquidity.Amount
+
+	// no refunds before the deadline
+	err := k.Refund(s.Ctx, planId, buyer1)
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	// no trading after the deadline once missed
+	s.Ctx = s.Ctx.WithBlockTime(deadline)
+	s.FundAcc(buyer1, sdk.NewCoins(sdk.NewCoin("adym", allocation)))
+	err = k.Buy(s.Ctx, planId, buyer1, math.NewInt(1).MulRaw(1e18), allocation)
+	utest.IsErr(s.Require(), err, types.ErrSoftCapMissed)
+	err = k.Sell(s.Ctx, planId, buyer1, math.NewInt(1).MulRaw(1e18), math.ZeroInt())
+	utest.IsErr(s.Require(), err, types.ErrSoftCapMissed)
+
+	// the first refund starts the refund state
+	tokens1 := s.App.BankKeeper.GetBalance(s.Ctx, buyer1, plan.TotalAllocation.Denom)
+	liqBefore := s.App.BankKeeper.GetBalance(s.Ctx, buyer1, "adym")
+	err = k.Refund(s.Ctx, planId, buyer1)
+	s.Require().NoError(err)
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(plan.IsRefunding())
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, buyer1, plan.TotalAllocation.Denom).IsZero())
+	refund1 := s.App.BankKeeper.GetBalance(s.Ctx, buyer1, "adym").Sub(liqBefore)
+	s.Require().True(raised.QuoRaw(4).Sub(refund1.Amount).Abs().LTE(math.OneInt()), "refund: %s", refund1)
+	s.Require().True(tokens1.Amount.MulRaw(3).Equal(plan.SoftCap.RefundSupply))
+
+	// refunded only once
+	err = k.Refund(s.Ctx, planId, buyer1)
+	utest.IsErr(s.Require(), err, types.ErrNoTokensToClaim)
+
+	s.Require().NoError(keeper.InvariantPlan(*k)(s.Ctx))
+	s.Require().NoError(keeper.InvariantAccounting(*k)(s.Ctx))
+
+	// the settlement returns the RA tokens to the rollapp owner, and does not settle the plan
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, allocation)))
+	err = k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().False(plan.IsSettled())
+	owner := s.App.RollappKeeper.MustGetRollappOwner(s.Ctx, rollappId)
+	s.Require().True(allocation.Equal(s.App.BankKeeper.GetBalance(s.Ctx, owner, rollappDenom).Amount))
+
+	err = k.Claim(s.Ctx, planId, buyer2)
+	utest.IsErr(s.Require(), err, types.ErrPlanNotSettled)
+
+	// the last refund gets the rest of the liquidity
+	liqBefore = s.App.BankKeeper.GetBalance(s.Ctx, buyer2, "adym")
+	err = k.Refund(s.Ctx, planId, buyer2)
+	s.Require().NoError(err)
+	refund2 := s.App.BankKeeper.GetBalance(s.Ctx, buyer2, "adym").Sub(liqBefore)
+	s.Require().True(raised.Equal(refund1.Amount.Add(refund2.Amount)))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), "adym").IsZero())
+
+	s.Require().NoError(keeper.InvariantPlan(*k)(s.Ctx))
+	s.Require().NoError(keeper.InvariantAccounting(*k)(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestSoftCapFixedPriceSale() {
+	k := s.App.IROKeeper
+	startTime := time.Now()
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	rollappDenom := "rollapp_denom"
+	rollappId, planId := s.createPlanFromMsg(startTime, allocation, func(m *types.MsgCreatePlan) {
+		m.FixedPriceSale = &types.FixedPriceSale{Price: math.LegacyMustNewDecFromStr("0.1")}
+		softCap := types.NewSoftCap(math.NewInt(1_000_000).MulRaw(1e18), startTime.Add(time.Hour))
+		m.SoftCap = &softCap
+	})
+
+	buyer := sample.Acc()
+	s.BuySomeTokens(planId, buyer, math.NewInt(1_000).MulRaw(1e18))
+	plan := k.MustGetPlan(s.Ctx, planId)
+	raised := s.App.BankKeeper.GetBalance(s.Ctx, plan.GetAddress(), "adym").Amount
+
+	// settling before the deadline short of the soft cap starts the refund state
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, allocation)))
+	err := k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+	plan = k.MustGetPlan(s.Ctx, planId)
+	s.Require().True(plan.IsRefunding())
+	s.Require().False(plan.IsSettled())
+
+	s.Require().NoError(keeper.InvariantAccounting(*k)(s.Ctx))
+	s.Require().NoError(keeper.InvariantSubscriptions(*k)(s.Ctx))
+
+	// the only subscriber gets all the liquidity, including the creation fee
+	liqBefore := s.App.BankKeeper.GetBalance(s.Ctx, buyer, "adym")
+	err = k.Refund(s.Ctx, planId, buyer)
+	s.Require().NoError(err)
+	s.Require().True(liqBefore.AddAmount(raised).IsEqual(s.App.BankKeeper.GetBalance(s.Ctx, buyer, "adym")))
+	_, found := k.GetSubscription(s.Ctx, planId, buyer.String())
+	s.Require().False(found)
+
+	s.Require().NoError(keeper.InvariantAccounting(*k)(s.Ctx))
+	s.Require().NoError(keeper.InvariantSubscriptions(*k)(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestSoftCapReached() {
+	k := s.App.IROKeeper
+	startTime := time.Now()
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	deadline := startTime.Add(time.Hour)
+	_, planId := s.createPlanFromMsg(startTime, allocation, func(m *types.MsgCreatePlan) {
+		softCap := types.NewSoftCap(math.OneInt(), deadline)
+		m.SoftCap = &softCap
+	})
+	plan := k.MustGetPlan(s.Ctx, planId)
+
+	buyer := sample.Acc()
+	buyAmt := math.NewInt(1_000).MulRaw(1e18)
+	s.BuySomeTokens(planId, buyer, buyAmt)
+
+	// set the soft cap to just what was raised
+	plan = k.MustGetPlan(s.Ctx, planId)
+	plan.SoftCap.Amount = plan.SoftCap.Raised
+	k.SetPlan(s.Ctx, plan)
+
+	// trading continues after the deadline, but selling cannot drop the raised liquidity below the soft cap
+	s.Ctx = s.Ctx.WithBlockTime(deadline)
+	err := k.Sell(s.Ctx, planId, buyer, buyAmt, math.ZeroInt())
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+	s.BuySomeTokens(planId, buyer, buyAmt)
+	err = k.Sell(s.Ctx, planId, buyer, buyAmt, math.ZeroInt())
+	s.Require().NoError(err)
+
+	// no refunds
+	err = k.Refund(s.Ctx, planId, buyer)
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+// SetSubscription sets the subscription of a buyer to a fixed price sale
+func (k Keeper) SetSubscription(ctx sdk.Context, sub types.Subscription) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&sub)
+	store.Set(types.SubscriptionKey(fmt.Sprintf("%d", sub.PlanId), sub.Buyer), b)
+}
+
+// GetSubscription returns the subscription of the buyer to the plan
+func (k Keeper) GetSubscription(ctx sdk.Context, planId, buyer string) (val types.Subscription, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.SubscriptionKey(planId, buyer))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveSubscription removes the subscription of the buyer to the plan
+func (k Keeper) RemoveSubscription(ctx sdk.Context, planId, buyer string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.SubscriptionKey(planId, buyer))
+}
+
+// GetPlanSubscriptions returns the subscriptions to the plan
+func (k Keeper) GetPlanSubscriptions(ctx sdk.Context, planId string) []types.Subscription {
+	return k.getSubscriptions(ctx, types.PlanSubscriptionsKey(planId))
+}
+
+// GetAllSubscriptions returns the subscriptions to all the plans
+func (k Keeper) GetAllSubscriptions(ctx sdk.Context) []types.Subscription {
+	return k.getSubscriptions(ctx, types.SubscriptionKeyPrefix)
+}
+
+func (k Keeper) getSubscriptions(ctx sdk.Context, pref []byte) (list []types.Subscription) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pref)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Subscription
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -18,6 +19,12 @@ import (
 // If all preconditions are met, it sets the TradingEnabled flag to true and stores the plan back in the
 // store.
 func (k Keeper) EnableTrading(ctx sdk.Context, planId string, submitter sdk.AccAddress) error {
+	return k.EnableTradingWithWhitelist(ctx, planId, submitter, nil)
+}
+
+// EnableTradingWithWhitelist enables trading for a given plan as EnableTrading, replacing the whitelist of
+// the plan if one is given. The whitelist phase starts with trading.
+func (k Keeper) EnableTradingWithWhitelist(ctx sdk.Context, planId string, submitter sdk.AccAddress, whitelist *types.Whitelist) error {
 	plan, ok := k.GetPlan(ctx, planId)
 	if !ok {
 		return types.ErrPlanNotFound
@@ -41,6 +48,13 @@ func (k Keeper) EnableTrading(ctx sdk.Context, planId string, submitter sdk.AccA
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "plan already settled")
 	}
 
+	if whitelist != nil {
+		if err := whitelist.ValidateBasic(); err != nil {
+			return errors.Join(gerrc.ErrInvalidArgument, err)
+		}
+		plan.Whitelist = whitelist
+	}
+
 	plan.EnableTradingWithStartTime(ctx.BlockTime())
 	k.SetPlan(ctx, plan)
 
@@ -48,7 +62,7 @@ func (k Keeper) EnableTrading(ctx sdk.Context, planId string, submitter sdk.AccA
 	return nil
 }
 
-// Buy buys fixed amount of allocation with price according to the price curve
+// Buy buys fixed amount of allocation with price according to the sale mechanism of the plan
 func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountTokensToBuy, maxCostAmt math.Int) error {
 	plan, err := k.GetTradeableIRO(ctx, planId, buyer)
 	if err != nil {
@@ -60,8 +74,8 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 		return types.ErrInsufficientTokens
 	}
 
-	// Calculate costAmt for buying amountTokensToBuy over the price curve
-	costAmt := plan.BondingCurve.Cost(plan.SoldAmt, plan.SoldAmt.Add(amountTokensToBuy))
+	// Calculate costAmt for buying amountTokensToBuy with the sale mechanism
+	costAmt := plan.SaleMechanism().Cost(plan.SoldAmt, plan.SoldAmt.Add(amountTokensToBuy), ctx.BlockTime())
 	costPlusTakerFeeAmt, takerFeeAmt, err := k.ApplyTakerFee(costAmt, k.GetParams(ctx).TakerFee, true)
 	if err != nil {
 		return err
@@ -72,49 +86,10 @@ func (k Keeper) Buy(ctx sdk.Context, planId string, buyer sdk.AccAddress, amount
 		return errorsmod.Wrapf(types.ErrInvalidExpectedOutAmount, "maxCost: %s, cost: %s, fee: %s", maxCostAmt.String(), costAmt.String(), takerFeeAmt.String())
 	}
 
-	// Charge taker fee
-	takerFee := sdk.NewCoin(plan.LiquidityDenom, takerFeeAmt)
-	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
-	err = k.chargeTakerFee(ctx, takerFee, buyer, &owner)
-	if err != nil {
-		return err
-	}
-
-	// Send liquidity token from buyer to the plan. The liquidity token sent directly to the plan's module account
-	cost := sdk.NewCoin(plan.LiquidityDenom, costAmt)
-	err = k.BK.SendCoins(ctx, buyer, plan.GetAddress(), sdk.NewCoins(cost))
-	if err != nil {
-		return err
-	}
-
-	// send allocated tokens from the plan to the buyer
-	err = k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, sdk.NewCoins(sdk.NewCoin(plan.TotalAllocation.Denom, amountTokensToBuy)))
-	if err != nil {
-		return err
-	}
-
-	// Update plan
-	plan.SoldAmt = plan.SoldAmt.Add(amountTokensToBuy)
-	k.SetPlan(ctx, *plan)
-
-	// Emit event
-	err = uevent.EmitTypedEvent(ctx, &types.EventBuy{
-		Buyer:        buyer.String(),
-		PlanId:       planId,
-		RollappId:    plan.RollappId,
-		Amount:       sdk.NewCoin(plan.TotalAllocation.Denom, amountTokensToBuy),
-		Cost:         sdk.NewCoin(plan.LiquidityDenom, costAmt),
-		TakerFee:     takerFee,
-		ClosingPrice: plan.SpotPrice(),
-	})
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return k.buy(ctx, plan, buyer, amountTokensToBuy, costAmt, takerFeeAmt)
 }
 
-// BuyExactSpend uses exact amount of liquidity to buy tokens on the curve
+// BuyExactSpend uses exact amount of liquidity to buy tokens with the sale mechanism of the plan
 func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddress, amountToSpend, minTokensAmt math.Int) error {
 	plan, err := k.GetTradeableIRO(ctx, planId, buyer)
 	if err != nil {
@@ -128,7 +103,7 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 	}
 
 	// calculate the amount of tokens possible to buy with the amount to spend
-	tokensOutAmt, err := plan.BondingCurve.TokensForExactInAmount(plan.SoldAmt, toSpendMinusTakerFeeAmt)
+	tokensOutAmt, err := plan.SaleMechanism().TokensForExactInAmount(plan.SoldAmt, toSpendMinusTakerFeeAmt, ctx.BlockTime())
 	if err != nil {
 		return err
 	}
@@ -143,6 +118,20 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 		return types.ErrInsufficientTokens
 	}
 
+	return k.buy(ctx, plan, buyer, tokensOutAmt, toSpendMinusTakerFeeAmt, takerFeeAmt)
+}
+
+// buy charges the taker fee and the cost of the tokens from the buyer, and sends the tokens to the buyer.
+// In a fixed price sale, the tokens are only subscribed, and allocated pro rata at settlement.
+func (k Keeper) buy(ctx sdk.Context, plan *types.Plan, buyer sdk.AccAddress, amt, costAmt, takerFeeAmt math.Int) error {
+	// Enforce the whitelist and the caps
+	err := k.checkPurchase(ctx, *plan, buyer, amt)
+	if err != nil {
+		return err
+	}
+
+	priceBefore := plan.SpotPrice(ctx.BlockTime())
+
 	// Charge taker fee
 	takerFee := sdk.NewCoin(plan.LiquidityDenom, takerFeeAmt)
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
@@ -152,31 +141,37 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 	}
 
 	// Send liquidity token from buyer to the plan. The liquidity token sent directly to the plan's module account
-	cost := sdk.NewCoin(plan.LiquidityDenom, toSpendMinusTakerFeeAmt)
+	cost := sdk.NewCoin(plan.LiquidityDenom, costAmt)
 	err = k.BK.SendCoins(ctx, buyer, plan.GetAddress(), sdk.NewCoins(cost))
 	if err != nil {
 		return err
 	}
 
-	// send allocated tokens from the plan to the buyer
-	err = k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, sdk.NewCoins(sdk.NewCoin(plan.TotalAllocation.Denom, tokensOutAmt)))
-	if err != nil {
-		return err
+	if plan.FixedPriceSale != nil {
+		k.subscribe(ctx, plan, buyer, amt, costAmt)
+	} else {
+		// send allocated tokens from the plan to the buyer
+		err = k.BK.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyer, sdk.NewCoins(sdk.NewCoin(plan.TotalAllocation.Denom, amt)))
+		if err != nil {
+			return err
+		}
+		plan.SoldAmt = plan.SoldAmt.Add(amt)
 	}
 
 	// Update plan
-	plan.SoldAmt = plan.SoldAmt.Add(tokensOutAmt)
+	plan.AddRaised(costAmt)
 	k.SetPlan(ctx, *plan)
+	k.recordTrade(ctx, *plan, buyer, true, amt, costAmt, priceBefore)
 
 	// Emit event
 	err = uevent.EmitTypedEvent(ctx, &types.EventBuy{
 		Buyer:        buyer.String(),
-		PlanId:       planId,
+		PlanId:       fmt.Sprintf("%d", plan.Id),
 		RollappId:    plan.RollappId,
-		Amount:       sdk.NewCoin(plan.TotalAllocation.Denom, tokensOutAmt),
-		Cost:         sdk.NewCoin(plan.LiquidityDenom, toSpendMinusTakerFeeAmt),
+		Amount:       sdk.NewCoin(plan.TotalAllocation.Denom, amt),
+		Cost:         cost,
 		TakerFee:     takerFee,
-		ClosingPrice: plan.SpotPrice(),
+		ClosingPrice: plan.SpotPrice(ctx.BlockTime()),
 	})
 	if err != nil {
 		return err
@@ -185,15 +180,36 @@ func (k Keeper) BuyExactSpend(ctx sdk.Context, planId string, buyer sdk.AccAddre
 	return nil
 }
 
-// Sell sells allocation with price according to the price curve
+// subscribe adds amt, paid with costAmt, to the subscription of the buyer to the fixed price sale of the plan
+func (k Keeper) subscribe(ctx sdk.Context, plan *types.Plan, buyer sdk.AccAddress, amt, costAmt math.Int) {
+	planId := fmt.Sprintf("%d", plan.Id)
+	sub, found := k.GetSubscription(ctx, planId, buyer.String())
+	if !found {
+		sub = types.Subscription{PlanId: plan.Id, Buyer: buyer.String(), Amount: math.ZeroInt(), Paid: math.ZeroInt()}
+	}
+	sub.Amount = sub.Amount.Add(amt)
+	sub.Paid = sub.Paid.Add(costAmt)
+	k.SetSubscription(ctx, sub)
+
+	plan.FixedPriceSale.Subscribed = plan.FixedPriceSale.Subscribed.Add(amt)
+	plan.FixedPriceSale.Paid = plan.FixedPriceSale.Paid.Add(costAmt)
+}
+
+// Sell sells allocation with price according to the price curve. Only the tokens bought on the bonding curve
+// can be sold back.
 func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amountTokensToSell, minIncomeAmt math.Int) error {
 	plan, err := k.GetTradeableIRO(ctx, planId, seller)
 	if err != nil {
 		return err
 	}
 
+	sale := plan.SaleMechanism()
+	if !sale.Sellable() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "tokens of the sale cannot be sold back")
+	}
+
 	// Calculate the value of the tokens to sell according to the price curve
-	costAmt := plan.BondingCurve.Cost(plan.SoldAmt.Sub(amountTokensToSell), plan.SoldAmt)
+	costAmt := sale.Cost(plan.SoldAmt.Sub(amountTokensToSell), plan.SoldAmt, ctx.BlockTime())
 	costMinusTakerFeeAmt, takerFeeAmt, err := k.ApplyTakerFee(costAmt, k.GetParams(ctx).TakerFee, false)
 	if err != nil {
 		return err
@@ -204,6 +220,12 @@ func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amou
 		return errorsmod.Wrapf(types.ErrInvalidMinCost, "minCost: %s, cost: %s, fee: %s", minIncomeAmt.String(), costAmt.String(), takerFeeAmt.String())
 	}
 
+	// a soft cap reached by its deadline must stay reached
+	if plan.SoftCap != nil && !ctx.BlockTime().Before(plan.SoftCap.Deadline) &&
+		plan.SoftCap.Raised.Sub(costAmt).LT(plan.SoftCap.Amount) {
+		return errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "sell would drop the raised liquidity below the soft cap: %s", plan.SoftCap.Amount)
+	}
+
 	// send allocated tokens from seller to the plan
 	err = k.BK.SendCoinsFromAccountToModule(ctx, seller, types.ModuleName, sdk.NewCoins(sdk.NewCoin(plan.TotalAllocation.Denom, amountTokensToSell)))
 	if err != nil {
@@ -218,8 +240,11 @@ func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amou
 	}
 
 	// Update plan
+	priceBefore := plan.SpotPrice(ctx.BlockTime())
 	plan.SoldAmt = plan.SoldAmt.Sub(amountTokensToSell)
+	plan.AddRaised(costAmt.Neg())
 	k.SetPlan(ctx, *plan)
+	k.recordTrade(ctx, *plan, seller, false, amountTokensToSell, costAmt, priceBefore)
 
 	// Charge taker fee
 	takerFee := sdk.NewCoin(plan.LiquidityDenom, takerFeeAmt)
@@ -237,7 +262,7 @@ func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amou
 		Amount:       sdk.NewCoin(plan.TotalAllocation.Denom, amountTokensToSell),
 		Revenue:      sdk.NewCoin(plan.LiquidityDenom, costAmt),
 		TakerFee:     takerFee,
-		ClosingPrice: plan.SpotPrice(),
+		ClosingPrice: plan.SpotPrice(ctx.BlockTime()),
 	})
 	if err != nil {
 		return err
@@ -249,7 +274,9 @@ func (k Keeper) Sell(ctx sdk.Context, planId string, seller sdk.AccAddress, amou
 // GetTradeableIRO returns the tradeable IRO plan
 // - plan must exist
 // - plan must not be settled
+// - plan must not have missed its soft cap
 // - plan must have started (unless the trader is the owner)
+// The whitelist of the plan is enforced on buying, see checkPurchase.
 func (k Keeper) GetTradeableIRO(ctx sdk.Context, planId string, trader sdk.AccAddress) (*types.Plan, error) {
 	plan, found := k.GetPlan(ctx, planId)
 	if !found {
@@ -260,6 +287,10 @@ func (k Keeper) GetTradeableIRO(ctx sdk.Context, planId string, trader sdk.AccAd
 		return nil, errorsmod.Wrapf(types.ErrPlanSettled, "planId: %d", plan.Id)
 	}
 
+	if plan.SoftCapMissed(ctx.BlockTime(), false) {
+		return nil, errorsmod.Wrapf(types.ErrSoftCapMissed, "planId: %d", plan.Id)
+	}
+
 	// Validate start time started (unless the trader is the owner)
 	owner := k.rk.MustGetRollappOwner(ctx, plan.RollappId)
 	if owner.Equals(trader) {
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+/*
+The last trades of each plan are kept in a ring buffer of the size set in the params, by their sequence number,
+together with candles of its spot price over the intervals set in the params. The candles of the intervals
+older than the candle history size are removed as new ones start. The whole history of a plan is removed when
+it is settled, as trading then continues on the liquidity pool.
+*/
+
+// SetTrade sets a trade of a plan
+func (k Keeper) SetTrade(ctx sdk.Context, t types.Trade) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&t)
+	store.Set(types.TradeKey(fmt.Sprintf("%d", t.PlanId), t.Seq), b)
+}
+
+// GetPlanTrades returns the trades of the plan, oldest first
+func (k Keeper) GetPlanTrades(ctx sdk.Context, planId string) []types.Trade {
+	return k.getTrades(ctx, types.PlanTradesKey(planId))
+}
+
+// GetAllTrades returns the trades of all the plans
+func (k Keeper) GetAllTrades(ctx sdk.Context) []types.Trade {
+	return k.getTrades(ctx, types.TradeKeyPrefix)
+}
+
+func (k Keeper) getTrades(ctx sdk.Context, pref []byte) (list []types.Trade) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pref)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Trade
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// SetCandle sets a candle of a plan
+func (k Keeper) SetCandle(ctx sdk.Context, c types.Candle) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&c)
+	store.Set(types.CandleKey(fmt.Sprintf("%d", c.PlanId), c.StartTime), b)
+}
+
+// GetPlanCandles returns the candles of the plan, oldest first
+func (k Keeper) GetPlanCandles(ctx sdk.Context, planId string) []types.Candle {
+	return k.getCandles(ctx, types.PlanCandlesKey(planId))
+}
+
+// GetAllCandles returns the candles of all the plans
+func (k Keeper) GetAllCandles(ctx sdk.Context) []types.Candle {
+	return k.getCandles(ctx, types.CandleKeyPrefix)
+}
+
+func (k Keeper) getCandles(ctx sdk.Context, pref []byte) (list []types.Candle) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), pref)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Candle
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// recordTrade adds a trade of amt tokens for costAmt to the trade history and the candles of the plan, from
+// the spot price before it to the spot price of the plan after it
+func (k Keeper) recordTrade(ctx sdk.Context, plan types.Plan, trader sdk.AccAddress, buy bool, amt, costAmt math.Int, priceBefore math.LegacyDec) {
+	params := k.GetParams(ctx)
+	planId := fmt.Sprintf("%d", plan.Id)
+	closingPrice := plan.SpotPrice(ctx.BlockTime())
+
+	if 0 < params.TradeHistorySize {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PlanTradesKey(planId))
+
+		// the sequence number follows the one of the newest trade
+		seq := uint64(0)
+		it := storetypes.KVStoreReversePrefixIterator(store, []byte{})
+		if it.Valid() {
+			var last types.Trade
+			k.cdc.MustUnmarshal(it.Value(), &last)
+			seq = last.Seq + 1
+		}
+		it.Close() // nolint: errcheck
+
+		k.SetTrade(ctx, types.Trade{
+			PlanId:       plan.Id,
+			Seq:          seq,
+			Trader:       trader.String(),
+			Buy:          buy,
+			Amount:       amt,
+			Cost:         costAmt,
+			ClosingPrice: closingPrice,
+			Time:         ctx.BlockTime(),
+			Height:       ctx.BlockHeight(),
+		})
+
+		// remove the trades out of the buffer
+		if params.TradeHistorySize <= seq {
+			k.pruneTrades(ctx, planId, seq-params.TradeHistorySize+1)
+		}
+	}
+
+	if 0 < params.CandleInterval && 0 < params.CandleHistorySize {
+		startTime := ctx.BlockTime().Truncate(params.CandleInterval)
+		store := ctx.KVStore(k.storeKey)
+		var c types.Candle
+		if b := store.Get(types.CandleKey(planId, startTime)); b != nil {
+			k.cdc.MustUnmarshal(b, &c)
+		} else {
+			c = types.NewCandle(plan.Id, startTime, priceBefore)
+
+			// remove the candles out of the history
+			minStartTime := startTime.Add(-params.CandleInterval * time.Duration(params.CandleHistorySize-1))
+			k.pruneCandles(ctx, planId, minStartTime)
+		}
+		c.AddTrade(amt, closingPrice)
+		k.SetCandle(ctx, c)
+	}
+}
+
+// pruneTrades removes the trades of the plan before the sequence number
+func (k Keeper) pruneTrades(ctx sdk.Context, planId string, seq uint64) {
+	k.removeRange(ctx, types.PlanTradesKey(planId), func(key []byte) bool {
+		return string(key) < string(types.TradeKey(planId, seq))
+	})
+}
+
+// pruneCandles removes the candles of the plan starting before the time
+func (k Keeper) pruneCandles(ctx sdk.Context, planId string, startTime time.Time) {
+	k.removeRange(ctx, types.PlanCandlesKey(planId), func(key []byte) bool {
+		return string(key) < string(types.CandleKey(planId, startTime))
+	})
+}
+
+// PruneTradeHistory removes the trades and the candles of the plan
+func (k Keeper) PruneTradeHistory(ctx sdk.Context, planId string) {
+	k.removeRange(ctx, types.PlanTradesKey(planId), func([]byte) bool { return true })
+	k.removeRange(ctx, types.PlanCandlesKey(planId), func([]byte) bool { return true })
+}
+
+// removeRange removes the keys with the prefix, in order, while before returns true for them
+func (k Keeper) removeRange(ctx sdk.Context, pref []byte, before func(key []byte) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, pref)
+
+	var keys [][]byte
+	for ; iterator.Valid() && before(iterator.Key()); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close() // nolint: errcheck
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
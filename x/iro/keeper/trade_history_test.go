@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func (s *KeeperTestSuite) TestTradeHistory() {
+	k := s.App.IROKeeper
+	params := k.GetParams(s.Ctx)
+	params.TradeHistorySize = 3
+	params.CandleInterval = time.Hour
+	params.CandleHistorySize = 2
+	k.SetParams(s.Ctx, params)
+
+	startTime := time.Now().Truncate(time.Hour)
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	rollappDenom := "rollapp_denom"
+	rollappId, planId := s.createPlanFromMsg(startTime, allocation, func(*types.MsgCreatePlan) {})
+
+	trader := sample.Acc()
+	// the price is above the buffer funded by BuySomeTokens
+	s.FundAcc(trader, sdk.NewCoins(sdk.NewCoin("adym", allocation)))
+	amt := math.NewInt(1_000).MulRaw(1e18)
+	prices := []math.LegacyDec{k.MustGetPlan(s.Ctx, planId).SpotPrice(s.Ctx.BlockTime())}
+
+	// two trades in the first hour, then one in each of the next two
+	for _, elapsed := range []time.Duration{0, 30 * time.Minute, time.Hour, 2 * time.Hour} {
+		s.Ctx = s.Ctx.WithBlockTime(startTime.Add(elapsed))
+		s.BuySomeTokens(planId, trader, amt)
+		prices = append(prices, k.MustGetPlan(s.Ctx, planId).SpotPrice(s.Ctx.BlockTime()))
+	}
+	err := k.Sell(s.Ctx, planId, trader, amt, math.ZeroInt())
+	s.Require().NoError(err)
+	prices = append(prices, k.MustGetPlan(s.Ctx, planId).SpotPrice(s.Ctx.BlockTime()))
+
+	// only the last trades are kept
+	res, err := s.App.IROKeeper.QueryTrades(s.Ctx, &types.QueryTradesRequest{PlanId: planId})
+	s.Require().NoError(err)
+	s.Require().Len(res.Trades, 3)
+	for i, trade := range res.Trades {
+		s.Require().Equal(uint64(i+2), trade.Seq)
+		s.Require().Equal(trader.String(), trade.Trader)
+		s.Require().True(amt.Equal(trade.Amount))
+		s.Require().True(prices[i+3].Equal(trade.ClosingPrice))
+	}
+	s.Require().False(res.Trades[2].Buy)
+
+	// only the candles of the last intervals are kept
+	candlesRes, err := s.App.IROKeeper.QueryCandles(s.Ctx, &types.QueryCandlesRequest{PlanId: planId})
+	s.Require().NoError(err)
+	s.Require().Equal(time.Hour, candlesRes.Interval)
+	s.Require().Len(candlesRes.Candles, 2)
+
+	c := candlesRes.Candles[0]
+	s.Require().True(startTime.Add(time.Hour).Equal(c.StartTime))
+	s.Require().True(prices[2].Equal(c.Open))
+	s.Require().True(prices[3].Equal(c.Close))
+	s.Require().True(amt.Equal(c.Volume))
+	s.Require().NoError(c.ValidateBasic())
+
+	// the sell in the last interval brings the price back to the open
+	c = candlesRes.Candles[1]
+	s.Require().True(startTime.Add(2 * time.Hour).Equal(c.StartTime))
+	s.Require().Equal(uint64(2), c.Trades)
+	s.Require().True(prices[3].Equal(c.Open))
+	s.Require().True(prices[4].Equal(c.High))
+	s.Require().True(prices[5].Equal(c.Close))
+	s.Require().True(amt.MulRaw(2).Equal(c.Volume))
+
+	// the history is removed on settlement
+	s.FundModuleAcc(types.ModuleName, sdk.NewCoins(sdk.NewCoin(rollappDenom, allocation)))
+	err = k.Settle(s.Ctx, rollappId, rollappDenom)
+	s.Require().NoError(err)
+	s.Require().Empty(k.GetPlanTrades(s.Ctx, planId))
+	s.Require().Empty(k.GetPlanCandles(s.Ctx, planId))
+}
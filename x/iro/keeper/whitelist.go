@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+/*
+A plan can have a whitelist. From the start time of the plan and for the duration of the whitelist phase, only
+the whitelisted accounts can buy, each up to the whitelist cap. Then the sale is public, optionally with a cap
+per account on the total it bought. The purchases of each account are tracked against the caps, and sells do
+not free up the caps. Accounts of a Merkle tree whitelist prove they are in it once, when they buy.
+*/
+
+// SetPurchases sets the purchases of an account from a plan with a whitelist
+func (k Keeper) SetPurchases(ctx sdk.Context, p types.Purchases) {
+	store := ctx.KVStore(k.storeKey)
+	b := k.cdc.MustMarshal(&p)
+	store.Set(types.PurchasesKey(fmt.Sprintf("%d", p.PlanId), p.Buyer), b)
+}
+
+// GetPurchases returns the purchases of the account from the plan, empty if it did not buy yet
+func (k Keeper) GetPurchases(ctx sdk.Context, planId uint64, buyer string) types.Purchases {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.PurchasesKey(fmt.Sprintf("%d", planId), buyer))
+	if b == nil {
+		return types.NewPurchases(planId, buyer)
+	}
+
+	var val types.Purchases
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// GetAllPurchases returns the purchases from all the plans
+func (k Keeper) GetAllPurchases(ctx sdk.Context) (list []types.Purchases) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PurchasesKeyPrefix)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close() // nolint: errcheck
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Purchases
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+	return
+}
+
+// ProveWhitelisted records that the buyer is in the Merkle tree of the whitelist of the plan, if the proof
+// shows it
+func (k Keeper) ProveWhitelisted(ctx sdk.Context, planId string, buyer sdk.AccAddress, proof [][]byte) error {
+	plan, found := k.GetPlan(ctx, planId)
+	if !found {
+		return types.ErrPlanNotFound
+	}
+	if plan.Whitelist == nil || len(plan.Whitelist.MerkleRoot) == 0 {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "plan has no merkle tree whitelist")
+	}
+	if !plan.Whitelist.VerifyProof(buyer.String(), proof) {
+		return errorsmod.Wrap(types.ErrNotWhitelisted, "invalid proof")
+	}
+
+	p := k.GetPurchases(ctx, plan.Id, buyer.String())
+	p.Proven = true
+	k.SetPurchases(ctx, p)
+	return nil
+}
+
+// whitelisted returns whether the account is known to be in the whitelist of the plan
+func whitelisted(plan types.Plan, p types.Purchases) bool {
+	return plan.Whitelist.Listed(p.Buyer) || p.Proven
+}
+
+// checkPurchase checks the buyer can buy amt from the plan under its whitelist, and counts it against its
+// caps. The rollapp owner is not subject to the whitelist, as it can buy before trading is enabled.
+func (k Keeper) checkPurchase(ctx sdk.Context, plan types.Plan, buyer sdk.AccAddress, amt math.Int) error {
+	if plan.Whitelist == nil {
+		return nil
+	}
+	if k.rk.MustGetRollappOwner(ctx, plan.RollappId).Equals(buyer) {
+		return nil
+	}
+
+	p := k.GetPurchases(ctx, plan.Id, buyer.String())
+	if plan.InWhitelistPhase(ctx.BlockTime()) && !whitelisted(plan, p) {
+		return errorsmod.Wrapf(types.ErrNotWhitelisted, "buyer: %s", buyer)
+	}
+	if limit, ok := plan.Cap(ctx.BlockTime()); ok && p.Amount.Add(amt).GT(limit) {
+		return errorsmod.Wrapf(types.ErrCapExceeded, "cap: %s, bought: %s", limit, p.Amount)
+	}
+
+	p.Amount = p.Amount.Add(amt)
+	k.SetPurchases(ctx, p)
+	return nil
+}
+
+// RemainingCap returns how many tokens the account can still buy from the plan, and whether a cap applies
+func (k Keeper) RemainingCap(ctx sdk.Context, plan types.Plan, account string) (math.Int, bool) {
+	limit, ok := plan.Cap(ctx.BlockTime())
+	if !ok {
+		return math.Int{}, false
+	}
+	p := k.GetPurchases(ctx, plan.Id, account)
+	return math.MaxInt(limit.Sub(p.Amount), math.ZeroInt()), true
+}
@@ -0,0 +1,124 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func (s *KeeperTestSuite) TestWhitelist() {
+	k := s.App.IROKeeper
+	startTime := time.Now()
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	listed, other := sample.Acc(), sample.Acc()
+	whitelistCap := math.NewInt(100).MulRaw(1e18)
+	publicCap := math.NewInt(150).MulRaw(1e18)
+	rollappId, planId := s.createPlanFromMsg(startTime, allocation, func(m *types.MsgCreatePlan) {
+		m.Whitelist = &types.Whitelist{
+			Addresses: []string{listed.String()},
+			Cap:       whitelistCap,
+			Duration:  time.Hour,
+			PublicCap: publicCap,
+		}
+	})
+	maxCost := math.NewInt(1_000_000).MulRaw(1e18)
+	s.FundAcc(listed, sdk.NewCoins(sdk.NewCoin("adym", maxCost)))
+	s.FundAcc(other, sdk.NewCoins(sdk.NewCoin("adym", maxCost)))
+
+	// only the whitelisted accounts can buy in the whitelist phase, up to the cap
+	err := k.Buy(s.Ctx, planId, other, math.NewInt(1).MulRaw(1e18), maxCost)
+	utest.IsErr(s.Require(), err, types.ErrNotWhitelisted)
+	err = k.Buy(s.Ctx, planId, listed, whitelistCap, maxCost)
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, listed, math.NewInt(1).MulRaw(1e18), maxCost)
+	utest.IsErr(s.Require(), err, types.ErrCapExceeded)
+
+	res, err := s.App.IROKeeper.QueryRemainingCap(s.Ctx, &types.QueryRemainingCapRequest{PlanId: planId, Account: listed.String()})
+	s.Require().NoError(err)
+	s.Require().True(res.WhitelistPhase)
+	s.Require().True(res.Whitelisted)
+	s.Require().True(res.Capped)
+	s.Require().True(res.Remaining.IsZero())
+
+	// the rollapp owner is not subject to the whitelist
+	owner := s.App.RollappKeeper.MustGetRollappOwner(s.Ctx, rollappId)
+	s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin("adym", maxCost)))
+	err = k.Buy(s.Ctx, planId, owner, whitelistCap.MulRaw(2), maxCost)
+	s.Require().NoError(err)
+
+	// once public, anyone can buy up to the public cap, counting what they bought before
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Hour))
+	res, err = s.App.IROKeeper.QueryRemainingCap(s.Ctx, &types.QueryRemainingCapRequest{PlanId: planId, Account: listed.String()})
+	s.Require().NoError(err)
+	s.Require().False(res.WhitelistPhase)
+	s.Require().True(publicCap.Sub(whitelistCap).Equal(res.Remaining.Amount))
+
+	err = k.BuyExactSpend(s.Ctx, planId, other, math.NewInt(10).MulRaw(1e18), math.OneInt())
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, other, publicCap, maxCost)
+	utest.IsErr(s.Require(), err, types.ErrCapExceeded)
+	err = k.Buy(s.Ctx, planId, listed, publicCap.Sub(whitelistCap), maxCost)
+	s.Require().NoError(err)
+	err = k.Buy(s.Ctx, planId, listed, math.NewInt(1).MulRaw(1e18), maxCost)
+	utest.IsErr(s.Require(), err, types.ErrCapExceeded)
+}
+
+func (s *KeeperTestSuite) TestWhitelistMerkleRoot() {
+	startTime := time.Now()
+	s.Ctx = s.Ctx.WithBlockTime(startTime)
+	allocation := math.NewInt(1_000_000).MulRaw(1e18)
+	alice, bob, other := sample.Acc(), sample.Acc(), sample.Acc()
+	root, proofs := types.MerkleTree([]string{alice.String(), bob.String()})
+
+	// the whitelist is set when enabling trading
+	rollappId, planId := s.createPlanFromMsg(startTime, allocation, func(m *types.MsgCreatePlan) {
+		m.TradingEnabled = false
+		m.StartTime = time.Time{}
+	})
+	owner := s.App.RollappKeeper.MustGetRollapp(s.Ctx, rollappId).Owner
+	_, err := s.msgServer.EnableTrading(s.Ctx, &types.MsgEnableTrading{
+		Owner:  owner,
+		PlanId: planId,
+		Whitelist: &types.Whitelist{
+			MerkleRoot: root,
+			Cap:        math.NewInt(100).MulRaw(1e18),
+			Duration:   time.Hour,
+			PublicCap:  math.ZeroInt(),
+		},
+	})
+	s.Require().NoError(err)
+
+	maxCost := math.NewInt(1_000_000).MulRaw(1e18)
+	buy := func(buyer sdk.AccAddress, proof [][]byte) error {
+		s.FundAcc(buyer, sdk.NewCoins(sdk.NewCoin("adym", maxCost)))
+		_, err := s.msgServer.Buy(s.Ctx, &types.MsgBuy{
+			Buyer:          buyer.String(),
+			PlanId:         planId,
+			Amount:         math.NewInt(10).MulRaw(1e18),
+			MaxCostAmount:  maxCost,
+			WhitelistProof: proof,
+		})
+		return err
+	}
+
+	// the accounts prove they are whitelisted once
+	s.Require().NoError(buy(alice, proofs[0]))
+	s.Require().NoError(buy(alice, nil))
+	utest.IsErr(s.Require(), buy(bob, nil), types.ErrNotWhitelisted)
+	utest.IsErr(s.Require(), buy(bob, proofs[0]), types.ErrNotWhitelisted)
+	s.Require().NoError(buy(bob, proofs[1]))
+	utest.IsErr(s.Require(), buy(other, proofs[0]), types.ErrNotWhitelisted)
+
+	// no cap once public
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(time.Hour))
+	s.Require().NoError(buy(other, nil))
+	res, err := s.App.IROKeeper.QueryRemainingCap(s.Ctx, &types.QueryRemainingCapRequest{PlanId: planId, Account: other.String()})
+	s.Require().NoError(err)
+	s.Require().False(res.Capped)
+}
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (v BuyerVestingPlan) ValidateBasic() error {
+	if v.Duration <= 0 {
+		return fmt.Errorf("duration must be positive: %s", v.Duration)
+	}
+	if v.Cliff < 0 || v.Duration < v.Cliff {
+		return fmt.Errorf("cliff must be between zero and the duration: %s", v.Cliff)
+	}
+	return nil
+}
+
+// Vested returns how much of the amount is vested at the given time
+func (v BuyerVestingPlan) Vested(amount math.Int, now time.Time) math.Int {
+	// not started, or before the cliff
+	if now.Before(v.StartTime.Add(v.Cliff)) {
+		return math.ZeroInt()
+	}
+
+	// ended
+	x := now.Sub(v.StartTime)
+	if v.Duration <= x {
+		return amount
+	}
+
+	s := math.LegacyNewDec(x.Nanoseconds()).Quo(math.LegacyNewDec(v.Duration.Nanoseconds()))
+	return s.MulInt(amount).TruncateInt()
+}
+
+// BuyerVested returns how much of the amount of tokens of a buyer is vested at the given time. All of it is
+// if the plan has no buyer vesting plan.
+func (p Plan) BuyerVested(amount math.Int, now time.Time) math.Int {
+	if p.BuyerVestingPlan == nil {
+		return amount
+	}
+	return p.BuyerVestingPlan.Vested(amount, now)
+}
+
+func NewBuyerVesting(planId uint64, account string) BuyerVesting {
+	return BuyerVesting{PlanId: planId, Account: account, Amount: math.ZeroInt(), Claimed: math.ZeroInt()}
+}
+
+func (v BuyerVesting) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(v.Account); err != nil {
+		return fmt.Errorf("invalid account address: %w", err)
+	}
+	if v.Amount.IsNil() || v.Claimed.IsNil() {
+		return errors.New("amounts must be set")
+	}
+	if v.Claimed.IsNegative() {
+		return fmt.Errorf("claimed amount must not be negative: %s", v.Claimed)
+	}
+	if v.Amount.LT(v.Claimed) {
+		return fmt.Errorf("amount cannot be less than claimed: %s < %s", v.Amount, v.Claimed)
+	}
+	return nil
+}
+
+// Unclaimed returns the amount of tokens not claimed yet
+func (v BuyerVesting) Unclaimed() math.Int {
+	return v.Amount.Sub(v.Claimed)
+}
@@ -12,6 +12,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSell{}, "iro/Sell", nil)
 	cdc.RegisterConcrete(&MsgClaim{}, "iro/Claim", nil)
 	cdc.RegisterConcrete(&MsgClaimVested{}, "iro/ClaimVested", nil)
+	cdc.RegisterConcrete(&MsgRefund{}, "iro/Refund", nil)
 	cdc.RegisterConcrete(&MsgCreatePlan{}, "iro/CreatePlan", nil)
 	cdc.RegisterConcrete(&MsgBuyExactSpend{}, "iro/BuyExactSpend", nil)
 	cdc.RegisterConcrete(&MsgEnableTrading{}, "iro/EnableTrading", nil)
@@ -26,6 +27,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSell{},
 		&MsgClaim{},
 		&MsgClaimVested{},
+		&MsgRefund{},
 		&MsgEnableTrading{},
 		&MsgCreatePlan{},
 		&MsgBuyExactSpend{},
@@ -28,4 +28,7 @@ var (
 	ErrInsufficientTokens           = errorsmod.Register(ModuleName, 1118, "insufficient tokens")
 	ErrRollappGenesisInfoNotSet     = errorsmod.Register(ModuleName, 1119, "rollapp genesis info not set")
 	ErrInvalidIncentivePlanParams   = errorsmod.Register(ModuleName, 1120, "invalid incentive plan params")
+	ErrNotWhitelisted               = errorsmod.Register(ModuleName, 1121, "not whitelisted")
+	ErrCapExceeded                  = errorsmod.Register(ModuleName, 1122, "purchase cap exceeded")
+	ErrSoftCapMissed                = errorsmod.Register(ModuleName, 1123, "soft cap missed")
 )
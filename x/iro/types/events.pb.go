@@ -322,6 +322,12 @@ type EventClaim struct {
 	PlanId    string     `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 	RollappId string     `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
 	Claim     types.Coin `protobuf:"bytes,4,opt,name=claim,proto3" json:"claim"`
+	// refund is the liquidity refunded for the part of a subscription to an
+	// oversubscribed fixed price sale which was not allocated
+	Refund types.Coin `protobuf:"bytes,5,opt,name=refund,proto3" json:"refund"`
+	// unvested is the amount of tokens of the claimer still vesting under the
+	// buyer vesting plan
+	Unvested types.Coin `protobuf:"bytes,6,opt,name=unvested,proto3" json:"unvested"`
 }
 
 func (m *EventClaim) Reset()         { *m = EventClaim{} }
@@ -385,6 +391,20 @@ func (m *EventClaim) GetClaim() types.Coin {
 	return types.Coin{}
 }
 
+func (m *EventClaim) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return types.Coin{}
+}
+
+func (m *EventClaim) GetUnvested() types.Coin {
+	if m != nil {
+		return m.Unvested
+	}
+	return types.Coin{}
+}
+
 type EventClaimVested struct {
 	Claimer   string     `protobuf:"bytes,1,opt,name=claimer,proto3" json:"claimer,omitempty"`
 	PlanId    string     `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
@@ -539,6 +559,145 @@ func (m *EventSettle) GetGaugeId() uint64 {
 	return 0
 }
 
+type EventRefundStarted struct {
+	PlanId    string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// raised is the liquidity raised, short of the soft cap
+	Raised  types.Coin            `protobuf:"bytes,3,opt,name=raised,proto3" json:"raised"`
+	SoftCap cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=soft_cap,json=softCap,proto3,customtype=cosmossdk.io/math.Int" json:"soft_cap"`
+}
+
+func (m *EventRefundStarted) Reset()         { *m = EventRefundStarted{} }
+func (m *EventRefundStarted) String() string { return proto.CompactTextString(m) }
+func (*EventRefundStarted) ProtoMessage()    {}
+func (*EventRefundStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7833031285167c, []int{7}
+}
+func (m *EventRefundStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRefundStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRefundStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRefundStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRefundStarted.Merge(m, src)
+}
+func (m *EventRefundStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRefundStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRefundStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRefundStarted proto.InternalMessageInfo
+
+func (m *EventRefundStarted) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *EventRefundStarted) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventRefundStarted) GetRaised() types.Coin {
+	if m != nil {
+		return m.Raised
+	}
+	return types.Coin{}
+}
+
+type EventRefund struct {
+	Refunder  string `protobuf:"bytes,1,opt,name=refunder,proto3" json:"refunder,omitempty"`
+	PlanId    string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// amount of tokens burned, or subscribed to a fixed price sale
+	Amount types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	Refund types.Coin `protobuf:"bytes,5,opt,name=refund,proto3" json:"refund"`
+}
+
+func (m *EventRefund) Reset()         { *m = EventRefund{} }
+func (m *EventRefund) String() string { return proto.CompactTextString(m) }
+func (*EventRefund) ProtoMessage()    {}
+func (*EventRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9d7833031285167c, []int{8}
+}
+func (m *EventRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventRefund.Merge(m, src)
+}
+func (m *EventRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventRefund proto.InternalMessageInfo
+
+func (m *EventRefund) GetRefunder() string {
+	if m != nil {
+		return m.Refunder
+	}
+	return ""
+}
+
+func (m *EventRefund) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+func (m *EventRefund) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventRefund) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventRefund) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return types.Coin{}
+}
+
 func init() {
 	proto.RegisterType((*EventUpdateParams)(nil), "dymensionxyz.dymension.iro.EventUpdateParams")
 	proto.RegisterType((*EventNewIROPlan)(nil), "dymensionxyz.dymension.iro.EventNewIROPlan")
@@ -547,6 +706,8 @@ func init() {
 	proto.RegisterType((*EventClaim)(nil), "dymensionxyz.dymension.iro.EventClaim")
 	proto.RegisterType((*EventClaimVested)(nil), "dymensionxyz.dymension.iro.EventClaimVested")
 	proto.RegisterType((*EventSettle)(nil), "dymensionxyz.dymension.iro.EventSettle")
+	proto.RegisterType((*EventRefundStarted)(nil), "dymensionxyz.dymension.iro.EventRefundStarted")
+	proto.RegisterType((*EventRefund)(nil), "dymensionxyz.dymension.iro.EventRefund")
 }
 
 func init() {
@@ -554,54 +715,59 @@ func init() {
 }
 
 var fileDescriptor_9d7833031285167c = []byte{
-	// 740 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0x4d, 0x4f, 0xd4, 0x5c,
-	0x14, 0x9e, 0x0e, 0xf3, 0xd5, 0xc3, 0xcb, 0xfb, 0xd1, 0xf0, 0xe6, 0x1d, 0x20, 0xef, 0x40, 0x26,
-	0x26, 0x92, 0x18, 0x5a, 0x3e, 0xa2, 0xc6, 0xe8, 0x86, 0x19, 0x94, 0xd4, 0x18, 0x25, 0x25, 0xb2,
-	0x70, 0x33, 0xb9, 0xd3, 0x1e, 0x4a, 0x43, 0x7b, 0x6f, 0xd3, 0xde, 0x0e, 0x8c, 0x89, 0xff, 0xc1,
-	0x7f, 0xe2, 0x86, 0x1f, 0xc1, 0x92, 0xb0, 0x32, 0x26, 0x12, 0x03, 0x3b, 0x97, 0x2e, 0xdc, 0x6a,
-	0xee, 0xed, 0x1d, 0x20, 0x1a, 0x60, 0x64, 0x61, 0x74, 0x37, 0xa7, 0xe7, 0x39, 0xcf, 0x79, 0xce,
-	0x73, 0xcf, 0x9d, 0x0b, 0x37, 0xbd, 0x7e, 0x84, 0x34, 0x0d, 0x18, 0xdd, 0xed, 0xbf, 0xb4, 0x4e,
-	0x03, 0x2b, 0x48, 0x98, 0x85, 0x3d, 0xa4, 0x3c, 0x35, 0xe3, 0x84, 0x71, 0x66, 0x4c, 0x9e, 0x07,
-	0x9a, 0xa7, 0x81, 0x19, 0x24, 0x6c, 0x72, 0xdc, 0x67, 0x3e, 0x93, 0x30, 0x4b, 0xfc, 0xca, 0x2b,
-	0x26, 0x27, 0x5c, 0x96, 0x46, 0x2c, 0xed, 0xe4, 0x89, 0x3c, 0x50, 0xa9, 0x69, 0x9f, 0x31, 0x3f,
-	0x44, 0x4b, 0x46, 0xdd, 0x6c, 0xd3, 0xe2, 0x41, 0x84, 0x29, 0x27, 0x51, 0xac, 0x00, 0x8d, 0x1c,
-	0x6e, 0x75, 0x49, 0x8a, 0x56, 0x6f, 0xa1, 0x8b, 0x9c, 0x2c, 0x58, 0x2e, 0x0b, 0xa8, 0xca, 0xdf,
-	0xb8, 0x44, 0x76, 0x90, 0x0c, 0x14, 0x5c, 0x36, 0x5c, 0x4c, 0x12, 0x12, 0x29, 0x3d, 0xcd, 0xf7,
-	0x1a, 0xfc, 0xf3, 0x50, 0x4c, 0xfb, 0x3c, 0xf6, 0x08, 0xc7, 0x35, 0x99, 0x33, 0xee, 0x80, 0x4e,
-	0x32, 0xbe, 0xc5, 0x92, 0x80, 0xf7, 0xeb, 0xda, 0x8c, 0x36, 0xab, 0xb7, 0xea, 0x87, 0x7b, 0x73,
-	0xe3, 0x6a, 0x94, 0x65, 0xcf, 0x4b, 0x30, 0x4d, 0xd7, 0x79, 0x12, 0x50, 0xdf, 0x39, 0x83, 0x1a,
-	0xab, 0x00, 0x14, 0x77, 0x3a, 0x79, 0x87, 0x7a, 0x71, 0x46, 0x9b, 0x1d, 0x5d, 0x6c, 0x9a, 0x17,
-	0xfb, 0x67, 0xe6, 0xfd, 0x5a, 0xa5, 0xfd, 0xa3, 0xe9, 0x82, 0xa3, 0x53, 0xdc, 0x51, 0x02, 0x56,
-	0x01, 0x58, 0xe8, 0x0d, 0x88, 0x46, 0x7e, 0x94, 0x88, 0x85, 0x5e, 0xfe, 0xa1, 0xf9, 0x0a, 0xfe,
-	0x92, 0xe3, 0x3d, 0xc5, 0x1d, 0xdb, 0x79, 0xb6, 0x16, 0x12, 0x6a, 0x2c, 0x42, 0xd5, 0x4d, 0x90,
-	0x70, 0x96, 0x5c, 0x39, 0xda, 0x00, 0x68, 0xfc, 0x07, 0xd5, 0x38, 0x24, 0xb4, 0x13, 0x78, 0x72,
-	0x2a, 0xdd, 0xa9, 0x88, 0xd0, 0xf6, 0x8c, 0xff, 0x01, 0x12, 0x16, 0x86, 0x24, 0x8e, 0x45, 0x6e,
-	0x44, 0xe6, 0x74, 0xf5, 0xc5, 0xf6, 0x9a, 0x9f, 0x8b, 0x50, 0x93, 0xfd, 0x5b, 0x59, 0xdf, 0x30,
-	0xa1, 0xdc, 0xcd, 0xfa, 0x78, 0x75, 0xdb, 0x1c, 0x76, 0xdd, 0xa6, 0xc6, 0x5d, 0xa8, 0x90, 0x88,
-	0x65, 0x94, 0xd7, 0x4b, 0xd2, 0xb8, 0x09, 0x53, 0x75, 0x11, 0x3b, 0x65, 0xaa, 0x9d, 0x32, 0xdb,
-	0x2c, 0xa0, 0xca, 0x2f, 0x05, 0x37, 0x96, 0xa0, 0xe4, 0xb2, 0x94, 0xd7, 0xcb, 0xc3, 0x95, 0x49,
-	0xb0, 0xf1, 0x00, 0x74, 0x4e, 0xb6, 0x31, 0xe9, 0x6c, 0x22, 0xd6, 0x2b, 0xc3, 0x55, 0xd6, 0x64,
-	0xc5, 0x23, 0x44, 0x63, 0x03, 0xc6, 0xdc, 0x90, 0xa5, 0x01, 0xf5, 0x3b, 0x71, 0x12, 0xb8, 0x58,
-	0xaf, 0x4a, 0x6f, 0x16, 0x04, 0xec, 0xdd, 0xd1, 0xf4, 0x54, 0x4e, 0x94, 0x7a, 0xdb, 0x66, 0xc0,
-	0xac, 0x88, 0xf0, 0x2d, 0xf3, 0x09, 0xfa, 0xc4, 0xed, 0xaf, 0xa0, 0x7b, 0xb8, 0x37, 0x07, 0xaa,
-	0xcf, 0x0a, 0xba, 0xce, 0x1f, 0x8a, 0x67, 0x4d, 0xd0, 0x34, 0xbf, 0x14, 0x41, 0x97, 0xc6, 0xaf,
-	0x63, 0x18, 0x1a, 0xf3, 0x50, 0x49, 0x31, 0x0c, 0x87, 0xb0, 0x5e, 0xe1, 0x7e, 0xbe, 0xf7, 0xf7,
-	0xa0, 0x9a, 0x88, 0xbf, 0x9d, 0x0c, 0x87, 0xb5, 0x7f, 0x80, 0xff, 0x45, 0x4f, 0xe0, 0x8d, 0x06,
-	0x20, 0x4f, 0xa0, 0x1d, 0x92, 0x20, 0x92, 0xb7, 0x4e, 0xfc, 0xc0, 0x61, 0x6e, 0x5d, 0x0e, 0xbc,
-	0xf6, 0x21, 0xdc, 0x86, 0xb2, 0xa4, 0x18, 0xf6, 0x0c, 0x72, 0x74, 0xf3, 0x93, 0x06, 0x7f, 0x9f,
-	0x29, 0xde, 0xc0, 0x94, 0xa3, 0xf7, 0x1b, 0xe8, 0x36, 0xee, 0x43, 0x2d, 0xa3, 0x3d, 0x29, 0x77,
-	0xd8, 0xdd, 0x39, 0x2d, 0x68, 0x7e, 0xd4, 0x60, 0x54, 0x5d, 0x14, 0xce, 0x43, 0x3c, 0xaf, 0x5d,
-	0xbb, 0x44, 0x7b, 0xf1, 0x5b, 0xed, 0x53, 0xa0, 0xdb, 0xad, 0x76, 0xc7, 0x43, 0xca, 0x22, 0x35,
-	0x59, 0xcd, 0x6e, 0xb5, 0x57, 0x44, 0x2c, 0x49, 0x19, 0x0b, 0x45, 0xa1, 0x18, 0xad, 0xe4, 0x54,
-	0x44, 0x68, 0x7b, 0xc6, 0x04, 0xd4, 0x7c, 0x92, 0xf9, 0x28, 0x32, 0x65, 0x99, 0xa9, 0xca, 0xd8,
-	0xf6, 0x0c, 0x07, 0xfe, 0x14, 0x12, 0xc5, 0x5e, 0xaa, 0x1b, 0x55, 0x91, 0xfe, 0xdf, 0x52, 0x8b,
-	0xf9, 0xef, 0xf7, 0x8b, 0x69, 0x53, 0x7e, 0x6e, 0x25, 0x6d, 0xca, 0x9d, 0x31, 0x45, 0xb1, 0x2c,
-	0x19, 0x5a, 0x8f, 0xf7, 0x8f, 0x1b, 0xda, 0xc1, 0x71, 0x43, 0xfb, 0x70, 0xdc, 0xd0, 0x5e, 0x9f,
-	0x34, 0x0a, 0x07, 0x27, 0x8d, 0xc2, 0xdb, 0x93, 0x46, 0xe1, 0xc5, 0xbc, 0x1f, 0xf0, 0xad, 0xac,
-	0x6b, 0xba, 0x2c, 0xb2, 0x2e, 0x78, 0x3b, 0x7b, 0x4b, 0xd6, 0xae, 0x7c, 0x40, 0x79, 0x3f, 0xc6,
-	0xb4, 0x5b, 0x91, 0x0f, 0xe8, 0xd2, 0xd7, 0x00, 0x00, 0x00, 0xff, 0xff, 0x83, 0x0c, 0x07, 0x86,
-	0x48, 0x08, 0x00, 0x00,
+	// 827 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4f, 0x6f, 0xdc, 0x44,
+	0x14, 0x8f, 0x37, 0x9b, 0xfd, 0xf3, 0x4a, 0xf9, 0x33, 0x2a, 0xc2, 0x49, 0xc5, 0xa6, 0x5a, 0x21,
+	0x51, 0x09, 0xd5, 0x6e, 0x1a, 0xfe, 0x08, 0xc1, 0xa5, 0xbb, 0xa1, 0x95, 0x11, 0x82, 0xc8, 0x11,
+	0x3d, 0x70, 0xb1, 0x66, 0xed, 0x17, 0x67, 0x54, 0x7b, 0xc6, 0x9a, 0x19, 0x27, 0x5d, 0x24, 0xbe,
+	0x03, 0x5f, 0x82, 0x6f, 0xd0, 0x0f, 0xd1, 0x0b, 0x52, 0xd5, 0x13, 0x42, 0xa2, 0x42, 0xc9, 0x0d,
+	0x89, 0x0b, 0x07, 0xae, 0xa0, 0x19, 0xcf, 0xa6, 0x11, 0xa8, 0xa9, 0xb3, 0x87, 0x0a, 0x6e, 0x7e,
+	0xf3, 0x7e, 0xef, 0xcf, 0xef, 0xf7, 0xe6, 0x59, 0x03, 0xef, 0x66, 0xf3, 0x12, 0xb9, 0x62, 0x82,
+	0x3f, 0x98, 0x7f, 0x1b, 0x9e, 0x1a, 0x21, 0x93, 0x22, 0xc4, 0x43, 0xe4, 0x5a, 0x05, 0x95, 0x14,
+	0x5a, 0x90, 0x8d, 0xb3, 0xc0, 0xe0, 0xd4, 0x08, 0x98, 0x14, 0x1b, 0x57, 0x72, 0x91, 0x0b, 0x0b,
+	0x0b, 0xcd, 0x57, 0x13, 0xb1, 0xb1, 0x9e, 0x0a, 0x55, 0x0a, 0x95, 0x34, 0x8e, 0xc6, 0x70, 0xae,
+	0xcd, 0x5c, 0x88, 0xbc, 0xc0, 0xd0, 0x5a, 0xb3, 0x7a, 0x3f, 0xd4, 0xac, 0x44, 0xa5, 0x69, 0x59,
+	0x39, 0xc0, 0xa8, 0x81, 0x87, 0x33, 0xaa, 0x30, 0x3c, 0xdc, 0x9a, 0xa1, 0xa6, 0x5b, 0x61, 0x2a,
+	0x18, 0x77, 0xfe, 0x77, 0xce, 0x69, 0x9b, 0xc9, 0x45, 0x07, 0xe7, 0x91, 0xab, 0xa8, 0xa4, 0xa5,
+	0xeb, 0x67, 0xfc, 0x8b, 0x07, 0x6f, 0x7c, 0x66, 0xd8, 0x7e, 0x5d, 0x65, 0x54, 0xe3, 0xae, 0xf5,
+	0x91, 0x0f, 0x61, 0x48, 0x6b, 0x7d, 0x20, 0x24, 0xd3, 0x73, 0xdf, 0xbb, 0xe6, 0x5d, 0x1f, 0x4e,
+	0xfc, 0x27, 0x0f, 0x6f, 0x5c, 0x71, 0x54, 0x6e, 0x67, 0x99, 0x44, 0xa5, 0xf6, 0xb4, 0x64, 0x3c,
+	0x8f, 0x9f, 0x41, 0xc9, 0x5d, 0x00, 0x8e, 0x47, 0x49, 0x53, 0xc1, 0xef, 0x5c, 0xf3, 0xae, 0x5f,
+	0xba, 0x35, 0x0e, 0x9e, 0xaf, 0x5f, 0xd0, 0xd4, 0x9b, 0x74, 0x1f, 0x3d, 0xdd, 0x5c, 0x89, 0x87,
+	0x1c, 0x8f, 0x5c, 0x03, 0x77, 0x01, 0x44, 0x91, 0x2d, 0x12, 0xad, 0x5e, 0x34, 0x91, 0x28, 0xb2,
+	0xe6, 0x60, 0xfc, 0x1d, 0xbc, 0x66, 0xe9, 0x7d, 0x89, 0x47, 0x51, 0xfc, 0xd5, 0x6e, 0x41, 0x39,
+	0xb9, 0x05, 0xfd, 0x54, 0x22, 0xd5, 0x42, 0xbe, 0x90, 0xda, 0x02, 0x48, 0xde, 0x82, 0x7e, 0x55,
+	0x50, 0x9e, 0xb0, 0xcc, 0xb2, 0x1a, 0xc6, 0x3d, 0x63, 0x46, 0x19, 0x79, 0x1b, 0x40, 0x8a, 0xa2,
+	0xa0, 0x55, 0x65, 0x7c, 0xab, 0xd6, 0x37, 0x74, 0x27, 0x51, 0x36, 0xfe, 0xb3, 0x03, 0x03, 0x5b,
+	0x7f, 0x52, 0xcf, 0x49, 0x00, 0x6b, 0xb3, 0x7a, 0x8e, 0x2f, 0x2e, 0xdb, 0xc0, 0x96, 0x2d, 0x4a,
+	0x3e, 0x82, 0x1e, 0x2d, 0x45, 0xcd, 0xb5, 0xdf, 0xb5, 0xc2, 0xad, 0x07, 0xae, 0x8a, 0xb9, 0x53,
+	0x81, 0xbb, 0x53, 0xc1, 0x54, 0x30, 0xee, 0xf4, 0x72, 0x70, 0xb2, 0x0d, 0xdd, 0x54, 0x28, 0xed,
+	0xaf, 0xb5, 0x0b, 0xb3, 0x60, 0xf2, 0x29, 0x0c, 0x35, 0xbd, 0x8f, 0x32, 0xd9, 0x47, 0xf4, 0x7b,
+	0xed, 0x22, 0x07, 0x36, 0xe2, 0x0e, 0x22, 0xb9, 0x07, 0x97, 0xd3, 0x42, 0x28, 0xc6, 0xf3, 0xa4,
+	0x92, 0x2c, 0x45, 0xbf, 0x6f, 0xb5, 0xd9, 0x32, 0xb0, 0x9f, 0x9f, 0x6e, 0x5e, 0x6d, 0x12, 0xa9,
+	0xec, 0x7e, 0xc0, 0x44, 0x58, 0x52, 0x7d, 0x10, 0x7c, 0x81, 0x39, 0x4d, 0xe7, 0x3b, 0x98, 0x3e,
+	0x79, 0x78, 0x03, 0x5c, 0x9d, 0x1d, 0x4c, 0xe3, 0x57, 0x5c, 0x9e, 0x5d, 0x93, 0x66, 0xfc, 0x57,
+	0x07, 0x86, 0x56, 0xf8, 0x3d, 0x2c, 0x0a, 0x72, 0x13, 0x7a, 0x0a, 0x8b, 0xa2, 0x85, 0xf4, 0x0e,
+	0xf7, 0xf2, 0xb5, 0xff, 0x18, 0xfa, 0xd2, 0xfc, 0x76, 0x6a, 0x6c, 0x2b, 0xff, 0x02, 0xff, 0x1f,
+	0x9d, 0xc0, 0x0f, 0x1d, 0x00, 0x3b, 0x81, 0x69, 0x41, 0x59, 0x69, 0xb7, 0xce, 0x7c, 0x60, 0x9b,
+	0xad, 0x6b, 0x80, 0x4b, 0x0f, 0xe1, 0x03, 0x58, 0xb3, 0x29, 0xda, 0xce, 0xa0, 0x41, 0x9b, 0xd9,
+	0x49, 0xdc, 0xaf, 0x79, 0xd6, 0x76, 0x02, 0x0e, 0x4e, 0x3e, 0x81, 0x41, 0xcd, 0x0f, 0x51, 0x69,
+	0xcc, 0x5a, 0xeb, 0xbf, 0x08, 0x18, 0xff, 0xe1, 0xc1, 0xeb, 0xcf, 0x74, 0xba, 0x67, 0x0f, 0xff,
+	0x0f, 0x6a, 0x9d, 0x25, 0xbd, 0x76, 0x51, 0xd2, 0xbf, 0x79, 0x70, 0xc9, 0xad, 0xa7, 0xd6, 0x05,
+	0x9e, 0xed, 0xdd, 0x3b, 0xa7, 0xf7, 0xce, 0x3f, 0x7b, 0xbf, 0x0a, 0xc3, 0x68, 0x32, 0x4d, 0x32,
+	0xe4, 0xa2, 0x74, 0xcc, 0x06, 0xd1, 0x64, 0xba, 0x63, 0x6c, 0x9b, 0x54, 0x88, 0xc2, 0x04, 0x1a,
+	0x6a, 0xdd, 0xb8, 0x67, 0xcc, 0x28, 0x23, 0xeb, 0x30, 0xc8, 0x69, 0x9d, 0x63, 0xc2, 0x9a, 0xd6,
+	0xbb, 0x71, 0xdf, 0xda, 0x51, 0x46, 0x62, 0x78, 0xd5, 0xb4, 0x68, 0xb6, 0xc1, 0xed, 0x71, 0xcf,
+	0xea, 0xff, 0x9e, 0x5b, 0x87, 0x37, 0xff, 0xbd, 0x0e, 0x11, 0xd7, 0x67, 0x16, 0x21, 0xe2, 0x3a,
+	0xbe, 0xec, 0x52, 0xdc, 0xb6, 0x19, 0xc6, 0x3f, 0x7a, 0x40, 0x2c, 0xd9, 0xd8, 0x5e, 0x97, 0x3d,
+	0x4d, 0xa5, 0x99, 0xf1, 0xb2, 0x9c, 0xcd, 0x35, 0xa5, 0x4c, 0x61, 0xe6, 0xaf, 0xb6, 0x93, 0xdd,
+	0xc1, 0xc9, 0x1d, 0x18, 0x28, 0xb1, 0xaf, 0x93, 0x94, 0x56, 0x7e, 0xf7, 0xe2, 0xac, 0xfa, 0x26,
+	0x78, 0x4a, 0xab, 0xf1, 0xef, 0x8b, 0xe1, 0x35, 0x7c, 0xc8, 0xfb, 0x30, 0x68, 0x16, 0xa1, 0xc5,
+	0x6d, 0x3d, 0x45, 0xbe, 0xfc, 0x3f, 0xec, 0xb2, 0xeb, 0x3d, 0xf9, 0xfc, 0xd1, 0xf1, 0xc8, 0x7b,
+	0x7c, 0x3c, 0xf2, 0x7e, 0x3d, 0x1e, 0x79, 0xdf, 0x9f, 0x8c, 0x56, 0x1e, 0x9f, 0x8c, 0x56, 0x7e,
+	0x3a, 0x19, 0xad, 0x7c, 0x73, 0x33, 0x67, 0xfa, 0xa0, 0x9e, 0x05, 0xa9, 0x28, 0xc3, 0xe7, 0xbc,
+	0xb8, 0x0e, 0xb7, 0xc3, 0x07, 0xf6, 0xd9, 0xa5, 0xe7, 0x15, 0xaa, 0x59, 0xcf, 0x3e, 0xbb, 0xb6,
+	0xff, 0x1e, 0x00, 0xe8, 0x8a, 0xa4, 0xd4, 0x7e, 0x0a, 0x00, 0x00,
 }
 
 func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
@@ -886,6 +1052,26 @@ func (m *EventClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Unvested.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
 	{
 		size, err := m.Claim.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1048,6 +1234,127 @@ func (m *EventSettle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventRefundStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRefundStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRefundStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SoftCap.Size()
+		i -= size
+		if _, err := m.SoftCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Raised.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Refunder) > 0 {
+		i -= len(m.Refunder)
+		copy(dAtA[i:], m.Refunder)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Refunder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1175,6 +1482,10 @@ func (m *EventClaim) Size() (n int) {
 	}
 	l = m.Claim.Size()
 	n += 1 + l + sovEvents(uint64(l))
+	l = m.Refund.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Unvested.Size()
+	n += 1 + l + sovEvents(uint64(l))
 	return n
 }
 
@@ -1232,18 +1543,64 @@ func (m *EventSettle) Size() (n int) {
 	return n
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EventRefundStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Raised.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.SoftCap.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
 }
-func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
+
+func (m *EventRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Refunder)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Refund.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowEvents
@@ -2248,6 +2605,72 @@ func (m *EventClaim) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unvested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Unvested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2699,6 +3122,399 @@ func (m *EventSettle) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventRefundStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRefundStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRefundStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raised", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Raised.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoftCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
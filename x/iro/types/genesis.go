@@ -1,6 +1,10 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
@@ -14,6 +18,9 @@ func DefaultGenesis() *GenesisState {
 func (gs GenesisState) Validate() error {
 	rollapps := make(map[string]bool)
 	ids := make(map[uint64]bool)
+	fixedPriceSales := make(map[uint64]bool)
+	whitelisted := make(map[uint64]bool)
+	buyerVesting := make(map[uint64]bool)
 
 	for _, plan := range gs.Plans {
 		if err := plan.ValidateBasic(); err != nil {
@@ -29,7 +36,115 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicate plan ID %d", plan.Id)
 		}
 		ids[plan.Id] = true
+
+		if plan.FixedPriceSale != nil {
+			fixedPriceSales[plan.Id] = true
+		}
+		if plan.Whitelist != nil {
+			whitelisted[plan.Id] = true
+		}
+		if plan.BuyerVestingPlan != nil {
+			buyerVesting[plan.Id] = true
+		}
+	}
+
+	subscriptions := make(map[string]bool)
+	for _, sub := range gs.Subscriptions {
+		if err := sub.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !fixedPriceSales[sub.PlanId] {
+			return fmt.Errorf("subscription to plan %d which is not a fixed price sale", sub.PlanId)
+		}
+
+		key := fmt.Sprintf("%d/%s", sub.PlanId, sub.Buyer)
+		if subscriptions[key] {
+			return fmt.Errorf("duplicate subscription: plan ID %d, buyer %s", sub.PlanId, sub.Buyer)
+		}
+		subscriptions[key] = true
+	}
+
+	purchases := make(map[string]bool)
+	for _, p := range gs.Purchases {
+		if err := p.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !whitelisted[p.PlanId] {
+			return fmt.Errorf("purchases from plan %d which has no whitelist", p.PlanId)
+		}
+
+		key := fmt.Sprintf("%d/%s", p.PlanId, p.Buyer)
+		if purchases[key] {
+			return fmt.Errorf("duplicate purchases: plan ID %d, buyer %s", p.PlanId, p.Buyer)
+		}
+		purchases[key] = true
+	}
+
+	vestings := make(map[string]bool)
+	for _, v := range gs.BuyerVestings {
+		if err := v.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !buyerVesting[v.PlanId] {
+			return fmt.Errorf("buyer vesting of plan %d which has no buyer vesting plan", v.PlanId)
+		}
+
+		key := fmt.Sprintf("%d/%s", v.PlanId, v.Account)
+		if vestings[key] {
+			return fmt.Errorf("duplicate buyer vesting: plan ID %d, account %s", v.PlanId, v.Account)
+		}
+		vestings[key] = true
+	}
+
+	trades := make(map[string]bool)
+	for _, t := range gs.Trades {
+		if err := t.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !ids[t.PlanId] {
+			return fmt.Errorf("trade of unknown plan %d", t.PlanId)
+		}
+
+		key := fmt.Sprintf("%d/%d", t.PlanId, t.Seq)
+		if trades[key] {
+			return fmt.Errorf("duplicate trade: plan ID %d, seq %d", t.PlanId, t.Seq)
+		}
+		trades[key] = true
+	}
+
+	candles := make(map[string]bool)
+	for _, c := range gs.Candles {
+		if err := c.ValidateBasic(); err != nil {
+			return err
+		}
+
+		if !ids[c.PlanId] {
+			return fmt.Errorf("candle of unknown plan %d", c.PlanId)
+		}
+
+		key := fmt.Sprintf("%d/%d", c.PlanId, c.StartTime.UnixNano())
+		if candles[key] {
+			return fmt.Errorf("duplicate candle: plan ID %d, start time %s", c.PlanId, c.StartTime)
+		}
+		candles[key] = true
 	}
 
 	return gs.Params.ValidateBasic()
 }
+
+func (s Subscription) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(s.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address: %w", err)
+	}
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return fmt.Errorf("subscribed amount must be positive: %s", s.Amount)
+	}
+	if s.Paid.IsNil() || s.Paid.IsNegative() {
+		return fmt.Errorf("paid amount must not be negative: %s", s.Paid)
+	}
+	return nil
+}
@@ -30,6 +30,15 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// VoterInfos hold information about voters.
 	Plans []Plan `protobuf:"bytes,2,rep,name=plans,proto3" json:"plans"`
+	// subscriptions to the fixed price sales, not claimed yet
+	Subscriptions []Subscription `protobuf:"bytes,3,rep,name=subscriptions,proto3" json:"subscriptions"`
+	// purchases from the plans with a whitelist
+	Purchases []Purchases `protobuf:"bytes,4,rep,name=purchases,proto3" json:"purchases"`
+	// tokens vesting under the buyer vesting plans
+	BuyerVestings []BuyerVesting `protobuf:"bytes,5,rep,name=buyer_vestings,json=buyerVestings,proto3" json:"buyer_vestings"`
+	// trade history of the plans not settled yet
+	Trades  []Trade  `protobuf:"bytes,6,rep,name=trades,proto3" json:"trades"`
+	Candles []Candle `protobuf:"bytes,7,rep,name=candles,proto3" json:"candles"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +88,41 @@ func (m *GenesisState) GetPlans() []Plan {
 	return nil
 }
 
+func (m *GenesisState) GetSubscriptions() []Subscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPurchases() []Purchases {
+	if m != nil {
+		return m.Purchases
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBuyerVestings() []BuyerVesting {
+	if m != nil {
+		return m.BuyerVestings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.iro.GenesisState")
 }
@@ -88,23 +132,31 @@ func init() {
 }
 
 var fileDescriptor_7c6c6e7791476d37 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x48, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x33, 0x8b, 0xf2, 0xf5,
-	0xd3, 0x53, 0xf3, 0x52, 0x8b, 0x33, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4, 0x90,
-	0x55, 0xea, 0xc1, 0x39, 0x7a, 0x99, 0x45, 0xf9, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0x65,
-	0xfa, 0x20, 0x16, 0x44, 0x87, 0x94, 0x64, 0x72, 0x7e, 0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x44, 0x02,
-	0xc2, 0x81, 0x4a, 0xa9, 0xe3, 0xb1, 0xb6, 0x20, 0xb1, 0x28, 0x31, 0x17, 0xa6, 0x50, 0x05, 0x8f,
-	0xc2, 0xcc, 0x22, 0xa8, 0x4d, 0x4a, 0x7d, 0x8c, 0x5c, 0x3c, 0xee, 0x10, 0xd7, 0x06, 0x97, 0x24,
-	0x96, 0xa4, 0x0a, 0x39, 0x70, 0xb1, 0x41, 0x8c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52,
-	0xd2, 0xc3, 0xed, 0x7a, 0xbd, 0x00, 0xb0, 0x4a, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xa0,
-	0xfa, 0x84, 0x6c, 0xb8, 0x58, 0x0b, 0x72, 0x12, 0xf3, 0x8a, 0x25, 0x98, 0x14, 0x98, 0x35, 0xb8,
-	0x8d, 0x14, 0xf0, 0x1a, 0x90, 0x93, 0x98, 0x07, 0xd5, 0x0e, 0xd1, 0xe4, 0xe4, 0x75, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x06, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x38, 0xfc, 0x56, 0x66, 0xac, 0x5f, 0x01, 0xf6, 0x60, 0x49, 0x65, 0x41,
-	0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x8f, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5b, 0xad, 0x9d,
-	0xb8, 0xab, 0x01, 0x00, 0x00,
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x31, 0x8f, 0xd3, 0x30,
+	0x18, 0x86, 0x13, 0xda, 0xa6, 0xc2, 0x05, 0x06, 0x8b, 0x21, 0x74, 0x08, 0xa1, 0x02, 0x91, 0x29,
+	0x41, 0xed, 0x8a, 0x04, 0x0a, 0x03, 0x82, 0x09, 0xd1, 0xc2, 0xc0, 0x52, 0x39, 0xa9, 0x95, 0x5a,
+	0x6a, 0xec, 0xc8, 0x9f, 0x53, 0x35, 0xfc, 0x05, 0x16, 0x7e, 0x56, 0xc7, 0x8e, 0x37, 0x9d, 0x4e,
+	0xed, 0x1f, 0x39, 0xd5, 0x4e, 0x7a, 0xbd, 0xa1, 0xe9, 0x96, 0x2f, 0x7e, 0x9f, 0xc7, 0xaf, 0x92,
+	0x0f, 0x05, 0x8b, 0x2a, 0xa7, 0x1c, 0x98, 0xe0, 0x9b, 0xea, 0x6f, 0x74, 0x1a, 0x22, 0x26, 0x45,
+	0x94, 0x51, 0x4e, 0x81, 0x41, 0x58, 0x48, 0xa1, 0x04, 0x1e, 0x9e, 0x27, 0xc3, 0xd3, 0x10, 0x32,
+	0x29, 0x86, 0x2f, 0x33, 0x91, 0x09, 0x1d, 0x8b, 0x8e, 0x4f, 0x86, 0x18, 0xbe, 0x4a, 0x05, 0xe4,
+	0x02, 0xe6, 0xe6, 0xc0, 0x0c, 0xf5, 0xd1, 0xfb, 0x96, 0x6b, 0x0b, 0x22, 0x49, 0xde, 0x04, 0xdf,
+	0xb6, 0x04, 0x99, 0xac, 0x6f, 0x1a, 0xfd, 0xeb, 0xa2, 0x67, 0x5f, 0x4d, 0xdb, 0xa9, 0x22, 0x8a,
+	0xe2, 0xcf, 0xc8, 0x31, 0x1a, 0xd7, 0xf6, 0xed, 0x60, 0x30, 0x1e, 0x85, 0x97, 0xdb, 0x87, 0x3f,
+	0x74, 0x32, 0xee, 0x6e, 0x6f, 0x5f, 0x5b, 0x3f, 0x6b, 0x0e, 0x7f, 0x44, 0xbd, 0x62, 0x45, 0x38,
+	0xb8, 0x4f, 0xfc, 0x4e, 0x30, 0x18, 0xfb, 0xad, 0x82, 0x15, 0xe1, 0x35, 0x6e, 0x20, 0x3c, 0x43,
+	0xcf, 0xa1, 0x4c, 0x20, 0x95, 0xac, 0x50, 0x4c, 0x70, 0x70, 0x3b, 0xda, 0x12, 0xb4, 0x59, 0xa6,
+	0x67, 0x40, 0x6d, 0x7b, 0x2c, 0xc1, 0xdf, 0xd0, 0xd3, 0xa2, 0x94, 0xe9, 0x92, 0x00, 0x05, 0xb7,
+	0xab, 0x8d, 0xef, 0x5a, 0x7b, 0x35, 0xe1, 0x5a, 0xf7, 0x40, 0xe3, 0x5f, 0xe8, 0x45, 0x52, 0x56,
+	0x54, 0xce, 0xd7, 0x14, 0x14, 0xe3, 0x19, 0xb8, 0xbd, 0xeb, 0x0d, 0xe3, 0x23, 0xf1, 0xdb, 0x00,
+	0x4d, 0xc3, 0xe4, 0xec, 0x1d, 0xe0, 0x4f, 0xc8, 0x51, 0x92, 0x2c, 0x28, 0xb8, 0x8e, 0xd6, 0xbd,
+	0x69, 0xd3, 0xcd, 0x8e, 0xc9, 0xe6, 0xb3, 0x1b, 0x0c, 0xc7, 0xa8, 0x9f, 0x12, 0xbe, 0x58, 0x51,
+	0x70, 0xfb, 0x7e, 0xe7, 0xda, 0x9f, 0xfb, 0xa2, 0xa3, 0xb5, 0xa2, 0x01, 0xe3, 0xef, 0xdb, 0xbd,
+	0x67, 0xef, 0xf6, 0x9e, 0x7d, 0xb7, 0xf7, 0xec, 0xff, 0x07, 0xcf, 0xda, 0x1d, 0x3c, 0xeb, 0xe6,
+	0xe0, 0x59, 0x7f, 0x3e, 0x64, 0x4c, 0x2d, 0xcb, 0x24, 0x4c, 0x45, 0x1e, 0x5d, 0x58, 0xac, 0xf5,
+	0x24, 0xda, 0xe8, 0xed, 0x52, 0x55, 0x41, 0x21, 0x71, 0xf4, 0x82, 0x4d, 0xee, 0x07, 0x00, 0xbc,
+	0x50, 0xc6, 0xef, 0x28, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +179,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.BuyerVestings) > 0 {
+		for iNdEx := len(m.BuyerVestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BuyerVestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Purchases) > 0 {
+		for iNdEx := len(m.Purchases) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Purchases[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Plans) > 0 {
 		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -179,6 +301,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Purchases) > 0 {
+		for _, e := range m.Purchases {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BuyerVestings) > 0 {
+		for _, e := range m.BuyerVestings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -284,6 +436,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscriptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subscriptions = append(m.Subscriptions, Subscription{})
+			if err := m.Subscriptions[len(m.Subscriptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Purchases", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Purchases = append(m.Purchases, Purchases{})
+			if err := m.Purchases[len(m.Purchases)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BuyerVestings = append(m.BuyerVestings, BuyerVesting{})
+			if err := m.BuyerVestings[len(m.BuyerVestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -128,6 +128,17 @@ type Plan struct {
 	IroPlanDuration time.Duration `protobuf:"bytes,16,opt,name=iro_plan_duration,json=iroPlanDuration,proto3,stdduration" json:"iro_plan_duration"`
 	// the denom used for raising liquidity
 	LiquidityDenom string `protobuf:"bytes,17,opt,name=liquidity_denom,json=liquidityDenom,proto3" json:"liquidity_denom,omitempty"`
+	// The sale mechanism of the plan. At most one of them is set, otherwise the
+	// tokens are sold on the bonding curve. The other mechanisms only use the
+	// decimals of the bonding curve.
+	DutchAuction   *DutchAuction   `protobuf:"bytes,18,opt,name=dutch_auction,json=dutchAuction,proto3" json:"dutch_auction,omitempty"`
+	FixedPriceSale *FixedPriceSale `protobuf:"bytes,19,opt,name=fixed_price_sale,json=fixedPriceSale,proto3" json:"fixed_price_sale,omitempty"`
+	// whitelist restricts the buyers from the start time of the plan, optional
+	Whitelist *Whitelist `protobuf:"bytes,20,opt,name=whitelist,proto3" json:"whitelist,omitempty"`
+	// soft_cap is the minimum liquidity to raise by its deadline, optional
+	SoftCap *SoftCap `protobuf:"bytes,21,opt,name=soft_cap,json=softCap,proto3" json:"soft_cap,omitempty"`
+	// buyer_vesting_plan vests the tokens claimed by the buyers, optional
+	BuyerVestingPlan *BuyerVestingPlan `protobuf:"bytes,22,opt,name=buyer_vesting_plan,json=buyerVestingPlan,proto3" json:"buyer_vesting_plan,omitempty"`
 }
 
 func (m *Plan) Reset()         { *m = Plan{} }
@@ -254,27 +265,64 @@ func (m *Plan) GetLiquidityDenom() string {
 	return ""
 }
 
-type IncentivePlanParams struct {
-	// start_time_after_settlement is the time after IRO settlement when the
-	// distribution of the remaining tokens as incentives will start
-	StartTimeAfterSettlement time.Duration `protobuf:"bytes,1,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
-	// num_epochs_paid_over is the number of total epochs distribution will be
-	// completed over
-	NumEpochsPaidOver uint64 `protobuf:"varint,2,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+func (m *Plan) GetDutchAuction() *DutchAuction {
+	if m != nil {
+		return m.DutchAuction
+	}
+	return nil
 }
 
-func (m *IncentivePlanParams) Reset()         { *m = IncentivePlanParams{} }
-func (m *IncentivePlanParams) String() string { return proto.CompactTextString(m) }
-func (*IncentivePlanParams) ProtoMessage()    {}
-func (*IncentivePlanParams) Descriptor() ([]byte, []int) {
+func (m *Plan) GetFixedPriceSale() *FixedPriceSale {
+	if m != nil {
+		return m.FixedPriceSale
+	}
+	return nil
+}
+
+func (m *Plan) GetWhitelist() *Whitelist {
+	if m != nil {
+		return m.Whitelist
+	}
+	return nil
+}
+
+func (m *Plan) GetSoftCap() *SoftCap {
+	if m != nil {
+		return m.SoftCap
+	}
+	return nil
+}
+
+func (m *Plan) GetBuyerVestingPlan() *BuyerVestingPlan {
+	if m != nil {
+		return m.BuyerVestingPlan
+	}
+	return nil
+}
+
+// BuyerVestingPlan vests the tokens the buyers claim after the plan is settled.
+// The tokens of each account vest linearly over the duration from the
+// settlement, and none can be claimed before the cliff. The IRO tokens are
+// burned on the first claim, and the vested tokens released by the next ones.
+type BuyerVestingPlan struct {
+	Cliff    time.Duration `protobuf:"bytes,1,opt,name=cliff,proto3,stdduration" json:"cliff"`
+	Duration time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration"`
+	// start_time of the vesting, set on settlement
+	StartTime time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+}
+
+func (m *BuyerVestingPlan) Reset()         { *m = BuyerVestingPlan{} }
+func (m *BuyerVestingPlan) String() string { return proto.CompactTextString(m) }
+func (*BuyerVestingPlan) ProtoMessage()    {}
+func (*BuyerVestingPlan) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e7d27cc6b5064d3f, []int{2}
 }
-func (m *IncentivePlanParams) XXX_Unmarshal(b []byte) error {
+func (m *BuyerVestingPlan) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BuyerVestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IncentivePlanParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BuyerVestingPlan.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -284,55 +332,62 @@ func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *IncentivePlanParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IncentivePlanParams.Merge(m, src)
+func (m *BuyerVestingPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyerVestingPlan.Merge(m, src)
 }
-func (m *IncentivePlanParams) XXX_Size() int {
+func (m *BuyerVestingPlan) XXX_Size() int {
 	return m.Size()
 }
-func (m *IncentivePlanParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_IncentivePlanParams.DiscardUnknown(m)
+func (m *BuyerVestingPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyerVestingPlan.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IncentivePlanParams proto.InternalMessageInfo
+var xxx_messageInfo_BuyerVestingPlan proto.InternalMessageInfo
 
-func (m *IncentivePlanParams) GetStartTimeAfterSettlement() time.Duration {
+func (m *BuyerVestingPlan) GetCliff() time.Duration {
 	if m != nil {
-		return m.StartTimeAfterSettlement
+		return m.Cliff
 	}
 	return 0
 }
 
-func (m *IncentivePlanParams) GetNumEpochsPaidOver() uint64 {
+func (m *BuyerVestingPlan) GetDuration() time.Duration {
 	if m != nil {
-		return m.NumEpochsPaidOver
+		return m.Duration
 	}
 	return 0
 }
 
-type IROVestingPlan struct {
-	Amount                   cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
-	Claimed                  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
-	VestingDuration          time.Duration         `protobuf:"bytes,3,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
-	StartTimeAfterSettlement time.Duration         `protobuf:"bytes,4,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
-	// Vesting start time (set on IRO settlement)
-	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
-	// Vesting end time (set on IRO settlement)
-	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+func (m *BuyerVestingPlan) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
 }
 
-func (m *IROVestingPlan) Reset()         { *m = IROVestingPlan{} }
-func (m *IROVestingPlan) String() string { return proto.CompactTextString(m) }
-func (*IROVestingPlan) ProtoMessage()    {}
-func (*IROVestingPlan) Descriptor() ([]byte, []int) {
+// BuyerVesting is the tokens of an account vesting under the buyer vesting plan
+// of a plan
+type BuyerVesting struct {
+	PlanId  uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	// amount of tokens vesting
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// claimed amount of tokens, out of the amount
+	Claimed cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+}
+
+func (m *BuyerVesting) Reset()         { *m = BuyerVesting{} }
+func (m *BuyerVesting) String() string { return proto.CompactTextString(m) }
+func (*BuyerVesting) ProtoMessage()    {}
+func (*BuyerVesting) Descriptor() ([]byte, []int) {
 	return fileDescriptor_e7d27cc6b5064d3f, []int{3}
 }
-func (m *IROVestingPlan) XXX_Unmarshal(b []byte) error {
+func (m *BuyerVesting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *IROVestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BuyerVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_IROVestingPlan.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BuyerVesting.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -342,426 +397,844 @@ func (m *IROVestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *IROVestingPlan) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IROVestingPlan.Merge(m, src)
+func (m *BuyerVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuyerVesting.Merge(m, src)
 }
-func (m *IROVestingPlan) XXX_Size() int {
+func (m *BuyerVesting) XXX_Size() int {
 	return m.Size()
 }
-func (m *IROVestingPlan) XXX_DiscardUnknown() {
-	xxx_messageInfo_IROVestingPlan.DiscardUnknown(m)
+func (m *BuyerVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuyerVesting.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IROVestingPlan proto.InternalMessageInfo
+var xxx_messageInfo_BuyerVesting proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*BondingCurve)(nil), "dymensionxyz.dymension.iro.BondingCurve")
-	proto.RegisterType((*Plan)(nil), "dymensionxyz.dymension.iro.Plan")
-	proto.RegisterType((*IncentivePlanParams)(nil), "dymensionxyz.dymension.iro.IncentivePlanParams")
-	proto.RegisterType((*IROVestingPlan)(nil), "dymensionxyz.dymension.iro.IROVestingPlan")
+func (m *BuyerVesting) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/iro/iro.proto", fileDescriptor_e7d27cc6b5064d3f)
+func (m *BuyerVesting) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
 }
 
-var fileDescriptor_e7d27cc6b5064d3f = []byte{
-	// 1009 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3b, 0x6f, 0x1b, 0x47,
-	0x17, 0xd5, 0x4a, 0xb4, 0x1e, 0x23, 0x89, 0x94, 0xc6, 0xb2, 0xbf, 0xb5, 0x8c, 0x8f, 0x14, 0xe8,
-	0x00, 0x16, 0x12, 0x78, 0x37, 0xb2, 0x53, 0x04, 0x6e, 0x0c, 0x8a, 0x92, 0x01, 0x19, 0xb2, 0x25,
-	0xac, 0x8c, 0xc0, 0x48, 0xb3, 0x18, 0xee, 0x8c, 0xa9, 0x41, 0xe6, 0xb1, 0x99, 0x9d, 0x25, 0xc4,
-	0xb4, 0x69, 0x52, 0xba, 0x4c, 0x99, 0x3a, 0xb5, 0xff, 0x40, 0x3a, 0x97, 0x86, 0xab, 0x20, 0x85,
-	0x12, 0x48, 0xff, 0x20, 0x4d, 0xda, 0x60, 0x1e, 0xa4, 0x24, 0x3a, 0x96, 0x43, 0x21, 0x05, 0x01,
-	0xce, 0xbd, 0x73, 0xce, 0xdd, 0xb9, 0xf7, 0x9c, 0xd9, 0x05, 0x9f, 0xe0, 0x3e, 0x27, 0xa2, 0xa0,
-	0x52, 0x1c, 0xf5, 0xbf, 0x8b, 0x87, 0x8b, 0x98, 0x2a, 0x69, 0x7e, 0x51, 0xae, 0xa4, 0x96, 0x70,
-	0xf5, 0xfc, 0xae, 0x68, 0xb8, 0x88, 0xa8, 0x92, 0xab, 0x2b, 0x5d, 0xd9, 0x95, 0x76, 0x5b, 0x6c,
-	0xfe, 0x39, 0xc4, 0x6a, 0xa3, 0x2b, 0x65, 0x97, 0x91, 0xd8, 0xae, 0x3a, 0xe5, 0xcb, 0x58, 0x53,
-	0x4e, 0x0a, 0x8d, 0x78, 0xee, 0x37, 0xd4, 0x47, 0x37, 0xe0, 0x52, 0x21, 0x6d, 0x48, 0x7d, 0x3e,
-	0x93, 0x05, 0x97, 0x45, 0xdc, 0x41, 0x05, 0x89, 0x7b, 0x1b, 0x1d, 0xa2, 0xd1, 0x46, 0x9c, 0x49,
-	0x3a, 0xc8, 0xdf, 0x72, 0xf9, 0xd4, 0x55, 0x76, 0x0b, 0x9f, 0xba, 0x7b, 0xc9, 0x99, 0x72, 0xa4,
-	0x10, 0xf7, 0x1b, 0x9b, 0xbf, 0x4c, 0x82, 0x85, 0x4d, 0x29, 0x30, 0x15, 0xdd, 0x76, 0xa9, 0x7a,
-	0x04, 0x3e, 0x02, 0xc1, 0xd3, 0x30, 0x58, 0x0b, 0xd6, 0xe7, 0x36, 0x37, 0xde, 0x1c, 0x37, 0x26,
-	0x7e, 0x3b, 0x6e, 0xdc, 0x76, 0xd4, 0x05, 0xfe, 0x26, 0xa2, 0x32, 0xe6, 0x48, 0x1f, 0x46, 0xbb,
-	0xa4, 0x8b, 0xb2, 0xfe, 0x16, 0xc9, 0xde, 0xbd, 0xbe, 0x07, 0x7c, 0xe5, 0x2d, 0x92, 0x25, 0xc1,
-	0x53, 0x43, 0xf0, 0x2c, 0x9c, 0xbc, 0x32, 0xc1, 0x33, 0x43, 0xd0, 0x0e, 0xa7, 0xae, 0x4c, 0xd0,
-	0x86, 0x5f, 0x80, 0x9b, 0x4a, 0x32, 0x86, 0xf2, 0x3c, 0xc5, 0x44, 0x48, 0x9e, 0x62, 0x92, 0x51,
-	0x8e, 0x58, 0x11, 0x56, 0xd6, 0x82, 0xf5, 0x4a, 0xb2, 0xe2, 0xb3, 0x5b, 0x26, 0xb9, 0xe5, 0x73,
-	0xf0, 0x4b, 0x10, 0x32, 0xfa, 0x6d, 0x49, 0x31, 0xd5, 0xfd, 0x51, 0xdc, 0x35, 0x8b, 0xbb, 0x39,
-	0xcc, 0x5f, 0x40, 0x36, 0xbf, 0x9f, 0x03, 0x95, 0x7d, 0x86, 0x04, 0xac, 0x82, 0x49, 0x8a, 0x6d,
-	0xf3, 0x2a, 0xc9, 0x24, 0xc5, 0xf0, 0xff, 0x00, 0x0c, 0x1e, 0x84, 0x62, 0xd7, 0x93, 0x64, 0xce,
-	0x47, 0x76, 0x30, 0x7c, 0x0c, 0x20, 0x97, 0xb8, 0x64, 0x24, 0x45, 0x59, 0x96, 0x22, 0x8c, 0x15,
-	0x29, 0x0a, 0x7f, 0xf2, 0xf0, 0xdd, 0xeb, 0x7b, 0x2b, 0xfe, 0x58, 0x2d, 0x97, 0x39, 0xd0, 0x8a,
-	0x8a, 0x6e, 0xb2, 0xe4, 0x30, 0xad, 0x2c, 0xf3, 0x71, 0xf8, 0x04, 0x2c, 0x69, 0xa9, 0x11, 0x4b,
-	0x11, 0x63, 0x32, 0xb3, 0x0a, 0xb2, 0x27, 0x9d, 0xbf, 0x7f, 0x2b, 0xf2, 0x14, 0x46, 0x42, 0x91,
-	0x97, 0x50, 0xd4, 0x96, 0x54, 0x6c, 0x56, 0x4c, 0x6b, 0x93, 0x9a, 0x05, 0xb6, 0x86, 0x38, 0x78,
-	0x00, 0x16, 0x3b, 0x4e, 0x0e, 0x69, 0x66, 0xf4, 0x60, 0x8f, 0x3e, 0x7f, 0x7f, 0x3d, 0xfa, 0xb0,
-	0xfc, 0xa3, 0xf3, 0xfa, 0xf1, 0xbc, 0x0b, 0x9d, 0xf3, 0x9a, 0xba, 0x03, 0x16, 0x0b, 0xa2, 0x35,
-	0x23, 0xd8, 0x35, 0x36, 0x9c, 0xb6, 0xad, 0x58, 0xf0, 0x41, 0xdb, 0x4d, 0xd8, 0x06, 0xa0, 0xd0,
-	0x48, 0xe9, 0xd4, 0xd8, 0x24, 0x9c, 0xb1, 0x65, 0x57, 0x23, 0x67, 0x91, 0x68, 0x60, 0x91, 0xe8,
-	0xf9, 0xc0, 0x43, 0x9b, 0xb3, 0xa6, 0xd0, 0xab, 0xdf, 0x1b, 0x41, 0x32, 0x67, 0x71, 0x26, 0x03,
-	0x77, 0x41, 0x2d, 0x57, 0x24, 0x65, 0xa8, 0x14, 0xd9, 0xa1, 0x63, 0x9a, 0x1d, 0x83, 0x69, 0x31,
-	0x57, 0x64, 0xd7, 0x62, 0x2d, 0xdb, 0x63, 0x30, 0x5b, 0x48, 0x86, 0x53, 0xc4, 0x75, 0x38, 0x67,
-	0xc7, 0xf2, 0x99, 0x17, 0xe4, 0x8d, 0xf7, 0x05, 0xb9, 0x23, 0xf4, 0x39, 0x29, 0xee, 0x08, 0x9d,
-	0xcc, 0x18, 0x70, 0x8b, 0x6b, 0xb8, 0x0b, 0xe6, 0x33, 0x86, 0x28, 0x27, 0x8e, 0x0a, 0x8c, 0x4f,
-	0x05, 0x3c, 0xde, 0xb0, 0x51, 0x70, 0x83, 0x8a, 0x8c, 0x08, 0x4d, 0x7b, 0x24, 0xcd, 0x19, 0x12,
-	0xa9, 0x73, 0x74, 0x38, 0x6f, 0x4f, 0x1a, 0x5f, 0x36, 0xaa, 0x9d, 0x01, 0xd0, 0xe8, 0x75, 0xdf,
-	0xc2, 0xfc, 0xc4, 0xae, 0xd3, 0xf7, 0x53, 0xf0, 0x05, 0x80, 0x1c, 0x1d, 0xa5, 0x88, 0xcb, 0x52,
-	0xe8, 0x54, 0xcb, 0xb4, 0x20, 0x8c, 0x85, 0x0b, 0xe3, 0x3f, 0x7f, 0x8d, 0xa3, 0xa3, 0x96, 0x65,
-	0x79, 0x2e, 0x0f, 0x08, 0x63, 0xf0, 0x05, 0xa8, 0x9e, 0xb9, 0x2d, 0x47, 0x4a, 0x87, 0x8b, 0x57,
-	0x75, 0xfc, 0xe2, 0x90, 0x68, 0x1f, 0x29, 0x0d, 0x0f, 0xc0, 0x42, 0x8f, 0x14, 0xda, 0x28, 0xd8,
-	0x34, 0x27, 0xac, 0xda, 0xae, 0x7c, 0x7a, 0x69, 0x57, 0x92, 0xbd, 0xaf, 0x1c, 0xc4, 0x9c, 0xdd,
-	0x37, 0x64, 0xbe, 0x77, 0x16, 0x82, 0x77, 0x41, 0x4d, 0x2b, 0x64, 0x6d, 0x41, 0x04, 0xea, 0x30,
-	0x82, 0xc3, 0xda, 0x5a, 0xb0, 0x3e, 0x9b, 0x54, 0x7d, 0x78, 0xdb, 0x45, 0xe1, 0x1e, 0x58, 0xa6,
-	0x4a, 0xba, 0xb1, 0x0c, 0xae, 0xf3, 0x70, 0xc9, 0x9b, 0x71, 0x54, 0x82, 0x5b, 0x7e, 0x83, 0x53,
-	0xe0, 0x8f, 0x46, 0x81, 0x35, 0xaa, 0xa4, 0xa9, 0x38, 0x48, 0x99, 0xca, 0x23, 0xd7, 0x52, 0xb8,
-	0x6c, 0xdd, 0x53, 0xbd, 0x78, 0x1b, 0x35, 0x7f, 0x0e, 0xc0, 0xf5, 0x7f, 0x18, 0x2f, 0xec, 0x80,
-	0xdb, 0x67, 0xbe, 0x4a, 0xd1, 0x4b, 0x4d, 0x54, 0xea, 0x8c, 0xc7, 0x89, 0xd0, 0xf6, 0xb6, 0xfa,
-	0x97, 0xcf, 0x16, 0x0e, 0x7d, 0xd6, 0x32, 0x2c, 0x07, 0x43, 0x12, 0x18, 0x83, 0x15, 0x51, 0xf2,
-	0x94, 0xe4, 0x32, 0x3b, 0x2c, 0xd2, 0x1c, 0x51, 0x9c, 0xca, 0x1e, 0x51, 0xf6, 0xca, 0xab, 0x24,
-	0xcb, 0xa2, 0xe4, 0xdb, 0x36, 0xb5, 0x8f, 0x28, 0xde, 0xeb, 0x11, 0xd5, 0xfc, 0x6b, 0x0a, 0x54,
-	0x2f, 0x76, 0x1d, 0xb6, 0xc1, 0xb4, 0xd3, 0x99, 0x7f, 0xfb, 0x8c, 0xa5, 0x2f, 0x0f, 0x85, 0xdb,
-	0x60, 0xc6, 0x3b, 0xc5, 0xbf, 0x82, 0xc6, 0x33, 0xac, 0xc7, 0x42, 0x0a, 0x96, 0x06, 0x1a, 0x1a,
-	0x0e, 0x71, 0xea, 0x63, 0x8d, 0xba, 0x63, 0x4a, 0xfd, 0x79, 0xdc, 0xf8, 0x5f, 0x1f, 0x71, 0xf6,
-	0xb0, 0x39, 0x4a, 0xd0, 0x74, 0xf3, 0xf5, 0xe1, 0xe1, 0x7c, 0x3f, 0x32, 0x9e, 0xca, 0x7f, 0x31,
-	0x9e, 0x8b, 0x57, 0xeb, 0xb5, 0xab, 0x5d, 0xad, 0x8f, 0xc0, 0x2c, 0x11, 0xd8, 0x51, 0x4c, 0x8f,
-	0x41, 0x31, 0x43, 0x04, 0x36, 0xf1, 0x87, 0x95, 0x1f, 0x7e, 0x6a, 0x4c, 0x6c, 0x3e, 0x79, 0x73,
-	0x52, 0x0f, 0xde, 0x9e, 0xd4, 0x83, 0x3f, 0x4e, 0xea, 0xc1, 0xab, 0xd3, 0xfa, 0xc4, 0xdb, 0xd3,
-	0xfa, 0xc4, 0xaf, 0xa7, 0xf5, 0x89, 0xaf, 0x3f, 0xef, 0x52, 0x7d, 0x58, 0x76, 0xa2, 0x4c, 0xf2,
-	0xf8, 0x03, 0x9f, 0x2f, 0xbd, 0x07, 0xf1, 0x91, 0xfd, 0x86, 0xd1, 0xfd, 0x9c, 0x14, 0x9d, 0x69,
-	0x5b, 0xf8, 0xc1, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x36, 0x30, 0x64, 0x27, 0xc2, 0x09, 0x00,
-	0x00,
+// SoftCap is the minimum liquidity a plan must raise by the deadline, or before
+// it is settled if earlier. If it is missed, trading stops, the plan is not
+// settled, and the plan enters a refund state. In the refund state the buyers
+// burn their IRO tokens, or give up their subscriptions to a fixed price sale,
+// for their pro rata share of the liquidity left in the plan. The taker fees
+// are not refunded.
+type SoftCap struct {
+	// amount of liquidity to raise
+	Amount   cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Deadline time.Time             `protobuf:"bytes,2,opt,name=deadline,proto3,stdtime" json:"deadline"`
+	// refunding is set once the plan entered the refund state
+	Refunding bool `protobuf:"varint,3,opt,name=refunding,proto3" json:"refunding,omitempty"`
+	// refund_supply is the amount of tokens left to refund
+	RefundSupply cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=refund_supply,json=refundSupply,proto3,customtype=cosmossdk.io/math.Int" json:"refund_supply"`
+	// refund_liquidity is the liquidity left to refund them with
+	RefundLiquidity cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=refund_liquidity,json=refundLiquidity,proto3,customtype=cosmossdk.io/math.Int" json:"refund_liquidity"`
+	// raised is the liquidity raised by trading: the cost of the buys less the
+	// revenue of the sells. It excludes the creation fee and anything else sent
+	// to the plan.
+	Raised cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=raised,proto3,customtype=cosmossdk.io/math.Int" json:"raised"`
 }
 
-func (m *BondingCurve) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SoftCap) Reset()         { *m = SoftCap{} }
+func (m *SoftCap) String() string { return proto.CompactTextString(m) }
+func (*SoftCap) ProtoMessage()    {}
+func (*SoftCap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{4}
+}
+func (m *SoftCap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SoftCap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SoftCap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *BondingCurve) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SoftCap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SoftCap.Merge(m, src)
+}
+func (m *SoftCap) XXX_Size() int {
+	return m.Size()
+}
+func (m *SoftCap) XXX_DiscardUnknown() {
+	xxx_messageInfo_SoftCap.DiscardUnknown(m)
 }
 
-func (m *BondingCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LiquidityDenomDecimals != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.LiquidityDenomDecimals))
-		i--
-		dAtA[i] = 0x28
+var xxx_messageInfo_SoftCap proto.InternalMessageInfo
+
+func (m *SoftCap) GetDeadline() time.Time {
+	if m != nil {
+		return m.Deadline
 	}
-	if m.RollappDenomDecimals != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.RollappDenomDecimals))
-		i--
-		dAtA[i] = 0x20
+	return time.Time{}
+}
+
+func (m *SoftCap) GetRefunding() bool {
+	if m != nil {
+		return m.Refunding
 	}
-	{
-		size := m.C.Size()
-		i -= size
-		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return false
+}
+
+// Whitelist restricts buying to the whitelisted accounts, up to a cap per
+// account, for the duration of the whitelist phase from the start time of the
+// plan. The sale is public afterwards, optionally with another cap per account.
+// The accounts are whitelisted either by an explicit list of addresses, or by
+// the root of a Merkle tree of their addresses, which they prove they are in
+// when they buy.
+type Whitelist struct {
+	// addresses of the whitelisted accounts, if merkle_root is not set
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// merkle_root of the tree of the whitelisted addresses, if addresses are not
+	// set. The leaves are the sha256 of the bech32 addresses, and the parents the
+	// sha256 of their sorted children.
+	MerkleRoot []byte `protobuf:"bytes,2,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	// cap is the max amount of tokens each account can buy in the whitelist
+	// phase
+	Cap cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=cap,proto3,customtype=cosmossdk.io/math.Int" json:"cap"`
+	// duration of the whitelist phase
+	Duration time.Duration `protobuf:"bytes,4,opt,name=duration,proto3,stdduration" json:"duration"`
+	// public_cap is the max amount of tokens each account can buy in total,
+	// including the whitelist phase, once the sale is public. Zero for no cap.
+	PublicCap cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=public_cap,json=publicCap,proto3,customtype=cosmossdk.io/math.Int" json:"public_cap"`
+}
+
+func (m *Whitelist) Reset()         { *m = Whitelist{} }
+func (m *Whitelist) String() string { return proto.CompactTextString(m) }
+func (*Whitelist) ProtoMessage()    {}
+func (*Whitelist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{5}
+}
+func (m *Whitelist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Whitelist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Whitelist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.N.Size()
-		i -= size
-		if _, err := m.N.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+}
+func (m *Whitelist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Whitelist.Merge(m, src)
+}
+func (m *Whitelist) XXX_Size() int {
+	return m.Size()
+}
+func (m *Whitelist) XXX_DiscardUnknown() {
+	xxx_messageInfo_Whitelist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Whitelist proto.InternalMessageInfo
+
+func (m *Whitelist) GetAddresses() []string {
+	if m != nil {
+		return m.Addresses
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.M.Size()
-		i -= size
-		if _, err := m.M.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+	return nil
+}
+
+func (m *Whitelist) GetMerkleRoot() []byte {
+	if m != nil {
+		return m.MerkleRoot
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Plan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Whitelist) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Purchases of an account from a plan with a whitelist, counted against its
+// caps
+type Purchases struct {
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Buyer  string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// amount is the total amount of tokens bought, regardless of sells
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// proven is set once the account proved it is in the Merkle tree of the
+	// whitelist
+	Proven bool `protobuf:"varint,4,opt,name=proven,proto3" json:"proven,omitempty"`
 }
 
-func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.LiquidityDenom) > 0 {
-		i -= len(m.LiquidityDenom)
-		copy(dAtA[i:], m.LiquidityDenom)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.LiquidityDenom)))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x8a
-	}
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintIro(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x1
-	i--
-	dAtA[i] = 0x82
-	if m.TradingEnabled {
-		i--
-		if m.TradingEnabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x78
-	}
-	{
-		size, err := m.VestingPlan.MarshalToSizedBuffer(dAtA[:i])
+func (m *Purchases) Reset()         { *m = Purchases{} }
+func (m *Purchases) String() string { return proto.CompactTextString(m) }
+func (*Purchases) ProtoMessage()    {}
+func (*Purchases) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{6}
+}
+func (m *Purchases) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Purchases) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Purchases.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x72
-	{
-		size := m.LiquidityPart.Size()
-		i -= size
-		if _, err := m.LiquidityPart.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x6a
-	{
-		size := m.MaxAmountToSell.Size()
-		i -= size
-		if _, err := m.MaxAmountToSell.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+}
+func (m *Purchases) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Purchases.Merge(m, src)
+}
+func (m *Purchases) XXX_Size() int {
+	return m.Size()
+}
+func (m *Purchases) XXX_DiscardUnknown() {
+	xxx_messageInfo_Purchases.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Purchases proto.InternalMessageInfo
+
+func (m *Purchases) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
 	}
-	i--
-	dAtA[i] = 0x62
-	{
-		size, err := m.IncentivePlanParams.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *Purchases) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	i--
-	dAtA[i] = 0x5a
-	{
-		size := m.ClaimedAmt.Size()
-		i -= size
-		if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *Purchases) GetProven() bool {
+	if m != nil {
+		return m.Proven
 	}
-	i--
-	dAtA[i] = 0x52
-	{
-		size := m.SoldAmt.Size()
-		i -= size
-		if _, err := m.SoldAmt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+	return false
+}
+
+// DutchAuction sells the tokens at a price descending linearly from
+// start_price to end_price over the duration, from the start time of the plan.
+// The price then stays at end_price. The tokens cannot be sold back.
+type DutchAuction struct {
+	// price per token, as for the bonding curve
+	StartPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=start_price,json=startPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"start_price"`
+	EndPrice   cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=end_price,json=endPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"end_price"`
+	Duration   time.Duration               `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration"`
+}
+
+func (m *DutchAuction) Reset()         { *m = DutchAuction{} }
+func (m *DutchAuction) String() string { return proto.CompactTextString(m) }
+func (*DutchAuction) ProtoMessage()    {}
+func (*DutchAuction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{7}
+}
+func (m *DutchAuction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x4a
-	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime):])
-	if err4 != nil {
-		return 0, err4
-	}
-	i -= n4
-	i = encodeVarintIro(dAtA, i, uint64(n4))
-	i--
-	dAtA[i] = 0x42
-	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err5 != nil {
-		return 0, err5
+		return b[:n], nil
 	}
-	i -= n5
-	i = encodeVarintIro(dAtA, i, uint64(n5))
-	i--
-	dAtA[i] = 0x3a
-	if len(m.SettledDenom) > 0 {
-		i -= len(m.SettledDenom)
-		copy(dAtA[i:], m.SettledDenom)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.SettledDenom)))
-		i--
-		dAtA[i] = 0x32
+}
+func (m *DutchAuction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuction.Merge(m, src)
+}
+func (m *DutchAuction) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuction) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuction proto.InternalMessageInfo
+
+func (m *DutchAuction) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
 	}
-	{
-		size, err := m.BondingCurve.MarshalToSizedBuffer(dAtA[:i])
+	return 0
+}
+
+// FixedPriceSale sells the tokens at a fixed price. Buyers subscribe during the
+// sale, and receive their tokens when they claim after settlement. If the sale
+// is oversubscribed, each subscription is allocated tokens pro rata, and the
+// rest of its payment is refunded. The tokens cannot be sold back.
+type FixedPriceSale struct {
+	// price per token, as for the bonding curve
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// subscribed is the total amount of tokens subscribed
+	Subscribed cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=subscribed,proto3,customtype=cosmossdk.io/math.Int" json:"subscribed"`
+	// paid is the total liquidity paid for the subscriptions, without fees
+	Paid cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
+	// allocated is the amount of tokens allocated to the subscriptions, set at
+	// settlement
+	Allocated cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=allocated,proto3,customtype=cosmossdk.io/math.Int" json:"allocated"`
+	// refund_reserve is the liquidity kept for the refunds not claimed yet
+	RefundReserve cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=refund_reserve,json=refundReserve,proto3,customtype=cosmossdk.io/math.Int" json:"refund_reserve"`
+}
+
+func (m *FixedPriceSale) Reset()         { *m = FixedPriceSale{} }
+func (m *FixedPriceSale) String() string { return proto.CompactTextString(m) }
+func (*FixedPriceSale) ProtoMessage()    {}
+func (*FixedPriceSale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{8}
+}
+func (m *FixedPriceSale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FixedPriceSale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FixedPriceSale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size, err := m.TotalAllocation.MarshalToSizedBuffer(dAtA[:i])
+}
+func (m *FixedPriceSale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixedPriceSale.Merge(m, src)
+}
+func (m *FixedPriceSale) XXX_Size() int {
+	return m.Size()
+}
+func (m *FixedPriceSale) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixedPriceSale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixedPriceSale proto.InternalMessageInfo
+
+// Subscription of a buyer to a fixed price sale
+type Subscription struct {
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Buyer  string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	// amount is the amount of tokens subscribed
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// paid is the liquidity paid for them, without fees
+	Paid cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=paid,proto3,customtype=cosmossdk.io/math.Int" json:"paid"`
+}
+
+func (m *Subscription) Reset()         { *m = Subscription{} }
+func (m *Subscription) String() string { return proto.CompactTextString(m) }
+func (*Subscription) ProtoMessage()    {}
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{9}
+}
+func (m *Subscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Subscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Subscription.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintIro(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.ModuleAccAddress) > 0 {
-		i -= len(m.ModuleAccAddress)
-		copy(dAtA[i:], m.ModuleAccAddress)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.ModuleAccAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintIro(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.Id != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *Subscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Subscription.Merge(m, src)
+}
+func (m *Subscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *Subscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_Subscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Subscription proto.InternalMessageInfo
+
+func (m *Subscription) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *IncentivePlanParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Subscription) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *IncentivePlanParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type IncentivePlanParams struct {
+	// start_time_after_settlement is the time after IRO settlement when the
+	// distribution of the remaining tokens as incentives will start
+	StartTimeAfterSettlement time.Duration `protobuf:"bytes,1,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
+	// num_epochs_paid_over is the number of total epochs distribution will be
+	// completed over
+	NumEpochsPaidOver uint64 `protobuf:"varint,2,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
 }
 
-func (m *IncentivePlanParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NumEpochsPaidOver != 0 {
-		i = encodeVarintIro(dAtA, i, uint64(m.NumEpochsPaidOver))
-		i--
-		dAtA[i] = 0x10
+func (m *IncentivePlanParams) Reset()         { *m = IncentivePlanParams{} }
+func (m *IncentivePlanParams) String() string { return proto.CompactTextString(m) }
+func (*IncentivePlanParams) ProtoMessage()    {}
+func (*IncentivePlanParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{10}
+}
+func (m *IncentivePlanParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IncentivePlanParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IncentivePlanParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
-	if err8 != nil {
-		return 0, err8
+}
+func (m *IncentivePlanParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IncentivePlanParams.Merge(m, src)
+}
+func (m *IncentivePlanParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *IncentivePlanParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_IncentivePlanParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IncentivePlanParams proto.InternalMessageInfo
+
+func (m *IncentivePlanParams) GetStartTimeAfterSettlement() time.Duration {
+	if m != nil {
+		return m.StartTimeAfterSettlement
 	}
-	i -= n8
-	i = encodeVarintIro(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *IROVestingPlan) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *IncentivePlanParams) GetNumEpochsPaidOver() uint64 {
+	if m != nil {
+		return m.NumEpochsPaidOver
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *IROVestingPlan) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type IROVestingPlan struct {
+	Amount                   cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Claimed                  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	VestingDuration          time.Duration         `protobuf:"bytes,3,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
+	StartTimeAfterSettlement time.Duration         `protobuf:"bytes,4,opt,name=start_time_after_settlement,json=startTimeAfterSettlement,proto3,stdduration" json:"start_time_after_settlement"`
+	// Vesting start time (set on IRO settlement)
+	StartTime time.Time `protobuf:"bytes,5,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	// Vesting end time (set on IRO settlement)
+	EndTime time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
 }
 
-func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
-	if err9 != nil {
-		return 0, err9
+func (m *IROVestingPlan) Reset()         { *m = IROVestingPlan{} }
+func (m *IROVestingPlan) String() string { return proto.CompactTextString(m) }
+func (*IROVestingPlan) ProtoMessage()    {}
+func (*IROVestingPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{11}
+}
+func (m *IROVestingPlan) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IROVestingPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IROVestingPlan.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n9
-	i = encodeVarintIro(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x32
-	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err10 != nil {
-		return 0, err10
+}
+func (m *IROVestingPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IROVestingPlan.Merge(m, src)
+}
+func (m *IROVestingPlan) XXX_Size() int {
+	return m.Size()
+}
+func (m *IROVestingPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_IROVestingPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IROVestingPlan proto.InternalMessageInfo
+
+// Trade is a buy or a sell of the tokens of a plan, kept until the plan is
+// settled
+type Trade struct {
+	PlanId uint64 `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// seq is the sequence number of the trade in the plan
+	Seq    uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	Trader string `protobuf:"bytes,3,opt,name=trader,proto3" json:"trader,omitempty"`
+	// buy is whether the trade is a buy, or a sell otherwise
+	Buy bool `protobuf:"varint,4,opt,name=buy,proto3" json:"buy,omitempty"`
+	// amount of tokens traded
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// cost of the tokens, without the taker fee
+	Cost cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=cost,proto3,customtype=cosmossdk.io/math.Int" json:"cost"`
+	// closing_price is the spot price after the trade
+	ClosingPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=closing_price,json=closingPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"closing_price"`
+	Time         time.Time                   `protobuf:"bytes,8,opt,name=time,proto3,stdtime" json:"time"`
+	Height       int64                       `protobuf:"varint,9,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *Trade) Reset()         { *m = Trade{} }
+func (m *Trade) String() string { return proto.CompactTextString(m) }
+func (*Trade) ProtoMessage()    {}
+func (*Trade) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{12}
+}
+func (m *Trade) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Trade) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Trade.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i -= n10
-	i = encodeVarintIro(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x2a
-	n11, err11 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
-	if err11 != nil {
-		return 0, err11
+}
+func (m *Trade) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Trade.Merge(m, src)
+}
+func (m *Trade) XXX_Size() int {
+	return m.Size()
+}
+func (m *Trade) XXX_DiscardUnknown() {
+	xxx_messageInfo_Trade.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Trade proto.InternalMessageInfo
+
+func (m *Trade) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
 	}
-	i -= n11
-	i = encodeVarintIro(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0x22
-	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
-	if err12 != nil {
-		return 0, err12
+	return 0
+}
+
+func (m *Trade) GetSeq() uint64 {
+	if m != nil {
+		return m.Seq
+	}
+	return 0
+}
+
+func (m *Trade) GetTrader() string {
+	if m != nil {
+		return m.Trader
+	}
+	return ""
+}
+
+func (m *Trade) GetBuy() bool {
+	if m != nil {
+		return m.Buy
+	}
+	return false
+}
+
+func (m *Trade) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Trade) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// Candle aggregates the spot prices of a plan over the trades of an interval,
+// kept until the plan is settled. The open price is the price before the first
+// trade of the interval.
+type Candle struct {
+	PlanId    uint64                      `protobuf:"varint,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	StartTime time.Time                   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	Open      cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=open,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"open"`
+	High      cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=high,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"high"`
+	Low       cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=low,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"low"`
+	Close     cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=close,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"close"`
+	// volume is the amount of tokens traded
+	Volume cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=volume,proto3,customtype=cosmossdk.io/math.Int" json:"volume"`
+	// trades is the number of trades
+	Trades uint64 `protobuf:"varint,8,opt,name=trades,proto3" json:"trades,omitempty"`
+}
+
+func (m *Candle) Reset()         { *m = Candle{} }
+func (m *Candle) String() string { return proto.CompactTextString(m) }
+func (*Candle) ProtoMessage()    {}
+func (*Candle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7d27cc6b5064d3f, []int{13}
+}
+func (m *Candle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Candle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Candle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Candle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Candle.Merge(m, src)
+}
+func (m *Candle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Candle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Candle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Candle proto.InternalMessageInfo
+
+func (m *Candle) GetPlanId() uint64 {
+	if m != nil {
+		return m.PlanId
+	}
+	return 0
+}
+
+func (m *Candle) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *Candle) GetTrades() uint64 {
+	if m != nil {
+		return m.Trades
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*BondingCurve)(nil), "dymensionxyz.dymension.iro.BondingCurve")
+	proto.RegisterType((*Plan)(nil), "dymensionxyz.dymension.iro.Plan")
+	proto.RegisterType((*BuyerVestingPlan)(nil), "dymensionxyz.dymension.iro.BuyerVestingPlan")
+	proto.RegisterType((*BuyerVesting)(nil), "dymensionxyz.dymension.iro.BuyerVesting")
+	proto.RegisterType((*SoftCap)(nil), "dymensionxyz.dymension.iro.SoftCap")
+	proto.RegisterType((*Whitelist)(nil), "dymensionxyz.dymension.iro.Whitelist")
+	proto.RegisterType((*Purchases)(nil), "dymensionxyz.dymension.iro.Purchases")
+	proto.RegisterType((*DutchAuction)(nil), "dymensionxyz.dymension.iro.DutchAuction")
+	proto.RegisterType((*FixedPriceSale)(nil), "dymensionxyz.dymension.iro.FixedPriceSale")
+	proto.RegisterType((*Subscription)(nil), "dymensionxyz.dymension.iro.Subscription")
+	proto.RegisterType((*IncentivePlanParams)(nil), "dymensionxyz.dymension.iro.IncentivePlanParams")
+	proto.RegisterType((*IROVestingPlan)(nil), "dymensionxyz.dymension.iro.IROVestingPlan")
+	proto.RegisterType((*Trade)(nil), "dymensionxyz.dymension.iro.Trade")
+	proto.RegisterType((*Candle)(nil), "dymensionxyz.dymension.iro.Candle")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/iro/iro.proto", fileDescriptor_e7d27cc6b5064d3f)
+}
+
+var fileDescriptor_e7d27cc6b5064d3f = []byte{
+	// 1732 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd6, 0x92, 0x4b, 0x8a, 0x7c, 0xa2, 0x28, 0x7a, 0x2c, 0x3b, 0x1b, 0xa7, 0x95, 0x8c, 0x75,
+	0x8b, 0x18, 0x6d, 0x43, 0xc6, 0x4e, 0x51, 0xa4, 0x01, 0x5a, 0x57, 0xa2, 0xec, 0x42, 0xae, 0x6c,
+	0x0b, 0x4b, 0xc3, 0x0d, 0x72, 0x59, 0x0c, 0x77, 0x87, 0xe4, 0x20, 0xb3, 0x3b, 0x9b, 0xdd, 0x59,
+	0x46, 0xec, 0x1f, 0x50, 0xb4, 0xb7, 0x1c, 0x7b, 0x29, 0xd0, 0x53, 0x0f, 0x3d, 0xb5, 0x40, 0xfe,
+	0x81, 0xde, 0x72, 0x0c, 0xd2, 0x4b, 0x51, 0xa0, 0x4e, 0x61, 0x1f, 0x7a, 0x6f, 0x0f, 0xbd, 0x16,
+	0xf3, 0x63, 0x29, 0x52, 0xa9, 0x25, 0x73, 0xe3, 0x43, 0x0f, 0x04, 0x38, 0x3f, 0xbe, 0x6f, 0x76,
+	0xde, 0xfb, 0xde, 0x7b, 0x33, 0x03, 0xdf, 0x0a, 0x67, 0x11, 0x89, 0x33, 0xca, 0xe3, 0x93, 0xd9,
+	0x2f, 0x7a, 0xf3, 0x46, 0x8f, 0xa6, 0x5c, 0xfe, 0xba, 0x49, 0xca, 0x05, 0x47, 0xd7, 0x16, 0x67,
+	0x75, 0xe7, 0x8d, 0x2e, 0x4d, 0xf9, 0xb5, 0xed, 0x31, 0x1f, 0x73, 0x35, 0xad, 0x27, 0xff, 0x69,
+	0xc4, 0xb5, 0xdd, 0x31, 0xe7, 0x63, 0x46, 0x7a, 0xaa, 0x35, 0xcc, 0x47, 0x3d, 0x41, 0x23, 0x92,
+	0x09, 0x1c, 0x25, 0x66, 0xc2, 0xce, 0xd9, 0x09, 0x61, 0x9e, 0x62, 0x21, 0x49, 0xcd, 0x78, 0xc0,
+	0xb3, 0x88, 0x67, 0xbd, 0x21, 0xce, 0x48, 0x6f, 0x7a, 0x6b, 0x48, 0x04, 0xbe, 0xd5, 0x0b, 0x38,
+	0x2d, 0xc6, 0x5f, 0xd7, 0xe3, 0xbe, 0x5e, 0x59, 0x37, 0xcc, 0xd0, 0x9b, 0xe7, 0xec, 0x29, 0xc1,
+	0x29, 0x8e, 0xcc, 0x44, 0xf7, 0xcf, 0x15, 0x68, 0xed, 0xf3, 0x38, 0xa4, 0xf1, 0xb8, 0x9f, 0xa7,
+	0x53, 0x82, 0xee, 0x80, 0xf5, 0xc0, 0xb1, 0xae, 0x5b, 0x37, 0x9b, 0xfb, 0xb7, 0x3e, 0x7b, 0xba,
+	0xbb, 0xf6, 0xb7, 0xa7, 0xbb, 0x6f, 0x68, 0xea, 0x2c, 0xfc, 0xb0, 0x4b, 0x79, 0x2f, 0xc2, 0x62,
+	0xd2, 0x3d, 0x22, 0x63, 0x1c, 0xcc, 0x0e, 0x48, 0xf0, 0xc5, 0xa7, 0x6f, 0x81, 0x59, 0xf9, 0x80,
+	0x04, 0x9e, 0xf5, 0x40, 0x12, 0x3c, 0x74, 0x2a, 0xa5, 0x09, 0x1e, 0x4a, 0x82, 0xbe, 0x53, 0x2d,
+	0x4d, 0xd0, 0x47, 0xdf, 0x87, 0xab, 0x29, 0x67, 0x0c, 0x27, 0x89, 0x1f, 0x92, 0x98, 0x47, 0x7e,
+	0x48, 0x02, 0x1a, 0x61, 0x96, 0x39, 0xf6, 0x75, 0xeb, 0xa6, 0xed, 0x6d, 0x9b, 0xd1, 0x03, 0x39,
+	0x78, 0x60, 0xc6, 0xd0, 0xbb, 0xe0, 0x30, 0xfa, 0x51, 0x4e, 0x43, 0x2a, 0x66, 0x67, 0x71, 0x35,
+	0x85, 0xbb, 0x3a, 0x1f, 0x5f, 0x42, 0xba, 0xbf, 0x6e, 0x81, 0x7d, 0xcc, 0x70, 0x8c, 0xda, 0x50,
+	0xa1, 0xa1, 0x32, 0x9e, 0xed, 0x55, 0x68, 0x88, 0xbe, 0x09, 0x50, 0x7c, 0x08, 0x0d, 0xb5, 0x4d,
+	0xbc, 0xa6, 0xe9, 0x39, 0x0c, 0xd1, 0x3d, 0x40, 0x11, 0x0f, 0x73, 0x46, 0x7c, 0x1c, 0x04, 0x3e,
+	0x0e, 0xc3, 0x94, 0x64, 0x99, 0xd9, 0xb9, 0xf3, 0xc5, 0xa7, 0x6f, 0x6d, 0x9b, 0x6d, 0xed, 0xe9,
+	0x91, 0x81, 0x48, 0x69, 0x3c, 0xf6, 0x3a, 0x1a, 0xb3, 0x17, 0x04, 0xa6, 0x1f, 0xdd, 0x87, 0x8e,
+	0xe0, 0x02, 0x33, 0x1f, 0x33, 0xc6, 0x03, 0xa5, 0x20, 0xb5, 0xd3, 0x8d, 0xdb, 0xaf, 0x77, 0x0d,
+	0x85, 0x94, 0x50, 0xd7, 0x48, 0xa8, 0xdb, 0xe7, 0x34, 0xde, 0xb7, 0xa5, 0x69, 0xbd, 0x2d, 0x05,
+	0xdc, 0x9b, 0xe3, 0xd0, 0x00, 0x36, 0x87, 0x5a, 0x0e, 0x7e, 0x20, 0xf5, 0xa0, 0xb6, 0xbe, 0x71,
+	0xfb, 0x66, 0xf7, 0xc5, 0xf2, 0xef, 0x2e, 0xea, 0xc7, 0xf0, 0xb6, 0x86, 0x8b, 0x9a, 0xba, 0x01,
+	0x9b, 0x19, 0x11, 0x82, 0x91, 0x50, 0x1b, 0xd6, 0xa9, 0x2b, 0x53, 0xb4, 0x4c, 0xa7, 0xb2, 0x26,
+	0xea, 0x03, 0x64, 0x02, 0xa7, 0xc2, 0x97, 0x61, 0xe2, 0xac, 0xab, 0x65, 0xaf, 0x75, 0x75, 0x88,
+	0x74, 0x8b, 0x10, 0xe9, 0x3e, 0x2e, 0x62, 0x68, 0xbf, 0x21, 0x17, 0xfa, 0xe4, 0xcb, 0x5d, 0xcb,
+	0x6b, 0x2a, 0x9c, 0x1c, 0x41, 0x47, 0xb0, 0x95, 0xa4, 0xc4, 0x67, 0x38, 0x8f, 0x83, 0x89, 0x66,
+	0x6a, 0xac, 0xc0, 0xb4, 0x99, 0xa4, 0xe4, 0x48, 0x61, 0x15, 0xdb, 0x3d, 0x68, 0x64, 0x9c, 0x85,
+	0x3e, 0x8e, 0x84, 0xd3, 0x54, 0x6e, 0xf9, 0xae, 0x11, 0xe4, 0x95, 0xaf, 0x0a, 0xf2, 0x30, 0x16,
+	0x0b, 0x52, 0x3c, 0x8c, 0x85, 0xb7, 0x2e, 0xc1, 0x7b, 0x91, 0x40, 0x47, 0xb0, 0x11, 0x30, 0x4c,
+	0x23, 0xa2, 0xa9, 0x60, 0x75, 0x2a, 0x30, 0x78, 0xc9, 0x46, 0xe1, 0x0a, 0x8d, 0x03, 0x12, 0x0b,
+	0x3a, 0x25, 0x7e, 0xc2, 0x70, 0xec, 0xeb, 0x88, 0x76, 0x36, 0xd4, 0x4e, 0x7b, 0xe7, 0xb9, 0xea,
+	0xb0, 0x00, 0x4a, 0xbd, 0x1e, 0x2b, 0x98, 0xf1, 0xd8, 0x65, 0xfa, 0xd5, 0x21, 0xf4, 0x3e, 0xa0,
+	0x08, 0x9f, 0xf8, 0x38, 0xe2, 0x79, 0x2c, 0x7c, 0xc1, 0xfd, 0x8c, 0x30, 0xe6, 0xb4, 0x56, 0xff,
+	0xfe, 0xad, 0x08, 0x9f, 0xec, 0x29, 0x96, 0xc7, 0x7c, 0x40, 0x18, 0x43, 0xef, 0x43, 0xfb, 0x34,
+	0xda, 0x12, 0x9c, 0x0a, 0x67, 0xb3, 0x6c, 0xc4, 0x6f, 0xce, 0x89, 0x8e, 0x71, 0x2a, 0xd0, 0x00,
+	0x5a, 0x53, 0x92, 0x09, 0xa9, 0x60, 0x69, 0x1c, 0xa7, 0xad, 0xac, 0xf2, 0x9d, 0x73, 0xad, 0xe2,
+	0x3d, 0x7a, 0xa2, 0x21, 0x72, 0xef, 0xc6, 0x20, 0x1b, 0xd3, 0xd3, 0x2e, 0xf4, 0x26, 0x6c, 0x89,
+	0x14, 0xab, 0xb0, 0x20, 0x31, 0x1e, 0x32, 0x12, 0x3a, 0x5b, 0xd7, 0xad, 0x9b, 0x0d, 0xaf, 0x6d,
+	0xba, 0xef, 0xea, 0x5e, 0xf4, 0x08, 0x2e, 0xd1, 0x94, 0x6b, 0xb7, 0x14, 0xe9, 0xdc, 0xe9, 0x98,
+	0x60, 0x3c, 0x2b, 0xc1, 0x03, 0x33, 0x41, 0x2b, 0xf0, 0x37, 0x52, 0x81, 0x5b, 0x34, 0xe5, 0x72,
+	0xc5, 0x62, 0x48, 0xae, 0x7c, 0x26, 0x2d, 0x39, 0x97, 0x54, 0xf4, 0xb4, 0x97, 0xb3, 0x11, 0x7a,
+	0x00, 0x9b, 0x61, 0x2e, 0x82, 0x89, 0x8f, 0xf3, 0x40, 0xad, 0x8a, 0x2e, 0x8e, 0xdc, 0x03, 0x09,
+	0xd8, 0xd3, 0xf3, 0xbd, 0x56, 0xb8, 0xd0, 0x42, 0x8f, 0xa1, 0x33, 0xa2, 0x27, 0x24, 0xf4, 0x93,
+	0x94, 0x06, 0xc4, 0xcf, 0x30, 0x23, 0xce, 0xe5, 0x8b, 0x4d, 0x79, 0x4f, 0x62, 0x8e, 0x25, 0x64,
+	0x80, 0x19, 0xf1, 0xda, 0xa3, 0xa5, 0x36, 0xea, 0x43, 0xf3, 0xe3, 0x09, 0x15, 0x84, 0xd1, 0x4c,
+	0x38, 0xdb, 0x8a, 0xee, 0xdb, 0xe7, 0xd1, 0xfd, 0xbc, 0x98, 0xec, 0x9d, 0xe2, 0xd0, 0x8f, 0x65,
+	0x58, 0x8e, 0x84, 0x1f, 0xe0, 0xc4, 0xb9, 0xa2, 0x38, 0x6e, 0x9c, 0xc7, 0x31, 0xe0, 0x23, 0xd1,
+	0xc7, 0x89, 0x0c, 0x47, 0xf5, 0x07, 0x7d, 0x00, 0x68, 0x98, 0xcf, 0x48, 0xea, 0x2f, 0xe9, 0xe4,
+	0xaa, 0x62, 0xfa, 0xde, 0xb9, 0x89, 0x4e, 0xa2, 0x16, 0x94, 0xe2, 0x75, 0x86, 0x67, 0x7a, 0xdc,
+	0xbf, 0x58, 0xd0, 0x39, 0x3b, 0x0d, 0xfd, 0x10, 0x6a, 0x01, 0xa3, 0xa3, 0x91, 0x63, 0xbd, 0xbc,
+	0x10, 0x34, 0x02, 0xdd, 0x81, 0xc6, 0x5c, 0x46, 0x95, 0x97, 0x47, 0xcf, 0x41, 0x67, 0xd2, 0x6a,
+	0xb5, 0x54, 0x5a, 0x75, 0xff, 0x69, 0x41, 0x6b, 0x71, 0x57, 0xe8, 0x35, 0x58, 0x57, 0x12, 0x9f,
+	0x97, 0xbb, 0xba, 0x6c, 0x1e, 0x86, 0xe8, 0x36, 0xac, 0xe3, 0x20, 0x90, 0x81, 0xee, 0x54, 0x2e,
+	0x28, 0x64, 0xc5, 0x44, 0xd4, 0x87, 0xba, 0xce, 0x30, 0x4e, 0x75, 0xf5, 0xcc, 0x62, 0xa0, 0xe8,
+	0x2e, 0xac, 0x9b, 0x1c, 0xe9, 0xd8, 0xab, 0xb3, 0x14, 0x58, 0xf7, 0xb7, 0x55, 0x58, 0x37, 0x82,
+	0x59, 0xf8, 0x2e, 0xab, 0xfc, 0x77, 0xfd, 0x04, 0x1a, 0x21, 0xc1, 0x21, 0xa3, 0x31, 0x71, 0x2a,
+	0x2b, 0x58, 0x7f, 0x8e, 0x42, 0xdf, 0x80, 0x66, 0x4a, 0x46, 0xb9, 0xaa, 0xa7, 0xca, 0x42, 0x0d,
+	0xef, 0xb4, 0x03, 0x1d, 0xc3, 0xa6, 0x6e, 0xf8, 0x59, 0x9e, 0x24, 0x6c, 0x56, 0x66, 0xf7, 0x2d,
+	0xcd, 0x30, 0x50, 0x04, 0xe8, 0x09, 0x74, 0x0c, 0xe3, 0x3c, 0xc3, 0x38, 0xb5, 0xd5, 0x49, 0xb7,
+	0x34, 0xc9, 0x51, 0xc1, 0x21, 0xcd, 0x99, 0x62, 0x9a, 0x91, 0xd0, 0xa9, 0xaf, 0xce, 0x66, 0xa0,
+	0xee, 0x1f, 0x2b, 0xd0, 0x9c, 0x27, 0x05, 0xf4, 0x03, 0x68, 0x9a, 0x63, 0x13, 0xc9, 0x1c, 0xeb,
+	0x7a, 0xf5, 0x5c, 0xbd, 0x9d, 0x4e, 0x45, 0xbb, 0xb0, 0x11, 0x91, 0xf4, 0x43, 0x46, 0xfc, 0x94,
+	0x73, 0xad, 0xd4, 0x96, 0x07, 0xba, 0xcb, 0xe3, 0x5c, 0xa0, 0x1f, 0x41, 0x55, 0x66, 0x97, 0x12,
+	0x7a, 0x94, 0xb8, 0xa5, 0xa8, 0xb5, 0xcb, 0x44, 0xed, 0x7d, 0x80, 0x24, 0x1f, 0x32, 0x1a, 0xa8,
+	0x24, 0x57, 0xc2, 0xfa, 0x4d, 0x0d, 0xef, 0xe3, 0xc4, 0xfd, 0x93, 0x05, 0xcd, 0xe3, 0x3c, 0x0d,
+	0x26, 0x58, 0x6e, 0xfd, 0x85, 0x91, 0xdb, 0x85, 0x9a, 0xca, 0x66, 0x17, 0xc6, 0xad, 0x9e, 0xf6,
+	0x6a, 0xa2, 0xf6, 0x2a, 0xd4, 0x93, 0x94, 0x4f, 0x89, 0x36, 0x53, 0xc3, 0x33, 0x2d, 0xf7, 0xdf,
+	0x16, 0xb4, 0x16, 0x8b, 0x13, 0xf2, 0x60, 0x43, 0xa7, 0x31, 0x55, 0x8e, 0xca, 0x5f, 0x50, 0x74,
+	0x32, 0x54, 0x05, 0x09, 0x3d, 0x84, 0x26, 0x89, 0x4d, 0x81, 0x2b, 0x7f, 0x63, 0x69, 0x90, 0x58,
+	0x17, 0xb8, 0x25, 0xaf, 0x57, 0x4b, 0x78, 0xdd, 0xfd, 0x65, 0x15, 0xda, 0xcb, 0x05, 0x14, 0xfd,
+	0x14, 0x6a, 0x5f, 0x73, 0xc7, 0x1a, 0x8f, 0x7e, 0x06, 0x90, 0xe5, 0xc3, 0x2c, 0x48, 0xe9, 0x90,
+	0x98, 0xbb, 0xc8, 0x8a, 0x47, 0xd0, 0x53, 0x38, 0xba, 0x03, 0x76, 0x82, 0x69, 0x58, 0xc6, 0xf3,
+	0x0a, 0x88, 0x0e, 0xa1, 0x69, 0x2e, 0x2b, 0xe5, 0xf2, 0xf5, 0x29, 0x1a, 0x79, 0xd0, 0x36, 0xe9,
+	0x2a, 0x25, 0x19, 0x29, 0xae, 0x2c, 0x2b, 0xf2, 0x99, 0x1c, 0xea, 0x69, 0x06, 0xf7, 0xef, 0x16,
+	0xb4, 0x06, 0x7a, 0xbb, 0x89, 0x92, 0xdf, 0xff, 0x57, 0xd4, 0x14, 0xe6, 0xb7, 0x4b, 0x9a, 0xdf,
+	0xfd, 0x83, 0x05, 0x97, 0xff, 0xc7, 0x55, 0x00, 0x0d, 0xe1, 0x8d, 0xd3, 0xc3, 0x82, 0x8f, 0x47,
+	0x82, 0xa4, 0xbe, 0xbe, 0xa4, 0x45, 0xc4, 0x94, 0xc1, 0x97, 0x14, 0xb5, 0x33, 0x3f, 0x3c, 0xec,
+	0x49, 0x96, 0xc1, 0x9c, 0x04, 0xf5, 0x60, 0x3b, 0xce, 0x23, 0x9f, 0x24, 0x3c, 0x98, 0x64, 0xbe,
+	0xfc, 0x1c, 0x9f, 0x4f, 0x8d, 0x01, 0x6d, 0xef, 0x52, 0x9c, 0x47, 0x77, 0xd5, 0xd0, 0x31, 0xa6,
+	0xe1, 0xa3, 0x29, 0x49, 0xdd, 0xff, 0x54, 0xa1, 0xbd, 0x7c, 0x42, 0x7f, 0x35, 0x95, 0x79, 0xe1,
+	0xc4, 0x50, 0x29, 0x7f, 0x62, 0x40, 0x14, 0x3a, 0xc5, 0x39, 0xf2, 0xe5, 0xa3, 0xff, 0x86, 0x5c,
+	0xea, 0x5f, 0x4f, 0x77, 0x5f, 0x9b, 0xe1, 0x88, 0xbd, 0xe7, 0x9e, 0x25, 0x70, 0xf5, 0x5d, 0xc0,
+	0x74, 0x17, 0xa8, 0x8b, 0xdc, 0x63, 0xbf, 0x0a, 0xf7, 0x2c, 0x9f, 0x17, 0x6b, 0xe5, 0xae, 0xe1,
+	0x77, 0x40, 0x66, 0x45, 0x4d, 0x51, 0x5f, 0x81, 0x62, 0x9d, 0xc4, 0xa1, 0xec, 0x7f, 0xcf, 0xfe,
+	0xd5, 0xef, 0x76, 0xd7, 0xdc, 0xdf, 0x57, 0xa1, 0xf6, 0x38, 0xc5, 0x21, 0x79, 0x71, 0xfc, 0x75,
+	0xa0, 0x9a, 0x91, 0x8f, 0x8c, 0x78, 0xe4, 0x5f, 0xf4, 0x36, 0xd4, 0xe5, 0x9d, 0x8c, 0xa4, 0x17,
+	0xbe, 0xa4, 0x98, 0x79, 0x92, 0x63, 0x98, 0xcf, 0x4c, 0x05, 0x92, 0x7f, 0x17, 0xf4, 0x55, 0xfb,
+	0x5a, 0x51, 0x1a, 0xf0, 0x4c, 0x94, 0x39, 0xed, 0x28, 0x20, 0x7a, 0x02, 0x9b, 0x01, 0xe3, 0x99,
+	0xba, 0xa1, 0xa8, 0x1a, 0xb0, 0x5e, 0xb6, 0x06, 0xb4, 0x0c, 0x8f, 0xae, 0x53, 0xef, 0x82, 0xbd,
+	0xf2, 0xcb, 0x88, 0x42, 0xc8, 0x72, 0x3d, 0x21, 0x74, 0x3c, 0xd1, 0xcf, 0x21, 0x55, 0xcf, 0xb4,
+	0xdc, 0x2f, 0xab, 0x50, 0xef, 0xe3, 0x38, 0x64, 0xe7, 0x78, 0x6a, 0x59, 0x58, 0x95, 0x72, 0xc2,
+	0xba, 0x0b, 0x36, 0x4f, 0x48, 0x5c, 0xfe, 0x79, 0x50, 0xc1, 0x25, 0xcd, 0x84, 0x8e, 0x27, 0x8e,
+	0x5d, 0x9a, 0x46, 0xc2, 0x51, 0x1f, 0xaa, 0x8c, 0x7f, 0xec, 0xd4, 0xca, 0xb2, 0x48, 0xb4, 0xac,
+	0xf0, 0xd2, 0x3b, 0xc4, 0xa9, 0x97, 0xa5, 0xd1, 0x78, 0x29, 0xda, 0x29, 0x67, 0x79, 0x54, 0xe8,
+	0x64, 0x35, 0xd1, 0x6a, 0xa8, 0xf4, 0xb0, 0x8a, 0x8a, 0x4c, 0xa9, 0xc3, 0x36, 0x31, 0x92, 0xed,
+	0xdf, 0xff, 0xec, 0xd9, 0x8e, 0xf5, 0xf9, 0xb3, 0x1d, 0xeb, 0x1f, 0xcf, 0x76, 0xac, 0x4f, 0x9e,
+	0xef, 0xac, 0x7d, 0xfe, 0x7c, 0x67, 0xed, 0xaf, 0xcf, 0x77, 0xd6, 0x3e, 0x78, 0x7b, 0x4c, 0xc5,
+	0x24, 0x1f, 0x76, 0x03, 0x1e, 0xf5, 0x5e, 0xf0, 0xea, 0x3c, 0x7d, 0xa7, 0x77, 0xa2, 0x9e, 0x9e,
+	0xc5, 0x2c, 0x21, 0xd9, 0xb0, 0xae, 0xbc, 0xfd, 0xce, 0x7f, 0x07, 0x00, 0x19, 0xf1, 0xf1, 0x81,
+	0x79, 0x17, 0x00, 0x00,
+}
+
+func (m *BondingCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondingCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondingCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LiquidityDenomDecimals != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.LiquidityDenomDecimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.RollappDenomDecimals != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.RollappDenomDecimals))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.C.Size()
+		i -= size
+		if _, err := m.C.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
-	i -= n12
-	i = encodeVarintIro(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x1a
 	{
-		size := m.Claimed.Size()
+		size := m.N.Size()
 		i -= size
-		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.N.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintIro(dAtA, i, uint64(size))
@@ -769,9 +1242,9 @@ func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x12
 	{
-		size := m.Amount.Size()
+		size := m.M.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.M.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintIro(dAtA, i, uint64(size))
@@ -781,133 +1254,3427 @@ func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintIro(dAtA []byte, offset int, v uint64) int {
-	offset -= sovIro(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *Plan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *BondingCurve) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.M.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.N.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.C.Size()
-	n += 1 + l + sovIro(uint64(l))
-	if m.RollappDenomDecimals != 0 {
-		n += 1 + sovIro(uint64(m.RollappDenomDecimals))
-	}
-	if m.LiquidityDenomDecimals != 0 {
-		n += 1 + sovIro(uint64(m.LiquidityDenomDecimals))
-	}
-	return n
+
+func (m *Plan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Plan) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Plan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Id != 0 {
-		n += 1 + sovIro(uint64(m.Id))
+	if m.BuyerVestingPlan != nil {
+		{
+			size, err := m.BuyerVestingPlan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
 	}
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
+	if m.SoftCap != nil {
+		{
+			size, err := m.SoftCap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
 	}
-	l = len(m.ModuleAccAddress)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
+	if m.Whitelist != nil {
+		{
+			size, err := m.Whitelist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
 	}
-	l = m.TotalAllocation.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.BondingCurve.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = len(m.SettledDenom)
-	if l > 0 {
-		n += 1 + l + sovIro(uint64(l))
+	if m.FixedPriceSale != nil {
+		{
+			size, err := m.FixedPriceSale.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = m.SoldAmt.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.ClaimedAmt.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.IncentivePlanParams.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.MaxAmountToSell.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.LiquidityPart.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.VestingPlan.Size()
-	n += 1 + l + sovIro(uint64(l))
+	if m.DutchAuction != nil {
+		{
+			size, err := m.DutchAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIro(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.LiquidityDenom) > 0 {
+		i -= len(m.LiquidityDenom)
+		copy(dAtA[i:], m.LiquidityDenom)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.LiquidityDenom)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintIro(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
 	if m.TradingEnabled {
-		n += 2
+		i--
+		if m.TradingEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration)
-	n += 2 + l + sovIro(uint64(l))
-	l = len(m.LiquidityDenom)
-	if l > 0 {
-		n += 2 + l + sovIro(uint64(l))
+	{
+		size, err := m.VestingPlan.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.LiquidityPart.Size()
+		i -= size
+		if _, err := m.LiquidityPart.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.MaxAmountToSell.Size()
+		i -= size
+		if _, err := m.MaxAmountToSell.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.IncentivePlanParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.ClaimedAmt.Size()
+		i -= size
+		if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.SoldAmt.Size()
+		i -= size
+		if _, err := m.SoldAmt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PreLaunchTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintIro(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x42
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintIro(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x3a
+	if len(m.SettledDenom) > 0 {
+		i -= len(m.SettledDenom)
+		copy(dAtA[i:], m.SettledDenom)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.SettledDenom)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.BondingCurve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.TotalAllocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ModuleAccAddress) > 0 {
+		i -= len(m.ModuleAccAddress)
+		copy(dAtA[i:], m.ModuleAccAddress)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.ModuleAccAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *IncentivePlanParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BuyerVestingPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyerVestingPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyerVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
-	n += 1 + l + sovIro(uint64(l))
-	if m.NumEpochsPaidOver != 0 {
-		n += 1 + sovIro(uint64(m.NumEpochsPaidOver))
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintIro(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
+	n14, err14 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintIro(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x12
+	n15, err15 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Cliff, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Cliff):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintIro(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BuyerVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BuyerVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BuyerVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SoftCap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SoftCap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SoftCap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Raised.Size()
+		i -= size
+		if _, err := m.Raised.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RefundLiquidity.Size()
+		i -= size
+		if _, err := m.RefundLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.RefundSupply.Size()
+		i -= size
+		if _, err := m.RefundSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Refunding {
+		i--
+		if m.Refunding {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Deadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintIro(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Whitelist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Whitelist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Whitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PublicCap.Size()
+		i -= size
+		if _, err := m.PublicCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n17, err17 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintIro(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Cap.Size()
+		i -= size
+		if _, err := m.Cap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Addresses) > 0 {
+		for iNdEx := len(m.Addresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addresses[iNdEx])
+			copy(dAtA[i:], m.Addresses[iNdEx])
+			i = encodeVarintIro(dAtA, i, uint64(len(m.Addresses[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Purchases) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Purchases) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Purchases) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Proven {
+		i--
+		if m.Proven {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DutchAuction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n18, err18 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration):])
+	if err18 != nil {
+		return 0, err18
+	}
+	i -= n18
+	i = encodeVarintIro(dAtA, i, uint64(n18))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.EndPrice.Size()
+		i -= size
+		if _, err := m.EndPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.StartPrice.Size()
+		i -= size
+		if _, err := m.StartPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FixedPriceSale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FixedPriceSale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FixedPriceSale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RefundReserve.Size()
+		i -= size
+		if _, err := m.RefundReserve.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.Allocated.Size()
+		i -= size
+		if _, err := m.Allocated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Subscribed.Size()
+		i -= size
+		if _, err := m.Subscribed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Subscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Subscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Subscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Paid.Size()
+		i -= size
+		if _, err := m.Paid.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IncentivePlanParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IncentivePlanParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IncentivePlanParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumEpochsPaidOver != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.NumEpochsPaidOver))
+		i--
+		dAtA[i] = 0x10
+	}
+	n19, err19 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
+	if err19 != nil {
+		return 0, err19
+	}
+	i -= n19
+	i = encodeVarintIro(dAtA, i, uint64(n19))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *IROVestingPlan) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IROVestingPlan) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IROVestingPlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n20, err20 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err20 != nil {
+		return 0, err20
+	}
+	i -= n20
+	i = encodeVarintIro(dAtA, i, uint64(n20))
+	i--
+	dAtA[i] = 0x32
+	n21, err21 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintIro(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x2a
+	n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.StartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintIro(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x22
+	n23, err23 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
+	if err23 != nil {
+		return 0, err23
+	}
+	i -= n23
+	i = encodeVarintIro(dAtA, i, uint64(n23))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Trade) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Trade) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Trade) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x48
+	}
+	n24, err24 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err24 != nil {
+		return 0, err24
+	}
+	i -= n24
+	i = encodeVarintIro(dAtA, i, uint64(n24))
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.ClosingPrice.Size()
+		i -= size
+		if _, err := m.ClosingPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Cost.Size()
+		i -= size
+		if _, err := m.Cost.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Buy {
+		i--
+		if m.Buy {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Trader) > 0 {
+		i -= len(m.Trader)
+		copy(dAtA[i:], m.Trader)
+		i = encodeVarintIro(dAtA, i, uint64(len(m.Trader)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Seq != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Seq))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PlanId != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Candle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Candle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Candle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Trades != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.Trades))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.Volume.Size()
+		i -= size
+		if _, err := m.Volume.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Close.Size()
+		i -= size
+		if _, err := m.Close.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Low.Size()
+		i -= size
+		if _, err := m.Low.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.High.Size()
+		i -= size
+		if _, err := m.High.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Open.Size()
+		i -= size
+		if _, err := m.Open.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintIro(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	n25, err25 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err25 != nil {
+		return 0, err25
+	}
+	i -= n25
+	i = encodeVarintIro(dAtA, i, uint64(n25))
+	i--
+	dAtA[i] = 0x12
+	if m.PlanId != 0 {
+		i = encodeVarintIro(dAtA, i, uint64(m.PlanId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIro(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIro(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BondingCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.M.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.N.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.C.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.RollappDenomDecimals != 0 {
+		n += 1 + sovIro(uint64(m.RollappDenomDecimals))
+	}
+	if m.LiquidityDenomDecimals != 0 {
+		n += 1 + sovIro(uint64(m.LiquidityDenomDecimals))
+	}
+	return n
+}
+
+func (m *Plan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovIro(uint64(m.Id))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = len(m.ModuleAccAddress)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.TotalAllocation.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.BondingCurve.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = len(m.SettledDenom)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PreLaunchTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = m.SoldAmt.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.ClaimedAmt.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.IncentivePlanParams.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.MaxAmountToSell.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.LiquidityPart.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.VestingPlan.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.TradingEnabled {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration)
+	n += 2 + l + sovIro(uint64(l))
+	l = len(m.LiquidityDenom)
+	if l > 0 {
+		n += 2 + l + sovIro(uint64(l))
+	}
+	if m.DutchAuction != nil {
+		l = m.DutchAuction.Size()
+		n += 2 + l + sovIro(uint64(l))
+	}
+	if m.FixedPriceSale != nil {
+		l = m.FixedPriceSale.Size()
+		n += 2 + l + sovIro(uint64(l))
+	}
+	if m.Whitelist != nil {
+		l = m.Whitelist.Size()
+		n += 2 + l + sovIro(uint64(l))
+	}
+	if m.SoftCap != nil {
+		l = m.SoftCap.Size()
+		n += 2 + l + sovIro(uint64(l))
+	}
+	if m.BuyerVestingPlan != nil {
+		l = m.BuyerVestingPlan.Size()
+		n += 2 + l + sovIro(uint64(l))
+	}
+	return n
+}
+
+func (m *BuyerVestingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Cliff)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *BuyerVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovIro(uint64(m.PlanId))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *SoftCap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Deadline)
+	n += 1 + l + sovIro(uint64(l))
+	if m.Refunding {
+		n += 2
+	}
+	l = m.RefundSupply.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.RefundLiquidity.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Raised.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Whitelist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Addresses) > 0 {
+		for _, s := range m.Addresses {
+			l = len(s)
+			n += 1 + l + sovIro(uint64(l))
+		}
+	}
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Cap.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovIro(uint64(l))
+	l = m.PublicCap.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Purchases) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovIro(uint64(m.PlanId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.Proven {
+		n += 2
+	}
+	return n
+}
+
+func (m *DutchAuction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.StartPrice.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.EndPrice.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *FixedPriceSale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Subscribed.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Allocated.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.RefundReserve.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Subscription) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovIro(uint64(m.PlanId))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Paid.Size()
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *IncentivePlanParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
+	n += 1 + l + sovIro(uint64(l))
+	if m.NumEpochsPaidOver != 0 {
+		n += 1 + sovIro(uint64(m.NumEpochsPaidOver))
+	}
+	return n
+}
+
+func (m *IROVestingPlan) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovIro(uint64(l))
+	return n
+}
+
+func (m *Trade) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovIro(uint64(m.PlanId))
+	}
+	if m.Seq != 0 {
+		n += 1 + sovIro(uint64(m.Seq))
+	}
+	l = len(m.Trader)
+	if l > 0 {
+		n += 1 + l + sovIro(uint64(l))
+	}
+	if m.Buy {
+		n += 2
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Cost.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.ClosingPrice.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovIro(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovIro(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *Candle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PlanId != 0 {
+		n += 1 + sovIro(uint64(m.PlanId))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Open.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.High.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Low.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Close.Size()
+	n += 1 + l + sovIro(uint64(l))
+	l = m.Volume.Size()
+	n += 1 + l + sovIro(uint64(l))
+	if m.Trades != 0 {
+		n += 1 + sovIro(uint64(m.Trades))
+	}
+	return n
+}
+
+func sovIro(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIro(x uint64) (n int) {
+	return sovIro(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BondingCurve) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondingCurve: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondingCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.N.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappDenomDecimals", wireType)
+			}
+			m.RollappDenomDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RollappDenomDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenomDecimals", wireType)
+			}
+			m.LiquidityDenomDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LiquidityDenomDecimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Plan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAccAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondingCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BondingCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SettledDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SettledDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreLaunchTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreLaunchTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoldAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SoldAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePlanParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentivePlanParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountToSell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountToSell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityPart", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityPart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestingPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradingEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.TradingEnabled = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IroPlanDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IroPlanDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuction", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DutchAuction == nil {
+				m.DutchAuction = &DutchAuction{}
+			}
+			if err := m.DutchAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedPriceSale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FixedPriceSale == nil {
+				m.FixedPriceSale = &FixedPriceSale{}
+			}
+			if err := m.FixedPriceSale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Whitelist == nil {
+				m.Whitelist = &Whitelist{}
+			}
+			if err := m.Whitelist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SoftCap == nil {
+				m.SoftCap = &SoftCap{}
+			}
+			if err := m.SoftCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVestingPlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuyerVestingPlan == nil {
+				m.BuyerVestingPlan = &BuyerVestingPlan{}
+			}
+			if err := m.BuyerVestingPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyerVestingPlan) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyerVestingPlan: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyerVestingPlan: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cliff", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Cliff, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BuyerVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BuyerVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BuyerVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SoftCap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SoftCap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SoftCap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Deadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunding", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Refunding = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Raised", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Raised.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Whitelist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Whitelist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Whitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addresses = append(m.Addresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = append(m.MerkleRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.MerkleRoot == nil {
+				m.MerkleRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublicCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Purchases) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Purchases: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Purchases: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proven", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Proven = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuction) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuction: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuction: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *IROVestingPlan) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = m.Claimed.Size()
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.StartTimeAfterSettlement)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovIro(uint64(l))
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
-	n += 1 + l + sovIro(uint64(l))
-	return n
-}
-
-func sovIro(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozIro(x uint64) (n int) {
-	return sovIro(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *BondingCurve) Unmarshal(dAtA []byte) error {
+func (m *FixedPriceSale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -930,15 +4697,15 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BondingCurve: wiretype end group for non-group")
+			return fmt.Errorf("proto: FixedPriceSale: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BondingCurve: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FixedPriceSale: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field M", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -966,13 +4733,250 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.M.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscribed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Subscribed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Allocated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RefundReserve", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RefundReserve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Subscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Subscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Subscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PlanId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1000,13 +5004,13 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.N.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field C", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paid", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1034,15 +5038,65 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.C.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Paid.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappDenomDecimals", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.RollappDenomDecimals = 0
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IncentivePlanParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IncentivePlanParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeAfterSettlement", wireType)
+			}
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1052,16 +5106,30 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RollappDenomDecimals |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthIro
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenomDecimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
 			}
-			m.LiquidityDenomDecimals = 0
+			m.NumEpochsPaidOver = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1071,7 +5139,7 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LiquidityDenomDecimals |= uint64(b&0x7F) << shift
+				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1097,7 +5165,7 @@ func (m *BondingCurve) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Plan) Unmarshal(dAtA []byte) error {
+func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1120,34 +5188,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Plan: wiretype end group for non-group")
+			return fmt.Errorf("proto: IROVestingPlan: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Plan: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: IROVestingPlan: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1175,11 +5224,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAccAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1207,11 +5258,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAccAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalAllocation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1238,13 +5291,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TotalAllocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BondingCurve", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeAfterSettlement", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1271,43 +5324,11 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BondingCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SettledDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SettledDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
@@ -1340,9 +5361,9 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreLaunchTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1369,15 +5390,65 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PreLaunchTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SoldAmt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIro(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIro
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Trade) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIro
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trade: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trade: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1387,29 +5458,33 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seq", wireType)
 			}
-			if err := m.SoldAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Seq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIro
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Seq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 10:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trader", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1437,15 +5512,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Trader = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentivePlanParams", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buy", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1455,28 +5528,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.IncentivePlanParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 12:
+			m.Buy = bool(v != 0)
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountToSell", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1504,13 +5564,13 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxAmountToSell.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 13:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityPart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1538,15 +5598,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityPart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 14:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingPlan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosingPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1556,48 +5616,29 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VestingPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClosingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 15:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TradingEnabled", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowIro
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.TradingEnabled = bool(v != 0)
-		case 16:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IroPlanDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1624,15 +5665,15 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.IroPlanDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 17:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDenom", wireType)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1642,24 +5683,11 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.LiquidityDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIro(dAtA[iNdEx:])
@@ -1681,7 +5709,7 @@ func (m *Plan) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
+func (m *Candle) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1704,17 +5732,17 @@ func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IncentivePlanParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: Candle: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IncentivePlanParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Candle: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeAfterSettlement", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			m.PlanId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1724,30 +5752,16 @@ func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PlanId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumEpochsPaidOver", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			m.NumEpochsPaidOver = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1757,64 +5771,28 @@ func (m *IncentivePlanParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NumEpochsPaidOver |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipIro(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthIro
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowIro
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIro
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: IROVestingPlan: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IROVestingPlan: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1842,13 +5820,13 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Open.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field High", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1876,15 +5854,15 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.High.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestingDuration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Low", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1894,30 +5872,31 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.VestingDuration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Low.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeAfterSettlement", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Close", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1927,30 +5906,31 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.StartTimeAfterSettlement, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Close.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Volume", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1960,30 +5940,31 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthIro
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthIro
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Volume.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
 			}
-			var msglen int
+			m.Trades = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowIro
@@ -1993,25 +5974,11 @@ func (m *IROVestingPlan) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Trades |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthIro
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthIro
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIro(dAtA[iNdEx:])
@@ -1,6 +1,9 @@
 package types
 
-import fmt "fmt"
+import (
+	fmt "fmt"
+	"time"
+)
 
 const (
 	// ModuleName defines the module name
@@ -28,6 +31,21 @@ var (
 
 	// ParamsKey is the key to retrieve the module parameters
 	ParamsKey = []byte{0x4} // params
+
+	// SubscriptionKeyPrefix is the prefix to retrieve the subscriptions to fixed price sales
+	SubscriptionKeyPrefix = []byte{0x5} // prefix/planId/buyer
+
+	// PurchasesKeyPrefix is the prefix to retrieve the purchases from plans with a whitelist
+	PurchasesKeyPrefix = []byte{0x6} // prefix/planId/buyer
+
+	// BuyerVestingKeyPrefix is the prefix to retrieve the tokens vesting under the buyer vesting plans
+	BuyerVestingKeyPrefix = []byte{0x7} // prefix/planId/account
+
+	// TradeKeyPrefix is the prefix to retrieve the trade history of the plans
+	TradeKeyPrefix = []byte{0x8} // prefix/planId/seq
+
+	// CandleKeyPrefix is the prefix to retrieve the candles of the plans
+	CandleKeyPrefix = []byte{0x9} // prefix/planId/startTime
 )
 
 /* --------------------- specific plan ID keys -------------------- */
@@ -40,3 +58,49 @@ func PlansByRollappKey(rollappId string) []byte {
 	rollappIdBytes := []byte(rollappId)
 	return []byte(fmt.Sprintf("%s%s%s", PlansByRollappKeyPrefix, KeySeparator, rollappIdBytes))
 }
+
+/* ------------------------- subscription keys ------------------------ */
+func SubscriptionKey(planId, buyer string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", SubscriptionKeyPrefix, KeySeparator, planId, KeySeparator, buyer))
+}
+
+// PlanSubscriptionsKey is the prefix of the subscriptions to the plan
+func PlanSubscriptionsKey(planId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", SubscriptionKeyPrefix, KeySeparator, planId, KeySeparator))
+}
+
+/* ------------------------- purchases keys ------------------------ */
+func PurchasesKey(planId, buyer string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", PurchasesKeyPrefix, KeySeparator, planId, KeySeparator, buyer))
+}
+
+/* ------------------------- buyer vesting keys ------------------------ */
+func BuyerVestingKey(planId, account string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%s", BuyerVestingKeyPrefix, KeySeparator, planId, KeySeparator, account))
+}
+
+// PlanBuyerVestingsKey is the prefix of the tokens vesting under the buyer vesting plan of the plan
+func PlanBuyerVestingsKey(planId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", BuyerVestingKeyPrefix, KeySeparator, planId, KeySeparator))
+}
+
+/* ------------------------- trade history keys ------------------------ */
+// TradeKey is the key of the trade of the plan with the sequence number, ordered by it
+func TradeKey(planId string, seq uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%020d", TradeKeyPrefix, KeySeparator, planId, KeySeparator, seq))
+}
+
+// PlanTradesKey is the prefix of the trades of the plan
+func PlanTradesKey(planId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", TradeKeyPrefix, KeySeparator, planId, KeySeparator))
+}
+
+// CandleKey is the key of the candle of the plan starting at the time, ordered by it
+func CandleKey(planId string, startTime time.Time) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s%020d", CandleKeyPrefix, KeySeparator, planId, KeySeparator, startTime.UnixNano()))
+}
+
+// PlanCandlesKey is the prefix of the candles of the plan
+func PlanCandlesKey(planId string) []byte {
+	return []byte(fmt.Sprintf("%s%s%s%s", CandleKeyPrefix, KeySeparator, planId, KeySeparator))
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"crypto/sha256"
 	"errors"
 	"fmt"
 
@@ -16,6 +17,7 @@ var (
 	_ sdk.Msg = &MsgSell{}
 	_ sdk.Msg = &MsgClaim{}
 	_ sdk.Msg = &MsgClaimVested{}
+	_ sdk.Msg = &MsgRefund{}
 	_ sdk.Msg = &MsgEnableTrading{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
@@ -67,6 +69,45 @@ func (m *MsgCreatePlan) ValidateBasic() error {
 	if sdk.ValidateDenom(m.LiquidityDenom) != nil {
 		return fmt.Errorf("invalid liquidity denom: %s", m.LiquidityDenom)
 	}
+
+	var fixed *FixedPriceSale
+	if m.FixedPriceSale != nil {
+		for _, x := range []math.Int{m.FixedPriceSale.Subscribed, m.FixedPriceSale.Paid, m.FixedPriceSale.Allocated, m.FixedPriceSale.RefundReserve} {
+			if !x.IsNil() && !x.IsZero() {
+				return errors.New("only the price of the fixed price sale can be set")
+			}
+		}
+		sale := NewFixedPriceSale(m.FixedPriceSale.Price)
+		fixed = &sale
+	}
+	if err := validateSaleMechanism(m.DutchAuction, fixed); err != nil {
+		return fmt.Errorf("sale mechanism: %w", err)
+	}
+
+	if m.Whitelist != nil {
+		if err := m.Whitelist.ValidateBasic(); err != nil {
+			return fmt.Errorf("whitelist: %w", err)
+		}
+	}
+
+	if m.SoftCap != nil {
+		// refund amounts must be zero unless refunding
+		if err := m.SoftCap.ValidateBasic(); err != nil {
+			return fmt.Errorf("soft cap: %w", err)
+		}
+		if m.SoftCap.Refunding || !m.SoftCap.Raised.IsZero() {
+			return errors.New("only the amount and the deadline of the soft cap can be set")
+		}
+	}
+
+	if m.BuyerVestingPlan != nil {
+		if err := m.BuyerVestingPlan.ValidateBasic(); err != nil {
+			return fmt.Errorf("buyer vesting plan: %w", err)
+		}
+		if m.BuyerVestingPlan.StartTime.Unix() > 0 {
+			return errors.New("only the cliff and the duration of the buyer vesting plan can be set")
+		}
+	}
 	return nil
 }
 
@@ -86,7 +127,7 @@ func (m *MsgBuy) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("expected out amount %v must be positive", m.MaxCostAmount)
 	}
 
-	return nil
+	return validateWhitelistProof(m.WhitelistProof)
 }
 
 func (m *MsgSell) ValidateBasic() error {
@@ -129,6 +170,17 @@ func (m *MsgClaimVested) ValidateBasic() error {
 	return nil
 }
 
+// ValidateBasic implements types.Msg.
+func (m *MsgRefund) ValidateBasic() error {
+	// refunder bech32
+	_, err := sdk.AccAddressFromBech32(m.Refunder)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid refunder address: %s", err)
+	}
+
+	return nil
+}
+
 func (m *MsgUpdateParams) ValidateBasic() error {
 	_, err := sdk.AccAddressFromBech32(m.Authority)
 	if err != nil {
@@ -163,7 +215,7 @@ func (m *MsgBuyExactSpend) ValidateBasic() error {
 		return sdkerrors.ErrInvalidRequest.Wrapf("expected out amount %v must be positive", m.MinOutTokensAmount)
 	}
 
-	return nil
+	return validateWhitelistProof(m.WhitelistProof)
 }
 
 func (m *MsgEnableTrading) ValidateBasic() error {
@@ -173,5 +225,20 @@ func (m *MsgEnableTrading) ValidateBasic() error {
 		return sdkerrors.ErrInvalidAddress.Wrapf("invalid owner address: %s", err)
 	}
 
+	if m.Whitelist != nil {
+		if err := m.Whitelist.ValidateBasic(); err != nil {
+			return sdkerrors.ErrInvalidRequest.Wrapf("whitelist: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func validateWhitelistProof(proof [][]byte) error {
+	for _, node := range proof {
+		if len(node) != sha256.Size {
+			return sdkerrors.ErrInvalidRequest.Wrapf("whitelist proof nodes must be %d bytes", sha256.Size)
+		}
+	}
 	return nil
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	fmt "fmt"
+	stdmath "math"
 	"time"
 
 	"cosmossdk.io/math"
@@ -18,6 +19,9 @@ var (
 	DefaultMinLiquidityPart                             = "0.4"                       // default: at least 40% goes to the liquidity pool
 	DefaultMinVestingDuration                           = 7 * 24 * time.Hour          // default: min 7 days
 	DefaultMinVestingStartTimeAfterSettlement           = 0 * time.Minute             // default: no enforced minimum by default
+	DefaultTradeHistorySize                             = uint64(1000)                // default: last 1000 trades
+	DefaultCandleInterval                               = time.Hour                   // default: hourly candles
+	DefaultCandleHistorySize                            = uint64(30 * 24)             // default: candles of the last 30 days
 )
 
 // NewParams creates a new Params object
@@ -45,6 +49,9 @@ func DefaultParams() Params {
 		MinLiquidityPart:                      math.LegacyMustNewDecFromStr(DefaultMinLiquidityPart),
 		MinVestingDuration:                    DefaultMinVestingDuration,
 		MinVestingStartTimeAfterSettlement:    DefaultMinVestingStartTimeAfterSettlement,
+		TradeHistorySize:                      DefaultTradeHistorySize,
+		CandleInterval:                        DefaultCandleInterval,
+		CandleHistorySize:                     DefaultCandleHistorySize,
 	}
 }
 
@@ -78,6 +85,14 @@ func (p Params) ValidateBasic() error {
 		return fmt.Errorf("minimum vesting duration must be non-negative: %v", p.MinVestingDuration)
 	}
 
+	if p.CandleInterval < 0 {
+		return fmt.Errorf("candle interval must be non-negative: %v", p.CandleInterval)
+	}
+
+	if 0 < p.CandleInterval && uint64(stdmath.MaxInt64/p.CandleInterval) < p.CandleHistorySize {
+		return fmt.Errorf("candle history is too long: %d intervals of %v", p.CandleHistorySize, p.CandleInterval)
+	}
+
 	return nil
 }
 
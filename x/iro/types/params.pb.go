@@ -53,6 +53,15 @@ type Params struct {
 	MinVestingDuration time.Duration               `protobuf:"bytes,7,opt,name=min_vesting_duration,json=minVestingDuration,proto3,stdduration" json:"min_vesting_duration"`
 	// Minimum start time after settlement to start vesting
 	MinVestingStartTimeAfterSettlement time.Duration `protobuf:"bytes,8,opt,name=min_vesting_start_time_after_settlement,json=minVestingStartTimeAfterSettlement,proto3,stdduration" json:"min_vesting_start_time_after_settlement"`
+	// The number of the last trades kept for each plan until it is settled. Zero
+	// disables the trade history.
+	TradeHistorySize uint64 `protobuf:"varint,9,opt,name=trade_history_size,json=tradeHistorySize,proto3" json:"trade_history_size,omitempty"`
+	// The interval of the candles of the price of each plan. Zero disables the
+	// candles.
+	CandleInterval time.Duration `protobuf:"bytes,10,opt,name=candle_interval,json=candleInterval,proto3,stdduration" json:"candle_interval"`
+	// The number of the last intervals the candles are kept for, for each plan
+	// until it is settled
+	CandleHistorySize uint64 `protobuf:"varint,11,opt,name=candle_history_size,json=candleHistorySize,proto3" json:"candle_history_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -123,6 +132,27 @@ func (m *Params) GetMinVestingStartTimeAfterSettlement() time.Duration {
 	return 0
 }
 
+func (m *Params) GetTradeHistorySize() uint64 {
+	if m != nil {
+		return m.TradeHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetCandleInterval() time.Duration {
+	if m != nil {
+		return m.CandleInterval
+	}
+	return 0
+}
+
+func (m *Params) GetCandleHistorySize() uint64 {
+	if m != nil {
+		return m.CandleHistorySize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.iro.Params")
 }
@@ -132,41 +162,45 @@ func init() {
 }
 
 var fileDescriptor_321dd4e17bb4cbec = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xc1, 0x6e, 0xd3, 0x4e,
-	0x10, 0xc6, 0xe3, 0xff, 0xbf, 0x2d, 0xad, 0x8b, 0x04, 0x58, 0x45, 0x4a, 0x83, 0xe4, 0x44, 0x45,
-	0xa8, 0x11, 0x08, 0x2f, 0xa1, 0x4f, 0x40, 0x14, 0x90, 0x0a, 0x25, 0x8d, 0x52, 0xe0, 0xc0, 0x65,
-	0xb5, 0xb1, 0x27, 0xce, 0xaa, 0xd9, 0x5d, 0xb3, 0x3b, 0xb6, 0x12, 0x0e, 0x3c, 0x03, 0x47, 0x1e,
-	0x84, 0x87, 0xe8, 0xb1, 0xe2, 0x84, 0x38, 0x14, 0x94, 0xbc, 0x04, 0x47, 0x64, 0x3b, 0x4e, 0x42,
-	0x51, 0x51, 0xc4, 0x6d, 0x47, 0xf3, 0xcd, 0xef, 0x1b, 0x7d, 0xa3, 0xb5, 0xf7, 0x83, 0xb1, 0x00,
-	0x69, 0xb8, 0x92, 0xa3, 0xf1, 0x7b, 0x32, 0x2f, 0x08, 0xd7, 0x8a, 0x44, 0x4c, 0x33, 0x61, 0xbc,
-	0x48, 0x2b, 0x54, 0x4e, 0x65, 0x59, 0xe8, 0xcd, 0x0b, 0x8f, 0x6b, 0x55, 0xd9, 0x09, 0x55, 0xa8,
-	0x32, 0x19, 0x49, 0x5f, 0xf9, 0x44, 0xa5, 0x1a, 0x2a, 0x15, 0x0e, 0x81, 0x64, 0x55, 0x2f, 0xee,
-	0x13, 0xe4, 0x02, 0x0c, 0x32, 0x11, 0xcd, 0x04, 0xee, 0x65, 0x41, 0x10, 0x6b, 0x86, 0x29, 0x74,
-	0xd6, 0xf7, 0x95, 0x11, 0xca, 0x90, 0x1e, 0x33, 0x40, 0x92, 0x46, 0x0f, 0x90, 0x35, 0x88, 0xaf,
-	0x78, 0xd1, 0xdf, 0xcd, 0xfb, 0x34, 0x77, 0xce, 0x8b, 0xbc, 0xb5, 0xf7, 0x73, 0xdd, 0xde, 0xe8,
-	0x64, 0xeb, 0x3b, 0x6d, 0x7b, 0x0b, 0xd9, 0x29, 0x68, 0xda, 0x07, 0x28, 0x5b, 0x35, 0xab, 0xbe,
-	0xd5, 0x6c, 0x9c, 0x5d, 0x54, 0x4b, 0xdf, 0x2e, 0xaa, 0x77, 0xf2, 0x19, 0x13, 0x9c, 0x7a, 0x5c,
-	0x11, 0xc1, 0x70, 0xe0, 0x1d, 0x41, 0xc8, 0xfc, 0x71, 0x0b, 0xfc, 0x2f, 0x9f, 0x1f, 0xda, 0x33,
-	0x64, 0x0b, 0xfc, 0xee, 0x66, 0xc6, 0x78, 0x06, 0xe0, 0xb4, 0xed, 0xeb, 0xbe, 0x86, 0x6c, 0xcf,
-	0x0c, 0xf9, 0x5f, 0x86, 0x7c, 0x30, 0x43, 0xde, 0xfe, 0x13, 0x79, 0x28, 0x71, 0x09, 0x76, 0x28,
-	0xb1, 0xbb, 0x5d, 0x00, 0x52, 0xde, 0xb1, 0x7d, 0x4b, 0x70, 0x49, 0xa3, 0x21, 0x93, 0xb4, 0x08,
-	0xa0, 0xfc, 0x7f, 0xcd, 0xaa, 0x6f, 0x3f, 0xde, 0xf5, 0xf2, 0x84, 0xbc, 0x22, 0x21, 0xaf, 0x35,
-	0x13, 0x34, 0x37, 0x53, 0xbf, 0x4f, 0xdf, 0xab, 0x56, 0xf7, 0x86, 0xe0, 0xb2, 0x33, 0x64, 0xb2,
-	0x68, 0x39, 0x1f, 0xec, 0xfb, 0x5c, 0xfa, 0x20, 0x91, 0x27, 0x60, 0x68, 0xca, 0x36, 0xc8, 0x34,
-	0xd2, 0x34, 0x7e, 0xca, 0xfa, 0x08, 0x9a, 0x1a, 0x40, 0x1c, 0x82, 0x00, 0x89, 0xe5, 0xb5, 0xd5,
-	0x9d, 0xee, 0x2d, 0xb0, 0x2f, 0xb9, 0x3c, 0x49, 0xa1, 0xaf, 0xb8, 0x80, 0x27, 0x29, 0xf2, 0x64,
-	0x4e, 0x74, 0x5e, 0xd8, 0x77, 0x2f, 0xf9, 0xcb, 0x58, 0x50, 0x88, 0x94, 0x3f, 0x30, 0x34, 0x62,
-	0x3c, 0xa0, 0x2a, 0x01, 0x5d, 0x5e, 0xaf, 0x59, 0xf5, 0xb5, 0xae, 0xfb, 0x1b, 0xb3, 0x1d, 0x8b,
-	0xa7, 0x99, 0xae, 0xc3, 0x78, 0x70, 0x9c, 0x80, 0x76, 0xa8, 0xed, 0xa4, 0x84, 0x21, 0x7f, 0x17,
-	0xf3, 0x80, 0xe3, 0x98, 0x46, 0x4c, 0x63, 0x79, 0xe3, 0x5f, 0xcf, 0x78, 0x53, 0x70, 0x79, 0x54,
-	0xb0, 0x3a, 0x4c, 0xa3, 0xf3, 0xda, 0xde, 0x49, 0x0d, 0x12, 0x30, 0xc8, 0x65, 0xb8, 0xb8, 0xc0,
-	0xb5, 0xd5, 0x73, 0x49, 0x37, 0x7c, 0x93, 0xcf, 0xcf, 0x8f, 0x30, 0xb2, 0xf7, 0x97, 0xb1, 0x7f,
-	0xbb, 0xc0, 0xe6, 0xea, 0x4e, 0x7b, 0x0b, 0xa7, 0xab, 0xe2, 0x6f, 0x3e, 0x3f, 0x9b, 0xb8, 0xd6,
-	0xf9, 0xc4, 0xb5, 0x7e, 0x4c, 0x5c, 0xeb, 0xe3, 0xd4, 0x2d, 0x9d, 0x4f, 0xdd, 0xd2, 0xd7, 0xa9,
-	0x5b, 0x7a, 0xfb, 0x28, 0xe4, 0x38, 0x88, 0x7b, 0x9e, 0xaf, 0x04, 0xb9, 0xe2, 0xdb, 0x27, 0x07,
-	0x64, 0x94, 0xfd, 0x7d, 0x1c, 0x47, 0x60, 0x7a, 0x1b, 0xd9, 0x32, 0x07, 0xbf, 0x02, 0x00, 0x00,
-	0xff, 0xff, 0x8d, 0x37, 0x2d, 0x84, 0x26, 0x04, 0x00, 0x00,
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xdd, 0x4e, 0xd4, 0x40,
+	0x14, 0xc7, 0xb7, 0x8a, 0x7c, 0x0c, 0x46, 0xa0, 0x62, 0x52, 0x30, 0xe9, 0x12, 0x8c, 0x81, 0xf8,
+	0xd1, 0x8a, 0x3c, 0x81, 0x04, 0x8d, 0x28, 0xc2, 0x66, 0x51, 0x2f, 0xbc, 0x99, 0xcc, 0xb6, 0x87,
+	0xee, 0x84, 0xce, 0x4c, 0x9d, 0x39, 0xdb, 0xb0, 0x5c, 0xf8, 0x0c, 0x5e, 0xfa, 0x00, 0x3e, 0x82,
+	0x0f, 0xc1, 0x25, 0xf1, 0xca, 0x78, 0x81, 0x06, 0x5e, 0xc4, 0x74, 0xda, 0xfd, 0x00, 0x83, 0xd9,
+	0x78, 0x37, 0x27, 0xff, 0x73, 0x7e, 0xff, 0x93, 0xff, 0x49, 0x86, 0xac, 0xc4, 0x5d, 0x01, 0xd2,
+	0x70, 0x25, 0x0f, 0xbb, 0x47, 0x61, 0xbf, 0x08, 0xb9, 0x56, 0x61, 0xc6, 0x34, 0x13, 0x26, 0xc8,
+	0xb4, 0x42, 0xe5, 0x2e, 0x0e, 0x37, 0x06, 0xfd, 0x22, 0xe0, 0x5a, 0x2d, 0xce, 0x27, 0x2a, 0x51,
+	0xb6, 0x2d, 0x2c, 0x5e, 0xe5, 0xc4, 0x62, 0x3d, 0x51, 0x2a, 0x49, 0x21, 0xb4, 0x55, 0xab, 0xb3,
+	0x1f, 0x22, 0x17, 0x60, 0x90, 0x89, 0xac, 0x6a, 0xf0, 0x2f, 0x37, 0xc4, 0x1d, 0xcd, 0xb0, 0x80,
+	0x56, 0x7a, 0xa4, 0x8c, 0x50, 0x26, 0x6c, 0x31, 0x03, 0x61, 0xbe, 0xd6, 0x02, 0x64, 0x6b, 0x61,
+	0xa4, 0x78, 0x4f, 0x5f, 0x28, 0x75, 0x5a, 0x3a, 0x97, 0x45, 0x29, 0x2d, 0x7f, 0x9d, 0x20, 0xe3,
+	0x0d, 0xbb, 0xbe, 0xbb, 0x43, 0xa6, 0x90, 0x1d, 0x80, 0xa6, 0xfb, 0x00, 0x9e, 0xb3, 0xe4, 0xac,
+	0x4e, 0x6d, 0xac, 0x1d, 0x9f, 0xd6, 0x6b, 0x3f, 0x4f, 0xeb, 0x77, 0xcb, 0x19, 0x13, 0x1f, 0x04,
+	0x5c, 0x85, 0x82, 0x61, 0x3b, 0xd8, 0x86, 0x84, 0x45, 0xdd, 0x4d, 0x88, 0xbe, 0x7f, 0x7b, 0x4c,
+	0x2a, 0xe4, 0x26, 0x44, 0xcd, 0x49, 0xcb, 0x78, 0x01, 0xe0, 0xee, 0x90, 0x9b, 0x91, 0x06, 0xbb,
+	0xa7, 0x45, 0x5e, 0xb3, 0xc8, 0x87, 0x15, 0xf2, 0xce, 0xdf, 0xc8, 0x2d, 0x89, 0x43, 0xb0, 0x2d,
+	0x89, 0xcd, 0xe9, 0x1e, 0xa0, 0xe0, 0xed, 0x92, 0x39, 0xc1, 0x25, 0xcd, 0x52, 0x26, 0x69, 0x2f,
+	0x00, 0xef, 0xfa, 0x92, 0xb3, 0x3a, 0xfd, 0x74, 0x21, 0x28, 0x13, 0x0a, 0x7a, 0x09, 0x05, 0x9b,
+	0x55, 0xc3, 0xc6, 0x64, 0xe1, 0xf7, 0xe5, 0x57, 0xdd, 0x69, 0xce, 0x08, 0x2e, 0x1b, 0x29, 0x93,
+	0x3d, 0xc9, 0xfd, 0x44, 0x1e, 0x70, 0x19, 0x81, 0x44, 0x9e, 0x83, 0xa1, 0x05, 0xdb, 0x20, 0xd3,
+	0x48, 0x8b, 0xf8, 0x29, 0xdb, 0x47, 0xd0, 0xd4, 0x00, 0x62, 0x0a, 0x02, 0x24, 0x7a, 0x63, 0xa3,
+	0x3b, 0xdd, 0x1f, 0x60, 0xdf, 0x70, 0xb9, 0x57, 0x40, 0xdf, 0x72, 0x01, 0xcf, 0x0a, 0xe4, 0x5e,
+	0x9f, 0xe8, 0xbe, 0x26, 0xf7, 0x2e, 0xf9, 0xcb, 0x8e, 0xa0, 0x90, 0xa9, 0xa8, 0x6d, 0x68, 0xc6,
+	0x78, 0x4c, 0x55, 0x0e, 0xda, 0xbb, 0xb1, 0xe4, 0xac, 0x8e, 0x35, 0xfd, 0x0b, 0xcc, 0x9d, 0x8e,
+	0x78, 0x6e, 0xfb, 0x1a, 0x8c, 0xc7, 0xbb, 0x39, 0x68, 0x97, 0x12, 0xb7, 0x20, 0xa4, 0xfc, 0x63,
+	0x87, 0xc7, 0x1c, 0xbb, 0x34, 0x63, 0x1a, 0xbd, 0xf1, 0xff, 0x3d, 0xe3, 0xac, 0xe0, 0x72, 0xbb,
+	0xc7, 0x6a, 0x30, 0x8d, 0xee, 0x3b, 0x32, 0x5f, 0x18, 0xe4, 0x60, 0x90, 0xcb, 0x64, 0x70, 0x81,
+	0x89, 0xd1, 0x73, 0x29, 0x36, 0x7c, 0x5f, 0xce, 0xf7, 0x8f, 0x70, 0x48, 0x56, 0x86, 0xb1, 0xff,
+	0xba, 0xc0, 0xe4, 0xe8, 0x4e, 0xcb, 0x03, 0xa7, 0x2b, 0xe3, 0x7f, 0x44, 0x5c, 0xd4, 0x2c, 0x06,
+	0xda, 0xe6, 0x06, 0x95, 0xee, 0x52, 0xc3, 0x8f, 0xc0, 0x9b, 0xb2, 0x69, 0xcf, 0x5a, 0xe5, 0x65,
+	0x29, 0xec, 0xf1, 0x23, 0x70, 0xb7, 0xc9, 0x4c, 0xc4, 0x64, 0x9c, 0x02, 0xe5, 0x12, 0x41, 0xe7,
+	0x2c, 0xf5, 0xc8, 0xe8, 0xfb, 0xdc, 0x2a, 0x67, 0xb7, 0xaa, 0x51, 0x37, 0x20, 0xb7, 0x2b, 0xda,
+	0x05, 0xf3, 0x69, 0x6b, 0x3e, 0x57, 0x4a, 0x43, 0xee, 0x1b, 0xaf, 0x8e, 0xcf, 0x7c, 0xe7, 0xe4,
+	0xcc, 0x77, 0x7e, 0x9f, 0xf9, 0xce, 0xe7, 0x73, 0xbf, 0x76, 0x72, 0xee, 0xd7, 0x7e, 0x9c, 0xfb,
+	0xb5, 0x0f, 0x4f, 0x12, 0x8e, 0xed, 0x4e, 0x2b, 0x88, 0x94, 0x08, 0xaf, 0xf8, 0xa2, 0xf2, 0xf5,
+	0xf0, 0xd0, 0xfe, 0x53, 0xd8, 0xcd, 0xc0, 0xb4, 0xc6, 0xed, 0xa2, 0xeb, 0x7f, 0x06, 0x00, 0xf4,
+	0x1c, 0x32, 0xe7, 0xd2, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -189,21 +223,39 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingStartTimeAfterSettlement):])
+	if m.CandleHistorySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CandleHistorySize))
+		i--
+		dAtA[i] = 0x58
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CandleInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CandleInterval):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintParams(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x42
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingDuration):])
+	dAtA[i] = 0x52
+	if m.TradeHistorySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.TradeHistorySize))
+		i--
+		dAtA[i] = 0x48
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingStartTimeAfterSettlement):])
 	if err2 != nil {
 		return 0, err2
 	}
 	i -= n2
 	i = encodeVarintParams(dAtA, i, uint64(n2))
 	i--
+	dAtA[i] = 0x42
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinVestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingDuration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
 	dAtA[i] = 0x3a
 	{
 		size := m.MinLiquidityPart.Size()
@@ -220,21 +272,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x28
 	}
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IncentivesMinStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IncentivesMinStartTimeAfterSettlement):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintParams(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinPlanDuration):])
+	n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IncentivesMinStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IncentivesMinStartTimeAfterSettlement):])
 	if err4 != nil {
 		return 0, err4
 	}
 	i -= n4
 	i = encodeVarintParams(dAtA, i, uint64(n4))
 	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinPlanDuration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintParams(dAtA, i, uint64(n5))
+	i--
 	dAtA[i] = 0x1a
 	{
 		size := m.CreationFee.Size()
@@ -293,6 +345,14 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinVestingStartTimeAfterSettlement)
 	n += 1 + l + sovParams(uint64(l))
+	if m.TradeHistorySize != 0 {
+		n += 1 + sovParams(uint64(m.TradeHistorySize))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CandleInterval)
+	n += 1 + l + sovParams(uint64(l))
+	if m.CandleHistorySize != 0 {
+		n += 1 + sovParams(uint64(m.CandleHistorySize))
+	}
 	return n
 }
 
@@ -584,6 +644,77 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TradeHistorySize", wireType)
+			}
+			m.TradeHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TradeHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CandleInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CandleHistorySize", wireType)
+			}
+			m.CandleHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CandleHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -87,6 +87,28 @@ func (p Plan) ValidateBasic() error {
 		return errors.Join(ErrInvalidIncentivePlanParams, err)
 	}
 
+	if err := validateSaleMechanism(p.DutchAuction, p.FixedPriceSale); err != nil {
+		return errorsmod.Wrap(err, "sale mechanism")
+	}
+
+	if p.Whitelist != nil {
+		if err := p.Whitelist.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "whitelist")
+		}
+	}
+
+	if p.SoftCap != nil {
+		if err := p.SoftCap.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "soft cap")
+		}
+	}
+
+	if p.BuyerVestingPlan != nil {
+		if err := p.BuyerVestingPlan.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "buyer vesting plan")
+		}
+	}
+
 	if err := p.VestingPlan.ValidateBasic(); err != nil {
 		return errorsmod.Wrap(err, "vesting plan")
 	}
@@ -98,9 +120,9 @@ func (p Plan) ValidateBasic() error {
 	return nil
 }
 
-// SpotPrice returns the spot price of the plan
-func (p Plan) SpotPrice() math.LegacyDec {
-	return p.BondingCurve.SpotPrice(p.SoldAmt)
+// SpotPrice returns the spot price of the plan at the given time
+func (p Plan) SpotPrice(now time.Time) math.LegacyDec {
+	return p.SaleMechanism().SpotPrice(p.SoldAmt, now)
 }
 
 func (p Plan) IsSettled() bool {
@@ -13,19 +13,23 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -866,1015 +870,1309 @@ func (m *QueryClaimedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryClaimedResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryVestingRequest)(nil), "dymensionxyz.dymension.iro.QueryVestingRequest")
-	proto.RegisterType((*QueryVestingResponse)(nil), "dymensionxyz.dymension.iro.QueryVestingResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.iro.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.iro.QueryParamsResponse")
-	proto.RegisterType((*QueryPlansRequest)(nil), "dymensionxyz.dymension.iro.QueryPlansRequest")
-	proto.RegisterType((*QueryPlansResponse)(nil), "dymensionxyz.dymension.iro.QueryPlansResponse")
-	proto.RegisterType((*QueryPlanRequest)(nil), "dymensionxyz.dymension.iro.QueryPlanRequest")
-	proto.RegisterType((*QueryPlanResponse)(nil), "dymensionxyz.dymension.iro.QueryPlanResponse")
-	proto.RegisterType((*QueryPlanByRollappRequest)(nil), "dymensionxyz.dymension.iro.QueryPlanByRollappRequest")
-	proto.RegisterType((*QueryPlanByRollappResponse)(nil), "dymensionxyz.dymension.iro.QueryPlanByRollappResponse")
-	proto.RegisterType((*QuerySpotPriceRequest)(nil), "dymensionxyz.dymension.iro.QuerySpotPriceRequest")
-	proto.RegisterType((*QuerySpotPriceResponse)(nil), "dymensionxyz.dymension.iro.QuerySpotPriceResponse")
-	proto.RegisterType((*QueryCostRequest)(nil), "dymensionxyz.dymension.iro.QueryCostRequest")
-	proto.RegisterType((*QueryCostResponse)(nil), "dymensionxyz.dymension.iro.QueryCostResponse")
-	proto.RegisterType((*QueryTokensForExactInAmountRequest)(nil), "dymensionxyz.dymension.iro.QueryTokensForExactInAmountRequest")
-	proto.RegisterType((*QueryTokensForExactInAmountResponse)(nil), "dymensionxyz.dymension.iro.QueryTokensForExactInAmountResponse")
-	proto.RegisterType((*QueryClaimedRequest)(nil), "dymensionxyz.dymension.iro.QueryClaimedRequest")
-	proto.RegisterType((*QueryClaimedResponse)(nil), "dymensionxyz.dymension.iro.QueryClaimedResponse")
+// QuerySubscriptionRequest is the request type for the Query/QuerySubscription
+// RPC method.
+type QuerySubscriptionRequest struct {
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Buyer  string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("dymensionxyz/dymension/iro/query.proto", fileDescriptor_ae2c72bd0c23c1c0)
+func (m *QuerySubscriptionRequest) Reset()         { *m = QuerySubscriptionRequest{} }
+func (m *QuerySubscriptionRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionRequest) ProtoMessage()    {}
+func (*QuerySubscriptionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{18}
 }
-
-var fileDescriptor_ae2c72bd0c23c1c0 = []byte{
-	// 1091 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x9b, 0xec, 0xd2, 0xbc, 0x84, 0x12, 0x86, 0x14, 0x12, 0x17, 0xb6, 0xc5, 0xad, 0x9a,
-	0x90, 0x74, 0xed, 0xec, 0x86, 0x22, 0xf1, 0xbb, 0xd9, 0x94, 0xb6, 0x8b, 0x90, 0x08, 0x2e, 0x2a,
-	0x88, 0x8b, 0x99, 0xf5, 0x0e, 0x5b, 0xab, 0xde, 0x19, 0xd7, 0x9e, 0x84, 0x2c, 0xa5, 0x1c, 0x90,
-	0xb8, 0x23, 0x21, 0x90, 0x10, 0x70, 0xe7, 0xc0, 0x81, 0x03, 0x7f, 0x03, 0xea, 0xb1, 0x82, 0x0b,
-	0xe2, 0x50, 0xa1, 0x84, 0x03, 0x7f, 0x06, 0xf2, 0xcc, 0xd8, 0xeb, 0x4d, 0x53, 0xdb, 0x5b, 0xc4,
-	0x6d, 0x67, 0xe6, 0x7d, 0xef, 0x7d, 0xdf, 0x9b, 0xe7, 0xcf, 0x6b, 0x38, 0xdb, 0x1d, 0xf4, 0x09,
-	0x8d, 0x3c, 0x46, 0x77, 0x07, 0x9f, 0x58, 0xe9, 0xc2, 0xf2, 0x42, 0x66, 0xdd, 0xdc, 0x26, 0xe1,
-	0xc0, 0x0c, 0x42, 0xc6, 0x19, 0xd2, 0xb3, 0x71, 0x66, 0xba, 0x30, 0xbd, 0x90, 0xe9, 0xf3, 0x3d,
-	0xd6, 0x63, 0x22, 0xcc, 0x8a, 0x7f, 0x49, 0x84, 0xbe, 0xe8, 0xb2, 0xa8, 0xcf, 0x22, 0x47, 0x1e,
-	0xc8, 0x85, 0x3a, 0x7a, 0xba, 0xc7, 0x58, 0xcf, 0x27, 0x16, 0x0e, 0x3c, 0x0b, 0x53, 0xca, 0x38,
-	0xe6, 0x1e, 0xa3, 0xc9, 0xe9, 0x99, 0x1c, 0x4a, 0x5e, 0x98, 0xa4, 0xaf, 0xc9, 0x8c, 0x56, 0x07,
-	0x47, 0xc4, 0xda, 0x69, 0x74, 0x08, 0xc7, 0x0d, 0xcb, 0x65, 0x1e, 0x55, 0xe7, 0x4b, 0x39, 0x59,
-	0x02, 0x1c, 0xe2, 0x7e, 0x52, 0x6e, 0x25, 0x9b, 0x48, 0x48, 0x4e, 0xd3, 0x05, 0xb8, 0xe7, 0x51,
-	0xc1, 0x4d, 0xc6, 0x1a, 0x26, 0x3c, 0xf1, 0x4e, 0x1c, 0x71, 0x8d, 0x44, 0xdc, 0xa3, 0x3d, 0x9b,
-	0xdc, 0xdc, 0x26, 0x11, 0x47, 0x4f, 0xc1, 0x23, 0x81, 0x8f, 0xa9, 0xe3, 0x75, 0x17, 0xb4, 0x53,
-	0xda, 0xf2, 0xb4, 0x5d, 0x8d, 0x97, 0xed, 0xae, 0xf1, 0xed, 0x11, 0x98, 0x1f, 0x05, 0x44, 0x01,
-	0xa3, 0x11, 0x41, 0xf3, 0x50, 0x61, 0x1f, 0x53, 0x12, 0xaa, 0x78, 0xb9, 0x40, 0x1b, 0x50, 0xe1,
-	0x8c, 0x63, 0x7f, 0xe1, 0x48, 0xbc, 0xdb, 0x5a, 0xbd, 0x73, 0xef, 0xe4, 0xc4, 0x9f, 0xf7, 0x4e,
-	0x1e, 0x97, 0x0c, 0xa3, 0xee, 0x0d, 0xd3, 0x63, 0x56, 0x1f, 0xf3, 0xeb, 0x66, 0x9b, 0xf2, 0xdf,
-	0x7e, 0xa9, 0x83, 0xea, 0x6a, 0x9b, 0x72, 0x5b, 0x22, 0xd1, 0x16, 0x3c, 0xba, 0x43, 0x22, 0x4e,
-	0xba, 0x0e, 0xee, 0xb3, 0x6d, 0xca, 0x17, 0x26, 0xc7, 0x4f, 0x35, 0x2b, 0x33, 0x6c, 0x88, 0x04,
-	0xe8, 0x1a, 0xcc, 0xb9, 0x3e, 0xf6, 0xfa, 0xb8, 0xe3, 0x93, 0x24, 0xe9, 0xd4, 0xf8, 0x49, 0x1f,
-	0x4b, 0x93, 0xc8, 0xbc, 0xc6, 0x3c, 0x20, 0xd1, 0x9a, 0x2d, 0x71, 0x19, 0xaa, 0x95, 0xc6, 0x7b,
-	0xaa, 0xc3, 0xc9, 0xae, 0xea, 0xd7, 0x05, 0xa8, 0xca, 0x4b, 0x13, 0x0d, 0x9b, 0x69, 0x1a, 0xe6,
-	0x83, 0xe7, 0xd1, 0x94, 0xd8, 0xd6, 0x54, 0x4c, 0xcf, 0x56, 0x38, 0xe3, 0x0b, 0x0d, 0x1e, 0x97,
-	0x99, 0x7d, 0x4c, 0x93, 0x72, 0x68, 0x19, 0xe6, 0x28, 0xa3, 0x4e, 0x44, 0x38, 0xf7, 0x49, 0xd7,
-	0x61, 0xd4, 0x1f, 0x88, 0x0a, 0x47, 0xed, 0x63, 0x94, 0xd1, 0xab, 0x72, 0xfb, 0x6d, 0xea, 0x0f,
-	0xd0, 0x25, 0x80, 0xe1, 0x38, 0x88, 0x0b, 0x9a, 0x69, 0x9e, 0x35, 0x95, 0xc0, 0x78, 0x76, 0x4c,
-	0xf9, 0xb8, 0xa8, 0xd9, 0x31, 0xb7, 0x70, 0x8f, 0xa8, 0x2a, 0x76, 0x06, 0x69, 0x7c, 0xa7, 0x25,
-	0xba, 0x25, 0x0f, 0x25, 0xf0, 0x15, 0xa8, 0xc4, 0x33, 0x13, 0xeb, 0x9b, 0x5c, 0x9e, 0x69, 0x9e,
-	0xca, 0xd5, 0xe7, 0x63, 0xaa, 0xd4, 0x49, 0x10, 0xba, 0x7c, 0x08, 0xb9, 0xa5, 0x42, 0x72, 0xb2,
-	0xf4, 0x08, 0xbb, 0x55, 0x98, 0x4b, 0xc9, 0x15, 0x4e, 0x77, 0x3b, 0xd3, 0xd1, 0x54, 0xc8, 0xf3,
-	0x30, 0x15, 0x1f, 0xab, 0x7b, 0x2a, 0xd4, 0x61, 0x8b, 0x68, 0xe3, 0x25, 0x58, 0x4c, 0x53, 0xb5,
-	0x06, 0x36, 0xf3, 0x7d, 0x1c, 0x04, 0x09, 0x81, 0x67, 0x00, 0x42, 0xb9, 0x33, 0xe4, 0x30, 0xad,
-	0x76, 0xda, 0x5d, 0xc3, 0x06, 0xfd, 0x30, 0xec, 0x7f, 0xe2, 0xb3, 0x06, 0xc7, 0x45, 0xce, 0xab,
-	0x01, 0xe3, 0x5b, 0xa1, 0xe7, 0x92, 0xc2, 0x66, 0x60, 0x78, 0xf2, 0x20, 0x42, 0x31, 0xb8, 0x0c,
-	0x95, 0x20, 0xde, 0x90, 0x80, 0x56, 0x43, 0x3d, 0x35, 0x27, 0xee, 0x7f, 0x6a, 0xde, 0x22, 0x3d,
-	0xec, 0x0e, 0x2e, 0x12, 0x37, 0xf3, 0xec, 0x5c, 0x24, 0xae, 0x2d, 0xf1, 0xc6, 0x67, 0xea, 0x72,
-	0x36, 0x59, 0xc4, 0x8b, 0xf8, 0xa0, 0x57, 0x61, 0x12, 0xf7, 0xf9, 0xc3, 0x38, 0x49, 0x8c, 0x43,
-	0x08, 0xa6, 0x22, 0xe2, 0xfb, 0xc2, 0x3e, 0x8e, 0xda, 0xe2, 0xb7, 0xd1, 0x52, 0xf7, 0x2d, 0xeb,
-	0x2b, 0x75, 0x75, 0x98, 0x72, 0x59, 0xc4, 0x55, 0x7f, 0x17, 0x47, 0x86, 0x2e, 0x19, 0xb7, 0x4d,
-	0xe6, 0x51, 0x5b, 0x84, 0x19, 0x9f, 0x82, 0x21, 0x72, 0xbc, 0xcb, 0x6e, 0x10, 0x1a, 0x5d, 0x62,
-	0xe1, 0x1b, 0xbb, 0xd8, 0xe5, 0x6d, 0x2a, 0x4d, 0xe1, 0x7f, 0x56, 0x65, 0xbc, 0x0f, 0xa7, 0x73,
-	0xab, 0x2b, 0x4d, 0x0d, 0xa8, 0x72, 0x11, 0x51, 0xac, 0x4a, 0x05, 0xa6, 0x6f, 0x86, 0xcd, 0xd8,
-	0xe5, 0x48, 0xb7, 0x70, 0x5c, 0x3e, 0x54, 0x2f, 0x86, 0x34, 0x5e, 0x95, 0xbe, 0x02, 0x33, 0xae,
-	0xdc, 0x72, 0x62, 0xa1, 0x72, 0x64, 0x96, 0xca, 0x8a, 0x04, 0x85, 0xdd, 0xe8, 0xf3, 0xe6, 0xcf,
-	0xb3, 0x50, 0x11, 0x25, 0xd0, 0xd7, 0x1a, 0x54, 0xa5, 0x27, 0x22, 0x33, 0x6f, 0xfe, 0xef, 0xb7,
-	0x63, 0xdd, 0x2a, 0x1d, 0x2f, 0xf9, 0x1b, 0x2b, 0x9f, 0xff, 0xfe, 0xf7, 0x57, 0x47, 0xce, 0x20,
-	0xc3, 0x2a, 0x7c, 0xff, 0xa2, 0x6f, 0x34, 0x80, 0xa1, 0x15, 0xa2, 0x7a, 0x71, 0xad, 0x8c, 0x75,
-	0xeb, 0x66, 0xd9, 0x70, 0xc5, 0xec, 0x39, 0xc1, 0xec, 0x34, 0x7a, 0x36, 0x97, 0x99, 0x60, 0xf2,
-	0x83, 0x06, 0xd3, 0x69, 0x06, 0x74, 0xae, 0x54, 0xa1, 0x84, 0x56, 0xbd, 0x64, 0xb4, 0x62, 0xb5,
-	0x2e, 0x58, 0xd5, 0xd1, 0x6a, 0x21, 0x2b, 0xeb, 0x96, 0x9a, 0xa4, 0xdb, 0xe8, 0xd7, 0xec, 0x3b,
-	0x24, 0xb5, 0x3c, 0x74, 0xbe, 0x54, 0xe9, 0x83, 0xf6, 0xaa, 0xbf, 0x30, 0x2e, 0x4c, 0x51, 0xdf,
-	0x10, 0xd4, 0x5f, 0x46, 0x2f, 0x16, 0x52, 0x77, 0x3a, 0x03, 0x47, 0xf9, 0xb5, 0x75, 0x6b, 0x68,
-	0xe5, 0xb7, 0xd1, 0x4f, 0x1a, 0x1c, 0x1b, 0x75, 0x4d, 0xd4, 0x28, 0x64, 0x73, 0xd0, 0x93, 0xf5,
-	0xe6, 0x38, 0x90, 0xb1, 0xfa, 0x1e, 0x43, 0x32, 0x7d, 0xff, 0x3e, 0x99, 0x8b, 0xd8, 0x01, 0x4b,
-	0xcc, 0x45, 0xc6, 0xa8, 0x4b, 0xcc, 0x45, 0xd6, 0x56, 0x8d, 0xa6, 0xe0, 0x77, 0x0e, 0xad, 0xe4,
-	0xf1, 0x8b, 0x1d, 0x35, 0x43, 0xef, 0x1f, 0x0d, 0x4e, 0xe4, 0xd8, 0x1b, 0x7a, 0xad, 0x90, 0x42,
-	0xae, 0x2b, 0xeb, 0xaf, 0x3f, 0x34, 0x5e, 0x89, 0xba, 0x22, 0x44, 0xb5, 0xd0, 0x85, 0x3c, 0x51,
-	0xd2, 0x50, 0x9d, 0x8f, 0x58, 0xe8, 0x90, 0x38, 0x8b, 0xe3, 0x51, 0xf5, 0xb7, 0x33, 0x23, 0xf5,
-	0x47, 0x0d, 0x66, 0xb3, 0xfe, 0x89, 0x8a, 0x8d, 0x6a, 0xd4, 0x99, 0xf5, 0xb5, 0xf2, 0x00, 0xc5,
-	0xfe, 0xbc, 0x60, 0x6f, 0xa1, 0x7a, 0xee, 0x95, 0x48, 0xd0, 0x61, 0x54, 0xd5, 0x37, 0x40, 0x09,
-	0xaa, 0xa3, 0x9f, 0x17, 0x25, 0xa8, 0x1e, 0xf8, 0xbc, 0x28, 0x47, 0x75, 0x47, 0x82, 0x86, 0x54,
-	0x5b, 0x6f, 0xde, 0xd9, 0xab, 0x69, 0x77, 0xf7, 0x6a, 0xda, 0x5f, 0x7b, 0x35, 0xed, 0xcb, 0xfd,
-	0xda, 0xc4, 0xdd, 0xfd, 0xda, 0xc4, 0x1f, 0xfb, 0xb5, 0x89, 0x0f, 0xd6, 0x7a, 0x1e, 0xbf, 0xbe,
-	0xdd, 0x31, 0x5d, 0xd6, 0x7f, 0x50, 0xca, 0x9d, 0x75, 0x6b, 0x57, 0x5e, 0xe0, 0x20, 0x20, 0x51,
-	0xa7, 0x2a, 0xbe, 0x98, 0xd6, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x00, 0xb3, 0x21, 0xf8, 0x61,
-	0x0e, 0x00, 0x00,
+func (m *QuerySubscriptionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscriptionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscriptionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySubscriptionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionRequest.Merge(m, src)
+}
+func (m *QuerySubscriptionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscriptionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySubscriptionRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the IRO module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// QueryPlans retrieves all available plans.
-	QueryPlans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error)
-	// QueryPlan retrieves the plan for the specified plan ID.
-	QueryPlan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error)
-	// QueryPlanByRollapp retrieves the plans for the specified rollapp ID.
-	QueryPlanByRollapp(ctx context.Context, in *QueryPlanByRollappRequest, opts ...grpc.CallOption) (*QueryPlanByRollappResponse, error)
-	// QuerySpotPrice retrieves the current spot price for the specified plan ID.
-	// The result is the price of 1 IRO token (not iro's base denom)
-	QuerySpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
-	// QueryCost retrieves the expected cost for buying or selling the specified
-	// amount of shares.
-	QueryCost(ctx context.Context, in *QueryCostRequest, opts ...grpc.CallOption) (*QueryCostResponse, error)
-	QueryTokensForExactInAmount(ctx context.Context, in *QueryTokensForExactInAmountRequest, opts ...grpc.CallOption) (*QueryTokensForExactInAmountResponse, error)
-	// QueryClaimed retrieves the claimed amount thus far for the specified plan
-	// ID.
-	QueryClaimed(ctx context.Context, in *QueryClaimedRequest, opts ...grpc.CallOption) (*QueryClaimedResponse, error)
-	// QueryVesting queries the claimable and vested amount for
-	// the specified plan ID.
-	QueryVesting(ctx context.Context, in *QueryVestingRequest, opts ...grpc.CallOption) (*QueryVestingResponse, error)
+func (m *QuerySubscriptionRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QuerySubscriptionRequest) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QuerySubscriptionResponse is the response type for the
+// Query/QuerySubscription RPC method.
+type QuerySubscriptionResponse struct {
+	Subscription Subscription `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription"`
+	// allocation is the tokens allocated to the subscription, known once the plan
+	// is settled
+	Allocation types.Coin `protobuf:"bytes,2,opt,name=allocation,proto3" json:"allocation"`
+	// refund is the liquidity refunded to the buyer, known once the plan is
+	// settled
+	Refund types.Coin `protobuf:"bytes,3,opt,name=refund,proto3" json:"refund"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QuerySubscriptionResponse) Reset()         { *m = QuerySubscriptionResponse{} }
+func (m *QuerySubscriptionResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySubscriptionResponse) ProtoMessage()    {}
+func (*QuerySubscriptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{19}
 }
-
-func (c *queryClient) QueryPlans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error) {
-	out := new(QueryPlansResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlans", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySubscriptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySubscriptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySubscriptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySubscriptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySubscriptionResponse.Merge(m, src)
+}
+func (m *QuerySubscriptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySubscriptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySubscriptionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryPlan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error) {
-	out := new(QueryPlanResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlan", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QuerySubscriptionResponse proto.InternalMessageInfo
+
+func (m *QuerySubscriptionResponse) GetSubscription() Subscription {
+	if m != nil {
+		return m.Subscription
 	}
-	return out, nil
+	return Subscription{}
 }
 
-func (c *queryClient) QueryPlanByRollapp(ctx context.Context, in *QueryPlanByRollappRequest, opts ...grpc.CallOption) (*QueryPlanByRollappResponse, error) {
-	out := new(QueryPlanByRollappResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlanByRollapp", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySubscriptionResponse) GetAllocation() types.Coin {
+	if m != nil {
+		return m.Allocation
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) QuerySpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
-	out := new(QuerySpotPriceResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QuerySpotPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySubscriptionResponse) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) QueryCost(ctx context.Context, in *QueryCostRequest, opts ...grpc.CallOption) (*QueryCostResponse, error) {
-	out := new(QueryCostResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryCost", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryRemainingCapRequest is the request type for the Query/QueryRemainingCap
+// RPC method.
+type QueryRemainingCapRequest struct {
+	PlanId  string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func (c *queryClient) QueryTokensForExactInAmount(ctx context.Context, in *QueryTokensForExactInAmountRequest, opts ...grpc.CallOption) (*QueryTokensForExactInAmountResponse, error) {
-	out := new(QueryTokensForExactInAmountResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryTokensForExactInAmount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRemainingCapRequest) Reset()         { *m = QueryRemainingCapRequest{} }
+func (m *QueryRemainingCapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingCapRequest) ProtoMessage()    {}
+func (*QueryRemainingCapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{20}
+}
+func (m *QueryRemainingCapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRemainingCapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingCapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryRemainingCapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingCapRequest.Merge(m, src)
+}
+func (m *QueryRemainingCapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRemainingCapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingCapRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) QueryClaimed(ctx context.Context, in *QueryClaimedRequest, opts ...grpc.CallOption) (*QueryClaimedResponse, error) {
-	out := new(QueryClaimedResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryClaimed", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRemainingCapRequest proto.InternalMessageInfo
+
+func (m *QueryRemainingCapRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) QueryVesting(ctx context.Context, in *QueryVestingRequest, opts ...grpc.CallOption) (*QueryVestingResponse, error) {
-	out := new(QueryVestingResponse)
-	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryVesting", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryRemainingCapRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the IRO module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// QueryPlans retrieves all available plans.
-	QueryPlans(context.Context, *QueryPlansRequest) (*QueryPlansResponse, error)
-	// QueryPlan retrieves the plan for the specified plan ID.
-	QueryPlan(context.Context, *QueryPlanRequest) (*QueryPlanResponse, error)
-	// QueryPlanByRollapp retrieves the plans for the specified rollapp ID.
-	QueryPlanByRollapp(context.Context, *QueryPlanByRollappRequest) (*QueryPlanByRollappResponse, error)
-	// QuerySpotPrice retrieves the current spot price for the specified plan ID.
-	// The result is the price of 1 IRO token (not iro's base denom)
-	QuerySpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
-	// QueryCost retrieves the expected cost for buying or selling the specified
-	// amount of shares.
-	QueryCost(context.Context, *QueryCostRequest) (*QueryCostResponse, error)
-	QueryTokensForExactInAmount(context.Context, *QueryTokensForExactInAmountRequest) (*QueryTokensForExactInAmountResponse, error)
-	// QueryClaimed retrieves the claimed amount thus far for the specified plan
-	// ID.
-	QueryClaimed(context.Context, *QueryClaimedRequest) (*QueryClaimedResponse, error)
-	// QueryVesting queries the claimable and vested amount for
-	// the specified plan ID.
-	QueryVesting(context.Context, *QueryVestingRequest) (*QueryVestingResponse, error)
+// QueryRemainingCapResponse is the response type for the
+// Query/QueryRemainingCap RPC method.
+type QueryRemainingCapResponse struct {
+	// whitelist_phase is whether the plan is in its whitelist phase
+	WhitelistPhase bool `protobuf:"varint,1,opt,name=whitelist_phase,json=whitelistPhase,proto3" json:"whitelist_phase,omitempty"`
+	// whitelisted is whether the account is known to be whitelisted. An account
+	// of a Merkle tree whitelist is only known once it proved it.
+	Whitelisted bool `protobuf:"varint,2,opt,name=whitelisted,proto3" json:"whitelisted,omitempty"`
+	// capped is whether a cap applies to the account
+	Capped bool `protobuf:"varint,3,opt,name=capped,proto3" json:"capped,omitempty"`
+	// remaining is the amount of tokens the account can still buy, if capped
+	Remaining types.Coin `protobuf:"bytes,4,opt,name=remaining,proto3" json:"remaining"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryRemainingCapResponse) Reset()         { *m = QueryRemainingCapResponse{} }
+func (m *QueryRemainingCapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRemainingCapResponse) ProtoMessage()    {}
+func (*QueryRemainingCapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{21}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryRemainingCapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) QueryPlans(ctx context.Context, req *QueryPlansRequest) (*QueryPlansResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPlans not implemented")
+func (m *QueryRemainingCapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRemainingCapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) QueryPlan(ctx context.Context, req *QueryPlanRequest) (*QueryPlanResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPlan not implemented")
+func (m *QueryRemainingCapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRemainingCapResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) QueryPlanByRollapp(ctx context.Context, req *QueryPlanByRollappRequest) (*QueryPlanByRollappResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryPlanByRollapp not implemented")
+func (m *QueryRemainingCapResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) QuerySpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QuerySpotPrice not implemented")
+func (m *QueryRemainingCapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRemainingCapResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) QueryCost(ctx context.Context, req *QueryCostRequest) (*QueryCostResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryCost not implemented")
+
+var xxx_messageInfo_QueryRemainingCapResponse proto.InternalMessageInfo
+
+func (m *QueryRemainingCapResponse) GetWhitelistPhase() bool {
+	if m != nil {
+		return m.WhitelistPhase
+	}
+	return false
 }
-func (*UnimplementedQueryServer) QueryTokensForExactInAmount(ctx context.Context, req *QueryTokensForExactInAmountRequest) (*QueryTokensForExactInAmountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryTokensForExactInAmount not implemented")
+
+func (m *QueryRemainingCapResponse) GetWhitelisted() bool {
+	if m != nil {
+		return m.Whitelisted
+	}
+	return false
 }
-func (*UnimplementedQueryServer) QueryClaimed(ctx context.Context, req *QueryClaimedRequest) (*QueryClaimedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryClaimed not implemented")
+
+func (m *QueryRemainingCapResponse) GetCapped() bool {
+	if m != nil {
+		return m.Capped
+	}
+	return false
 }
-func (*UnimplementedQueryServer) QueryVesting(ctx context.Context, req *QueryVestingRequest) (*QueryVestingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method QueryVesting not implemented")
+
+func (m *QueryRemainingCapResponse) GetRemaining() types.Coin {
+	if m != nil {
+		return m.Remaining
+	}
+	return types.Coin{}
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryBuyerVestingRequest is the request type for the Query/QueryBuyerVesting
+// RPC method.
+type QueryBuyerVestingRequest struct {
+	PlanId  string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryBuyerVestingRequest) Reset()         { *m = QueryBuyerVestingRequest{} }
+func (m *QueryBuyerVestingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBuyerVestingRequest) ProtoMessage()    {}
+func (*QueryBuyerVestingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{22}
+}
+func (m *QueryBuyerVestingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuyerVestingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuyerVestingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBuyerVestingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuyerVestingRequest.Merge(m, src)
+}
+func (m *QueryBuyerVestingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuyerVestingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuyerVestingRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlansRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPlans(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlans",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPlans(ctx, req.(*QueryPlansRequest))
+var xxx_messageInfo_QueryBuyerVestingRequest proto.InternalMessageInfo
+
+func (m *QueryBuyerVestingRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlanRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPlan(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlan",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPlan(ctx, req.(*QueryPlanRequest))
+func (m *QueryBuyerVestingRequest) GetAccount() string {
+	if m != nil {
+		return m.Account
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_QueryPlanByRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPlanByRollappRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryPlanByRollapp(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlanByRollapp",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryPlanByRollapp(ctx, req.(*QueryPlanByRollappRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryBuyerVestingResponse is the response type for the
+// Query/QueryBuyerVesting RPC method. The amounts are of the settled denom.
+type QueryBuyerVestingResponse struct {
+	// total is the amount of tokens of the account, including the IRO tokens or
+	// the allocation not claimed yet
+	Total cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=total,proto3,customtype=cosmossdk.io/math.Int" json:"total"`
+	// vested_amount is the amount of tokens that are vested
+	VestedAmount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=vested_amount,json=vestedAmount,proto3,customtype=cosmossdk.io/math.Int" json:"vested_amount"`
+	// claimed is the amount of tokens claimed
+	Claimed cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=claimed,proto3,customtype=cosmossdk.io/math.Int" json:"claimed"`
+	// claimable_amount is the amount of tokens that can be claimed
+	ClaimableAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=claimable_amount,json=claimableAmount,proto3,customtype=cosmossdk.io/math.Int" json:"claimable_amount"`
 }
 
-func _Query_QuerySpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QuerySpotPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QuerySpotPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QuerySpotPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QuerySpotPrice(ctx, req.(*QuerySpotPriceRequest))
+func (m *QueryBuyerVestingResponse) Reset()         { *m = QueryBuyerVestingResponse{} }
+func (m *QueryBuyerVestingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBuyerVestingResponse) ProtoMessage()    {}
+func (*QueryBuyerVestingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{23}
+}
+func (m *QueryBuyerVestingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBuyerVestingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBuyerVestingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryBuyerVestingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBuyerVestingResponse.Merge(m, src)
+}
+func (m *QueryBuyerVestingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBuyerVestingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBuyerVestingResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryCostRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryCost(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryCost",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryCost(ctx, req.(*QueryCostRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryBuyerVestingResponse proto.InternalMessageInfo
+
+// QueryTradesRequest is the request type for the Query/QueryTrades RPC method.
+type QueryTradesRequest struct {
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
 }
 
-func _Query_QueryTokensForExactInAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTokensForExactInAmountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryTokensForExactInAmount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryTokensForExactInAmount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryTokensForExactInAmount(ctx, req.(*QueryTokensForExactInAmountRequest))
+func (m *QueryTradesRequest) Reset()         { *m = QueryTradesRequest{} }
+func (m *QueryTradesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesRequest) ProtoMessage()    {}
+func (*QueryTradesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{24}
+}
+func (m *QueryTradesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTradesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesRequest.Merge(m, src)
+}
+func (m *QueryTradesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesRequest.DiscardUnknown(m)
 }
 
-func _Query_QueryClaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryClaimed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryClaimed",
+var xxx_messageInfo_QueryTradesRequest proto.InternalMessageInfo
+
+func (m *QueryTradesRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryClaimed(ctx, req.(*QueryClaimedRequest))
+	return ""
+}
+
+// QueryTradesResponse is the response type for the Query/QueryTrades RPC
+// method.
+type QueryTradesResponse struct {
+	Trades []Trade `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades"`
+}
+
+func (m *QueryTradesResponse) Reset()         { *m = QueryTradesResponse{} }
+func (m *QueryTradesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTradesResponse) ProtoMessage()    {}
+func (*QueryTradesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{25}
+}
+func (m *QueryTradesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTradesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTradesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTradesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTradesResponse.Merge(m, src)
+}
+func (m *QueryTradesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTradesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTradesResponse.DiscardUnknown(m)
 }
 
-func _Query_QueryVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryVestingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTradesResponse proto.InternalMessageInfo
+
+func (m *QueryTradesResponse) GetTrades() []Trade {
+	if m != nil {
+		return m.Trades
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).QueryVesting(ctx, in)
+	return nil
+}
+
+// QueryCandlesRequest is the request type for the Query/QueryCandles RPC
+// method.
+type QueryCandlesRequest struct {
+	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *QueryCandlesRequest) Reset()         { *m = QueryCandlesRequest{} }
+func (m *QueryCandlesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesRequest) ProtoMessage()    {}
+func (*QueryCandlesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{26}
+}
+func (m *QueryCandlesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryVesting",
+}
+func (m *QueryCandlesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesRequest.Merge(m, src)
+}
+func (m *QueryCandlesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCandlesRequest proto.InternalMessageInfo
+
+func (m *QueryCandlesRequest) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).QueryVesting(ctx, req.(*QueryVestingRequest))
+	return ""
+}
+
+// QueryCandlesResponse is the response type for the Query/QueryCandles RPC
+// method.
+type QueryCandlesResponse struct {
+	Candles []Candle `protobuf:"bytes,1,rep,name=candles,proto3" json:"candles"`
+	// interval of the new candles
+	Interval time.Duration `protobuf:"bytes,2,opt,name=interval,proto3,stdduration" json:"interval"`
+}
+
+func (m *QueryCandlesResponse) Reset()         { *m = QueryCandlesResponse{} }
+func (m *QueryCandlesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCandlesResponse) ProtoMessage()    {}
+func (*QueryCandlesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae2c72bd0c23c1c0, []int{27}
+}
+func (m *QueryCandlesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCandlesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCandlesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCandlesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCandlesResponse.Merge(m, src)
+}
+func (m *QueryCandlesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCandlesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCandlesResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dymensionxyz.dymension.iro.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "QueryPlans",
-			Handler:    _Query_QueryPlans_Handler,
-		},
-		{
-			MethodName: "QueryPlan",
-			Handler:    _Query_QueryPlan_Handler,
-		},
-		{
-			MethodName: "QueryPlanByRollapp",
-			Handler:    _Query_QueryPlanByRollapp_Handler,
-		},
-		{
-			MethodName: "QuerySpotPrice",
-			Handler:    _Query_QuerySpotPrice_Handler,
-		},
-		{
-			MethodName: "QueryCost",
-			Handler:    _Query_QueryCost_Handler,
-		},
-		{
-			MethodName: "QueryTokensForExactInAmount",
-			Handler:    _Query_QueryTokensForExactInAmount_Handler,
-		},
-		{
-			MethodName: "QueryClaimed",
-			Handler:    _Query_QueryClaimed_Handler,
-		},
-		{
-			MethodName: "QueryVesting",
-			Handler:    _Query_QueryVesting_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dymensionxyz/dymension/iro/query.proto",
+var xxx_messageInfo_QueryCandlesResponse proto.InternalMessageInfo
+
+func (m *QueryCandlesResponse) GetCandles() []Candle {
+	if m != nil {
+		return m.Candles
+	}
+	return nil
 }
 
-func (m *QueryVestingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCandlesResponse) GetInterval() time.Duration {
+	if m != nil {
+		return m.Interval
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryVestingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryVestingRequest)(nil), "dymensionxyz.dymension.iro.QueryVestingRequest")
+	proto.RegisterType((*QueryVestingResponse)(nil), "dymensionxyz.dymension.iro.QueryVestingResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "dymensionxyz.dymension.iro.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dymensionxyz.dymension.iro.QueryParamsResponse")
+	proto.RegisterType((*QueryPlansRequest)(nil), "dymensionxyz.dymension.iro.QueryPlansRequest")
+	proto.RegisterType((*QueryPlansResponse)(nil), "dymensionxyz.dymension.iro.QueryPlansResponse")
+	proto.RegisterType((*QueryPlanRequest)(nil), "dymensionxyz.dymension.iro.QueryPlanRequest")
+	proto.RegisterType((*QueryPlanResponse)(nil), "dymensionxyz.dymension.iro.QueryPlanResponse")
+	proto.RegisterType((*QueryPlanByRollappRequest)(nil), "dymensionxyz.dymension.iro.QueryPlanByRollappRequest")
+	proto.RegisterType((*QueryPlanByRollappResponse)(nil), "dymensionxyz.dymension.iro.QueryPlanByRollappResponse")
+	proto.RegisterType((*QuerySpotPriceRequest)(nil), "dymensionxyz.dymension.iro.QuerySpotPriceRequest")
+	proto.RegisterType((*QuerySpotPriceResponse)(nil), "dymensionxyz.dymension.iro.QuerySpotPriceResponse")
+	proto.RegisterType((*QueryCostRequest)(nil), "dymensionxyz.dymension.iro.QueryCostRequest")
+	proto.RegisterType((*QueryCostResponse)(nil), "dymensionxyz.dymension.iro.QueryCostResponse")
+	proto.RegisterType((*QueryTokensForExactInAmountRequest)(nil), "dymensionxyz.dymension.iro.QueryTokensForExactInAmountRequest")
+	proto.RegisterType((*QueryTokensForExactInAmountResponse)(nil), "dymensionxyz.dymension.iro.QueryTokensForExactInAmountResponse")
+	proto.RegisterType((*QueryClaimedRequest)(nil), "dymensionxyz.dymension.iro.QueryClaimedRequest")
+	proto.RegisterType((*QueryClaimedResponse)(nil), "dymensionxyz.dymension.iro.QueryClaimedResponse")
+	proto.RegisterType((*QuerySubscriptionRequest)(nil), "dymensionxyz.dymension.iro.QuerySubscriptionRequest")
+	proto.RegisterType((*QuerySubscriptionResponse)(nil), "dymensionxyz.dymension.iro.QuerySubscriptionResponse")
+	proto.RegisterType((*QueryRemainingCapRequest)(nil), "dymensionxyz.dymension.iro.QueryRemainingCapRequest")
+	proto.RegisterType((*QueryRemainingCapResponse)(nil), "dymensionxyz.dymension.iro.QueryRemainingCapResponse")
+	proto.RegisterType((*QueryBuyerVestingRequest)(nil), "dymensionxyz.dymension.iro.QueryBuyerVestingRequest")
+	proto.RegisterType((*QueryBuyerVestingResponse)(nil), "dymensionxyz.dymension.iro.QueryBuyerVestingResponse")
+	proto.RegisterType((*QueryTradesRequest)(nil), "dymensionxyz.dymension.iro.QueryTradesRequest")
+	proto.RegisterType((*QueryTradesResponse)(nil), "dymensionxyz.dymension.iro.QueryTradesResponse")
+	proto.RegisterType((*QueryCandlesRequest)(nil), "dymensionxyz.dymension.iro.QueryCandlesRequest")
+	proto.RegisterType((*QueryCandlesResponse)(nil), "dymensionxyz.dymension.iro.QueryCandlesResponse")
 }
 
-func (m *QueryVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/iro/query.proto", fileDescriptor_ae2c72bd0c23c1c0)
 }
 
-func (m *QueryVestingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+var fileDescriptor_ae2c72bd0c23c1c0 = []byte{
+	// 1554 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xcd, 0x6f, 0xdc, 0xc4,
+	0x1b, 0x8e, 0xf3, 0xb1, 0x49, 0xde, 0xf4, 0xd7, 0x8f, 0x49, 0xda, 0x5f, 0xe2, 0xc2, 0xb6, 0x75,
+	0xab, 0x26, 0xb4, 0x5d, 0x3b, 0x49, 0x1b, 0x10, 0xa5, 0x25, 0xcd, 0xa6, 0x5f, 0x41, 0x20, 0x82,
+	0x5b, 0x15, 0xc4, 0x65, 0x99, 0xb5, 0xa7, 0x1b, 0xab, 0xde, 0x19, 0xd7, 0xf6, 0xa6, 0x5d, 0x4a,
+	0x39, 0x20, 0x71, 0x47, 0x42, 0x20, 0x50, 0xe1, 0xc6, 0x81, 0x03, 0x47, 0xae, 0xdc, 0x10, 0xf4,
+	0x46, 0x05, 0x17, 0x84, 0x44, 0x41, 0x2d, 0x07, 0xf8, 0x2f, 0x90, 0x67, 0xc6, 0x8e, 0x37, 0xd9,
+	0xda, 0xde, 0x96, 0xde, 0x76, 0xc6, 0xef, 0xf3, 0xbe, 0xcf, 0xf3, 0xfa, 0x9d, 0xd9, 0xc7, 0x70,
+	0xd8, 0x6e, 0x37, 0x09, 0x0d, 0x1c, 0x46, 0x6f, 0xb6, 0xdf, 0x35, 0x92, 0x85, 0xe1, 0xf8, 0xcc,
+	0xb8, 0xde, 0x22, 0x7e, 0x5b, 0xf7, 0x7c, 0x16, 0x32, 0xa4, 0xa6, 0xe3, 0xf4, 0x64, 0xa1, 0x3b,
+	0x3e, 0x53, 0x27, 0x1a, 0xac, 0xc1, 0x78, 0x98, 0x11, 0xfd, 0x12, 0x08, 0x75, 0xca, 0x62, 0x41,
+	0x93, 0x05, 0x35, 0xf1, 0x40, 0x2c, 0xe4, 0xa3, 0x67, 0x1a, 0x8c, 0x35, 0x5c, 0x62, 0x60, 0xcf,
+	0x31, 0x30, 0xa5, 0x2c, 0xc4, 0xa1, 0xc3, 0x68, 0xfc, 0xb4, 0x2c, 0x9f, 0xf2, 0x55, 0xbd, 0x75,
+	0xd5, 0xb0, 0x5b, 0x3e, 0x0f, 0x90, 0xcf, 0x0f, 0x65, 0x50, 0x76, 0xfc, 0xb8, 0x7c, 0x59, 0x54,
+	0x34, 0xea, 0x38, 0x20, 0xc6, 0xfa, 0x5c, 0x9d, 0x84, 0x78, 0xce, 0xb0, 0x98, 0x13, 0x67, 0x99,
+	0xce, 0xc8, 0xe2, 0x61, 0x1f, 0x37, 0x63, 0x3a, 0x47, 0xd2, 0x89, 0x78, 0x4b, 0x92, 0x74, 0x1e,
+	0x6e, 0x38, 0x34, 0x45, 0x4d, 0xd3, 0x61, 0xfc, 0x8d, 0x28, 0xe2, 0x0a, 0x09, 0x42, 0x87, 0x36,
+	0x4c, 0x72, 0xbd, 0x45, 0x82, 0x10, 0xfd, 0x1f, 0x86, 0x3d, 0x17, 0xd3, 0x9a, 0x63, 0x4f, 0x2a,
+	0xfb, 0x95, 0x99, 0x51, 0xb3, 0x14, 0x2d, 0x57, 0x6c, 0xed, 0xf3, 0x7e, 0x98, 0xe8, 0x04, 0x04,
+	0x1e, 0xa3, 0x01, 0x41, 0x13, 0x30, 0xc4, 0x6e, 0x50, 0xe2, 0xcb, 0x78, 0xb1, 0x40, 0x4b, 0x30,
+	0x14, 0xb2, 0x10, 0xbb, 0x93, 0xfd, 0xd1, 0x6e, 0xf5, 0xe8, 0xdd, 0xfb, 0xfb, 0xfa, 0x7e, 0xbb,
+	0xbf, 0x6f, 0xb7, 0x60, 0x18, 0xd8, 0xd7, 0x74, 0x87, 0x19, 0x4d, 0x1c, 0xae, 0xe9, 0x2b, 0x34,
+	0xfc, 0xf9, 0xdb, 0x0a, 0xc8, 0xae, 0xaf, 0xd0, 0xd0, 0x14, 0x48, 0xb4, 0x0a, 0xff, 0x5b, 0x27,
+	0x41, 0x48, 0xec, 0x1a, 0x6e, 0xb2, 0x16, 0x0d, 0x27, 0x07, 0x7a, 0x4f, 0xb5, 0x4d, 0x64, 0x58,
+	0xe2, 0x09, 0xd0, 0x15, 0xd8, 0x69, 0xb9, 0xd8, 0x69, 0xe2, 0xba, 0x4b, 0xe2, 0xa4, 0x83, 0xbd,
+	0x27, 0xdd, 0x91, 0x24, 0x11, 0x79, 0xb5, 0x09, 0x40, 0xbc, 0x35, 0xab, 0xfc, 0x65, 0xc8, 0x56,
+	0x6a, 0x6f, 0xc2, 0x78, 0xc7, 0xae, 0xec, 0xd7, 0x19, 0x28, 0x89, 0x97, 0xc6, 0x1b, 0x36, 0x36,
+	0xaf, 0xe9, 0x8f, 0x9e, 0x57, 0x5d, 0x60, 0xab, 0x83, 0x11, 0x3d, 0x53, 0xe2, 0xb4, 0x0f, 0x15,
+	0xd8, 0x25, 0x32, 0xbb, 0x98, 0xc6, 0xe5, 0xd0, 0x0c, 0xec, 0xa4, 0x8c, 0xd6, 0x02, 0x12, 0x86,
+	0x2e, 0xb1, 0x6b, 0x8c, 0xba, 0x6d, 0x5e, 0x61, 0xc4, 0xdc, 0x4e, 0x19, 0xbd, 0x24, 0xb6, 0x5f,
+	0xa7, 0x6e, 0x1b, 0x9d, 0x07, 0xd8, 0x18, 0x07, 0xfe, 0x82, 0xc6, 0xe6, 0x0f, 0xeb, 0x52, 0x60,
+	0x34, 0x3b, 0xba, 0x38, 0x4e, 0x72, 0x76, 0xf4, 0x55, 0xdc, 0x20, 0xb2, 0x8a, 0x99, 0x42, 0x6a,
+	0x77, 0x14, 0x40, 0x69, 0x1e, 0x52, 0xe0, 0x29, 0x18, 0x8a, 0x66, 0x26, 0xd2, 0x37, 0x30, 0x33,
+	0x36, 0xbf, 0x3f, 0x53, 0x9f, 0x8b, 0xa9, 0x54, 0x27, 0x40, 0xe8, 0x42, 0x17, 0x72, 0xd3, 0xb9,
+	0xe4, 0x44, 0xe9, 0x0e, 0x76, 0x47, 0x61, 0x67, 0x42, 0x2e, 0x77, 0xba, 0x57, 0x52, 0x1d, 0x4d,
+	0x84, 0x9c, 0x80, 0xc1, 0xe8, 0xb1, 0x7c, 0x4f, 0xb9, 0x3a, 0x4c, 0x1e, 0xad, 0x9d, 0x84, 0xa9,
+	0x24, 0x55, 0xb5, 0x6d, 0x32, 0xd7, 0xc5, 0x9e, 0x17, 0x13, 0x78, 0x16, 0xc0, 0x17, 0x3b, 0x1b,
+	0x1c, 0x46, 0xe5, 0xce, 0x8a, 0xad, 0x99, 0xa0, 0x76, 0xc3, 0x3e, 0x11, 0x9f, 0x59, 0xd8, 0xcd,
+	0x73, 0x5e, 0xf2, 0x58, 0xb8, 0xea, 0x3b, 0x16, 0xc9, 0x6d, 0x06, 0x86, 0x3d, 0x9b, 0x11, 0x92,
+	0xc1, 0x05, 0x18, 0xf2, 0xa2, 0x0d, 0x01, 0xa8, 0xce, 0xc9, 0x53, 0xb3, 0x77, 0xeb, 0xa9, 0x79,
+	0x95, 0x34, 0xb0, 0xd5, 0x3e, 0x4b, 0xac, 0xd4, 0xd9, 0x39, 0x4b, 0x2c, 0x53, 0xe0, 0xb5, 0xf7,
+	0xe5, 0xcb, 0x59, 0x66, 0x41, 0x98, 0xc7, 0x07, 0x9d, 0x86, 0x01, 0xdc, 0x0c, 0x1f, 0xe7, 0x26,
+	0x89, 0x70, 0x08, 0xc1, 0x60, 0x40, 0x5c, 0x97, 0x5f, 0x1f, 0x23, 0x26, 0xff, 0xad, 0x55, 0x61,
+	0x57, 0xaa, 0xbe, 0x54, 0x57, 0x81, 0x41, 0x8b, 0x05, 0xa1, 0xec, 0xef, 0x54, 0xc7, 0xd0, 0xc5,
+	0xe3, 0xb6, 0xcc, 0x1c, 0x6a, 0xf2, 0x30, 0xed, 0x3d, 0xd0, 0x78, 0x8e, 0xcb, 0xec, 0x1a, 0xa1,
+	0xc1, 0x79, 0xe6, 0x9f, 0xbb, 0x89, 0xad, 0x70, 0x85, 0x8a, 0x4b, 0xe1, 0x29, 0xab, 0xd2, 0xde,
+	0x82, 0x83, 0x99, 0xd5, 0xa5, 0xa6, 0x39, 0x28, 0x85, 0x3c, 0x22, 0x5f, 0x95, 0x0c, 0x4c, 0xfe,
+	0x19, 0x96, 0xa3, 0x5b, 0x8e, 0xd8, 0xb9, 0xe3, 0xf2, 0x0e, 0x4c, 0x74, 0xc6, 0xcb, 0xd2, 0x17,
+	0x61, 0xcc, 0x12, 0x5b, 0xb5, 0x48, 0xa8, 0x18, 0x99, 0xe9, 0xa2, 0x22, 0x41, 0x62, 0x97, 0x9a,
+	0xa1, 0xb6, 0x02, 0x93, 0x62, 0x20, 0x5b, 0xf5, 0xc0, 0xf2, 0x1d, 0x2f, 0x3a, 0xdf, 0xb9, 0xfd,
+	0x9d, 0x80, 0xa1, 0x7a, 0xab, 0x4d, 0x7c, 0xd1, 0x61, 0x53, 0x2c, 0xb4, 0x7f, 0x14, 0x98, 0xea,
+	0x92, 0x4b, 0x52, 0x36, 0x61, 0x5b, 0x90, 0xda, 0x97, 0x3d, 0x9b, 0xc9, 0x3a, 0x69, 0xe9, 0x3c,
+	0xf2, 0x26, 0xeb, 0xc8, 0x81, 0x16, 0x01, 0xb0, 0xeb, 0x32, 0x2b, 0x7d, 0xa1, 0x3d, 0xfa, 0x2d,
+	0xc8, 0x14, 0x29, 0x08, 0x7a, 0x01, 0x4a, 0x3e, 0xb9, 0xda, 0xa2, 0xf6, 0xe4, 0x40, 0x31, 0xb0,
+	0x0c, 0xd7, 0x5e, 0x93, 0x6d, 0x33, 0x49, 0x13, 0x3b, 0xd4, 0xa1, 0x8d, 0x65, 0xec, 0xe5, 0xb6,
+	0x6d, 0x12, 0x86, 0xb1, 0x65, 0xf1, 0xbf, 0x46, 0xd1, 0xb8, 0x78, 0xa9, 0x7d, 0x17, 0xb7, 0xae,
+	0x33, 0x9f, 0x6c, 0xdd, 0x34, 0xec, 0xb8, 0xb1, 0xe6, 0x84, 0xc4, 0x75, 0x82, 0xb0, 0xe6, 0xad,
+	0xe1, 0x80, 0xc4, 0xff, 0x3e, 0xc9, 0xf6, 0x6a, 0xb4, 0x8b, 0xf6, 0xc3, 0x58, 0xb2, 0x43, 0x6c,
+	0x5e, 0x64, 0xc4, 0x4c, 0x6f, 0xa1, 0x3d, 0x50, 0xb2, 0xb0, 0xe7, 0x11, 0x5b, 0x1e, 0x59, 0xb9,
+	0x42, 0xa7, 0x61, 0xd4, 0x8f, 0x4b, 0x4f, 0x0e, 0x16, 0xeb, 0xc5, 0x06, 0x22, 0x69, 0x47, 0x35,
+	0x1a, 0x84, 0x82, 0xb6, 0x27, 0xa3, 0x1d, 0x3f, 0xf5, 0xc3, 0x54, 0x97, 0x7c, 0xb2, 0x1d, 0x89,
+	0xff, 0x51, 0xfe, 0x3b, 0xff, 0xd3, 0xff, 0xa4, 0xfe, 0xe7, 0x1c, 0x0c, 0xcb, 0x53, 0xf5, 0x38,
+	0x5e, 0x2a, 0xc6, 0x3e, 0x35, 0x1b, 0x55, 0x91, 0x76, 0xe2, 0xb2, 0x8f, 0x6d, 0x12, 0xe4, 0xde,
+	0x3b, 0x57, 0x60, 0xbc, 0x23, 0x5c, 0x76, 0x7e, 0x11, 0x4a, 0x21, 0xdf, 0x91, 0xfe, 0xe3, 0x40,
+	0xd6, 0xe9, 0xe5, 0xd8, 0xf8, 0xd8, 0x08, 0xd8, 0xc6, 0xfd, 0x87, 0xa9, 0xed, 0x16, 0xe0, 0x71,
+	0x47, 0x81, 0x89, 0x4e, 0x80, 0x64, 0x52, 0x85, 0x61, 0x4b, 0x6c, 0x49, 0x2a, 0x99, 0x56, 0x4f,
+	0xa0, 0x25, 0x97, 0x18, 0x88, 0x16, 0x61, 0xc4, 0xa1, 0x21, 0xf1, 0xd7, 0xa5, 0x95, 0x8e, 0x46,
+	0x5e, 0x7c, 0x74, 0xe8, 0xf1, 0x47, 0x87, 0x7e, 0x56, 0x7e, 0x74, 0x54, 0x47, 0x22, 0xec, 0x67,
+	0x7f, 0xec, 0x53, 0xcc, 0x04, 0x34, 0xff, 0xfb, 0x38, 0x0c, 0x71, 0x76, 0xe8, 0x13, 0x05, 0x4a,
+	0xc2, 0x4f, 0x22, 0x3d, 0x8b, 0xc8, 0x56, 0x2b, 0xab, 0x1a, 0x85, 0xe3, 0x85, 0x74, 0xed, 0xc8,
+	0x07, 0xbf, 0xfc, 0xf5, 0x71, 0xff, 0x21, 0xa4, 0x19, 0xb9, 0xdf, 0x2e, 0xe8, 0x53, 0x05, 0x60,
+	0xc3, 0x46, 0xa2, 0x4a, 0x7e, 0xad, 0x94, 0xed, 0x55, 0xf5, 0xa2, 0xe1, 0x92, 0xd9, 0x73, 0x9c,
+	0xd9, 0x41, 0x74, 0x20, 0x93, 0x19, 0x67, 0xf2, 0xa5, 0x02, 0xa3, 0x49, 0x06, 0x74, 0xac, 0x50,
+	0xa1, 0x98, 0x56, 0xa5, 0x60, 0xb4, 0x64, 0x75, 0x9c, 0xb3, 0xaa, 0xa0, 0xa3, 0xb9, 0xac, 0x8c,
+	0x5b, 0x72, 0x0a, 0x6f, 0xa3, 0x1f, 0xd2, 0xfe, 0x3b, 0xb1, 0x8b, 0x68, 0xa1, 0x50, 0xe9, 0xcd,
+	0xd6, 0x54, 0x7d, 0xbe, 0x57, 0x98, 0xa4, 0xbe, 0xc4, 0xa9, 0xbf, 0x84, 0x5e, 0xcc, 0xa5, 0x5e,
+	0xab, 0xb7, 0x6b, 0xd2, 0xeb, 0x1a, 0xb7, 0x36, 0x6c, 0xf0, 0x6d, 0xf4, 0x8d, 0x02, 0xdb, 0x3b,
+	0x1d, 0x27, 0x9a, 0xcb, 0x65, 0xb3, 0xd9, 0xcf, 0xaa, 0xf3, 0xbd, 0x40, 0x7a, 0xea, 0x7b, 0x04,
+	0x49, 0xf5, 0xfd, 0x8b, 0x78, 0x2e, 0x22, 0xf7, 0x58, 0x60, 0x2e, 0x52, 0x26, 0x57, 0xad, 0x14,
+	0x8c, 0x96, 0xfc, 0xe6, 0x39, 0xbf, 0x63, 0xe8, 0x48, 0x16, 0xbf, 0xc8, 0x8d, 0xa6, 0xe8, 0xfd,
+	0xad, 0xc0, 0xde, 0x0c, 0x6b, 0x88, 0x5e, 0xce, 0xa5, 0x90, 0xe9, 0x68, 0xd5, 0xc5, 0xc7, 0xc6,
+	0x4b, 0x51, 0x17, 0xb9, 0xa8, 0x2a, 0x3a, 0x93, 0x25, 0x4a, 0x98, 0xd1, 0xda, 0x55, 0xe6, 0xd7,
+	0x48, 0x94, 0xa5, 0xe6, 0x50, 0xf9, 0x5f, 0x93, 0x92, 0xfa, 0x7d, 0xfc, 0x25, 0x9c, 0x76, 0x61,
+	0xe8, 0x44, 0xfe, 0x20, 0x6c, 0x35, 0x92, 0xea, 0x42, 0x8f, 0x28, 0x29, 0xa6, 0xca, 0xc5, 0x9c,
+	0x42, 0x27, 0xb3, 0xc4, 0xa4, 0x0d, 0xe1, 0x06, 0x7d, 0xe3, 0x16, 0xf7, 0xa4, 0xb7, 0xd1, 0x8f,
+	0xb1, 0x8c, 0xb4, 0xb3, 0x2a, 0x20, 0xa3, 0x8b, 0xb1, 0x53, 0x17, 0x7a, 0x44, 0x49, 0x19, 0xe7,
+	0xb8, 0x8c, 0x45, 0x74, 0x3a, 0x4b, 0x46, 0xe2, 0xa5, 0x6a, 0x16, 0xf6, 0xd2, 0x3a, 0xa4, 0x27,
+	0x4a, 0x29, 0x49, 0x9b, 0xa2, 0x02, 0x4a, 0xba, 0x78, 0x32, 0x75, 0xa1, 0x47, 0x54, 0x2f, 0x4a,
+	0x78, 0xe3, 0x6b, 0xeb, 0x02, 0xda, 0x55, 0xc9, 0x57, 0x0a, 0x8c, 0xa5, 0xec, 0x45, 0x81, 0xbf,
+	0xcc, 0x0e, 0xdb, 0xa2, 0x1a, 0x85, 0xe3, 0x25, 0xef, 0x13, 0x9c, 0xb7, 0x8e, 0x8e, 0x65, 0x9e,
+	0x0a, 0x8e, 0x49, 0x9d, 0x80, 0xaf, 0x15, 0xd8, 0x96, 0x36, 0x1f, 0x28, 0xbf, 0x6e, 0xa7, 0xaf,
+	0x51, 0x67, 0x8b, 0x03, 0x24, 0xd3, 0x05, 0xce, 0xd4, 0x40, 0x95, 0xcc, 0x4b, 0x49, 0x80, 0xba,
+	0x52, 0x95, 0x3e, 0xb2, 0x00, 0xd5, 0x8e, 0x4f, 0x50, 0x75, 0xb6, 0x38, 0xa0, 0x27, 0xaa, 0x02,
+	0xd4, 0x8d, 0x6a, 0x3c, 0xc1, 0xf9, 0x54, 0x37, 0x0d, 0xef, 0x6c, 0x71, 0x40, 0x2f, 0x54, 0xb7,
+	0x4c, 0x6c, 0xf5, 0x95, 0xbb, 0x0f, 0xca, 0xca, 0xbd, 0x07, 0x65, 0xe5, 0xcf, 0x07, 0x65, 0xe5,
+	0xa3, 0x87, 0xe5, 0xbe, 0x7b, 0x0f, 0xcb, 0x7d, 0xbf, 0x3e, 0x2c, 0xf7, 0xbd, 0x3d, 0xdb, 0x70,
+	0xc2, 0xb5, 0x56, 0x5d, 0xb7, 0x58, 0xf3, 0x51, 0x29, 0xd7, 0x8f, 0x1b, 0x37, 0xc5, 0x5c, 0xb5,
+	0x3d, 0x12, 0xd4, 0x4b, 0xdc, 0x52, 0x1e, 0xff, 0x77, 0x00, 0x03, 0x0e, 0xd1, 0xf3, 0x6a, 0x17,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the IRO module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// QueryPlans retrieves all available plans.
+	QueryPlans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error)
+	// QueryPlan retrieves the plan for the specified plan ID.
+	QueryPlan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error)
+	// QueryPlanByRollapp retrieves the plans for the specified rollapp ID.
+	QueryPlanByRollapp(ctx context.Context, in *QueryPlanByRollappRequest, opts ...grpc.CallOption) (*QueryPlanByRollappResponse, error)
+	// QuerySpotPrice retrieves the current spot price for the specified plan ID.
+	// The result is the price of 1 IRO token (not iro's base denom)
+	QuerySpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
+	// QueryCost retrieves the expected cost for buying or selling the specified
+	// amount of shares.
+	QueryCost(ctx context.Context, in *QueryCostRequest, opts ...grpc.CallOption) (*QueryCostResponse, error)
+	QueryTokensForExactInAmount(ctx context.Context, in *QueryTokensForExactInAmountRequest, opts ...grpc.CallOption) (*QueryTokensForExactInAmountResponse, error)
+	// QuerySubscription retrieves the subscription of the buyer to the fixed
+	// price sale of the plan.
+	QuerySubscription(ctx context.Context, in *QuerySubscriptionRequest, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error)
+	// QueryRemainingCap retrieves how many tokens the account can still buy
+	// from the plan under its whitelist.
+	QueryRemainingCap(ctx context.Context, in *QueryRemainingCapRequest, opts ...grpc.CallOption) (*QueryRemainingCapResponse, error)
+	// QueryBuyerVesting queries the claimable and vested amount of the tokens
+	// of the account under the buyer vesting plan of the plan.
+	QueryBuyerVesting(ctx context.Context, in *QueryBuyerVestingRequest, opts ...grpc.CallOption) (*QueryBuyerVestingResponse, error)
+	// QueryTrades retrieves the last trades of the plan, oldest first. They are
+	// kept until the plan is settled.
+	QueryTrades(ctx context.Context, in *QueryTradesRequest, opts ...grpc.CallOption) (*QueryTradesResponse, error)
+	// QueryCandles retrieves the candles of the price of the plan over the last
+	// intervals, oldest first. They are kept until the plan is settled.
+	QueryCandles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error)
+	// QueryClaimed retrieves the claimed amount thus far for the specified plan
+	// ID.
+	QueryClaimed(ctx context.Context, in *QueryClaimedRequest, opts ...grpc.CallOption) (*QueryClaimedResponse, error)
+	// QueryVesting queries the claimable and vested amount for
+	// the specified plan ID.
+	QueryVesting(ctx context.Context, in *QueryVestingRequest, opts ...grpc.CallOption) (*QueryVestingResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/Params", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryVestingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryPlans(ctx context.Context, in *QueryPlansRequest, opts ...grpc.CallOption) (*QueryPlansResponse, error) {
+	out := new(QueryPlansResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ClaimableAmount.Size()
-		i -= size
-		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.VestedAmount.Size()
-		i -= size
-		if _, err := m.VestedAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Total.Size()
-		i -= size
-		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) QueryPlan(ctx context.Context, in *QueryPlanRequest, opts ...grpc.CallOption) (*QueryPlanResponse, error) {
+	out := new(QueryPlanResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlan", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryPlanByRollapp(ctx context.Context, in *QueryPlanByRollappRequest, opts ...grpc.CallOption) (*QueryPlanByRollappResponse, error) {
+	out := new(QueryPlanByRollappResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryPlanByRollapp", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
+	out := new(QuerySpotPriceResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QuerySpotPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) QueryCost(ctx context.Context, in *QueryCostRequest, opts ...grpc.CallOption) (*QueryCostResponse, error) {
+	out := new(QueryCostResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryCost", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryTokensForExactInAmount(ctx context.Context, in *QueryTokensForExactInAmountRequest, opts ...grpc.CallOption) (*QueryTokensForExactInAmountResponse, error) {
+	out := new(QueryTokensForExactInAmountResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryTokensForExactInAmount", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QuerySubscription(ctx context.Context, in *QuerySubscriptionRequest, opts ...grpc.CallOption) (*QuerySubscriptionResponse, error) {
+	out := new(QuerySubscriptionResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QuerySubscription", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) QueryRemainingCap(ctx context.Context, in *QueryRemainingCapRequest, opts ...grpc.CallOption) (*QueryRemainingCapResponse, error) {
+	out := new(QueryRemainingCapResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryRemainingCap", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryBuyerVesting(ctx context.Context, in *QueryBuyerVestingRequest, opts ...grpc.CallOption) (*QueryBuyerVestingResponse, error) {
+	out := new(QueryBuyerVestingResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryBuyerVesting", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.NonSettledOnly {
-		i--
-		if m.NonSettledOnly {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) QueryTrades(ctx context.Context, in *QueryTradesRequest, opts ...grpc.CallOption) (*QueryTradesResponse, error) {
+	out := new(QueryTradesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryTrades", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryCandles(ctx context.Context, in *QueryCandlesRequest, opts ...grpc.CallOption) (*QueryCandlesResponse, error) {
+	out := new(QueryCandlesResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryCandles", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Plans) > 0 {
-		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) QueryClaimed(ctx context.Context, in *QueryClaimedRequest, opts ...grpc.CallOption) (*QueryClaimedResponse, error) {
+	out := new(QueryClaimedResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryClaimed", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) QueryVesting(ctx context.Context, in *QueryVestingRequest, opts ...grpc.CallOption) (*QueryVestingResponse, error) {
+	out := new(QueryVestingResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Query/QueryVesting", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the IRO module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// QueryPlans retrieves all available plans.
+	QueryPlans(context.Context, *QueryPlansRequest) (*QueryPlansResponse, error)
+	// QueryPlan retrieves the plan for the specified plan ID.
+	QueryPlan(context.Context, *QueryPlanRequest) (*QueryPlanResponse, error)
+	// QueryPlanByRollapp retrieves the plans for the specified rollapp ID.
+	QueryPlanByRollapp(context.Context, *QueryPlanByRollappRequest) (*QueryPlanByRollappResponse, error)
+	// QuerySpotPrice retrieves the current spot price for the specified plan ID.
+	// The result is the price of 1 IRO token (not iro's base denom)
+	QuerySpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
+	// QueryCost retrieves the expected cost for buying or selling the specified
+	// amount of shares.
+	QueryCost(context.Context, *QueryCostRequest) (*QueryCostResponse, error)
+	QueryTokensForExactInAmount(context.Context, *QueryTokensForExactInAmountRequest) (*QueryTokensForExactInAmountResponse, error)
+	// QuerySubscription retrieves the subscription of the buyer to the fixed
+	// price sale of the plan.
+	QuerySubscription(context.Context, *QuerySubscriptionRequest) (*QuerySubscriptionResponse, error)
+	// QueryRemainingCap retrieves how many tokens the account can still buy
+	// from the plan under its whitelist.
+	QueryRemainingCap(context.Context, *QueryRemainingCapRequest) (*QueryRemainingCapResponse, error)
+	// QueryBuyerVesting queries the claimable and vested amount of the tokens
+	// of the account under the buyer vesting plan of the plan.
+	QueryBuyerVesting(context.Context, *QueryBuyerVestingRequest) (*QueryBuyerVestingResponse, error)
+	// QueryTrades retrieves the last trades of the plan, oldest first. They are
+	// kept until the plan is settled.
+	QueryTrades(context.Context, *QueryTradesRequest) (*QueryTradesResponse, error)
+	// QueryCandles retrieves the candles of the price of the plan over the last
+	// intervals, oldest first. They are kept until the plan is settled.
+	QueryCandles(context.Context, *QueryCandlesRequest) (*QueryCandlesResponse, error)
+	// QueryClaimed retrieves the claimed amount thus far for the specified plan
+	// ID.
+	QueryClaimed(context.Context, *QueryClaimedRequest) (*QueryClaimedResponse, error)
+	// QueryVesting queries the claimable and vested amount for
+	// the specified plan ID.
+	QueryVesting(context.Context, *QueryVestingRequest) (*QueryVestingResponse, error)
 }
 
-func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) QueryPlans(ctx context.Context, req *QueryPlansRequest) (*QueryPlansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPlans not implemented")
+}
+func (*UnimplementedQueryServer) QueryPlan(ctx context.Context, req *QueryPlanRequest) (*QueryPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPlan not implemented")
+}
+func (*UnimplementedQueryServer) QueryPlanByRollapp(ctx context.Context, req *QueryPlanByRollappRequest) (*QueryPlanByRollappResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryPlanByRollapp not implemented")
+}
+func (*UnimplementedQueryServer) QuerySpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySpotPrice not implemented")
+}
+func (*UnimplementedQueryServer) QueryCost(ctx context.Context, req *QueryCostRequest) (*QueryCostResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCost not implemented")
+}
+func (*UnimplementedQueryServer) QueryTokensForExactInAmount(ctx context.Context, req *QueryTokensForExactInAmountRequest) (*QueryTokensForExactInAmountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTokensForExactInAmount not implemented")
+}
+func (*UnimplementedQueryServer) QuerySubscription(ctx context.Context, req *QuerySubscriptionRequest) (*QuerySubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuerySubscription not implemented")
+}
+func (*UnimplementedQueryServer) QueryRemainingCap(ctx context.Context, req *QueryRemainingCapRequest) (*QueryRemainingCapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryRemainingCap not implemented")
+}
+func (*UnimplementedQueryServer) QueryBuyerVesting(ctx context.Context, req *QueryBuyerVestingRequest) (*QueryBuyerVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryBuyerVesting not implemented")
+}
+func (*UnimplementedQueryServer) QueryTrades(ctx context.Context, req *QueryTradesRequest) (*QueryTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryTrades not implemented")
+}
+func (*UnimplementedQueryServer) QueryCandles(ctx context.Context, req *QueryCandlesRequest) (*QueryCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryCandles not implemented")
+}
+func (*UnimplementedQueryServer) QueryClaimed(ctx context.Context, req *QueryClaimedRequest) (*QueryClaimedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryClaimed not implemented")
+}
+func (*UnimplementedQueryServer) QueryVesting(ctx context.Context, req *QueryVestingRequest) (*QueryVestingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QueryVesting not implemented")
 }
 
-func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryPlanByRollappRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPlanByRollappRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlansRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPlans(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlans",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPlans(ctx, req.(*QueryPlansRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPlanByRollappRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RollappId) > 0 {
-		i -= len(m.RollappId)
-		copy(dAtA[i:], m.RollappId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
-		i--
-		dAtA[i] = 0xa
+func _Query_QueryPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPlan(ctx, req.(*QueryPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryPlanByRollappResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryPlanByRollapp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPlanByRollappRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryPlanByRollappResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryPlanByRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Plan != nil {
-		{
-			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).QueryPlanByRollapp(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryPlanByRollapp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryPlanByRollapp(ctx, req.(*QueryPlanByRollappRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QuerySpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySpotPriceRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySpotPrice(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QuerySpotPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySpotPrice(ctx, req.(*QuerySpotPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryCost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCostRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryCost",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCost(ctx, req.(*QueryCostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryTokensForExactInAmount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokensForExactInAmountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTokensForExactInAmount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryTokensForExactInAmount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTokensForExactInAmount(ctx, req.(*QueryTokensForExactInAmountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Price.Size()
-		i -= size
-		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_QuerySubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).QuerySubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QuerySubscription",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QuerySubscription(ctx, req.(*QuerySubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryRemainingCap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRemainingCapRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryRemainingCap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryRemainingCap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryRemainingCap(ctx, req.(*QueryRemainingCapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryBuyerVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBuyerVestingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryBuyerVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryBuyerVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryBuyerVesting(ctx, req.(*QueryBuyerVestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Sell {
-		i--
-		if m.Sell {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x18
+func _Query_QueryTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	{
-		size := m.Amt.Size()
-		i -= size
-		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(QueryServer).QueryTrades(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryTrades",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryTrades(ctx, req.(*QueryTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_QueryCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCandlesRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).QueryCandles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryCandles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryCandles(ctx, req.(*QueryCandlesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_QueryClaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryClaimed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryClaimed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryClaimed(ctx, req.(*QueryClaimedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Query_QueryVesting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVestingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).QueryVesting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Query/QueryVesting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).QueryVesting(ctx, req.(*QueryVestingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dymensionxyz.dymension.iro.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "QueryPlans",
+			Handler:    _Query_QueryPlans_Handler,
+		},
+		{
+			MethodName: "QueryPlan",
+			Handler:    _Query_QueryPlan_Handler,
+		},
+		{
+			MethodName: "QueryPlanByRollapp",
+			Handler:    _Query_QueryPlanByRollapp_Handler,
+		},
+		{
+			MethodName: "QuerySpotPrice",
+			Handler:    _Query_QuerySpotPrice_Handler,
+		},
+		{
+			MethodName: "QueryCost",
+			Handler:    _Query_QueryCost_Handler,
+		},
+		{
+			MethodName: "QueryTokensForExactInAmount",
+			Handler:    _Query_QueryTokensForExactInAmount_Handler,
+		},
+		{
+			MethodName: "QuerySubscription",
+			Handler:    _Query_QuerySubscription_Handler,
+		},
+		{
+			MethodName: "QueryRemainingCap",
+			Handler:    _Query_QueryRemainingCap_Handler,
+		},
+		{
+			MethodName: "QueryBuyerVesting",
+			Handler:    _Query_QueryBuyerVesting_Handler,
+		},
+		{
+			MethodName: "QueryTrades",
+			Handler:    _Query_QueryTrades_Handler,
+		},
+		{
+			MethodName: "QueryCandles",
+			Handler:    _Query_QueryCandles_Handler,
+		},
+		{
+			MethodName: "QueryClaimed",
+			Handler:    _Query_QueryClaimed_Handler,
+		},
+		{
+			MethodName: "QueryVesting",
+			Handler:    _Query_QueryVesting_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dymensionxyz/dymension/iro/query.proto",
+}
+
+func (m *QueryVestingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVestingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Cost != nil {
-		{
-			size, err := m.Cost.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokensForExactInAmountRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryVestingResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1884,37 +2182,57 @@ func (m *QueryTokensForExactInAmountRequest) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryTokensForExactInAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryVestingResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokensForExactInAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.Amt.Size()
+		size := m.ClaimableAmount.Size()
 		i -= size
-		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.VestedAmount.Size()
+		i -= size
+		if _, err := m.VestedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokensForExactInAmountResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1924,32 +2242,20 @@ func (m *QueryTokensForExactInAmountResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *QueryTokensForExactInAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryTokensForExactInAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Tokens != nil {
-		{
-			size, err := m.Tokens.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1959,27 +2265,30 @@ func (m *QueryClaimedRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PlanId) > 0 {
-		i -= len(m.PlanId)
-		copy(dAtA[i:], m.PlanId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryPlansRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1989,297 +2298,2630 @@ func (m *QueryClaimedResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryPlansRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryPlansRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ClaimedAmt != nil {
+	if m.Pagination != nil {
 		{
-			size := m.ClaimedAmt.Size()
-			i -= size
-			if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
 				return 0, err
 			}
+			i -= size
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.NonSettledOnly {
+		i--
+		if m.NonSettledOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryPlansResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryVestingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+
+func (m *QueryPlansResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryVestingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPlansResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = m.Total.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.VestedAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.ClaimableAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if len(m.Plans) > 0 {
+		for iNdEx := len(m.Plans) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Plans[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPlanRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPlanRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlansRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPlanRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.NonSettledOnly {
-		n += 2
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlansResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Plans) > 0 {
-		for _, e := range m.Plans {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryPlanRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPlanResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlanByRollappRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPlanByRollappRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanByRollappRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanByRollappRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RollappId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryPlanByRollappResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPlanByRollappResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPlanByRollappResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPlanByRollappResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Plan != nil {
-		l = m.Plan.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Plan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpotPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySpotPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QuerySpotPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QuerySpotPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryCostRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QuerySpotPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySpotPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.Amt.Size()
-	n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	if m.Sell {
-		n += 2
+		i--
+		if m.Sell {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	{
+		size := m.Amt.Size()
+		i -= size
+		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryCostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryCostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Cost != nil {
-		l = m.Cost.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Cost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokensForExactInAmountRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTokensForExactInAmountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokensForExactInAmountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokensForExactInAmountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	{
+		size := m.Amt.Size()
+		i -= size
+		if _, err := m.Amt.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	l = m.Amt.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryTokensForExactInAmountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryTokensForExactInAmountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokensForExactInAmountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokensForExactInAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Tokens != nil {
-		l = m.Tokens.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Tokens.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClaimedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryClaimedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.PlanId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ClaimedAmt != nil {
+		{
+			size := m.ClaimedAmt.Size()
+			i -= size
+			if _, err := m.ClaimedAmt.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscriptionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscriptionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscriptionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySubscriptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySubscriptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySubscriptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Allocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Subscription.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingCapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingCapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingCapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRemainingCapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRemainingCapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRemainingCapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Remaining.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Capped {
+		i--
+		if m.Capped {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Whitelisted {
+		i--
+		if m.Whitelisted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.WhitelistPhase {
+		i--
+		if m.WhitelistPhase {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuyerVestingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuyerVestingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuyerVestingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBuyerVestingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBuyerVestingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBuyerVestingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ClaimableAmount.Size()
+		i -= size
+		if _, err := m.ClaimableAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Claimed.Size()
+		i -= size
+		if _, err := m.Claimed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.VestedAmount.Size()
+		i -= size
+		if _, err := m.VestedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Total.Size()
+		i -= size
+		if _, err := m.Total.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for iNdEx := len(m.Trades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Trades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCandlesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCandlesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCandlesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Candles) > 0 {
+		for iNdEx := len(m.Candles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Candles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryVestingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.VestedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ClaimableAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPlansRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NonSettledOnly {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlansResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Plans) > 0 {
+		for _, e := range m.Plans {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanByRollappRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPlanByRollappResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Plan != nil {
+		l = m.Plan.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySpotPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Sell {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryCostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Cost != nil {
+		l = m.Cost.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokensForExactInAmountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Amt.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTokensForExactInAmountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tokens != nil {
+		l = m.Tokens.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClaimedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ClaimedAmt != nil {
+		l = m.ClaimedAmt.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubscriptionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySubscriptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Subscription.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Allocation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Refund.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRemainingCapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRemainingCapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.WhitelistPhase {
+		n += 2
+	}
+	if m.Whitelisted {
+		n += 2
+	}
+	if m.Capped {
+		n += 2
+	}
+	l = m.Remaining.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBuyerVestingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBuyerVestingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.VestedAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ClaimableAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Trades) > 0 {
+		for _, e := range m.Trades {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCandlesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCandlesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Candles) > 0 {
+		for _, e := range m.Candles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Interval)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryVestingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVestingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVestingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VestedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlansRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonSettledOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.NonSettledOnly = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlansResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Plans = append(m.Plans, Plan{})
+			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &Plan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanByRollappRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanByRollappRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanByRollappRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPlanByRollappResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPlanByRollappResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPlanByRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Plan == nil {
+				m.Plan = &Plan{}
+			}
+			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sell", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sell = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryClaimedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.ClaimedAmt != nil {
-		l = m.ClaimedAmt.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryVestingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCostResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2302,17 +4944,17 @@ func (m *QueryVestingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVestingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCostResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2322,23 +4964,27 @@ func (m *QueryVestingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			if m.Cost == nil {
+				m.Cost = &types.Coin{}
+			}
+			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2361,7 +5007,7 @@ func (m *QueryVestingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryVestingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTokensForExactInAmountRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2384,15 +5030,15 @@ func (m *QueryVestingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryVestingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTokensForExactInAmountRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTokensForExactInAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2420,11 +5066,11 @@ func (m *QueryVestingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2452,49 +5098,65 @@ func (m *QueryVestingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VestedAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokensForExactInAmountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.VestedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokensForExactInAmountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokensForExactInAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2504,23 +5166,25 @@ func (m *QueryVestingResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Tokens == nil {
+				m.Tokens = &types.Coin{}
+			}
+			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2545,7 +5209,7 @@ func (m *QueryVestingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2568,12 +5232,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2595,7 +5291,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClaimedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2618,17 +5314,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClaimedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2638,22 +5334,25 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			var v cosmossdk_io_math.Int
+			m.ClaimedAmt = &v
+			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2678,7 +5377,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySubscriptionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2701,17 +5400,17 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlansRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubscriptionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlansRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubscriptionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NonSettledOnly", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2721,17 +5420,29 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.NonSettledOnly = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2741,27 +5452,23 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2784,7 +5491,7 @@ func (m *QueryPlansRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySubscriptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2807,15 +5514,15 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlansResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySubscriptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlansResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySubscriptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plans", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subscription", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2842,14 +5549,13 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Plans = append(m.Plans, Plan{})
-			if err := m.Plans[len(m.Plans)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Subscription.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocation", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2876,68 +5582,15 @@ func (m *QueryPlansResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Allocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2947,23 +5600,24 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2986,7 +5640,7 @@ func (m *QueryPlanRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainingCapRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3009,17 +5663,17 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainingCapRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainingCapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3029,81 +5683,27 @@ func (m *QueryPlanResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plan == nil {
-				m.Plan = &Plan{}
-			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryPlanByRollappRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanByRollappRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanByRollappRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3131,7 +5731,7 @@ func (m *QueryPlanByRollappRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RollappId = string(dAtA[iNdEx:postIndex])
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3154,7 +5754,7 @@ func (m *QueryPlanByRollappRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPlanByRollappResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRemainingCapResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3177,15 +5777,75 @@ func (m *QueryPlanByRollappResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPlanByRollappResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemainingCapResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPlanByRollappResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemainingCapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistPhase", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WhitelistPhase = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelisted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Whitelisted = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Capped", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Capped = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Plan", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3212,10 +5872,7 @@ func (m *QueryPlanByRollappResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Plan == nil {
-				m.Plan = &Plan{}
-			}
-			if err := m.Plan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Remaining.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3240,7 +5897,7 @@ func (m *QueryPlanByRollappResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBuyerVestingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3263,10 +5920,10 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBuyerVestingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBuyerVestingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3301,59 +5958,9 @@ func (m *QuerySpotPriceRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QuerySpotPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3381,9 +5988,7 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Account = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3406,7 +6011,7 @@ func (m *QuerySpotPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBuyerVestingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3429,15 +6034,15 @@ func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBuyerVestingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBuyerVestingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3465,11 +6070,13 @@ func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PlanId = string(dAtA[iNdEx:postIndex])
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestedAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3497,15 +6104,15 @@ func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.VestedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sell", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3515,67 +6122,31 @@ func (m *QueryCostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Sell = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryCostResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCostResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3585,25 +6156,23 @@ func (m *QueryCostResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cost == nil {
-				m.Cost = &types.Coin{}
-			}
-			if err := m.Cost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClaimableAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3628,7 +6197,7 @@ func (m *QueryCostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokensForExactInAmountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTradesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3651,10 +6220,10 @@ func (m *QueryTokensForExactInAmountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokensForExactInAmountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTradesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokensForExactInAmountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3689,40 +6258,6 @@ func (m *QueryTokensForExactInAmountRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amt", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3744,7 +6279,7 @@ func (m *QueryTokensForExactInAmountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTokensForExactInAmountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTradesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3767,15 +6302,15 @@ func (m *QueryTokensForExactInAmountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTokensForExactInAmountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTradesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTokensForExactInAmountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tokens", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Trades", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3802,10 +6337,8 @@ func (m *QueryTokensForExactInAmountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Tokens == nil {
-				m.Tokens = &types.Coin{}
-			}
-			if err := m.Tokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Trades = append(m.Trades, Trade{})
+			if err := m.Trades[len(m.Trades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3830,7 +6363,7 @@ func (m *QueryTokensForExactInAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3853,10 +6386,10 @@ func (m *QueryClaimedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3912,7 +6445,7 @@ func (m *QueryClaimedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCandlesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3935,17 +6468,17 @@ func (m *QueryClaimedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCandlesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCandlesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedAmt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Candles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3955,25 +6488,56 @@ func (m *QueryClaimedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.Int
-			m.ClaimedAmt = &v
-			if err := m.ClaimedAmt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Candles = append(m.Candles, Candle{})
+			if err := m.Candles[len(m.Candles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Interval, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
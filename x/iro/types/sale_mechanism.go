@@ -0,0 +1,242 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+/*
+A plan sells its tokens with one of the sale mechanisms:
+- the bonding curve, the default, on which the tokens can be bought and sold back
+- a Dutch auction, at a price descending over time
+- a fixed price sale, where buyers subscribe and are allocated pro rata if it is oversubscribed
+
+The prices are per token in decimal representation, and the inputs and outputs in base denomination, as for
+the bonding curve, whose decimals are used by all the mechanisms.
+*/
+
+// SaleMechanism prices the tokens of a plan
+type SaleMechanism interface {
+	ValidateBasic() error
+	// SpotPrice returns the price of the next token once x tokens are sold
+	SpotPrice(x math.Int, now time.Time) math.LegacyDec
+	// Cost returns the cost of buying the tokens from x to x1, in liquidity
+	Cost(x, x1 math.Int, now time.Time) math.Int
+	// TokensForExactInAmount returns the amount of tokens bought from x by spending spendAmt
+	TokensForExactInAmount(x, spendAmt math.Int, now time.Time) (math.Int, error)
+	// MaxAmountToSell returns the amount of tokens which can be sold for the raised liquidity to bootstrap the
+	// pool at the last price
+	MaxAmountToSell(totalAllocation math.Int, liquidityPart math.LegacyDec) math.Int
+	// Sellable returns whether the tokens bought can be sold back before settlement
+	Sellable() bool
+}
+
+// SaleMechanism returns the sale mechanism of the plan
+func (p Plan) SaleMechanism() SaleMechanism {
+	switch {
+	case p.DutchAuction != nil:
+		return dutchAuctionSale{a: *p.DutchAuction, curve: p.BondingCurve, start: p.StartTime}
+	case p.FixedPriceSale != nil:
+		return flatPriceSale{price: p.FixedPriceSale.Price, curve: p.BondingCurve}
+	default:
+		return bondingCurveSale{p.BondingCurve}
+	}
+}
+
+// validateSaleMechanism checks at most one of the mechanisms other than the bonding curve is set
+func validateSaleMechanism(dutch *DutchAuction, fixed *FixedPriceSale) error {
+	if dutch != nil && fixed != nil {
+		return errors.New("only one sale mechanism can be set")
+	}
+	if dutch != nil {
+		if err := dutch.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "dutch auction")
+		}
+	}
+	if fixed != nil {
+		if err := fixed.ValidateBasic(); err != nil {
+			return errorsmod.Wrap(err, "fixed price sale")
+		}
+	}
+	return nil
+}
+
+func (a DutchAuction) ValidateBasic() error {
+	if a.EndPrice.IsNil() || !a.EndPrice.IsPositive() {
+		return fmt.Errorf("end price must be positive: %s", a.EndPrice)
+	}
+	if a.StartPrice.IsNil() || a.StartPrice.LT(a.EndPrice) {
+		return fmt.Errorf("start price must not be less than the end price: %s", a.StartPrice)
+	}
+	if a.Duration < 0 {
+		return fmt.Errorf("duration must not be negative: %s", a.Duration)
+	}
+	return nil
+}
+
+// Price returns the price at the given time, for an auction started at start. An auction whose trading is
+// not enabled yet, with a zero start, is at its start price.
+func (a DutchAuction) Price(start, now time.Time) math.LegacyDec {
+	elapsed := now.Sub(start)
+	if start.IsZero() || elapsed <= 0 {
+		return a.StartPrice
+	}
+	if a.Duration <= elapsed {
+		return a.EndPrice
+	}
+	drop := a.StartPrice.Sub(a.EndPrice).MulInt64(int64(elapsed)).QuoInt64(int64(a.Duration))
+	return a.StartPrice.Sub(drop)
+}
+
+func NewFixedPriceSale(price math.LegacyDec) FixedPriceSale {
+	return FixedPriceSale{
+		Price:         price,
+		Subscribed:    math.ZeroInt(),
+		Paid:          math.ZeroInt(),
+		Allocated:     math.ZeroInt(),
+		RefundReserve: math.ZeroInt(),
+	}
+}
+
+func (s FixedPriceSale) ValidateBasic() error {
+	if s.Price.IsNil() || !s.Price.IsPositive() {
+		return fmt.Errorf("price must be positive: %s", s.Price)
+	}
+	for _, x := range []math.Int{s.Subscribed, s.Paid, s.Allocated, s.RefundReserve} {
+		if x.IsNil() || x.IsNegative() {
+			return fmt.Errorf("subscription amounts must not be negative")
+		}
+	}
+	if s.Subscribed.LT(s.Allocated) {
+		return fmt.Errorf("allocated more than subscribed: %s > %s", s.Allocated, s.Subscribed)
+	}
+	return nil
+}
+
+// Allocation returns the tokens allocated to the subscription and the liquidity refunded, rounded down. They
+// are only known once the sale is settled.
+func (s FixedPriceSale) Allocation(sub Subscription) (tokens, refund math.Int) {
+	if !s.Subscribed.IsPositive() {
+		return math.ZeroInt(), sub.Paid
+	}
+	tokens = sub.Amount.Mul(s.Allocated).Quo(s.Subscribed)
+	refund = sub.Paid.Mul(s.Subscribed.Sub(s.Allocated)).Quo(s.Subscribed)
+	return tokens, refund
+}
+
+// Settle allocates up to available tokens to the subscriptions, and reserves the liquidity to refund
+func (s *FixedPriceSale) Settle(available math.Int) {
+	s.Allocated = math.MinInt(s.Subscribed, available)
+	if s.Subscribed.IsPositive() {
+		s.RefundReserve = s.Paid.Mul(s.Subscribed.Sub(s.Allocated)).Quo(s.Subscribed)
+	}
+}
+
+type bondingCurveSale struct {
+	curve BondingCurve
+}
+
+func (s bondingCurveSale) ValidateBasic() error {
+	return s.curve.ValidateBasic()
+}
+
+func (s bondingCurveSale) SpotPrice(x math.Int, _ time.Time) math.LegacyDec {
+	return s.curve.SpotPrice(x)
+}
+
+func (s bondingCurveSale) Cost(x, x1 math.Int, _ time.Time) math.Int {
+	return s.curve.Cost(x, x1)
+}
+
+func (s bondingCurveSale) TokensForExactInAmount(x, spendAmt math.Int, _ time.Time) (math.Int, error) {
+	return s.curve.TokensForExactInAmount(x, spendAmt)
+}
+
+func (s bondingCurveSale) MaxAmountToSell(totalAllocation math.Int, liquidityPart math.LegacyDec) math.Int {
+	return FindEquilibrium(s.curve, totalAllocation, liquidityPart)
+}
+
+func (s bondingCurveSale) Sellable() bool {
+	return true
+}
+
+// flatPriceSale sells all the tokens at the same price
+type flatPriceSale struct {
+	price math.LegacyDec
+	// for the decimals
+	curve BondingCurve
+}
+
+func (s flatPriceSale) ValidateBasic() error {
+	if s.price.IsNil() || !s.price.IsPositive() {
+		return fmt.Errorf("price must be positive: %s", s.price)
+	}
+	return nil
+}
+
+func (s flatPriceSale) SpotPrice(math.Int, time.Time) math.LegacyDec {
+	return s.price
+}
+
+func (s flatPriceSale) Cost(x, x1 math.Int, _ time.Time) math.Int {
+	tokens := ScaleFromBase(x1.Sub(x), s.curve.SupplyDecimals())
+	return ScaleToBase(tokens.Mul(s.price), s.curve.LiquidityDecimals())
+}
+
+func (s flatPriceSale) TokensForExactInAmount(_, spendAmt math.Int, _ time.Time) (math.Int, error) {
+	if !spendAmt.IsPositive() {
+		return math.ZeroInt(), errors.New("spend amount is not positive")
+	}
+	spend := ScaleFromBase(spendAmt, s.curve.LiquidityDecimals())
+	return ScaleToBase(spend.Quo(s.price), s.curve.SupplyDecimals()), nil
+}
+
+// MaxAmountToSell is the equilibrium of a curve with a constant price
+func (s flatPriceSale) MaxAmountToSell(totalAllocation math.Int, liquidityPart math.LegacyDec) math.Int {
+	return FindEquilibrium(BondingCurve{M: math.LegacyZeroDec(), C: s.price}, totalAllocation, liquidityPart)
+}
+
+func (s flatPriceSale) Sellable() bool {
+	return false
+}
+
+// dutchAuctionSale sells the tokens at the price of the auction at the time
+type dutchAuctionSale struct {
+	a     DutchAuction
+	curve BondingCurve
+	start time.Time
+}
+
+func (s dutchAuctionSale) at(now time.Time) flatPriceSale {
+	return flatPriceSale{price: s.a.Price(s.start, now), curve: s.curve}
+}
+
+func (s dutchAuctionSale) ValidateBasic() error {
+	return s.a.ValidateBasic()
+}
+
+func (s dutchAuctionSale) SpotPrice(x math.Int, now time.Time) math.LegacyDec {
+	return s.at(now).SpotPrice(x, now)
+}
+
+func (s dutchAuctionSale) Cost(x, x1 math.Int, now time.Time) math.Int {
+	return s.at(now).Cost(x, x1, now)
+}
+
+func (s dutchAuctionSale) TokensForExactInAmount(x, spendAmt math.Int, now time.Time) (math.Int, error) {
+	return s.at(now).TokensForExactInAmount(x, spendAmt, now)
+}
+
+// MaxAmountToSell is computed for the end price, which the pool is bootstrapped at if the auction ends before
+// settlement. The tokens sold above it only raise more liquidity.
+func (s dutchAuctionSale) MaxAmountToSell(totalAllocation math.Int, liquidityPart math.LegacyDec) math.Int {
+	return flatPriceSale{price: s.a.EndPrice, curve: s.curve}.MaxAmountToSell(totalAllocation, liquidityPart)
+}
+
+func (s dutchAuctionSale) Sellable() bool {
+	return false
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+)
+
+// NewSoftCap returns a soft cap of the amount to raise by the deadline
+func NewSoftCap(amount math.Int, deadline time.Time) SoftCap {
+	return SoftCap{
+		Amount:          amount,
+		Deadline:        deadline,
+		RefundSupply:    math.ZeroInt(),
+		RefundLiquidity: math.ZeroInt(),
+		Raised:          math.ZeroInt(),
+	}
+}
+
+func (s SoftCap) ValidateBasic() error {
+	if s.Amount.IsNil() || !s.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive: %s", s.Amount)
+	}
+	if s.Deadline.IsZero() {
+		return errors.New("deadline must be set")
+	}
+	if s.RefundSupply.IsNil() || s.RefundSupply.IsNegative() {
+		return fmt.Errorf("refund supply must not be negative: %s", s.RefundSupply)
+	}
+	if s.RefundLiquidity.IsNil() || s.RefundLiquidity.IsNegative() {
+		return fmt.Errorf("refund liquidity must not be negative: %s", s.RefundLiquidity)
+	}
+	if s.Raised.IsNil() || s.Raised.IsNegative() {
+		return fmt.Errorf("raised must not be negative: %s", s.Raised)
+	}
+	if !s.Refunding && (!s.RefundSupply.IsZero() || !s.RefundLiquidity.IsZero()) {
+		return errors.New("refund amounts must be zero until refunding")
+	}
+	return nil
+}
+
+// Refund returns the liquidity to refund for amt of the tokens left to refund, pro rata
+func (s SoftCap) Refund(amt math.Int) math.Int {
+	if !s.RefundSupply.IsPositive() {
+		return math.ZeroInt()
+	}
+	return s.RefundLiquidity.Mul(amt).Quo(s.RefundSupply)
+}
+
+// IsRefunding returns whether the plan missed its soft cap and entered the refund state
+func (p Plan) IsRefunding() bool {
+	return p.SoftCap != nil && p.SoftCap.Refunding
+}
+
+// SoftCapMissed returns whether the plan missed its soft cap at the given time. If settling, the soft cap must
+// be reached even before the deadline.
+func (p Plan) SoftCapMissed(now time.Time, settling bool) bool {
+	if p.SoftCap == nil || p.IsSettled() {
+		return false
+	}
+	if p.SoftCap.Refunding {
+		return true
+	}
+	return p.SoftCap.Raised.LT(p.SoftCap.Amount) && (settling || !now.Before(p.SoftCap.Deadline))
+}
+
+// AddRaised adds the net liquidity of a trade to the liquidity raised toward the soft cap of the plan, if any.
+// It is negative for a sell.
+func (p *Plan) AddRaised(amt math.Int) {
+	if p.SoftCap != nil {
+		p.SoftCap.Raised = p.SoftCap.Raised.Add(amt)
+	}
+}
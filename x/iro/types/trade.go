@@ -0,0 +1,63 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (t Trade) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(t.Trader); err != nil {
+		return fmt.Errorf("invalid trader address: %w", err)
+	}
+	if t.Amount.IsNil() || !t.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive: %s", t.Amount)
+	}
+	if t.Cost.IsNil() || t.Cost.IsNegative() {
+		return fmt.Errorf("cost must not be negative: %s", t.Cost)
+	}
+	if t.ClosingPrice.IsNil() || t.ClosingPrice.IsNegative() {
+		return fmt.Errorf("closing price must not be negative: %s", t.ClosingPrice)
+	}
+	return nil
+}
+
+// NewCandle returns the candle of the plan starting at the time, opening at the price
+func NewCandle(planId uint64, startTime time.Time, open math.LegacyDec) Candle {
+	return Candle{
+		PlanId:    planId,
+		StartTime: startTime,
+		Open:      open,
+		High:      open,
+		Low:       open,
+		Close:     open,
+		Volume:    math.ZeroInt(),
+	}
+}
+
+// AddTrade adds a trade of amt tokens closing at the price to the candle
+func (c *Candle) AddTrade(amt math.Int, closingPrice math.LegacyDec) {
+	c.High = math.LegacyMaxDec(c.High, closingPrice)
+	c.Low = math.LegacyMinDec(c.Low, closingPrice)
+	c.Close = closingPrice
+	c.Volume = c.Volume.Add(amt)
+	c.Trades++
+}
+
+func (c Candle) ValidateBasic() error {
+	for _, p := range []math.LegacyDec{c.Open, c.High, c.Low, c.Close} {
+		if p.IsNil() || p.IsNegative() {
+			return errors.New("prices must not be negative")
+		}
+	}
+	if c.Low.GT(c.High) || c.Open.GT(c.High) || c.Close.GT(c.High) || c.Open.LT(c.Low) || c.Close.LT(c.Low) {
+		return fmt.Errorf("prices must be between the low and the high: %s, %s", c.Low, c.High)
+	}
+	if c.Volume.IsNil() || c.Volume.IsNegative() {
+		return fmt.Errorf("volume must not be negative: %s", c.Volume)
+	}
+	return nil
+}
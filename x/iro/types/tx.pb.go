@@ -155,6 +155,17 @@ type MsgCreatePlan struct {
 	LiquidityDenom                  string                      `protobuf:"bytes,10,opt,name=liquidity_denom,json=liquidityDenom,proto3" json:"liquidity_denom,omitempty"`
 	VestingDuration                 time.Duration               `protobuf:"bytes,11,opt,name=vesting_duration,json=vestingDuration,proto3,stdduration" json:"vesting_duration" yaml:"vesting_duration"`
 	VestingStartTimeAfterSettlement time.Duration               `protobuf:"bytes,12,opt,name=vesting_start_time_after_settlement,json=vestingStartTimeAfterSettlement,proto3,stdduration" json:"vesting_start_time_after_settlement" yaml:"vesting_start_time_after_settlement"`
+	// The sale mechanism, at most one of them. The bonding curve is used if none
+	// is set, otherwise only its decimals are used.
+	DutchAuction *DutchAuction `protobuf:"bytes,13,opt,name=dutch_auction,json=dutchAuction,proto3" json:"dutch_auction,omitempty"`
+	// only the price is set
+	FixedPriceSale *FixedPriceSale `protobuf:"bytes,14,opt,name=fixed_price_sale,json=fixedPriceSale,proto3" json:"fixed_price_sale,omitempty"`
+	// whitelist of the buyers, optional
+	Whitelist *Whitelist `protobuf:"bytes,15,opt,name=whitelist,proto3" json:"whitelist,omitempty"`
+	// soft cap of the plan, optional. Only the amount and the deadline are set.
+	SoftCap *SoftCap `protobuf:"bytes,16,opt,name=soft_cap,json=softCap,proto3" json:"soft_cap,omitempty"`
+	// buyer vesting plan, optional. Only the cliff and the duration are set.
+	BuyerVestingPlan *BuyerVestingPlan `protobuf:"bytes,17,opt,name=buyer_vesting_plan,json=buyerVestingPlan,proto3" json:"buyer_vesting_plan,omitempty"`
 }
 
 func (m *MsgCreatePlan) Reset()         { *m = MsgCreatePlan{} }
@@ -260,6 +271,41 @@ func (m *MsgCreatePlan) GetVestingStartTimeAfterSettlement() time.Duration {
 	return 0
 }
 
+func (m *MsgCreatePlan) GetDutchAuction() *DutchAuction {
+	if m != nil {
+		return m.DutchAuction
+	}
+	return nil
+}
+
+func (m *MsgCreatePlan) GetFixedPriceSale() *FixedPriceSale {
+	if m != nil {
+		return m.FixedPriceSale
+	}
+	return nil
+}
+
+func (m *MsgCreatePlan) GetWhitelist() *Whitelist {
+	if m != nil {
+		return m.Whitelist
+	}
+	return nil
+}
+
+func (m *MsgCreatePlan) GetSoftCap() *SoftCap {
+	if m != nil {
+		return m.SoftCap
+	}
+	return nil
+}
+
+func (m *MsgCreatePlan) GetBuyerVestingPlan() *BuyerVestingPlan {
+	if m != nil {
+		return m.BuyerVestingPlan
+	}
+	return nil
+}
+
 type MsgCreatePlanResponse struct {
 	// The ID of the plan.
 	PlanId string `protobuf:"bytes,1,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
@@ -310,6 +356,8 @@ type MsgEnableTrading struct {
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	// The ID of the plan.
 	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+	// whitelist replaces the whitelist of the plan if set
+	Whitelist *Whitelist `protobuf:"bytes,3,opt,name=whitelist,proto3" json:"whitelist,omitempty"`
 }
 
 func (m *MsgEnableTrading) Reset()         { *m = MsgEnableTrading{} }
@@ -359,6 +407,13 @@ func (m *MsgEnableTrading) GetPlanId() string {
 	return ""
 }
 
+func (m *MsgEnableTrading) GetWhitelist() *Whitelist {
+	if m != nil {
+		return m.Whitelist
+	}
+	return nil
+}
+
 type MsgEnableTradingResponse struct {
 }
 
@@ -404,6 +459,9 @@ type MsgBuy struct {
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
 	// The maximum cost this buy action can incur.
 	MaxCostAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=max_cost_amount,json=maxCostAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_cost_amount"`
+	// whitelist_proof proves the buyer is in the Merkle tree of the whitelist of
+	// the plan. Only needed once, in the whitelist phase.
+	WhitelistProof [][]byte `protobuf:"bytes,5,rep,name=whitelist_proof,json=whitelistProof,proto3" json:"whitelist_proof,omitempty"`
 }
 
 func (m *MsgBuy) Reset()         { *m = MsgBuy{} }
@@ -453,6 +511,13 @@ func (m *MsgBuy) GetPlanId() string {
 	return ""
 }
 
+func (m *MsgBuy) GetWhitelistProof() [][]byte {
+	if m != nil {
+		return m.WhitelistProof
+	}
+	return nil
+}
+
 type MsgBuyExactSpend struct {
 	Buyer string `protobuf:"bytes,1,opt,name=buyer,proto3" json:"buyer,omitempty"`
 	// The ID of the plan.
@@ -461,6 +526,9 @@ type MsgBuyExactSpend struct {
 	Spend cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=spend,proto3,customtype=cosmossdk.io/math.Int" json:"spend"`
 	// The minimum tokens this buy action can provide.
 	MinOutTokensAmount cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_out_tokens_amount,json=minOutTokensAmount,proto3,customtype=cosmossdk.io/math.Int" json:"min_out_tokens_amount"`
+	// whitelist_proof proves the buyer is in the Merkle tree of the whitelist of
+	// the plan. Only needed once, in the whitelist phase.
+	WhitelistProof [][]byte `protobuf:"bytes,5,rep,name=whitelist_proof,json=whitelistProof,proto3" json:"whitelist_proof,omitempty"`
 }
 
 func (m *MsgBuyExactSpend) Reset()         { *m = MsgBuyExactSpend{} }
@@ -510,6 +578,13 @@ func (m *MsgBuyExactSpend) GetPlanId() string {
 	return ""
 }
 
+func (m *MsgBuyExactSpend) GetWhitelistProof() [][]byte {
+	if m != nil {
+		return m.WhitelistProof
+	}
+	return nil
+}
+
 type MsgBuyResponse struct {
 }
 
@@ -820,6 +895,97 @@ func (m *MsgClaimVestedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimVestedResponse proto.InternalMessageInfo
 
+// MsgRefund defines a message to burn the IRO tokens of a plan which missed its
+// soft cap, or give up the subscription to it, for a refund.
+type MsgRefund struct {
+	Refunder string `protobuf:"bytes,1,opt,name=refunder,proto3" json:"refunder,omitempty"`
+	// The ID of the plan.
+	PlanId string `protobuf:"bytes,2,opt,name=plan_id,json=planId,proto3" json:"plan_id,omitempty"`
+}
+
+func (m *MsgRefund) Reset()         { *m = MsgRefund{} }
+func (m *MsgRefund) String() string { return proto.CompactTextString(m) }
+func (*MsgRefund) ProtoMessage()    {}
+func (*MsgRefund) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{15}
+}
+func (m *MsgRefund) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefund) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefund.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefund) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefund.Merge(m, src)
+}
+func (m *MsgRefund) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefund) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefund.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefund proto.InternalMessageInfo
+
+func (m *MsgRefund) GetRefunder() string {
+	if m != nil {
+		return m.Refunder
+	}
+	return ""
+}
+
+func (m *MsgRefund) GetPlanId() string {
+	if m != nil {
+		return m.PlanId
+	}
+	return ""
+}
+
+type MsgRefundResponse struct {
+}
+
+func (m *MsgRefundResponse) Reset()         { *m = MsgRefundResponse{} }
+func (m *MsgRefundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRefundResponse) ProtoMessage()    {}
+func (*MsgRefundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41b9ae3e091bbd60, []int{16}
+}
+func (m *MsgRefundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRefundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRefundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRefundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRefundResponse.Merge(m, src)
+}
+func (m *MsgRefundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRefundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRefundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRefundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgUpdateParams)(nil), "dymensionxyz.dymension.iro.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dymensionxyz.dymension.iro.MsgUpdateParamsResponse")
@@ -836,6 +1002,8 @@ func init() {
 	proto.RegisterType((*MsgClaimResponse)(nil), "dymensionxyz.dymension.iro.MsgClaimResponse")
 	proto.RegisterType((*MsgClaimVested)(nil), "dymensionxyz.dymension.iro.MsgClaimVested")
 	proto.RegisterType((*MsgClaimVestedResponse)(nil), "dymensionxyz.dymension.iro.MsgClaimVestedResponse")
+	proto.RegisterType((*MsgRefund)(nil), "dymensionxyz.dymension.iro.MsgRefund")
+	proto.RegisterType((*MsgRefundResponse)(nil), "dymensionxyz.dymension.iro.MsgRefundResponse")
 }
 
 func init() {
@@ -843,82 +1011,96 @@ func init() {
 }
 
 var fileDescriptor_41b9ae3e091bbd60 = []byte{
-	// 1198 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x13, 0xc7,
-	0x1b, 0xce, 0x42, 0xe2, 0xc4, 0x6f, 0xfe, 0xb2, 0x3f, 0xf2, 0xcb, 0xb2, 0x55, 0x1d, 0xb4, 0x41,
-	0x22, 0x0d, 0xb0, 0x4b, 0x42, 0xc5, 0x81, 0x5b, 0x9c, 0xa0, 0x2a, 0x55, 0x23, 0x90, 0x0d, 0x14,
-	0xb5, 0x52, 0x57, 0xe3, 0xdd, 0x61, 0x99, 0xb2, 0x3b, 0xe3, 0xee, 0xcc, 0x06, 0xbb, 0xa7, 0xaa,
-	0xc7, 0x9e, 0x38, 0xf6, 0x56, 0xa9, 0x9f, 0x80, 0x03, 0xdf, 0xa1, 0x1c, 0x29, 0xa7, 0xaa, 0x07,
-	0x5a, 0xc1, 0x81, 0x7b, 0x2f, 0x3d, 0xb6, 0x9a, 0x99, 0xdd, 0xb5, 0x1d, 0x1a, 0xdb, 0xa1, 0xe5,
-	0x14, 0xcf, 0xcc, 0x33, 0xcf, 0xf3, 0xfa, 0x79, 0xff, 0x64, 0x0c, 0x6b, 0x61, 0x37, 0xc1, 0x94,
-	0x13, 0x46, 0x3b, 0xdd, 0xaf, 0xbd, 0x72, 0xe1, 0x91, 0x94, 0x79, 0xa2, 0xe3, 0xb6, 0x53, 0x26,
-	0x98, 0x69, 0xf7, 0x83, 0xdc, 0x72, 0xe1, 0x92, 0x94, 0xd9, 0xa7, 0x23, 0x16, 0x31, 0x05, 0xf3,
-	0xe4, 0x27, 0x7d, 0xc3, 0x3e, 0x13, 0x30, 0x9e, 0x30, 0xee, 0xeb, 0x03, 0xbd, 0xc8, 0x8f, 0x56,
-	0xf4, 0xca, 0x4b, 0x78, 0xe4, 0x1d, 0x6c, 0xca, 0x3f, 0xf9, 0xc1, 0xb9, 0x21, 0xa1, 0x90, 0xb4,
-	0x60, 0xae, 0x45, 0x8c, 0x45, 0x31, 0xf6, 0xd4, 0xaa, 0x95, 0xdd, 0xf3, 0xc2, 0x2c, 0x45, 0x42,
-	0x46, 0xa3, 0xcf, 0x57, 0x0f, 0x9f, 0x0b, 0x92, 0x60, 0x2e, 0x50, 0xd2, 0x2e, 0x08, 0x72, 0xfd,
-	0x16, 0xe2, 0xd8, 0x3b, 0xd8, 0x6c, 0x61, 0x81, 0x36, 0xbd, 0x80, 0x91, 0x82, 0xe0, 0xfc, 0x90,
-	0x30, 0xda, 0x28, 0x45, 0x49, 0xfe, 0x45, 0x9c, 0x1f, 0x0d, 0x58, 0xdc, 0xe7, 0xd1, 0xed, 0x76,
-	0x88, 0x04, 0xbe, 0xa9, 0x4e, 0xcc, 0xab, 0x50, 0x45, 0x99, 0xb8, 0xcf, 0x52, 0x22, 0xba, 0x96,
-	0x71, 0xd6, 0x58, 0xaf, 0xd6, 0xad, 0xe7, 0x4f, 0x2e, 0x9d, 0xce, 0x1d, 0xd8, 0x0e, 0xc3, 0x14,
-	0x73, 0xde, 0x14, 0x29, 0xa1, 0x51, 0xa3, 0x07, 0x35, 0x3f, 0x02, 0xa0, 0xf8, 0xa1, 0xaf, 0xf9,
-	0xad, 0x13, 0x67, 0x8d, 0xf5, 0xd9, 0x2d, 0xc7, 0x3d, 0xda, 0x76, 0x57, 0xeb, 0xd5, 0x27, 0x9f,
-	0xbe, 0x58, 0x9d, 0x68, 0x54, 0x29, 0x7e, 0xa8, 0x37, 0xae, 0x2d, 0x7c, 0xfb, 0xfa, 0xf1, 0x46,
-	0x8f, 0xd8, 0x39, 0x03, 0x2b, 0x87, 0x62, 0x6c, 0x60, 0xde, 0x66, 0x94, 0x63, 0xe7, 0xe7, 0x69,
-	0x98, 0xdf, 0xe7, 0xd1, 0x4e, 0x8a, 0xe5, 0x59, 0x8c, 0xa8, 0xe9, 0xc2, 0x14, 0x7b, 0x48, 0x71,
-	0x3a, 0x32, 0x72, 0x0d, 0x33, 0xdf, 0x07, 0x48, 0x59, 0x1c, 0xa3, 0x76, 0xdb, 0x27, 0xa1, 0x8a,
-	0xba, 0xda, 0xa8, 0xe6, 0x3b, 0x7b, 0xa1, 0x79, 0x07, 0x96, 0x50, 0x1c, 0xb3, 0x00, 0x09, 0x1c,
-	0xfa, 0x28, 0x61, 0x19, 0x15, 0xd6, 0x49, 0xc5, 0x7c, 0x41, 0x86, 0xfd, 0xeb, 0x8b, 0xd5, 0x65,
-	0xcd, 0xce, 0xc3, 0x07, 0x2e, 0x61, 0x5e, 0x82, 0xc4, 0x7d, 0x77, 0x8f, 0x8a, 0xe7, 0x4f, 0x2e,
-	0x41, 0x2e, 0xbb, 0x47, 0x45, 0x63, 0xb1, 0x24, 0xd9, 0x56, 0x1c, 0x66, 0x13, 0xe6, 0x5b, 0x8c,
-	0x86, 0x84, 0x46, 0x7e, 0x90, 0xa5, 0x07, 0xd8, 0x9a, 0x54, 0x7e, 0xad, 0x0f, 0xf3, 0xab, 0xae,
-	0x2f, 0xec, 0x48, 0x7c, 0xee, 0xda, 0x5c, 0xab, 0x6f, 0xcf, 0x3c, 0x0f, 0x8b, 0x22, 0x45, 0x8a,
-	0x14, 0x53, 0xd4, 0x8a, 0x71, 0x68, 0x4d, 0x9d, 0x35, 0xd6, 0x67, 0x1a, 0x0b, 0xf9, 0xf6, 0x75,
-	0xbd, 0x6b, 0xee, 0x00, 0x70, 0x81, 0x52, 0xe1, 0xcb, 0xc2, 0xb2, 0x2a, 0x4a, 0xda, 0x76, 0x75,
-	0xd5, 0xb9, 0x45, 0xd5, 0xb9, 0xb7, 0x8a, 0xaa, 0xab, 0xcf, 0x48, 0xb1, 0x47, 0xbf, 0xad, 0x1a,
-	0x8d, 0xaa, 0xba, 0x27, 0x4f, 0xcc, 0x1b, 0x70, 0x8a, 0xa4, 0xcc, 0x6f, 0xc7, 0x88, 0xfa, 0x45,
-	0x01, 0x5b, 0xd3, 0x8a, 0xeb, 0xcc, 0x1b, 0x5c, 0xbb, 0x39, 0x40, 0x53, 0x7d, 0x2f, 0xa9, 0x16,
-	0x49, 0xca, 0x64, 0xca, 0x8a, 0x23, 0x93, 0xc0, 0x32, 0xa1, 0x01, 0xa6, 0x82, 0x1c, 0x60, 0x4d,
-	0x9b, 0xd7, 0xd2, 0x8c, 0x22, 0xf5, 0x86, 0x79, 0xb3, 0x57, 0x5c, 0x94, 0x8c, 0x03, 0x85, 0xf5,
-	0x3f, 0xf2, 0xe6, 0x91, 0x79, 0x17, 0x16, 0x62, 0xf2, 0x55, 0x46, 0x42, 0x22, 0xba, 0x52, 0x45,
-	0x58, 0x55, 0x95, 0xd4, 0xcd, 0x3c, 0xa9, 0xef, 0xbd, 0x99, 0xd4, 0x4f, 0x70, 0x84, 0x82, 0xee,
-	0x2e, 0x0e, 0xfa, 0x52, 0xbb, 0x8b, 0x83, 0xc6, 0x7c, 0x49, 0x74, 0x13, 0xa5, 0x42, 0xe6, 0xa0,
-	0xc7, 0x1c, 0x62, 0xca, 0x12, 0x0b, 0x54, 0x51, 0xf5, 0x04, 0x77, 0xe5, 0xae, 0x49, 0x60, 0xe9,
-	0x00, 0x73, 0x21, 0x93, 0x55, 0xba, 0x37, 0x3b, 0xca, 0xbd, 0x35, 0x19, 0xdf, 0x1f, 0x2f, 0x56,
-	0x57, 0xba, 0x28, 0x89, 0xaf, 0x39, 0x87, 0x09, 0x1c, 0x6d, 0x6c, 0xbe, 0x5d, 0x1a, 0xfb, 0x83,
-	0x01, 0x6b, 0x05, 0xb4, 0x97, 0x77, 0x1f, 0xdd, 0x13, 0x38, 0xf5, 0x39, 0x16, 0x22, 0xc6, 0x09,
-	0xa6, 0xc2, 0x9a, 0x1b, 0x25, 0x7f, 0x35, 0x97, 0xdf, 0x18, 0x94, 0x1f, 0xc2, 0xa9, 0x23, 0x5a,
-	0xcd, 0x91, 0xcd, 0xa2, 0x78, 0xb6, 0x25, 0xac, 0x59, 0xa2, 0xae, 0x81, 0x6c, 0x79, 0xdd, 0x91,
-	0xce, 0x65, 0x58, 0x1e, 0x68, 0xe9, 0xa2, 0xd9, 0xcd, 0x15, 0x98, 0x56, 0x55, 0x41, 0x42, 0xdd,
-	0xdc, 0x8d, 0x8a, 0x5c, 0xee, 0x85, 0x4e, 0x04, 0x4b, 0xfb, 0x3c, 0x2f, 0xee, 0x5b, 0xba, 0xd2,
-	0x8f, 0x3d, 0x07, 0xfa, 0xc8, 0x4f, 0xf4, 0x93, 0x0f, 0x84, 0x66, 0x83, 0x75, 0x58, 0xa8, 0x1c,
-	0x45, 0x7f, 0x1a, 0x50, 0xd9, 0xe7, 0x51, 0x3d, 0xeb, 0x4a, 0xed, 0x56, 0xd6, 0x1d, 0x47, 0x5b,
-	0xc1, 0x8e, 0xd4, 0x36, 0x77, 0xa0, 0xf2, 0xf6, 0x33, 0x27, 0xbf, 0x6a, 0x36, 0x61, 0x31, 0x41,
-	0x1d, 0x3f, 0x60, 0x5c, 0x14, 0x13, 0x6c, 0xf2, 0xf8, 0x6c, 0xf3, 0x09, 0xea, 0xec, 0x30, 0x2e,
-	0xf4, 0xfc, 0xca, 0x5d, 0x51, 0xe1, 0x3b, 0xdf, 0x9d, 0x50, 0xfe, 0xd7, 0xb3, 0xee, 0xf5, 0x0e,
-	0x0a, 0x44, 0xb3, 0x8d, 0x69, 0xf8, 0xdf, 0x79, 0xb0, 0x0d, 0x53, 0x5c, 0x32, 0xbe, 0x8d, 0x05,
-	0xfa, 0xa6, 0xf9, 0x05, 0x2c, 0x27, 0x84, 0xfa, 0x2c, 0x13, 0xbe, 0x60, 0x0f, 0x30, 0xe5, 0xff,
-	0xc2, 0x07, 0x33, 0x21, 0xf4, 0x46, 0x26, 0x6e, 0x29, 0x9e, 0x7f, 0x30, 0x63, 0x09, 0x16, 0xb4,
-	0x17, 0x65, 0x61, 0xfc, 0x65, 0xc0, 0xf4, 0x3e, 0x8f, 0x9a, 0x38, 0x8e, 0xcd, 0xcb, 0x50, 0xe1,
-	0x38, 0x8e, 0xc7, 0xb0, 0x25, 0xc7, 0xbd, 0xe3, 0xda, 0xf8, 0x14, 0x4e, 0x49, 0x67, 0x08, 0x0d,
-	0x98, 0x6c, 0xde, 0xb7, 0x76, 0x65, 0x31, 0x21, 0x74, 0x4f, 0x91, 0xe4, 0x96, 0xcc, 0x4a, 0x4b,
-	0xf2, 0xef, 0xe0, 0x9c, 0x52, 0x8f, 0x0c, 0x69, 0x40, 0x69, 0x0a, 0x86, 0x19, 0xd9, 0xe4, 0x31,
-	0x22, 0x89, 0xb9, 0x05, 0xd3, 0x81, 0xfc, 0x30, 0x86, 0x2b, 0x05, 0xf0, 0xe8, 0x76, 0x9d, 0x93,
-	0xc2, 0x05, 0xcc, 0x31, 0x55, 0x65, 0x2a, 0x99, 0x52, 0xfa, 0x81, 0xca, 0x90, 0xda, 0xbb, 0x83,
-	0xb9, 0xc0, 0xe1, 0xbb, 0x0c, 0xc0, 0x82, 0xff, 0x0f, 0x8a, 0x15, 0x61, 0x6c, 0xfd, 0x54, 0x81,
-	0x93, 0xfb, 0x3c, 0x32, 0xdb, 0x30, 0x37, 0xf0, 0xfc, 0xba, 0x30, 0xec, 0xdf, 0xdc, 0xa1, 0x77,
-	0x90, 0x7d, 0xe5, 0x18, 0xe0, 0x72, 0x8e, 0x7e, 0x09, 0xd0, 0xf7, 0x60, 0xfa, 0x60, 0x04, 0x45,
-	0x0f, 0x6a, 0x6f, 0x8e, 0x0d, 0x2d, 0xb5, 0x38, 0xcc, 0x0f, 0xce, 0xe5, 0x8b, 0x23, 0x38, 0x06,
-	0xd0, 0xf6, 0x87, 0xc7, 0x41, 0x97, 0xa2, 0xb7, 0xe1, 0xa4, 0x1c, 0xc3, 0xce, 0x88, 0xcb, 0xf5,
-	0xac, 0x6b, 0x6f, 0x8c, 0xc6, 0x94, 0xb4, 0x04, 0xe6, 0x07, 0x67, 0xdc, 0xc5, 0xd1, 0x97, 0x7b,
-	0xe8, 0x63, 0x49, 0xdd, 0x85, 0x49, 0x35, 0x2f, 0xd6, 0x46, 0xdc, 0x91, 0x20, 0xfb, 0xc2, 0x18,
-	0xa0, 0x92, 0xf9, 0x73, 0x98, 0xd2, 0x5d, 0x77, 0x6e, 0x54, 0x32, 0x25, 0xca, 0xbe, 0x38, 0x0e,
-	0xaa, 0x24, 0x4f, 0x60, 0xb6, 0xbf, 0xaf, 0x36, 0xc6, 0xb9, 0xac, 0xb1, 0xf6, 0xd6, 0xf8, 0xd8,
-	0x42, 0xce, 0x9e, 0xfa, 0xe6, 0xf5, 0xe3, 0x0d, 0xa3, 0xfe, 0xf1, 0xd3, 0x97, 0x35, 0xe3, 0xd9,
-	0xcb, 0x9a, 0xf1, 0xfb, 0xcb, 0x9a, 0xf1, 0xe8, 0x55, 0x6d, 0xe2, 0xd9, 0xab, 0xda, 0xc4, 0x2f,
-	0xaf, 0x6a, 0x13, 0x9f, 0x5d, 0x8e, 0x88, 0xb8, 0x9f, 0xb5, 0xdc, 0x80, 0x25, 0xde, 0x11, 0x3f,
-	0x89, 0x0e, 0xae, 0x78, 0x1d, 0xfd, 0x4b, 0xb1, 0xdb, 0xc6, 0xbc, 0x55, 0x51, 0x8f, 0x9e, 0x2b,
-	0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x59, 0xcf, 0x69, 0x2f, 0x54, 0x0e, 0x00, 0x00,
+	// 1415 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6e, 0xdb, 0xc6,
+	0x16, 0xb6, 0xfc, 0xaf, 0x63, 0xeb, 0xc7, 0x4c, 0x7c, 0xcd, 0xe8, 0xe2, 0xda, 0x86, 0x9c, 0x20,
+	0xbe, 0x8e, 0x23, 0xc5, 0x4e, 0x90, 0x85, 0x17, 0x17, 0xb0, 0xec, 0xdc, 0xc2, 0x45, 0x85, 0x18,
+	0x94, 0xf3, 0x83, 0x14, 0x08, 0x31, 0x22, 0x47, 0xf4, 0x34, 0x24, 0x47, 0xe5, 0x0c, 0x6d, 0xa9,
+	0xab, 0xa2, 0x4f, 0x90, 0x65, 0x77, 0x2d, 0xba, 0xe9, 0xa6, 0x8b, 0x00, 0xcd, 0x43, 0x64, 0x19,
+	0x64, 0xd3, 0xa2, 0x8b, 0xb4, 0x48, 0x16, 0xd9, 0xf7, 0x05, 0x5a, 0xcc, 0x0c, 0x49, 0x49, 0x0e,
+	0x2c, 0xc9, 0x4e, 0xb3, 0x32, 0xe7, 0xcc, 0x77, 0xbe, 0x73, 0xe6, 0x9b, 0x33, 0x67, 0xc6, 0x82,
+	0x15, 0xbb, 0xed, 0x61, 0x9f, 0x11, 0xea, 0xb7, 0xda, 0x5f, 0x95, 0x93, 0x41, 0x99, 0x04, 0xb4,
+	0xcc, 0x5b, 0xa5, 0x66, 0x40, 0x39, 0xd5, 0x0a, 0xdd, 0xa0, 0x52, 0x32, 0x28, 0x91, 0x80, 0x16,
+	0x2e, 0x3a, 0xd4, 0xa1, 0x12, 0x56, 0x16, 0x5f, 0xca, 0xa3, 0x70, 0xc9, 0xa2, 0xcc, 0xa3, 0xcc,
+	0x54, 0x13, 0x6a, 0x10, 0x4d, 0x2d, 0xa8, 0x51, 0xd9, 0x63, 0x4e, 0xf9, 0x68, 0x43, 0xfc, 0x89,
+	0x26, 0x2e, 0xf7, 0x49, 0x85, 0x04, 0x31, 0xf3, 0xa2, 0x43, 0xa9, 0xe3, 0xe2, 0xb2, 0x1c, 0xd5,
+	0xc3, 0x46, 0xd9, 0x0e, 0x03, 0xc4, 0x45, 0x36, 0x6a, 0x7e, 0xe9, 0xe4, 0x3c, 0x27, 0x1e, 0x66,
+	0x1c, 0x79, 0xcd, 0x98, 0x20, 0x8a, 0x5f, 0x47, 0x0c, 0x97, 0x8f, 0x36, 0xea, 0x98, 0xa3, 0x8d,
+	0xb2, 0x45, 0x49, 0x4c, 0x70, 0xb5, 0x4f, 0x1a, 0x4d, 0x14, 0x20, 0x2f, 0x5a, 0x48, 0xf1, 0x87,
+	0x14, 0xe4, 0xaa, 0xcc, 0xb9, 0xd7, 0xb4, 0x11, 0xc7, 0xfb, 0x72, 0x46, 0xbb, 0x0d, 0x69, 0x14,
+	0xf2, 0x43, 0x1a, 0x10, 0xde, 0xd6, 0x53, 0xcb, 0xa9, 0xd5, 0x74, 0x45, 0x7f, 0xf5, 0xfc, 0xfa,
+	0xc5, 0x48, 0x81, 0x6d, 0xdb, 0x0e, 0x30, 0x63, 0x35, 0x1e, 0x10, 0xdf, 0x31, 0x3a, 0x50, 0xed,
+	0x13, 0x00, 0x1f, 0x1f, 0x9b, 0x8a, 0x5f, 0x1f, 0x5d, 0x4e, 0xad, 0xce, 0x6c, 0x16, 0x4b, 0xa7,
+	0xcb, 0x5e, 0x52, 0xf1, 0x2a, 0xe3, 0x2f, 0x5e, 0x2f, 0x8d, 0x18, 0x69, 0x1f, 0x1f, 0x2b, 0xc3,
+	0x56, 0xf6, 0x9b, 0x77, 0xcf, 0xd6, 0x3a, 0xc4, 0xc5, 0x4b, 0xb0, 0x70, 0x22, 0x47, 0x03, 0xb3,
+	0x26, 0xf5, 0x19, 0x2e, 0xfe, 0x02, 0x90, 0xa9, 0x32, 0x67, 0x27, 0xc0, 0x62, 0xce, 0x45, 0xbe,
+	0x56, 0x82, 0x09, 0x7a, 0xec, 0xe3, 0x60, 0x60, 0xe6, 0x0a, 0xa6, 0xfd, 0x07, 0x20, 0xa0, 0xae,
+	0x8b, 0x9a, 0x4d, 0x93, 0xd8, 0x32, 0xeb, 0xb4, 0x91, 0x8e, 0x2c, 0x7b, 0xb6, 0x76, 0x1f, 0xf2,
+	0xc8, 0x75, 0xa9, 0x85, 0x38, 0xb6, 0x4d, 0xe4, 0xd1, 0xd0, 0xe7, 0xfa, 0x98, 0x64, 0xbe, 0x26,
+	0xd2, 0xfe, 0xed, 0xf5, 0xd2, 0xbc, 0x62, 0x67, 0xf6, 0x93, 0x12, 0xa1, 0x65, 0x0f, 0xf1, 0xc3,
+	0xd2, 0x9e, 0xcf, 0x5f, 0x3d, 0xbf, 0x0e, 0x51, 0xd8, 0x3d, 0x9f, 0x1b, 0xb9, 0x84, 0x64, 0x5b,
+	0x72, 0x68, 0x35, 0xc8, 0xd4, 0xa9, 0x6f, 0x13, 0xdf, 0x31, 0xad, 0x30, 0x38, 0xc2, 0xfa, 0xb8,
+	0xd4, 0x6b, 0xb5, 0x9f, 0x5e, 0x15, 0xe5, 0xb0, 0x23, 0xf0, 0x91, 0x6a, 0xb3, 0xf5, 0x2e, 0x9b,
+	0x76, 0x15, 0x72, 0x3c, 0x40, 0x92, 0x14, 0xfb, 0xa8, 0xee, 0x62, 0x5b, 0x9f, 0x58, 0x4e, 0xad,
+	0x4e, 0x1b, 0xd9, 0xc8, 0x7c, 0x47, 0x59, 0xb5, 0x1d, 0x00, 0xc6, 0x51, 0xc0, 0x4d, 0x51, 0x58,
+	0xfa, 0xa4, 0x0c, 0x5d, 0x28, 0xa9, 0xaa, 0x2b, 0xc5, 0x55, 0x57, 0x3a, 0x88, 0xab, 0xae, 0x32,
+	0x2d, 0x82, 0x3d, 0xfd, 0x7d, 0x29, 0x65, 0xa4, 0xa5, 0x9f, 0x98, 0xd1, 0xee, 0xc2, 0x1c, 0x09,
+	0xa8, 0xd9, 0x74, 0x91, 0x6f, 0xc6, 0x05, 0xac, 0x4f, 0x49, 0xae, 0x4b, 0xef, 0x71, 0xed, 0x46,
+	0x00, 0x45, 0xf5, 0xad, 0xa0, 0xca, 0x91, 0x80, 0x8a, 0x2d, 0x8b, 0xa7, 0x34, 0x02, 0xf3, 0xc4,
+	0xb7, 0xb0, 0xcf, 0xc9, 0x11, 0x56, 0xb4, 0x51, 0x2d, 0x4d, 0x4b, 0xd2, 0x72, 0x3f, 0x6d, 0xf6,
+	0x62, 0x47, 0xc1, 0xd8, 0x53, 0x58, 0x17, 0xc8, 0xfb, 0x53, 0xda, 0x43, 0xc8, 0xba, 0xe4, 0xcb,
+	0x90, 0xd8, 0x84, 0xb7, 0x45, 0x14, 0xae, 0xa7, 0xe5, 0xa6, 0x6e, 0x44, 0x9b, 0xfa, 0xef, 0xf7,
+	0x37, 0xf5, 0x33, 0xec, 0x20, 0xab, 0xbd, 0x8b, 0xad, 0xae, 0xad, 0xdd, 0xc5, 0x96, 0x91, 0x49,
+	0x88, 0xf6, 0x51, 0xc0, 0xc5, 0x1e, 0x74, 0x98, 0x6d, 0xec, 0x53, 0x4f, 0x07, 0x59, 0x54, 0x9d,
+	0x80, 0xbb, 0xc2, 0xaa, 0x11, 0xc8, 0x1f, 0x61, 0xc6, 0xc5, 0x66, 0x25, 0xea, 0xcd, 0x0c, 0x52,
+	0x6f, 0x45, 0xe4, 0xf7, 0xe7, 0xeb, 0xa5, 0x85, 0x36, 0xf2, 0xdc, 0xad, 0xe2, 0x49, 0x82, 0xa2,
+	0x12, 0x36, 0x32, 0x27, 0xc2, 0x7e, 0x97, 0x82, 0x95, 0x18, 0xda, 0xd9, 0x77, 0x13, 0x35, 0x38,
+	0x0e, 0x4c, 0x86, 0x39, 0x77, 0xb1, 0x87, 0x7d, 0xae, 0xcf, 0x0e, 0x0a, 0x7f, 0x3b, 0x0a, 0xbf,
+	0xd6, 0x1b, 0xbe, 0x0f, 0xa7, 0xca, 0x68, 0x29, 0x42, 0xd6, 0xe2, 0xe2, 0xd9, 0x16, 0xb0, 0x5a,
+	0x82, 0xd2, 0xaa, 0x90, 0xb1, 0x43, 0x6e, 0x1d, 0x9a, 0x28, 0xb4, 0xa4, 0x12, 0x99, 0xc1, 0xc7,
+	0x61, 0x57, 0x38, 0x6c, 0x2b, 0xbc, 0x31, 0x6b, 0x77, 0x8d, 0xb4, 0x03, 0xc8, 0x37, 0x48, 0x0b,
+	0xdb, 0x66, 0x33, 0x20, 0x16, 0x36, 0x19, 0x72, 0xb1, 0x9e, 0x95, 0x8c, 0x6b, 0xfd, 0x18, 0xff,
+	0x2f, 0x7c, 0xf6, 0x85, 0x4b, 0x0d, 0xb9, 0xd8, 0xc8, 0x36, 0x7a, 0xc6, 0xda, 0x0e, 0xa4, 0x8f,
+	0x0f, 0x09, 0xc7, 0x2e, 0x61, 0x5c, 0xcf, 0x49, 0xba, 0x2b, 0xfd, 0xe8, 0x1e, 0xc4, 0x60, 0xa3,
+	0xe3, 0xa7, 0xfd, 0x0f, 0xa6, 0x19, 0x6d, 0x70, 0xd3, 0x42, 0x4d, 0x3d, 0x2f, 0x39, 0x56, 0xfa,
+	0x71, 0xd4, 0x68, 0x83, 0xef, 0xa0, 0xa6, 0x31, 0xc5, 0xd4, 0x87, 0xf6, 0x08, 0xb4, 0x7a, 0xd8,
+	0xc6, 0x81, 0x19, 0x8b, 0x2f, 0x0e, 0x8a, 0x3e, 0x27, 0x99, 0xd6, 0xfb, 0x76, 0x0f, 0xe1, 0x75,
+	0x5f, 0x39, 0x89, 0x93, 0x60, 0xe4, 0xeb, 0x27, 0x2c, 0x5b, 0x20, 0x1a, 0xaf, 0xea, 0x8b, 0xc5,
+	0x1b, 0x30, 0xdf, 0xd3, 0x58, 0xe3, 0x96, 0xab, 0x2d, 0xc0, 0x94, 0x3c, 0x9b, 0xc4, 0x56, 0x2d,
+	0xd6, 0x98, 0x14, 0xc3, 0x3d, 0xbb, 0xf8, 0x53, 0x0a, 0xf2, 0x55, 0x16, 0xf5, 0x98, 0x03, 0xd5,
+	0x70, 0xce, 0xdc, 0x8e, 0xbb, 0xd8, 0x47, 0xbb, 0xd9, 0x7b, 0xc5, 0x1f, 0x3b, 0x9f, 0xf8, 0x3d,
+	0x0b, 0x2c, 0x80, 0x7e, 0x32, 0xdb, 0xe4, 0x5a, 0xf9, 0x7e, 0x14, 0x26, 0xab, 0xcc, 0xa9, 0x84,
+	0x6d, 0xb1, 0x00, 0xa9, 0xd3, 0xe0, 0x05, 0x48, 0x58, 0xbf, 0x05, 0x4c, 0x9e, 0xff, 0xfe, 0x88,
+	0x5c, 0xb5, 0x1a, 0xe4, 0x3c, 0xd4, 0x32, 0x2d, 0xca, 0x78, 0x7c, 0x1b, 0x8d, 0x9f, 0x9d, 0x2d,
+	0xe3, 0xa1, 0xd6, 0x0e, 0x65, 0x3c, 0xba, 0x8b, 0xae, 0x42, 0x2e, 0x91, 0x48, 0xbc, 0x76, 0x68,
+	0x43, 0x9f, 0x58, 0x1e, 0x5b, 0x9d, 0x35, 0xb2, 0x89, 0x79, 0x5f, 0x58, 0x23, 0xf9, 0xe4, 0x3a,
+	0x8b, 0x3f, 0x8f, 0xca, 0xdd, 0xae, 0x84, 0xed, 0x3b, 0x2d, 0x64, 0xf1, 0x5a, 0x13, 0xfb, 0xf6,
+	0x3f, 0x27, 0xd6, 0x36, 0x4c, 0x30, 0xc1, 0x78, 0x1e, 0xad, 0x94, 0xa7, 0xf6, 0x18, 0xe6, 0x3d,
+	0xe2, 0x9b, 0x34, 0xe4, 0x26, 0xa7, 0x4f, 0xb0, 0xcf, 0x3e, 0x40, 0x30, 0xcd, 0x23, 0xfe, 0xdd,
+	0x90, 0x1f, 0x48, 0x9e, 0x0f, 0x51, 0x2d, 0x0f, 0x59, 0x25, 0x5a, 0x52, 0x6a, 0x7f, 0xa5, 0x60,
+	0xaa, 0xca, 0x9c, 0x1a, 0x76, 0x5d, 0xed, 0x06, 0x4c, 0x32, 0xec, 0xba, 0x43, 0xe8, 0x17, 0xe1,
+	0x3e, 0x72, 0xb5, 0x3d, 0x80, 0x39, 0x21, 0x21, 0xf1, 0x2d, 0x2a, 0x5a, 0xfb, 0xb9, 0xe5, 0xcb,
+	0x79, 0xc4, 0xdf, 0x93, 0x24, 0x4a, 0xbb, 0xad, 0x19, 0x21, 0x49, 0xb4, 0x86, 0xe2, 0x1c, 0xe4,
+	0x22, 0x01, 0x12, 0x51, 0x30, 0x4c, 0x8b, 0xe6, 0xe3, 0x22, 0xe2, 0x69, 0x9b, 0x30, 0x65, 0x89,
+	0x8f, 0x21, 0x54, 0x89, 0x81, 0xa7, 0xca, 0xb2, 0x35, 0x2b, 0x02, 0xc7, 0xb0, 0xa2, 0x06, 0xf9,
+	0x38, 0x4c, 0x12, 0xfa, 0x09, 0x64, 0x63, 0x9b, 0x68, 0x8d, 0xd8, 0xfe, 0x98, 0x09, 0xe8, 0xf0,
+	0xaf, 0xde, 0x60, 0x49, 0x1a, 0x04, 0xd2, 0x55, 0xe6, 0x18, 0xb8, 0x11, 0xfa, 0xb6, 0x76, 0x0b,
+	0xa6, 0x03, 0xf9, 0x35, 0x44, 0x0a, 0x09, 0xf2, 0xf4, 0x1c, 0x32, 0x22, 0x87, 0x04, 0x57, 0xbc,
+	0x00, 0x73, 0x49, 0xa8, 0x38, 0xfe, 0xe6, 0x8f, 0x53, 0x30, 0x56, 0x65, 0x8e, 0xd6, 0x84, 0xd9,
+	0x9e, 0x7f, 0x0e, 0xae, 0xf5, 0xeb, 0xb9, 0x27, 0x5e, 0xe9, 0x85, 0x9b, 0x67, 0x00, 0x27, 0xf7,
+	0xcb, 0x17, 0x00, 0x5d, 0xcf, 0xf9, 0xff, 0x0e, 0xa0, 0xe8, 0x40, 0x0b, 0x1b, 0x43, 0x43, 0x93,
+	0x58, 0x0c, 0x32, 0xbd, 0xd7, 0xd5, 0xfa, 0x00, 0x8e, 0x1e, 0x74, 0xe1, 0xd6, 0x59, 0xd0, 0x49,
+	0xd0, 0x7b, 0x30, 0x26, 0x2e, 0x96, 0xe2, 0x00, 0xe7, 0x4a, 0xd8, 0x2e, 0xac, 0x0d, 0xc6, 0x24,
+	0xb4, 0x04, 0x32, 0xbd, 0xcd, 0x78, 0x7d, 0xb0, 0x73, 0x07, 0x7d, 0xa6, 0x50, 0x0f, 0x61, 0x5c,
+	0xf6, 0xab, 0x95, 0x01, 0x3e, 0x02, 0x54, 0xb8, 0x36, 0x04, 0x28, 0x61, 0xfe, 0x1c, 0x26, 0xd4,
+	0xa9, 0xbf, 0x3c, 0x68, 0x33, 0x05, 0xaa, 0xb0, 0x3e, 0x0c, 0x2a, 0x21, 0xf7, 0x60, 0xa6, 0xfb,
+	0x5c, 0xaf, 0x0d, 0xe3, 0xac, 0xb0, 0x85, 0xcd, 0xe1, 0xb1, 0x49, 0xb8, 0xc7, 0x30, 0x19, 0x9d,
+	0xdf, 0x2b, 0x03, 0xbc, 0x15, 0xac, 0x70, 0x7d, 0x28, 0x58, 0xcc, 0x5f, 0x98, 0xf8, 0xfa, 0xdd,
+	0xb3, 0xb5, 0x54, 0xe5, 0xd3, 0x17, 0x6f, 0x16, 0x53, 0x2f, 0xdf, 0x2c, 0xa6, 0xfe, 0x78, 0xb3,
+	0x98, 0x7a, 0xfa, 0x76, 0x71, 0xe4, 0xe5, 0xdb, 0xc5, 0x91, 0x5f, 0xdf, 0x2e, 0x8e, 0x3c, 0xba,
+	0xe1, 0x10, 0x7e, 0x18, 0xd6, 0x4b, 0x16, 0xf5, 0xca, 0xa7, 0xfc, 0x20, 0x70, 0x74, 0xb3, 0xdc,
+	0x52, 0xbf, 0x93, 0xb4, 0x9b, 0x98, 0xd5, 0x27, 0xe5, 0x93, 0xff, 0xe6, 0xdf, 0x03, 0x00, 0xaf,
+	0xe6, 0x0c, 0xa8, 0x52, 0x11, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -946,6 +1128,9 @@ type MsgClient interface {
 	// Claim is used to claim tokens after the plan is settled.
 	Claim(ctx context.Context, in *MsgClaim, opts ...grpc.CallOption) (*MsgClaimResponse, error)
 	ClaimVested(ctx context.Context, in *MsgClaimVested, opts ...grpc.CallOption) (*MsgClaimVestedResponse, error)
+	// Refund is used to get the liquidity paid back from a plan which missed its
+	// soft cap.
+	Refund(ctx context.Context, in *MsgRefund, opts ...grpc.CallOption) (*MsgRefundResponse, error)
 }
 
 type msgClient struct {
@@ -1028,6 +1213,15 @@ func (c *msgClient) ClaimVested(ctx context.Context, in *MsgClaimVested, opts ..
 	return out, nil
 }
 
+func (c *msgClient) Refund(ctx context.Context, in *MsgRefund, opts ...grpc.CallOption) (*MsgRefundResponse, error) {
+	out := new(MsgRefundResponse)
+	err := c.cc.Invoke(ctx, "/dymensionxyz.dymension.iro.Msg/Refund", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// UpdateParams is used for updating module params.
@@ -1043,6 +1237,9 @@ type MsgServer interface {
 	// Claim is used to claim tokens after the plan is settled.
 	Claim(context.Context, *MsgClaim) (*MsgClaimResponse, error)
 	ClaimVested(context.Context, *MsgClaimVested) (*MsgClaimVestedResponse, error)
+	// Refund is used to get the liquidity paid back from a plan which missed its
+	// soft cap.
+	Refund(context.Context, *MsgRefund) (*MsgRefundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1073,6 +1270,9 @@ func (*UnimplementedMsgServer) Claim(ctx context.Context, req *MsgClaim) (*MsgCl
 func (*UnimplementedMsgServer) ClaimVested(ctx context.Context, req *MsgClaimVested) (*MsgClaimVestedResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ClaimVested not implemented")
 }
+func (*UnimplementedMsgServer) Refund(ctx context.Context, req *MsgRefund) (*MsgRefundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Refund not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1222,6 +1422,25 @@ func _Msg_ClaimVested_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Refund_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRefund)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Refund(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dymensionxyz.dymension.iro.Msg/Refund",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Refund(ctx, req.(*MsgRefund))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dymensionxyz.dymension.iro.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1258,6 +1477,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ClaimVested",
 			Handler:    _Msg_ClaimVested_Handler,
 		},
+		{
+			MethodName: "Refund",
+			Handler:    _Msg_Refund_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dymensionxyz/dymension/iro/tx.proto",
@@ -1346,20 +1569,84 @@ func (m *MsgCreatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement):])
-	if err2 != nil {
-		return 0, err2
+	if m.BuyerVestingPlan != nil {
+		{
+			size, err := m.BuyerVestingPlan.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.SoftCap != nil {
+		{
+			size, err := m.SoftCap.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Whitelist != nil {
+		{
+			size, err := m.Whitelist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.FixedPriceSale != nil {
+		{
+			size, err := m.FixedPriceSale.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.DutchAuction != nil {
+		{
+			size, err := m.DutchAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
 	}
-	i -= n2
-	i = encodeVarintTx(dAtA, i, uint64(n2))
+	n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingStartTimeAfterSettlement, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x62
-	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
-	if err3 != nil {
-		return 0, err3
+	n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.VestingDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingDuration):])
+	if err8 != nil {
+		return 0, err8
 	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i -= n8
+	i = encodeVarintTx(dAtA, i, uint64(n8))
 	i--
 	dAtA[i] = 0x5a
 	if len(m.LiquidityDenom) > 0 {
@@ -1389,20 +1676,20 @@ func (m *MsgCreatePlan) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x42
-	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
-	if err5 != nil {
-		return 0, err5
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.IroPlanDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.IroPlanDuration):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n5
-	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i -= n10
+	i = encodeVarintTx(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x3a
-	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
-	if err6 != nil {
-		return 0, err6
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err11 != nil {
+		return 0, err11
 	}
-	i -= n6
-	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i -= n11
+	i = encodeVarintTx(dAtA, i, uint64(n11))
 	i--
 	dAtA[i] = 0x32
 	if m.TradingEnabled {
@@ -1502,6 +1789,18 @@ func (m *MsgEnableTrading) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Whitelist != nil {
+		{
+			size, err := m.Whitelist.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.PlanId) > 0 {
 		i -= len(m.PlanId)
 		copy(dAtA[i:], m.PlanId)
@@ -1562,6 +1861,15 @@ func (m *MsgBuy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WhitelistProof) > 0 {
+		for iNdEx := len(m.WhitelistProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhitelistProof[iNdEx])
+			copy(dAtA[i:], m.WhitelistProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.WhitelistProof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size := m.MaxCostAmount.Size()
 		i -= size
@@ -1619,6 +1927,15 @@ func (m *MsgBuyExactSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.WhitelistProof) > 0 {
+		for iNdEx := len(m.WhitelistProof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.WhitelistProof[iNdEx])
+			copy(dAtA[i:], m.WhitelistProof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.WhitelistProof[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size := m.MinOutTokensAmount.Size()
 		i -= size
@@ -1879,6 +2196,66 @@ func (m *MsgClaimVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgRefund) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefund) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PlanId) > 0 {
+		i -= len(m.PlanId)
+		copy(dAtA[i:], m.PlanId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PlanId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Refunder) > 0 {
+		i -= len(m.Refunder)
+		copy(dAtA[i:], m.Refunder)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Refunder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRefundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRefundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRefundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1951,6 +2328,26 @@ func (m *MsgCreatePlan) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.VestingStartTimeAfterSettlement)
 	n += 1 + l + sovTx(uint64(l))
+	if m.DutchAuction != nil {
+		l = m.DutchAuction.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FixedPriceSale != nil {
+		l = m.FixedPriceSale.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Whitelist != nil {
+		l = m.Whitelist.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SoftCap != nil {
+		l = m.SoftCap.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
+	if m.BuyerVestingPlan != nil {
+		l = m.BuyerVestingPlan.Size()
+		n += 2 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -1981,6 +2378,10 @@ func (m *MsgEnableTrading) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.Whitelist != nil {
+		l = m.Whitelist.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -2011,6 +2412,12 @@ func (m *MsgBuy) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MaxCostAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.WhitelistProof) > 0 {
+		for _, b := range m.WhitelistProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2032,6 +2439,12 @@ func (m *MsgBuyExactSpend) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.MinOutTokensAmount.Size()
 	n += 1 + l + sovTx(uint64(l))
+	if len(m.WhitelistProof) > 0 {
+		for _, b := range m.WhitelistProof {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2126,6 +2539,32 @@ func (m *MsgClaimVestedResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRefund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Refunder)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.PlanId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRefundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2708,16 +3147,196 @@ func (m *MsgCreatePlan) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuction", wireType)
 			}
-			if (iNdEx + skippy) > l {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DutchAuction == nil {
+				m.DutchAuction = &DutchAuction{}
+			}
+			if err := m.DutchAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FixedPriceSale", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FixedPriceSale == nil {
+				m.FixedPriceSale = &FixedPriceSale{}
+			}
+			if err := m.FixedPriceSale.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Whitelist == nil {
+				m.Whitelist = &Whitelist{}
+			}
+			if err := m.Whitelist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SoftCap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SoftCap == nil {
+				m.SoftCap = &SoftCap{}
+			}
+			if err := m.SoftCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BuyerVestingPlan", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BuyerVestingPlan == nil {
+				m.BuyerVestingPlan = &BuyerVestingPlan{}
+			}
+			if err := m.BuyerVestingPlan.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
@@ -2904,6 +3523,42 @@ func (m *MsgEnableTrading) Unmarshal(dAtA []byte) error {
 			}
 			m.PlanId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Whitelist", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Whitelist == nil {
+				m.Whitelist = &Whitelist{}
+			}
+			if err := m.Whitelist.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3136,6 +3791,38 @@ func (m *MsgBuy) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistProof = append(m.WhitelistProof, make([]byte, postIndex-iNdEx))
+			copy(m.WhitelistProof[len(m.WhitelistProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3318,6 +4005,38 @@ func (m *MsgBuyExactSpend) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WhitelistProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WhitelistProof = append(m.WhitelistProof, make([]byte, postIndex-iNdEx))
+			copy(m.WhitelistProof[len(m.WhitelistProof)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3949,6 +4668,170 @@ func (m *MsgClaimVestedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgRefund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Refunder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlanId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlanId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRefundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRefundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRefundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -75,3 +75,33 @@ func TestGetVestedCoinsContVestingAcc(t *testing.T) {
 		})
 	}
 }
+
+func TestBuyerVestingPlanVested(t *testing.T) {
+	startTime := time.Now()
+	amount := math.NewInt(1_000_000).MulRaw(1e18)
+	v := types.BuyerVestingPlan{Cliff: 6 * time.Hour, Duration: 24 * time.Hour, StartTime: startTime}
+	require.NoError(t, v.ValidateBasic())
+
+	cases := []struct {
+		name     string
+		elapsed  time.Duration
+		expected math.Int
+	}{
+		{"not started", -time.Hour, math.ZeroInt()},
+		{"before the cliff", 6*time.Hour - time.Second, math.ZeroInt()},
+		{"at the cliff", 6 * time.Hour, amount.QuoRaw(4)},
+		{"partially vested", 12 * time.Hour, amount.QuoRaw(2)},
+		{"fully vested", 24 * time.Hour, amount},
+		{"after the end", 48 * time.Hour, amount},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			vested := v.Vested(amount, startTime.Add(tc.elapsed))
+			require.True(t, tc.expected.Equal(vested), "expected: %s, vested: %s", tc.expected, vested)
+		})
+	}
+
+	require.Error(t, types.BuyerVestingPlan{Cliff: 25 * time.Hour, Duration: 24 * time.Hour}.ValidateBasic())
+	require.Error(t, types.BuyerVestingPlan{}.ValidateBasic())
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (w Whitelist) ValidateBasic() error {
+	if (len(w.Addresses) == 0) == (len(w.MerkleRoot) == 0) {
+		return errors.New("exactly one of addresses and merkle root must be set")
+	}
+	seen := make(map[string]bool, len(w.Addresses))
+	for _, addr := range w.Addresses {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid address: %s: %w", addr, err)
+		}
+		if seen[addr] {
+			return fmt.Errorf("duplicate address: %s", addr)
+		}
+		seen[addr] = true
+	}
+	if len(w.MerkleRoot) != 0 && len(w.MerkleRoot) != sha256.Size {
+		return fmt.Errorf("merkle root must be %d bytes", sha256.Size)
+	}
+	if w.Cap.IsNil() || !w.Cap.IsPositive() {
+		return fmt.Errorf("cap must be positive: %s", w.Cap)
+	}
+	if w.Duration < 0 {
+		return fmt.Errorf("duration must not be negative: %s", w.Duration)
+	}
+	if w.PublicCap.IsNil() || w.PublicCap.IsNegative() {
+		return fmt.Errorf("public cap must not be negative: %s", w.PublicCap)
+	}
+	return nil
+}
+
+// Listed returns whether the address is in the explicit list of the whitelist
+func (w Whitelist) Listed(addr string) bool {
+	return slices.Contains(w.Addresses, addr)
+}
+
+// VerifyProof returns whether the proof shows the address is in the Merkle tree of the whitelist
+func (w Whitelist) VerifyProof(addr string, proof [][]byte) bool {
+	if len(w.MerkleRoot) == 0 {
+		return false
+	}
+	h := MerkleLeaf(addr)
+	for _, sibling := range proof {
+		h = merkleParent(h, sibling)
+	}
+	return bytes.Equal(h, w.MerkleRoot)
+}
+
+// MerkleLeaf returns the leaf of the address in the Merkle tree of a whitelist
+func MerkleLeaf(addr string) []byte {
+	h := sha256.Sum256([]byte(addr))
+	return h[:]
+}
+
+func merkleParent(a, b []byte) []byte {
+	if 0 < bytes.Compare(a, b) {
+		a, b = b, a
+	}
+	h := sha256.Sum256(append(slices.Clone(a), b...))
+	return h[:]
+}
+
+// MerkleTree returns the root of the Merkle tree of the addresses, and the proof of each of them. An odd node
+// at a level is promoted to the next level as is.
+func MerkleTree(addrs []string) (root []byte, proofs [][][]byte) {
+	if len(addrs) == 0 {
+		return nil, nil
+	}
+	level := make([][]byte, len(addrs))
+	// the indexes of the leaves of each node of the level
+	leaves := make([][]int, len(addrs))
+	for i, addr := range addrs {
+		level[i] = MerkleLeaf(addr)
+		leaves[i] = []int{i}
+	}
+	proofs = make([][][]byte, len(addrs))
+	for len(level) > 1 {
+		var next [][]byte
+		var nextLeaves [][]int
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				nextLeaves = append(nextLeaves, leaves[i])
+				continue
+			}
+			for _, l := range leaves[i] {
+				proofs[l] = append(proofs[l], level[i+1])
+			}
+			for _, l := range leaves[i+1] {
+				proofs[l] = append(proofs[l], level[i])
+			}
+			next = append(next, merkleParent(level[i], level[i+1]))
+			nextLeaves = append(nextLeaves, append(slices.Clone(leaves[i]), leaves[i+1]...))
+		}
+		level, leaves = next, nextLeaves
+	}
+	return level[0], proofs
+}
+
+// InWhitelistPhase returns whether only the whitelisted accounts can buy from the plan at the given time. It
+// starts when trading is enabled, or before for the rollapp owner.
+func (p Plan) InWhitelistPhase(now time.Time) bool {
+	if p.Whitelist == nil {
+		return false
+	}
+	return !p.TradingEnabled || now.Before(p.StartTime.Add(p.Whitelist.Duration))
+}
+
+// Cap returns the max amount of tokens an account can buy from the plan in total at the given time, and
+// whether there is one
+func (p Plan) Cap(now time.Time) (math.Int, bool) {
+	switch {
+	case p.Whitelist == nil:
+		return math.Int{}, false
+	case p.InWhitelistPhase(now):
+		return p.Whitelist.Cap, true
+	case p.Whitelist.PublicCap.IsPositive():
+		return p.Whitelist.PublicCap, true
+	default:
+		return math.Int{}, false
+	}
+}
+
+func NewPurchases(planId uint64, buyer string) Purchases {
+	return Purchases{PlanId: planId, Buyer: buyer, Amount: math.ZeroInt()}
+}
+
+func (p Purchases) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(p.Buyer); err != nil {
+		return fmt.Errorf("invalid buyer address: %w", err)
+	}
+	if p.Amount.IsNil() || p.Amount.IsNegative() {
+		return fmt.Errorf("purchased amount must not be negative: %s", p.Amount)
+	}
+	return nil
+}
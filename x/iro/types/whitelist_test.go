@@ -0,0 +1,29 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/iro/types"
+)
+
+func TestWhitelistMerkleProof(t *testing.T) {
+	for _, n := range []int{1, 2, 3, 5, 8} {
+		addrs := make([]string, n)
+		for i := range addrs {
+			addrs[i] = sample.AccAddress()
+		}
+		root, proofs := types.MerkleTree(addrs)
+		w := types.Whitelist{MerkleRoot: root}
+
+		for i, addr := range addrs {
+			require.True(t, w.VerifyProof(addr, proofs[i]), "n: %d, i: %d", n, i)
+		}
+		require.False(t, w.VerifyProof(sample.AccAddress(), proofs[0]), "n: %d", n)
+		if 1 < n {
+			require.False(t, w.VerifyProof(addrs[0], proofs[1]), "n: %d", n)
+		}
+	}
+}
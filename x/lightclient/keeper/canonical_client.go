@@ -126,7 +126,9 @@ func (k Keeper) validClient(ctx sdk.Context, clientID string, cs *ibctm.ClientSt
 	}
 	baseHeight := k.GetFirstConsensusStateHeight(ctx, clientID)
 	atLeastOneMatch := false
-	for i := sinfo.Index; i > 0; i-- {
+	// the states before the first one kept are pruned, and cannot be matched
+	first := k.rollappKeeper.FirstStateInfoIndex(ctx, rollappId)
+	for i := sinfo.Index; i >= first && i > 0; i-- {
 		sInfo, ok := k.rollappKeeper.GetStateInfo(ctx, rollappId, i)
 		if !ok {
 			return errorsmod.Wrap(gerrc.ErrInternal, "get state info")
@@ -157,6 +159,10 @@ func (k Keeper) validClient(ctx sdk.Context, clientID string, cs *ibctm.ClientSt
 
 func (k Keeper) ValidateHeaderAgainstStateInfo(ctx sdk.Context, sInfo *rollapptypes.StateInfo, consState *ibctm.ConsensusState, h uint64) error {
 	bd, ok := sInfo.GetBlockDescriptor(h)
+	if !ok && sInfo.Compressed() {
+		// the sequencer must sample the heights the client has headers for
+		return errorsmod.Wrapf(rollapptypes.ErrBlockDescriptorNotSampled, "height %d", h)
+	}
 	if !ok {
 		return errorsmod.Wrapf(gerrc.ErrInternal, "no block descriptor found for height %d", h)
 	}
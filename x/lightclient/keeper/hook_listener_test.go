@@ -69,6 +69,39 @@ func TestAfterUpdateState(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		{
+			name: "compressed state does not sample a height the client has a header for",
+			prepare: func(ctx sdk.Context, k lightClientKeeper.Keeper) input {
+				k.SetCanonicalClient(ctx, keepertest.DefaultRollapp, keepertest.CanonClientID)
+				err := k.SaveSigner(ctx, keepertest.Alice.Address, keepertest.CanonClientID, 2)
+				require.NoError(t, err)
+				return input{
+					rollappId: keepertest.DefaultRollapp,
+					stateInfo: &rollapptypes.StateInfo{
+						Sequencer:        keepertest.Alice.Address,
+						StartHeight:      1,
+						NumBlocks:        3,
+						BDsRoot:          []byte("root"),
+						BDsRootNumBlocks: 3,
+						BDs: rollapptypes.BlockDescriptors{
+							BD: []rollapptypes.BlockDescriptor{
+								{
+									Height:    1,
+									StateRoot: []byte("test"),
+									Timestamp: time.Unix(1724392989, 0),
+								},
+								{
+									Height:    3,
+									StateRoot: []byte("test3"),
+									Timestamp: time.Unix(1724392989, 0).Add(1),
+								},
+							},
+						},
+					},
+				}
+			},
+			expectErr: true,
+		},
 		{
 			name: "state is compatible",
 			prepare: func(ctx sdk.Context, k lightClientKeeper.Keeper) input {
@@ -88,6 +88,8 @@ func (i IBCMessagesDecorator) HandleMsgUpdateClient(ctx sdk.Context, msg *ibccli
 		return errorsmod.Wrap(err, "find state info by height")
 	}
 
+	// a compressed state does not hold the heights it does not sample: the header cannot be checked against
+	// it, so it is rejected, and the client must be updated at a sampled height instead
 	err = i.k.ValidateHeaderAgainstStateInfo(ctx, sInfo, header.ConsensusState(), h)
 	if err != nil {
 		return errorsmod.Wrap(err, "validate pessimistic")
@@ -73,6 +73,10 @@ func (m *MockRollappKeeper) GetStateInfo(ctx sdk.Context, rollappId string, inde
 	return val, found
 }
 
+func (m *MockRollappKeeper) FirstStateInfoIndex(ctx sdk.Context, rollappId string) uint64 {
+	return 1
+}
+
 func (m *MockRollappKeeper) HardFork(ctx sdk.Context, rollappID string, fraudHeight uint64) error {
 	return nil
 }
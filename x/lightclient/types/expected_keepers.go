@@ -27,6 +27,7 @@ type RollappKeeperExpected interface {
 
 	GetLatestStateInfoIndex(ctx sdk.Context, rollappId string) (rollapptypes.StateInfoIndex, bool)
 	GetStateInfo(ctx sdk.Context, rollappId string, index uint64) (sInfo rollapptypes.StateInfo, found bool)
+	FirstStateInfoIndex(ctx sdk.Context, rollappId string) uint64
 }
 
 type IBCClientKeeperExpected interface {
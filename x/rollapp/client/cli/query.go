@@ -27,6 +27,10 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 	cmd.AddCommand(CmdShowLatestHeight())
 	cmd.AddCommand(CmdShowLatestStateIndex())
 	cmd.AddCommand(CmdQueryRegisteredDenoms())
+	cmd.AddCommand(CmdShowChallenge())
+	cmd.AddCommand(CmdListChallenges())
+	cmd.AddCommand(CmdVerifyBlockDescriptor())
+	cmd.AddCommand(CmdShowLiveness())
 
 	return cmd
 }
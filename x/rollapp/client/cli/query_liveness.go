@@ -0,0 +1,49 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdShowLiveness() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "liveness [rollapp-id]",
+		Short: "Show the liveness health of a rollapp, or of all the rollapps if none is given",
+		Long: `Show the liveness health of a rollapp, or of all the rollapps if none is given: the last state update,
+the blocks until the next liveness slash, and the bond, dishonor and projected slash of the proposer.`,
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			if len(args) == 1 {
+				res, err := queryClient.RollappLiveness(cmd.Context(), &types.QueryRollappLivenessRequest{RollappId: args[0]})
+				if err != nil {
+					return err
+				}
+				return clientCtx.PrintProto(res)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			res, err := queryClient.RollappLivenessAll(cmd.Context(), &types.QueryRollappLivenessAllRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
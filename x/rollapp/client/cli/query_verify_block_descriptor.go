@@ -0,0 +1,47 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdVerifyBlockDescriptor() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-block-descriptor [rollapp-id] [block-descriptor-json] [proof-json]",
+		Short: "Verify a block descriptor against the state holding its height",
+		Long:  "Verify a block descriptor against the state holding its height. The proof is only needed for heights which a compressed state does not sample.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryVerifyBlockDescriptorRequest{RollappId: args[0]}
+			if err = clientCtx.Codec.UnmarshalJSON([]byte(args[1]), &req.BlockDescriptor); err != nil {
+				return err
+			}
+			if len(args) == 3 {
+				req.Proof = new(types.BlockDescriptorProof)
+				if err = clientCtx.Codec.UnmarshalJSON([]byte(args[2]), req.Proof); err != nil {
+					return err
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.VerifyBlockDescriptor(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
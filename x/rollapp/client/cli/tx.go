@@ -25,6 +25,10 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdAddApp())
 	cmd.AddCommand(CmdUpdateApp())
 	cmd.AddCommand(CmdRemoveApp())
+	cmd.AddCommand(CmdChallengeState())
+	cmd.AddCommand(CmdRespondChallenge())
+	cmd.AddCommand(CmdUpdateDisputePeriod())
+	cmd.AddCommand(CmdUpdateSequencerBondDenoms())
 
 	return cmd
 }
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"encoding/hex"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdChallengeState() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "challenge-state [rollapp-id] [height] [state-root-hex] [evidence]",
+		Short:   "Challenge the block descriptor of a pending state, escrowing the challenge bond",
+		Example: "dymd tx rollapp challenge-state rollapp_1234-1 42 0a1b..ff 'da path of the block'",
+		Args:    cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+			stateRoot, err := hex.DecodeString(args[2])
+			if err != nil {
+				return err
+			}
+			var evidence string
+			if len(args) == 4 {
+				evidence = args[3]
+			}
+
+			msg := &types.MsgChallengeState{
+				Challenger: clientCtx.GetFromAddress().String(),
+				RollappId:  args[0],
+				Height:     height,
+				StateRoot:  stateRoot,
+				Evidence:   evidence,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRespondChallenge() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "respond-challenge [challenge-id] [response]",
+		Short: "Respond to a challenge of a state submitted by the sequencer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRespondChallenge{
+				Sequencer:   clientCtx.GetFromAddress().String(),
+				ChallengeId: id,
+				Response:    args[1],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func CmdUpdateSequencerBondDenoms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "update-sequencer-bond-denoms [rollapp-id] [denoms...]",
+		Short:   "Set the denoms, other than DYM, the sequencers of the rollapp can bond in",
+		Example: "dymd tx rollapp update-sequencer-bond-denoms ROLLAPP_CHAIN_ID ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Args:    cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateSequencerBondDenoms{
+				Owner:     clientCtx.GetFromAddress().String(),
+				RollappId: args[0],
+				Denoms:    args[1:],
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
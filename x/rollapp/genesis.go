@@ -13,9 +13,22 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.RollappList {
 		k.SetRollapp(ctx, elem)
 	}
-	// Set all the stateInfo
+	// Set all the stateInfo, the states missing before the first one of a rollapp were pruned
+	firstStateInfoIndex := make(map[string]uint64)
 	for _, elem := range genState.StateInfoList {
 		k.SetStateInfo(ctx, elem)
+		if first, ok := firstStateInfoIndex[elem.StateInfoIndex.RollappId]; !ok || elem.StateInfoIndex.Index < first {
+			firstStateInfoIndex[elem.StateInfoIndex.RollappId] = elem.StateInfoIndex.Index
+		}
+	}
+	for _, elem := range genState.StateInfoList {
+		rollappID := elem.StateInfoIndex.RollappId
+		if first, ok := firstStateInfoIndex[rollappID]; ok && 1 < first {
+			if err := k.SetFirstStateInfoIndex(ctx, rollappID, first); err != nil {
+				panic(err)
+			}
+			delete(firstStateInfoIndex, rollappID)
+		}
 	}
 	// Set all the latestStateInfoIndex
 	for _, elem := range genState.LatestStateInfoIndexList {
@@ -58,6 +71,25 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 			panic(err)
 		}
 	}
+	// Set all the challenges, and index the pending ones
+	for _, elem := range genState.Challenges {
+		if err := k.SetChallenge(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	if err := k.SetChallengeSeq(ctx, genState.ChallengeSeq); err != nil {
+		panic(err)
+	}
+	for _, elem := range genState.PendingChallenges {
+		if err := k.SetPendingChallenge(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.ChallengeDeadlines {
+		if err := k.SetChallengeDeadline(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
 
 	k.SetParams(ctx, genState.Params)
 }
@@ -108,5 +140,22 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	}
 	genesis.ObsoleteDrsVersions = drsVersions
 
+	genesis.Challenges, err = k.GetAllChallenges(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ChallengeSeq, err = k.GetChallengeSeq(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.PendingChallenges, err = k.GetAllPendingChallenges(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.ChallengeDeadlines, err = k.GetAllChallengeDeadlines(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return genesis
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	keepertest "github.com/dymensionxyz/dymension/v3/testutil/keeper"
@@ -117,3 +118,62 @@ func TestInitExportGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.BlockHeightToFinalizationQueueList, got.BlockHeightToFinalizationQueueList)
 	require.ElementsMatch(t, genesisState.AppList, got.AppList)
 }
+
+func TestInitExportGenesisChallenges(t *testing.T) {
+	const rollappID = "rollapp_1234-1"
+
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		Challenges: []types.Challenge{
+			{
+				Id:        0,
+				RollappId: rollappID,
+				Height:    5,
+				StateRoot: []byte{0xab},
+				Bond:      sdk.NewCoin("adym", math.NewInt(100)),
+				Deadline:  20,
+				Status:    types.ChallengeStatus_CHALLENGE_STATUS_RESPONDED,
+				Response:  "valid",
+			},
+			{
+				Id:        1,
+				RollappId: rollappID,
+				Height:    7,
+				StateRoot: []byte{0xab},
+				Bond:      sdk.NewCoin("adym", math.NewInt(100)),
+				Deadline:  12,
+				Status:    types.ChallengeStatus_CHALLENGE_STATUS_DISMISSED,
+			},
+		},
+		ChallengeSeq: 2,
+		PendingChallenges: []types.PendingChallenge{
+			{RollappId: rollappID, Height: 5, ChallengeId: 0},
+		},
+		ChallengeDeadlines: []types.ChallengeDeadline{
+			{HubHeight: 20, ChallengeId: 0},
+		},
+	}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.RollappKeeper(t)
+	rollapp.InitGenesis(ctx, *k, genesisState)
+	got := rollapp.ExportGenesis(ctx, *k)
+	require.NotNil(t, got)
+	require.NoError(t, got.Validate())
+
+	nullify.Fill(genesisState)
+	nullify.Fill(*got)
+
+	require.ElementsMatch(t, genesisState.Challenges, got.Challenges)
+	require.Equal(t, genesisState.ChallengeSeq, got.ChallengeSeq)
+	require.ElementsMatch(t, genesisState.PendingChallenges, got.PendingChallenges)
+	require.ElementsMatch(t, genesisState.ChallengeDeadlines, got.ChallengeDeadlines)
+
+	// the restored challenges are queryable
+	c, err := k.GetChallenge(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, types.ChallengeStatus_CHALLENGE_STATUS_RESPONDED, c.Status)
+	res, err := k.Challenges(ctx, &types.QueryChallengesRequest{RollappId: rollappID})
+	require.NoError(t, err)
+	require.Len(t, res.Challenges, 2)
+}
@@ -58,7 +58,10 @@ func (k Keeper) AllSequencerHeightPairs(ctx sdk.Context) ([]types.SequencerHeigh
 }
 
 // FinalizeRollappStates is called every block to finalize states when their dispute period over.
+// The states of the rollapps which may have their own dispute period are finalized apart.
 func (k Keeper) FinalizeRollappStates(ctx sdk.Context) {
+	k.finalizeDisputePeriodRollappStates(ctx)
+
 	h := uint64(ctx.BlockHeight()) //nolint:gosec
 	if h < k.DisputePeriodInBlocks(ctx) {
 		// hub just started
@@ -75,9 +78,90 @@ func (k Keeper) FinalizeRollappStates(ctx sdk.Context) {
 		return
 	}
 
+	queue = slices.DeleteFunc(queue, func(q types.BlockHeightToFinalizationQueue) bool {
+		own, err := k.disputePeriodRollapps.Has(ctx, q.RollappId)
+		return err != nil || own
+	})
+
 	k.FinalizeAllPending(ctx, queue)
 }
 
+// finalizeDisputePeriodRollappStates finalizes the states of the rollapps which may have their own dispute
+// period, in order, up to the first one whose dispute period is not over. Rollapps without pending states
+// which no longer have their own dispute period are forgotten.
+func (k Keeper) finalizeDisputePeriodRollappStates(ctx sdk.Context) {
+	iter, err := k.disputePeriodRollapps.Iterate(ctx, nil)
+	if err != nil {
+		k.Logger(ctx).Error("Dispute period rollapps.", "err", err)
+		return
+	}
+	rollapps, err := iter.Keys()
+	if err != nil {
+		k.Logger(ctx).Error("Dispute period rollapps.", "err", err)
+		return
+	}
+
+	params := k.GetParams(ctx)
+	for _, rollappID := range rollapps {
+		due, pending, err := k.getDueFinalizationQueueByRollapp(ctx, params, rollappID)
+		if err != nil {
+			k.Logger(ctx).Error("Due finalization queue.", "rollapp", rollappID, "err", err)
+			continue
+		}
+		if !pending && k.MustGetRollapp(ctx, rollappID).DisputePeriodInBlocks == 0 {
+			if err := k.disputePeriodRollapps.Remove(ctx, rollappID); err != nil {
+				k.Logger(ctx).Error("Remove dispute period rollapp.", "rollapp", rollappID, "err", err)
+			}
+			continue
+		}
+		k.FinalizeAllPending(ctx, due)
+	}
+}
+
+// StateFinalizationHeight returns the hub height from which the pending state can be finalized, unless
+// its finalization is paused
+func (k Keeper) StateFinalizationHeight(ctx sdk.Context, stateInfo *types.StateInfo) uint64 {
+	params := k.GetParams(ctx)
+	queue, found := k.GetFinalizationQueue(ctx, stateInfo.CreationHeight, stateInfo.StateInfoIndex.RollappId)
+	if !found {
+		return stateInfo.CreationHeight + params.DisputePeriodInBlocks
+	}
+	return queue.FinalizationHeight(params)
+}
+
+// getDueFinalizationQueueByRollapp returns the queues of the rollapp, in order of creation height, up to the
+// first one whose dispute period is not over. It also returns whether the rollapp has any queue.
+func (k Keeper) getDueFinalizationQueueByRollapp(
+	ctx sdk.Context,
+	params types.Params,
+	rollapp string,
+) ([]types.BlockHeightToFinalizationQueue, bool, error) {
+	iter, err := k.finalizationQueue.Indexes.RollappIDReverseLookup.MatchExact(ctx, rollapp)
+	if err != nil {
+		return nil, false, err
+	}
+	defer iter.Close() // nolint: errcheck
+
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	pending := iter.Valid()
+	var res []types.BlockHeightToFinalizationQueue
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.PrimaryKey()
+		if err != nil {
+			return nil, false, err
+		}
+		queue, err := k.finalizationQueue.Get(ctx, key)
+		if err != nil {
+			return nil, false, err
+		}
+		if h < queue.FinalizationHeight(params) {
+			break
+		}
+		res = append(res, queue)
+	}
+	return res, pending, nil
+}
+
 // FinalizeAllPending is called every block to finalize all pending states in the queue.
 // pendingQueues contains queues in ascending order of creation height. There may be multiple queues for the same
 // creation height since multiple rollapps may have pending states. In that case, the queues are ordered by rollappID.
@@ -150,11 +234,19 @@ func (k *Keeper) finalizePendingState(ctx sdk.Context, stateInfoIndex types.Stat
 	if stateInfo.Status != common.Status_PENDING {
 		panic(fmt.Sprintf("invariant broken: stateInfo is not in pending state: rollapp: %s: status: %s", stateInfoIndex.RollappId, stateInfo.Status))
 	}
+	// finalization of the rollapp is paused until the challenges of the state are resolved
+	if err := k.checkNotChallenged(ctx, stateInfoIndex.RollappId, stateInfo.GetLatestHeight()); err != nil {
+		return err
+	}
 	stateInfo.Finalize()
 	// update the status of the stateInfo
 	k.SetStateInfo(ctx, stateInfo)
 	// update the LatestStateInfoIndex of the rollapp
 	k.SetLatestFinalizedStateIndex(ctx, stateInfoIndex)
+	// the previous states may eventually be pruned
+	if err := k.queueStateInfoPruning(ctx, stateInfoIndex.RollappId); err != nil {
+		return errorsmod.Wrap(err, "queue state info pruning")
+	}
 
 	for _, bd := range stateInfo.BDs.BD {
 		// sequencer is no longer liable
@@ -179,6 +271,11 @@ func (k *Keeper) finalizePendingState(ctx sdk.Context, stateInfoIndex types.Stat
 
 // SetFinalizationQueue set types.BlockHeightToFinalizationQueue for a specific height and rollappID.
 func (k Keeper) SetFinalizationQueue(ctx sdk.Context, queue types.BlockHeightToFinalizationQueue) error {
+	if queue.DisputePeriodInBlocks != 0 {
+		if err := k.disputePeriodRollapps.Set(ctx, queue.RollappId); err != nil {
+			return err
+		}
+	}
 	return k.finalizationQueue.Set(ctx, collections.Join(queue.CreationHeight, queue.RollappId), queue)
 }
 
@@ -0,0 +1,377 @@
+package keeper
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+/*
+A challenge is a bonded claim that the block descriptor of a pending state is fraudulent. The sequencer of the
+state must respond before the response deadline, otherwise the challenge is upheld: the sequencer is punished,
+with the challenger as rewardee, and the rollapp is hard forked to the height before the challenged one.
+The response cannot be checked on chain, so a responded challenge must be adjudicated by the authority before
+the resolution deadline, otherwise it is voided, refunding the bond: an honest sequencer which responded is never
+punished by default. A dismissed challenge pays the bond to the sequencer.
+The challenged state, and those after it, do not finalize meanwhile. A state can only be challenged during its
+dispute period, so a challenge delays its finalization by the response and resolution windows at most.
+*/
+
+func (k Keeper) GetChallenge(ctx sdk.Context, id uint64) (types.Challenge, error) {
+	c, err := k.challenges.Get(ctx, id)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Challenge{}, errorsmod.Wrapf(types.ErrChallengeNotFound, "id: %d", id)
+	}
+	return c, err
+}
+
+func (k Keeper) GetChallengesPaginated(ctx sdk.Context, rollappID string, pageReq *query.PageRequest) ([]types.Challenge, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.challengesByRollapp, pageReq,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.Challenge, error) {
+			return k.challenges.Get(ctx, key.K2())
+		}, collcompat.WithCollectionPaginationPairPrefix[string, uint64](rollappID),
+	)
+}
+
+func (k Keeper) SetChallenge(ctx sdk.Context, c types.Challenge) error {
+	if err := k.challenges.Set(ctx, c.Id, c); err != nil {
+		return err
+	}
+	return k.challengesByRollapp.Set(ctx, collections.Join(c.RollappId, c.Id))
+}
+
+// ChallengeState bonds a challenge against the block descriptor of the height, which must be in a pending state.
+// The block descriptor of a height a compressed state does not sample is given by the msg, with its proof.
+func (k Keeper) ChallengeState(ctx sdk.Context, msg *types.MsgChallengeState) (types.Challenge, error) {
+	rollapp, found := k.GetRollapp(ctx, msg.RollappId)
+	if !found {
+		return types.Challenge{}, types.ErrRollappNotFound
+	}
+	stateInfo, err := k.FindStateInfoByHeight(ctx, msg.RollappId, msg.Height)
+	if err != nil {
+		return types.Challenge{}, errorsmod.Wrap(err, "find state info")
+	}
+	if stateInfo.Status != common.Status_PENDING {
+		return types.Challenge{}, errorsmod.Wrapf(types.ErrDisputeAlreadyFinalized, "height: %d", msg.Height)
+	}
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	if k.StateFinalizationHeight(ctx, stateInfo) <= h {
+		return types.Challenge{}, errorsmod.Wrapf(types.ErrDisputePeriodOver, "height: %d", msg.Height)
+	}
+	bd, ok := stateInfo.GetBlockDescriptor(msg.Height)
+	if !ok {
+		// a compressed state does not hold the heights it does not sample, they are proven against its root
+		if msg.BlockDescriptor == nil {
+			return types.Challenge{}, errorsmod.Wrapf(types.ErrBlockDescriptorNotSampled, "height: %d", msg.Height)
+		}
+		if err := stateInfo.VerifyBlockDescriptor(*msg.BlockDescriptor, msg.Proof); err != nil {
+			return types.Challenge{}, errorsmod.Wrap(err, "verify block descriptor")
+		}
+		bd = *msg.BlockDescriptor
+	}
+	if bytes.Equal(bd.StateRoot, msg.StateRoot) {
+		return types.Challenge{}, types.ErrStateRootMatches
+	}
+
+	pending, err := k.pendingChallenges.Has(ctx, collections.Join(msg.RollappId, msg.Height))
+	if err != nil {
+		return types.Challenge{}, err
+	}
+	if pending {
+		return types.Challenge{}, errorsmod.Wrapf(types.ErrChallengeExists, "height: %d", msg.Height)
+	}
+
+	params := k.GetParams(ctx)
+	challenger := sdk.MustAccAddressFromBech32(msg.Challenger)
+	err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, challenger, types.ModuleName, sdk.NewCoins(params.ChallengeBond))
+	if err != nil {
+		return types.Challenge{}, errorsmod.Wrap(err, "escrow bond")
+	}
+
+	id, err := k.challengeSeq.Next(ctx)
+	if err != nil {
+		return types.Challenge{}, errorsmod.Wrap(err, "next challenge id")
+	}
+	c := types.Challenge{
+		Id:            id,
+		RollappId:     msg.RollappId,
+		Challenger:    msg.Challenger,
+		StateIndex:    stateInfo.StateInfoIndex.Index,
+		Height:        msg.Height,
+		Revision:      rollapp.GetRevisionForHeight(msg.Height).Number,
+		Sequencer:     stateInfo.Sequencer,
+		StateRoot:     msg.StateRoot,
+		Evidence:      msg.Evidence,
+		Bond:          params.ChallengeBond,
+		Status:        types.ChallengeStatus_CHALLENGE_STATUS_OPEN,
+		CreatedHeight: h,
+		Deadline:      h + params.ChallengeResponseBlocks,
+	}
+	if err := k.SetChallenge(ctx, c); err != nil {
+		return types.Challenge{}, err
+	}
+	if err := k.pendingChallenges.Set(ctx, collections.Join(c.RollappId, c.Height), c.Id); err != nil {
+		return types.Challenge{}, err
+	}
+	if err := k.challengeDeadlines.Set(ctx, collections.Join(c.Deadline, c.Id)); err != nil {
+		return types.Challenge{}, err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventChallengeCreated{Challenge: c}); err != nil {
+		return types.Challenge{}, fmt.Errorf("emit event: %w", err)
+	}
+	return c, nil
+}
+
+// RespondChallenge records the response of the sequencer of the challenged state, which then awaits
+// the adjudication of the authority until the resolution deadline
+func (k Keeper) RespondChallenge(ctx sdk.Context, msg *types.MsgRespondChallenge) error {
+	c, err := k.GetChallenge(ctx, msg.ChallengeId)
+	if err != nil {
+		return err
+	}
+	if c.Status != types.ChallengeStatus_CHALLENGE_STATUS_OPEN {
+		return errorsmod.Wrapf(types.ErrChallengeNotOpen, "status: %s", c.Status)
+	}
+	if msg.Sequencer != c.Sequencer {
+		return errorsmod.Wrap(types.ErrUnauthorizedSigner, "not the sequencer of the challenged state")
+	}
+
+	if err := k.challengeDeadlines.Remove(ctx, collections.Join(c.Deadline, c.Id)); err != nil {
+		return err
+	}
+	c.Status = types.ChallengeStatus_CHALLENGE_STATUS_RESPONDED
+	c.Response = msg.Response
+	c.Deadline = uint64(ctx.BlockHeight()) + k.GetParams(ctx).ChallengeResolutionBlocks //nolint:gosec
+	if err := k.SetChallenge(ctx, c); err != nil {
+		return err
+	}
+	if err := k.challengeDeadlines.Set(ctx, collections.Join(c.Deadline, c.Id)); err != nil {
+		return err
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventChallengeResponded{Challenge: c}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+// ResolveChallenge adjudicates a pending challenge: it is upheld if fraud is true, dismissed otherwise
+func (k Keeper) ResolveChallenge(ctx sdk.Context, id uint64, fraud bool) error {
+	c, err := k.GetChallenge(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !c.IsPending() {
+		return errorsmod.Wrapf(types.ErrChallengeNotPending, "status: %s", c.Status)
+	}
+	if fraud {
+		return k.upholdChallenge(ctx, c, "adjudicated fraudulent")
+	}
+	return k.dismissChallenge(ctx, c, "adjudicated valid")
+}
+
+// upholdChallenge refunds the bond, punishes the sequencer with the challenger as rewardee and hard forks
+// the rollapp to the height before the challenged one
+func (k Keeper) upholdChallenge(ctx sdk.Context, c types.Challenge, reason string) error {
+	if err := k.closeChallenge(ctx, &c, types.ChallengeStatus_CHALLENGE_STATUS_UPHELD, reason); err != nil {
+		return err
+	}
+	challenger := sdk.MustAccAddressFromBech32(c.Challenger)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, challenger, sdk.NewCoins(c.Bond)); err != nil {
+		return errorsmod.Wrap(err, "refund bond")
+	}
+	if err := k.SequencerK.PunishSequencer(ctx, c.Sequencer, &challenger); err != nil {
+		return errorsmod.Wrap(err, "punish sequencer")
+	}
+	if err := k.HardFork(ctx, c.RollappId, c.Height-1); err != nil {
+		return errorsmod.Wrap(err, "hard fork")
+	}
+	return nil
+}
+
+// dismissChallenge pays the bond to the sequencer
+func (k Keeper) dismissChallenge(ctx sdk.Context, c types.Challenge, reason string) error {
+	if err := k.closeChallenge(ctx, &c, types.ChallengeStatus_CHALLENGE_STATUS_DISMISSED, reason); err != nil {
+		return err
+	}
+	seq := sdk.MustAccAddressFromBech32(c.Sequencer)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, seq, sdk.NewCoins(c.Bond)); err != nil {
+		return errorsmod.Wrap(err, "pay bond to sequencer")
+	}
+	return nil
+}
+
+// voidChallenge refunds the bond
+func (k Keeper) voidChallenge(ctx sdk.Context, c types.Challenge, reason string) error {
+	if err := k.closeChallenge(ctx, &c, types.ChallengeStatus_CHALLENGE_STATUS_VOID, reason); err != nil {
+		return err
+	}
+	challenger := sdk.MustAccAddressFromBech32(c.Challenger)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, challenger, sdk.NewCoins(c.Bond)); err != nil {
+		return errorsmod.Wrap(err, "refund bond")
+	}
+	return nil
+}
+
+func (k Keeper) closeChallenge(ctx sdk.Context, c *types.Challenge, status types.ChallengeStatus, reason string) error {
+	if err := k.pendingChallenges.Remove(ctx, collections.Join(c.RollappId, c.Height)); err != nil {
+		return err
+	}
+	if err := k.challengeDeadlines.Remove(ctx, collections.Join(c.Deadline, c.Id)); err != nil {
+		return err
+	}
+	c.Status = status
+	if err := k.SetChallenge(ctx, *c); err != nil {
+		return err
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventChallengeResolved{Challenge: *c, Reason: reason}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+// voidChallengesAbove voids the pending challenges of heights reverted by a hard fork
+func (k Keeper) voidChallengesAbove(ctx sdk.Context, rollappID string, lastValidHeight uint64) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](rollappID).StartExclusive(lastValidHeight)
+	iter, err := k.pendingChallenges.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	ids, err := iter.Values()
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		c, err := k.GetChallenge(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.voidChallenge(ctx, c, "height reverted by hard fork"); err != nil {
+			return errorsmod.Wrapf(err, "void challenge: %d", id)
+		}
+	}
+	return nil
+}
+
+// checkNotChallenged returns an error if there is a pending challenge of the rollapp up to the height
+func (k Keeper) checkNotChallenged(ctx sdk.Context, rollappID string, height uint64) error {
+	rng := collections.NewPrefixedPairRange[string, uint64](rollappID).EndInclusive(height)
+	iter, err := k.pendingChallenges.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	defer iter.Close() // nolint: errcheck
+	if !iter.Valid() {
+		return nil
+	}
+	key, err := iter.Key()
+	if err != nil {
+		return err
+	}
+	return errorsmod.Wrapf(types.ErrStateChallenged, "height: %d", key.K2())
+}
+
+// ProcessChallengeDeadlines is called at the end of every block. Open challenges whose response deadline
+// passed are upheld, while responded challenges whose resolution deadline passed are voided. A challenge which
+// cannot be upheld, e.g. because forking is no longer allowed, is voided.
+func (k Keeper) ProcessChallengeDeadlines(ctx sdk.Context) {
+	rng := collections.NewPrefixUntilPairRange[uint64, uint64](uint64(ctx.BlockHeight())) //nolint:gosec
+	iter, err := k.challengeDeadlines.Iterate(ctx, rng)
+	if err != nil {
+		k.Logger(ctx).Error("Challenge deadlines.", "err", err)
+		return
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		k.Logger(ctx).Error("Challenge deadlines.", "err", err)
+		return
+	}
+
+	for _, key := range keys {
+		c, err := k.GetChallenge(ctx, key.K2())
+		if err != nil {
+			k.Logger(ctx).Error("Challenge deadline: get challenge.", "id", key.K2(), "err", err)
+			continue
+		}
+		switch c.Status {
+		case types.ChallengeStatus_CHALLENGE_STATUS_OPEN:
+			err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.upholdChallenge(ctx, c, "response deadline passed")
+			})
+			if err != nil {
+				k.Logger(ctx).Error("Challenge deadline: uphold.", "id", c.Id, "err", err)
+				reason := fmt.Sprintf("cannot uphold: %s", err)
+				err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+					return k.voidChallenge(ctx, c, reason)
+				})
+			}
+		case types.ChallengeStatus_CHALLENGE_STATUS_RESPONDED:
+			err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+				return k.voidChallenge(ctx, c, "resolution deadline passed")
+			})
+		default:
+			err = errorsmod.Wrapf(gerrc.ErrInternal, "deadline of challenge not pending: status: %s", c.Status)
+		}
+		if err != nil {
+			// the deadline is kept, to be retried next block
+			k.Logger(ctx).Error("Challenge deadline.", "id", c.Id, "err", err)
+		}
+	}
+}
+
+// GetAllChallenges returns all the challenges, the closed ones included
+func (k Keeper) GetAllChallenges(ctx sdk.Context) ([]types.Challenge, error) {
+	iter, err := k.challenges.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// GetChallengeSeq returns the id of the next challenge
+func (k Keeper) GetChallengeSeq(ctx sdk.Context) (uint64, error) {
+	return k.challengeSeq.Peek(ctx)
+}
+
+func (k Keeper) SetChallengeSeq(ctx sdk.Context, seq uint64) error {
+	return k.challengeSeq.Set(ctx, seq)
+}
+
+func (k Keeper) GetAllPendingChallenges(ctx sdk.Context) ([]types.PendingChallenge, error) {
+	ret := make([]types.PendingChallenge, 0)
+	err := k.pendingChallenges.Walk(ctx, nil, func(key collections.Pair[string, uint64], id uint64) (stop bool, err error) {
+		ret = append(ret, types.PendingChallenge{RollappId: key.K1(), Height: key.K2(), ChallengeId: id})
+		return false, nil
+	})
+	return ret, err
+}
+
+func (k Keeper) SetPendingChallenge(ctx sdk.Context, p types.PendingChallenge) error {
+	return k.pendingChallenges.Set(ctx, collections.Join(p.RollappId, p.Height), p.ChallengeId)
+}
+
+func (k Keeper) GetAllChallengeDeadlines(ctx sdk.Context) ([]types.ChallengeDeadline, error) {
+	ret := make([]types.ChallengeDeadline, 0)
+	err := k.challengeDeadlines.Walk(ctx, nil, func(key collections.Pair[uint64, uint64]) (stop bool, err error) {
+		ret = append(ret, types.ChallengeDeadline{HubHeight: key.K1(), ChallengeId: key.K2()})
+		return false, nil
+	})
+	return ret, err
+}
+
+func (k Keeper) SetChallengeDeadline(ctx sdk.Context, d types.ChallengeDeadline) error {
+	return k.challengeDeadlines.Set(ctx, collections.Join(d.HubHeight, d.ChallengeId))
+}
@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestChallengeState() {
+	wrongRoot := bytes.Repeat([]byte{0xab}, 32)
+
+	setup := func() (string, string, sdk.AccAddress) {
+		s.SetupTest()
+		s.Ctx = s.Ctx.WithBlockHeight(1)
+
+		rollappID, proposer := s.CreateDefaultRollappAndProposer()
+		_, err := s.PostStateUpdate(s.Ctx, rollappID, proposer, 1, 10)
+		s.Require().NoError(err)
+
+		challenger := apptesting.CreateRandomAccounts(1)[0]
+		apptesting.FundAccount(s.App, s.Ctx, challenger, sdk.NewCoins(s.k().ChallengeBond(s.Ctx)))
+		return rollappID, proposer, challenger
+	}
+
+	challenge := func(rollappID string, challenger sdk.AccAddress, height uint64) (types.Challenge, error) {
+		return s.k().ChallengeState(s.Ctx, &types.MsgChallengeState{
+			Challenger: challenger.String(),
+			RollappId:  rollappID,
+			Height:     height,
+			StateRoot:  wrongRoot,
+		})
+	}
+
+	s.Run("invalid challenges", func() {
+		rollappID, _, challenger := setup()
+
+		stateInfo, ok := s.k().GetLatestStateInfo(s.Ctx, rollappID)
+		s.Require().True(ok)
+		bd, _ := stateInfo.GetBlockDescriptor(5)
+		_, err := s.k().ChallengeState(s.Ctx, &types.MsgChallengeState{
+			Challenger: challenger.String(),
+			RollappId:  rollappID,
+			Height:     5,
+			StateRoot:  bd.StateRoot,
+		})
+		s.Require().ErrorIs(err, types.ErrStateRootMatches)
+
+		_, err = challenge(rollappID, challenger, 11)
+		s.Require().Error(err)
+
+		_, err = challenge(rollappID, challenger, 5)
+		s.Require().NoError(err)
+		s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, challenger).IsZero())
+
+		_, err = challenge(rollappID, challenger, 5)
+		s.Require().ErrorIs(err, types.ErrChallengeExists)
+	})
+
+	s.Run("responded challenge is dismissed by the authority", func() {
+		rollappID, proposer, challenger := setup()
+		params := s.k().GetParams(s.Ctx)
+
+		c, err := challenge(rollappID, challenger, 5)
+		s.Require().NoError(err)
+		s.Require().Equal(proposer, c.Sequencer)
+		s.Require().Equal(uint64(1)+params.ChallengeResponseBlocks, c.Deadline)
+
+		// finalization is paused
+		s.k().FinalizeRollappStates(s.Ctx.WithBlockHeight(int64(params.DisputePeriodInBlocks) + 2))
+		stateInfo, ok := s.k().GetLatestStateInfo(s.Ctx, rollappID)
+		s.Require().True(ok)
+		s.Require().Equal(common.Status_PENDING, stateInfo.Status)
+
+		err = s.k().RespondChallenge(s.Ctx, &types.MsgRespondChallenge{Sequencer: challenger.String(), ChallengeId: c.Id})
+		s.Require().ErrorIs(err, types.ErrUnauthorizedSigner)
+
+		s.Ctx = s.Ctx.WithBlockHeight(10)
+		err = s.k().RespondChallenge(s.Ctx, &types.MsgRespondChallenge{Sequencer: proposer, ChallengeId: c.Id, Response: "valid"})
+		s.Require().NoError(err)
+		c, err = s.k().GetChallenge(s.Ctx, c.Id)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ChallengeStatus_CHALLENGE_STATUS_RESPONDED, c.Status)
+		s.Require().Equal(uint64(10)+params.ChallengeResolutionBlocks, c.Deadline)
+
+		// the response deadline no longer applies
+		s.k().ProcessChallengeDeadlines(s.Ctx.WithBlockHeight(int64(1 + params.ChallengeResponseBlocks)))
+		c, err = s.k().GetChallenge(s.Ctx, c.Id)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ChallengeStatus_CHALLENGE_STATUS_RESPONDED, c.Status)
+
+		seqBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, sdk.MustAccAddressFromBech32(proposer))
+		s.Ctx = s.Ctx.WithBlockHeight(int64(c.Deadline) - 1)
+		s.Require().NoError(s.k().ResolveChallenge(s.Ctx, c.Id, false))
+		c, err = s.k().GetChallenge(s.Ctx, c.Id)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ChallengeStatus_CHALLENGE_STATUS_DISMISSED, c.Status)
+		s.Require().Equal(
+			seqBalance.Add(c.Bond),
+			s.App.BankKeeper.GetAllBalances(s.Ctx, sdk.MustAccAddressFromBech32(proposer)),
+		)
+		s.AssertEventEmitted(s.Ctx, "dymensionxyz.dymension.rollapp.EventChallengeResolved", 1)
+
+		// finalization resumes
+		s.k().FinalizeRollappStates(s.Ctx)
+		stateInfo, ok = s.k().GetLatestStateInfo(s.Ctx, rollappID)
+		s.Require().True(ok)
+		s.Require().Equal(common.Status_FINALIZED, stateInfo.Status)
+	})
+
+	s.Run("unresolved responded challenge is voided at the resolution deadline", func() {
+		rollappID, proposer, challenger := setup()
+
+		c, err := challenge(rollappID, challenger, 5)
+		s.Require().NoError(err)
+		err = s.k().RespondChallenge(s.Ctx, &types.MsgRespondChallenge{Sequencer: proposer, ChallengeId: c.Id, Response: "valid"})
+		s.Require().NoError(err)
+		c, err = s.k().GetChallenge(s.Ctx, c.Id)
+		s.Require().NoError(err)
+
+		s.Ctx = s.Ctx.WithBlockHeight(int64(c.Deadline))
+		s.k().ProcessChallengeDeadlines(s.Ctx)
+		c, err = s.k().GetChallenge(s.Ctx, c.Id)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ChallengeStatus_CHALLENGE_STATUS_VOID, c.Status)
+		s.Require().Equal(sdk.NewCoins(c.Bond), s.App.BankKeeper.GetAllBalances(s.Ctx, challenger))
+
+		// the sequencer is not punished and the rollapp is not forked
+		seq, err := s.App.SequencerKeeper.RealSequencer(s.Ctx, proposer)
+		s.Require().NoError(err)
+		s.Require().True(seq.Bonded())
+		s.Require().Equal(uint64(10), s.GetRollappLastHeight(rollappID))
+	})
+
+	s.Run("states are only challenged during their dispute period", func() {
+		rollappID, _, challenger := setup()
+		stateInfo, ok := s.k().GetLatestStateInfo(s.Ctx, rollappID)
+		s.Require().True(ok)
+
+		s.Ctx = s.Ctx.WithBlockHeight(int64(s.k().StateFinalizationHeight(s.Ctx, &stateInfo)))
+		_, err := challenge(rollappID, challenger, 5)
+		s.Require().ErrorIs(err, types.ErrDisputePeriodOver)
+	})
+
+	s.Run("unanswered challenge is upheld at the response deadline", func() {
+		rollappID, proposer, challenger := setup()
+
+		c, err := challenge(rollappID, challenger, 5)
+		s.Require().NoError(err)
+
+		s.Ctx = s.Ctx.WithBlockHeight(int64(c.Deadline))
+		s.k().ProcessChallengeDeadlines(s.Ctx)
+		c, err = s.k().GetChallenge(s.Ctx, c.Id)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ChallengeStatus_CHALLENGE_STATUS_UPHELD, c.Status)
+
+		// the rollapp forked at the challenged height
+		rollapp := s.k().MustGetRollapp(s.Ctx, rollappID)
+		s.Require().EqualValues(1, rollapp.GetRevisionForHeight(5).Number)
+		s.Require().Equal(uint64(4), s.GetRollappLastHeight(rollappID))
+
+		// the bond is refunded and the challenger rewarded
+		s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, challenger).IsAllGT(sdk.NewCoins(c.Bond)))
+		seq, err := s.App.SequencerKeeper.RealSequencer(s.Ctx, proposer)
+		s.Require().NoError(err)
+		s.Require().False(seq.Bonded())
+
+		// late responses are rejected
+		err = s.k().RespondChallenge(s.Ctx, &types.MsgRespondChallenge{Sequencer: proposer, ChallengeId: c.Id})
+		s.Require().ErrorIs(err, types.ErrChallengeNotOpen)
+	})
+
+	s.Run("challenges of reverted heights are voided by a hard fork", func() {
+		rollappID, _, challenger := setup()
+
+		c, err := challenge(rollappID, challenger, 8)
+		s.Require().NoError(err)
+
+		s.Require().NoError(s.k().HardFork(s.Ctx, rollappID, 6))
+		c, err = s.k().GetChallenge(s.Ctx, c.Id)
+		s.Require().NoError(err)
+		s.Require().Equal(types.ChallengeStatus_CHALLENGE_STATUS_VOID, c.Status)
+		s.Require().Equal(sdk.NewCoins(c.Bond), s.App.BankKeeper.GetAllBalances(s.Ctx, challenger))
+
+		res, err := s.queryClient.Challenges(s.Ctx, &types.QueryChallengesRequest{RollappId: rollappID})
+		s.Require().NoError(err)
+		s.Require().Len(res.Challenges, 1)
+	})
+}
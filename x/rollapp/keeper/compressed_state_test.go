@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"bytes"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/app/apptesting"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestCompressedStateUpdate() {
+	s.k().SetHooks(nil)
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+
+	// the sequencer commits to all the blocks and samples a few
+	const start, num = 1, 100
+	all := types.BlockDescriptors{BD: make([]types.BlockDescriptor, num)}
+	for i := range all.BD {
+		all.BD[i] = types.BlockDescriptor{
+			Height:     start + uint64(i),
+			StateRoot:  bytes.Repeat([]byte{byte(i)}, 32),
+			Timestamp:  time.Unix(1_700_000_000+int64(i), 0).UTC(),
+			DrsVersion: 1,
+		}
+	}
+	root, proofs := all.Proofs()
+	s.Require().Equal(root, all.Root())
+
+	sampled := types.BlockDescriptors{BD: []types.BlockDescriptor{all.BD[0], all.BD[49], all.BD[num-1]}}
+	msg := &types.MsgUpdateState{
+		Creator:     proposer,
+		RollappId:   rollappID,
+		StartHeight: start,
+		NumBlocks:   num,
+		BDs:         sampled,
+		BDsRoot:     root,
+	}
+	s.Require().NoError(msg.ValidateBasic())
+	_, err := s.msgServer.UpdateState(s.Ctx, msg)
+	s.Require().NoError(err)
+
+	stateInfo, ok := s.k().GetLatestStateInfo(s.Ctx, rollappID)
+	s.Require().True(ok)
+	s.Require().True(stateInfo.Compressed())
+	s.Require().Equal(uint64(num), stateInfo.GetLatestHeight())
+	s.Require().Equal(uint64(num), stateInfo.BDsRootNumBlocks)
+	bd, ok := stateInfo.GetBlockDescriptor(50)
+	s.Require().True(ok)
+	s.Require().Equal(all.BD[49].StateRoot, bd.StateRoot)
+	_, ok = stateInfo.GetBlockDescriptor(51)
+	s.Require().False(ok)
+
+	verify := func(bd types.BlockDescriptor, proof *types.BlockDescriptorProof) *types.QueryVerifyBlockDescriptorResponse {
+		res, err := s.queryClient.VerifyBlockDescriptor(s.Ctx, &types.QueryVerifyBlockDescriptorRequest{
+			RollappId:       rollappID,
+			BlockDescriptor: bd,
+			Proof:           proof,
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(stateInfo.StateInfoIndex, res.StateInfoIndex)
+		return res
+	}
+
+	// sampled heights need no proof
+	s.Require().True(verify(all.BD[49], nil).Valid)
+
+	// unsampled heights are proven against the root
+	s.Require().True(verify(all.BD[50], &proofs[50]).Valid)
+	s.Require().False(verify(all.BD[50], nil).Valid)
+	s.Require().False(verify(all.BD[50], &proofs[51]).Valid)
+
+	tampered := all.BD[50]
+	tampered.StateRoot = bytes.Repeat([]byte{0xff}, 32)
+	s.Require().False(verify(tampered, &proofs[50]).Valid)
+
+	// proofs must be over all the committed blocks
+	short := types.BlockDescriptors{BD: all.BD[:num-1]}
+	_, shortProofs := short.Proofs()
+	s.Require().False(verify(all.BD[50], &shortProofs[50]).Valid)
+
+	// unsampled heights are challenged with their proof
+	challenger := apptesting.CreateRandomAccounts(1)[0]
+	apptesting.FundAccount(s.App, s.Ctx, challenger, sdk.NewCoins(s.k().ChallengeBond(s.Ctx)))
+	challenge := &types.MsgChallengeState{
+		Challenger: challenger.String(),
+		RollappId:  rollappID,
+		Height:     51,
+		StateRoot:  bytes.Repeat([]byte{0xff}, 32),
+	}
+	_, err = s.k().ChallengeState(s.Ctx, challenge)
+	s.Require().ErrorIs(err, types.ErrBlockDescriptorNotSampled)
+	challenge.BlockDescriptor, challenge.Proof = &all.BD[50], &proofs[51]
+	_, err = s.k().ChallengeState(s.Ctx, challenge)
+	s.Require().ErrorIs(err, types.ErrInvalidBlockDescriptorProof)
+	challenge.Proof = &proofs[50]
+	c, err := s.k().ChallengeState(s.Ctx, challenge)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(51), c.Height)
+
+	// a hard fork truncates the state and keeps the proofs valid
+	truncated, err := s.k().UpdateLastStateInfo(s.Ctx, &stateInfo, 60)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(59), truncated.GetLatestHeight())
+	s.Require().Equal(uint64(num), truncated.BDsRootNumBlocks)
+	s.Require().Len(truncated.BDs.BD, 2)
+	s.Require().NoError(truncated.VerifyBlockDescriptor(all.BD[55], &proofs[55]))
+	s.Require().ErrorIs(truncated.VerifyBlockDescriptor(all.BD[70], &proofs[70]), types.ErrStateNotExists)
+}
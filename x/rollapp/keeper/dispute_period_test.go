@@ -0,0 +1,75 @@
+package keeper_test
+
+import (
+	common "github.com/dymensionxyz/dymension/v3/x/common/types"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestRollappDisputePeriod() {
+	s.k().SetHooks(nil)
+	s.k().SetParams(s.Ctx, s.k().GetParams(s.Ctx).WithDisputePeriodInBlocks(2).WithDisputePeriodBounds(1, 20))
+	s.Ctx = s.Ctx.WithBlockHeight(1)
+
+	own, ownProposer := s.CreateDefaultRollappAndProposer()
+	global, globalProposer := s.CreateDefaultRollappAndProposer()
+	owner := s.k().MustGetRollapp(s.Ctx, own).Owner
+
+	// out of bounds
+	_, err := s.msgServer.UpdateDisputePeriod(s.Ctx, &types.MsgUpdateDisputePeriod{
+		Owner:                 owner,
+		RollappId:             own,
+		DisputePeriodInBlocks: 21,
+	})
+	s.Require().ErrorIs(err, types.ErrInvalidDisputePeriod)
+
+	_, err = s.msgServer.UpdateDisputePeriod(s.Ctx, &types.MsgUpdateDisputePeriod{
+		Owner:                 owner,
+		RollappId:             own,
+		DisputePeriodInBlocks: 10,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(10), s.k().RollappDisputePeriodInBlocks(s.Ctx, own))
+	s.Require().Equal(uint64(2), s.k().RollappDisputePeriodInBlocks(s.Ctx, global))
+
+	_, err = s.PostStateUpdate(s.Ctx, own, ownProposer, 1, 10)
+	s.Require().NoError(err)
+	_, err = s.PostStateUpdate(s.Ctx, global, globalProposer, 1, 10)
+	s.Require().NoError(err)
+
+	ownState, _ := s.k().GetLatestStateInfo(s.Ctx, own)
+	s.Require().Equal(uint64(11), s.k().StateFinalizationHeight(s.Ctx, &ownState))
+
+	// the states already submitted keep their dispute period
+	_, err = s.msgServer.UpdateDisputePeriod(s.Ctx, &types.MsgUpdateDisputePeriod{
+		Owner:     owner,
+		RollappId: own,
+	})
+	s.Require().NoError(err)
+
+	assertStatus := func(rollappID string, status common.Status) {
+		s.T().Helper()
+		stateInfo, ok := s.k().GetLatestStateInfo(s.Ctx, rollappID)
+		s.Require().True(ok)
+		s.Require().Equal(status, stateInfo.Status)
+	}
+
+	s.Ctx = s.Ctx.WithBlockHeight(3)
+	s.k().FinalizeRollappStates(s.Ctx)
+	assertStatus(global, common.Status_FINALIZED)
+	assertStatus(own, common.Status_PENDING)
+
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	s.k().FinalizeRollappStates(s.Ctx)
+	assertStatus(own, common.Status_PENDING)
+
+	s.Ctx = s.Ctx.WithBlockHeight(11)
+	s.k().FinalizeRollappStates(s.Ctx)
+	assertStatus(own, common.Status_FINALIZED)
+
+	// new states follow the global dispute period again
+	_, err = s.PostStateUpdate(s.Ctx, own, ownProposer, 11, 10)
+	s.Require().NoError(err)
+	s.Ctx = s.Ctx.WithBlockHeight(13)
+	s.k().FinalizeRollappStates(s.Ctx)
+	assertStatus(own, common.Status_FINALIZED)
+}
@@ -20,12 +20,14 @@ type SequencerKeeper interface {
 	GetProposer(ctx sdk.Context, rollappId string) types.Sequencer
 	GetSuccessor(ctx sdk.Context, rollapp string) types.Sequencer
 	SlashLiveness(ctx sdk.Context, rollappID string) error
+	LivenessSlashAmount(ctx sdk.Context, seq types.Sequencer) sdk.Coins
 	PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.AccAddress) error
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, name string, amt sdk.Coins) error
 }
 
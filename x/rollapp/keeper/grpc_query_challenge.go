@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) Challenge(c context.Context, req *types.QueryChallengeRequest) (*types.QueryChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	challenge, err := k.GetChallenge(sdk.UnwrapSDKContext(c), req.Id)
+	if errorsmod.IsOf(err, types.ErrChallengeNotFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChallengeResponse{Challenge: challenge}, nil
+}
+
+func (k Keeper) Challenges(c context.Context, req *types.QueryChallengesRequest) (*types.QueryChallengesResponse, error) {
+	if req == nil || req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	challenges, pageResp, err := k.GetChallengesPaginated(sdk.UnwrapSDKContext(c), req.RollappId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChallengesResponse{
+		Challenges: challenges,
+		Pagination: pageResp,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k Keeper) RollappLiveness(c context.Context, req *types.QueryRollappLivenessRequest) (*types.QueryRollappLivenessResponse, error) {
+	if req == nil || req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	ra, ok := k.GetRollapp(ctx, req.RollappId)
+	if !ok {
+		return nil, status.Error(codes.NotFound, types.ErrUnknownRollappID.Error())
+	}
+
+	return &types.QueryRollappLivenessResponse{Liveness: k.GetRollappLiveness(ctx, ra)}, nil
+}
+
+func (k Keeper) RollappLivenessAll(c context.Context, req *types.QueryRollappLivenessAllRequest) (*types.QueryRollappLivenessAllResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var liveness []types.RollappLiveness
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rollappStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.RollappKeyPrefix))
+	pageRes, err := query.Paginate(rollappStore, req.Pagination, func(key []byte, value []byte) error {
+		var ra types.Rollapp
+		if err := k.cdc.Unmarshal(value, &ra); err != nil {
+			return err
+		}
+		liveness = append(liveness, k.GetRollappLiveness(ctx, ra))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRollappLivenessAllResponse{Liveness: liveness, Pagination: pageRes}, nil
+}
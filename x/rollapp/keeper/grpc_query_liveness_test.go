@@ -0,0 +1,61 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestRollappLivenessQuery() {
+	s.k().SetHooks(nil)
+	params := s.k().GetParams(s.Ctx).WithLivenessSlashBlocks(100).WithLivenessSlashInterval(10)
+	s.k().SetParams(s.Ctx, params)
+
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+	idle := s.CreateDefaultRollapp()
+	_, err := s.PostStateUpdate(s.Ctx, rollappID, proposer, 1, 10)
+	s.Require().NoError(err)
+
+	// 30 blocks later
+	s.Ctx = s.Ctx.WithBlockHeight(40)
+	res, err := s.k().RollappLiveness(s.Ctx, &types.QueryRollappLivenessRequest{RollappId: rollappID})
+	s.Require().NoError(err)
+	l := res.Liveness
+	s.Require().Equal(uint64(10), l.LastStateUpdateHeight)
+	s.Require().Equal(int64(10), l.LivenessCountdownStartHeight)
+	s.Require().Equal(int64(110), l.NextSlashHeight)
+	s.Require().Equal(int64(70), l.BlocksUntilSlash)
+
+	seq := s.App.SequencerKeeper.GetProposer(s.Ctx, rollappID)
+	s.Require().Equal(proposer, l.Proposer)
+	s.Require().Equal(seq.AllTokens(), l.ProposerBond)
+	s.Require().Equal(seq.Dishonor, l.ProposerDishonor)
+	s.Require().Equal(s.App.SequencerKeeper.LivenessSlashAmount(s.Ctx, seq), l.ProjectedSlash)
+	s.Require().True(l.ProjectedSlash.IsAllPositive())
+
+	// past the first slash, the next ones are an interval apart
+	s.Ctx = s.Ctx.WithBlockHeight(115)
+	res, err = s.k().RollappLiveness(s.Ctx, &types.QueryRollappLivenessRequest{RollappId: rollappID})
+	s.Require().NoError(err)
+	s.Require().Equal(int64(120), res.Liveness.NextSlashHeight)
+	s.Require().Equal(int64(5), res.Liveness.BlocksUntilSlash)
+
+	// no slash is scheduled without a proposer
+	res, err = s.k().RollappLiveness(s.Ctx, &types.QueryRollappLivenessRequest{RollappId: idle})
+	s.Require().NoError(err)
+	s.Require().Zero(res.Liveness.LastStateUpdateHeight)
+	s.Require().Zero(res.Liveness.NextSlashHeight)
+	s.Require().Zero(res.Liveness.BlocksUntilSlash)
+	s.Require().True(res.Liveness.ProjectedSlash.IsZero())
+
+	_, err = s.k().RollappLiveness(s.Ctx, &types.QueryRollappLivenessRequest{RollappId: "unknown_1-1"})
+	s.Require().Error(err)
+
+	all, err := s.k().RollappLivenessAll(s.Ctx, &types.QueryRollappLivenessAllRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(all.Liveness, 1)
+	s.Require().Equal(uint64(2), all.Pagination.Total)
+}
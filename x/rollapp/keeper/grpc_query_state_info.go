@@ -42,6 +42,9 @@ func (k Keeper) StateInfo(c context.Context, req *types.QueryGetStateInfoRequest
 	var stateInfo types.StateInfo
 	if req.Index != 0 {
 		val, found := k.GetStateInfo(ctx, req.RollappId, req.Index)
+		if !found && k.IsStateInfoPruned(ctx, req.RollappId, req.Index) {
+			return nil, status.Error(codes.OutOfRange, types.ErrStateInfoPruned.Error())
+		}
 		if !found {
 			return nil, status.Error(codes.NotFound, "not found")
 		}
@@ -76,8 +79,11 @@ func (k Keeper) FindStateInfoByHeight(ctx sdk.Context, rollappId string, height
 			rollappId)
 	}
 
-	// initial interval to search in
-	startInfoIndex := uint64(1) // TODO: handle pruned states (https://github.com/dymensionxyz/dymension/issues/1307)
+	// initial interval to search in, the states before the first one kept are pruned
+	startInfoIndex := k.FirstStateInfoIndex(ctx, rollappId)
+	if first, ok := k.GetStateInfo(ctx, rollappId, startInfoIndex); ok && height < first.StartHeight {
+		return nil, errorsmod.Wrapf(types.ErrStateInfoPruned, "rollappId=%s, height=%d", rollappId, height)
+	}
 	endInfoIndex := ss.StateInfoIndex.Index
 	for startInfoIndex <= endInfoIndex {
 		midIndex := startInfoIndex + (endInfoIndex-startInfoIndex)/2
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// VerifyBlockDescriptor checks the block descriptor against the state holding its height, with a proof
+// of inclusion for heights which a compressed state does not sample
+func (k Keeper) VerifyBlockDescriptor(c context.Context, req *types.QueryVerifyBlockDescriptorRequest) (*types.QueryVerifyBlockDescriptorResponse, error) {
+	if req == nil || req.RollappId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	stateInfo, err := k.FindStateInfoByHeight(sdk.UnwrapSDKContext(c), req.RollappId, req.BlockDescriptor.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryVerifyBlockDescriptorResponse{StateInfoIndex: stateInfo.StateInfoIndex}
+	if err := stateInfo.VerifyBlockDescriptor(req.BlockDescriptor, req.Proof); err != nil {
+		res.Err = err.Error()
+	} else {
+		res.Valid = true
+	}
+	return res, nil
+}
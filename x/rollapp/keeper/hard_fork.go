@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 
 	errorsmod "cosmossdk.io/errors"
@@ -35,6 +36,11 @@ func (k Keeper) HardFork(ctx sdk.Context, rollappID string, lastValidHeight uint
 		rollapp.BumpRevision(newRevisionHeight)
 	}
 
+	// the challenges of the reverted heights are moot
+	if err := k.voidChallengesAbove(ctx, rollappID, lastValidHeight); err != nil {
+		return errorsmod.Wrap(err, "void challenges")
+	}
+
 	// stop liveness events
 	k.ResetLivenessClock(ctx, &rollapp)
 	k.SetRollapp(ctx, rollapp)
@@ -137,6 +143,15 @@ func (k Keeper) UpdateLastStateInfo(ctx sdk.Context, stateInfo *types.StateInfo,
 		if !ok {
 			return nil, errorsmod.Wrapf(gerrc.ErrFailedPrecondition, "no state info found for rollapp: %s", stateInfo.StateInfoIndex.RollappId)
 		}
+	} else if stateInfo.GetLatestHeight() >= fraudHeight && stateInfo.Compressed() {
+		// Keep the sampled block descriptors before the fraud height. The root still commits to
+		// the kept blocks, so their proofs remain valid.
+		truncatedBDs := slices.DeleteFunc(stateInfo.BDs.BD, func(bd types.BlockDescriptor) bool {
+			return fraudHeight <= bd.Height
+		})
+
+		stateInfo.NumBlocks = fraudHeight - stateInfo.StartHeight
+		stateInfo.BDs.BD = truncatedBDs
 	} else if stateInfo.GetLatestHeight() >= fraudHeight {
 		// Remove block descriptors until the one we need to rollback to
 		truncatedBDs := stateInfo.BDs.BD[:fraudHeight-stateInfo.StartHeight]
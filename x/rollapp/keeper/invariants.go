@@ -244,7 +244,7 @@ func RollappFinalizedStateInvariant(k Keeper) sdk.Invariant {
 				continue
 			}
 
-			for i := uint64(1); i <= latestFinalizedStateIdx.Index; i++ {
+			for i := k.FirstStateInfoIndex(ctx, rollapp.RollappId); i <= latestFinalizedStateIdx.Index; i++ {
 				stateInfo, found := k.GetStateInfo(ctx, rollapp.RollappId, i)
 				if !found {
 					msg += fmt.Sprintf("rollapp (%s) have no stateInfo at index %d\n", rollapp.RollappId, i)
@@ -48,6 +48,24 @@ type Keeper struct {
 
 	finalizePending        func(ctx sdk.Context, stateInfoIndex types.StateInfoIndex) error
 	seqToUnfinalizedHeight collections.KeySet[collections.Pair[string, uint64]]
+	// disputePeriodRollapps are the rollapps whose pending states may have their own dispute period.
+	// Their finalization queues are not finalized along with the others, see FinalizeRollappStates.
+	disputePeriodRollapps collections.KeySet[string]
+
+	// challenge id -> challenge
+	challenges   collections.Map[uint64, types.Challenge]
+	challengeSeq collections.Sequence
+	// <rollapp id, challenge id>
+	challengesByRollapp collections.KeySet[collections.Pair[string, uint64]]
+	// <rollapp id, height> -> challenge id, for challenges awaiting a response or an adjudication
+	pendingChallenges collections.Map[collections.Pair[string, uint64], uint64]
+	// <hub height, challenge id>, the deadlines of the pending challenges
+	challengeDeadlines collections.KeySet[collections.Pair[uint64, uint64]]
+
+	// rollapp id -> index of the first state kept, the states below it are pruned. Absent if none is pruned.
+	firstStateInfoIndex collections.Map[string, uint64]
+	// rollapps which may have finalized states to prune
+	stateInfoPruneQueue collections.KeySet[string]
 }
 
 func NewKeeper(
@@ -111,6 +129,56 @@ func NewKeeper(
 			"seq_to_unfinalized_height",
 			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
 		),
+		disputePeriodRollapps: collections.NewKeySet(
+			sb,
+			types.DisputePeriodRollappsKeyPrefix,
+			"dispute_period_rollapps",
+			collections.StringKey,
+		),
+		challenges: collections.NewMap(
+			sb,
+			types.ChallengesKeyPrefix,
+			"challenges",
+			collections.Uint64Key,
+			collcompat.ProtoValue[types.Challenge](cdc),
+		),
+		challengeSeq: collections.NewSequence(
+			sb,
+			types.ChallengeSeqKey,
+			"challenge_seq",
+		),
+		challengesByRollapp: collections.NewKeySet(
+			sb,
+			types.ChallengesByRollappKeyPrefix,
+			"challenges_by_rollapp",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+		),
+		pendingChallenges: collections.NewMap(
+			sb,
+			types.PendingChallengesKeyPrefix,
+			"pending_challenges",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			collections.Uint64Value,
+		),
+		challengeDeadlines: collections.NewKeySet(
+			sb,
+			types.ChallengeDeadlinesKeyPrefix,
+			"challenge_deadlines",
+			collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key),
+		),
+		firstStateInfoIndex: collections.NewMap(
+			sb,
+			types.FirstStateInfoIndexKeyPrefix,
+			"first_state_info_index",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		stateInfoPruneQueue: collections.NewKeySet(
+			sb,
+			types.StateInfoPruneQueueKeyPrefix,
+			"state_info_prune_queue",
+			collections.StringKey,
+		),
 	}
 	k.SetFinalizePendingFn(k.finalizePendingState)
 	return k
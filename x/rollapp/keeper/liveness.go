@@ -101,6 +101,39 @@ func (k Keeper) ScheduleLivenessEvent(ctx sdk.Context, ra *types.Rollapp) {
 	})
 }
 
+// GetRollappLiveness returns the liveness health of the rollapp: when it is going to be slashed for not
+// updating its state, and what its proposer stands to lose.
+func (k Keeper) GetRollappLiveness(ctx sdk.Context, ra types.Rollapp) types.RollappLiveness {
+	l := types.RollappLiveness{
+		RollappId:                    ra.RollappId,
+		LivenessCountdownStartHeight: ra.LivenessCountdownStartHeight,
+	}
+	if s, ok := k.GetLatestStateInfo(ctx, ra.RollappId); ok {
+		l.LastStateUpdateHeight = s.CreationHeight
+	}
+	// no event is scheduled until there is a proposer, nor after a hard fork until the next state update
+	if ra.LivenessEventHeight != 0 {
+		l.NextSlashHeight = NextSlashHeight(
+			k.LivenessSlashBlocks(ctx),
+			k.LivenessSlashInterval(ctx),
+			ctx.BlockHeight(),
+			ra.LivenessCountdownStartHeight,
+		)
+		l.BlocksUntilSlash = l.NextSlashHeight - ctx.BlockHeight()
+	}
+
+	proposer := k.SequencerK.GetProposer(ctx, ra.RollappId)
+	l.Proposer = proposer.Address
+	l.ProposerBond = proposer.AllTokens()
+	l.ProposerDishonor = proposer.GetPenalty()
+	// the sentinel has no bond, and is not slashed
+	l.ProjectedSlash = l.ProposerBond
+	if !proposer.Sentinel() {
+		l.ProjectedSlash = k.SequencerK.LivenessSlashAmount(ctx, proposer)
+	}
+	return l
+}
+
 // GetLivenessEvents returns events. If a height is specified, only for that height.
 func (k Keeper) GetLivenessEvents(ctx sdk.Context, height *int64) []types.LivenessEvent {
 	store := ctx.KVStore(k.storeKey)
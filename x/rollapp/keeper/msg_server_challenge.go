@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (k msgServer) ChallengeState(goCtx context.Context, msg *types.MsgChallengeState) (*types.MsgChallengeStateResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	c, err := k.Keeper.ChallengeState(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgChallengeStateResponse{ChallengeId: c.Id}, nil
+}
+
+func (k msgServer) RespondChallenge(goCtx context.Context, msg *types.MsgRespondChallenge) (*types.MsgRespondChallengeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.RespondChallenge(ctx, msg); err != nil {
+		return nil, err
+	}
+	return &types.MsgRespondChallengeResponse{}, nil
+}
+
+func (k msgServer) ResolveChallenge(goCtx context.Context, msg *types.MsgResolveChallenge) (*types.MsgResolveChallengeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if msg.Authority != k.authority {
+		return nil, errorsmod.Wrap(gerrc.ErrUnauthenticated, "only the gov module can resolve challenges")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.ResolveChallenge(ctx, msg.ChallengeId, msg.Fraud); err != nil {
+		return nil, err
+	}
+	return &types.MsgResolveChallengeResponse{}, nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// UpdateDisputePeriod sets the dispute period of the rollapp. The states already submitted keep theirs.
+func (k msgServer) UpdateDisputePeriod(goCtx context.Context, msg *types.MsgUpdateDisputePeriod) (*types.MsgUpdateDisputePeriodResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+	if rollapp.Owner != msg.Owner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	params := k.GetParams(ctx)
+	if msg.DisputePeriodInBlocks != 0 && params.ClampDisputePeriod(msg.DisputePeriodInBlocks) != msg.DisputePeriodInBlocks {
+		return nil, errorsmod.Wrapf(types.ErrInvalidDisputePeriod, "min: %d: max: %d",
+			params.MinDisputePeriodInBlocks, params.MaxDisputePeriodInBlocks)
+	}
+
+	rollapp.DisputePeriodInBlocks = msg.DisputePeriodInBlocks
+	k.SetRollapp(ctx, rollapp)
+
+	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgUpdateDisputePeriodResponse{}, nil
+}
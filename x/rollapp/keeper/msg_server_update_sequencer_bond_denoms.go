@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// UpdateSequencerBondDenoms sets the denoms the sequencers of the rollapp can bond in, besides DYM. Unlike
+// the other sequencer settings it can be changed after launch, as the rollapp token only reaches the hub
+// through IBC once the rollapp is running.
+func (k msgServer) UpdateSequencerBondDenoms(goCtx context.Context, msg *types.MsgUpdateSequencerBondDenoms) (*types.MsgUpdateSequencerBondDenomsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rollapp, ok := k.GetRollapp(ctx, msg.RollappId)
+	if !ok {
+		return nil, types.ErrUnknownRollappID
+	}
+	if rollapp.Owner != msg.Owner {
+		return nil, types.ErrUnauthorizedSigner
+	}
+
+	rollapp.SequencerBondDenoms = msg.Denoms
+	k.SetRollapp(ctx, rollapp)
+
+	if err := uevent.EmitTypedEvent(ctx, msg); err != nil {
+		return nil, fmt.Errorf("emit event: %w", err)
+	}
+
+	return &types.MsgUpdateSequencerBondDenomsResponse{}, nil
+}
@@ -95,6 +95,10 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		blockTime,
 		successor.Address,
 	)
+	stateInfo.BDsRoot = msg.BDsRoot
+	if msg.Compressed() {
+		stateInfo.BDsRootNumBlocks = msg.NumBlocks
+	}
 
 	// verify the DRS version is not obsolete
 	// check only last block descriptor DRS, since if that last is not obsolete it means the rollapp already upgraded and is not obsolete anymore
@@ -134,11 +138,18 @@ func (k msgServer) UpdateState(goCtx context.Context, msg *types.MsgUpdateState)
 		newFinalizationQueue = append(finalizationQueue.FinalizationQueue, newFinalizationQueue...)
 	}
 
+	// the dispute period of the rollapp, if it has its own, is fixed when the state is submitted
+	var disputePeriod uint64
+	if rollapp.DisputePeriodInBlocks != 0 {
+		disputePeriod = max(k.GetParams(ctx).ClampDisputePeriod(rollapp.DisputePeriodInBlocks), finalizationQueue.DisputePeriodInBlocks)
+	}
+
 	// Write new BlockHeightToFinalizationQueue
 	err = k.SetFinalizationQueue(ctx, types.BlockHeightToFinalizationQueue{
-		CreationHeight:    creationHeight,
-		FinalizationQueue: newFinalizationQueue,
-		RollappId:         msg.RollappId,
+		CreationHeight:        creationHeight,
+		FinalizationQueue:     newFinalizationQueue,
+		RollappId:             msg.RollappId,
+		DisputePeriodInBlocks: disputePeriod,
 	})
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "set finalization queue")
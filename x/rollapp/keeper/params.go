@@ -27,6 +27,17 @@ func (k Keeper) DisputePeriodInBlocks(ctx sdk.Context) (res uint64) {
 	return k.GetParams(ctx).DisputePeriodInBlocks
 }
 
+// RollappDisputePeriodInBlocks returns the dispute period of the states the rollapp submits, that is its own
+// dispute period, bounded by the params, if it set one, and the global one otherwise
+func (k Keeper) RollappDisputePeriodInBlocks(ctx sdk.Context, rollappID string) uint64 {
+	params := k.GetParams(ctx)
+	rollapp, found := k.GetRollapp(ctx, rollappID)
+	if !found || rollapp.DisputePeriodInBlocks == 0 {
+		return params.DisputePeriodInBlocks
+	}
+	return params.ClampDisputePeriod(rollapp.DisputePeriodInBlocks)
+}
+
 func (k Keeper) LivenessSlashBlocks(ctx sdk.Context) (res uint64) {
 	return k.GetParams(ctx).LivenessSlashBlocks
 }
@@ -43,3 +54,7 @@ func (k Keeper) AppRegistrationFee(ctx sdk.Context) (res sdk.Coin) {
 func (k Keeper) MinSequencerBondGlobal(ctx sdk.Context) (res sdk.Coin) {
 	return k.GetParams(ctx).MinSequencerBondGlobal
 }
+
+func (k Keeper) ChallengeBond(ctx sdk.Context) (res sdk.Coin) {
+	return k.GetParams(ctx).ChallengeBond
+}
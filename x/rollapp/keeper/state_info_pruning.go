@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+// FirstStateInfoIndex returns the index of the first state of the rollapp which is not pruned
+func (k Keeper) FirstStateInfoIndex(ctx sdk.Context, rollappID string) uint64 {
+	ix, err := k.firstStateInfoIndex.Get(ctx, rollappID)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 1
+	}
+	if err != nil {
+		panic(err)
+	}
+	return ix
+}
+
+// SetFirstStateInfoIndex records that the states of the rollapp below the index are pruned
+func (k Keeper) SetFirstStateInfoIndex(ctx sdk.Context, rollappID string, index uint64) error {
+	return k.firstStateInfoIndex.Set(ctx, rollappID, index)
+}
+
+// IsStateInfoPruned returns true if the state of the index was pruned
+func (k Keeper) IsStateInfoPruned(ctx sdk.Context, rollappID string, index uint64) bool {
+	return index < k.FirstStateInfoIndex(ctx, rollappID)
+}
+
+// queueStateInfoPruning is called when a state of the rollapp is finalized, as the previous ones may
+// eventually be pruned
+func (k Keeper) queueStateInfoPruning(ctx sdk.Context, rollappID string) error {
+	return k.stateInfoPruneQueue.Set(ctx, rollappID)
+}
+
+// PruneStateInfos is called at the end of every block. It deletes, up to the per block limit, the finalized
+// states of the queued rollapps which were submitted more than the retention window ago. The latest
+// finalized state of a rollapp is always kept, as finalization, hard forks and the light client build on it,
+// and pending states are never pruned. Rollapps without prunable states left are dequeued until their next
+// finalization.
+func (k Keeper) PruneStateInfos(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.StateInfoRetentionBlocks == 0 {
+		return
+	}
+
+	iter, err := k.stateInfoPruneQueue.Iterate(ctx, nil)
+	if err != nil {
+		k.Logger(ctx).Error("State info prune queue.", "err", err)
+		return
+	}
+	rollapps, err := iter.Keys()
+	if err != nil {
+		k.Logger(ctx).Error("State info prune queue.", "err", err)
+		return
+	}
+
+	budget := params.MaxPrunedStateInfosPerBlock
+	for _, rollappID := range rollapps {
+		if budget == 0 {
+			return
+		}
+		err = osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			n, err := k.pruneRollappStateInfos(ctx, rollappID, params.StateInfoRetentionBlocks, budget)
+			budget -= n
+			return err
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Prune state infos.", "rollapp", rollappID, "err", err)
+		}
+	}
+}
+
+// pruneRollappStateInfos prunes up to limit states of the rollapp and returns how many it pruned
+func (k Keeper) pruneRollappStateInfos(ctx sdk.Context, rollappID string, retention, limit uint64) (uint64, error) {
+	latestFinalized, ok := k.GetLatestFinalizedStateIndex(ctx, rollappID)
+	if !ok {
+		return 0, k.stateInfoPruneQueue.Remove(ctx, rollappID)
+	}
+
+	h := uint64(ctx.BlockHeight()) //nolint:gosec
+	first := k.FirstStateInfoIndex(ctx, rollappID)
+	ix := first
+	for ; ix < latestFinalized.Index && ix-first < limit; ix++ {
+		s, ok := k.GetStateInfo(ctx, rollappID, ix)
+		if !ok {
+			return 0, errorsmod.Wrapf(types.ErrStateNotExists, "index: %d", ix)
+		}
+		if h < s.CreationHeight+retention {
+			// the next states were submitted later, so they are within the window too
+			break
+		}
+		k.RemoveStateInfo(ctx, rollappID, ix)
+	}
+	if ix == latestFinalized.Index {
+		if err := k.stateInfoPruneQueue.Remove(ctx, rollappID); err != nil {
+			return 0, errorsmod.Wrap(err, "dequeue rollapp")
+		}
+	}
+	if ix == first {
+		return 0, nil
+	}
+
+	if err := k.SetFirstStateInfoIndex(ctx, rollappID, ix); err != nil {
+		return 0, errorsmod.Wrap(err, "set first state info index")
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventStateInfosPruned{
+		RollappId:  rollappID,
+		FirstIndex: first,
+		LastIndex:  ix - 1,
+	}); err != nil {
+		return 0, fmt.Errorf("emit event: %w", err)
+	}
+	return ix - first, nil
+}
@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+)
+
+func (s *RollappTestSuite) TestPruneStateInfos() {
+	s.k().SetHooks(nil)
+	params := s.k().GetParams(s.Ctx).
+		WithDisputePeriodInBlocks(2).
+		WithDisputePeriodBounds(1, 20).
+		WithStateInfoRetentionBlocks(20)
+	params.MaxPrunedStateInfosPerBlock = 2
+	s.k().SetParams(s.Ctx, params)
+
+	rollappID, proposer := s.CreateDefaultRollappAndProposer()
+
+	// a state of 10 blocks is submitted at each of the hub heights 1..5, and finalized 2 blocks later
+	for i := uint64(0); i < 5; i++ {
+		_, err := s.PostStateUpdate(s.Ctx.WithBlockHeight(int64(i+1)), rollappID, proposer, 1+10*i, 10)
+		s.Require().NoError(err)
+	}
+	s.k().FinalizeRollappStates(s.Ctx.WithBlockHeight(7))
+	latestFinalized, ok := s.k().GetLatestFinalizedStateIndex(s.Ctx, rollappID)
+	s.Require().True(ok)
+	s.Require().Equal(uint64(5), latestFinalized.Index)
+
+	// within the retention window
+	s.k().PruneStateInfos(s.Ctx.WithBlockHeight(20))
+	s.Require().Equal(uint64(1), s.k().FirstStateInfoIndex(s.Ctx, rollappID))
+
+	// the states submitted at hub heights 1..3 are past the window, but only 2 are pruned per block
+	s.Ctx = s.Ctx.WithBlockHeight(23)
+	s.k().PruneStateInfos(s.Ctx)
+	s.Require().Equal(uint64(3), s.k().FirstStateInfoIndex(s.Ctx, rollappID))
+	s.k().PruneStateInfos(s.Ctx)
+	s.Require().Equal(uint64(4), s.k().FirstStateInfoIndex(s.Ctx, rollappID))
+	_, ok = s.k().GetStateInfo(s.Ctx, rollappID, 3)
+	s.Require().False(ok)
+
+	// pruned heights and indexes are reported as such
+	_, err := s.k().FindStateInfoByHeight(s.Ctx, rollappID, 25)
+	s.Require().ErrorIs(err, types.ErrStateInfoPruned)
+	stateInfo, err := s.k().FindStateInfoByHeight(s.Ctx, rollappID, 35)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(4), stateInfo.StateInfoIndex.Index)
+	_, err = s.queryClient.StateInfo(s.Ctx, &types.QueryGetStateInfoRequest{RollappId: rollappID, Index: 2})
+	s.Require().ErrorContains(err, types.ErrStateInfoPruned.Error())
+
+	// the latest finalized state is always kept
+	s.Ctx = s.Ctx.WithBlockHeight(100)
+	s.k().PruneStateInfos(s.Ctx)
+	s.Require().Equal(uint64(5), s.k().FirstStateInfoIndex(s.Ctx, rollappID))
+	_, ok = s.k().GetLatestFinalizedStateInfo(s.Ctx, rollappID)
+	s.Require().True(ok)
+
+	// the rollapp is dequeued until its next finalization
+	_, err = s.PostStateUpdate(s.Ctx, rollappID, proposer, 51, 10)
+	s.Require().NoError(err)
+	s.k().PruneStateInfos(s.Ctx.WithBlockHeight(200))
+	s.Require().Equal(uint64(5), s.k().FirstStateInfoIndex(s.Ctx, rollappID))
+	s.k().FinalizeRollappStates(s.Ctx.WithBlockHeight(102))
+	s.k().PruneStateInfos(s.Ctx.WithBlockHeight(200))
+	s.Require().Equal(uint64(6), s.k().FirstStateInfoIndex(s.Ctx, rollappID))
+
+	msg, broken := keeper.RollappFinalizedStateInvariant(*s.k())(s.Ctx)
+	s.Require().False(broken, msg)
+}
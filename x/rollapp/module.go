@@ -162,11 +162,14 @@ func (am AppModule) GetHooks() []types.RollappHooks {
 	return am.keeper.GetHooks()
 }
 
-// EndBlock finalizes states from rollapps (after dispute period) and corresponding packets. It slashes and jails
-// sequencers of inactive rollapps.
+// EndBlock resolves the state challenges which are past their deadline, and finalizes states from rollapps
+// (after dispute period) and corresponding packets, and prunes the finalized states past the retention window.
+// It slashes and jails sequencers of inactive rollapps.
 func (am AppModule) EndBlock(goCtx context.Context) error {
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	am.keeper.ProcessChallengeDeadlines(ctx)
 	am.keeper.FinalizeRollappStates(ctx)
+	am.keeper.PruneStateInfos(ctx)
 	am.keeper.CheckLiveness(ctx)
 	return nil
 }
@@ -1,6 +1,10 @@
 package types
 
-import errorsmod "cosmossdk.io/errors"
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
+)
 
 func (bds BlockDescriptors) Validate() error {
 	for _, bd := range bds.BD {
@@ -17,3 +21,56 @@ func (bd BlockDescriptor) Validate() error {
 	}
 	return nil
 }
+
+// Leaf returns the block descriptor as a leaf of the Merkle tree of a compressed state update
+func (bd BlockDescriptor) Leaf() []byte {
+	b, err := bd.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return b
+}
+
+func (bds BlockDescriptors) leaves() [][]byte {
+	leaves := make([][]byte, len(bds.BD))
+	for i, bd := range bds.BD {
+		leaves[i] = bd.Leaf()
+	}
+	return leaves
+}
+
+// Root returns the Merkle root a compressed state update of all the block descriptors commits to
+func (bds BlockDescriptors) Root() []byte {
+	return merkle.HashFromByteSlices(bds.leaves())
+}
+
+// Proofs returns the Merkle root of all the block descriptors and their proofs of inclusion, as sequencers
+// and clients of compressed state updates need
+func (bds BlockDescriptors) Proofs() ([]byte, []BlockDescriptorProof) {
+	root, proofs := merkle.ProofsFromByteSlices(bds.leaves())
+	res := make([]BlockDescriptorProof, len(proofs))
+	for i, p := range proofs {
+		res[i] = BlockDescriptorProof{
+			Total: p.Total,
+			Index: p.Index,
+			Aunts: p.Aunts,
+		}
+	}
+	return root, res
+}
+
+// Verify checks that the block descriptor is a leaf of the Merkle tree of root
+func (p BlockDescriptorProof) Verify(root []byte, bd BlockDescriptor) error {
+	leaf := bd.Leaf()
+	proof := merkle.Proof{
+		Total: p.Total,
+		Index: p.Index,
+		// the leaf hash of cometbft Merkle trees (RFC 6962)
+		LeafHash: tmhash.Sum(append([]byte{0}, leaf...)),
+		Aunts:    p.Aunts,
+	}
+	if err := proof.Verify(root, leaf); err != nil {
+		return errorsmod.Wrap(ErrInvalidBlockDescriptorProof, err.Error())
+	}
+	return nil
+}
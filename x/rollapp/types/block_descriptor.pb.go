@@ -146,9 +146,76 @@ func (m *BlockDescriptors) GetBD() []BlockDescriptor {
 	return nil
 }
 
+// BlockDescriptorProof is the Merkle proof of inclusion of a block descriptor
+// in the block descriptors of a compressed state update. The tree is built as
+// cometbft Merkle trees (RFC 6962), with the proto encoded block descriptors
+// of all the heights of the state update as leaves, in order of height.
+type BlockDescriptorProof struct {
+	// total is the number of leaves
+	Total int64 `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	// index of the leaf, that is the height minus the start height of the state
+	Index int64    `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	Aunts [][]byte `protobuf:"bytes,3,rep,name=aunts,proto3" json:"aunts,omitempty"`
+}
+
+func (m *BlockDescriptorProof) Reset()         { *m = BlockDescriptorProof{} }
+func (m *BlockDescriptorProof) String() string { return proto.CompactTextString(m) }
+func (*BlockDescriptorProof) ProtoMessage()    {}
+func (*BlockDescriptorProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6eb4c1d0c21c2e68, []int{2}
+}
+func (m *BlockDescriptorProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockDescriptorProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockDescriptorProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockDescriptorProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockDescriptorProof.Merge(m, src)
+}
+func (m *BlockDescriptorProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockDescriptorProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockDescriptorProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockDescriptorProof proto.InternalMessageInfo
+
+func (m *BlockDescriptorProof) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *BlockDescriptorProof) GetIndex() int64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *BlockDescriptorProof) GetAunts() [][]byte {
+	if m != nil {
+		return m.Aunts
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*BlockDescriptor)(nil), "dymensionxyz.dymension.rollapp.BlockDescriptor")
 	proto.RegisterType((*BlockDescriptors)(nil), "dymensionxyz.dymension.rollapp.BlockDescriptors")
+	proto.RegisterType((*BlockDescriptorProof)(nil), "dymensionxyz.dymension.rollapp.BlockDescriptorProof")
 }
 
 func init() {
@@ -156,28 +223,31 @@ func init() {
 }
 
 var fileDescriptor_6eb4c1d0c21c2e68 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0x4f, 0x4f, 0xc2, 0x30,
-	0x18, 0xc6, 0x57, 0x20, 0x44, 0x8a, 0x46, 0xb3, 0x18, 0xb3, 0x10, 0xd3, 0x2d, 0x9c, 0x76, 0x6a,
-	0x13, 0xd0, 0x2f, 0xd0, 0xe0, 0xd5, 0xc3, 0x62, 0x4c, 0xf4, 0x42, 0x18, 0xab, 0x63, 0x71, 0xe3,
-	0x6d, 0xda, 0x42, 0xc0, 0x4f, 0xc1, 0x07, 0xf1, 0x83, 0x70, 0xe4, 0xe8, 0x49, 0x0d, 0x7c, 0x11,
-	0x33, 0x06, 0xc3, 0x90, 0xe8, 0xad, 0xcf, 0x9b, 0xe7, 0xd7, 0xe7, 0xfd, 0x83, 0x6f, 0xa3, 0x79,
-	0x26, 0xc6, 0x3a, 0x81, 0xf1, 0x6c, 0xfe, 0xc6, 0x4a, 0xc1, 0x14, 0xa4, 0xe9, 0x40, 0x4a, 0x16,
-	0xa6, 0x30, 0x7c, 0xed, 0x47, 0x42, 0x0f, 0x55, 0x22, 0x0d, 0x28, 0x2a, 0x15, 0x18, 0xb0, 0xc9,
-	0x6f, 0x8c, 0x96, 0x82, 0xee, 0xb0, 0xd6, 0x65, 0x0c, 0x31, 0x6c, 0xad, 0x2c, 0x7f, 0x15, 0x54,
-	0xcb, 0x8d, 0x01, 0xe2, 0x54, 0xb0, 0xad, 0x0a, 0x27, 0x2f, 0xcc, 0x24, 0x99, 0xd0, 0x66, 0x90,
-	0xc9, 0xc2, 0xd0, 0x7e, 0x47, 0xf8, 0x9c, 0xe7, 0x89, 0xbd, 0x32, 0xd0, 0xbe, 0xc2, 0xf5, 0x91,
-	0x48, 0xe2, 0x91, 0x71, 0x90, 0x87, 0xfc, 0x5a, 0xb0, 0x53, 0xf6, 0x35, 0x6e, 0x68, 0x33, 0x30,
-	0x22, 0x00, 0x30, 0x4e, 0xc5, 0x43, 0xfe, 0x69, 0x70, 0x28, 0xd8, 0x1c, 0x37, 0xca, 0xcf, 0x9d,
-	0xaa, 0x87, 0xfc, 0x66, 0xa7, 0x45, 0x8b, 0x78, 0xba, 0x8f, 0xa7, 0x0f, 0x7b, 0x07, 0x3f, 0x59,
-	0x7e, 0xba, 0xd6, 0xe2, 0xcb, 0x45, 0xc1, 0x01, 0xb3, 0x5d, 0xdc, 0x8c, 0x94, 0xee, 0x4f, 0x85,
-	0xca, 0x67, 0x73, 0x6a, 0x1e, 0xf2, 0xcf, 0x02, 0x1c, 0x29, 0xfd, 0x58, 0x54, 0xda, 0x4f, 0xf8,
-	0xe2, 0xa8, 0x5b, 0x6d, 0xdf, 0xe1, 0x0a, 0xef, 0x39, 0xc8, 0xab, 0xfa, 0xcd, 0x0e, 0xa3, 0xff,
-	0xaf, 0x89, 0x1e, 0xd1, 0xbc, 0x96, 0xb7, 0x11, 0x54, 0x78, 0x8f, 0xdf, 0x2f, 0xd7, 0x04, 0xad,
-	0xd6, 0x04, 0x7d, 0xaf, 0x09, 0x5a, 0x6c, 0x88, 0xb5, 0xda, 0x10, 0xeb, 0x63, 0x43, 0xac, 0xe7,
-	0x9b, 0x38, 0x31, 0xa3, 0x49, 0x48, 0x87, 0x90, 0xb1, 0x3f, 0x8e, 0x37, 0xed, 0xb2, 0x59, 0x79,
-	0x41, 0x33, 0x97, 0x42, 0x87, 0xf5, 0xed, 0xd0, 0xdd, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x3b,
-	0xda, 0x31, 0x6f, 0xf0, 0x01, 0x00, 0x00,
+	// 372 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0xd1, 0x6a, 0xe2, 0x40,
+	0x14, 0x86, 0x33, 0xc6, 0x95, 0x75, 0x74, 0xd9, 0x25, 0xc8, 0x12, 0x64, 0x49, 0x82, 0x57, 0xb9,
+	0x9a, 0x01, 0xdd, 0x7d, 0x81, 0xe0, 0xde, 0x2e, 0xcb, 0x50, 0x4a, 0xdb, 0x1b, 0x49, 0xcc, 0x18,
+	0x43, 0x93, 0x9c, 0x30, 0x33, 0x8a, 0xf6, 0x29, 0x7c, 0x90, 0x3e, 0x88, 0x97, 0x5e, 0xf6, 0xaa,
+	0x2d, 0xfa, 0x22, 0x25, 0x89, 0xc6, 0x22, 0xb4, 0x77, 0xf9, 0xfe, 0x9c, 0x3f, 0xff, 0xc9, 0xcf,
+	0xc1, 0x7f, 0xc2, 0x75, 0xca, 0x33, 0x19, 0x43, 0xb6, 0x5a, 0x3f, 0xd0, 0x1a, 0xa8, 0x80, 0x24,
+	0xf1, 0xf3, 0x9c, 0x06, 0x09, 0x4c, 0xef, 0x27, 0x21, 0x97, 0x53, 0x11, 0xe7, 0x0a, 0x04, 0xc9,
+	0x05, 0x28, 0x30, 0xac, 0xf7, 0x36, 0x52, 0x03, 0x39, 0xda, 0xfa, 0xbd, 0x08, 0x22, 0x28, 0x47,
+	0x69, 0xf1, 0x54, 0xb9, 0xfa, 0x76, 0x04, 0x10, 0x25, 0x9c, 0x96, 0x14, 0x2c, 0x66, 0x54, 0xc5,
+	0x29, 0x97, 0xca, 0x4f, 0xf3, 0x6a, 0x60, 0xf0, 0x88, 0xf0, 0x77, 0xaf, 0x48, 0x1c, 0xd7, 0x81,
+	0xc6, 0x4f, 0xdc, 0x9a, 0xf3, 0x38, 0x9a, 0x2b, 0x13, 0x39, 0xc8, 0x6d, 0xb2, 0x23, 0x19, 0xbf,
+	0x70, 0x5b, 0x2a, 0x5f, 0x71, 0x06, 0xa0, 0xcc, 0x86, 0x83, 0xdc, 0x2e, 0x3b, 0x0b, 0x86, 0x87,
+	0xdb, 0xf5, 0xc7, 0x4d, 0xdd, 0x41, 0x6e, 0x67, 0xd8, 0x27, 0x55, 0x3c, 0x39, 0xc5, 0x93, 0xab,
+	0xd3, 0x84, 0xf7, 0x75, 0xfb, 0x6c, 0x6b, 0x9b, 0x17, 0x1b, 0xb1, 0xb3, 0xcd, 0xb0, 0x71, 0x27,
+	0x14, 0x72, 0xb2, 0xe4, 0xa2, 0xf8, 0x37, 0xb3, 0xe9, 0x20, 0xf7, 0x1b, 0xc3, 0xa1, 0x90, 0xd7,
+	0x95, 0x32, 0xb8, 0xc5, 0x3f, 0x2e, 0xb6, 0x95, 0xc6, 0x5f, 0xdc, 0xf0, 0xc6, 0x26, 0x72, 0x74,
+	0xb7, 0x33, 0xa4, 0xe4, 0xf3, 0x9a, 0xc8, 0x85, 0xdb, 0x6b, 0x16, 0x6b, 0xb0, 0x86, 0x37, 0x1e,
+	0xdc, 0xe0, 0xde, 0xc5, 0xcb, 0xff, 0x02, 0x60, 0x66, 0xf4, 0xf0, 0x17, 0x05, 0xca, 0x4f, 0xca,
+	0x32, 0x74, 0x56, 0x41, 0xa1, 0xc6, 0x59, 0xc8, 0x57, 0x65, 0x0f, 0x3a, 0xab, 0xa0, 0x50, 0xfd,
+	0x45, 0xa6, 0xa4, 0xa9, 0x3b, 0xba, 0xdb, 0x65, 0x15, 0x78, 0xff, 0xb6, 0x7b, 0x0b, 0xed, 0xf6,
+	0x16, 0x7a, 0xdd, 0x5b, 0x68, 0x73, 0xb0, 0xb4, 0xdd, 0xc1, 0xd2, 0x9e, 0x0e, 0x96, 0x76, 0xf7,
+	0x3b, 0x8a, 0xd5, 0x7c, 0x11, 0x90, 0x29, 0xa4, 0xf4, 0x83, 0xb3, 0x58, 0x8e, 0xe8, 0xaa, 0xbe,
+	0x0d, 0xb5, 0xce, 0xb9, 0x0c, 0x5a, 0x65, 0x9d, 0xa3, 0xb7, 0x01, 0x00, 0xf2, 0x4b, 0x3b, 0xe2,
+	0x4a, 0x02, 0x00, 0x00,
 }
 
 func (m *BlockDescriptor) Marshal() (dAtA []byte, err error) {
@@ -265,6 +335,48 @@ func (m *BlockDescriptors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BlockDescriptorProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockDescriptorProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockDescriptorProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Aunts) > 0 {
+		for iNdEx := len(m.Aunts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Aunts[iNdEx])
+			copy(dAtA[i:], m.Aunts[iNdEx])
+			i = encodeVarintBlockDescriptor(dAtA, i, uint64(len(m.Aunts[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Index != 0 {
+		i = encodeVarintBlockDescriptor(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Total != 0 {
+		i = encodeVarintBlockDescriptor(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintBlockDescriptor(dAtA []byte, offset int, v uint64) int {
 	offset -= sovBlockDescriptor(v)
 	base := offset
@@ -312,6 +424,27 @@ func (m *BlockDescriptors) Size() (n int) {
 	return n
 }
 
+func (m *BlockDescriptorProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Total != 0 {
+		n += 1 + sovBlockDescriptor(uint64(m.Total))
+	}
+	if m.Index != 0 {
+		n += 1 + sovBlockDescriptor(uint64(m.Index))
+	}
+	if len(m.Aunts) > 0 {
+		for _, b := range m.Aunts {
+			l = len(b)
+			n += 1 + l + sovBlockDescriptor(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovBlockDescriptor(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -557,6 +690,126 @@ func (m *BlockDescriptors) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BlockDescriptorProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBlockDescriptor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockDescriptorProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockDescriptorProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockDescriptor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockDescriptor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Aunts", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBlockDescriptor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBlockDescriptor
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBlockDescriptor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Aunts = append(m.Aunts, make([]byte, postIndex-iNdEx))
+			copy(m.Aunts[len(m.Aunts)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBlockDescriptor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBlockDescriptor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipBlockDescriptor(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
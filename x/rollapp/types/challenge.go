@@ -0,0 +1,6 @@
+package types
+
+// IsPending returns true if the challenge awaits a response or an adjudication
+func (c Challenge) IsPending() bool {
+	return c.Status == ChallengeStatus_CHALLENGE_STATUS_OPEN || c.Status == ChallengeStatus_CHALLENGE_STATUS_RESPONDED
+}
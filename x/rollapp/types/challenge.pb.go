@@ -0,0 +1,956 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/rollapp/challenge.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ChallengeStatus int32
+
+const (
+	// the sequencer has not responded yet
+	ChallengeStatus_CHALLENGE_STATUS_OPEN ChallengeStatus = 0
+	// the sequencer responded, the challenge awaits adjudication
+	ChallengeStatus_CHALLENGE_STATUS_RESPONDED ChallengeStatus = 1
+	// the state was found fraudulent: the rollapp was hard forked and the
+	// sequencer punished
+	ChallengeStatus_CHALLENGE_STATUS_UPHELD ChallengeStatus = 2
+	// the state was found valid: the bond went to the sequencer
+	ChallengeStatus_CHALLENGE_STATUS_DISMISSED ChallengeStatus = 3
+	// the challenge became moot, e.g. the state was reverted by another hard
+	// fork, or a response was not adjudicated in time: the bond was refunded
+	ChallengeStatus_CHALLENGE_STATUS_VOID ChallengeStatus = 4
+)
+
+var ChallengeStatus_name = map[int32]string{
+	0: "CHALLENGE_STATUS_OPEN",
+	1: "CHALLENGE_STATUS_RESPONDED",
+	2: "CHALLENGE_STATUS_UPHELD",
+	3: "CHALLENGE_STATUS_DISMISSED",
+	4: "CHALLENGE_STATUS_VOID",
+}
+
+var ChallengeStatus_value = map[string]int32{
+	"CHALLENGE_STATUS_OPEN":      0,
+	"CHALLENGE_STATUS_RESPONDED": 1,
+	"CHALLENGE_STATUS_UPHELD":    2,
+	"CHALLENGE_STATUS_DISMISSED": 3,
+	"CHALLENGE_STATUS_VOID":      4,
+}
+
+func (x ChallengeStatus) String() string {
+	return proto.EnumName(ChallengeStatus_name, int32(x))
+}
+
+func (ChallengeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_648565459499906c, []int{0}
+}
+
+// Challenge is a bonded claim that a block descriptor of a pending state is
+// fraudulent. The sequencer of the state must respond before the response
+// deadline, or the challenge is upheld. A responded challenge is adjudicated
+// by the authority before the resolution deadline, or voided. The state, and
+// those after it, do not finalize while the challenge is open.
+type Challenge struct {
+	Id        uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	RollappId string `protobuf:"bytes,2,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// challenger is the bech32-encoded address of the challenger
+	Challenger string `protobuf:"bytes,3,opt,name=challenger,proto3" json:"challenger,omitempty"`
+	// state_index is the index of the challenged state
+	StateIndex uint64 `protobuf:"varint,4,opt,name=state_index,json=stateIndex,proto3" json:"state_index,omitempty"`
+	// height is the rollapp height of the challenged block descriptor
+	Height uint64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// revision is the rollapp revision of height
+	Revision uint64 `protobuf:"varint,6,opt,name=revision,proto3" json:"revision,omitempty"`
+	// sequencer is the bech32-encoded address of the sequencer who submitted
+	// the state
+	Sequencer string `protobuf:"bytes,7,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// state_root is the state root the challenger claims is correct for height
+	StateRoot []byte `protobuf:"bytes,8,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	// evidence of the fraud, e.g. where to find the block on the DA
+	Evidence string `protobuf:"bytes,9,opt,name=evidence,proto3" json:"evidence,omitempty"`
+	// bond escrowed by the challenger
+	Bond   types.Coin      `protobuf:"bytes,10,opt,name=bond,proto3" json:"bond"`
+	Status ChallengeStatus `protobuf:"varint,11,opt,name=status,proto3,enum=dymensionxyz.dymension.rollapp.ChallengeStatus" json:"status,omitempty"`
+	// created_height is the hub height the challenge was submitted at
+	CreatedHeight uint64 `protobuf:"varint,12,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// deadline is the hub height by which the sequencer must respond, or, once
+	// responded, by which the challenge must be adjudicated
+	Deadline uint64 `protobuf:"varint,13,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	// response of the sequencer, e.g. where to find the proof of validity
+	Response string `protobuf:"bytes,14,opt,name=response,proto3" json:"response,omitempty"`
+}
+
+func (m *Challenge) Reset()         { *m = Challenge{} }
+func (m *Challenge) String() string { return proto.CompactTextString(m) }
+func (*Challenge) ProtoMessage()    {}
+func (*Challenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_648565459499906c, []int{0}
+}
+func (m *Challenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Challenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Challenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Challenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Challenge.Merge(m, src)
+}
+func (m *Challenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *Challenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_Challenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Challenge proto.InternalMessageInfo
+
+func (m *Challenge) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Challenge) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *Challenge) GetChallenger() string {
+	if m != nil {
+		return m.Challenger
+	}
+	return ""
+}
+
+func (m *Challenge) GetStateIndex() uint64 {
+	if m != nil {
+		return m.StateIndex
+	}
+	return 0
+}
+
+func (m *Challenge) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Challenge) GetRevision() uint64 {
+	if m != nil {
+		return m.Revision
+	}
+	return 0
+}
+
+func (m *Challenge) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *Challenge) GetStateRoot() []byte {
+	if m != nil {
+		return m.StateRoot
+	}
+	return nil
+}
+
+func (m *Challenge) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
+	}
+	return ""
+}
+
+func (m *Challenge) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *Challenge) GetStatus() ChallengeStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ChallengeStatus_CHALLENGE_STATUS_OPEN
+}
+
+func (m *Challenge) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *Challenge) GetDeadline() uint64 {
+	if m != nil {
+		return m.Deadline
+	}
+	return 0
+}
+
+func (m *Challenge) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.rollapp.ChallengeStatus", ChallengeStatus_name, ChallengeStatus_value)
+	proto.RegisterType((*Challenge)(nil), "dymensionxyz.dymension.rollapp.Challenge")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/rollapp/challenge.proto", fileDescriptor_648565459499906c)
+}
+
+var fileDescriptor_648565459499906c = []byte{
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x4d, 0x6e, 0xda, 0x40,
+	0x18, 0x65, 0x80, 0xd2, 0xf8, 0x23, 0xa1, 0x68, 0xd4, 0x9f, 0x09, 0x6d, 0x1d, 0x54, 0xa9, 0x12,
+	0xea, 0xc2, 0x56, 0x42, 0x2f, 0x90, 0x60, 0x2b, 0x20, 0x51, 0x40, 0x76, 0xd2, 0x45, 0x37, 0xc8,
+	0x78, 0x46, 0x30, 0x12, 0x78, 0x5c, 0x8f, 0x41, 0xd0, 0x53, 0xf4, 0x10, 0x3d, 0x4c, 0x96, 0x59,
+	0x76, 0x55, 0x55, 0xa0, 0xde, 0xa3, 0xf2, 0xd8, 0xb8, 0x51, 0x93, 0x76, 0xc7, 0x7b, 0xef, 0x7b,
+	0xdf, 0xcf, 0xc3, 0x03, 0x06, 0xdd, 0x2c, 0x58, 0x20, 0xb9, 0x08, 0xd6, 0x9b, 0x2f, 0x66, 0x0e,
+	0xcc, 0x48, 0xcc, 0xe7, 0x5e, 0x18, 0x9a, 0xfe, 0xcc, 0x9b, 0xcf, 0x59, 0x30, 0x65, 0x46, 0x18,
+	0x89, 0x58, 0x60, 0xfd, 0x6e, 0xfd, 0x1f, 0xb3, 0x91, 0xd5, 0x37, 0x9e, 0x4e, 0xc5, 0x54, 0xa8,
+	0x52, 0x33, 0xf9, 0x95, 0xba, 0x1a, 0xba, 0x2f, 0xe4, 0x42, 0x48, 0x73, 0xe2, 0x49, 0x66, 0xae,
+	0x4e, 0x27, 0x2c, 0xf6, 0x4e, 0x4d, 0x5f, 0xf0, 0x20, 0xd5, 0xdf, 0xfc, 0x2a, 0x81, 0xd6, 0xd9,
+	0x4f, 0xc2, 0x35, 0x28, 0x72, 0x4a, 0x50, 0x13, 0xb5, 0xca, 0x4e, 0x91, 0x53, 0xfc, 0x1a, 0x20,
+	0x6b, 0x3f, 0xe6, 0x94, 0x14, 0x9b, 0xa8, 0xa5, 0x39, 0x5a, 0xc6, 0xf4, 0x28, 0xd6, 0x01, 0xf2,
+	0x2d, 0x23, 0x52, 0x52, 0xf2, 0x1d, 0x06, 0x9f, 0x40, 0x55, 0xc6, 0x5e, 0xcc, 0xc6, 0x3c, 0xa0,
+	0x6c, 0x4d, 0xca, 0xaa, 0x2f, 0x28, 0xaa, 0x97, 0x30, 0xf8, 0x39, 0x54, 0x66, 0x8c, 0x4f, 0x67,
+	0x31, 0x79, 0xa4, 0xb4, 0x0c, 0xe1, 0x06, 0x1c, 0x44, 0x6c, 0xc5, 0x93, 0xfb, 0x48, 0x45, 0x29,
+	0x39, 0xc6, 0xaf, 0x40, 0x93, 0xec, 0xf3, 0x92, 0x05, 0x3e, 0x8b, 0xc8, 0xe3, 0x74, 0xa5, 0x9c,
+	0x48, 0x36, 0x4e, 0x47, 0x46, 0x42, 0xc4, 0xe4, 0xa0, 0x89, 0x5a, 0x87, 0x8e, 0xa6, 0x18, 0x47,
+	0x08, 0xd5, 0x98, 0xad, 0x38, 0x4d, 0x6a, 0x89, 0xa6, 0xbc, 0x39, 0xc6, 0x6d, 0x28, 0x4f, 0x44,
+	0x40, 0x09, 0x34, 0x51, 0xab, 0x7a, 0x76, 0x6c, 0xa4, 0xc9, 0x19, 0x49, 0x72, 0x46, 0x96, 0x9c,
+	0xd1, 0x11, 0x3c, 0xb8, 0x28, 0xdf, 0xfc, 0x38, 0x29, 0x38, 0xaa, 0x18, 0x5f, 0x42, 0x25, 0xe9,
+	0xbe, 0x94, 0xa4, 0xda, 0x44, 0xad, 0xda, 0x99, 0x69, 0xfc, 0xff, 0x6f, 0x32, 0xf2, 0xb0, 0x5d,
+	0x65, 0x73, 0x32, 0x3b, 0x7e, 0x0b, 0x35, 0x3f, 0x62, 0x5e, 0xcc, 0xe8, 0x38, 0x8b, 0xe4, 0x50,
+	0x1d, 0x7e, 0x94, 0xb1, 0xdd, 0x3c, 0x19, 0xca, 0x3c, 0x3a, 0xe7, 0x01, 0x23, 0x47, 0x69, 0x32,
+	0x7b, 0x9c, 0xa6, 0x26, 0x43, 0x11, 0x48, 0x46, 0x6a, 0xe9, 0x71, 0x7b, 0xfc, 0xee, 0x1b, 0x82,
+	0x27, 0x7f, 0x8d, 0xc6, 0xc7, 0xf0, 0xac, 0xd3, 0x3d, 0xef, 0xf7, 0xed, 0xc1, 0xa5, 0x3d, 0x76,
+	0xaf, 0xce, 0xaf, 0xae, 0xdd, 0xf1, 0x70, 0x64, 0x0f, 0xea, 0x05, 0xac, 0x43, 0xe3, 0x9e, 0xe4,
+	0xd8, 0xee, 0x68, 0x38, 0xb0, 0x6c, 0xab, 0x8e, 0xf0, 0x4b, 0x78, 0x71, 0x4f, 0xbf, 0x1e, 0x75,
+	0xed, 0xbe, 0x55, 0x2f, 0x3e, 0x68, 0xb6, 0x7a, 0xee, 0x87, 0x9e, 0xeb, 0xda, 0x56, 0xbd, 0xf4,
+	0xe0, 0xdc, 0x8f, 0xc3, 0x9e, 0x55, 0x2f, 0x5f, 0x0c, 0x6e, 0xb6, 0x3a, 0xba, 0xdd, 0xea, 0xe8,
+	0xe7, 0x56, 0x47, 0x5f, 0x77, 0x7a, 0xe1, 0x76, 0xa7, 0x17, 0xbe, 0xef, 0xf4, 0xc2, 0xa7, 0xf7,
+	0x53, 0x1e, 0xcf, 0x96, 0x13, 0xc3, 0x17, 0x0b, 0xf3, 0x1f, 0x2f, 0x67, 0xd5, 0x36, 0xd7, 0xf9,
+	0xf3, 0x89, 0x37, 0x21, 0x93, 0x93, 0x8a, 0xfa, 0xca, 0xdb, 0xbf, 0x07, 0x00, 0x3d, 0xd7, 0xcd,
+	0x2b, 0x6d, 0x03, 0x00, 0x00,
+}
+
+func (m *Challenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Challenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Challenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Response) > 0 {
+		i -= len(m.Response)
+		copy(dAtA[i:], m.Response)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.Response)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.Deadline != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.Deadline))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Status != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintChallenge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.StateRoot) > 0 {
+		i -= len(m.StateRoot)
+		copy(dAtA[i:], m.StateRoot)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.StateRoot)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Revision != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.Revision))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Height != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StateIndex != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.StateIndex))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Challenger) > 0 {
+		i -= len(m.Challenger)
+		copy(dAtA[i:], m.Challenger)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.Challenger)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintChallenge(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintChallenge(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintChallenge(dAtA []byte, offset int, v uint64) int {
+	offset -= sovChallenge(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Challenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovChallenge(uint64(m.Id))
+	}
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	l = len(m.Challenger)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	if m.StateIndex != 0 {
+		n += 1 + sovChallenge(uint64(m.StateIndex))
+	}
+	if m.Height != 0 {
+		n += 1 + sovChallenge(uint64(m.Height))
+	}
+	if m.Revision != 0 {
+		n += 1 + sovChallenge(uint64(m.Revision))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	l = len(m.StateRoot)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovChallenge(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovChallenge(uint64(m.Status))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovChallenge(uint64(m.CreatedHeight))
+	}
+	if m.Deadline != 0 {
+		n += 1 + sovChallenge(uint64(m.Deadline))
+	}
+	l = len(m.Response)
+	if l > 0 {
+		n += 1 + l + sovChallenge(uint64(l))
+	}
+	return n
+}
+
+func sovChallenge(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozChallenge(x uint64) (n int) {
+	return sovChallenge(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Challenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChallenge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Challenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Challenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenger", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenger = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateIndex", wireType)
+			}
+			m.StateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			m.Revision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Revision |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StateRoot = append(m.StateRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.StateRoot == nil {
+				m.StateRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ChallengeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deadline", wireType)
+			}
+			m.Deadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Deadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Response", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Response = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChallenge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChallenge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipChallenge(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowChallenge
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowChallenge
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthChallenge
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupChallenge
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthChallenge
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthChallenge        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowChallenge          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupChallenge = fmt.Errorf("proto: unexpected end of group")
+)
@@ -21,6 +21,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(Params{}, "rollapp/Params", nil)
 	cdc.RegisterConcrete(&MsgForceGenesisInfoChange{}, "rollapp/ForceGenesisInfoChange", nil)
 	cdc.RegisterConcrete(&GenesisInfo{}, "rollapp/GenesisInfo", nil)
+	cdc.RegisterConcrete(&MsgChallengeState{}, "rollapp/ChallengeState", nil)
+	cdc.RegisterConcrete(&MsgRespondChallenge{}, "rollapp/RespondChallenge", nil)
+	cdc.RegisterConcrete(&MsgResolveChallenge{}, "rollapp/ResolveChallenge", nil)
+	cdc.RegisterConcrete(&MsgUpdateDisputePeriod{}, "rollapp/UpdateDisputePeriod", nil)
+	cdc.RegisterConcrete(&MsgUpdateSequencerBondDenoms{}, "rollapp/UpdateSequencerBondDenoms", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -36,6 +41,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgMarkObsoleteRollapps{},
 		&MsgForceGenesisInfoChange{},
 		&MsgUpdateParams{},
+		&MsgChallengeState{},
+		&MsgRespondChallenge{},
+		&MsgResolveChallenge{},
+		&MsgUpdateDisputePeriod{},
+		&MsgUpdateSequencerBondDenoms{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
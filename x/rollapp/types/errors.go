@@ -55,6 +55,11 @@ var (
 	ErrTooManyGenesisAccounts            = errorsmod.Wrap(gerrc.ErrInvalidArgument, "too many genesis accounts")
 	ErrInvalidBech32Prefix               = errorsmod.Wrap(gerrc.ErrInvalidArgument, "invalid bech32 prefix")
 	ErrRollappNotFound                   = errorsmod.Wrap(gerrc.ErrNotFound, "rollapp")
+	ErrInvalidDisputePeriod              = errorsmod.Wrap(gerrc.ErrOutOfRange, "dispute period")
+	ErrInvalidBDsRoot                    = errorsmod.Wrap(gerrc.ErrInvalidArgument, "block descriptors root")
+	ErrBlockDescriptorNotSampled         = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "block descriptor not sampled by compressed state")
+	ErrInvalidBlockDescriptorProof       = errorsmod.Wrap(gerrc.ErrInvalidArgument, "block descriptor proof")
+	ErrStateInfoPruned                   = errorsmod.Wrap(gerrc.ErrOutOfRange, "state info pruned")
 
 	/* ------------------------------ fraud related ----------------------------- */
 	ErrDisputeAlreadyFinalized = errorsmod.Register(ModuleName, 2000, "disputed height already finalized")
@@ -62,4 +67,11 @@ var (
 	ErrWrongProposerAddr       = errorsmod.Register(ModuleName, 2003, "wrong proposer address")
 	ErrInvalidDRSVersion       = errorsmod.Register(ModuleName, 2004, "wrong DRS version")
 	ErrWrongRollappRevision    = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "wrong rollapp revision")
+	ErrChallengeNotFound       = errorsmod.Wrap(gerrc.ErrNotFound, "challenge")
+	ErrChallengeExists         = errorsmod.Wrap(gerrc.ErrAlreadyExists, "open challenge for height")
+	ErrChallengeNotOpen        = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "challenge not open")
+	ErrChallengeNotPending     = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "challenge not pending")
+	ErrStateChallenged         = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "state has a pending challenge")
+	ErrDisputePeriodOver       = errorsmod.Wrap(gerrc.ErrFailedPrecondition, "dispute period of the state is over")
+	ErrStateRootMatches        = errorsmod.Wrap(gerrc.ErrInvalidArgument, "claimed state root matches the block descriptor")
 )
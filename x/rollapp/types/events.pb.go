@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -208,11 +209,222 @@ func (m *EventMarkObsoleteRollapps) GetDrsVersions() []uint32 {
 	return nil
 }
 
+type EventChallengeCreated struct {
+	Challenge Challenge `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge"`
+}
+
+func (m *EventChallengeCreated) Reset()         { *m = EventChallengeCreated{} }
+func (m *EventChallengeCreated) String() string { return proto.CompactTextString(m) }
+func (*EventChallengeCreated) ProtoMessage()    {}
+func (*EventChallengeCreated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{4}
+}
+func (m *EventChallengeCreated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChallengeCreated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChallengeCreated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChallengeCreated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChallengeCreated.Merge(m, src)
+}
+func (m *EventChallengeCreated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChallengeCreated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChallengeCreated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChallengeCreated proto.InternalMessageInfo
+
+func (m *EventChallengeCreated) GetChallenge() Challenge {
+	if m != nil {
+		return m.Challenge
+	}
+	return Challenge{}
+}
+
+type EventChallengeResponded struct {
+	Challenge Challenge `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge"`
+}
+
+func (m *EventChallengeResponded) Reset()         { *m = EventChallengeResponded{} }
+func (m *EventChallengeResponded) String() string { return proto.CompactTextString(m) }
+func (*EventChallengeResponded) ProtoMessage()    {}
+func (*EventChallengeResponded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{5}
+}
+func (m *EventChallengeResponded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChallengeResponded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChallengeResponded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChallengeResponded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChallengeResponded.Merge(m, src)
+}
+func (m *EventChallengeResponded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChallengeResponded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChallengeResponded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChallengeResponded proto.InternalMessageInfo
+
+func (m *EventChallengeResponded) GetChallenge() Challenge {
+	if m != nil {
+		return m.Challenge
+	}
+	return Challenge{}
+}
+
+// EventChallengeResolved is emitted when a challenge is upheld, dismissed or
+// voided.
+type EventChallengeResolved struct {
+	Challenge Challenge `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge"`
+	// reason of the resolution, e.g. the response deadline passed
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventChallengeResolved) Reset()         { *m = EventChallengeResolved{} }
+func (m *EventChallengeResolved) String() string { return proto.CompactTextString(m) }
+func (*EventChallengeResolved) ProtoMessage()    {}
+func (*EventChallengeResolved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{6}
+}
+func (m *EventChallengeResolved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChallengeResolved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChallengeResolved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChallengeResolved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChallengeResolved.Merge(m, src)
+}
+func (m *EventChallengeResolved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChallengeResolved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChallengeResolved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChallengeResolved proto.InternalMessageInfo
+
+func (m *EventChallengeResolved) GetChallenge() Challenge {
+	if m != nil {
+		return m.Challenge
+	}
+	return Challenge{}
+}
+
+func (m *EventChallengeResolved) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// EventStateInfosPruned is emitted when finalized states of a rollapp past the
+// retention window are pruned.
+type EventStateInfosPruned struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// first_index is the index of the first pruned state
+	FirstIndex uint64 `protobuf:"varint,2,opt,name=first_index,json=firstIndex,proto3" json:"first_index,omitempty"`
+	// last_index is the index of the last pruned state
+	LastIndex uint64 `protobuf:"varint,3,opt,name=last_index,json=lastIndex,proto3" json:"last_index,omitempty"`
+}
+
+func (m *EventStateInfosPruned) Reset()         { *m = EventStateInfosPruned{} }
+func (m *EventStateInfosPruned) String() string { return proto.CompactTextString(m) }
+func (*EventStateInfosPruned) ProtoMessage()    {}
+func (*EventStateInfosPruned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e0f74405c12dec3c, []int{7}
+}
+func (m *EventStateInfosPruned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventStateInfosPruned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventStateInfosPruned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventStateInfosPruned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventStateInfosPruned.Merge(m, src)
+}
+func (m *EventStateInfosPruned) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventStateInfosPruned) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventStateInfosPruned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventStateInfosPruned proto.InternalMessageInfo
+
+func (m *EventStateInfosPruned) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *EventStateInfosPruned) GetFirstIndex() uint64 {
+	if m != nil {
+		return m.FirstIndex
+	}
+	return 0
+}
+
+func (m *EventStateInfosPruned) GetLastIndex() uint64 {
+	if m != nil {
+		return m.LastIndex
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventAppAdded)(nil), "dymensionxyz.dymension.rollapp.EventAppAdded")
 	proto.RegisterType((*EventAppUpdated)(nil), "dymensionxyz.dymension.rollapp.EventAppUpdated")
 	proto.RegisterType((*EventAppRemoved)(nil), "dymensionxyz.dymension.rollapp.EventAppRemoved")
 	proto.RegisterType((*EventMarkObsoleteRollapps)(nil), "dymensionxyz.dymension.rollapp.EventMarkObsoleteRollapps")
+	proto.RegisterType((*EventChallengeCreated)(nil), "dymensionxyz.dymension.rollapp.EventChallengeCreated")
+	proto.RegisterType((*EventChallengeResponded)(nil), "dymensionxyz.dymension.rollapp.EventChallengeResponded")
+	proto.RegisterType((*EventChallengeResolved)(nil), "dymensionxyz.dymension.rollapp.EventChallengeResolved")
+	proto.RegisterType((*EventStateInfosPruned)(nil), "dymensionxyz.dymension.rollapp.EventStateInfosPruned")
 }
 
 func init() {
@@ -220,25 +432,35 @@ func init() {
 }
 
 var fileDescriptor_e0f74405c12dec3c = []byte{
-	// 277 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4e, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x8b, 0xf2, 0x73, 0x72,
-	0x12, 0x0b, 0x0a, 0xf4, 0x53, 0xcb, 0x52, 0xf3, 0x4a, 0x8a, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2,
-	0x85, 0xe4, 0x90, 0x15, 0xeb, 0xc1, 0x39, 0x7a, 0x50, 0xc5, 0x52, 0x1a, 0x04, 0x0c, 0x4b, 0x2c,
-	0x28, 0x80, 0x98, 0xa4, 0xe4, 0xc6, 0xc5, 0xeb, 0x0a, 0x32, 0xd9, 0xb1, 0xa0, 0xc0, 0x31, 0x25,
-	0x25, 0x35, 0x45, 0xc8, 0x94, 0x8b, 0x39, 0xb1, 0xa0, 0x40, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb,
-	0x48, 0x59, 0x0f, 0xbf, 0x45, 0x7a, 0x8e, 0x05, 0x05, 0x41, 0x20, 0xf5, 0x4a, 0x1e, 0x5c, 0xfc,
-	0x30, 0x73, 0x42, 0x0b, 0x52, 0x12, 0x4b, 0xa8, 0x62, 0x52, 0x50, 0x6a, 0x6e, 0x7e, 0x19, 0xf9,
-	0x26, 0x15, 0x70, 0x49, 0x82, 0x4d, 0xf2, 0x4d, 0x2c, 0xca, 0xf6, 0x4f, 0x2a, 0xce, 0xcf, 0x49,
-	0x2d, 0x49, 0x0d, 0x82, 0x28, 0x2a, 0x16, 0x32, 0xe0, 0x12, 0xc9, 0x87, 0x8a, 0xc5, 0x43, 0x75,
-	0xc6, 0xe7, 0x95, 0xe6, 0x82, 0x2d, 0x61, 0x09, 0x12, 0xca, 0x47, 0x55, 0xef, 0x57, 0x9a, 0x2b,
-	0xa4, 0xc8, 0xc5, 0x93, 0x52, 0x54, 0x1c, 0x5f, 0x96, 0x5a, 0x04, 0xb2, 0xae, 0x58, 0x82, 0x49,
-	0x81, 0x59, 0x83, 0x37, 0x88, 0x3b, 0xa5, 0xa8, 0x38, 0x0c, 0x2a, 0xe4, 0xe4, 0x77, 0xe2, 0x91,
-	0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1,
-	0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x26, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a,
-	0xc9, 0xf9, 0xb9, 0xfa, 0x38, 0x22, 0xa7, 0xcc, 0x58, 0xbf, 0x02, 0x1e, 0x43, 0x25, 0x95, 0x05,
-	0xa9, 0xc5, 0x49, 0x6c, 0xe0, 0x48, 0x32, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x93, 0xbd,
-	0x81, 0x1d, 0x02, 0x00, 0x00,
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0x86, 0xe3, 0x26, 0xaa, 0xe4, 0x09, 0x15, 0x92, 0x55, 0x4a, 0xa8, 0x84, 0x1b, 0xcc, 0x25,
+	0x08, 0xc9, 0x46, 0x14, 0x1e, 0x20, 0xad, 0x40, 0xe4, 0xd0, 0x82, 0x16, 0xc1, 0x81, 0x4b, 0xb4,
+	0xe9, 0x4e, 0x12, 0x0b, 0x7b, 0x77, 0xb5, 0xbb, 0xb6, 0x12, 0x2e, 0xbc, 0x02, 0x8f, 0xd5, 0x63,
+	0x8f, 0x9c, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0xb3, 0x31, 0x44, 0x08, 0x22, 0x55, 0xb9, 0x79, 0x66,
+	0xbe, 0xf9, 0xff, 0xd9, 0xb1, 0x06, 0x9e, 0xb2, 0x79, 0x8e, 0x5c, 0xa7, 0x82, 0xcf, 0xe6, 0x5f,
+	0x92, 0x3a, 0x48, 0x94, 0xc8, 0x32, 0x2a, 0x65, 0x82, 0x25, 0x72, 0xa3, 0x63, 0xa9, 0x84, 0x11,
+	0x41, 0xf8, 0x27, 0x1c, 0xd7, 0x41, 0xec, 0xe0, 0xe3, 0xc3, 0x89, 0x98, 0x08, 0x8b, 0x26, 0xd5,
+	0xd7, 0xaa, 0xeb, 0xb8, 0xb7, 0xc5, 0x82, 0x4a, 0xe9, 0xc8, 0x78, 0x0b, 0x79, 0x35, 0xa5, 0x59,
+	0x86, 0x7c, 0x82, 0x2b, 0x3e, 0x7a, 0x0d, 0x07, 0xaf, 0xaa, 0xf9, 0xfa, 0x52, 0xf6, 0x19, 0x43,
+	0x16, 0xbc, 0x84, 0x26, 0x95, 0xb2, 0xe3, 0x75, 0xbd, 0x5e, 0xfb, 0xf9, 0xe3, 0xf8, 0xff, 0xe3,
+	0xc6, 0x7d, 0x29, 0x49, 0xc5, 0x47, 0x6f, 0xe0, 0xee, 0x5a, 0xe7, 0x83, 0x64, 0xd4, 0xec, 0x44,
+	0x89, 0x60, 0x2e, 0xca, 0xdb, 0x2b, 0x49, 0x78, 0x60, 0x95, 0x2e, 0xa8, 0xfa, 0xfc, 0x76, 0xa4,
+	0x45, 0x86, 0x06, 0xc9, 0x0a, 0xd2, 0xc1, 0x33, 0x38, 0x14, 0x2e, 0x37, 0x74, 0x9d, 0x43, 0x5e,
+	0xe4, 0xd6, 0xa4, 0x45, 0x02, 0xb1, 0xc9, 0x5f, 0x16, 0x79, 0xf0, 0x08, 0xee, 0x30, 0xa5, 0x87,
+	0x25, 0xaa, 0xca, 0x4e, 0x77, 0xf6, 0xba, 0xcd, 0xde, 0x01, 0x69, 0x33, 0xa5, 0x3f, 0xba, 0x54,
+	0x34, 0x86, 0x7b, 0xd6, 0xf1, 0x7c, 0xbd, 0xe5, 0x73, 0x85, 0x76, 0x17, 0x17, 0xe0, 0xd7, 0x9b,
+	0x77, 0xef, 0x78, 0xb2, 0xed, 0x1d, 0xb5, 0xc8, 0x59, 0xeb, 0xfa, 0xc7, 0x49, 0x83, 0xfc, 0x56,
+	0x88, 0xa6, 0x70, 0x7f, 0xd3, 0x87, 0xa0, 0x96, 0x82, 0xb3, 0xdd, 0x3b, 0x7d, 0x85, 0xa3, 0xbf,
+	0x9c, 0x44, 0x56, 0xee, 0xdc, 0x28, 0x38, 0x82, 0x7d, 0x85, 0x54, 0x0b, 0xde, 0xd9, 0xeb, 0x7a,
+	0x3d, 0x9f, 0xb8, 0x28, 0x2a, 0xdd, 0x4a, 0xdf, 0x1b, 0x6a, 0x70, 0xc0, 0xc7, 0x42, 0xbf, 0x53,
+	0x05, 0x47, 0x16, 0x3c, 0x04, 0x58, 0xff, 0xb7, 0x94, 0xd9, 0x01, 0x7c, 0xe2, 0xbb, 0xcc, 0x80,
+	0x05, 0x27, 0xd0, 0x1e, 0xa7, 0x4a, 0x9b, 0x61, 0xca, 0x19, 0xce, 0xac, 0x68, 0x8b, 0x80, 0x4d,
+	0x0d, 0xaa, 0x4c, 0xd5, 0x9f, 0xd1, 0xba, 0xde, 0xb4, 0x75, 0x3f, 0xa3, 0xae, 0x7c, 0x76, 0x79,
+	0xbd, 0x08, 0xbd, 0x9b, 0x45, 0xe8, 0xfd, 0x5c, 0x84, 0xde, 0xb7, 0x65, 0xd8, 0xb8, 0x59, 0x86,
+	0x8d, 0xef, 0xcb, 0xb0, 0xf1, 0xe9, 0xc5, 0x24, 0x35, 0xd3, 0x62, 0x14, 0x5f, 0x89, 0x3c, 0xf9,
+	0xc7, 0xb5, 0x95, 0xa7, 0xc9, 0xac, 0x3e, 0x39, 0x33, 0x97, 0xa8, 0x47, 0xfb, 0xf6, 0xde, 0x4e,
+	0x7f, 0x0d, 0x00, 0x87, 0x57, 0xb2, 0xdc, 0x2e, 0x04, 0x00, 0x00,
 }
 
 func (m *EventAppAdded) Marshal() (dAtA []byte, err error) {
@@ -392,6 +614,152 @@ func (m *EventMarkObsoleteRollapps) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
+func (m *EventChallengeCreated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChallengeCreated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChallengeCreated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Challenge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChallengeResponded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChallengeResponded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChallengeResponded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Challenge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChallengeResolved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChallengeResolved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChallengeResolved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Challenge.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventStateInfosPruned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventStateInfosPruned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventStateInfosPruned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.LastIndex))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FirstIndex != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FirstIndex))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -461,6 +829,62 @@ func (m *EventMarkObsoleteRollapps) Size() (n int) {
 	return n
 }
 
+func (m *EventChallengeCreated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Challenge.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventChallengeResponded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Challenge.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventChallengeResolved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Challenge.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventStateInfosPruned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FirstIndex != 0 {
+		n += 1 + sovEvents(uint64(m.FirstIndex))
+	}
+	if m.LastIndex != 0 {
+		n += 1 + sovEvents(uint64(m.LastIndex))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -870,6 +1294,407 @@ func (m *EventMarkObsoleteRollapps) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventChallengeCreated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChallengeCreated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChallengeCreated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChallengeResponded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChallengeResponded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChallengeResponded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChallengeResolved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChallengeResolved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChallengeResolved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Challenge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventStateInfosPruned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventStateInfosPruned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventStateInfosPruned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstIndex", wireType)
+			}
+			m.FirstIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FirstIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastIndex", wireType)
+			}
+			m.LastIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -153,5 +153,60 @@ func (gs GenesisState) Validate() error {
 		obsoleteDRSVersionIndexMap[elem] = struct{}{}
 	}
 
+	if err := gs.validateChallenges(); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
+
+// validateChallenges checks the pending challenges and their deadlines are exactly those of the challenges
+// which are pending
+func (gs GenesisState) validateChallenges() error {
+	challenges := make(map[uint64]Challenge)
+	pending := 0
+	for _, c := range gs.Challenges {
+		if _, ok := challenges[c.Id]; ok {
+			return fmt.Errorf("duplicated challenge: %d", c.Id)
+		}
+		if gs.ChallengeSeq <= c.Id {
+			return fmt.Errorf("challenge id not below the challenge seq: %d", c.Id)
+		}
+		challenges[c.Id] = c
+		if c.IsPending() {
+			pending++
+		}
+	}
+
+	pendingIndexMap := make(map[string]struct{})
+	for _, elem := range gs.PendingChallenges {
+		c, ok := challenges[elem.ChallengeId]
+		if !ok || !c.IsPending() || c.RollappId != elem.RollappId || c.Height != elem.Height {
+			return fmt.Errorf("pending challenge does not match a pending challenge: %d", elem.ChallengeId)
+		}
+		index := fmt.Sprintf("%s-%d", elem.RollappId, elem.Height)
+		if _, ok := pendingIndexMap[index]; ok {
+			return fmt.Errorf("duplicated pending challenge: rollapp: %s: height: %d", elem.RollappId, elem.Height)
+		}
+		pendingIndexMap[index] = struct{}{}
+	}
+	if len(gs.PendingChallenges) != pending {
+		return errors.New("pending challenges do not match the challenges")
+	}
+
+	deadlineIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.ChallengeDeadlines {
+		c, ok := challenges[elem.ChallengeId]
+		if !ok || !c.IsPending() || c.Deadline != elem.HubHeight {
+			return fmt.Errorf("challenge deadline does not match a pending challenge: %d", elem.ChallengeId)
+		}
+		if _, ok := deadlineIndexMap[elem.ChallengeId]; ok {
+			return fmt.Errorf("duplicated challenge deadline: %d", elem.ChallengeId)
+		}
+		deadlineIndexMap[elem.ChallengeId] = struct{}{}
+	}
+	if len(gs.ChallengeDeadlines) != pending {
+		return errors.New("challenge deadlines do not match the challenges")
+	}
+	return nil
+}
@@ -38,6 +38,15 @@ type GenesisState struct {
 	SequencerHeightPairs []SequencerHeightPair     `protobuf:"bytes,10,rep,name=sequencerHeightPairs,proto3" json:"sequencerHeightPairs"`
 	// ObsoleteDrsVersions is a list of DRS versions that are marked obsolete
 	ObsoleteDrsVersions []uint32 `protobuf:"varint,11,rep,packed,name=obsolete_drs_versions,json=obsoleteDrsVersions,proto3" json:"obsolete_drs_versions,omitempty"`
+	// challenges are all the challenges, the closed ones included
+	Challenges []Challenge `protobuf:"bytes,12,rep,name=challenges,proto3" json:"challenges"`
+	// challenge_seq is the id of the next challenge
+	ChallengeSeq uint64 `protobuf:"varint,13,opt,name=challenge_seq,json=challengeSeq,proto3" json:"challenge_seq,omitempty"`
+	// pending_challenges index the challenges awaiting a response or an
+	// adjudication by challenged height
+	PendingChallenges []PendingChallenge `protobuf:"bytes,14,rep,name=pending_challenges,json=pendingChallenges,proto3" json:"pending_challenges"`
+	// challenge_deadlines are the deadlines of the pending challenges
+	ChallengeDeadlines []ChallengeDeadline `protobuf:"bytes,15,rep,name=challenge_deadlines,json=challengeDeadlines,proto3" json:"challenge_deadlines"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -150,6 +159,146 @@ func (m *GenesisState) GetObsoleteDrsVersions() []uint32 {
 	return nil
 }
 
+func (m *GenesisState) GetChallenges() []Challenge {
+	if m != nil {
+		return m.Challenges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChallengeSeq() uint64 {
+	if m != nil {
+		return m.ChallengeSeq
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPendingChallenges() []PendingChallenge {
+	if m != nil {
+		return m.PendingChallenges
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChallengeDeadlines() []ChallengeDeadline {
+	if m != nil {
+		return m.ChallengeDeadlines
+	}
+	return nil
+}
+
+type PendingChallenge struct {
+	RollappId   string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	Height      uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	ChallengeId uint64 `protobuf:"varint,3,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+}
+
+func (m *PendingChallenge) Reset()         { *m = PendingChallenge{} }
+func (m *PendingChallenge) String() string { return proto.CompactTextString(m) }
+func (*PendingChallenge) ProtoMessage()    {}
+func (*PendingChallenge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b76890aebc09aa04, []int{1}
+}
+func (m *PendingChallenge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingChallenge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingChallenge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingChallenge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingChallenge.Merge(m, src)
+}
+func (m *PendingChallenge) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingChallenge) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingChallenge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingChallenge proto.InternalMessageInfo
+
+func (m *PendingChallenge) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *PendingChallenge) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *PendingChallenge) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
+type ChallengeDeadline struct {
+	HubHeight   uint64 `protobuf:"varint,1,opt,name=hub_height,json=hubHeight,proto3" json:"hub_height,omitempty"`
+	ChallengeId uint64 `protobuf:"varint,2,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+}
+
+func (m *ChallengeDeadline) Reset()         { *m = ChallengeDeadline{} }
+func (m *ChallengeDeadline) String() string { return proto.CompactTextString(m) }
+func (*ChallengeDeadline) ProtoMessage()    {}
+func (*ChallengeDeadline) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b76890aebc09aa04, []int{2}
+}
+func (m *ChallengeDeadline) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChallengeDeadline) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChallengeDeadline.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChallengeDeadline) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChallengeDeadline.Merge(m, src)
+}
+func (m *ChallengeDeadline) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChallengeDeadline) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChallengeDeadline.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChallengeDeadline proto.InternalMessageInfo
+
+func (m *ChallengeDeadline) GetHubHeight() uint64 {
+	if m != nil {
+		return m.HubHeight
+	}
+	return 0
+}
+
+func (m *ChallengeDeadline) GetChallengeId() uint64 {
+	if m != nil {
+		return m.ChallengeId
+	}
+	return 0
+}
+
 type SequencerHeightPair struct {
 	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
 	Height    uint64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -159,7 +308,7 @@ func (m *SequencerHeightPair) Reset()         { *m = SequencerHeightPair{} }
 func (m *SequencerHeightPair) String() string { return proto.CompactTextString(m) }
 func (*SequencerHeightPair) ProtoMessage()    {}
 func (*SequencerHeightPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b76890aebc09aa04, []int{1}
+	return fileDescriptor_b76890aebc09aa04, []int{3}
 }
 func (m *SequencerHeightPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -211,7 +360,7 @@ func (m *RollappRegisteredDenoms) Reset()         { *m = RollappRegisteredDenoms
 func (m *RollappRegisteredDenoms) String() string { return proto.CompactTextString(m) }
 func (*RollappRegisteredDenoms) ProtoMessage()    {}
 func (*RollappRegisteredDenoms) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b76890aebc09aa04, []int{2}
+	return fileDescriptor_b76890aebc09aa04, []int{4}
 }
 func (m *RollappRegisteredDenoms) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -256,6 +405,8 @@ func (m *RollappRegisteredDenoms) GetDenoms() []string {
 
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dymensionxyz.dymension.rollapp.GenesisState")
+	proto.RegisterType((*PendingChallenge)(nil), "dymensionxyz.dymension.rollapp.PendingChallenge")
+	proto.RegisterType((*ChallengeDeadline)(nil), "dymensionxyz.dymension.rollapp.ChallengeDeadline")
 	proto.RegisterType((*SequencerHeightPair)(nil), "dymensionxyz.dymension.rollapp.SequencerHeightPair")
 	proto.RegisterType((*RollappRegisteredDenoms)(nil), "dymensionxyz.dymension.rollapp.RollappRegisteredDenoms")
 }
@@ -265,44 +416,53 @@ func init() {
 }
 
 var fileDescriptor_b76890aebc09aa04 = []byte{
-	// 589 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
-	0x14, 0xc5, 0xe3, 0xb6, 0x5f, 0xda, 0x4c, 0xbe, 0x22, 0x34, 0x2d, 0x60, 0x45, 0xd4, 0x44, 0x41,
-	0x82, 0x20, 0xa8, 0x2d, 0x25, 0x48, 0xec, 0x90, 0x08, 0xe1, 0x4f, 0x44, 0x05, 0xc1, 0x05, 0x16,
-	0xb0, 0x88, 0x9c, 0xf8, 0xd6, 0x19, 0xe1, 0x78, 0x8c, 0x67, 0x12, 0x25, 0x79, 0x0a, 0x16, 0xbc,
-	0x08, 0x6f, 0xd1, 0x65, 0x97, 0xac, 0x10, 0x4a, 0x5e, 0x04, 0x79, 0x3c, 0x36, 0xa1, 0x6d, 0x32,
-	0x91, 0x58, 0x39, 0xe3, 0x39, 0xf7, 0x77, 0x8e, 0x6f, 0xae, 0x2e, 0x7a, 0xe0, 0x4e, 0x06, 0x10,
-	0x30, 0x42, 0x83, 0xf1, 0x64, 0x6a, 0x65, 0x07, 0x2b, 0xa2, 0xbe, 0xef, 0x84, 0xa1, 0xe5, 0x41,
-	0x00, 0x8c, 0x30, 0x33, 0x8c, 0x28, 0xa7, 0xd8, 0x58, 0x54, 0x9b, 0xd9, 0xc1, 0x94, 0xea, 0xd2,
-	0xbe, 0x47, 0x3d, 0x2a, 0xa4, 0x56, 0xfc, 0x2b, 0xa9, 0x2a, 0xdd, 0x57, 0x78, 0x84, 0x4e, 0xe4,
-	0x0c, 0xa4, 0x45, 0x49, 0x15, 0x48, 0x3e, 0xa5, 0xda, 0x52, 0xa8, 0x19, 0x77, 0x38, 0x74, 0x48,
-	0x70, 0x92, 0x66, 0x39, 0x54, 0x14, 0xf8, 0x64, 0x14, 0x7f, 0x71, 0x9a, 0xa6, 0xaa, 0x90, 0x67,
-	0x49, 0x2a, 0xdf, 0x77, 0xd0, 0xff, 0x2f, 0x92, 0x66, 0x1d, 0xc7, 0xa6, 0xb8, 0x89, 0xf2, 0xc9,
-	0x87, 0xe9, 0x5a, 0x59, 0xab, 0x16, 0x6b, 0x77, 0xcc, 0xd5, 0xcd, 0x33, 0xdb, 0x42, 0xdd, 0xd8,
-	0x3a, 0xfd, 0x79, 0x2b, 0x67, 0xcb, 0x5a, 0xfc, 0x06, 0x15, 0xe5, 0xfd, 0x11, 0x61, 0x5c, 0xdf,
-	0x28, 0x6f, 0x56, 0x8b, 0xb5, 0xbb, 0x2a, 0x94, 0x9d, 0x3c, 0x25, 0x6b, 0x91, 0x80, 0xdf, 0xa3,
-	0x5d, 0xd1, 0x94, 0x56, 0x70, 0x42, 0x05, 0x72, 0x53, 0x20, 0xef, 0xa9, 0x90, 0xc7, 0x69, 0x91,
-	0x84, 0xfe, 0x4d, 0xc1, 0x21, 0xd2, 0x7d, 0x87, 0x03, 0xe3, 0x99, 0xae, 0x15, 0xb8, 0x30, 0x16,
-	0x0e, 0x5b, 0xc2, 0xc1, 0x5c, 0xdb, 0x41, 0x54, 0x4a, 0x9b, 0xa5, 0x54, 0x3c, 0x45, 0x07, 0xc9,
-	0xdd, 0x73, 0x12, 0x38, 0x3e, 0x99, 0x82, 0x2b, 0x45, 0xa9, 0xed, 0x7f, 0xff, 0x60, 0xbb, 0x1a,
-	0x8d, 0xbf, 0x69, 0xa8, 0xd2, 0xf5, 0x69, 0xef, 0xf3, 0x4b, 0x20, 0x5e, 0x9f, 0xbf, 0xa3, 0x52,
-	0xe8, 0x70, 0x42, 0x83, 0xb7, 0x43, 0x18, 0x82, 0x48, 0x90, 0x17, 0x09, 0x1e, 0xab, 0x12, 0x34,
-	0x56, 0x92, 0x64, 0xa2, 0x35, 0xfc, 0xf0, 0x27, 0x74, 0x25, 0x9d, 0xdf, 0x67, 0x23, 0x08, 0x38,
-	0xd3, 0xb7, 0x45, 0x82, 0x43, 0x55, 0x82, 0xa3, 0xc5, 0x2a, 0x69, 0x78, 0x0e, 0x85, 0x9f, 0xa2,
-	0xed, 0x74, 0x0a, 0x77, 0x04, 0xf5, 0xb6, 0x8a, 0xfa, 0x24, 0x9b, 0xc0, 0xb4, 0x12, 0x13, 0x74,
-	0x35, 0x02, 0x8f, 0x30, 0x0e, 0x11, 0xb8, 0x4d, 0x08, 0xe8, 0x80, 0xe9, 0x05, 0x41, 0x7b, 0xb4,
-	0xe6, 0x4c, 0xdb, 0xe7, 0xca, 0xa5, 0xc3, 0x05, 0x2c, 0x1e, 0xa0, 0x7d, 0x06, 0x5f, 0x86, 0x10,
-	0xf4, 0x20, 0x4a, 0xda, 0xd6, 0x76, 0x48, 0xc4, 0x74, 0x24, 0xec, 0xea, 0xca, 0xb1, 0xb8, 0x58,
-	0x2b, 0xad, 0x2e, 0xc5, 0xe2, 0x1a, 0xba, 0x46, 0xbb, 0x8c, 0xfa, 0xc0, 0xa1, 0xe3, 0x46, 0xac,
-	0x33, 0x82, 0x28, 0xe6, 0x31, 0xbd, 0x58, 0xde, 0xac, 0xee, 0xda, 0x7b, 0xe9, 0x65, 0x33, 0x62,
-	0x1f, 0xe4, 0x55, 0xe5, 0x15, 0xda, 0xbb, 0xc4, 0x06, 0xdf, 0x44, 0x85, 0xcc, 0x42, 0x2c, 0x8f,
-	0x82, 0xfd, 0xe7, 0x05, 0xbe, 0x8e, 0xf2, 0x7d, 0xa1, 0xd5, 0x37, 0xca, 0x5a, 0x75, 0xcb, 0x96,
-	0xa7, 0x4a, 0x1b, 0xdd, 0x58, 0xd2, 0x22, 0x7c, 0x80, 0x90, 0xfc, 0xac, 0x0e, 0x71, 0x53, 0xa2,
-	0x7c, 0xd3, 0x72, 0x63, 0xa2, 0x9b, 0xfc, 0x15, 0xf1, 0x7a, 0x29, 0xd8, 0xf2, 0xd4, 0x78, 0x7d,
-	0x3a, 0x33, 0xb4, 0xb3, 0x99, 0xa1, 0xfd, 0x9a, 0x19, 0xda, 0xd7, 0xb9, 0x91, 0x3b, 0x9b, 0x1b,
-	0xb9, 0x1f, 0x73, 0x23, 0xf7, 0xf1, 0xa1, 0x47, 0x78, 0x7f, 0xd8, 0x35, 0x7b, 0x74, 0xb0, 0x6c,
-	0x03, 0x8f, 0xea, 0xd6, 0x38, 0x5b, 0x93, 0x7c, 0x12, 0x02, 0xeb, 0xe6, 0xc5, 0xa6, 0xac, 0xff,
-	0x0e, 0x00, 0x00, 0xff, 0xff, 0xfd, 0x21, 0x55, 0x13, 0x74, 0x06, 0x00, 0x00,
+	// 731 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x53, 0xd3, 0x4c,
+	0x18, 0x6f, 0x28, 0x6f, 0x79, 0xfb, 0x94, 0xf2, 0xc2, 0xc2, 0xab, 0x19, 0x46, 0x6a, 0x2d, 0x33,
+	0x5a, 0x47, 0x49, 0x15, 0x9c, 0xf1, 0xe6, 0x8c, 0x50, 0x95, 0x8e, 0x8c, 0x60, 0x11, 0x0f, 0x7a,
+	0xe8, 0xa4, 0xcd, 0x43, 0xb2, 0x63, 0xba, 0x1b, 0xb2, 0x69, 0x07, 0xf8, 0x14, 0x1e, 0xfc, 0x50,
+	0x1c, 0x39, 0x7a, 0x72, 0x1c, 0xf8, 0x12, 0x1e, 0x9d, 0x6c, 0x36, 0xa1, 0xb6, 0x94, 0x74, 0xc6,
+	0x53, 0xba, 0xfb, 0xfc, 0xfe, 0xed, 0xd3, 0x27, 0x59, 0x78, 0x6c, 0x9d, 0x74, 0x91, 0x09, 0xca,
+	0xd9, 0xf1, 0xc9, 0x69, 0x2d, 0x59, 0xd4, 0x7c, 0xee, 0xba, 0xa6, 0xe7, 0xd5, 0x6c, 0x64, 0x28,
+	0xa8, 0x30, 0x3c, 0x9f, 0x07, 0x9c, 0x94, 0x06, 0xd1, 0x46, 0xb2, 0x30, 0x14, 0x7a, 0x79, 0xc9,
+	0xe6, 0x36, 0x97, 0xd0, 0x5a, 0xf8, 0x2b, 0x62, 0x2d, 0x3f, 0x4a, 0xf1, 0xf0, 0x4c, 0xdf, 0xec,
+	0x2a, 0x8b, 0xe5, 0xb4, 0x40, 0xea, 0xa9, 0xd0, 0xb5, 0x14, 0xb4, 0x08, 0xcc, 0x00, 0x5b, 0x94,
+	0x1d, 0xc6, 0x59, 0xd6, 0x52, 0x08, 0x2e, 0xed, 0x87, 0x27, 0x8e, 0xd3, 0x54, 0x53, 0xe0, 0x57,
+	0x49, 0x8c, 0x14, 0x64, 0xc7, 0x31, 0x5d, 0x17, 0x99, 0x8d, 0x11, 0xbe, 0xf2, 0x0b, 0x60, 0xf6,
+	0x4d, 0xd4, 0xdc, 0xfd, 0x30, 0x24, 0xa9, 0x43, 0x2e, 0x6a, 0x84, 0xae, 0x95, 0xb5, 0x6a, 0x61,
+	0xfd, 0xbe, 0x71, 0x73, 0xb3, 0x8d, 0x3d, 0x89, 0xde, 0x9c, 0x3e, 0xfb, 0x71, 0x37, 0xd3, 0x54,
+	0x5c, 0xb2, 0x0b, 0x05, 0x55, 0xdf, 0xa1, 0x22, 0xd0, 0xa7, 0xca, 0xd9, 0x6a, 0x61, 0xfd, 0x41,
+	0x9a, 0x54, 0x33, 0x7a, 0x2a, 0xad, 0x41, 0x05, 0x72, 0x00, 0x45, 0xd9, 0xc4, 0x06, 0x3b, 0xe4,
+	0x52, 0x32, 0x2b, 0x25, 0x1f, 0xa6, 0x49, 0xee, 0xc7, 0x24, 0x25, 0xfa, 0xa7, 0x0a, 0xf1, 0x40,
+	0x77, 0xcd, 0x00, 0x45, 0x90, 0xe0, 0x1a, 0xcc, 0xc2, 0x63, 0xe9, 0x30, 0x2d, 0x1d, 0x8c, 0x89,
+	0x1d, 0x24, 0x53, 0xd9, 0x8c, 0x55, 0x25, 0xa7, 0xb0, 0x12, 0xd5, 0x5e, 0x53, 0x66, 0xba, 0xf4,
+	0x14, 0x2d, 0x05, 0x8a, 0x6d, 0xff, 0xf9, 0x0b, 0xdb, 0x9b, 0xa5, 0xc9, 0x37, 0x0d, 0x2a, 0x6d,
+	0x97, 0x77, 0xbe, 0x6c, 0x23, 0xb5, 0x9d, 0xe0, 0x03, 0x57, 0x40, 0x33, 0xa0, 0x9c, 0xbd, 0xef,
+	0x61, 0x0f, 0x65, 0x82, 0x9c, 0x4c, 0xf0, 0x22, 0x2d, 0xc1, 0xe6, 0x8d, 0x4a, 0x2a, 0xd1, 0x04,
+	0x7e, 0xe4, 0x33, 0xcc, 0xc5, 0xf3, 0xfe, 0xaa, 0x8f, 0x2c, 0x10, 0xfa, 0x8c, 0x4c, 0xb0, 0x96,
+	0x96, 0x60, 0x67, 0x90, 0xa5, 0x0c, 0x87, 0xa4, 0xc8, 0x16, 0xcc, 0xc4, 0x53, 0xf8, 0xaf, 0x54,
+	0x5d, 0x4d, 0x53, 0x7d, 0x99, 0x4c, 0x60, 0xcc, 0x24, 0x14, 0xe6, 0x7d, 0xb4, 0xa9, 0x08, 0xd0,
+	0x47, 0xab, 0x8e, 0x8c, 0x77, 0x85, 0x9e, 0x97, 0x6a, 0xcf, 0x27, 0x9c, 0xe9, 0xe6, 0x10, 0x5d,
+	0x39, 0x8c, 0xc8, 0x92, 0x2e, 0x2c, 0x09, 0x3c, 0xea, 0x21, 0xeb, 0xa0, 0x1f, 0xb5, 0x6d, 0xcf,
+	0xa4, 0xbe, 0xd0, 0x41, 0xda, 0x6d, 0xa4, 0x8e, 0xc5, 0x28, 0x57, 0x59, 0x5d, 0x2b, 0x4b, 0xd6,
+	0xe1, 0x7f, 0xde, 0x16, 0xdc, 0xc5, 0x00, 0x5b, 0x96, 0x2f, 0x5a, 0x7d, 0xf4, 0x43, 0x3d, 0xa1,
+	0x17, 0xca, 0xd9, 0x6a, 0xb1, 0xb9, 0x18, 0x17, 0xeb, 0xbe, 0xf8, 0xa8, 0x4a, 0x64, 0x17, 0x20,
+	0xf9, 0x8c, 0x08, 0x7d, 0x76, 0xb2, 0x17, 0x71, 0x2b, 0x66, 0xa8, 0x38, 0x03, 0x12, 0x64, 0x15,
+	0x8a, 0xc9, 0xaa, 0x25, 0xf0, 0x48, 0x2f, 0x96, 0xb5, 0xea, 0x74, 0x73, 0x36, 0xd9, 0xdc, 0xc7,
+	0x23, 0x82, 0x40, 0x3c, 0x64, 0x16, 0x65, 0x76, 0x6b, 0xc0, 0x7d, 0x4e, 0xba, 0x3f, 0x49, 0xfd,
+	0x48, 0x45, 0xcc, 0xe1, 0x10, 0x0b, 0xde, 0xd0, 0xbe, 0x20, 0x0e, 0x2c, 0x5e, 0x65, 0xb1, 0xd0,
+	0xb4, 0x5c, 0xca, 0x50, 0xe8, 0xff, 0x49, 0x9f, 0xa7, 0x13, 0x9f, 0xb2, 0xae, 0x98, 0xca, 0x88,
+	0x74, 0x86, 0x0b, 0xa2, 0xe2, 0xc2, 0xfc, 0x70, 0x2c, 0xb2, 0x02, 0xa0, 0xa4, 0x5a, 0xd4, 0x92,
+	0x5f, 0xe0, 0x7c, 0x33, 0xaf, 0x76, 0x1a, 0x16, 0xb9, 0x05, 0x39, 0x47, 0xfe, 0x79, 0xfa, 0x94,
+	0xec, 0x90, 0x5a, 0x91, 0x7b, 0x70, 0xd5, 0xab, 0x90, 0x98, 0x95, 0xd5, 0x42, 0xb2, 0xd7, 0xb0,
+	0x2a, 0x07, 0xb0, 0x30, 0x12, 0x2e, 0xb4, 0x73, 0x7a, 0xed, 0x96, 0xd2, 0xd4, 0x24, 0x2b, 0xef,
+	0xf4, 0xda, 0xdb, 0xd7, 0xcb, 0x4e, 0x8d, 0xca, 0xbe, 0x85, 0xc5, 0x6b, 0x46, 0x8e, 0xdc, 0x81,
+	0x7c, 0x32, 0x6e, 0xf1, 0x31, 0x92, 0x8d, 0x71, 0xc7, 0xa8, 0xec, 0xc1, 0xed, 0x31, 0xaf, 0xcb,
+	0x04, 0x8d, 0xb1, 0xa2, 0xd7, 0x32, 0xbc, 0x6a, 0xf2, 0x4d, 0xb5, 0xda, 0x7c, 0x77, 0x76, 0x51,
+	0xd2, 0xce, 0x2f, 0x4a, 0xda, 0xcf, 0x8b, 0x92, 0xf6, 0xf5, 0xb2, 0x94, 0x39, 0xbf, 0x2c, 0x65,
+	0xbe, 0x5f, 0x96, 0x32, 0x9f, 0x9e, 0xd9, 0x34, 0x70, 0x7a, 0x6d, 0xa3, 0xc3, 0xbb, 0xe3, 0x6e,
+	0xef, 0xfe, 0x46, 0xed, 0x38, 0xb9, 0x38, 0x83, 0x13, 0x0f, 0x45, 0x3b, 0x27, 0x6f, 0xcd, 0x8d,
+	0xdf, 0x03, 0x00, 0xfb, 0xbc, 0x4a, 0x43, 0xb0, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -325,6 +485,53 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChallengeDeadlines) > 0 {
+		for iNdEx := len(m.ChallengeDeadlines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChallengeDeadlines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.PendingChallenges) > 0 {
+		for iNdEx := len(m.PendingChallenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingChallenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.ChallengeSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ChallengeSeq))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Challenges) > 0 {
+		for iNdEx := len(m.Challenges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Challenges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
 	if len(m.ObsoleteDrsVersions) > 0 {
 		dAtA2 := make([]byte, len(m.ObsoleteDrsVersions)*10)
 		var j1 int
@@ -482,6 +689,79 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PendingChallenge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingChallenge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingChallenge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChallengeDeadline) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChallengeDeadline) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChallengeDeadline) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ChallengeId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ChallengeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.HubHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HubHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SequencerHeightPair) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -636,6 +916,61 @@ func (m *GenesisState) Size() (n int) {
 		}
 		n += 1 + sovGenesis(uint64(l)) + l
 	}
+	if len(m.Challenges) > 0 {
+		for _, e := range m.Challenges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ChallengeSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.ChallengeSeq))
+	}
+	if len(m.PendingChallenges) > 0 {
+		for _, e := range m.PendingChallenges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChallengeDeadlines) > 0 {
+		for _, e := range m.ChallengeDeadlines {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingChallenge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovGenesis(uint64(m.ChallengeId))
+	}
+	return n
+}
+
+func (m *ChallengeDeadline) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HubHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.HubHeight))
+	}
+	if m.ChallengeId != 0 {
+		n += 1 + sovGenesis(uint64(m.ChallengeId))
+	}
 	return n
 }
 
@@ -1124,6 +1459,335 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field ObsoleteDrsVersions", wireType)
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenges = append(m.Challenges, Challenge{})
+			if err := m.Challenges[len(m.Challenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeSeq", wireType)
+			}
+			m.ChallengeSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingChallenges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingChallenges = append(m.PendingChallenges, PendingChallenge{})
+			if err := m.PendingChallenges[len(m.PendingChallenges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeDeadlines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChallengeDeadlines = append(m.ChallengeDeadlines, ChallengeDeadline{})
+			if err := m.ChallengeDeadlines[len(m.ChallengeDeadlines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingChallenge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingChallenge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingChallenge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChallengeDeadline) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChallengeDeadline: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChallengeDeadline: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HubHeight", wireType)
+			}
+			m.HubHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HubHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeId", wireType)
+			}
+			m.ChallengeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -260,6 +260,56 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "valid challenges",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Challenges: []types.Challenge{
+					{Id: 0, RollappId: "rollapp1", Height: 5, Deadline: 20, Status: types.ChallengeStatus_CHALLENGE_STATUS_OPEN},
+					{Id: 1, RollappId: "rollapp1", Height: 7, Deadline: 10, Status: types.ChallengeStatus_CHALLENGE_STATUS_VOID},
+				},
+				ChallengeSeq:       2,
+				PendingChallenges:  []types.PendingChallenge{{RollappId: "rollapp1", Height: 5, ChallengeId: 0}},
+				ChallengeDeadlines: []types.ChallengeDeadline{{HubHeight: 20, ChallengeId: 0}},
+			},
+			valid: true,
+		},
+		{
+			desc: "challenge id not below the challenge seq",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Challenges: []types.Challenge{
+					{Id: 1, RollappId: "rollapp1", Height: 7, Status: types.ChallengeStatus_CHALLENGE_STATUS_VOID},
+				},
+				ChallengeSeq: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "pending challenge without deadline",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Challenges: []types.Challenge{
+					{Id: 0, RollappId: "rollapp1", Height: 5, Deadline: 20, Status: types.ChallengeStatus_CHALLENGE_STATUS_RESPONDED},
+				},
+				ChallengeSeq:      1,
+				PendingChallenges: []types.PendingChallenge{{RollappId: "rollapp1", Height: 5, ChallengeId: 0}},
+			},
+			valid: false,
+		},
+		{
+			desc: "pending index of a closed challenge",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Challenges: []types.Challenge{
+					{Id: 0, RollappId: "rollapp1", Height: 5, Deadline: 20, Status: types.ChallengeStatus_CHALLENGE_STATUS_UPHELD},
+				},
+				ChallengeSeq:       1,
+				PendingChallenges:  []types.PendingChallenge{{RollappId: "rollapp1", Height: 5, ChallengeId: 0}},
+				ChallengeDeadlines: []types.ChallengeDeadline{{HubHeight: 20, ChallengeId: 0}},
+			},
+			valid: false,
+		},
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
 			err := tc.genState.Validate()
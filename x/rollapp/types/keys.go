@@ -33,3 +33,21 @@ const (
 )
 
 var SeqToUnfinalizedHeightKeyPrefix = collections.NewPrefix("seqToFinalizeHeight/")
+
+var (
+	ChallengesKeyPrefix          = collections.NewPrefix("challenges/")
+	ChallengeSeqKey              = collections.NewPrefix("challengeSeq/")
+	ChallengesByRollappKeyPrefix = collections.NewPrefix("challengesByRollapp/")
+	PendingChallengesKeyPrefix   = collections.NewPrefix("pendingChallenges/")
+	ChallengeDeadlinesKeyPrefix  = collections.NewPrefix("challengeDeadlines/")
+)
+
+// DisputePeriodRollappsKeyPrefix is the prefix of the rollapps whose pending states have their own dispute period
+var DisputePeriodRollappsKeyPrefix = collections.NewPrefix("disputePeriodRollapps/")
+
+var (
+	// FirstStateInfoIndexKeyPrefix is the prefix of the index of the first state each rollapp kept, after pruning
+	FirstStateInfoIndexKeyPrefix = collections.NewPrefix("firstStateInfoIndex/")
+	// StateInfoPruneQueueKeyPrefix is the prefix of the rollapps which may have finalized states to prune
+	StateInfoPruneQueueKeyPrefix = collections.NewPrefix("stateInfoPruneQueue/")
+)
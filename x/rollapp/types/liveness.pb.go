@@ -5,7 +5,8 @@ package types
 
 import (
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -79,8 +80,134 @@ func (m *LivenessEvent) GetHubHeight() int64 {
 	return 0
 }
 
+// RollappLiveness is the liveness health of a rollapp: when it is going to be
+// slashed for not updating its state, and what its proposer stands to lose.
+type RollappLiveness struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// last_state_update_height is the hub height of the latest state update, 0
+	// if none
+	LastStateUpdateHeight uint64 `protobuf:"varint,2,opt,name=last_state_update_height,json=lastStateUpdateHeight,proto3" json:"last_state_update_height,omitempty"`
+	// liveness_countdown_start_height is the hub height the liveness clock was
+	// last reset at
+	LivenessCountdownStartHeight int64 `protobuf:"varint,3,opt,name=liveness_countdown_start_height,json=livenessCountdownStartHeight,proto3" json:"liveness_countdown_start_height,omitempty"`
+	// next_slash_height is the hub height of the next liveness slash, 0 if none
+	// is scheduled
+	NextSlashHeight int64 `protobuf:"varint,4,opt,name=next_slash_height,json=nextSlashHeight,proto3" json:"next_slash_height,omitempty"`
+	// blocks_until_slash is the number of hub blocks until the next liveness
+	// slash, 0 if none is scheduled
+	BlocksUntilSlash int64 `protobuf:"varint,5,opt,name=blocks_until_slash,json=blocksUntilSlash,proto3" json:"blocks_until_slash,omitempty"`
+	// proposer is the address of the current proposer, the sentinel if there is
+	// none
+	Proposer string `protobuf:"bytes,6,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// proposer_bond is the bond of the current proposer, in all the denoms it
+	// bonded in
+	ProposerBond github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=proposer_bond,json=proposerBond,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"proposer_bond"`
+	// proposer_dishonor is the dishonor score of the current proposer
+	ProposerDishonor uint64 `protobuf:"varint,8,opt,name=proposer_dishonor,json=proposerDishonor,proto3" json:"proposer_dishonor,omitempty"`
+	// projected_slash is what the current proposer is slashed at the next
+	// liveness slash
+	ProjectedSlash github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,9,rep,name=projected_slash,json=projectedSlash,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"projected_slash"`
+}
+
+func (m *RollappLiveness) Reset()         { *m = RollappLiveness{} }
+func (m *RollappLiveness) String() string { return proto.CompactTextString(m) }
+func (*RollappLiveness) ProtoMessage()    {}
+func (*RollappLiveness) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0e2dfe628b004fdb, []int{1}
+}
+func (m *RollappLiveness) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RollappLiveness) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RollappLiveness.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RollappLiveness) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RollappLiveness.Merge(m, src)
+}
+func (m *RollappLiveness) XXX_Size() int {
+	return m.Size()
+}
+func (m *RollappLiveness) XXX_DiscardUnknown() {
+	xxx_messageInfo_RollappLiveness.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RollappLiveness proto.InternalMessageInfo
+
+func (m *RollappLiveness) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *RollappLiveness) GetLastStateUpdateHeight() uint64 {
+	if m != nil {
+		return m.LastStateUpdateHeight
+	}
+	return 0
+}
+
+func (m *RollappLiveness) GetLivenessCountdownStartHeight() int64 {
+	if m != nil {
+		return m.LivenessCountdownStartHeight
+	}
+	return 0
+}
+
+func (m *RollappLiveness) GetNextSlashHeight() int64 {
+	if m != nil {
+		return m.NextSlashHeight
+	}
+	return 0
+}
+
+func (m *RollappLiveness) GetBlocksUntilSlash() int64 {
+	if m != nil {
+		return m.BlocksUntilSlash
+	}
+	return 0
+}
+
+func (m *RollappLiveness) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *RollappLiveness) GetProposerBond() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProposerBond
+	}
+	return nil
+}
+
+func (m *RollappLiveness) GetProposerDishonor() uint64 {
+	if m != nil {
+		return m.ProposerDishonor
+	}
+	return 0
+}
+
+func (m *RollappLiveness) GetProjectedSlash() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProjectedSlash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*LivenessEvent)(nil), "dymensionxyz.dymension.rollapp.LivenessEvent")
+	proto.RegisterType((*RollappLiveness)(nil), "dymensionxyz.dymension.rollapp.RollappLiveness")
 }
 
 func init() {
@@ -88,23 +215,38 @@ func init() {
 }
 
 var fileDescriptor_0e2dfe628b004fdb = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4d, 0xa9, 0xcc, 0x4d,
-	0xcd, 0x2b, 0xce, 0xcc, 0xcf, 0xab, 0xa8, 0xac, 0xd2, 0x87, 0x73, 0xf4, 0x8b, 0xf2, 0x73, 0x72,
-	0x12, 0x0b, 0x0a, 0xf4, 0x73, 0x32, 0xcb, 0x52, 0xf3, 0x52, 0x8b, 0x8b, 0xf5, 0x0a, 0x8a, 0xf2,
-	0x4b, 0xf2, 0x85, 0xe4, 0x90, 0x95, 0xeb, 0xc1, 0x39, 0x7a, 0x50, 0xe5, 0x52, 0x22, 0xe9, 0xf9,
-	0xe9, 0xf9, 0x60, 0xa5, 0xfa, 0x20, 0x16, 0x44, 0x97, 0x94, 0x3e, 0x01, 0x4b, 0x8a, 0x4b, 0x12,
-	0x4b, 0x52, 0xe3, 0x33, 0xf3, 0xd2, 0x60, 0x1a, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5,
-	0x93, 0x12, 0x8b, 0x53, 0xf5, 0xcb, 0x0c, 0x93, 0x52, 0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33,
-	0xf3, 0x20, 0xf2, 0x4a, 0xc1, 0x5c, 0xbc, 0x3e, 0x50, 0x87, 0xb9, 0x96, 0xa5, 0xe6, 0x95, 0x08,
-	0xc9, 0x72, 0x71, 0x41, 0x0d, 0x8b, 0xcf, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2,
-	0x84, 0x8a, 0x78, 0xa6, 0x80, 0xa4, 0x33, 0x4a, 0x93, 0xe2, 0x33, 0x52, 0x33, 0xd3, 0x33, 0x4a,
-	0x24, 0x98, 0x14, 0x18, 0x35, 0x98, 0x83, 0x38, 0x33, 0x4a, 0x93, 0x3c, 0xc0, 0x02, 0x5e, 0x2c,
-	0x1c, 0xcc, 0x02, 0x2c, 0x4e, 0x7e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x92, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0x8b, 0xcb, 0x2b, 0x65, 0xc6,
-	0xfa, 0x15, 0x70, 0xff, 0x94, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd, 0x6a, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x0d, 0x3f, 0x53, 0x4d, 0x63, 0x01, 0x00, 0x00,
+	// 489 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcf, 0x6e, 0xd3, 0x30,
+	0x18, 0x6f, 0x68, 0x19, 0xad, 0x61, 0xb4, 0x8b, 0x40, 0x0a, 0x15, 0xa4, 0xd5, 0x4e, 0x15, 0xb0,
+	0x98, 0x31, 0x24, 0xee, 0x1d, 0x93, 0x00, 0x21, 0x0e, 0xa9, 0x76, 0xe1, 0x12, 0x25, 0xb1, 0x69,
+	0xcc, 0x52, 0x7f, 0x51, 0xec, 0x94, 0x96, 0xa7, 0xe0, 0x39, 0x78, 0x05, 0x5e, 0x60, 0xc7, 0x1d,
+	0x39, 0x01, 0x6a, 0x5f, 0x04, 0xd9, 0xb1, 0xa3, 0x5e, 0xd0, 0x2e, 0x3b, 0xc5, 0xfe, 0x7e, 0xff,
+	0xec, 0xef, 0x8b, 0xd1, 0x11, 0x59, 0x2f, 0x28, 0x17, 0x0c, 0xf8, 0x6a, 0xfd, 0x0d, 0x37, 0x1b,
+	0x5c, 0x42, 0x9e, 0xc7, 0x45, 0x81, 0x73, 0xb6, 0xa4, 0x9c, 0x0a, 0x11, 0x14, 0x25, 0x48, 0x70,
+	0xfd, 0x5d, 0x7a, 0xd0, 0x6c, 0x02, 0x43, 0x1f, 0x3e, 0x98, 0xc3, 0x1c, 0x34, 0x15, 0xab, 0x55,
+	0xad, 0x1a, 0xe2, 0x6b, 0x42, 0x84, 0x8c, 0x25, 0x8d, 0x18, 0xff, 0x6c, 0x05, 0x7e, 0x0a, 0x62,
+	0x01, 0x02, 0x27, 0xb1, 0xa0, 0x78, 0x79, 0x9c, 0x50, 0x19, 0x1f, 0xe3, 0x14, 0x18, 0xaf, 0xf1,
+	0xc3, 0x19, 0xda, 0xff, 0x60, 0x0e, 0x76, 0xb6, 0xa4, 0x5c, 0xba, 0x4f, 0x10, 0x32, 0x66, 0x11,
+	0x23, 0x9e, 0x33, 0x76, 0x26, 0xbd, 0xb0, 0x67, 0x2a, 0xef, 0x88, 0x82, 0xb3, 0x2a, 0x89, 0x32,
+	0xca, 0xe6, 0x99, 0xf4, 0x6e, 0x8d, 0x9d, 0x49, 0x3b, 0xec, 0x65, 0x55, 0xf2, 0x56, 0x17, 0xde,
+	0x77, 0xba, 0xed, 0x41, 0xe7, 0xf0, 0x67, 0x07, 0xf5, 0xc3, 0x5a, 0x62, 0xcd, 0xaf, 0xf3, 0x7d,
+	0x8d, 0xbc, 0x3c, 0x16, 0x32, 0xaa, 0x2f, 0x50, 0x15, 0x44, 0x7d, 0x76, 0x52, 0x3a, 0xe1, 0x43,
+	0x85, 0xcf, 0x14, 0x7c, 0xae, 0xd1, 0x3a, 0xd1, 0x3d, 0x43, 0x23, 0xdb, 0xd9, 0x28, 0x85, 0x8a,
+	0x4b, 0x02, 0x5f, 0xb9, 0xb2, 0x29, 0xa5, 0xd5, 0xb7, 0xf5, 0x29, 0x1f, 0x5b, 0xda, 0xa9, 0x65,
+	0xcd, 0x14, 0xc9, 0xd8, 0x3c, 0x45, 0x07, 0x9c, 0xae, 0x64, 0x24, 0xf2, 0x58, 0x64, 0x56, 0xd8,
+	0xd1, 0xc2, 0xbe, 0x02, 0x66, 0xaa, 0x6e, 0xb8, 0xcf, 0x91, 0x9b, 0xe4, 0x90, 0x5e, 0x88, 0xa8,
+	0xe2, 0x92, 0xe5, 0xb5, 0xc6, 0xbb, 0xad, 0xc9, 0x83, 0x1a, 0x39, 0x57, 0x80, 0xd6, 0xb8, 0x43,
+	0xd4, 0x2d, 0x4a, 0x28, 0x40, 0xd0, 0xd2, 0xdb, 0xd3, 0xd7, 0x6e, 0xf6, 0x6e, 0x81, 0xf6, 0xed,
+	0x3a, 0x4a, 0x80, 0x13, 0xef, 0xce, 0xb8, 0x3d, 0xb9, 0xfb, 0xf2, 0x51, 0x50, 0x4f, 0x2d, 0x50,
+	0x53, 0x0b, 0xcc, 0xd4, 0x82, 0x53, 0x60, 0x7c, 0xfa, 0xe2, 0xf2, 0xf7, 0xa8, 0xf5, 0xe3, 0xcf,
+	0x68, 0x32, 0x67, 0x32, 0xab, 0x92, 0x20, 0x85, 0x05, 0x36, 0x23, 0xae, 0x3f, 0x47, 0x82, 0x5c,
+	0x60, 0xb9, 0x2e, 0xa8, 0xd0, 0x02, 0x11, 0xde, 0xb3, 0x09, 0x53, 0xe0, 0xc4, 0x7d, 0x86, 0x0e,
+	0x9a, 0x44, 0xc2, 0x44, 0x06, 0x1c, 0x4a, 0xaf, 0xab, 0x1b, 0x3c, 0xb0, 0xc0, 0x1b, 0x53, 0x77,
+	0x25, 0xea, 0x17, 0x25, 0x7c, 0xa1, 0xa9, 0xa4, 0xc4, 0xdc, 0xb2, 0x77, 0xf3, 0x07, 0xbc, 0xdf,
+	0x64, 0xe8, 0x86, 0x4d, 0x3f, 0x5e, 0x6e, 0x7c, 0xe7, 0x6a, 0xe3, 0x3b, 0x7f, 0x37, 0xbe, 0xf3,
+	0x7d, 0xeb, 0xb7, 0xae, 0xb6, 0x7e, 0xeb, 0xd7, 0xd6, 0x6f, 0x7d, 0x7a, 0xb5, 0xe3, 0xf9, 0x9f,
+	0x87, 0xb0, 0x3c, 0xc1, 0xab, 0xe6, 0x35, 0xe8, 0x94, 0x64, 0x4f, 0xff, 0xe9, 0x27, 0xff, 0x06,
+	0x00, 0x15, 0x80, 0x4a, 0xfa, 0xa1, 0x03, 0x00, 0x00,
 }
 
 func (m *LivenessEvent) Marshal() (dAtA []byte, err error) {
@@ -142,6 +284,96 @@ func (m *LivenessEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RollappLiveness) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RollappLiveness) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RollappLiveness) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProjectedSlash) > 0 {
+		for iNdEx := len(m.ProjectedSlash) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProjectedSlash[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiveness(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.ProposerDishonor != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.ProposerDishonor))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ProposerBond) > 0 {
+		for iNdEx := len(m.ProposerBond) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProposerBond[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintLiveness(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.BlocksUntilSlash != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.BlocksUntilSlash))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.NextSlashHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.NextSlashHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LivenessCountdownStartHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.LivenessCountdownStartHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LastStateUpdateHeight != 0 {
+		i = encodeVarintLiveness(dAtA, i, uint64(m.LastStateUpdateHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintLiveness(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintLiveness(dAtA []byte, offset int, v uint64) int {
 	offset -= sovLiveness(v)
 	base := offset
@@ -169,6 +401,50 @@ func (m *LivenessEvent) Size() (n int) {
 	return n
 }
 
+func (m *RollappLiveness) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if m.LastStateUpdateHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.LastStateUpdateHeight))
+	}
+	if m.LivenessCountdownStartHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.LivenessCountdownStartHeight))
+	}
+	if m.NextSlashHeight != 0 {
+		n += 1 + sovLiveness(uint64(m.NextSlashHeight))
+	}
+	if m.BlocksUntilSlash != 0 {
+		n += 1 + sovLiveness(uint64(m.BlocksUntilSlash))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovLiveness(uint64(l))
+	}
+	if len(m.ProposerBond) > 0 {
+		for _, e := range m.ProposerBond {
+			l = e.Size()
+			n += 1 + l + sovLiveness(uint64(l))
+		}
+	}
+	if m.ProposerDishonor != 0 {
+		n += 1 + sovLiveness(uint64(m.ProposerDishonor))
+	}
+	if len(m.ProjectedSlash) > 0 {
+		for _, e := range m.ProjectedSlash {
+			l = e.Size()
+			n += 1 + l + sovLiveness(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovLiveness(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -276,6 +552,283 @@ func (m *LivenessEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *RollappLiveness) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLiveness
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RollappLiveness: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RollappLiveness: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastStateUpdateHeight", wireType)
+			}
+			m.LastStateUpdateHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastStateUpdateHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LivenessCountdownStartHeight", wireType)
+			}
+			m.LivenessCountdownStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LivenessCountdownStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSlashHeight", wireType)
+			}
+			m.NextSlashHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSlashHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksUntilSlash", wireType)
+			}
+			m.BlocksUntilSlash = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksUntilSlash |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposerBond = append(m.ProposerBond, types.Coin{})
+			if err := m.ProposerBond[len(m.ProposerBond)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposerDishonor", wireType)
+			}
+			m.ProposerDishonor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposerDishonor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProjectedSlash", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLiveness
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProjectedSlash = append(m.ProjectedSlash, types.Coin{})
+			if err := m.ProjectedSlash[len(m.ProjectedSlash)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLiveness(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLiveness
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipLiveness(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
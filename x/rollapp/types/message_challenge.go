@@ -0,0 +1,57 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+// MaxChallengeTextLength is the max length of the evidence of a challenge and of the response to it
+const MaxChallengeTextLength = 1024
+
+var (
+	_ sdk.Msg = new(MsgChallengeState)
+	_ sdk.Msg = new(MsgRespondChallenge)
+	_ sdk.Msg = new(MsgResolveChallenge)
+)
+
+func (m MsgChallengeState) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Challenger); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "challenger"))
+	}
+	if m.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id")
+	}
+	if m.Height == 0 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "height must be positive")
+	}
+	if len(m.StateRoot) != 32 {
+		return errorsmod.Wrap(ErrInvalidStateRoot, "state root must be 32 bytes")
+	}
+	if len(m.Evidence) > MaxChallengeTextLength {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "evidence too long: max: %d", MaxChallengeTextLength)
+	}
+	if m.BlockDescriptor != nil && m.BlockDescriptor.Height != m.Height {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "block descriptor height")
+	}
+	return nil
+}
+
+func (m MsgRespondChallenge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sequencer); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "sequencer"))
+	}
+	if len(m.Response) > MaxChallengeTextLength {
+		return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "response too long: max: %d", MaxChallengeTextLength)
+	}
+	return nil
+}
+
+func (m MsgResolveChallenge) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "authority"))
+	}
+	return nil
+}
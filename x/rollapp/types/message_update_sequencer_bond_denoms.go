@@ -0,0 +1,36 @@
+package types
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var _ sdk.Msg = new(MsgUpdateSequencerBondDenoms)
+
+func (m MsgUpdateSequencerBondDenoms) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrap(err, "owner"))
+	}
+	if m.RollappId == "" {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "rollapp id")
+	}
+	return ValidateSequencerBondDenoms(m.Denoms)
+}
+
+// ValidateSequencerBondDenoms checks the denoms are valid and distinct
+func ValidateSequencerBondDenoms(denoms []string) error {
+	seen := make(map[string]struct{}, len(denoms))
+	for _, d := range denoms {
+		if err := sdk.ValidateDenom(d); err != nil {
+			return errors.Join(gerrc.ErrInvalidArgument, errorsmod.Wrapf(err, "sequencer bond denom: %s", d))
+		}
+		if _, ok := seen[d]; ok {
+			return errorsmod.Wrapf(gerrc.ErrInvalidArgument, "duplicate sequencer bond denom: %s", d)
+		}
+		seen[d] = struct{}{}
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"math"
 
 	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -36,16 +37,20 @@ func (msg *MsgUpdateState) ValidateBasic() error {
 		return errorsmod.Wrapf(ErrInvalidNumBlocks, "numBlocks(%d) + startHeight(%d) exceeds max uint64", msg.NumBlocks, msg.StartHeight)
 	}
 
-	// check to see that update contains all BDs
-	if len(msg.BDs.BD) != int(msg.NumBlocks) { //nolint:gosec
-		return errorsmod.Wrapf(ErrInvalidNumBlocks, "number of blocks (%d) != number of block descriptors(%d)", msg.NumBlocks, len(msg.BDs.BD))
-	}
-
 	// check to see that startHeight is not zaro
 	if msg.StartHeight == 0 {
 		return errorsmod.Wrapf(ErrWrongBlockHeight, "StartHeight must be greater than zero")
 	}
 
+	if msg.Compressed() {
+		return msg.validateSampledBDs()
+	}
+
+	// check to see that update contains all BDs
+	if len(msg.BDs.BD) != int(msg.NumBlocks) { //nolint:gosec
+		return errorsmod.Wrapf(ErrInvalidNumBlocks, "number of blocks (%d) != number of block descriptors(%d)", msg.NumBlocks, len(msg.BDs.BD))
+	}
+
 	// check that the blocks are sequential by height
 	for bdIndex := uint64(0); bdIndex < msg.NumBlocks; bdIndex += 1 {
 
@@ -65,3 +70,33 @@ func (msg *MsgUpdateState) ValidateBasic() error {
 
 	return nil
 }
+
+// Compressed returns true if the update only holds a sample of the block descriptors, committed to by BDsRoot
+func (msg *MsgUpdateState) Compressed() bool {
+	return len(msg.BDsRoot) != 0
+}
+
+// validateSampledBDs checks that the sample of block descriptors of a compressed update is in order of height
+// and spans the heights of the update
+func (msg *MsgUpdateState) validateSampledBDs() error {
+	if len(msg.BDsRoot) != tmhash.Size {
+		return errorsmod.Wrapf(ErrInvalidBDsRoot, "must be %d bytes", tmhash.Size)
+	}
+	n := len(msg.BDs.BD)
+	if n == 0 || uint64(n) > msg.NumBlocks { //nolint:gosec
+		return errorsmod.Wrapf(ErrInvalidNumBlocks, "number of blocks (%d): number of sampled block descriptors (%d)", msg.NumBlocks, n)
+	}
+	if msg.BDs.BD[0].Height != msg.StartHeight || msg.BDs.BD[n-1].Height != msg.StartHeight+msg.NumBlocks-1 {
+		return errorsmod.Wrap(ErrInvalidBlockSequence, "sampled block descriptors must include the first and the last height")
+	}
+	for i, bd := range msg.BDs.BD {
+		if 0 < i && bd.Height <= msg.BDs.BD[i-1].Height {
+			return errorsmod.Wrap(ErrInvalidBlockSequence, "sampled block descriptors must be in strictly increasing order of height")
+		}
+		if len(bd.StateRoot) != 32 {
+			return errorsmod.Wrapf(ErrInvalidStateRoot, "StateRoot of block high (%d) must be 32 byte array. But received (%d) bytes",
+				bd.Height, len(bd.StateRoot))
+		}
+	}
+	return nil
+}
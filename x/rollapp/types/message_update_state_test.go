@@ -234,6 +234,69 @@ func TestMsgUpdateState_ValidateBasic(t *testing.T) {
 				}},
 			},
 			err: ErrInvalidStateRoot,
+		}, {
+			name: "valid compressed state",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 4,
+				NumBlocks:   100,
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 4, StateRoot: hash32, DrsVersion: 1},
+					{Height: 50, StateRoot: hash32, DrsVersion: 1},
+					{Height: 103, StateRoot: hash32, DrsVersion: 1},
+				}},
+				BDsRoot: hash32,
+			},
+		}, {
+			name: "compressed state with invalid root",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 4,
+				NumBlocks:   100,
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 4, StateRoot: hash32, DrsVersion: 1},
+					{Height: 103, StateRoot: hash32, DrsVersion: 1},
+				}},
+				BDsRoot: []byte("1"),
+			},
+			err: ErrInvalidBDsRoot,
+		}, {
+			name: "compressed state without the last height",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 4,
+				NumBlocks:   100,
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 4, StateRoot: hash32, DrsVersion: 1},
+					{Height: 50, StateRoot: hash32, DrsVersion: 1},
+				}},
+				BDsRoot: hash32,
+			},
+			err: ErrInvalidBlockSequence,
+		}, {
+			name: "compressed state not sorted",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 4,
+				NumBlocks:   100,
+				BDs: BlockDescriptors{BD: []BlockDescriptor{
+					{Height: 4, StateRoot: hash32, DrsVersion: 1},
+					{Height: 60, StateRoot: hash32, DrsVersion: 1},
+					{Height: 50, StateRoot: hash32, DrsVersion: 1},
+					{Height: 103, StateRoot: hash32, DrsVersion: 1},
+				}},
+				BDsRoot: hash32,
+			},
+			err: ErrInvalidBlockSequence,
+		}, {
+			name: "compressed state without block descriptors",
+			msg: MsgUpdateState{
+				Creator:     sample.AccAddress(),
+				StartHeight: 4,
+				NumBlocks:   100,
+				BDsRoot:     hash32,
+			},
+			err: ErrInvalidNumBlocks,
 		},
 	}
 	for _, tt := range tests {
@@ -15,6 +15,7 @@ import (
 var (
 	DefaultAppRegistrationFee         = commontypes.Dym(math.NewInt(1))
 	DefaultMinSequencerBondGlobalCoin = commontypes.Dym(math.NewInt(100))
+	DefaultChallengeBond              = commontypes.Dym(math.NewInt(10))
 )
 
 const (
@@ -24,6 +25,16 @@ const (
 
 	DefaultLivenessSlashBlocks   = uint64(7200) // 12 hours worth of blocks at 1 block per 6 seconds
 	DefaultLivenessSlashInterval = uint64(600)  // 1 hour worth of blocks at 1 block per 6 seconds
+
+	DefaultChallengeResponseBlocks   = uint64(14400) // 24 hours worth of blocks at 1 block per 6 seconds
+	DefaultChallengeResolutionBlocks = uint64(50400) // 3.5 days worth of blocks at 1 block per 6 seconds
+
+	DefaultMinDisputePeriodInBlocks = DefaultDisputePeriodInBlocks
+	DefaultMaxDisputePeriodInBlocks = uint64(100800) // 7 days worth of blocks at 1 block per 6 seconds
+
+	// DefaultStateInfoRetentionBlocks keeps all the states
+	DefaultStateInfoRetentionBlocks    = uint64(0)
+	DefaultMaxPrunedStateInfosPerBlock = uint64(100)
 )
 
 // NewParams creates a new Params instance
@@ -33,13 +44,27 @@ func NewParams(
 	livenessSlashInterval uint64,
 	appRegistrationFee sdk.Coin,
 	minSequencerBondGlobal sdk.Coin,
+	challengeBond sdk.Coin,
+	challengeResponseBlocks uint64,
+	challengeResolutionBlocks uint64,
+	minDisputePeriodInBlocks uint64,
+	maxDisputePeriodInBlocks uint64,
+	stateInfoRetentionBlocks uint64,
+	maxPrunedStateInfosPerBlock uint64,
 ) Params {
 	return Params{
-		DisputePeriodInBlocks:  disputePeriodInBlocks,
-		LivenessSlashBlocks:    livenessSlashBlocks,
-		LivenessSlashInterval:  livenessSlashInterval,
-		AppRegistrationFee:     appRegistrationFee,
-		MinSequencerBondGlobal: minSequencerBondGlobal,
+		DisputePeriodInBlocks:       disputePeriodInBlocks,
+		LivenessSlashBlocks:         livenessSlashBlocks,
+		LivenessSlashInterval:       livenessSlashInterval,
+		AppRegistrationFee:          appRegistrationFee,
+		MinSequencerBondGlobal:      minSequencerBondGlobal,
+		ChallengeBond:               challengeBond,
+		ChallengeResponseBlocks:     challengeResponseBlocks,
+		ChallengeResolutionBlocks:   challengeResolutionBlocks,
+		MinDisputePeriodInBlocks:    minDisputePeriodInBlocks,
+		MaxDisputePeriodInBlocks:    maxDisputePeriodInBlocks,
+		StateInfoRetentionBlocks:    stateInfoRetentionBlocks,
+		MaxPrunedStateInfosPerBlock: maxPrunedStateInfosPerBlock,
 	}
 }
 
@@ -51,6 +76,13 @@ func DefaultParams() Params {
 		DefaultLivenessSlashInterval,
 		DefaultAppRegistrationFee,
 		DefaultMinSequencerBondGlobalCoin,
+		DefaultChallengeBond,
+		DefaultChallengeResponseBlocks,
+		DefaultChallengeResolutionBlocks,
+		DefaultMinDisputePeriodInBlocks,
+		DefaultMaxDisputePeriodInBlocks,
+		DefaultStateInfoRetentionBlocks,
+		DefaultMaxPrunedStateInfosPerBlock,
 	)
 }
 
@@ -59,6 +91,22 @@ func (p Params) WithDisputePeriodInBlocks(x uint64) Params {
 	return p
 }
 
+func (p Params) WithDisputePeriodBounds(min, max uint64) Params {
+	p.MinDisputePeriodInBlocks = min
+	p.MaxDisputePeriodInBlocks = max
+	return p
+}
+
+// ClampDisputePeriod bounds a rollapp dispute period to the governance bounds
+func (p Params) ClampDisputePeriod(x uint64) uint64 {
+	return min(max(x, p.MinDisputePeriodInBlocks), p.MaxDisputePeriodInBlocks)
+}
+
+func (p Params) WithStateInfoRetentionBlocks(x uint64) Params {
+	p.StateInfoRetentionBlocks = x
+	return p
+}
+
 func (p Params) WithLivenessSlashBlocks(x uint64) Params {
 	p.LivenessSlashBlocks = x
 	return p
@@ -74,6 +122,12 @@ func (p Params) ValidateBasic() error {
 	if err := validateDisputePeriodInBlocks(p.DisputePeriodInBlocks); err != nil {
 		return errorsmod.Wrap(err, "dispute period")
 	}
+	if err := validateDisputePeriodInBlocks(p.MinDisputePeriodInBlocks); err != nil {
+		return errorsmod.Wrap(err, "min dispute period")
+	}
+	if p.MaxDisputePeriodInBlocks < p.MinDisputePeriodInBlocks {
+		return errors.New("max dispute period cannot be lower than min dispute period")
+	}
 
 	if err := validateLivenessSlashBlocks(p.LivenessSlashBlocks); err != nil {
 		return errorsmod.Wrap(err, "liveness slash blocks")
@@ -88,6 +142,23 @@ func (p Params) ValidateBasic() error {
 	if err := uparam.ValidateCoin(p.MinSequencerBondGlobal); err != nil {
 		return errorsmod.Wrap(err, "min sequencer bond")
 	}
+
+	if err := uparam.ValidateCoin(p.ChallengeBond); err != nil {
+		return errorsmod.Wrap(err, "challenge bond")
+	}
+	if err := uparam.ValidatePositiveUint64(p.ChallengeResponseBlocks); err != nil {
+		return errorsmod.Wrap(err, "challenge response blocks")
+	}
+	if err := uparam.ValidatePositiveUint64(p.ChallengeResolutionBlocks); err != nil {
+		return errorsmod.Wrap(err, "challenge resolution blocks")
+	}
+
+	if p.StateInfoRetentionBlocks != 0 && p.StateInfoRetentionBlocks < p.MaxDisputePeriodInBlocks {
+		return errors.New("state info retention cannot be shorter than max dispute period")
+	}
+	if err := uparam.ValidatePositiveUint64(p.MaxPrunedStateInfosPerBlock); err != nil {
+		return errorsmod.Wrap(err, "max pruned state infos per block")
+	}
 	return nil
 }
 
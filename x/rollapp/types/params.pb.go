@@ -40,6 +40,29 @@ type Params struct {
 	AppRegistrationFee types.Coin `protobuf:"bytes,7,opt,name=app_registration_fee,json=appRegistrationFee,proto3" json:"app_registration_fee" yaml:"app_registration_fee"`
 	// no rollapp can have a minimum less than this (in dym)
 	MinSequencerBondGlobal types.Coin `protobuf:"bytes,8,opt,name=min_sequencer_bond_global,json=minSequencerBondGlobal,proto3" json:"min_sequencer_bond_global" yaml:"min_sequencer_bond_global"`
+	// challenge_bond is the bond a challenger escrows when challenging a state.
+	// It is refunded if the challenge is upheld, and goes to the sequencer
+	// otherwise.
+	ChallengeBond types.Coin `protobuf:"bytes,9,opt,name=challenge_bond,json=challengeBond,proto3" json:"challenge_bond" yaml:"challenge_bond"`
+	// challenge_response_blocks is the number of hub blocks the sequencer has
+	// to respond to a challenge, before it is upheld
+	ChallengeResponseBlocks uint64 `protobuf:"varint,10,opt,name=challenge_response_blocks,json=challengeResponseBlocks,proto3" json:"challenge_response_blocks,omitempty" yaml:"challenge_response_blocks"`
+	// challenge_resolution_blocks is the number of hub blocks the authority has
+	// to adjudicate a responded challenge, before it is voided
+	ChallengeResolutionBlocks uint64 `protobuf:"varint,11,opt,name=challenge_resolution_blocks,json=challengeResolutionBlocks,proto3" json:"challenge_resolution_blocks,omitempty" yaml:"challenge_resolution_blocks"`
+	// min_dispute_period_in_blocks is the lower bound of the dispute period a
+	// rollapp can set for itself
+	MinDisputePeriodInBlocks uint64 `protobuf:"varint,12,opt,name=min_dispute_period_in_blocks,json=minDisputePeriodInBlocks,proto3" json:"min_dispute_period_in_blocks,omitempty" yaml:"min_dispute_period_in_blocks"`
+	// max_dispute_period_in_blocks is the upper bound of the dispute period a
+	// rollapp can set for itself
+	MaxDisputePeriodInBlocks uint64 `protobuf:"varint,13,opt,name=max_dispute_period_in_blocks,json=maxDisputePeriodInBlocks,proto3" json:"max_dispute_period_in_blocks,omitempty" yaml:"max_dispute_period_in_blocks"`
+	// state_info_retention_blocks is the number of hub blocks finalized states
+	// are kept for after their submission. The latest finalized state of a
+	// rollapp is always kept. Zero keeps all the states.
+	StateInfoRetentionBlocks uint64 `protobuf:"varint,14,opt,name=state_info_retention_blocks,json=stateInfoRetentionBlocks,proto3" json:"state_info_retention_blocks,omitempty" yaml:"state_info_retention_blocks"`
+	// max_pruned_state_infos_per_block is the maximum number of states pruned
+	// at the end of a block, across all the rollapps
+	MaxPrunedStateInfosPerBlock uint64 `protobuf:"varint,15,opt,name=max_pruned_state_infos_per_block,json=maxPrunedStateInfosPerBlock,proto3" json:"max_pruned_state_infos_per_block,omitempty" yaml:"max_pruned_state_infos_per_block"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -109,6 +132,55 @@ func (m *Params) GetMinSequencerBondGlobal() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetChallengeBond() types.Coin {
+	if m != nil {
+		return m.ChallengeBond
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetChallengeResponseBlocks() uint64 {
+	if m != nil {
+		return m.ChallengeResponseBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetChallengeResolutionBlocks() uint64 {
+	if m != nil {
+		return m.ChallengeResolutionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMinDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.MinDisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.MaxDisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetStateInfoRetentionBlocks() uint64 {
+	if m != nil {
+		return m.StateInfoRetentionBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPrunedStateInfosPerBlock() uint64 {
+	if m != nil {
+		return m.MaxPrunedStateInfosPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.rollapp.Params")
 }
@@ -118,36 +190,49 @@ func init() {
 }
 
 var fileDescriptor_75a44aa904ae1ba5 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xcd, 0x6e, 0xd3, 0x40,
-	0x14, 0x85, 0x6d, 0x6a, 0xd2, 0xc8, 0x6c, 0x22, 0xd3, 0x80, 0x5b, 0xd0, 0x38, 0x72, 0x37, 0x91,
-	0x90, 0x3c, 0x2a, 0x65, 0xd5, 0xa5, 0x91, 0x40, 0xcd, 0x02, 0x55, 0x2e, 0xab, 0x0a, 0x69, 0x34,
-	0xb6, 0x07, 0x77, 0xc4, 0x78, 0xee, 0xe0, 0x71, 0xa2, 0x86, 0x05, 0xcf, 0xc0, 0x92, 0x25, 0xe2,
-	0x69, 0xba, 0xec, 0x92, 0x95, 0x85, 0x92, 0x37, 0xc8, 0x13, 0xa0, 0xf8, 0x27, 0x2a, 0x28, 0x11,
-	0xbb, 0xb9, 0xe7, 0x9c, 0xf9, 0x8e, 0x74, 0x75, 0xed, 0x17, 0xe9, 0x3c, 0x67, 0x52, 0x73, 0x90,
-	0x37, 0xf3, 0x2f, 0x78, 0x33, 0xe0, 0x02, 0x84, 0xa0, 0x4a, 0x61, 0x45, 0x0b, 0x9a, 0xeb, 0x40,
-	0x15, 0x50, 0x82, 0x83, 0xee, 0x87, 0x83, 0xcd, 0x10, 0xb4, 0xe1, 0xa3, 0x83, 0x0c, 0x32, 0xa8,
-	0xa3, 0x78, 0xfd, 0x6a, 0x7e, 0x1d, 0xa1, 0x04, 0x74, 0x0e, 0x1a, 0xc7, 0x54, 0x33, 0x3c, 0x3b,
-	0x89, 0x59, 0x49, 0x4f, 0x70, 0x02, 0x5c, 0x36, 0xbe, 0xff, 0xd3, 0xb2, 0x7b, 0x17, 0x75, 0x8d,
-	0xf3, 0xc1, 0x76, 0x53, 0xae, 0xd5, 0xb4, 0x64, 0x44, 0xb1, 0x82, 0x43, 0x4a, 0xb8, 0x24, 0xb1,
-	0x80, 0xe4, 0x93, 0x76, 0xcd, 0x91, 0x39, 0xb6, 0xc2, 0xe3, 0x55, 0xe5, 0x79, 0x73, 0x9a, 0x8b,
-	0x33, 0x7f, 0x57, 0xd2, 0x8f, 0x86, 0xad, 0x75, 0x51, 0x3b, 0xe7, 0x32, 0xac, 0x75, 0xe7, 0xbd,
-	0x3d, 0x14, 0x7c, 0xc6, 0x24, 0xd3, 0x9a, 0x68, 0x41, 0xf5, 0x75, 0x87, 0xb6, 0x6a, 0xf4, 0x68,
-	0x55, 0x79, 0xcf, 0x1b, 0xf4, 0xd6, 0x98, 0x1f, 0x3d, 0xee, 0xf4, 0xcb, 0xb5, 0xdc, 0x52, 0xaf,
-	0xec, 0xa7, 0xff, 0xc4, 0xb9, 0x2c, 0x59, 0x31, 0xa3, 0xc2, 0x7d, 0x58, 0x73, 0xfd, 0x55, 0xe5,
-	0xa1, 0xad, 0xdc, 0x2e, 0xe8, 0x47, 0xc3, 0xbf, 0xc8, 0xe7, 0xad, 0xee, 0x28, 0xfb, 0x80, 0x2a,
-	0x45, 0x0a, 0x96, 0x71, 0x5d, 0x16, 0xb4, 0xe4, 0x20, 0xc9, 0x47, 0xc6, 0xdc, 0xfd, 0x91, 0x39,
-	0x7e, 0xf4, 0xf2, 0x30, 0x68, 0x36, 0x1b, 0xac, 0x37, 0x1b, 0xb4, 0x9b, 0x0d, 0x5e, 0x03, 0x97,
-	0xe1, 0xf1, 0x6d, 0xe5, 0x19, 0xab, 0xca, 0x7b, 0xd6, 0xf4, 0x6e, 0x83, 0xf8, 0x91, 0x43, 0x95,
-	0x8a, 0xee, 0xa9, 0x6f, 0x18, 0x73, 0xbe, 0xda, 0x87, 0x39, 0x97, 0x44, 0xb3, 0xcf, 0x53, 0x26,
-	0x13, 0x56, 0x90, 0x18, 0x64, 0x4a, 0x32, 0x01, 0x31, 0x15, 0x6e, 0xff, 0x7f, 0xb5, 0xe3, 0xb6,
-	0x76, 0xd4, 0xd4, 0xee, 0x24, 0xf9, 0xd1, 0x93, 0x9c, 0xcb, 0xcb, 0xce, 0x0a, 0x41, 0xa6, 0x6f,
-	0x6b, 0xe3, 0xcc, 0xfa, 0xfe, 0xc3, 0x33, 0x26, 0x56, 0xff, 0xc1, 0x60, 0x6f, 0x62, 0xf5, 0xf7,
-	0x06, 0xd6, 0xc4, 0xea, 0xf7, 0x06, 0xfb, 0xe1, 0xbb, 0xdb, 0x05, 0x32, 0xef, 0x16, 0xc8, 0xfc,
-	0xbd, 0x40, 0xe6, 0xb7, 0x25, 0x32, 0xee, 0x96, 0xc8, 0xf8, 0xb5, 0x44, 0xc6, 0xd5, 0xab, 0x8c,
-	0x97, 0xd7, 0xd3, 0x38, 0x48, 0x20, 0xc7, 0x3b, 0x8e, 0x79, 0x76, 0x8a, 0x6f, 0x36, 0x17, 0x5d,
-	0xce, 0x15, 0xd3, 0x71, 0xaf, 0xbe, 0xbd, 0xd3, 0x3f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x96, 0xbb,
-	0xec, 0xbf, 0x00, 0x03, 0x00, 0x00,
+	// 662 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xcd, 0x4e, 0xdb, 0x4e,
+	0x14, 0xc5, 0x93, 0x3f, 0xfe, 0x43, 0x18, 0x0a, 0x45, 0x2e, 0x29, 0x0e, 0xa1, 0x76, 0x34, 0x54,
+	0x05, 0x09, 0xc9, 0x16, 0xa5, 0x2b, 0x96, 0x69, 0xd5, 0x0a, 0x16, 0x55, 0x64, 0xba, 0x42, 0x95,
+	0xdc, 0x71, 0x32, 0x31, 0xa3, 0xda, 0x33, 0x53, 0x8f, 0x13, 0x25, 0x5d, 0xf4, 0x19, 0xba, 0xec,
+	0xb2, 0x8f, 0xc3, 0x92, 0x65, 0x57, 0x56, 0x05, 0x6f, 0xe0, 0x65, 0x57, 0x95, 0xc7, 0x76, 0x3e,
+	0x68, 0x0c, 0x3b, 0xfb, 0x9e, 0x73, 0xcf, 0xcf, 0xbe, 0xf3, 0x01, 0x0e, 0x7b, 0xe3, 0x00, 0x53,
+	0x41, 0x18, 0x1d, 0x8d, 0xbf, 0x5a, 0x93, 0x17, 0x2b, 0x64, 0xbe, 0x8f, 0x38, 0xb7, 0x38, 0x0a,
+	0x51, 0x20, 0x4c, 0x1e, 0xb2, 0x88, 0xa9, 0xfa, 0xac, 0xd9, 0x9c, 0xbc, 0x98, 0xb9, 0x79, 0x67,
+	0xcb, 0x63, 0x1e, 0x93, 0x56, 0x2b, 0x7d, 0xca, 0xba, 0x76, 0xf4, 0x2e, 0x13, 0x01, 0x13, 0x96,
+	0x8b, 0x04, 0xb6, 0x86, 0x47, 0x2e, 0x8e, 0xd0, 0x91, 0xd5, 0x65, 0x84, 0x66, 0x3a, 0xfc, 0xb3,
+	0x0a, 0x96, 0x3b, 0x12, 0xa3, 0x7e, 0x04, 0x5a, 0x8f, 0x08, 0x3e, 0x88, 0xb0, 0xc3, 0x71, 0x48,
+	0x58, 0xcf, 0x21, 0xd4, 0x71, 0x7d, 0xd6, 0xfd, 0x2c, 0xb4, 0x6a, 0xab, 0x7a, 0xa0, 0xb4, 0xf7,
+	0x92, 0xd8, 0x30, 0xc6, 0x28, 0xf0, 0x4f, 0x60, 0x99, 0x13, 0xda, 0xf5, 0x5c, 0xea, 0x48, 0xe5,
+	0x94, 0xb6, 0x65, 0x5d, 0xfd, 0x00, 0xea, 0x3e, 0x19, 0x62, 0x8a, 0x85, 0x70, 0x84, 0x8f, 0xc4,
+	0x65, 0x11, 0xad, 0xc8, 0xe8, 0x56, 0x12, 0x1b, 0xbb, 0x59, 0xf4, 0x42, 0x1b, 0xb4, 0x9f, 0x14,
+	0xf5, 0xf3, 0xb4, 0x9c, 0xa7, 0x5e, 0x80, 0xed, 0x3b, 0x76, 0x42, 0x23, 0x1c, 0x0e, 0x91, 0xaf,
+	0xfd, 0x2f, 0x73, 0x61, 0x12, 0x1b, 0xfa, 0xc2, 0xdc, 0xc2, 0x08, 0xed, 0xfa, 0x5c, 0xf2, 0x69,
+	0x5e, 0x57, 0x39, 0xd8, 0x42, 0x9c, 0x3b, 0x21, 0xf6, 0x88, 0x88, 0x42, 0x14, 0x11, 0x46, 0x9d,
+	0x3e, 0xc6, 0xda, 0x4a, 0xab, 0x7a, 0xb0, 0xf6, 0xb2, 0x61, 0x66, 0x93, 0x35, 0xd3, 0xc9, 0x9a,
+	0xf9, 0x64, 0xcd, 0xd7, 0x8c, 0xd0, 0xf6, 0xde, 0x55, 0x6c, 0x54, 0x92, 0xd8, 0x68, 0x66, 0xdc,
+	0x45, 0x21, 0xd0, 0x56, 0x11, 0xe7, 0xf6, 0x4c, 0xf5, 0x2d, 0xc6, 0xea, 0x37, 0xd0, 0x08, 0x08,
+	0x75, 0x04, 0xfe, 0x32, 0xc0, 0xb4, 0x8b, 0x43, 0xc7, 0x65, 0xb4, 0xe7, 0x78, 0x3e, 0x73, 0x91,
+	0xaf, 0xd5, 0x1e, 0xc2, 0x1e, 0xe4, 0xd8, 0x56, 0x86, 0x2d, 0x4d, 0x82, 0xf6, 0xd3, 0x80, 0xd0,
+	0xf3, 0x42, 0x6a, 0x33, 0xda, 0x7b, 0x27, 0x05, 0xd5, 0x01, 0x1b, 0xdd, 0x4b, 0xe4, 0xfb, 0x98,
+	0x7a, 0x58, 0x76, 0x68, 0xab, 0x0f, 0x41, 0x9f, 0xe5, 0xd0, 0x7a, 0x06, 0x9d, 0x6f, 0x87, 0xf6,
+	0xfa, 0xa4, 0x90, 0x62, 0xd4, 0x4f, 0xa0, 0x31, 0x75, 0x84, 0x58, 0x70, 0x46, 0x05, 0x2e, 0x36,
+	0x02, 0x90, 0x0b, 0xf6, 0x7c, 0xfa, 0x07, 0xa5, 0x56, 0x68, 0x6f, 0x4f, 0x34, 0x3b, 0x97, 0xf2,
+	0x0d, 0xd1, 0x07, 0xcd, 0xb9, 0x36, 0xe6, 0x0f, 0xe4, 0xcc, 0x73, 0xc6, 0x9a, 0x64, 0xbc, 0x48,
+	0x62, 0x03, 0x2e, 0x60, 0xcc, 0x9b, 0xa1, 0xdd, 0x98, 0xa5, 0xe4, 0x62, 0xce, 0xf1, 0xc0, 0x6e,
+	0x3a, 0xe0, 0xd2, 0x03, 0xf3, 0x48, 0x82, 0xf6, 0x93, 0xd8, 0xd8, 0x9b, 0x2e, 0x47, 0xf9, 0xa1,
+	0xd1, 0x02, 0x42, 0xdf, 0x2c, 0x3c, 0x37, 0x29, 0x08, 0x8d, 0xca, 0x41, 0xeb, 0xff, 0x80, 0xd0,
+	0xe8, 0x5e, 0x10, 0x1a, 0x2d, 0x06, 0x61, 0xd0, 0x14, 0x11, 0x8a, 0xb0, 0x43, 0x68, 0x9f, 0x39,
+	0x21, 0x8e, 0x30, 0x9d, 0x9d, 0xdc, 0xc6, 0xdd, 0xc9, 0xdd, 0x63, 0x86, 0xb6, 0x26, 0xd5, 0x53,
+	0xda, 0x67, 0x76, 0xa1, 0xe5, 0x98, 0x10, 0xb4, 0xd2, 0x2f, 0xe4, 0xe1, 0x80, 0xe2, 0x9e, 0x33,
+	0x0d, 0x11, 0xe9, 0xc7, 0x66, 0xfd, 0xda, 0x63, 0xc9, 0x3a, 0x4c, 0x62, 0x63, 0x7f, 0xfa, 0x4f,
+	0xf7, 0x75, 0x40, 0xbb, 0x19, 0xa0, 0x51, 0x47, 0x3a, 0xce, 0x0b, 0xb2, 0xe8, 0xe0, 0x50, 0x42,
+	0x4f, 0x94, 0x1f, 0x3f, 0x8d, 0xca, 0x99, 0x52, 0xfb, 0x6f, 0x73, 0xe9, 0x4c, 0xa9, 0x2d, 0x6d,
+	0x2a, 0x67, 0x4a, 0x6d, 0x79, 0x73, 0xa5, 0xfd, 0xfe, 0xea, 0x46, 0xaf, 0x5e, 0xdf, 0xe8, 0xd5,
+	0xdf, 0x37, 0x7a, 0xf5, 0xfb, 0xad, 0x5e, 0xb9, 0xbe, 0xd5, 0x2b, 0xbf, 0x6e, 0xf5, 0xca, 0xc5,
+	0x2b, 0x8f, 0x44, 0x97, 0x03, 0xd7, 0xec, 0xb2, 0xc0, 0x2a, 0xb9, 0xa4, 0x87, 0xc7, 0xd6, 0x68,
+	0x72, 0x53, 0x47, 0x63, 0x8e, 0x85, 0xbb, 0x2c, 0xef, 0xd4, 0xe3, 0xbf, 0x03, 0x00, 0x9b, 0x2b,
+	0xfd, 0x40, 0xd8, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -170,6 +255,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxPrunedStateInfosPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPrunedStateInfosPerBlock))
+		i--
+		dAtA[i] = 0x78
+	}
+	if m.StateInfoRetentionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.StateInfoRetentionBlocks))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxDisputePeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxDisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MinDisputePeriodInBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinDisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ChallengeResolutionBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeResolutionBlocks))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ChallengeResponseBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ChallengeResponseBlocks))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.ChallengeBond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
 	{
 		size, err := m.MinSequencerBondGlobal.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -238,6 +363,26 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovParams(uint64(l))
 	l = m.MinSequencerBondGlobal.Size()
 	n += 1 + l + sovParams(uint64(l))
+	l = m.ChallengeBond.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.ChallengeResponseBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeResponseBlocks))
+	}
+	if m.ChallengeResolutionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.ChallengeResolutionBlocks))
+	}
+	if m.MinDisputePeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MinDisputePeriodInBlocks))
+	}
+	if m.MaxDisputePeriodInBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MaxDisputePeriodInBlocks))
+	}
+	if m.StateInfoRetentionBlocks != 0 {
+		n += 1 + sovParams(uint64(m.StateInfoRetentionBlocks))
+	}
+	if m.MaxPrunedStateInfosPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxPrunedStateInfosPerBlock))
+	}
 	return n
 }
 
@@ -399,6 +544,153 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeBond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ChallengeBond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeResponseBlocks", wireType)
+			}
+			m.ChallengeResponseBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeResponseBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengeResolutionBlocks", wireType)
+			}
+			m.ChallengeResolutionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChallengeResolutionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDisputePeriodInBlocks", wireType)
+			}
+			m.MinDisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDisputePeriodInBlocks", wireType)
+			}
+			m.MaxDisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateInfoRetentionBlocks", wireType)
+			}
+			m.StateInfoRetentionBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateInfoRetentionBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPrunedStateInfosPerBlock", wireType)
+			}
+			m.MaxPrunedStateInfosPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPrunedStateInfosPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -93,6 +93,10 @@ func (r Rollapp) ValidateBasic() error {
 		return errorsmod.Wrap(err, "min sequencer bond")
 	}
 
+	if err = ValidateSequencerBondDenoms(r.SequencerBondDenoms); err != nil {
+		return err
+	}
+
 	if err = validateInitialSequencer(r.InitialSequencer); err != nil {
 		return errorsmod.Wrap(ErrInvalidInitialSequencer, err.Error())
 	}
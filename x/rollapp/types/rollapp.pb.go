@@ -152,6 +152,13 @@ type Rollapp struct {
 	LivenessCountdownStartHeight int64 `protobuf:"varint,18,opt,name=liveness_countdown_start_height,json=livenessCountdownStartHeight,proto3" json:"liveness_countdown_start_height,omitempty"`
 	// Revisions is a list of all the rollapp revisions.
 	Revisions []Revision `protobuf:"bytes,19,rep,name=revisions,proto3" json:"revisions"`
+	// dispute_period_in_blocks overrides the global dispute period for the
+	// states of the rollapp. 0 means the global dispute period applies.
+	DisputePeriodInBlocks uint64 `protobuf:"varint,21,opt,name=dispute_period_in_blocks,json=disputePeriodInBlocks,proto3" json:"dispute_period_in_blocks,omitempty"`
+	// sequencer_bond_denoms are the denoms, other than DYM, the sequencers of the
+	// rollapp can bond in. Only the ones with a weight in the sequencer params
+	// are effective.
+	SequencerBondDenoms []string `protobuf:"bytes,22,rep,name=sequencer_bond_denoms,json=sequencerBondDenoms,proto3" json:"sequencer_bond_denoms,omitempty"`
 }
 
 func (m *Rollapp) Reset()         { *m = Rollapp{} }
@@ -285,6 +292,20 @@ func (m *Rollapp) GetRevisions() []Revision {
 	return nil
 }
 
+func (m *Rollapp) GetDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodInBlocks
+	}
+	return 0
+}
+
+func (m *Rollapp) GetSequencerBondDenoms() []string {
+	if m != nil {
+		return m.SequencerBondDenoms
+	}
+	return nil
+}
+
 // Revision is a representation of the rollapp revision.
 type Revision struct {
 	// Number is the revision number of the rollapp. Always start with 0 revision.
@@ -434,62 +455,65 @@ func init() {
 }
 
 var fileDescriptor_d4ef2bec3aea5528 = []byte{
-	// 873 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xc1, 0x6e, 0xdb, 0x46,
-	0x10, 0x15, 0x2d, 0xc6, 0xa2, 0x47, 0x8a, 0xcd, 0xac, 0x9c, 0x82, 0x16, 0x12, 0x49, 0xd5, 0x89,
-	0x40, 0x12, 0x12, 0xb6, 0x73, 0xea, 0xad, 0x0a, 0xdc, 0xc4, 0x6e, 0x54, 0x14, 0x94, 0x93, 0x02,
-	0x39, 0x94, 0xa0, 0xc4, 0x15, 0xb5, 0x28, 0xb9, 0xcb, 0x72, 0x57, 0x8a, 0x95, 0xaf, 0xc8, 0xa9,
-	0x1f, 0xd1, 0x2f, 0xc9, 0x31, 0xe8, 0xa9, 0x27, 0xa7, 0xb0, 0xff, 0xa0, 0x5f, 0x50, 0x70, 0xb9,
-	0x94, 0x94, 0x3a, 0xa9, 0x82, 0x9e, 0xa8, 0x99, 0x37, 0xf3, 0x76, 0x66, 0x76, 0xde, 0x0a, 0x1e,
-	0x86, 0x8b, 0x04, 0x53, 0x4e, 0x18, 0xbd, 0x58, 0xbc, 0x71, 0x97, 0x86, 0x9b, 0xb1, 0x38, 0x0e,
-	0xd2, 0xb4, 0xfc, 0x3a, 0x69, 0xc6, 0x04, 0x43, 0xed, 0xf5, 0x68, 0x67, 0x69, 0x38, 0x2a, 0xaa,
-	0xb5, 0x1f, 0xb1, 0x88, 0xc9, 0x50, 0x37, 0xff, 0x55, 0x64, 0xb5, 0x3a, 0x11, 0x63, 0x51, 0x8c,
-	0x5d, 0x69, 0x8d, 0x66, 0x13, 0x57, 0x90, 0x04, 0x73, 0x11, 0x24, 0x8a, 0xb6, 0xe5, 0x6e, 0x28,
-	0x82, 0x8b, 0x40, 0x60, 0x9f, 0xd0, 0x49, 0xc9, 0xf8, 0x68, 0x43, 0x42, 0x82, 0x45, 0x10, 0x06,
-	0x22, 0x50, 0xe1, 0xed, 0x31, 0xe3, 0x09, 0xe3, 0xee, 0x28, 0xe0, 0xd8, 0x9d, 0x1f, 0x8e, 0xb0,
-	0x08, 0x0e, 0xdd, 0x31, 0x23, 0x54, 0xe1, 0x87, 0x1b, 0xe8, 0x22, 0x4c, 0x31, 0x27, 0x7c, 0xad,
-	0x82, 0xde, 0x0b, 0x68, 0x7a, 0x05, 0xfa, 0xb4, 0x00, 0x87, 0x79, 0x8d, 0xe8, 0x08, 0xee, 0x8a,
-	0x2c, 0xa0, 0x7c, 0x82, 0x33, 0x3f, 0xcd, 0x18, 0x9b, 0xf8, 0x53, 0x4c, 0xa2, 0xa9, 0xb0, 0xaa,
-	0x5d, 0xcd, 0xd6, 0xbd, 0x66, 0x09, 0xfe, 0x98, 0x63, 0xcf, 0x24, 0x74, 0xa6, 0x1b, 0x9a, 0xb9,
-	0x75, 0xa6, 0x1b, 0x5b, 0x66, 0xb5, 0x77, 0x59, 0x83, 0x9a, 0xe2, 0x45, 0xf7, 0x01, 0x54, 0x01,
-	0x3e, 0x09, 0x2d, 0xad, 0xab, 0xd9, 0x3b, 0xde, 0x8e, 0xf2, 0x9c, 0x86, 0x68, 0x1f, 0x6e, 0xb1,
-	0xd7, 0x14, 0x67, 0xd6, 0x96, 0x44, 0x0a, 0x03, 0xfd, 0x0c, 0xb7, 0xcb, 0x6a, 0xe5, 0xd4, 0xac,
-	0x5a, 0x57, 0xb3, 0xeb, 0x47, 0xc7, 0xce, 0x7f, 0xdf, 0x9c, 0xf3, 0x89, 0x66, 0xfa, 0xfa, 0xbb,
-	0xcb, 0x4e, 0xc5, 0x6b, 0x44, 0xeb, 0x0d, 0xde, 0x07, 0x18, 0x4f, 0x03, 0x4a, 0x71, 0x9c, 0x17,
-	0x65, 0x14, 0x45, 0x29, 0xcf, 0x69, 0x88, 0xbe, 0x07, 0xa3, 0x9c, 0xbd, 0x55, 0x97, 0x27, 0xbb,
-	0x5f, 0x78, 0xf2, 0x40, 0xa5, 0x79, 0x4b, 0x02, 0x74, 0x0e, 0x8d, 0xf5, 0xc9, 0x5b, 0x0d, 0x49,
-	0xf8, 0x60, 0x13, 0xa1, 0xea, 0xe1, 0x94, 0x4e, 0x98, 0x6a, 0xa1, 0x1e, 0xad, 0x5c, 0xe8, 0x01,
-	0xdc, 0x21, 0x94, 0x08, 0x12, 0xc4, 0x3e, 0xc7, 0xbf, 0xce, 0x30, 0x1d, 0xe3, 0xcc, 0xba, 0x2d,
-	0x1b, 0x31, 0x15, 0x30, 0x2c, 0xfd, 0xe8, 0x37, 0x0d, 0x50, 0x42, 0xe8, 0x2a, 0xd2, 0x1f, 0x31,
-	0x1a, 0x5a, 0xfb, 0xdd, 0xaa, 0x5d, 0x3f, 0x3a, 0x70, 0x8a, 0xbd, 0x72, 0xf2, 0xbd, 0x72, 0xd4,
-	0x5e, 0x39, 0x4f, 0x18, 0xa1, 0xfd, 0x41, 0x7e, 0xee, 0xdf, 0x97, 0x9d, 0x83, 0x45, 0x90, 0xc4,
-	0xdf, 0xf4, 0x6e, 0x52, 0xf4, 0x7e, 0xff, 0xd0, 0xb1, 0x23, 0x22, 0xa6, 0xb3, 0x91, 0x33, 0x66,
-	0x89, 0xab, 0x36, 0xb4, 0xf8, 0x3c, 0xe2, 0xe1, 0x2f, 0xae, 0x58, 0xa4, 0x98, 0x4b, 0x36, 0xee,
-	0x99, 0x09, 0xa1, 0xcb, 0xa2, 0xfa, 0x8c, 0x86, 0xe8, 0x29, 0xd4, 0xe6, 0x89, 0x9f, 0xc7, 0x58,
-	0xbb, 0x5d, 0xcd, 0xde, 0x3d, 0x72, 0xbe, 0x70, 0xce, 0xce, 0xcb, 0xc1, 0xf9, 0x22, 0xc5, 0xde,
-	0xf6, 0x3c, 0xc9, 0xbf, 0xa8, 0x05, 0x46, 0x1c, 0xcc, 0xe8, 0x78, 0x8a, 0x43, 0x6b, 0xaf, 0xab,
-	0xd9, 0x86, 0xb7, 0xb4, 0xd1, 0x33, 0xd8, 0x4b, 0x33, 0xec, 0x17, 0xb6, 0x9f, 0xab, 0xd6, 0x32,
-	0xe5, 0x1d, 0xb4, 0x9c, 0x42, 0xd2, 0x4e, 0x29, 0x69, 0xe7, 0xbc, 0x94, 0x74, 0x5f, 0x7f, 0xfb,
-	0xa1, 0xa3, 0x79, 0xb7, 0xd3, 0x0c, 0x3f, 0x97, 0x79, 0x39, 0x92, 0xeb, 0x22, 0x26, 0xf3, 0xfc,
-	0x16, 0xb8, 0x8f, 0xe7, 0x98, 0x8a, 0x52, 0x17, 0x77, 0xba, 0x9a, 0x5d, 0xf5, 0x9a, 0x25, 0x78,
-	0x92, 0x63, 0x85, 0x2e, 0xd0, 0x09, 0x74, 0x96, 0x39, 0x63, 0x36, 0xa3, 0x22, 0x64, 0xaf, 0x69,
-	0xbe, 0xd5, 0xd9, 0x32, 0x1b, 0xc9, 0xec, 0x7b, 0x65, 0xd8, 0x93, 0x32, 0x6a, 0x98, 0x07, 0x29,
-	0x9a, 0xe7, 0xb0, 0x93, 0xe1, 0x39, 0xc9, 0x67, 0xc1, 0xad, 0xa6, 0xbc, 0x38, 0x7b, 0xe3, 0xac,
-	0x54, 0x82, 0xda, 0x9f, 0x15, 0x41, 0xef, 0x21, 0x6c, 0x17, 0x03, 0x44, 0x7b, 0x50, 0x7f, 0x41,
-	0x79, 0x8a, 0xc7, 0x64, 0x42, 0x70, 0x68, 0x56, 0x50, 0x0d, 0xaa, 0x27, 0x2f, 0x07, 0xa6, 0x86,
-	0x0c, 0xd0, 0x7f, 0xfa, 0x76, 0x38, 0x90, 0xa2, 0xae, 0x9a, 0xb5, 0x33, 0xdd, 0xd8, 0x31, 0xe1,
-	0x4c, 0x37, 0xc0, 0xac, 0xf7, 0x4e, 0xc0, 0x28, 0xc9, 0xd1, 0x57, 0xb0, 0x4d, 0x67, 0xc9, 0x08,
-	0x67, 0x56, 0x53, 0xbe, 0x0e, 0xca, 0x42, 0x5f, 0x43, 0xe3, 0xa3, 0x2e, 0xf7, 0x25, 0x5a, 0xe7,
-	0xab, 0xa6, 0x7a, 0x7f, 0x6c, 0xc1, 0xae, 0xba, 0xd0, 0xe1, 0x2c, 0x49, 0x82, 0x6c, 0x81, 0xee,
-	0xc1, 0xea, 0x71, 0xb8, 0xf9, 0x5a, 0xbc, 0x02, 0x33, 0x0e, 0x04, 0xe6, 0x42, 0xca, 0xf8, 0x94,
-	0x86, 0xf8, 0x42, 0x3e, 0x1c, 0xf5, 0xcd, 0x8b, 0xa3, 0x32, 0x26, 0x4c, 0x66, 0x79, 0x37, 0x78,
-	0x50, 0x0c, 0x07, 0x85, 0xef, 0x3b, 0x42, 0x83, 0x98, 0xbc, 0xc1, 0xe1, 0xda, 0x21, 0xd5, 0xff,
-	0x75, 0xc8, 0xe7, 0x09, 0x51, 0x0f, 0x1a, 0x05, 0x58, 0x8c, 0xc2, 0xd2, 0xe5, 0x74, 0x3e, 0xf2,
-	0xa1, 0xc7, 0x70, 0xf7, 0x5f, 0x04, 0x2a, 0xf8, 0x96, 0x0c, 0xfe, 0x34, 0xd8, 0xff, 0xe1, 0xdd,
-	0x55, 0x5b, 0x7b, 0x7f, 0xd5, 0xd6, 0xfe, 0xba, 0x6a, 0x6b, 0x6f, 0xaf, 0xdb, 0x95, 0xf7, 0xd7,
-	0xed, 0xca, 0x9f, 0xd7, 0xed, 0xca, 0xab, 0xc7, 0x6b, 0x4a, 0xfd, 0xcc, 0x7f, 0xc5, 0xfc, 0xd8,
-	0xbd, 0x58, 0xfe, 0x61, 0x48, 0xed, 0x8e, 0xb6, 0xa5, 0x3a, 0x8e, 0xff, 0x09, 0x00, 0x00, 0xff,
-	0xff, 0x89, 0x4e, 0x0e, 0xd0, 0x64, 0x07, 0x00, 0x00,
+	// 928 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6e, 0xdb, 0x46,
+	0x14, 0x16, 0x2d, 0xc6, 0xa2, 0x46, 0xb2, 0xcd, 0x8c, 0xec, 0x80, 0x16, 0x12, 0x49, 0xd5, 0x8a,
+	0x40, 0x12, 0x12, 0xb6, 0x03, 0x14, 0xe8, 0xae, 0x4a, 0xdd, 0x44, 0x6a, 0x54, 0x04, 0x94, 0x93,
+	0x02, 0x59, 0x94, 0xa0, 0xc8, 0x11, 0x35, 0x08, 0x39, 0xc3, 0x72, 0x46, 0x8a, 0x95, 0x53, 0x64,
+	0xd5, 0x43, 0xf4, 0x24, 0x01, 0xba, 0x09, 0xba, 0xea, 0x2a, 0x29, 0xec, 0x1b, 0xf4, 0x04, 0x05,
+	0x87, 0x43, 0x49, 0xae, 0x93, 0xca, 0xe8, 0x8a, 0x7a, 0xef, 0x7b, 0xef, 0x9b, 0xf7, 0x2f, 0xf0,
+	0x20, 0x58, 0xc4, 0x88, 0x30, 0x4c, 0xc9, 0xf9, 0xe2, 0xad, 0xbd, 0x14, 0xec, 0x94, 0x46, 0x91,
+	0x97, 0x24, 0xc5, 0xd7, 0x4a, 0x52, 0xca, 0x29, 0x6c, 0xad, 0x5b, 0x5b, 0x4b, 0xc1, 0x92, 0x56,
+	0xcd, 0xfd, 0x90, 0x86, 0x54, 0x98, 0xda, 0xd9, 0xaf, 0xdc, 0xab, 0xd9, 0x0e, 0x29, 0x0d, 0x23,
+	0x64, 0x0b, 0x69, 0x3c, 0x9b, 0xd8, 0x1c, 0xc7, 0x88, 0x71, 0x2f, 0x96, 0xb4, 0x4d, 0x7b, 0x43,
+	0x10, 0x8c, 0x7b, 0x1c, 0xb9, 0x98, 0x4c, 0x0a, 0xc6, 0x87, 0x1b, 0x1c, 0x62, 0xc4, 0xbd, 0xc0,
+	0xe3, 0x9e, 0x34, 0x6f, 0xf9, 0x94, 0xc5, 0x94, 0xd9, 0x63, 0x8f, 0x21, 0x7b, 0x7e, 0x34, 0x46,
+	0xdc, 0x3b, 0xb2, 0x7d, 0x8a, 0x89, 0xc4, 0x8f, 0x36, 0xd0, 0x85, 0x88, 0x20, 0x86, 0xd9, 0x5a,
+	0x04, 0xdd, 0x17, 0xa0, 0xe1, 0xe4, 0xe8, 0x93, 0x1c, 0x1c, 0x65, 0x31, 0xc2, 0x63, 0x70, 0xc0,
+	0x53, 0x8f, 0xb0, 0x09, 0x4a, 0xdd, 0x24, 0xa5, 0x74, 0xe2, 0x4e, 0x11, 0x0e, 0xa7, 0xdc, 0x28,
+	0x77, 0x14, 0x53, 0x75, 0x1a, 0x05, 0xf8, 0x3c, 0xc3, 0x9e, 0x0a, 0x68, 0xa0, 0x6a, 0x8a, 0xbe,
+	0x35, 0x50, 0xb5, 0x2d, 0xbd, 0xdc, 0xfd, 0x5d, 0x03, 0x15, 0xc9, 0x0b, 0xef, 0x01, 0x20, 0x03,
+	0x70, 0x71, 0x60, 0x28, 0x1d, 0xc5, 0xac, 0x3a, 0x55, 0xa9, 0xe9, 0x07, 0x70, 0x1f, 0xdc, 0xa2,
+	0x6f, 0x08, 0x4a, 0x8d, 0x2d, 0x81, 0xe4, 0x02, 0xfc, 0x19, 0xec, 0x14, 0xd1, 0x8a, 0xaa, 0x19,
+	0x95, 0x8e, 0x62, 0xd6, 0x8e, 0x4f, 0xac, 0xff, 0xee, 0x9c, 0xf5, 0x99, 0x64, 0x7a, 0xea, 0xfb,
+	0x8f, 0xed, 0x92, 0x53, 0x0f, 0xd7, 0x13, 0xbc, 0x07, 0x80, 0x3f, 0xf5, 0x08, 0x41, 0x51, 0x16,
+	0x94, 0x96, 0x07, 0x25, 0x35, 0xfd, 0x00, 0xfe, 0x00, 0xb4, 0xa2, 0xf6, 0x46, 0x4d, 0xbc, 0x6c,
+	0xdf, 0xf0, 0xe5, 0xa1, 0x74, 0x73, 0x96, 0x04, 0xf0, 0x0c, 0xd4, 0xd7, 0x2b, 0x6f, 0xd4, 0x05,
+	0xe1, 0xfd, 0x4d, 0x84, 0x32, 0x87, 0x3e, 0x99, 0x50, 0x99, 0x42, 0x2d, 0x5c, 0xa9, 0xe0, 0x7d,
+	0x70, 0x1b, 0x13, 0xcc, 0xb1, 0x17, 0xb9, 0x0c, 0xfd, 0x32, 0x43, 0xc4, 0x47, 0xa9, 0xb1, 0x23,
+	0x12, 0xd1, 0x25, 0x30, 0x2a, 0xf4, 0xf0, 0x57, 0x05, 0xc0, 0x18, 0x93, 0x95, 0xa5, 0x3b, 0xa6,
+	0x24, 0x30, 0xf6, 0x3b, 0x65, 0xb3, 0x76, 0x7c, 0x68, 0xe5, 0x73, 0x65, 0x65, 0x73, 0x65, 0xc9,
+	0xb9, 0xb2, 0x1e, 0x53, 0x4c, 0x7a, 0xc3, 0xec, 0xdd, 0xbf, 0x3f, 0xb6, 0x0f, 0x17, 0x5e, 0x1c,
+	0x7d, 0xd3, 0xbd, 0x4e, 0xd1, 0xfd, 0xed, 0x53, 0xdb, 0x0c, 0x31, 0x9f, 0xce, 0xc6, 0x96, 0x4f,
+	0x63, 0x5b, 0x4e, 0x68, 0xfe, 0x79, 0xc8, 0x82, 0xd7, 0x36, 0x5f, 0x24, 0x88, 0x09, 0x36, 0xe6,
+	0xe8, 0x31, 0x26, 0xcb, 0xa0, 0x7a, 0x94, 0x04, 0xf0, 0x09, 0xa8, 0xcc, 0x63, 0x37, 0xb3, 0x31,
+	0x76, 0x3b, 0x8a, 0xb9, 0x7b, 0x6c, 0xdd, 0xb0, 0xce, 0xd6, 0xcb, 0xe1, 0xd9, 0x22, 0x41, 0xce,
+	0xf6, 0x3c, 0xce, 0xbe, 0xb0, 0x09, 0xb4, 0xc8, 0x9b, 0x11, 0x7f, 0x8a, 0x02, 0x63, 0xaf, 0xa3,
+	0x98, 0x9a, 0xb3, 0x94, 0xe1, 0x53, 0xb0, 0x97, 0xa4, 0xc8, 0xcd, 0x65, 0x37, 0xdb, 0x5a, 0x43,
+	0x17, 0x3d, 0x68, 0x5a, 0xf9, 0x4a, 0x5b, 0xc5, 0x4a, 0x5b, 0x67, 0xc5, 0x4a, 0xf7, 0xd4, 0x77,
+	0x9f, 0xda, 0x8a, 0xb3, 0x93, 0xa4, 0xe8, 0x99, 0xf0, 0xcb, 0x90, 0x6c, 0x2f, 0x22, 0x3c, 0xcf,
+	0xba, 0xc0, 0x5c, 0x34, 0x47, 0x84, 0x17, 0x7b, 0x71, 0xbb, 0xa3, 0x98, 0x65, 0xa7, 0x51, 0x80,
+	0xa7, 0x19, 0x96, 0xef, 0x05, 0x3c, 0x05, 0xed, 0xa5, 0x8f, 0x4f, 0x67, 0x84, 0x07, 0xf4, 0x0d,
+	0xc9, 0xa6, 0x3a, 0x5d, 0x7a, 0x43, 0xe1, 0x7d, 0xb7, 0x30, 0x7b, 0x5c, 0x58, 0x8d, 0x32, 0x23,
+	0x49, 0xf3, 0x0c, 0x54, 0x53, 0x34, 0xc7, 0x59, 0x2d, 0x98, 0xd1, 0x10, 0x8d, 0x33, 0x37, 0xd6,
+	0x4a, 0x3a, 0xc8, 0xf9, 0x59, 0x11, 0xc0, 0xaf, 0x81, 0x11, 0x60, 0x96, 0xcc, 0x38, 0x72, 0x13,
+	0x94, 0x62, 0x1a, 0xb8, 0x98, 0xb8, 0xe3, 0x88, 0xfa, 0xaf, 0x99, 0x71, 0x20, 0x76, 0xfc, 0x40,
+	0xe2, 0xcf, 0x05, 0xdc, 0x27, 0x3d, 0x01, 0x66, 0x15, 0xb8, 0x3a, 0x01, 0x6e, 0x80, 0x08, 0x8d,
+	0x99, 0x71, 0xa7, 0x53, 0x36, 0xab, 0x4e, 0x83, 0xad, 0xb7, 0xf7, 0x3b, 0x01, 0x75, 0x1f, 0x80,
+	0xed, 0xbc, 0x5b, 0x70, 0x0f, 0xd4, 0x5e, 0x10, 0x96, 0x20, 0x1f, 0x4f, 0x30, 0x0a, 0xf4, 0x12,
+	0xac, 0x80, 0xf2, 0xe9, 0xcb, 0xa1, 0xae, 0x40, 0x0d, 0xa8, 0x3f, 0x7d, 0x3b, 0x1a, 0x8a, 0x0b,
+	0x52, 0xd6, 0x2b, 0x03, 0x55, 0xab, 0xea, 0x60, 0xa0, 0x6a, 0x40, 0xaf, 0x75, 0x4f, 0x81, 0x56,
+	0x64, 0x02, 0xef, 0x80, 0x6d, 0x32, 0x8b, 0xc7, 0x28, 0x35, 0x1a, 0x22, 0x4c, 0x29, 0xc1, 0xaf,
+	0x40, 0xfd, 0x4a, 0x49, 0xf7, 0x05, 0x5a, 0x63, 0xab, 0x0a, 0x76, 0xff, 0xd8, 0x02, 0xbb, 0x72,
+	0x7a, 0x46, 0xb3, 0x38, 0xf6, 0xd2, 0x05, 0xbc, 0x0b, 0x56, 0x97, 0xe8, 0xfa, 0x69, 0x7a, 0x05,
+	0xf4, 0xc8, 0xe3, 0x88, 0x71, 0x71, 0x33, 0xfa, 0x24, 0x40, 0xe7, 0xe2, 0x4a, 0xd5, 0x36, 0x4f,
+	0xa9, 0xf4, 0x98, 0x50, 0xe1, 0xe5, 0x5c, 0xe3, 0x81, 0x11, 0x38, 0xcc, 0x75, 0xdf, 0x63, 0xe2,
+	0x45, 0xf8, 0x2d, 0x0a, 0xd6, 0x1e, 0x29, 0xff, 0xaf, 0x47, 0xbe, 0x4c, 0x08, 0xbb, 0xa0, 0x9e,
+	0x83, 0x79, 0x29, 0x0c, 0x55, 0x54, 0xe7, 0x8a, 0x0e, 0x3e, 0x02, 0x07, 0xff, 0x22, 0x90, 0xc6,
+	0xb7, 0xf2, 0x79, 0xf8, 0x2c, 0xd8, 0xfb, 0xf1, 0xfd, 0x45, 0x4b, 0xf9, 0x70, 0xd1, 0x52, 0xfe,
+	0xba, 0x68, 0x29, 0xef, 0x2e, 0x5b, 0xa5, 0x0f, 0x97, 0xad, 0xd2, 0x9f, 0x97, 0xad, 0xd2, 0xab,
+	0x47, 0x6b, 0x67, 0xe1, 0x0b, 0x7f, 0x4c, 0xf3, 0x13, 0xfb, 0x7c, 0xf9, 0xef, 0x24, 0x0e, 0xc5,
+	0x78, 0x5b, 0xac, 0xe2, 0xc9, 0x3f, 0x03, 0x00, 0x8d, 0x3d, 0x61, 0xde, 0xd1, 0x07, 0x00, 0x00,
 }
 
 func (m *RollappGenesisState) Marshal() (dAtA []byte, err error) {
@@ -540,6 +564,24 @@ func (m *Rollapp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SequencerBondDenoms) > 0 {
+		for iNdEx := len(m.SequencerBondDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SequencerBondDenoms[iNdEx])
+			copy(dAtA[i:], m.SequencerBondDenoms[iNdEx])
+			i = encodeVarintRollapp(dAtA, i, uint64(len(m.SequencerBondDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if m.DisputePeriodInBlocks != 0 {
+		i = encodeVarintRollapp(dAtA, i, uint64(m.DisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa8
+	}
 	if len(m.MinSequencerBond) > 0 {
 		for iNdEx := len(m.MinSequencerBond) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -858,6 +900,15 @@ func (m *Rollapp) Size() (n int) {
 			n += 2 + l + sovRollapp(uint64(l))
 		}
 	}
+	if m.DisputePeriodInBlocks != 0 {
+		n += 2 + sovRollapp(uint64(m.DisputePeriodInBlocks))
+	}
+	if len(m.SequencerBondDenoms) > 0 {
+		for _, s := range m.SequencerBondDenoms {
+			l = len(s)
+			n += 2 + l + sovRollapp(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1418,6 +1469,57 @@ func (m *Rollapp) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 21:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodInBlocks", wireType)
+			}
+			m.DisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SequencerBondDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRollapp
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRollapp
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SequencerBondDenoms = append(m.SequencerBondDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRollapp(dAtA[iNdEx:])
@@ -1,9 +1,13 @@
 package types
 
 import (
+	"bytes"
+	"sort"
 	"strconv"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	common "github.com/dymensionxyz/dymension/v3/x/common/types"
@@ -60,11 +64,47 @@ func (s *StateInfo) ContainsHeight(height uint64) bool {
 	return s.StartHeight <= height && height <= s.GetLatestHeight()
 }
 
+// Compressed returns true if the state only holds a sample of its block descriptors, committed to by BDsRoot
+func (s *StateInfo) Compressed() bool {
+	return len(s.BDsRoot) != 0
+}
+
+// GetBlockDescriptor returns the block descriptor of the height. Compressed states only hold the
+// block descriptors of the sampled heights.
 func (s *StateInfo) GetBlockDescriptor(height uint64) (BlockDescriptor, bool) {
 	if !s.ContainsHeight(height) {
 		return BlockDescriptor{}, false
 	}
-	return s.BDs.BD[height-s.StartHeight], true
+	if !s.Compressed() {
+		return s.BDs.BD[height-s.StartHeight], true
+	}
+	i := sort.Search(len(s.BDs.BD), func(i int) bool { return height <= s.BDs.BD[i].Height })
+	if i == len(s.BDs.BD) || s.BDs.BD[i].Height != height {
+		return BlockDescriptor{}, false
+	}
+	return s.BDs.BD[i], true
+}
+
+// VerifyBlockDescriptor checks that the block descriptor is the one the state holds or, for compressed
+// states, commits to. The proof is only needed for heights which a compressed state does not sample.
+func (s *StateInfo) VerifyBlockDescriptor(bd BlockDescriptor, proof *BlockDescriptorProof) error {
+	if !s.ContainsHeight(bd.Height) {
+		return errorsmod.Wrapf(ErrStateNotExists, "height: %d", bd.Height)
+	}
+	if stored, ok := s.GetBlockDescriptor(bd.Height); ok {
+		if !bytes.Equal(stored.Leaf(), bd.Leaf()) {
+			return errorsmod.Wrap(ErrInvalidBlockDescriptorProof, "block descriptor mismatch")
+		}
+		return nil
+	}
+	if proof == nil {
+		return errorsmod.Wrap(ErrBlockDescriptorNotSampled, "proof required")
+	}
+	// a state truncated by a hard fork holds fewer blocks than its root commits to
+	if uint64(proof.Index) != bd.Height-s.StartHeight || uint64(proof.Total) != s.BDsRootNumBlocks { //nolint:gosec
+		return errorsmod.Wrap(ErrInvalidBlockDescriptorProof, "index or total mismatch")
+	}
+	return proof.Verify(s.BDsRoot, bd)
 }
 
 func (s *StateInfo) GetLatestBlockDescriptor() BlockDescriptor {
@@ -96,3 +136,11 @@ type StateInfoMeta struct {
 	Revision uint64
 	Rollapp  string
 }
+
+// FinalizationHeight returns the hub height from which the states of the queue can be finalized
+func (q BlockHeightToFinalizationQueue) FinalizationHeight(p Params) uint64 {
+	if q.DisputePeriodInBlocks == 0 {
+		return q.CreationHeight + p.DisputePeriodInBlocks
+	}
+	return q.CreationHeight + p.ClampDisputePeriod(q.DisputePeriodInBlocks)
+}
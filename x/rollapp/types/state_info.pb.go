@@ -120,6 +120,14 @@ type StateInfo struct {
 	// to see in the next state info. Most of the time NextProposer is the current
 	// proposer. In case of rotation it is changed to the successor.
 	NextProposer string `protobuf:"bytes,11,opt,name=nextProposer,proto3" json:"nextProposer,omitempty"`
+	// BDsRoot is the Merkle root of the block descriptors of all the heights of
+	// a compressed state update. BDs then only hold a sample of them, at least
+	// the first and the last.
+	BDsRoot []byte `protobuf:"bytes,12,opt,name=BDsRoot,proto3" json:"BDsRoot,omitempty"`
+	// BDsRootNumBlocks is the number of block descriptors BDsRoot commits to. It
+	// is the number of blocks of the state update, which a hard fork can
+	// truncate.
+	BDsRootNumBlocks uint64 `protobuf:"varint,13,opt,name=BDsRootNumBlocks,proto3" json:"BDsRootNumBlocks,omitempty"`
 }
 
 func (m *StateInfo) Reset()         { *m = StateInfo{} }
@@ -225,6 +233,20 @@ func (m *StateInfo) GetNextProposer() string {
 	return ""
 }
 
+func (m *StateInfo) GetBDsRoot() []byte {
+	if m != nil {
+		return m.BDsRoot
+	}
+	return nil
+}
+
+func (m *StateInfo) GetBDsRootNumBlocks() uint64 {
+	if m != nil {
+		return m.BDsRootNumBlocks
+	}
+	return 0
+}
+
 // StateInfoSummary is a compact representation of StateInfo
 type StateInfoSummary struct {
 	// stateInfoIndex defines what rollapp the state belongs to
@@ -300,6 +322,10 @@ type BlockHeightToFinalizationQueue struct {
 	FinalizationQueue []StateInfoIndex `protobuf:"bytes,2,rep,name=finalizationQueue,proto3" json:"finalizationQueue"`
 	// RollappID is the rollapp which the queue belongs to
 	RollappId string `protobuf:"bytes,3,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// DisputePeriodInBlocks is the dispute period of the rollapp when the states
+	// were submitted, if it overrode the global one. 0 means the global dispute
+	// period applies.
+	DisputePeriodInBlocks uint64 `protobuf:"varint,4,opt,name=dispute_period_in_blocks,json=disputePeriodInBlocks,proto3" json:"dispute_period_in_blocks,omitempty"`
 }
 
 func (m *BlockHeightToFinalizationQueue) Reset()         { *m = BlockHeightToFinalizationQueue{} }
@@ -356,6 +382,13 @@ func (m *BlockHeightToFinalizationQueue) GetRollappId() string {
 	return ""
 }
 
+func (m *BlockHeightToFinalizationQueue) GetDisputePeriodInBlocks() uint64 {
+	if m != nil {
+		return m.DisputePeriodInBlocks
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*StateInfoIndex)(nil), "dymensionxyz.dymension.rollapp.StateInfoIndex")
 	proto.RegisterType((*StateInfo)(nil), "dymensionxyz.dymension.rollapp.StateInfo")
@@ -368,44 +401,47 @@ func init() {
 }
 
 var fileDescriptor_750f3a9f16533ec4 = []byte{
-	// 579 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xc1, 0x6a, 0xdb, 0x4a,
-	0x14, 0xf5, 0xd8, 0x8e, 0x13, 0x8d, 0x1f, 0x26, 0x19, 0xc2, 0x43, 0x98, 0x46, 0x36, 0x82, 0x16,
-	0xd3, 0x85, 0x54, 0x92, 0x76, 0x53, 0xe8, 0x22, 0xc6, 0x94, 0xb8, 0x8b, 0x92, 0x2a, 0x59, 0x94,
-	0x52, 0x30, 0xb2, 0x35, 0x96, 0x87, 0x4a, 0x33, 0xaa, 0x66, 0x54, 0xec, 0x7c, 0x45, 0x16, 0xfd,
-	0xa8, 0x40, 0x37, 0xd9, 0xb5, 0xab, 0xb4, 0xd8, 0x7f, 0xd0, 0x2f, 0x28, 0x1a, 0x4d, 0x2c, 0xdb,
-	0xb1, 0x1b, 0x08, 0x74, 0xe7, 0x7b, 0x7c, 0xcf, 0xe1, 0xdc, 0x73, 0xaf, 0x06, 0xda, 0xde, 0x24,
-	0xc4, 0x94, 0x13, 0x46, 0xc7, 0x93, 0x8b, 0xbc, 0xb0, 0x63, 0x16, 0x04, 0x6e, 0x14, 0xd9, 0x5c,
-	0xb8, 0x02, 0xf7, 0x08, 0x1d, 0x32, 0x2b, 0x8a, 0x99, 0x60, 0xc8, 0x58, 0x24, 0x58, 0xf3, 0xc2,
-	0x52, 0x84, 0xfa, 0xbe, 0xcf, 0x7c, 0x26, 0x5b, 0xed, 0xf4, 0x57, 0xc6, 0xaa, 0x37, 0x7c, 0xc6,
-	0xfc, 0x00, 0xdb, 0xb2, 0xea, 0x27, 0x43, 0x5b, 0x90, 0x10, 0x73, 0xe1, 0x86, 0x91, 0x6a, 0x78,
-	0x71, 0x8f, 0x8f, 0x7e, 0xc0, 0x06, 0x9f, 0x7a, 0x1e, 0xe6, 0x83, 0x98, 0x44, 0x82, 0xc5, 0x8a,
-	0xf6, 0x74, 0x03, 0x6d, 0xc0, 0xc2, 0x90, 0x51, 0xe9, 0x3e, 0xe1, 0x59, 0xaf, 0xd9, 0x81, 0xb5,
-	0xb3, 0x74, 0x9a, 0x2e, 0x1d, 0xb2, 0x2e, 0xf5, 0xf0, 0x18, 0x3d, 0x82, 0x9a, 0xd2, 0xef, 0x7a,
-	0x3a, 0x68, 0x82, 0x96, 0xe6, 0xe4, 0x00, 0xda, 0x87, 0x5b, 0x24, 0x6d, 0xd3, 0x8b, 0x4d, 0xd0,
-	0x2a, 0x3b, 0x59, 0x61, 0x7e, 0x2d, 0x43, 0x6d, 0x2e, 0x83, 0x3e, 0xc2, 0x1a, 0x5f, 0xd2, 0x94,
-	0x32, 0xd5, 0x43, 0xcb, 0xfa, 0x7b, 0x4c, 0xd6, 0xb2, 0x93, 0x76, 0xf9, 0xea, 0xa6, 0x51, 0x70,
-	0x56, 0xb4, 0x52, 0x7f, 0x1c, 0x7f, 0x4e, 0x30, 0x1d, 0xe0, 0x58, 0xba, 0xd0, 0x9c, 0x1c, 0x40,
-	0x4d, 0x58, 0xe5, 0xc2, 0x8d, 0xc5, 0x09, 0x26, 0xfe, 0x48, 0xe8, 0x25, 0xe9, 0x72, 0x11, 0x4a,
-	0xf9, 0x34, 0x09, 0xdb, 0x69, 0x74, 0x5c, 0x2f, 0xcb, 0xff, 0x73, 0x00, 0xfd, 0x0f, 0x2b, 0x9d,
-	0xe3, 0x53, 0x57, 0x8c, 0xf4, 0x2d, 0x29, 0xad, 0x2a, 0xf4, 0x04, 0xd6, 0x06, 0x31, 0x76, 0x05,
-	0x61, 0x54, 0x49, 0x6f, 0x4b, 0xea, 0x0a, 0x8a, 0x5e, 0xc1, 0x4a, 0x96, 0xaf, 0xbe, 0xd3, 0x04,
-	0xad, 0xda, 0xe1, 0xe3, 0x4d, 0x33, 0x67, 0xcb, 0x90, 0x23, 0x27, 0xdc, 0x51, 0x24, 0x74, 0x02,
-	0x4b, 0xed, 0x0e, 0xd7, 0x35, 0x99, 0xd7, 0xb3, 0xfb, 0xf2, 0x92, 0x9e, 0x3b, 0xf3, 0xf5, 0x73,
-	0x95, 0x58, 0x2a, 0x81, 0xde, 0x43, 0x28, 0xad, 0x61, 0xaf, 0xe7, 0x0a, 0x1d, 0x4a, 0xc1, 0xba,
-	0x95, 0x5d, 0x9c, 0x75, 0x7b, 0x71, 0xd6, 0xf9, 0xed, 0xc5, 0xb5, 0x0f, 0x52, 0xea, 0xef, 0x9b,
-	0xc6, 0xde, 0xc4, 0x0d, 0x83, 0x97, 0x66, 0xce, 0x35, 0x2f, 0x7f, 0x36, 0x80, 0xa3, 0x29, 0xe0,
-	0x58, 0x20, 0x13, 0xfe, 0x47, 0xf1, 0x58, 0x9c, 0xc6, 0x2c, 0x62, 0x1c, 0xc7, 0x7a, 0x55, 0x06,
-	0xb5, 0x84, 0xbd, 0x29, 0xef, 0x54, 0x76, 0xb7, 0xcd, 0xef, 0x00, 0xee, 0xce, 0x77, 0x7a, 0x96,
-	0x84, 0xa1, 0x1b, 0x4f, 0xfe, 0xf1, 0x75, 0xe4, 0xf9, 0x17, 0x1f, 0x92, 0xff, 0xdd, 0x35, 0x97,
-	0xd6, 0xad, 0xd9, 0xfc, 0x06, 0xa0, 0x21, 0xd3, 0xcf, 0xea, 0x73, 0xf6, 0x9a, 0x50, 0x37, 0x20,
-	0x17, 0xb2, 0xe7, 0x5d, 0x82, 0x13, 0xbc, 0x46, 0x0a, 0xac, 0xbd, 0x98, 0x3e, 0xdc, 0x1b, 0xae,
-	0x92, 0xf5, 0x62, 0xb3, 0xf4, 0xe0, 0x48, 0xee, 0xca, 0xa1, 0x03, 0x08, 0x15, 0xa5, 0x47, 0x3c,
-	0x39, 0xd2, 0xe2, 0x47, 0xdd, 0x7e, 0x7b, 0x35, 0x35, 0xc0, 0xf5, 0xd4, 0x00, 0xbf, 0xa6, 0x06,
-	0xb8, 0x9c, 0x19, 0x85, 0xeb, 0x99, 0x51, 0xf8, 0x31, 0x33, 0x0a, 0x1f, 0x9e, 0xfb, 0x44, 0x8c,
-	0x92, 0x7e, 0x9a, 0xd6, 0xa6, 0x47, 0xf1, 0xcb, 0x91, 0x3d, 0x9e, 0xbf, 0x48, 0x62, 0x12, 0x61,
-	0xde, 0xaf, 0xc8, 0xfb, 0x3a, 0xfa, 0x13, 0x00, 0x00, 0xff, 0xff, 0x4e, 0x18, 0x9c, 0xca, 0x48,
-	0x05, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x4b, 0xdc, 0x4c,
+	0x18, 0xde, 0xec, 0xae, 0xab, 0x3b, 0xfa, 0x2d, 0x3a, 0xf8, 0x95, 0x41, 0x6a, 0x76, 0x09, 0xb4,
+	0x2c, 0x1e, 0x92, 0xa2, 0x2d, 0x85, 0x42, 0x0f, 0x86, 0xa5, 0xb8, 0x3d, 0x88, 0x8d, 0x1e, 0x4a,
+	0x29, 0x84, 0x64, 0x33, 0x1b, 0x87, 0x26, 0x33, 0x69, 0x66, 0x52, 0x76, 0x3d, 0xf7, 0x07, 0xf8,
+	0xb3, 0x3c, 0x7a, 0x6b, 0xe9, 0xc1, 0x16, 0xfd, 0x07, 0xfd, 0x05, 0x25, 0x93, 0x31, 0x51, 0x57,
+	0x2b, 0x08, 0xbd, 0xe5, 0x7d, 0xe7, 0x7d, 0x1e, 0x9e, 0x79, 0xde, 0x27, 0x03, 0xac, 0x60, 0x1a,
+	0x63, 0xca, 0x09, 0xa3, 0x93, 0xe9, 0x51, 0x55, 0x58, 0x29, 0x8b, 0x22, 0x2f, 0x49, 0x2c, 0x2e,
+	0x3c, 0x81, 0x5d, 0x42, 0xc7, 0xcc, 0x4c, 0x52, 0x26, 0x18, 0xd4, 0xaf, 0x02, 0xcc, 0xb2, 0x30,
+	0x15, 0x60, 0x6d, 0x35, 0x64, 0x21, 0x93, 0xa3, 0x56, 0xfe, 0x55, 0xa0, 0xd6, 0xba, 0x21, 0x63,
+	0x61, 0x84, 0x2d, 0x59, 0xf9, 0xd9, 0xd8, 0x12, 0x24, 0xc6, 0x5c, 0x78, 0x71, 0xa2, 0x06, 0x5e,
+	0xdc, 0xa3, 0xc3, 0x8f, 0xd8, 0xe8, 0x93, 0x1b, 0x60, 0x3e, 0x4a, 0x49, 0x22, 0x58, 0xaa, 0x60,
+	0x1b, 0x77, 0xc0, 0x46, 0x2c, 0x8e, 0x19, 0x95, 0xea, 0x33, 0x5e, 0xcc, 0x1a, 0x03, 0xd0, 0xd9,
+	0xcf, 0x6f, 0x33, 0xa4, 0x63, 0x36, 0xa4, 0x01, 0x9e, 0xc0, 0xc7, 0xa0, 0xad, 0xf8, 0x87, 0x01,
+	0xd2, 0x7a, 0x5a, 0xbf, 0xed, 0x54, 0x0d, 0xb8, 0x0a, 0xe6, 0x48, 0x3e, 0x86, 0xea, 0x3d, 0xad,
+	0xdf, 0x74, 0x8a, 0xc2, 0xf8, 0xd1, 0x04, 0xed, 0x92, 0x06, 0x7e, 0x04, 0x1d, 0x7e, 0x8d, 0x53,
+	0xd2, 0x2c, 0x6e, 0x9a, 0xe6, 0xdf, 0x6d, 0x32, 0xaf, 0x2b, 0xb1, 0x9b, 0x27, 0x67, 0xdd, 0x9a,
+	0xd3, 0xe1, 0x33, 0xfa, 0x38, 0xfe, 0x9c, 0x61, 0x3a, 0xc2, 0xa9, 0x54, 0xd1, 0x76, 0xaa, 0x06,
+	0xec, 0x81, 0x45, 0x2e, 0xbc, 0x54, 0xec, 0x60, 0x12, 0x1e, 0x0a, 0xd4, 0x90, 0x2a, 0xaf, 0xb6,
+	0x72, 0x3c, 0xcd, 0x62, 0x3b, 0xb7, 0x8e, 0xa3, 0xa6, 0x3c, 0xaf, 0x1a, 0xf0, 0x11, 0x68, 0x0d,
+	0xb6, 0xf7, 0x3c, 0x71, 0x88, 0xe6, 0x24, 0xb5, 0xaa, 0xe0, 0x53, 0xd0, 0x19, 0xa5, 0xd8, 0x13,
+	0x84, 0x51, 0x45, 0x3d, 0x2f, 0xa1, 0x37, 0xba, 0xf0, 0x35, 0x68, 0x15, 0xfe, 0xa2, 0x85, 0x9e,
+	0xd6, 0xef, 0x6c, 0x3e, 0xb9, 0xeb, 0xce, 0xc5, 0x32, 0xe4, 0x95, 0x33, 0xee, 0x28, 0x10, 0xdc,
+	0x01, 0x0d, 0x7b, 0xc0, 0x51, 0x5b, 0xfa, 0xf5, 0xec, 0x3e, 0xbf, 0xa4, 0xe6, 0x41, 0xb9, 0x7e,
+	0xae, 0x1c, 0xcb, 0x29, 0xe0, 0x7b, 0x00, 0xa4, 0x34, 0x1c, 0xb8, 0x9e, 0x40, 0x40, 0x12, 0xae,
+	0x99, 0x45, 0xe2, 0xcc, 0xcb, 0xc4, 0x99, 0x07, 0x97, 0x89, 0xb3, 0xd7, 0x73, 0xe8, 0xef, 0xb3,
+	0xee, 0xca, 0xd4, 0x8b, 0xa3, 0x57, 0x46, 0x85, 0x35, 0x8e, 0x7f, 0x76, 0x35, 0xa7, 0xad, 0x1a,
+	0xdb, 0x02, 0x1a, 0x60, 0x89, 0xe2, 0x89, 0xd8, 0x4b, 0x59, 0xc2, 0x38, 0x4e, 0xd1, 0xa2, 0x34,
+	0xea, 0x5a, 0x0f, 0x22, 0x30, 0x6f, 0x0f, 0xb8, 0xc3, 0x98, 0x40, 0x4b, 0x3d, 0xad, 0xbf, 0xe4,
+	0x5c, 0x96, 0x70, 0x03, 0x2c, 0xab, 0xcf, 0xdd, 0x72, 0x0b, 0xff, 0x49, 0x2b, 0x67, 0xfa, 0x6f,
+	0x9b, 0x0b, 0xad, 0xe5, 0x79, 0xe3, 0x9b, 0x06, 0x96, 0xcb, 0x64, 0xec, 0x67, 0x71, 0xec, 0xa5,
+	0xd3, 0x7f, 0x9c, 0xb1, 0x6a, 0x8b, 0xf5, 0x87, 0x6c, 0x71, 0x36, 0x2c, 0x8d, 0xdb, 0xc2, 0x62,
+	0x7c, 0xad, 0x03, 0x5d, 0x5e, 0xb5, 0xa8, 0x0f, 0xd8, 0x1b, 0x42, 0xbd, 0x88, 0x1c, 0xc9, 0x99,
+	0x77, 0x19, 0xce, 0xf0, 0x2d, 0x54, 0xda, 0xad, 0xb9, 0xf3, 0xc1, 0xca, 0xf8, 0x26, 0x18, 0xd5,
+	0x7b, 0x8d, 0x07, 0x5b, 0x32, 0x4b, 0x07, 0xd7, 0x01, 0x50, 0x10, 0x97, 0x04, 0xa8, 0x71, 0xf3,
+	0x69, 0x78, 0x09, 0x50, 0x40, 0x78, 0x92, 0x09, 0xec, 0x26, 0x38, 0x25, 0x2c, 0x70, 0x09, 0x75,
+	0xfd, 0xab, 0xff, 0xd9, 0xff, 0xea, 0x7c, 0x4f, 0x1e, 0x0f, 0x69, 0xb1, 0x66, 0x7b, 0xf7, 0xe4,
+	0x5c, 0xd7, 0x4e, 0xcf, 0x75, 0xed, 0xd7, 0xb9, 0xae, 0x1d, 0x5f, 0xe8, 0xb5, 0xd3, 0x0b, 0xbd,
+	0xf6, 0xfd, 0x42, 0xaf, 0x7d, 0x78, 0x1e, 0x12, 0x71, 0x98, 0xf9, 0xb9, 0xcd, 0x77, 0xbd, 0xc9,
+	0x5f, 0xb6, 0xac, 0x49, 0xf9, 0x20, 0x8a, 0x69, 0x82, 0xb9, 0xdf, 0x92, 0xf1, 0xde, 0xfa, 0x33,
+	0x00, 0xd7, 0x04, 0x72, 0x38, 0xc7, 0x05, 0x00, 0x00,
 }
 
 func (m *StateInfoIndex) Marshal() (dAtA []byte, err error) {
@@ -463,6 +499,18 @@ func (m *StateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BDsRootNumBlocks != 0 {
+		i = encodeVarintStateInfo(dAtA, i, uint64(m.BDsRootNumBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.BDsRoot) > 0 {
+		i -= len(m.BDsRoot)
+		copy(dAtA[i:], m.BDsRoot)
+		i = encodeVarintStateInfo(dAtA, i, uint64(len(m.BDsRoot)))
+		i--
+		dAtA[i] = 0x62
+	}
 	if len(m.NextProposer) > 0 {
 		i -= len(m.NextProposer)
 		copy(dAtA[i:], m.NextProposer)
@@ -598,6 +646,11 @@ func (m *BlockHeightToFinalizationQueue) MarshalToSizedBuffer(dAtA []byte) (int,
 	_ = i
 	var l int
 	_ = l
+	if m.DisputePeriodInBlocks != 0 {
+		i = encodeVarintStateInfo(dAtA, i, uint64(m.DisputePeriodInBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.RollappId) > 0 {
 		i -= len(m.RollappId)
 		copy(dAtA[i:], m.RollappId)
@@ -690,6 +743,13 @@ func (m *StateInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStateInfo(uint64(l))
 	}
+	l = len(m.BDsRoot)
+	if l > 0 {
+		n += 1 + l + sovStateInfo(uint64(l))
+	}
+	if m.BDsRootNumBlocks != 0 {
+		n += 1 + sovStateInfo(uint64(m.BDsRootNumBlocks))
+	}
 	return n
 }
 
@@ -729,6 +789,9 @@ func (m *BlockHeightToFinalizationQueue) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStateInfo(uint64(l))
 	}
+	if m.DisputePeriodInBlocks != 0 {
+		n += 1 + sovStateInfo(uint64(m.DisputePeriodInBlocks))
+	}
 	return n
 }
 
@@ -1139,6 +1202,59 @@ func (m *StateInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.NextProposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BDsRoot", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStateInfo
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BDsRoot = append(m.BDsRoot[:0], dAtA[iNdEx:postIndex]...)
+			if m.BDsRoot == nil {
+				m.BDsRoot = []byte{}
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BDsRootNumBlocks", wireType)
+			}
+			m.BDsRootNumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BDsRootNumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStateInfo(dAtA[iNdEx:])
@@ -1395,6 +1511,25 @@ func (m *BlockHeightToFinalizationQueue) Unmarshal(dAtA []byte) error {
 			}
 			m.RollappId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputePeriodInBlocks", wireType)
+			}
+			m.DisputePeriodInBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStateInfo
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputePeriodInBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStateInfo(dAtA[iNdEx:])
@@ -27,6 +27,11 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdGetProposerByRollapp())
 	cmd.AddCommand(CmdGetNextProposerByRollapp())
 	cmd.AddCommand(CmdGetAllProposers())
+	cmd.AddCommand(CmdDelegationsBySequencer())
+	cmd.AddCommand(CmdDelegationsByDelegator())
+	cmd.AddCommand(CmdSequencerHistory())
+	cmd.AddCommand(CmdUnbondings())
+	cmd.AddCommand(CmdUnbondingsBySequencer())
 
 	return cmd
 }
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -35,6 +36,12 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdDecreaseBond())
 	cmd.AddCommand(CmdKickProposer())
 	cmd.AddCommand(CmdUpdateOptInStatus())
+	cmd.AddCommand(CmdScheduleRotation())
+	cmd.AddCommand(CmdAcceptRotation())
+	cmd.AddCommand(CmdCancelRotation())
+	cmd.AddCommand(CmdDelegate())
+	cmd.AddCommand(CmdUndelegate())
+	cmd.AddCommand(CmdDistributeRewards())
 
 	return cmd
 }
@@ -326,3 +333,156 @@ func CmdDecreaseBond() *cobra.Command {
 
 	return cmd
 }
+
+func CmdScheduleRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "schedule-rotation [successor] [handover-height]",
+		Short:   "Plan to hand the rollapp over to a successor from a rollapp height onwards",
+		Example: "schedule-rotation ethm1lhk5cnfrhgh26w5r6qft36qerg4dclfev9nprc 10000 --from foouser",
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			height, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("handover height: %w", err)
+			}
+
+			msg := types.NewMsgScheduleRotation(clientCtx.GetFromAddress().String(), args[0], height)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdAcceptRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-rotation",
+		Short: "Commit to take over the rollapp at the handover height planned by the proposer",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptRotation(clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdCancelRotation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-rotation",
+		Short: "Cancel the rotation planned by the proposer",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRotation(clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegate [sequencer] [amount]",
+		Short: "Delegate bond to a sequencer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDelegate(clientCtx.GetFromAddress().String(), args[0], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUndelegate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "undelegate [sequencer] [amount]",
+		Short: "Withdraw bond delegated to a sequencer",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUndelegate(clientCtx.GetFromAddress().String(), args[0], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDistributeRewards() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "distribute-rewards [sequencer] [amount]",
+		Short: "Share rewards between a sequencer and its delegators, pro rata to their bond",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDistributeRewards(clientCtx.GetFromAddress().String(), args[0], amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -27,6 +27,31 @@ func InitGenesis(ctx sdk.Context, k *keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.GenesisSuccessors {
 		k.SetSuccessor(ctx, elem.RollappId, elem.Address)
 	}
+	for _, elem := range genState.PlannedRotations {
+		if err := k.SetPlannedRotation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.Delegations {
+		if err := k.SetDelegation(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	for _, elem := range genState.History {
+		if err := k.SetHistoryRecord(ctx, elem); err != nil {
+			panic(err)
+		}
+	}
+	var nextUnbondingID uint64
+	for _, elem := range genState.Unbondings {
+		if err := k.SetUnbonding(ctx, elem); err != nil {
+			panic(err)
+		}
+		nextUnbondingID = max(nextUnbondingID, elem.Id+1)
+	}
+	if err := k.SetUnbondingID(ctx, nextUnbondingID); err != nil {
+		panic(err)
+	}
 }
 
 func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
@@ -58,5 +83,22 @@ func ExportGenesis(ctx sdk.Context, k *keeper.Keeper) *types.GenesisState {
 		genesis.NoticeQueue = append(genesis.NoticeQueue, seq.Address)
 	}
 
+	genesis.PlannedRotations, err = k.AllPlannedRotations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Delegations, err = k.AllDelegations(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.History, err = k.AllHistory(ctx)
+	if err != nil {
+		panic(err)
+	}
+	genesis.Unbondings, err = k.AllUnbondings(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &genesis
 }
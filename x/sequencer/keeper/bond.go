@@ -4,7 +4,6 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
-	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
 )
 
 // UnbondBlocker allows vetoing unbond attempts
@@ -15,9 +14,11 @@ type UnbondBlocker interface {
 
 // TryUnbond will try to either partially or totally unbond a sequencer.
 // The sequencer may not be allowed to unbond, based on certain conditions.
-// A partial unbonding refunds tokens, but doesn't allow the remaining bond to fall below a threshold.
-// A total unbond refunds all tokens and changes status to unbonded.
-func (k Keeper) TryUnbond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
+// Only the self bond can be unbonded, delegations are withdrawn by the delegators.
+// A partial unbonding doesn't allow the value of the remaining bond to fall below a threshold.
+// A total unbond unbonds all the self bond and changes status to unbonded.
+// The unbonded tokens are queued, and only refunded once the unbonding time passed.
+func (k Keeper) TryUnbond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins) error {
 	if k.IsProposer(ctx, *seq) || k.IsSuccessor(ctx, *seq) {
 		return types.ErrUnbondProposerOrSuccessor
 	}
@@ -26,20 +27,26 @@ func (k Keeper) TryUnbond(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) e
 			return errorsmod.Wrap(err, "other module")
 		}
 	}
-	bond := seq.TokensCoin()
-	minBond := k.rollappKeeper.MinBond(ctx, seq.RollappId)
-	maxReduction, _ := bond.SafeSub(minBond)
-	isPartial := !amt.IsEqual(bond)
-	if isPartial && maxReduction.IsLT(amt) {
-		return errorsmod.Wrapf(types.ErrUnbondNotAllowed,
-			"attempted reduction: %s, max reduction: %s",
-			amt, ucoin.NonNegative(maxReduction),
-		)
+	selfBond := seq.SelfBond()
+	if !selfBond.IsAllGTE(amt) {
+		return errorsmod.Wrapf(types.ErrUnbondNotAllowed, "attempted reduction: %s, self bond: %s", amt, selfBond)
 	}
-	if err := k.refund(ctx, seq, amt); err != nil {
-		return errorsmod.Wrap(err, "refund")
+	isPartial := !amt.Equal(selfBond)
+	if isPartial {
+		minBond := k.rollappKeeper.MinBond(ctx, seq.RollappId)
+		if remaining := k.bondValue(ctx, seq.AllTokens().Sub(amt...)); remaining.LT(minBond.Amount) {
+			return errorsmod.Wrapf(types.ErrUnbondNotAllowed,
+				"attempted reduction: %s, remaining bond value: %s, min bond: %s",
+				amt, remaining, minBond,
+			)
+		}
+	}
+	for _, c := range amt {
+		if err := k.startUnbonding(ctx, seq, seq.AccAddr(), c); err != nil {
+			return errorsmod.Wrap(err, "start unbonding")
+		}
 	}
-	if seq.Tokens.IsZero() {
+	if seq.SelfBond().IsZero() {
 		k.unbond(ctx, seq)
 	}
 	return nil
@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	rollappkeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) TestMultiDenomBond() {
+	const raDenom = "ibc/rollapp"
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+
+	params := s.k().GetParams(s.Ctx)
+	params.BondDenoms = []types.BondDenom{{Denom: raDenom, Weight: math.LegacyNewDec(2)}}
+	s.k().SetParams(s.Ctx, params)
+	half := sdk.NewCoin(raDenom, bond.Amount.QuoRaw(2))
+
+	// the rollapp must accept the denom
+	s.fundSequencer(bob, sdk.NewCoin(raDenom, bond.Amount))
+	msg := createSequencerMsgOnePubkey(ra.RollappId, bob)
+	msg.Bond = half
+	_, err := s.msgServer.CreateSequencer(s.Ctx, &msg)
+	utest.IsErr(s.Require(), err, types.ErrInvalidDenom)
+
+	raMsgServer := rollappkeeper.NewMsgServerImpl(s.raK())
+	update := &rollapptypes.MsgUpdateSequencerBondDenoms{Owner: pkAddr(bob), RollappId: ra.RollappId, Denoms: []string{raDenom}}
+	_, err = raMsgServer.UpdateSequencerBondDenoms(s.Ctx, update)
+	utest.IsErr(s.Require(), err, rollapptypes.ErrUnauthorizedSigner)
+	update.Owner = ra.Owner
+	_, err = raMsgServer.UpdateSequencerBondDenoms(s.Ctx, update)
+	s.Require().NoError(err)
+
+	// the min bond applies to the weighted value
+	msg.Bond = half.SubAmount(math.OneInt())
+	_, err = s.msgServer.CreateSequencer(s.Ctx, &msg)
+	utest.IsErr(s.Require(), err, types.ErrInsufficientBond)
+	msg.Bond = half
+	_, err = s.msgServer.CreateSequencer(s.Ctx, &msg)
+	s.Require().NoError(err)
+	s.Require().True(s.seq(bob).TokensCoin().IsZero())
+	s.Require().Equal(bond.Amount, params.BondValue(s.seq(bob).AllTokens()))
+
+	_, err = s.msgServer.IncreaseBond(s.Ctx, types.NewMsgIncreaseBond(pkAddr(bob), sdk.NewCoin("other", math.OneInt())))
+	utest.IsErr(s.Require(), err, types.ErrInvalidDenom)
+	_, err = s.msgServer.IncreaseBond(s.Ctx, types.NewMsgIncreaseBond(pkAddr(bob), half))
+	s.Require().NoError(err)
+	_, err = s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(pkAddr(bob), half))
+	s.Require().NoError(err)
+	_, err = s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(pkAddr(bob), sdk.NewCoin(raDenom, math.OneInt())))
+	utest.IsErr(s.Require(), err, types.ErrUnbondNotAllowed)
+
+	// liveness slashing is computed on the value, and taken from each denom in proportion
+	s.FundAcc(pkAcc(bob), sdk.NewCoins(bond))
+	_, err = s.msgServer.IncreaseBond(s.Ctx, types.NewMsgIncreaseBond(pkAddr(bob), bond))
+	s.Require().NoError(err)
+	seq := s.seq(bob)
+	value := params.BondValue(seq.AllTokens())
+	s.Require().Equal(bond.Amount.MulRaw(2), value)
+	slashValue := math.MaxInt(params.LivenessSlashMinAbsolute.Amount, params.LivenessSlashMinMultiplier.MulInt(value).TruncateInt())
+	amt := s.k().LivenessSlashAmount(s.Ctx, seq)
+	s.Require().Len(amt, 2)
+	for _, c := range seq.AllTokens() {
+		s.Require().Equal(c.Amount.Mul(slashValue).Quo(value), amt.AmountOf(c.Denom))
+	}
+
+	// the punishment reward is paid in every denom, including the unbonding ones
+	rewardee := pkAcc(randomTMPubKey())
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, pkAddr(bob), &rewardee))
+	s.Require().True(s.seq(bob).AllTokens().IsZero())
+	s.Require().Equal(bond.Amount.QuoRaw(2), s.App.BankKeeper.GetBalance(s.Ctx, rewardee, bond.Denom).Amount)
+	s.Require().Equal(half.Amount, s.App.BankKeeper.GetBalance(s.Ctx, rewardee, raDenom).Amount)
+
+	_, broken := keeper.AllInvariants(*s.k())(s.Ctx)
+	s.Require().False(broken)
+}
@@ -21,6 +21,6 @@ func (s *SequencerTestSuite) TestBondBlockers() {
 	s.k().SetProposer(s.Ctx, ra.RollappId, pkAddr(bob)) // ensure alice is not proposer
 	db := DummyBlocker{}
 	s.k().SetUnbondBlockers(&db)
-	_ = s.k().TryUnbond(s.Ctx, &seq, seq.TokensCoin())
+	_ = s.k().TryUnbond(s.Ctx, &seq, seq.SelfBond())
 	s.Require().True(db.called)
 }
@@ -0,0 +1,286 @@
+package keeper
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+/*
+Third party stakers can add bond to a sequencer by delegating to it. The delegated tokens count towards the
+bond of the sequencer, in particular its min bond and its priority as proposer, and the delegators receive
+shares in return. Slashing the sequencer reduces the delegated tokens pro rata to their part of the bond,
+which reduces what the shares are worth. Delegators can withdraw at any time. A sequencer whose bond falls
+below the min bond as they do can no longer be chosen as proposer, until it is topped up.
+
+The rewards of a sequencer with delegators are to be paid to its reward pool, which is announced as its
+reward address on rotation. The pools are shared between the delegators and the sequencer at the end of
+each rewards epoch.
+*/
+
+func (k Keeper) GetDelegation(ctx sdk.Context, seqAddr, delegator string) (types.Delegation, error) {
+	d, err := k.delegations.Get(ctx, collections.Join(seqAddr, delegator))
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Delegation{}, errorsmod.Wrapf(gerrc.ErrNotFound, "delegation: sequencer: %s: delegator: %s", seqAddr, delegator)
+	}
+	return d, err
+}
+
+func (k Keeper) SetDelegation(ctx sdk.Context, d types.Delegation) error {
+	if err := k.delegations.Set(ctx, collections.Join(d.Sequencer, d.Delegator), d); err != nil {
+		return err
+	}
+	return k.delegationsByDelegator.Set(ctx, collections.Join(d.Delegator, d.Sequencer))
+}
+
+func (k Keeper) removeDelegation(ctx sdk.Context, d types.Delegation) error {
+	if err := k.delegations.Remove(ctx, collections.Join(d.Sequencer, d.Delegator)); err != nil {
+		return err
+	}
+	return k.delegationsByDelegator.Remove(ctx, collections.Join(d.Delegator, d.Sequencer))
+}
+
+func (k Keeper) AllDelegations(ctx sdk.Context) ([]types.Delegation, error) {
+	iter, err := k.delegations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) sequencerDelegations(ctx sdk.Context, seqAddr string) ([]types.Delegation, error) {
+	iter, err := k.delegations.Iterate(ctx, collections.NewPrefixedPairRange[string, string](seqAddr))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) GetDelegationsBySequencer(ctx sdk.Context, seqAddr string, pageReq *query.PageRequest) ([]types.DelegationWithBalance, *query.PageResponse, error) {
+	seq, err := k.RealSequencer(ctx, seqAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+	return collcompat.CollectionPaginate(ctx, k.delegations, pageReq,
+		func(_ collections.Pair[string, string], d types.Delegation) (types.DelegationWithBalance, error) {
+			return withBalance(seq, d), nil
+		}, collcompat.WithCollectionPaginationPairPrefix[string, string](seqAddr),
+	)
+}
+
+func (k Keeper) GetDelegationsByDelegator(ctx sdk.Context, delegator string, pageReq *query.PageRequest) ([]types.DelegationWithBalance, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.delegationsByDelegator, pageReq,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.DelegationWithBalance, error) {
+			seq, err := k.RealSequencer(ctx, key.K2())
+			if err != nil {
+				return types.DelegationWithBalance{}, err
+			}
+			d, err := k.GetDelegation(ctx, key.K2(), key.K1())
+			if err != nil {
+				return types.DelegationWithBalance{}, err
+			}
+			return withBalance(seq, d), nil
+		}, collcompat.WithCollectionPaginationPairPrefix[string, string](delegator),
+	)
+}
+
+func withBalance(seq types.Sequencer, d types.Delegation) types.DelegationWithBalance {
+	return types.DelegationWithBalance{
+		Delegation: d,
+		Balance:    sdk.NewCoin(seq.TokensCoin().Denom, seq.SharesToTokens(d.Shares)),
+	}
+}
+
+// Delegate adds amt of the delegator to the bond of the sequencer, in exchange for shares
+func (k Keeper) Delegate(ctx sdk.Context, seq *types.Sequencer, delegator sdk.AccAddress, amt sdk.Coin) error {
+	if !seq.Bonded() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "sequencer is not bonded")
+	}
+	if delegator.String() == seq.Address {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "sequencer cannot delegate to itself, increase bond instead")
+	}
+	if err := validBondDenom(amt); err != nil {
+		return err
+	}
+
+	var shares math.LegacyDec
+	switch {
+	case !seq.Shares().IsPositive():
+		shares = math.LegacyNewDecFromInt(amt.Amount)
+	case !seq.Delegated().IsPositive():
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "delegations to the sequencer were slashed entirely")
+	default:
+		shares = seq.Shares().MulInt(amt.Amount).QuoInt(seq.Delegated())
+	}
+	if !shares.IsPositive() {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "amount too small")
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, delegator, types.ModuleName, sdk.NewCoins(amt))
+	if err != nil {
+		return errorsmod.Wrap(err, "send coins to module")
+	}
+	seq.AddTokens(sdk.NewCoins(amt))
+	seq.SetDelegated(seq.Delegated().Add(amt.Amount), seq.Shares().Add(shares))
+
+	d, err := k.GetDelegation(ctx, seq.Address, delegator.String())
+	if errorsmod.IsOf(err, gerrc.ErrNotFound) {
+		d = types.Delegation{Delegator: delegator.String(), Sequencer: seq.Address, Shares: math.LegacyZeroDec()}
+	} else if err != nil {
+		return err
+	}
+	d.Shares = d.Shares.Add(shares)
+	if err := k.SetDelegation(ctx, d); err != nil {
+		return errorsmod.Wrap(err, "set delegation")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventDelegated{
+		Delegator: delegator.String(),
+		Sequencer: seq.Address,
+		Amount:    amt,
+		Shares:    shares,
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+// Undelegate unbonds amt of the delegation of the delegator, redeeming the corresponding shares. The amount
+// is refunded once the unbonding time passed.
+func (k Keeper) Undelegate(ctx sdk.Context, seq *types.Sequencer, delegator sdk.AccAddress, amt sdk.Coin) error {
+	if err := validBondDenom(amt); err != nil {
+		return err
+	}
+	d, err := k.GetDelegation(ctx, seq.Address, delegator.String())
+	if err != nil {
+		return err
+	}
+	balance := seq.SharesToTokens(d.Shares)
+	if balance.LT(amt.Amount) {
+		return errorsmod.Wrapf(gerrc.ErrOutOfRange, "amount above delegation: %s", balance)
+	}
+	shares := d.Shares
+	if amt.Amount.LT(balance) {
+		shares = seq.Shares().MulInt(amt.Amount).QuoRoundUp(math.LegacyNewDecFromInt(seq.Delegated()))
+		shares = math.LegacyMinDec(shares, d.Shares)
+	}
+
+	if err := k.startUnbonding(ctx, seq, delegator, amt); err != nil {
+		return errorsmod.Wrap(err, "start unbonding")
+	}
+	delegated, remainingShares := seq.Delegated().Sub(amt.Amount), seq.Shares().Sub(shares)
+	if remainingShares.IsZero() {
+		// rounding dust left by the last delegator goes to the sequencer
+		delegated = math.ZeroInt()
+	}
+	seq.SetDelegated(delegated, remainingShares)
+
+	d.Shares = d.Shares.Sub(shares)
+	if d.Shares.IsZero() {
+		err = k.removeDelegation(ctx, d)
+	} else {
+		err = k.SetDelegation(ctx, d)
+	}
+	if err != nil {
+		return errorsmod.Wrap(err, "update delegation")
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventUndelegated{
+		Delegator: delegator.String(),
+		Sequencer: seq.Address,
+		Amount:    amt,
+		Shares:    shares,
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+// slashDelegations reduces the delegated tokens by their part of amt, which is about to be slashed from
+// the tokens of the sequencer. Delegations are in DYM, so only the DYM part of amt concerns them.
+func slashDelegations(seq *types.Sequencer, amt sdk.Coins) {
+	tokens := seq.TokensCoin()
+	if !tokens.IsPositive() {
+		return
+	}
+	part := amt.AmountOf(tokens.Denom).Mul(seq.Delegated()).Quo(tokens.Amount)
+	seq.SetDelegated(seq.Delegated().Sub(part), seq.Shares())
+}
+
+// DistributeRewards pays rewards from payer to the delegators of the sequencer, pro rata to their part of
+// the value of the bond. The rest goes to the reward address of the sequencer.
+func (k Keeper) DistributeRewards(ctx sdk.Context, seq types.Sequencer, payer sdk.AccAddress, amt sdk.Coins) error {
+	total := k.bondValue(ctx, seq.AllTokens())
+	if !total.IsPositive() {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "sequencer has no bond")
+	}
+	ds, err := k.sequencerDelegations(ctx, seq.Address)
+	if err != nil {
+		return errorsmod.Wrap(err, "sequencer delegations")
+	}
+
+	toDelegators := sdk.NewCoins()
+	for _, d := range ds {
+		balance := seq.SharesToTokens(d.Shares)
+		share := sdk.NewCoins()
+		for _, c := range amt {
+			share = share.Add(sdk.NewCoin(c.Denom, c.Amount.Mul(balance).Quo(total)))
+		}
+		if share.IsZero() {
+			continue
+		}
+		if err := k.bankKeeper.SendCoins(ctx, payer, sdk.MustAccAddressFromBech32(d.Delegator), share); err != nil {
+			return errorsmod.Wrapf(err, "pay delegator: %s", d.Delegator)
+		}
+		toDelegators = toDelegators.Add(share...)
+	}
+
+	toSequencer := amt.Sub(toDelegators...)
+	if !toSequencer.IsZero() {
+		rewardAddr := seq.RewardAddr
+		if rewardAddr == "" {
+			rewardAddr = seq.Address
+		}
+		if err := k.bankKeeper.SendCoins(ctx, payer, sdk.MustAccAddressFromBech32(rewardAddr), toSequencer); err != nil {
+			return errorsmod.Wrap(err, "pay sequencer")
+		}
+	}
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventRewardsDistributed{
+		Sequencer:    seq.Address,
+		ToDelegators: toDelegators,
+		ToSequencer:  toSequencer,
+	}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+// DistributeRewardPools shares the rewards collected in the reward pool of each sequencer, see
+// DistributeRewards. A pool which fails to be distributed keeps its rewards until the next time.
+func (k Keeper) DistributeRewardPools(ctx sdk.Context) {
+	for _, seq := range k.AllSequencers(ctx) {
+		pool := seq.RewardPoolAddr()
+		amt := k.bankKeeper.GetAllBalances(ctx, pool)
+		if amt.IsZero() {
+			continue
+		}
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.DistributeRewards(ctx, seq, pool, amt)
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Distribute reward pool.", "sequencer", seq.Address, "err", err)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	"slices"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+
+	"github.com/dymensionxyz/dymension/v3/testutil/sample"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) TestDelegationFlow() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+
+	// bob cannot go below the min bond on his own, delegations make up for it
+	half := sdk.NewCoin(bond.Denom, bond.Amount.QuoRaw(2))
+	_, err := s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(pkAddr(bob), half))
+	utest.IsErr(s.Require(), err, types.ErrUnbondNotAllowed)
+
+	delegator := sample.Acc()
+	s.FundAcc(delegator, sdk.NewCoins(bond))
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(delegator.String(), pkAddr(bob), bond))
+	s.Require().NoError(err)
+	s.Require().True(s.moduleBalance().IsEqual(ucoin.SimpleMul(bond, 3)))
+
+	_, err = s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(pkAddr(bob), half))
+	s.Require().NoError(err)
+	seq := s.seq(bob)
+	s.Require().True(seq.SelfBondCoin().IsEqual(half))
+	s.Require().True(seq.Delegated().Equal(bond.Amount))
+
+	res, err := s.queryClient.DelegationsBySequencer(s.Ctx, &types.QueryDelegationsBySequencerRequest{Sequencer: pkAddr(bob)})
+	s.Require().NoError(err)
+	s.Require().Len(res.Delegations, 1)
+	s.Require().Equal(delegator.String(), res.Delegations[0].Delegation.Delegator)
+	s.Require().True(res.Delegations[0].Balance.Equal(bond))
+	res, err = s.queryClient.DelegationsByDelegator(s.Ctx, &types.QueryDelegationsByDelegatorRequest{Delegator: delegator.String()})
+	s.Require().NoError(err)
+	s.Require().Len(res.Delegations, 1)
+	s.Require().Equal(pkAddr(bob), res.Delegations[0].Delegation.Sequencer)
+
+	// the delegator can withdraw even below the min bond, bob is then no longer a potential proposer
+	potential := func() bool {
+		return slices.ContainsFunc(s.k().RollappPotentialProposers(s.Ctx, ra.RollappId), func(seq types.Sequencer) bool {
+			return seq.Address == pkAddr(bob)
+		})
+	}
+	quarter := sdk.NewCoin(bond.Denom, bond.Amount.QuoRaw(4))
+	_, err = s.msgServer.Undelegate(s.Ctx, types.NewMsgUndelegate(delegator.String(), pkAddr(bob), half))
+	s.Require().NoError(err)
+	s.Require().True(potential())
+	_, err = s.msgServer.Undelegate(s.Ctx, types.NewMsgUndelegate(delegator.String(), pkAddr(bob), quarter))
+	s.Require().NoError(err)
+	s.Require().False(potential())
+
+	// once bob is unbonded, the delegator can withdraw the rest
+	_, err = s.msgServer.Unbond(s.Ctx, &types.MsgUnbond{Creator: pkAddr(bob)})
+	s.Require().NoError(err)
+	seq = s.seq(bob)
+	s.Require().False(seq.Bonded())
+	s.Require().True(seq.TokensCoin().IsEqual(quarter))
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(delegator.String(), pkAddr(bob), quarter))
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+	_, err = s.msgServer.Undelegate(s.Ctx, types.NewMsgUndelegate(delegator.String(), pkAddr(bob), quarter))
+	s.Require().NoError(err)
+
+	s.completeUnbondings()
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, delegator, bond.Denom).IsEqual(bond))
+	_, err = s.k().GetDelegation(s.Ctx, pkAddr(bob), delegator.String())
+	utest.IsErr(s.Require(), err, gerrc.ErrNotFound)
+	s.Require().True(s.moduleBalance().IsEqual(bond))
+}
+
+func (s *SequencerTestSuite) TestDelegationSlashedProRata() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+
+	// the delegators have 3/4 of the bond
+	d1, d2 := sample.Acc(), sample.Acc()
+	s.FundAcc(d1, sdk.NewCoins(bond))
+	s.FundAcc(d2, sdk.NewCoins(ucoin.SimpleMul(bond, 2)))
+	_, err := s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(d1.String(), pkAddr(alice), bond))
+	s.Require().NoError(err)
+	_, err = s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(d2.String(), pkAddr(alice), ucoin.SimpleMul(bond, 2)))
+	s.Require().NoError(err)
+
+	seq := s.seq(alice)
+	amt := s.k().LivenessSlashAmount(s.Ctx, seq)
+	s.Require().NoError(s.k().SlashLiveness(s.Ctx, ra.RollappId))
+	seq = s.seq(alice)
+	s.Require().True(s.moduleBalance().IsEqual(seq.TokensCoin()))
+	expDelegated := ucoin.SimpleMul(bond, 3).Amount.Sub(amt.AmountOf(bond.Denom).MulRaw(3).QuoRaw(4))
+	s.Require().True(seq.Delegated().Equal(expDelegated))
+
+	b1, err := s.k().GetDelegation(s.Ctx, pkAddr(alice), d1.String())
+	s.Require().NoError(err)
+	b2, err := s.k().GetDelegation(s.Ctx, pkAddr(alice), d2.String())
+	s.Require().NoError(err)
+	s.Require().True(seq.SharesToTokens(b2.Shares).Sub(seq.SharesToTokens(b1.Shares).MulRaw(2)).Abs().LTE(math.OneInt()))
+
+	// rewards are shared pro rata to the bond
+	payer := sample.Acc()
+	rewards := sdk.NewCoins(sdk.NewCoin("reward", math.NewInt(1000)))
+	s.FundAcc(payer, rewards)
+	_, err = s.msgServer.DistributeRewards(s.Ctx, types.NewMsgDistributeRewards(payer.String(), pkAddr(alice), rewards))
+	s.Require().NoError(err)
+
+	total := seq.TokensCoin().Amount
+	exp1 := math.NewInt(1000).Mul(seq.SharesToTokens(b1.Shares)).Quo(total)
+	s.Require().Equal(exp1, s.App.BankKeeper.GetBalance(s.Ctx, d1, "reward").Amount)
+	got := s.App.BankKeeper.GetBalance(s.Ctx, d1, "reward").Amount.
+		Add(s.App.BankKeeper.GetBalance(s.Ctx, d2, "reward").Amount).
+		Add(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(alice), "reward").Amount)
+	s.Require().Equal(math.NewInt(1000), got)
+}
+
+func (s *SequencerTestSuite) TestDelegationRewardPool() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	seq := s.seq(alice)
+	s.Require().Equal(seq.RewardAddr, seq.RewardRecipient())
+
+	// once there are delegators, the rewards are to be paid to the reward pool
+	delegator := sample.Acc()
+	s.FundAcc(delegator, sdk.NewCoins(bond))
+	_, err := s.msgServer.Delegate(s.Ctx, types.NewMsgDelegate(delegator.String(), pkAddr(alice), bond))
+	s.Require().NoError(err)
+	seq = s.seq(alice)
+	s.Require().Equal(seq.RewardPoolAddr().String(), seq.RewardRecipient())
+
+	// the pool is shared at the end of the rewards epoch only
+	rewards := sdk.NewCoins(sdk.NewCoin("reward", math.NewInt(1000)))
+	s.FundAcc(seq.RewardPoolAddr(), rewards)
+	s.Require().NoError(s.k().EpochHooks().AfterEpochEnd(s.Ctx, "other", 1))
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, seq.RewardPoolAddr()).Equal(rewards))
+
+	s.Require().NoError(s.k().EpochHooks().AfterEpochEnd(s.Ctx, types.DefaultRewardsEpochIdentifier, 1))
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, seq.RewardPoolAddr()).IsZero())
+	s.Require().Equal(math.NewInt(500), s.App.BankKeeper.GetBalance(s.Ctx, delegator, "reward").Amount)
+	s.Require().Equal(math.NewInt(500), s.App.BankKeeper.GetBalance(s.Ctx, sdk.MustAccAddressFromBech32(seq.RewardAddr), "reward").Amount)
+}
@@ -6,14 +6,13 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
-	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
 )
 
 // TryKickProposer tries to remove the incumbent proposer. It requires the incumbent
-// proposer to be below a threshold of bond. The caller must also be bonded and opted in.
+// proposer to be below a threshold of bond. The caller must also be bonded, opted in and have the min bond.
 func (k Keeper) TryKickProposer(ctx sdk.Context, kicker types.Sequencer) error {
-	if !kicker.IsPotentialProposer() {
+	if !k.isPotentialProposer(ctx, kicker) {
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "kicker is not a potential proposer")
 	}
 
@@ -25,9 +24,21 @@ func (k Keeper) TryKickProposer(ctx sdk.Context, kicker types.Sequencer) error {
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "not kickable")
 	}
 
+	if err := k.dropPlannedRotation(ctx, ra, "proposer kicked"); err != nil {
+		return errorsmod.Wrap(err, "drop planned rotation")
+	}
+
 	// clear the proposer
 	k.abruptRemoveProposer(ctx, ra)
 
+	if err := k.recordHistory(ctx, types.HistoryRecord{
+		Sequencer: proposer.Address,
+		Kind:      types.HistoryKick,
+		Kicker:    kicker.Address,
+	}); err != nil {
+		return errorsmod.Wrap(err, "record history")
+	}
+
 	// This will call hard fork on the rollapp, which will also optOut all sequencers
 	err := k.hooks.AfterKickProposer(ctx, proposer)
 	if err != nil {
@@ -35,7 +46,7 @@ func (k Keeper) TryKickProposer(ctx sdk.Context, kicker types.Sequencer) error {
 	}
 
 	// optIn the kicker
-	if err := kicker.SetOptedIn(ctx, true); err != nil {
+	if err := k.setOptedIn(ctx, &kicker, true); err != nil {
 		return errorsmod.Wrap(err, "set opted in")
 	}
 	k.SetSequencer(ctx, kicker)
@@ -75,12 +86,34 @@ func (k Keeper) SlashLiveness(ctx sdk.Context, rollappID string) error {
 }
 
 func (k Keeper) livenessSlash(ctx sdk.Context, seq *types.Sequencer) error {
-	mul := k.GetParams(ctx).LivenessSlashMinMultiplier
-	abs := k.GetParams(ctx).LivenessSlashMinAbsolute
-	tokens := seq.TokensCoin()
-	tokensMul := ucoin.MulDec(mul, tokens)
-	amt := ucoin.SimpleMin(tokens, ucoin.SimpleMax(abs, tokensMul[0]))
-	return errorsmod.Wrap(k.slash(ctx, seq, amt, math.LegacyZeroDec(), nil), "slash")
+	amt := k.LivenessSlashAmount(ctx, *seq)
+	return errorsmod.Wrap(k.slash(ctx, seq, amt, math.LegacyZeroDec(), nil, types.HistoryLivenessSlash), "slash")
+}
+
+// LivenessSlashAmount returns what the sequencer is slashed for a liveness fault. The amount is computed on
+// the value of the bond, and taken from each denom in proportion.
+func (k Keeper) LivenessSlashAmount(ctx sdk.Context, seq types.Sequencer) sdk.Coins {
+	params := k.GetParams(ctx)
+	tokens := seq.AllTokens()
+	value := params.BondValue(tokens)
+	if !value.IsPositive() {
+		return sdk.NewCoins()
+	}
+	slashValue := math.MinInt(value, math.MaxInt(params.LivenessSlashMinAbsolute.Amount, params.LivenessSlashMinMultiplier.MulInt(value).TruncateInt()))
+	amt := sdk.NewCoins()
+	for _, c := range tokens {
+		amt = amt.Add(sdk.NewCoin(c.Denom, c.Amount.Mul(slashValue).Quo(value)))
+	}
+	return amt
+}
+
+// mulCoins multiplies each of the coins by mul, rounding down
+func mulCoins(mul math.LegacyDec, coins sdk.Coins) sdk.Coins {
+	ret := sdk.NewCoins()
+	for _, c := range coins {
+		ret = ret.Add(sdk.NewCoin(c.Denom, mul.MulInt(c.Amount).TruncateInt()))
+	}
+	return ret
 }
 
 func (k Keeper) reducePenaltyUptime(ctx sdk.Context, seq *types.Sequencer) {
@@ -112,29 +145,46 @@ func (k Keeper) PunishSequencer(ctx sdk.Context, seqAddr string, rewardee *sdk.A
 		addr = *rewardee
 	}
 
-	err = k.slash(ctx, &seq, seq.TokensCoin(), rewardMul, addr)
+	err = k.slash(ctx, &seq, seq.AllTokens(), rewardMul, addr, types.HistoryPunishment)
 	if err != nil {
 		return errorsmod.Wrap(err, "slash")
 	}
+	// tokens unbonded before the fraud was found are punished too
+	if err := k.slashUnbondings(ctx, seq.Address, rewardMul, addr); err != nil {
+		return errorsmod.Wrap(err, "slash unbondings")
+	}
 	k.SetSequencer(ctx, seq)
 	return nil
 }
 
-func (k Keeper) slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, rewardMul math.LegacyDec, rewardee sdk.AccAddress) error {
-	rewardCoin := ucoin.MulDec(rewardMul, amt)[0]
-	if !rewardCoin.IsZero() {
-		err := k.sendFromModule(ctx, seq, rewardCoin, rewardee)
+// slash slashes amt from the sequencer, of which the rewardee receives rewardMul, and records it in the
+// history of the sequencer as kind
+func (k Keeper) slash(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins, rewardMul math.LegacyDec, rewardee sdk.AccAddress, kind types.HistoryRecordKind) error {
+	slashDelegations(seq, amt)
+	reward := mulCoins(rewardMul, amt)
+	record := types.HistoryRecord{
+		Sequencer: seq.Address,
+		Kind:      kind,
+		Amount:    amt,
+		Reward:    reward,
+	}
+	if !reward.IsZero() {
+		err := k.sendFromModule(ctx, seq, reward, rewardee)
 		if err != nil {
 			return errorsmod.Wrap(err, "send")
 		}
+		record.Rewardee = rewardee.String()
+	}
+	if err := k.recordHistory(ctx, record); err != nil {
+		return errorsmod.Wrap(err, "record history")
 	}
-	remainder := amt.Sub(rewardCoin)
+	remainder := amt.Sub(reward...)
 	err := errorsmod.Wrap(k.burn(ctx, seq, remainder), "burn")
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeSlashed,
 			sdk.NewAttribute(types.AttributeKeySequencer, seq.Address),
-			sdk.NewAttribute(types.AttributeKeyRemainingAmt, seq.TokensCoin().String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingAmt, seq.AllTokens().String()),
 			sdk.NewAttribute(types.AttributeKeyAmt, amt.String()),
 		),
 	)
@@ -1,7 +1,10 @@
 package keeper
 
 import (
+	"slices"
+
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
@@ -14,13 +17,34 @@ func validBondDenom(c sdk.Coin) error {
 	return nil
 }
 
+// allowedBondDenom checks the sequencers of the rollapp can bond in the denom of c: DYM, or a denom
+// accepted by the rollapp which has a weight in the params
+func (k Keeper) allowedBondDenom(ctx sdk.Context, rollapp string, c sdk.Coin) error {
+	if validBondDenom(c) == nil {
+		return nil
+	}
+	ra := k.rollappKeeper.MustGetRollapp(ctx, rollapp)
+	if !slices.Contains(ra.SequencerBondDenoms, c.Denom) {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "not accepted by rollapp: %s", c.Denom)
+	}
+	if _, ok := k.GetParams(ctx).BondWeight(c.Denom); !ok {
+		return errorsmod.Wrapf(types.ErrInvalidDenom, "no bond weight: %s", c.Denom)
+	}
+	return nil
+}
+
+// bondValue returns the value of the coins in adym, according to the bond weights
+func (k Keeper) bondValue(ctx sdk.Context, coins sdk.Coins) math.Int {
+	return k.GetParams(ctx).BondValue(coins)
+}
+
 func (k Keeper) sufficientBond(ctx sdk.Context, rollapp string, c sdk.Coin) error {
-	if err := validBondDenom(c); err != nil {
+	if err := k.allowedBondDenom(ctx, rollapp, c); err != nil {
 		return err
 	}
 	minBond := k.rollappKeeper.MinBond(ctx, rollapp)
-	if c.IsLT(minBond) {
-		return errorsmod.Wrapf(types.ErrInsufficientBond, "min: %s: given: %s", minBond.Amount, c.Amount)
+	if v := k.bondValue(ctx, sdk.NewCoins(c)); v.LT(minBond.Amount) {
+		return errorsmod.Wrapf(types.ErrInsufficientBond, "min: %s: given: %s: value: %s", minBond.Amount, c, v)
 	}
 	return nil
 }
@@ -30,22 +54,17 @@ func (k Keeper) Kickable(ctx sdk.Context, proposer types.Sequencer) bool {
 	return !proposer.Sentinel() && kickThreshold <= proposer.GetPenalty()
 }
 
-func (k Keeper) burn(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
-	seq.SetTokensCoin(seq.TokensCoin().Sub(amt))
-	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amt))
-}
-
-// Refund reduces the sequencer token balance by amt and refunds amt to the addr
-func (k Keeper) refund(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
-	return errorsmod.Wrap(k.sendFromModule(ctx, seq, amt, seq.AccAddr()), "send tokens")
+func (k Keeper) burn(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins) error {
+	seq.SubTokens(amt)
+	return k.bankKeeper.BurnCoins(ctx, types.ModuleName, amt)
 }
 
-func (k Keeper) sendFromModule(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin, recipient sdk.AccAddress) error {
-	seq.SetTokensCoin(seq.TokensCoin().Sub(amt))
-	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(amt))
+func (k Keeper) sendFromModule(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins, recipient sdk.AccAddress) error {
+	seq.SubTokens(amt)
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amt)
 }
 
-func (k Keeper) sendToModule(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coin) error {
-	seq.SetTokensCoin(seq.TokensCoin().Add(amt))
-	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, seq.AccAddr(), types.ModuleName, sdk.NewCoins(amt))
+func (k Keeper) sendToModule(ctx sdk.Context, seq *types.Sequencer, amt sdk.Coins) error {
+	seq.AddTokens(amt)
+	return k.bankKeeper.SendCoinsFromAccountToModule(ctx, seq.AccAddr(), types.ModuleName, amt)
 }
@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) DelegationsBySequencer(c context.Context, req *types.QueryDelegationsBySequencerRequest) (*types.QueryDelegationsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ds, pageRes, err := k.GetDelegationsBySequencer(ctx, req.Sequencer, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDelegationsResponse{Delegations: ds, Pagination: pageRes}, nil
+}
+
+func (k Keeper) DelegationsByDelegator(c context.Context, req *types.QueryDelegationsByDelegatorRequest) (*types.QueryDelegationsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	ds, pageRes, err := k.GetDelegationsByDelegator(ctx, req.Delegator, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDelegationsResponse{Delegations: ds, Pagination: pageRes}, nil
+}
@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) SequencerHistory(c context.Context, req *types.QuerySequencerHistoryRequest) (*types.QuerySequencerHistoryResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records, pageRes, err := k.GetSequencerHistory(ctx, req.Sequencer, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QuerySequencerHistoryResponse{Records: records, Pagination: pageRes}, nil
+}
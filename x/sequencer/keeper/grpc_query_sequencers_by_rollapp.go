@@ -68,10 +68,14 @@ func (k Keeper) GetNextProposerByRollapp(c context.Context, req *types.QueryGetN
 	successor := k.GetSuccessor(ctx, req.RollappId)
 	inProgress := k.AwaitingLastProposerBlock(ctx, req.RollappId)
 
-	return &types.QueryGetNextProposerByRollappResponse{
+	res := &types.QueryGetNextProposerByRollappResponse{
 		NextProposerAddr:   successor.Address,
 		RotationInProgress: inProgress,
-	}, nil
+	}
+	if r, ok := k.GetPlannedRotation(ctx, req.RollappId); ok {
+		res.PlannedRotation = &r
+	}
+	return res, nil
 }
 
 func (k Keeper) Proposers(c context.Context, req *types.QueryProposersRequest) (*types.QueryProposersResponse, error) {
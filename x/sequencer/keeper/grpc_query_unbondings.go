@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k Keeper) Unbondings(c context.Context, req *types.QueryUnbondingsRequest) (*types.QueryUnbondingsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	es, pageRes, err := k.GetUnbondings(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryUnbondingsResponse{Entries: es, Pagination: pageRes}, nil
+}
+
+func (k Keeper) UnbondingsBySequencer(c context.Context, req *types.QueryUnbondingsBySequencerRequest) (*types.QueryUnbondingsResponse, error) {
+	if req == nil {
+		return nil, gerrc.ErrInvalidArgument
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	es, pageRes, err := k.GetUnbondingsBySequencer(ctx, req.Sequencer, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryUnbondingsResponse{Entries: es, Pagination: pageRes}, nil
+}
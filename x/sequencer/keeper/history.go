@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+/*
+Each sequencer has a bounded history of what it did as a proposer and what happened to it: state updates,
+liveness slashes, punishments, kicks and opt in changes. It lets rollapp owners assess sequencers, e.g. to
+decide who to whitelist or to kick. Only the latest records, up to the history limit param, are kept.
+*/
+
+// recordHistory appends the record to the history of its sequencer, pruning the records past the limit
+func (k Keeper) recordHistory(ctx sdk.Context, r types.HistoryRecord) error {
+	limit := k.GetParams(ctx).HistoryLimit
+	if limit == 0 {
+		return nil
+	}
+
+	next, err := k.historyNext.Get(ctx, r.Sequencer)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	r.Id = next
+	r.Height = ctx.BlockHeight()
+	r.Time = ctx.BlockTime()
+	if err := k.history.Set(ctx, collections.Join(r.Sequencer, r.Id), r); err != nil {
+		return err
+	}
+	if err := k.historyNext.Set(ctx, r.Sequencer, next+1); err != nil {
+		return err
+	}
+
+	if next+1 <= limit {
+		return nil
+	}
+	rng := collections.NewPrefixedPairRange[string, uint64](r.Sequencer).EndExclusive(next + 1 - limit)
+	iter, err := k.history.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	// usually only the oldest one, unless the limit was lowered
+	pruned, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range pruned {
+		if err := k.history.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetHistoryRecord stores the record as is, used by genesis
+func (k Keeper) SetHistoryRecord(ctx sdk.Context, r types.HistoryRecord) error {
+	if err := k.history.Set(ctx, collections.Join(r.Sequencer, r.Id), r); err != nil {
+		return err
+	}
+	next, err := k.historyNext.Get(ctx, r.Sequencer)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return k.historyNext.Set(ctx, r.Sequencer, max(next, r.Id+1))
+}
+
+func (k Keeper) AllHistory(ctx sdk.Context) ([]types.HistoryRecord, error) {
+	iter, err := k.history.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) GetSequencerHistory(ctx sdk.Context, seqAddr string, pageReq *query.PageRequest) ([]types.HistoryRecord, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.history, pageReq,
+		func(_ collections.Pair[string, uint64], r types.HistoryRecord) (types.HistoryRecord, error) {
+			return r, nil
+		}, collcompat.WithCollectionPaginationPairPrefix[string, uint64](seqAddr),
+	)
+}
+
+// setOptedIn changes the opt in status of the sequencer, recording the change in its history
+func (k Keeper) setOptedIn(ctx sdk.Context, seq *types.Sequencer, x bool) error {
+	changed := seq.OptedIn != x
+	if err := seq.SetOptedIn(ctx, x); err != nil {
+		return err
+	}
+	if !changed {
+		return nil
+	}
+	return errorsmod.Wrap(k.recordHistory(ctx, types.HistoryRecord{
+		Sequencer: seq.Address,
+		Kind:      types.HistoryOptInChange,
+		OptedIn:   x,
+	}), "record history")
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) TestHistory() {
+	params := s.k().GetParams(s.Ctx)
+	params.HistoryLimit = 3
+	s.k().SetParams(s.Ctx, params)
+
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+
+	s.submitAFewRollappStates(ra.RollappId)
+	livenessAmt := s.k().LivenessSlashAmount(s.Ctx, s.seq(alice))
+	s.Require().NoError(s.k().SlashLiveness(s.Ctx, ra.RollappId))
+	s.Require().NoError(s.k().SlashLiveness(s.Ctx, ra.RollappId))
+
+	res, err := s.queryClient.SequencerHistory(s.Ctx, &types.QuerySequencerHistoryRequest{Sequencer: pkAddr(alice)})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 3)
+	s.Require().Equal(types.HistoryStateUpdate, res.Records[0].Kind)
+	s.Require().Equal(uint64(1), res.Records[0].StateIndex)
+	s.Require().Equal(types.HistoryLivenessSlash, res.Records[1].Kind)
+	s.Require().True(res.Records[1].Amount.Equal(livenessAmt))
+	s.Require().Equal(s.Ctx.BlockHeight(), res.Records[1].Height)
+
+	// the oldest record is pruned
+	rewardee := pkAcc(randomTMPubKey())
+	tokens := s.seq(alice).AllTokens()
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, pkAddr(alice), &rewardee))
+
+	res, err = s.queryClient.SequencerHistory(s.Ctx, &types.QuerySequencerHistoryRequest{
+		Sequencer:  pkAddr(alice),
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), res.Pagination.Total)
+	s.Require().Len(res.Records, 2)
+	s.Require().Equal(uint64(1), res.Records[0].Id)
+	res, err = s.queryClient.SequencerHistory(s.Ctx, &types.QuerySequencerHistoryRequest{
+		Sequencer:  pkAddr(alice),
+		Pagination: &query.PageRequest{Key: res.Pagination.NextKey},
+	})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 1)
+	r := res.Records[0]
+	s.Require().Equal(uint64(3), r.Id)
+	s.Require().Equal(types.HistoryPunishment, r.Kind)
+	s.Require().True(r.Amount.Equal(tokens))
+	s.Require().Equal(rewardee.String(), r.Rewardee)
+	s.Require().False(r.Reward.IsZero())
+
+	// opting out is recorded, repeating it is not
+	_, err = s.msgServer.UpdateOptInStatus(s.Ctx, types.NewMsgUpdateOptInStatus(pkAddr(bob), false))
+	s.Require().NoError(err)
+	_, err = s.msgServer.UpdateOptInStatus(s.Ctx, types.NewMsgUpdateOptInStatus(pkAddr(bob), false))
+	s.Require().NoError(err)
+	res, err = s.queryClient.SequencerHistory(s.Ctx, &types.QuerySequencerHistoryRequest{Sequencer: pkAddr(bob)})
+	s.Require().NoError(err)
+	s.Require().Len(res.Records, 1)
+	s.Require().Equal(types.HistoryOptInChange, res.Records[0].Kind)
+	s.Require().False(res.Records[0].OptedIn)
+
+	// all the kept records are exported
+	exported, err := s.k().AllHistory(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(exported, 4)
+}
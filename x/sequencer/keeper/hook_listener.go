@@ -6,6 +6,7 @@ import (
 	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	epochstypes "github.com/osmosis-labs/osmosis/v15/x/epochs/types"
 )
 
 var _ rollapptypes.RollappHooks = rollappHook{}
@@ -28,15 +29,28 @@ func (hook rollappHook) BeforeUpdateState(ctx sdk.Context, seqAddr, rollappId st
 	}
 
 	// if lastStateUpdateBySequencer is true, validate that the sequencer is in the middle of a rotation
-	if lastStateUpdateBySequencer && !hook.k.AwaitingLastProposerBlock(ctx, rollappId) {
+	// the height of the last update of a planned rotation is checked after the update
+	if lastStateUpdateBySequencer && !hook.k.AwaitingLastProposerBlock(ctx, rollappId) && !hook.k.plannedRotationAccepted(ctx, proposer) {
 		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "sequencer is not in the middle of a rotation")
 	}
 
 	return nil
 }
 
-// AfterUpdateState checks if rotation is completed and the nextProposer is changed
+// AfterUpdateState records the update in the history of the sequencer, enforces the planned rotation, if
+// any, and checks if rotation is completed and the nextProposer is changed
 func (hook rollappHook) AfterUpdateState(ctx sdk.Context, stateInfo *rollapptypes.StateInfoMeta) error {
+	if err := hook.k.recordHistory(ctx, types.HistoryRecord{
+		Sequencer:  stateInfo.Sequencer,
+		Kind:       types.HistoryStateUpdate,
+		StateIndex: stateInfo.StateInfoIndex.Index,
+		NumBlocks:  stateInfo.NumBlocks,
+	}); err != nil {
+		return errorsmod.Wrap(err, "record history")
+	}
+	if err := hook.k.checkPlannedRotation(ctx, stateInfo); err != nil {
+		return errorsmod.Wrap(err, "check planned rotation")
+	}
 	proposer := hook.k.GetProposer(ctx, stateInfo.Rollapp)
 	return hook.k.afterStateUpdate(ctx, proposer, stateInfo.Sequencer != stateInfo.NextProposer)
 }
@@ -50,9 +64,37 @@ func (hook rollappHook) OnHardFork(ctx sdk.Context, rollappID string, _ uint64)
 		return errorsmod.Wrap(err, "opt out all sequencers")
 	}
 
+	if err := hook.k.dropPlannedRotation(ctx, rollappID, "hard fork"); err != nil {
+		return errorsmod.Wrap(err, "drop planned rotation")
+	}
+
 	// clear current proposer and successor
 	hook.k.abruptRemoveProposer(ctx, rollappID)
 	hook.k.SetSuccessor(ctx, rollappID, types.SentinelSeqAddr)
 
 	return nil
 }
+
+var _ epochstypes.EpochHooks = epochHooks{}
+
+type epochHooks struct {
+	k Keeper
+}
+
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return epochHooks{k: k}
+}
+
+// BeforeEpochStart implements the EpochHooks interface
+func (e epochHooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
+
+// AfterEpochEnd distributes the reward pools of the sequencers at the end of the rewards epoch
+func (e epochHooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier != e.k.GetParams(ctx).RewardsEpochIdentifier {
+		return nil
+	}
+	e.k.DistributeRewardPools(ctx)
+	return nil
+}
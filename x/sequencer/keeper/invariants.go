@@ -5,10 +5,8 @@ import (
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
-	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/dymensionxyz/dymension/v3/utils/uinv"
-	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
 )
 
@@ -123,7 +121,7 @@ func checkRollappStatus(ctx sdk.Context, k Keeper, ra string) error {
 	return nil
 }
 
-// module balance must correspond to sequencer stakes, and sequencer stakes should be sensible
+// module balance must correspond to sequencer stakes and unbondings, and sequencer stakes should be sensible
 func InvariantTokens(k Keeper) uinv.Func {
 	return uinv.AnyErrorIsBreaking(func(ctx sdk.Context) error {
 		var errs []error
@@ -138,21 +136,22 @@ func InvariantTokens(k Keeper) uinv.Func {
 			return err
 		}
 
-		total := sdk.NewCoin(commontypes.DYMCoin.Denom, math.ZeroInt())
+		total := sdk.NewCoins()
 		for _, seq := range k.AllSequencers(ctx) {
-			total = total.Add(seq.TokensCoin())
+			total = total.Add(seq.AllTokens()...)
+		}
+		unbondings, err := k.AllUnbondings(ctx)
+		if err != nil {
+			return errorsmod.Wrap(err, "all unbondings")
+		}
+		for _, e := range unbondings {
+			total = total.Add(e.Amount)
 		}
 		// check module balance is equal
 		moduleAcc := k.accountK.GetModuleAccount(ctx, types.ModuleName)
 		balances := k.bankKeeper.GetAllBalances(ctx, moduleAcc.GetAddress())
-		if 1 < len(balances) {
-			return errors.New("module account has more than one coin")
-		}
-		if !total.IsZero() && len(balances) == 0 {
-			return errors.New("module account has no balance")
-		}
-		if !total.IsZero() && !balances[0].IsEqual(total) {
-			return errors.New("module account balance not equal to sum of sequencer tokens")
+		if !balances.Equal(total) {
+			return errors.New("module account balance not equal to sum of sequencer tokens and unbondings")
 		}
 		return nil
 	})
@@ -165,9 +164,12 @@ func checkSeqTokens(seq types.Sequencer) error {
 	if err := validBondDenom(seq.TokensCoin()); err != nil {
 		return errorsmod.Wrap(err, "valid bond denom")
 	}
-	if seq.TokensCoin().Amount.IsNegative() {
+	if seq.TokensCoin().Amount.IsNegative() || seq.OtherTokens().IsAnyNegative() {
 		return errors.New("negative seq tokens")
 	}
+	if seq.Delegated().IsNegative() || seq.TokensCoin().Amount.LT(seq.Delegated()) {
+		return errors.New("delegated tokens not within seq tokens")
+	}
 	return nil
 }
 
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/log"
@@ -26,6 +27,21 @@ type Keeper struct {
 	hooks          types.Hooks
 
 	dymintProposerAddrToAccAddr collections.Map[[]byte, string]
+	// rollapp id -> rotation planned by its proposer
+	plannedRotations collections.Map[string, types.PlannedRotation]
+	// <sequencer, delegator> -> delegation
+	delegations collections.Map[collections.Pair[string, string], types.Delegation]
+	// <delegator, sequencer>
+	delegationsByDelegator collections.KeySet[collections.Pair[string, string]]
+	// <sequencer, id> -> history record
+	history collections.Map[collections.Pair[string, uint64], types.HistoryRecord]
+	// sequencer -> id of its next history record
+	historyNext collections.Map[string, uint64]
+	// <sequencer, id> -> unbonding
+	unbondings collections.Map[collections.Pair[string, uint64], types.UnbondingEntry]
+	// <completion time, sequencer, id>
+	unbondingQueue collections.KeySet[collections.Triple[time.Time, string, uint64]]
+	unbondingID    collections.Sequence
 }
 
 func NewKeeper(
@@ -59,6 +75,54 @@ func NewKeeper(
 			collections.BytesKey,
 			collections.StringValue,
 		),
+		plannedRotations: collections.NewMap(
+			sb,
+			types.PlannedRotationKeyPrefix,
+			"plannedRotations",
+			collections.StringKey,
+			codec.CollValue[types.PlannedRotation](cdc),
+		),
+		delegations: collections.NewMap(
+			sb,
+			types.DelegationsKeyPrefix,
+			"delegations",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.Delegation](cdc),
+		),
+		delegationsByDelegator: collections.NewKeySet(
+			sb,
+			types.DelegationsByDelegatorKeyPrefix,
+			"delegationsByDelegator",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+		),
+		history: collections.NewMap(
+			sb,
+			types.HistoryKeyPrefix,
+			"history",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.HistoryRecord](cdc),
+		),
+		historyNext: collections.NewMap(
+			sb,
+			types.HistoryNextKeyPrefix,
+			"historyNext",
+			collections.StringKey,
+			collections.Uint64Value,
+		),
+		unbondings: collections.NewMap(
+			sb,
+			types.UnbondingsKeyPrefix,
+			"unbondings",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.UnbondingEntry](cdc),
+		),
+		unbondingQueue: collections.NewKeySet(
+			sb,
+			types.UnbondingQueueKeyPrefix,
+			"unbondingQueue",
+			collections.TripleKeyCodec(sdk.TimeKey, collections.StringKey, collections.Uint64Key),
+		),
+		unbondingID: collections.NewSequence(sb, types.UnbondingIDKey, "unbondingID"),
 	}
 }
 
@@ -18,12 +18,12 @@ func (k msgServer) IncreaseBond(goCtx context.Context, msg *types.MsgIncreaseBon
 		return nil, err
 	}
 
-	if err := validBondDenom(msg.AddAmount); err != nil {
+	if err := k.allowedBondDenom(ctx, seq.RollappId, msg.AddAmount); err != nil {
 		return nil, err
 	}
 
 	// charge the user and modify the sequencer object
-	if err := k.sendToModule(ctx, &seq, msg.AddAmount); err != nil {
+	if err := k.sendToModule(ctx, &seq, sdk.NewCoins(msg.AddAmount)); err != nil {
 		return nil, err
 	}
 	k.SetSequencer(ctx, seq)
@@ -32,7 +32,7 @@ func (k msgServer) IncreaseBond(goCtx context.Context, msg *types.MsgIncreaseBon
 	return &types.MsgIncreaseBondResponse{}, uevent.EmitTypedEvent(ctx,
 		&types.EventIncreasedBond{
 			Sequencer:   msg.Creator,
-			Bond:        seq.Tokens,
+			Bond:        seq.AllTokens(),
 			AddedAmount: msg.AddAmount,
 		},
 	)
@@ -46,12 +46,12 @@ func (k msgServer) DecreaseBond(goCtx context.Context, msg *types.MsgDecreaseBon
 		return nil, err
 	}
 
-	if err := k.TryUnbond(ctx, &seq, msg.GetDecreaseAmount()); err != nil {
+	if err := k.TryUnbond(ctx, &seq, sdk.NewCoins(msg.GetDecreaseAmount())); err != nil {
 		return nil, errorsmod.Wrap(err, "try unbond")
 	}
 	k.SetSequencer(ctx, seq)
 
-	return &types.MsgDecreaseBondResponse{}, nil
+	return &types.MsgDecreaseBondResponse{CompletionTime: k.UnbondingCompletionTime(ctx)}, nil
 }
 
 func (k msgServer) Unbond(goCtx context.Context, msg *types.MsgUnbond) (*types.MsgUnbondResponse, error) {
@@ -69,7 +69,7 @@ func (k msgServer) Unbond(goCtx context.Context, msg *types.MsgUnbond) (*types.M
 	}
 
 	// ensures they will not get chosen as their own successor!
-	if err := seq.SetOptedIn(ctx, false); err != nil {
+	if err := k.setOptedIn(ctx, &seq, false); err != nil {
 		return nil, err
 	}
 
@@ -82,6 +82,9 @@ func (k msgServer) Unbond(goCtx context.Context, msg *types.MsgUnbond) (*types.M
 		if seq.NoticeInProgress(ctx.BlockTime()) {
 			return nil, gerrc.ErrFailedPrecondition.Wrap("notice period in progress")
 		}
+		if _, ok := k.GetPlannedRotation(ctx, seq.RollappId); ok {
+			return nil, gerrc.ErrFailedPrecondition.Wrap("planned rotation in progress")
+		}
 
 		k.StartNoticePeriod(ctx, &seq)
 		k.SetSequencer(ctx, seq)
@@ -93,11 +96,16 @@ func (k msgServer) Unbond(goCtx context.Context, msg *types.MsgUnbond) (*types.M
 
 	}
 
-	err = k.TryUnbond(ctx, &seq, seq.TokensCoin())
+	err = k.TryUnbond(ctx, &seq, seq.SelfBond())
 	if err != nil {
 		return nil, errorsmod.Wrap(err, "try unbond")
 	}
 	k.SetSequencer(ctx, seq)
 
-	return &types.MsgUnbondResponse{}, nil
+	completion := k.UnbondingCompletionTime(ctx)
+	return &types.MsgUnbondResponse{
+		CompletionTime: &types.MsgUnbondResponse_UnbondingCompletionTime{
+			UnbondingCompletionTime: &completion,
+		},
+	}, nil
 }
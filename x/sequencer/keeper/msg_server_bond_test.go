@@ -66,6 +66,7 @@ func (s *SequencerTestSuite) TestDecreaseBondBasic() {
 	ra := s.createRollapp()
 	expect := ucoin.SimpleMul(bond, 10) // plenty
 	seq := s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, expect)
+	initial := expect
 	m := &types.MsgDecreaseBond{
 		Creator:        seq.Address,
 		DecreaseAmount: bond,
@@ -77,8 +78,13 @@ func (s *SequencerTestSuite) TestDecreaseBondBasic() {
 		expect = expect.Sub(bond)
 		seq = s.k().GetSequencer(s.Ctx, seq.Address)
 		s.Require().True(expect.Equal(seq.TokensCoin()))
-		s.Require().True(expect.Equal(s.moduleBalance()))
+		// still held until the unbonding completes
+		s.Require().True(initial.Equal(s.moduleBalance()))
 	}
+	s.completeUnbondings()
+	s.Require().True(expect.Equal(s.moduleBalance()))
+	refunded := s.App.BankKeeper.GetBalance(s.Ctx, seq.AccAddr(), bond.Denom)
+	s.Require().True(ucoin.SimpleMul(bond, 2).IsEqual(refunded))
 }
 
 func (s *SequencerTestSuite) TestDecreaseBondRestrictions() {
@@ -140,8 +146,10 @@ func (s *SequencerTestSuite) TestUnbondBasic() {
 	s.Require().NoError(err)
 	seq = s.k().GetSequencer(s.Ctx, seq.Address)
 	s.Require().Equal(types.Unbonded, seq.Status)
-	s.Require().True(s.moduleBalance().IsZero())
 	s.Require().True(seq.TokensCoin().IsZero())
+	s.Require().True(s.moduleBalance().IsEqual(bond))
+	s.completeUnbondings()
+	s.Require().True(s.moduleBalance().IsZero())
 }
 
 func (s *SequencerTestSuite) TestUnbondRestrictions() {
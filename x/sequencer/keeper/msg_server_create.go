@@ -88,7 +88,7 @@ func (k msgServer) CreateSequencer(goCtx context.Context, msg *types.MsgCreateSe
 	seq.OptedIn = true
 	seq.SetWhitelistedRelayers(msg.WhitelistedRelayers)
 
-	if err := k.sendToModule(ctx, seq, msg.Bond); err != nil {
+	if err := k.sendToModule(ctx, seq, sdk.NewCoins(msg.Bond)); err != nil {
 		return nil, err
 	}
 
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k msgServer) Delegate(goCtx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.Delegate(ctx, &seq, sdk.MustAccAddressFromBech32(msg.Delegator), msg.Amount); err != nil {
+		return nil, err
+	}
+	k.SetSequencer(ctx, seq)
+	return &types.MsgDelegateResponse{}, nil
+}
+
+func (k msgServer) Undelegate(goCtx context.Context, msg *types.MsgUndelegate) (*types.MsgUndelegateResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.Undelegate(ctx, &seq, sdk.MustAccAddressFromBech32(msg.Delegator), msg.Amount); err != nil {
+		return nil, err
+	}
+	k.SetSequencer(ctx, seq)
+	return &types.MsgUndelegateResponse{CompletionTime: k.UnbondingCompletionTime(ctx)}, nil
+}
+
+func (k msgServer) DistributeRewards(goCtx context.Context, msg *types.MsgDistributeRewards) (*types.MsgDistributeRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	seq, err := k.RealSequencer(ctx, msg.Sequencer)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.DistributeRewards(ctx, seq, sdk.MustAccAddressFromBech32(msg.Creator), msg.Amount); err != nil {
+		return nil, err
+	}
+	return &types.MsgDistributeRewardsResponse{}, nil
+}
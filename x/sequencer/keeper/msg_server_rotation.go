@@ -0,0 +1,45 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (k msgServer) ScheduleRotation(goCtx context.Context, msg *types.MsgScheduleRotation) (*types.MsgScheduleRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prop, err := k.RealSequencer(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.ScheduleRotation(ctx, prop, msg.Successor, msg.HandoverHeight); err != nil {
+		return nil, err
+	}
+	return &types.MsgScheduleRotationResponse{}, nil
+}
+
+func (k msgServer) AcceptRotation(goCtx context.Context, msg *types.MsgAcceptRotation) (*types.MsgAcceptRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	successor, err := k.RealSequencer(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.AcceptRotation(ctx, successor); err != nil {
+		return nil, err
+	}
+	return &types.MsgAcceptRotationResponse{}, nil
+}
+
+func (k msgServer) CancelRotation(goCtx context.Context, msg *types.MsgCancelRotation) (*types.MsgCancelRotationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	prop, err := k.RealSequencer(ctx, msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.Keeper.CancelRotation(ctx, prop); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelRotationResponse{}, nil
+}
@@ -56,7 +56,7 @@ func (k msgServer) UpdateOptInStatus(goCtx context.Context,
 sequencers can only be proposer at most once`)
 	}
 
-	if err := seq.SetOptedIn(ctx, msg.OptedIn); err != nil {
+	if err := k.setOptedIn(ctx, &seq, msg.OptedIn); err != nil {
 		return nil, err
 	}
 	k.SetSequencer(ctx, seq)
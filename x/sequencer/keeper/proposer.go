@@ -36,7 +36,7 @@ func (k Keeper) abruptRemoveProposer(ctx sdk.Context, rollapp string) {
 func (k Keeper) optOutAllSequencers(ctx sdk.Context, rollapp string) error {
 	seqs := k.RollappSequencers(ctx, rollapp)
 	for _, seq := range seqs {
-		if err := seq.SetOptedIn(ctx, false); err != nil {
+		if err := k.setOptedIn(ctx, &seq, false); err != nil {
 			return errorsmod.Wrap(err, "set opted in")
 		}
 		k.SetSequencer(ctx, seq)
@@ -44,10 +44,17 @@ func (k Keeper) optOutAllSequencers(ctx sdk.Context, rollapp string) error {
 	return nil
 }
 
+// isPotentialProposer returns whether the sequencer can be chosen as proposer: bonded, opted in, and with a
+// bond worth the min bond, which it may no longer have once its delegators withdrew
+func (k Keeper) isPotentialProposer(ctx sdk.Context, seq types.Sequencer) bool {
+	minBond := k.rollappKeeper.MinBond(ctx, seq.RollappId)
+	return seq.IsPotentialProposer() && k.bondValue(ctx, seq.AllTokens()).GTE(minBond.Amount)
+}
+
 func (k Keeper) RollappPotentialProposers(ctx sdk.Context, rollappId string) []types.Sequencer {
 	seqs := k.RollappBondedSequencers(ctx, rollappId)
 	seqs = slices.DeleteFunc(seqs, func(seq types.Sequencer) bool {
-		return !seq.IsPotentialProposer()
+		return !k.isPotentialProposer(ctx, seq)
 	})
 	return append(seqs, k.SentinelSequencer(ctx))
 }
@@ -62,7 +69,7 @@ func (k Keeper) ChooseProposerAfterSentinel(ctx sdk.Context, rollapp string) err
 		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "proposer is not sentinel")
 	}
 
-	successor, err := ProposerChoiceAlgo(k.RollappPotentialProposers(ctx, rollapp))
+	successor, err := ProposerChoiceAlgo(k.RollappPotentialProposers(ctx, rollapp), k.GetParams(ctx))
 	if err != nil {
 		return err
 	}
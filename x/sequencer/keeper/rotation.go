@@ -55,7 +55,7 @@ func (k Keeper) ChooseSuccessorForFinishedNotices(ctx sdk.Context, now time.Time
 				types.EventTypeRotationStarted,
 				sdk.NewAttribute(types.AttributeKeyRollappId, seq.RollappId),
 				sdk.NewAttribute(types.AttributeKeyNextProposer, successor.Address),
-				sdk.NewAttribute(types.AttributeKeyRewardAddr, successor.RewardAddr),
+				sdk.NewAttribute(types.AttributeKeyRewardAddr, successor.RewardRecipient()),
 				sdk.NewAttribute(types.AttributeKeyWhitelistedRelayers, strings.Join(successor.WhitelistedRelayers, ",")),
 			),
 		)
@@ -74,9 +74,10 @@ func (k Keeper) AwaitingLastProposerBlock(ctx sdk.Context, rollapp string) bool
 }
 
 // OnProposerLastBlock : it will assign the successor to be the proposer.
+// The proposer must have finished their notice period, or have a planned rotation accepted by the successor.
 // Contract: must be called after ChooseSuccessorForFinishedNotices for a given block time
 func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) error {
-	allowLastBlock := proposer.NoticeElapsed(ctx.BlockTime())
+	allowLastBlock := proposer.NoticeElapsed(ctx.BlockTime()) || k.plannedRotationAccepted(ctx, proposer)
 	if !allowLastBlock {
 		return errorsmod.Wrap(gerrc.ErrFault, "sequencer has submitted last block without finishing notice period")
 	}
@@ -86,6 +87,9 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 	successor := k.GetSuccessor(ctx, rollapp)
 	k.SetSuccessor(ctx, rollapp, types.SentinelSeqAddr) // clear successor
 	k.SetProposer(ctx, rollapp, successor.Address)
+	if err := k.plannedRotations.Remove(ctx, rollapp); err != nil {
+		return errorsmod.Wrap(err, "remove planned rotation")
+	}
 
 	// if successor is sentinel, prepare new revision for the rollapp
 	if successor.Sentinel() {
@@ -112,7 +116,7 @@ func (k Keeper) OnProposerLastBlock(ctx sdk.Context, proposer types.Sequencer) e
 // called when a proposer has finished their notice period.
 func (k Keeper) setSuccessorForRotatingRollapp(ctx sdk.Context, rollapp string) error {
 	seqs := k.RollappPotentialProposers(ctx, rollapp)
-	successor, err := ProposerChoiceAlgo(seqs)
+	successor, err := ProposerChoiceAlgo(seqs, k.GetParams(ctx))
 	if err != nil {
 		return err
 	}
@@ -120,22 +124,22 @@ func (k Keeper) setSuccessorForRotatingRollapp(ctx sdk.Context, rollapp string)
 	return nil
 }
 
-// ProposerChoiceAlgo : choose the one with most bond
+// ProposerChoiceAlgo : choose the one with most bond, by value according to the bond weights of the params
 // Requires sentinel to be passed in, as last resort.
-func ProposerChoiceAlgo(seqs []types.Sequencer) (types.Sequencer, error) {
+func ProposerChoiceAlgo(seqs []types.Sequencer, params types.Params) (types.Sequencer, error) {
 	if len(seqs) == 0 {
 		return types.Sequencer{}, gerrc.ErrInternal.Wrap("seqs must at least include sentinel")
 	}
 	// slices package is recommended over sort package
 	slices.SortStableFunc(seqs, func(a, b types.Sequencer) int {
-		ca := a.TokensCoin()
-		cb := b.TokensCoin()
-		if ca.IsEqual(cb) {
+		va := params.BondValue(a.AllTokens())
+		vb := params.BondValue(b.AllTokens())
+		if va.Equal(vb) {
 			return 0
 		}
 
 		// flipped to sort decreasing
-		if ca.IsLT(cb) {
+		if va.LT(vb) {
 			return 1
 		}
 		return -1
@@ -0,0 +1,179 @@
+package keeper
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+// A planned rotation is an alternative to the notice period: the proposer names a successor and a rollapp
+// handover height, and the successor commits to take over by accepting. From then on the successor is the
+// successor of the rollapp, and the proposer must submit its last state update ending at the block right
+// before the handover height. A rotation which is not accepted by then is dropped.
+
+func (k Keeper) GetPlannedRotation(ctx sdk.Context, rollapp string) (types.PlannedRotation, bool) {
+	r, err := k.plannedRotations.Get(ctx, rollapp)
+	if err != nil {
+		return types.PlannedRotation{}, false
+	}
+	return r, true
+}
+
+func (k Keeper) SetPlannedRotation(ctx sdk.Context, r types.PlannedRotation) error {
+	return k.plannedRotations.Set(ctx, r.RollappId, r)
+}
+
+func (k Keeper) AllPlannedRotations(ctx sdk.Context) ([]types.PlannedRotation, error) {
+	iter, err := k.plannedRotations.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+// ScheduleRotation plans the handover of the rollapp of the proposer to the successor, from the rollapp
+// height handover onwards.
+func (k Keeper) ScheduleRotation(ctx sdk.Context, prop types.Sequencer, successorAddr string, handover uint64) error {
+	ra := prop.RollappId
+	if !k.IsProposer(ctx, prop) {
+		return types.ErrNotProposer
+	}
+	if k.RotationInProgress(ctx, ra) {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "notice period rotation in progress")
+	}
+	if _, ok := k.GetPlannedRotation(ctx, ra); ok {
+		return errorsmod.Wrap(gerrc.ErrAlreadyExists, "planned rotation")
+	}
+
+	successor, err := k.RealSequencer(ctx, successorAddr)
+	if err != nil {
+		return errorsmod.Wrap(err, "successor")
+	}
+	if successor.RollappId != ra {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "successor belongs to another rollapp")
+	}
+	if successor.Address == prop.Address {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "successor is the proposer")
+	}
+	if !k.isPotentialProposer(ctx, successor) {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "successor is not a potential proposer")
+	}
+
+	// the block before the handover is to be submitted in the last state update of the proposer
+	latest, _ := k.rollappKeeper.GetLatestHeight(ctx, ra)
+	if handover <= latest+1 {
+		return errorsmod.Wrapf(gerrc.ErrOutOfRange, "handover height must be above: %d", latest+1)
+	}
+
+	r := types.PlannedRotation{
+		RollappId:      ra,
+		Proposer:       prop.Address,
+		Successor:      successor.Address,
+		HandoverHeight: handover,
+		ScheduledAt:    ctx.BlockTime(),
+	}
+	if err := k.SetPlannedRotation(ctx, r); err != nil {
+		return errorsmod.Wrap(err, "set planned rotation")
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventRotationScheduled{Rotation: r}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+// AcceptRotation commits the successor to the rotation planned to it, making it the successor of the
+// rollapp.
+func (k Keeper) AcceptRotation(ctx sdk.Context, successor types.Sequencer) error {
+	r, ok := k.GetPlannedRotation(ctx, successor.RollappId)
+	if !ok || r.Successor != successor.Address {
+		return types.ErrNoPlannedRotation
+	}
+	if r.Accepted {
+		return errorsmod.Wrap(gerrc.ErrAlreadyExists, "rotation already accepted")
+	}
+	if !k.isPotentialProposer(ctx, successor) {
+		return errorsmod.Wrap(gerrc.ErrFailedPrecondition, "successor is not a potential proposer")
+	}
+
+	r.Accepted = true
+	if err := k.SetPlannedRotation(ctx, r); err != nil {
+		return errorsmod.Wrap(err, "set planned rotation")
+	}
+	k.SetSuccessor(ctx, r.RollappId, successor.Address)
+
+	if err := uevent.EmitTypedEvent(ctx, &types.EventRotationAccepted{Rotation: r}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+// CancelRotation drops the rotation planned by the proposer, accepted or not
+func (k Keeper) CancelRotation(ctx sdk.Context, prop types.Sequencer) error {
+	r, ok := k.GetPlannedRotation(ctx, prop.RollappId)
+	if !ok || r.Proposer != prop.Address {
+		return types.ErrNoPlannedRotation
+	}
+	return k.dropPlannedRotation(ctx, prop.RollappId, "cancelled by proposer")
+}
+
+// dropPlannedRotation removes the planned rotation of the rollapp, if any. The successor which accepted it
+// is no longer the successor.
+func (k Keeper) dropPlannedRotation(ctx sdk.Context, rollapp, reason string) error {
+	r, ok := k.GetPlannedRotation(ctx, rollapp)
+	if !ok {
+		return nil
+	}
+	if err := k.plannedRotations.Remove(ctx, rollapp); err != nil {
+		return errorsmod.Wrap(err, "remove planned rotation")
+	}
+	if r.Accepted && k.GetSuccessor(ctx, rollapp).Address == r.Successor {
+		k.SetSuccessor(ctx, rollapp, types.SentinelSeqAddr)
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventRotationCancelled{Rotation: r, Reason: reason}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+// plannedRotationAccepted returns true if the proposer has a planned rotation which was accepted
+func (k Keeper) plannedRotationAccepted(ctx sdk.Context, prop types.Sequencer) bool {
+	r, ok := k.GetPlannedRotation(ctx, prop.RollappId)
+	return ok && r.Accepted && r.Proposer == prop.Address
+}
+
+// checkPlannedRotation enforces the planned rotation of the rollapp, if any, on a new state update. Once
+// accepted, the state updates may not go past the handover height, and the one ending at the block before
+// it must be the last of the proposer and name the successor as next proposer. A rotation which is not
+// accepted by the time that block is submitted is dropped.
+func (k Keeper) checkPlannedRotation(ctx sdk.Context, info *rollapptypes.StateInfoMeta) error {
+	r, ok := k.GetPlannedRotation(ctx, info.Rollapp)
+	if !ok {
+		return nil
+	}
+	end := info.GetLatestHeight()
+	before := r.HandoverHeight - 1
+
+	if !r.Accepted {
+		if before <= end {
+			return k.dropPlannedRotation(ctx, info.Rollapp, "not accepted before handover")
+		}
+		return nil
+	}
+
+	last := info.Sequencer != info.NextProposer
+	switch {
+	case before < end:
+		return errorsmod.Wrapf(types.ErrPlannedRotationViolated, "state goes past the handover height: %d", r.HandoverHeight)
+	case end == before && info.NextProposer != r.Successor:
+		return errorsmod.Wrapf(types.ErrPlannedRotationViolated, "last state before handover must name the successor: %s", r.Successor)
+	case end < before && last:
+		return errorsmod.Wrapf(types.ErrPlannedRotationViolated, "last state must end at the height before handover: %d", before)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package keeper_test
+
+import (
+	"time"
+
+	rollappkeeper "github.com/dymensionxyz/dymension/v3/x/rollapp/keeper"
+	rollapptypes "github.com/dymensionxyz/dymension/v3/x/rollapp/types"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+	"github.com/dymensionxyz/sdk-utils/utils/utest"
+)
+
+// updateState submits the state update of the blocks [start, end], and only persists it if it succeeds
+func (s *SequencerTestSuite) updateState(rollapp, seqAddr string, start, end uint64, last bool) error {
+	var bds rollapptypes.BlockDescriptors
+	for h := start; h <= end; h++ {
+		bds.BD = append(bds.BD, rollapptypes.BlockDescriptor{Height: h, Timestamp: time.Now().UTC(), DrsVersion: 1})
+	}
+	ctx, write := s.Ctx.CacheContext()
+	_, err := rollappkeeper.NewMsgServerImpl(s.raK()).UpdateState(ctx, &rollapptypes.MsgUpdateState{
+		Creator:     seqAddr,
+		RollappId:   rollapp,
+		StartHeight: start,
+		NumBlocks:   end - start + 1,
+		BDs:         bds,
+		Last:        last,
+	})
+	if err == nil {
+		write()
+	}
+	return err
+}
+
+func (s *SequencerTestSuite) TestPlannedRotationHappyFlow() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.submitAFewRollappStates(ra.RollappId)
+	latest, _ := s.raK().GetLatestHeight(s.Ctx, ra.RollappId)
+	handover := latest + 21
+
+	// the handover must leave room for the last state update of the proposer
+	_, err := s.msgServer.ScheduleRotation(s.Ctx, types.NewMsgScheduleRotation(pkAddr(alice), pkAddr(bob), latest+1))
+	utest.IsErr(s.Require(), err, gerrc.ErrOutOfRange)
+	// only the proposer can schedule
+	_, err = s.msgServer.ScheduleRotation(s.Ctx, types.NewMsgScheduleRotation(pkAddr(bob), pkAddr(alice), handover))
+	utest.IsErr(s.Require(), err, types.ErrNotProposer)
+
+	_, err = s.msgServer.ScheduleRotation(s.Ctx, types.NewMsgScheduleRotation(pkAddr(alice), pkAddr(bob), handover))
+	s.Require().NoError(err)
+
+	res, err := s.queryClient.GetNextProposerByRollapp(s.Ctx, &types.QueryGetNextProposerByRollappRequest{RollappId: ra.RollappId})
+	s.Require().NoError(err)
+	s.Require().NotNil(res.PlannedRotation)
+	s.Require().Equal(handover, res.PlannedRotation.HandoverHeight)
+	s.Require().False(res.PlannedRotation.Accepted)
+	s.Require().Equal(types.SentinelSeqAddr, res.NextProposerAddr)
+
+	// the proposer cannot start a notice period rotation on top
+	_, err = s.msgServer.Unbond(s.Ctx, &types.MsgUnbond{Creator: pkAddr(alice)})
+	utest.IsErr(s.Require(), err, gerrc.ErrFailedPrecondition)
+
+	// nor submit its last state before the successor accepted
+	err = s.updateState(ra.RollappId, pkAddr(alice), latest+1, handover-1, true)
+	utest.IsErr(s.Require(), err, gerrc.ErrInvalidArgument)
+
+	_, err = s.msgServer.AcceptRotation(s.Ctx, types.NewMsgAcceptRotation(pkAddr(bob)))
+	s.Require().NoError(err)
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.seq(bob)))
+	_, err = s.msgServer.AcceptRotation(s.Ctx, types.NewMsgAcceptRotation(pkAddr(bob)))
+	utest.IsErr(s.Require(), err, gerrc.ErrAlreadyExists)
+
+	res, err = s.queryClient.GetNextProposerByRollapp(s.Ctx, &types.QueryGetNextProposerByRollappRequest{RollappId: ra.RollappId})
+	s.Require().NoError(err)
+	s.Require().True(res.PlannedRotation.Accepted)
+	s.Require().Equal(pkAddr(bob), res.NextProposerAddr)
+
+	// going past the handover
+	err = s.updateState(ra.RollappId, pkAddr(alice), latest+1, handover, true)
+	utest.IsErr(s.Require(), err, types.ErrPlannedRotationViolated)
+	// last before the handover
+	err = s.updateState(ra.RollappId, pkAddr(alice), latest+1, handover-2, true)
+	utest.IsErr(s.Require(), err, types.ErrPlannedRotationViolated)
+	// reaching the handover without naming the successor
+	err = s.updateState(ra.RollappId, pkAddr(alice), latest+1, handover-1, false)
+	utest.IsErr(s.Require(), err, types.ErrPlannedRotationViolated)
+
+	err = s.updateState(ra.RollappId, pkAddr(alice), latest+1, latest+10, false)
+	s.Require().NoError(err)
+	err = s.updateState(ra.RollappId, pkAddr(alice), latest+11, handover-1, true)
+	s.Require().NoError(err)
+
+	info, ok := s.raK().GetLatestStateInfo(s.Ctx, ra.RollappId)
+	s.Require().True(ok)
+	s.Require().Equal(pkAddr(bob), info.NextProposer)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(bob)))
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.k().SentinelSequencer(s.Ctx)))
+	_, ok = s.k().GetPlannedRotation(s.Ctx, ra.RollappId)
+	s.Require().False(ok)
+
+	// the successor carries on from the handover height
+	err = s.updateState(ra.RollappId, pkAddr(bob), handover, handover+5, false)
+	s.Require().NoError(err)
+}
+
+func (s *SequencerTestSuite) TestPlannedRotationNotAccepted() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.submitAFewRollappStates(ra.RollappId)
+	latest, _ := s.raK().GetLatestHeight(s.Ctx, ra.RollappId)
+	handover := latest + 11
+
+	_, err := s.msgServer.ScheduleRotation(s.Ctx, types.NewMsgScheduleRotation(pkAddr(alice), pkAddr(bob), handover))
+	s.Require().NoError(err)
+
+	// the rotation is dropped once the proposer reaches the handover
+	err = s.updateState(ra.RollappId, pkAddr(alice), latest+1, handover+5, false)
+	s.Require().NoError(err)
+	_, ok := s.k().GetPlannedRotation(s.Ctx, ra.RollappId)
+	s.Require().False(ok)
+	s.Require().True(s.k().IsProposer(s.Ctx, s.seq(alice)))
+
+	_, err = s.msgServer.AcceptRotation(s.Ctx, types.NewMsgAcceptRotation(pkAddr(bob)))
+	utest.IsErr(s.Require(), err, types.ErrNoPlannedRotation)
+}
+
+func (s *SequencerTestSuite) TestPlannedRotationCancel() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, bond)
+	s.submitAFewRollappStates(ra.RollappId)
+	latest, _ := s.raK().GetLatestHeight(s.Ctx, ra.RollappId)
+
+	_, err := s.msgServer.ScheduleRotation(s.Ctx, types.NewMsgScheduleRotation(pkAddr(alice), pkAddr(bob), latest+11))
+	s.Require().NoError(err)
+	_, err = s.msgServer.ScheduleRotation(s.Ctx, types.NewMsgScheduleRotation(pkAddr(alice), pkAddr(bob), latest+12))
+	utest.IsErr(s.Require(), err, gerrc.ErrAlreadyExists)
+	_, err = s.msgServer.AcceptRotation(s.Ctx, types.NewMsgAcceptRotation(pkAddr(bob)))
+	s.Require().NoError(err)
+
+	// the successor is committed, only the proposer can cancel
+	_, err = s.msgServer.CancelRotation(s.Ctx, types.NewMsgCancelRotation(pkAddr(bob)))
+	utest.IsErr(s.Require(), err, types.ErrNoPlannedRotation)
+	_, err = s.msgServer.CancelRotation(s.Ctx, types.NewMsgCancelRotation(pkAddr(alice)))
+	s.Require().NoError(err)
+
+	s.Require().True(s.k().IsSuccessor(s.Ctx, s.k().SentinelSequencer(s.Ctx)))
+	err = s.updateState(ra.RollappId, pkAddr(alice), latest+1, latest+10, true)
+	utest.IsErr(s.Require(), err, gerrc.ErrInvalidArgument)
+	err = s.updateState(ra.RollappId, pkAddr(alice), latest+1, latest+20, false)
+	s.Require().NoError(err)
+}
@@ -0,0 +1,177 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+	"github.com/osmosis-labs/osmosis/v15/osmoutils"
+
+	"github.com/dymensionxyz/dymension/v3/internal/collcompat"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+/*
+Tokens unbonded from a sequencer, by the sequencer itself or by its delegators, are not released right away.
+They wait in the unbonding queue for the unbonding time, during which they stay in the module account and are
+still slashed if fraud of the sequencer is found. Otherwise a sequencer could escape punishment by decreasing
+its bond ahead of the fraud proposal.
+*/
+
+// UnbondingCompletionTime returns when tokens unbonded now are released
+func (k Keeper) UnbondingCompletionTime(ctx sdk.Context) time.Time {
+	return ctx.BlockTime().Add(k.GetParams(ctx).UnbondingTime)
+}
+
+// startUnbonding removes amt from the bond of the sequencer and queues it for release to the recipient
+func (k Keeper) startUnbonding(ctx sdk.Context, seq *types.Sequencer, recipient sdk.AccAddress, amt sdk.Coin) error {
+	id, err := k.unbondingID.Next(ctx)
+	if err != nil {
+		return errorsmod.Wrap(err, "next unbonding id")
+	}
+	seq.SubTokens(sdk.NewCoins(amt))
+	e := types.UnbondingEntry{
+		Id:             id,
+		Sequencer:      seq.Address,
+		Recipient:      recipient.String(),
+		Amount:         amt,
+		CreationHeight: ctx.BlockHeight(),
+		CompletionTime: k.UnbondingCompletionTime(ctx),
+	}
+	if err := k.SetUnbonding(ctx, e); err != nil {
+		return errorsmod.Wrap(err, "set unbonding")
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventUnbondingStarted{Entry: e}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+func (k Keeper) SetUnbonding(ctx sdk.Context, e types.UnbondingEntry) error {
+	if err := k.unbondings.Set(ctx, collections.Join(e.Sequencer, e.Id), e); err != nil {
+		return err
+	}
+	return k.unbondingQueue.Set(ctx, collections.Join3(e.CompletionTime, e.Sequencer, e.Id))
+}
+
+func (k Keeper) removeUnbonding(ctx sdk.Context, e types.UnbondingEntry) error {
+	if err := k.unbondings.Remove(ctx, collections.Join(e.Sequencer, e.Id)); err != nil {
+		return err
+	}
+	return k.unbondingQueue.Remove(ctx, collections.Join3(e.CompletionTime, e.Sequencer, e.Id))
+}
+
+// SetUnbondingID sets the id of the next unbonding, used by genesis
+func (k Keeper) SetUnbondingID(ctx sdk.Context, id uint64) error {
+	return k.unbondingID.Set(ctx, id)
+}
+
+func (k Keeper) AllUnbondings(ctx sdk.Context) ([]types.UnbondingEntry, error) {
+	iter, err := k.unbondings.Iterate(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) sequencerUnbondings(ctx sdk.Context, seqAddr string) ([]types.UnbondingEntry, error) {
+	iter, err := k.unbondings.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](seqAddr))
+	if err != nil {
+		return nil, err
+	}
+	return iter.Values()
+}
+
+func (k Keeper) GetUnbondings(ctx sdk.Context, pageReq *query.PageRequest) ([]types.UnbondingEntry, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.unbondingQueue, pageReq,
+		func(key collections.Triple[time.Time, string, uint64], _ collections.NoValue) (types.UnbondingEntry, error) {
+			return k.unbondings.Get(ctx, collections.Join(key.K2(), key.K3()))
+		},
+	)
+}
+
+func (k Keeper) GetUnbondingsBySequencer(ctx sdk.Context, seqAddr string, pageReq *query.PageRequest) ([]types.UnbondingEntry, *query.PageResponse, error) {
+	return collcompat.CollectionPaginate(ctx, k.unbondings, pageReq,
+		func(_ collections.Pair[string, uint64], e types.UnbondingEntry) (types.UnbondingEntry, error) {
+			return e, nil
+		}, collcompat.WithCollectionPaginationPairPrefix[string, uint64](seqAddr),
+	)
+}
+
+// CompleteUnbondings releases the unbondings whose completion time is reached. Called every block.
+// An unbonding which fails to be released is left in the queue, to be retried on the next block, and does not
+// prevent the others from being released.
+func (k Keeper) CompleteUnbondings(ctx sdk.Context) error {
+	rng := collections.NewPrefixUntilTripleRange[time.Time, string, uint64](ctx.BlockTime())
+	iter, err := k.unbondingQueue.Iterate(ctx, rng)
+	if err != nil {
+		return err
+	}
+	keys, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(ctx sdk.Context) error {
+			return k.completeUnbonding(ctx, key.K2(), key.K3())
+		})
+		if err != nil {
+			k.Logger(ctx).Error("Complete unbonding.", "sequencer", key.K2(), "id", key.K3(), "err", err)
+		}
+	}
+	return nil
+}
+
+func (k Keeper) completeUnbonding(ctx sdk.Context, seqAddr string, id uint64) error {
+	e, err := k.unbondings.Get(ctx, collections.Join(seqAddr, id))
+	if err != nil {
+		return errorsmod.Wrapf(err, "get unbonding: %d", id)
+	}
+	if err := k.removeUnbonding(ctx, e); err != nil {
+		return errorsmod.Wrap(err, "remove unbonding")
+	}
+	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.MustAccAddressFromBech32(e.Recipient), sdk.NewCoins(e.Amount))
+	if err != nil {
+		return errorsmod.Wrapf(err, "release unbonding: %d", e.Id)
+	}
+	if err := uevent.EmitTypedEvent(ctx, &types.EventUnbondingCompleted{Entry: e}); err != nil {
+		return fmt.Errorf("emit event: %w", err)
+	}
+	return nil
+}
+
+// slashUnbondings slashes all the pending unbondings from the sequencer, of which the rewardee receives
+// rewardMul
+func (k Keeper) slashUnbondings(ctx sdk.Context, seqAddr string, rewardMul math.LegacyDec, rewardee sdk.AccAddress) error {
+	es, err := k.sequencerUnbondings(ctx, seqAddr)
+	if err != nil {
+		return errorsmod.Wrap(err, "sequencer unbondings")
+	}
+	for _, e := range es {
+		if err := k.removeUnbonding(ctx, e); err != nil {
+			return errorsmod.Wrap(err, "remove unbonding")
+		}
+		reward := ucoin.MulDec(rewardMul, e.Amount)[0]
+		if !reward.IsZero() {
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, rewardee, sdk.NewCoins(reward))
+			if err != nil {
+				return errorsmod.Wrap(err, "send reward")
+			}
+		}
+		if burn := e.Amount.Sub(reward); !burn.IsZero() {
+			if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(burn)); err != nil {
+				return errorsmod.Wrap(err, "burn")
+			}
+		}
+		if err := uevent.EmitTypedEvent(ctx, &types.EventUnbondingSlashed{Entry: e, Reward: reward}); err != nil {
+			return fmt.Errorf("emit event: %w", err)
+		}
+	}
+	return nil
+}
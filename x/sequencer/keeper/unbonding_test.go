@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/dymensionxyz/sdk-utils/utils/ucoin"
+
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/keeper"
+	"github.com/dymensionxyz/dymension/v3/x/sequencer/types"
+)
+
+func (s *SequencerTestSuite) TestUnbondingQueue() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, ucoin.SimpleMul(bond, 3))
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, charlie, ucoin.SimpleMul(bond, 2))
+
+	res, err := s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(pkAddr(bob), bond))
+	s.Require().NoError(err)
+	s.Require().True(s.Ctx.BlockTime().Add(s.k().GetParams(s.Ctx).UnbondingTime).Equal(res.CompletionTime))
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(1))
+	_, err = s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(pkAddr(charlie), bond))
+	s.Require().NoError(err)
+	s.Require().True(s.seq(bob).TokensCoin().IsEqual(ucoin.SimpleMul(bond, 2)))
+
+	all, err := s.queryClient.Unbondings(s.Ctx, &types.QueryUnbondingsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(all.Entries, 2)
+	s.Require().Equal(pkAddr(bob), all.Entries[0].Sequencer)
+	s.Require().Equal(pkAddr(charlie), all.Entries[1].Sequencer)
+	bySeq, err := s.queryClient.UnbondingsBySequencer(s.Ctx, &types.QueryUnbondingsBySequencerRequest{Sequencer: pkAddr(bob)})
+	s.Require().NoError(err)
+	s.Require().Len(bySeq.Entries, 1)
+	s.Require().Equal(pkAddr(bob), bySeq.Entries[0].Recipient)
+	s.Require().True(bySeq.Entries[0].Amount.Equal(bond))
+
+	// fraud found during the unbonding window reaches the unbonding tokens
+	rewardee := pkAcc(randomTMPubKey())
+	s.Require().NoError(s.k().PunishSequencer(s.Ctx, pkAddr(bob), &rewardee))
+	s.Require().True(s.seq(bob).TokensCoin().IsZero())
+	reward := s.App.BankKeeper.GetBalance(s.Ctx, rewardee, bond.Denom)
+	s.Require().True(ucoin.SimpleMul(bond, 3).Amount.QuoRaw(2).Equal(reward.Amount))
+	bySeq, err = s.queryClient.UnbondingsBySequencer(s.Ctx, &types.QueryUnbondingsBySequencerRequest{Sequencer: pkAddr(bob)})
+	s.Require().NoError(err)
+	s.Require().Empty(bySeq.Entries)
+	_, broken := keeper.AllInvariants(*s.k())(s.Ctx)
+	s.Require().False(broken)
+
+	// nothing is released before the completion time
+	s.Ctx = s.Ctx.WithBlockTime(res.CompletionTime)
+	s.Require().NoError(s.k().CompleteUnbondings(s.Ctx))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(charlie), bond.Denom).IsZero())
+
+	s.completeUnbondings()
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(charlie), bond.Denom).IsEqual(bond))
+	all, err = s.queryClient.Unbondings(s.Ctx, &types.QueryUnbondingsRequest{})
+	s.Require().NoError(err)
+	s.Require().Empty(all.Entries)
+	_, broken = keeper.AllInvariants(*s.k())(s.Ctx)
+	s.Require().False(broken)
+}
+
+func (s *SequencerTestSuite) TestUnbondingReleaseFailure() {
+	ra := s.createRollapp()
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, alice, bond)
+	s.createSequencerWithBond(s.Ctx, ra.RollappId, bob, ucoin.SimpleMul(bond, 3))
+	_, err := s.msgServer.DecreaseBond(s.Ctx, types.NewMsgDecreaseBond(pkAddr(bob), bond))
+	s.Require().NoError(err)
+
+	// an entry which cannot be released, to a blocked address, does not block the others
+	all, err := s.queryClient.Unbondings(s.Ctx, &types.QueryUnbondingsRequest{})
+	s.Require().NoError(err)
+	blocked := all.Entries[0]
+	blocked.Id++
+	blocked.Recipient = authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	s.Require().NoError(s.k().SetUnbonding(s.Ctx, blocked))
+	s.Require().NoError(s.k().SetUnbondingID(s.Ctx, blocked.Id+1))
+
+	s.Ctx = s.Ctx.WithBlockTime(blocked.CompletionTime)
+	s.Require().NoError(s.k().CompleteUnbondings(s.Ctx))
+	s.Require().True(s.App.BankKeeper.GetBalance(s.Ctx, pkAcc(bob), bond.Denom).IsEqual(bond))
+
+	// the failed entry is kept to be retried
+	all, err = s.queryClient.Unbondings(s.Ctx, &types.QueryUnbondingsRequest{})
+	s.Require().NoError(err)
+	s.Require().Len(all.Entries, 1)
+	s.Require().Equal(blocked.Id, all.Entries[0].Id)
+}
@@ -122,6 +122,12 @@ func (s *SequencerTestSuite) moduleBalance() sdk.Coin {
 	return cs[0]
 }
 
+// completeUnbondings moves the clock past the unbonding time and releases the unbondings
+func (s *SequencerTestSuite) completeUnbondings() {
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(s.k().GetParams(s.Ctx).UnbondingTime))
+	s.Require().NoError(s.k().CompleteUnbondings(s.Ctx))
+}
+
 func (s *SequencerTestSuite) createRollapp() rollapptypes.Rollapp {
 	return s.createRollappWithInitialSeqConstraint("*")
 }
@@ -167,5 +167,11 @@ func (am AppModule) BeginBlock(goCtx context.Context) error {
 		return err
 	}
 
+	// a failure to release unbonded tokens must not halt the chain
+	err = am.keeper.CompleteUnbondings(ctx)
+	if err != nil {
+		ctx.Logger().Error("CompleteUnbondings", "err", err)
+	}
+
 	return nil
 }
@@ -21,6 +21,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgPunishSequencer{}, "sequencer/PunishSequencer", nil)
 	cdc.RegisterConcrete(&MsgUpdateSequencerInformation{}, "sequencer/UpdateSequencerInformation", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "sequencer/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgScheduleRotation{}, "sequencer/ScheduleRotation", nil)
+	cdc.RegisterConcrete(&MsgAcceptRotation{}, "sequencer/AcceptRotation", nil)
+	cdc.RegisterConcrete(&MsgCancelRotation{}, "sequencer/CancelRotation", nil)
+	cdc.RegisterConcrete(&MsgDelegate{}, "sequencer/Delegate", nil)
+	cdc.RegisterConcrete(&MsgUndelegate{}, "sequencer/Undelegate", nil)
+	cdc.RegisterConcrete(&MsgDistributeRewards{}, "sequencer/DistributeRewards", nil)
 	cdc.RegisterConcrete(Params{}, "sequencer/Params", nil)
 }
 
@@ -37,6 +43,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateParams{},
 		&MsgPunishSequencer{},
 		&MsgUpdateSequencerInformation{},
+		&MsgScheduleRotation{},
+		&MsgAcceptRotation{},
+		&MsgCancelRotation{},
+		&MsgDelegate{},
+		&MsgUndelegate{},
+		&MsgDistributeRewards{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
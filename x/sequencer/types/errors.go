@@ -25,4 +25,6 @@ var (
 	ErrInvalidPubKey             = gerrc.ErrInvalidArgument.Wrap("pubkey")
 	ErrUnknownRequest            = gerrc.ErrInvalidArgument.Wrap("unknown request")
 	ErrInvalidFeeDenom           = gerrc.ErrInvalidArgument.Wrap("invalid fee denom")
+	ErrNoPlannedRotation         = gerrc.ErrNotFound.Wrap("planned rotation")
+	ErrPlannedRotationViolated   = gerrc.ErrFailedPrecondition.Wrap("planned rotation violated")
 )
@@ -17,6 +17,7 @@ type RollappKeeper interface {
 	SetRollappAsLaunched(ctx sdk.Context, rollapp *rollapptypes.Rollapp) error
 	HardForkToLatest(ctx sdk.Context, rollappId string) error
 	ForkLatestAllowed(ctx sdk.Context, rollappId string) bool
+	GetLatestHeight(ctx sdk.Context, rollappId string) (uint64, bool)
 }
 
 // AccountKeeper defines the expected account keeper used for simulations (noalias)
@@ -27,6 +28,7 @@ type AccountKeeper interface {
 // BankKeeper defines the expected interface needed to retrieve account balances.
 type BankKeeper interface {
 	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
@@ -1,6 +1,10 @@
 package types
 
-import "fmt"
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // DefaultGenesis returns the default Capability genesis state
 func DefaultGenesis() *GenesisState {
@@ -42,6 +46,63 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	plannedRotations := make(map[string]struct{})
+	for _, r := range gs.PlannedRotations {
+		if _, ok := plannedRotations[r.RollappId]; ok {
+			return fmt.Errorf("duplicated planned rotation for %s", r.RollappId)
+		}
+		plannedRotations[r.RollappId] = struct{}{}
+		for _, addr := range []string{r.Proposer, r.Successor} {
+			if _, ok := sequencerIndexMap[string(SequencerKey(addr))]; !ok {
+				return fmt.Errorf("planned rotation contains non-existent sequencer")
+			}
+		}
+	}
+
+	delegations := make(map[string]struct{})
+	for _, d := range gs.Delegations {
+		key := d.Sequencer + KeySeparator + d.Delegator
+		if _, ok := delegations[key]; ok {
+			return fmt.Errorf("duplicated delegation of %s to %s", d.Delegator, d.Sequencer)
+		}
+		delegations[key] = struct{}{}
+		if _, ok := sequencerIndexMap[string(SequencerKey(d.Sequencer))]; !ok {
+			return fmt.Errorf("delegation to non-existent sequencer")
+		}
+		if d.Shares.IsNil() || !d.Shares.IsPositive() {
+			return fmt.Errorf("delegation with non positive shares")
+		}
+	}
+
+	history := make(map[string]struct{})
+	for _, r := range gs.History {
+		key := fmt.Sprintf("%s%s%d", r.Sequencer, KeySeparator, r.Id)
+		if _, ok := history[key]; ok {
+			return fmt.Errorf("duplicated history record %d of %s", r.Id, r.Sequencer)
+		}
+		history[key] = struct{}{}
+		if _, ok := sequencerIndexMap[string(SequencerKey(r.Sequencer))]; !ok {
+			return fmt.Errorf("history record of non-existent sequencer")
+		}
+	}
+
+	unbondings := make(map[uint64]struct{})
+	for _, e := range gs.Unbondings {
+		if _, ok := unbondings[e.Id]; ok {
+			return fmt.Errorf("duplicated unbonding %d", e.Id)
+		}
+		unbondings[e.Id] = struct{}{}
+		if _, ok := sequencerIndexMap[string(SequencerKey(e.Sequencer))]; !ok {
+			return fmt.Errorf("unbonding from non-existent sequencer")
+		}
+		if _, err := sdk.AccAddressFromBech32(e.Recipient); err != nil {
+			return fmt.Errorf("unbonding recipient: %w", err)
+		}
+		if !e.Amount.IsValid() || e.Amount.IsZero() {
+			return fmt.Errorf("unbonding with invalid amount: %s", e.Amount)
+		}
+	}
+
 	return gs.Params.ValidateBasic()
 }
 
@@ -34,6 +34,14 @@ type GenesisState struct {
 	GenesisSuccessors []GenesisProposer `protobuf:"bytes,5,rep,name=genesisSuccessors,proto3" json:"genesisSuccessors"`
 	// list of sequencers in the notice queue
 	NoticeQueue []string `protobuf:"bytes,4,rep,name=noticeQueue,proto3" json:"noticeQueue,omitempty"`
+	// planned rotations which are not handed over yet
+	PlannedRotations []PlannedRotation `protobuf:"bytes,6,rep,name=planned_rotations,json=plannedRotations,proto3" json:"planned_rotations"`
+	// delegations of third party stakers to the sequencers
+	Delegations []Delegation `protobuf:"bytes,7,rep,name=delegations,proto3" json:"delegations"`
+	// history records of the sequencers
+	History []HistoryRecord `protobuf:"bytes,8,rep,name=history,proto3" json:"history"`
+	// unbondings which are not released yet
+	Unbondings []UnbondingEntry `protobuf:"bytes,9,rep,name=unbondings,proto3" json:"unbondings"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -104,6 +112,34 @@ func (m *GenesisState) GetNoticeQueue() []string {
 	return nil
 }
 
+func (m *GenesisState) GetPlannedRotations() []PlannedRotation {
+	if m != nil {
+		return m.PlannedRotations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDelegations() []Delegation {
+	if m != nil {
+		return m.Delegations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHistory() []HistoryRecord {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUnbondings() []UnbondingEntry {
+	if m != nil {
+		return m.Unbondings
+	}
+	return nil
+}
+
 type GenesisProposer struct {
 	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	RollappId string `protobuf:"bytes,2,opt,name=rollappId,proto3" json:"rollappId,omitempty"`
@@ -166,30 +202,38 @@ func init() {
 }
 
 var fileDescriptor_3115db717b16c2af = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x4e, 0xc2, 0x40,
-	0x10, 0x86, 0x5b, 0x8a, 0x18, 0x16, 0x8d, 0xba, 0xf1, 0xb0, 0x21, 0xa6, 0x36, 0x9c, 0x9a, 0x18,
-	0x5b, 0x85, 0xc4, 0x07, 0xe0, 0x20, 0x21, 0xf1, 0x80, 0x70, 0x30, 0xf1, 0x56, 0xda, 0x49, 0x6d,
-	0x02, 0xdd, 0xba, 0xb3, 0x35, 0xe0, 0x53, 0xf8, 0x02, 0xbe, 0x0f, 0x47, 0x8e, 0x9e, 0x8c, 0x81,
-	0x17, 0x31, 0xb6, 0xa5, 0x80, 0xc4, 0xf4, 0xe0, 0x6d, 0x66, 0xfa, 0xff, 0xdf, 0x3f, 0xe9, 0x0e,
-	0xb1, 0xbc, 0xe9, 0x18, 0x42, 0x0c, 0x78, 0x38, 0x99, 0xbe, 0xda, 0x79, 0x63, 0x23, 0x3c, 0xc7,
-	0x10, 0xba, 0x20, 0x6c, 0x1f, 0x42, 0xc0, 0x00, 0xad, 0x48, 0x70, 0xc9, 0xa9, 0xb1, 0xa9, 0x5f,
-	0x9b, 0xad, 0x5c, 0x5f, 0x3f, 0xf5, 0xb9, 0xcf, 0x13, 0xb1, 0xfd, 0x53, 0xa5, 0xbe, 0xfa, 0x65,
-	0x61, 0x4e, 0xe4, 0x08, 0x67, 0x9c, 0xc5, 0xd4, 0xaf, 0x0a, 0xe5, 0x79, 0x95, 0x3a, 0x1a, 0xef,
-	0x1a, 0x39, 0xe8, 0xa4, 0xab, 0x0e, 0xa4, 0x23, 0x81, 0xde, 0x92, 0x4a, 0x8a, 0x64, 0xaa, 0xa1,
-	0x9a, 0xb5, 0xa6, 0x69, 0x15, 0xad, 0x6e, 0xf5, 0x12, 0x7d, 0xbb, 0x3c, 0xfb, 0x3c, 0x57, 0xfa,
-	0x99, 0x9b, 0x3e, 0x90, 0xc3, 0x5c, 0x71, 0x17, 0xa0, 0x64, 0x25, 0x43, 0x33, 0x6b, 0xcd, 0x8b,
-	0x62, 0xdc, 0x60, 0x55, 0x65, 0xc4, 0x6d, 0x0e, 0x75, 0xc9, 0x71, 0xf6, 0x6f, 0x7b, 0x82, 0x47,
-	0x1c, 0x41, 0x20, 0xd3, 0x12, 0xf6, 0x75, 0x31, 0xbb, 0xb3, 0xed, 0xcc, 0x12, 0x76, 0x80, 0x14,
-	0xc8, 0x49, 0x36, 0x1b, 0xc4, 0xae, 0x0b, 0x88, 0x5c, 0x20, 0xdb, 0xfb, 0x5f, 0xca, 0x2e, 0x91,
-	0x1a, 0xa4, 0x16, 0x72, 0x19, 0xb8, 0x70, 0x1f, 0x43, 0x0c, 0xac, 0x6c, 0x68, 0x66, 0xb5, 0xbf,
-	0x39, 0x6a, 0x74, 0xc9, 0xd1, 0x2f, 0x1a, 0x65, 0x64, 0xdf, 0xf1, 0x3c, 0x01, 0x98, 0x3e, 0x51,
-	0xb5, 0xbf, 0x6a, 0xe9, 0x19, 0xa9, 0x0a, 0x3e, 0x1a, 0x39, 0x51, 0xd4, 0xf5, 0x58, 0x29, 0xf9,
-	0xb6, 0x1e, 0xb4, 0x7b, 0xb3, 0x85, 0xae, 0xce, 0x17, 0xba, 0xfa, 0xb5, 0xd0, 0xd5, 0xb7, 0xa5,
-	0xae, 0xcc, 0x97, 0xba, 0xf2, 0xb1, 0xd4, 0x95, 0xc7, 0x1b, 0x3f, 0x90, 0x4f, 0xf1, 0xd0, 0x72,
-	0xf9, 0xd8, 0xfe, 0xe3, 0x82, 0x5e, 0x5a, 0xf6, 0x64, 0xe3, 0x8c, 0xe4, 0x34, 0x02, 0x1c, 0x56,
-	0x92, 0x1b, 0x6a, 0x7d, 0x07, 0x00, 0x00, 0xff, 0xff, 0x74, 0x53, 0x26, 0xab, 0x0e, 0x03, 0x00,
-	0x00,
+	// 482 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xdd, 0x8a, 0xd3, 0x40,
+	0x14, 0x80, 0x9b, 0xed, 0xda, 0xda, 0xa9, 0xa2, 0x3b, 0x78, 0x31, 0x14, 0x89, 0x61, 0xaf, 0x0a,
+	0x6a, 0xb2, 0x3f, 0xe0, 0x03, 0x2c, 0xea, 0xba, 0x20, 0x58, 0x53, 0x7f, 0xc0, 0x1b, 0x49, 0x33,
+	0x87, 0x6c, 0xa0, 0x9d, 0x89, 0x73, 0x26, 0xb2, 0xf1, 0xd2, 0x27, 0xf0, 0xb1, 0xf6, 0x72, 0x2f,
+	0xbd, 0x12, 0x69, 0x5f, 0x44, 0x36, 0x99, 0xa4, 0xa9, 0x8b, 0x4c, 0xc1, 0xbb, 0x99, 0x93, 0xf3,
+	0x7d, 0x67, 0x7e, 0xce, 0x84, 0xf8, 0xbc, 0x58, 0x80, 0xc0, 0x54, 0x8a, 0x8b, 0xe2, 0x5b, 0xd0,
+	0x4c, 0x02, 0x84, 0x2f, 0x39, 0x88, 0x18, 0x54, 0x90, 0x80, 0x00, 0x4c, 0xd1, 0xcf, 0x94, 0xd4,
+	0x92, 0x7a, 0xed, 0xfc, 0x35, 0xec, 0x37, 0xf9, 0xa3, 0x07, 0x89, 0x4c, 0x64, 0x99, 0x1c, 0x5c,
+	0x8f, 0x2a, 0x6e, 0xf4, 0xd4, 0x5a, 0x27, 0x8b, 0x54, 0xb4, 0x30, 0x65, 0x46, 0x07, 0xd6, 0xf4,
+	0x66, 0x64, 0x88, 0xc0, 0x4a, 0x28, 0xa9, 0x23, 0x7d, 0xbd, 0xd6, 0x0a, 0x38, 0xb4, 0x02, 0x1c,
+	0xe6, 0x90, 0xb4, 0x11, 0xfb, 0x61, 0x9d, 0xa7, 0xa8, 0xa5, 0x2a, 0xb6, 0xde, 0x45, 0x2e, 0x66,
+	0x52, 0xf0, 0x54, 0x24, 0x15, 0xb1, 0xff, 0xbd, 0x47, 0xee, 0x9c, 0x56, 0x07, 0x3e, 0xd5, 0x91,
+	0x06, 0xfa, 0x92, 0xf4, 0xaa, 0x83, 0x61, 0x8e, 0xe7, 0x8c, 0x87, 0x47, 0x63, 0xdf, 0x76, 0x01,
+	0xfe, 0xa4, 0xcc, 0x3f, 0xd9, 0xbd, 0xfc, 0xf5, 0xa8, 0x13, 0x1a, 0x9a, 0x7e, 0x24, 0x77, 0x9b,
+	0x8c, 0xd7, 0x29, 0x6a, 0xb6, 0xe3, 0x75, 0xc7, 0xc3, 0xa3, 0xc7, 0x76, 0xdd, 0xb4, 0x1e, 0x19,
+	0xe3, 0xa6, 0x87, 0xc6, 0xe4, 0xbe, 0xe9, 0x90, 0x89, 0x92, 0x99, 0x44, 0x50, 0xc8, 0xba, 0xa5,
+	0xfb, 0xd0, 0xee, 0x3e, 0xdd, 0x24, 0x4d, 0x85, 0x1b, 0x42, 0x0a, 0x64, 0xcf, 0xc4, 0xa6, 0x79,
+	0x1c, 0x03, 0xa2, 0x54, 0xc8, 0x6e, 0xfd, 0x5f, 0x95, 0x9b, 0x46, 0xea, 0x91, 0xa1, 0x90, 0x3a,
+	0x8d, 0xe1, 0x6d, 0x0e, 0x39, 0xb0, 0x5d, 0xaf, 0x3b, 0x1e, 0x84, 0xed, 0x10, 0xe5, 0x64, 0x2f,
+	0x9b, 0x47, 0x42, 0x00, 0xff, 0x5c, 0xb7, 0x13, 0xb2, 0xde, 0xb6, 0x0b, 0x99, 0x54, 0x68, 0x68,
+	0xc8, 0x7a, 0xbb, 0xd9, 0x66, 0x18, 0xe9, 0x3b, 0x32, 0x5c, 0xf7, 0x1e, 0xb2, 0x7e, 0xe9, 0x7f,
+	0x62, 0xf7, 0x3f, 0x6f, 0x20, 0xa3, 0x6e, 0x6b, 0xe8, 0x1b, 0xd2, 0x37, 0xed, 0xc9, 0x6e, 0x97,
+	0xc6, 0xc0, 0x6e, 0x7c, 0x55, 0x01, 0x21, 0xc4, 0x52, 0x71, 0x23, 0xad, 0x2d, 0xf4, 0x03, 0x21,
+	0x4d, 0xff, 0x22, 0x1b, 0x94, 0xce, 0x03, 0xbb, 0xf3, 0x7d, 0xcd, 0xbc, 0x10, 0x5a, 0x15, 0x46,
+	0xda, 0x32, 0xed, 0x9f, 0x91, 0x7b, 0x7f, 0x5d, 0x19, 0x65, 0xa4, 0x1f, 0x71, 0xae, 0x00, 0xab,
+	0x77, 0x30, 0x08, 0xeb, 0x29, 0x7d, 0x48, 0x06, 0x4a, 0xce, 0xe7, 0x51, 0x96, 0x9d, 0x71, 0xb6,
+	0x53, 0x7e, 0x5b, 0x07, 0x4e, 0x26, 0x97, 0x4b, 0xd7, 0xb9, 0x5a, 0xba, 0xce, 0xef, 0xa5, 0xeb,
+	0xfc, 0x58, 0xb9, 0x9d, 0xab, 0x95, 0xdb, 0xf9, 0xb9, 0x72, 0x3b, 0x9f, 0x9e, 0x25, 0xa9, 0x3e,
+	0xcf, 0x67, 0x7e, 0x2c, 0x17, 0xff, 0xfa, 0x75, 0x7c, 0x3d, 0x0e, 0x2e, 0x5a, 0x6f, 0x55, 0x17,
+	0x19, 0xe0, 0xac, 0x57, 0x3e, 0xd4, 0xe3, 0x3f, 0x03, 0x00, 0xa0, 0xbd, 0xa9, 0xd4, 0x39, 0x05,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -212,6 +256,62 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Unbondings) > 0 {
+		for iNdEx := len(m.Unbondings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Unbondings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for iNdEx := len(m.Delegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Delegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PlannedRotations) > 0 {
+		for iNdEx := len(m.PlannedRotations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PlannedRotations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.GenesisSuccessors) > 0 {
 		for iNdEx := len(m.GenesisSuccessors) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -356,6 +456,30 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PlannedRotations) > 0 {
+		for _, e := range m.PlannedRotations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Delegations) > 0 {
+		for _, e := range m.Delegations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Unbondings) > 0 {
+		for _, e := range m.Unbondings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -578,6 +702,142 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PlannedRotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PlannedRotations = append(m.PlannedRotations, PlannedRotation{})
+			if err := m.PlannedRotations[len(m.PlannedRotations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegations = append(m.Delegations, Delegation{})
+			if err := m.Delegations[len(m.Delegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, HistoryRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Unbondings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Unbondings = append(m.Unbondings, UnbondingEntry{})
+			if err := m.Unbondings[len(m.Unbondings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,909 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/history.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// HistoryRecordKind is the kind of event recorded in the history of a
+// sequencer
+type HistoryRecordKind int32
+
+const (
+	HistoryUnspecified HistoryRecordKind = 0
+	// the sequencer submitted a state update as proposer
+	HistoryStateUpdate HistoryRecordKind = 1
+	// the sequencer was slashed for a liveness fault
+	HistoryLivenessSlash HistoryRecordKind = 2
+	// the sequencer was punished for fraud or misbehavior
+	HistoryPunishment HistoryRecordKind = 3
+	// the sequencer was kicked as proposer
+	HistoryKick HistoryRecordKind = 4
+	// the sequencer opted in or out of being proposer
+	HistoryOptInChange HistoryRecordKind = 5
+)
+
+var HistoryRecordKind_name = map[int32]string{
+	0: "HISTORY_RECORD_KIND_UNSPECIFIED",
+	1: "HISTORY_RECORD_KIND_STATE_UPDATE",
+	2: "HISTORY_RECORD_KIND_LIVENESS_SLASH",
+	3: "HISTORY_RECORD_KIND_PUNISHMENT",
+	4: "HISTORY_RECORD_KIND_KICK",
+	5: "HISTORY_RECORD_KIND_OPT_IN_CHANGE",
+}
+
+var HistoryRecordKind_value = map[string]int32{
+	"HISTORY_RECORD_KIND_UNSPECIFIED":    0,
+	"HISTORY_RECORD_KIND_STATE_UPDATE":   1,
+	"HISTORY_RECORD_KIND_LIVENESS_SLASH": 2,
+	"HISTORY_RECORD_KIND_PUNISHMENT":     3,
+	"HISTORY_RECORD_KIND_KICK":           4,
+	"HISTORY_RECORD_KIND_OPT_IN_CHANGE":  5,
+}
+
+func (x HistoryRecordKind) String() string {
+	return proto.EnumName(HistoryRecordKind_name, int32(x))
+}
+
+func (HistoryRecordKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_59f900947975bf9b, []int{0}
+}
+
+// HistoryRecord is an entry of the performance and slashing history of a
+// sequencer. Only the fields relevant to the kind are set.
+type HistoryRecord struct {
+	// sequencer is the bech32-encoded address of the sequencer
+	Sequencer string `protobuf:"bytes,1,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// id is the position of the record in the history of the sequencer
+	Id   uint64            `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Kind HistoryRecordKind `protobuf:"varint,3,opt,name=kind,proto3,enum=dymensionxyz.dymension.sequencer.HistoryRecordKind" json:"kind,omitempty"`
+	// height is the hub height of the event
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the hub time of the event
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// state update: the index of the state info and its number of blocks
+	StateIndex uint64 `protobuf:"varint,6,opt,name=state_index,json=stateIndex,proto3" json:"state_index,omitempty"`
+	NumBlocks  uint64 `protobuf:"varint,7,opt,name=num_blocks,json=numBlocks,proto3" json:"num_blocks,omitempty"`
+	// slashes: the slashed amount, and the part of it which went to the
+	// rewardee, if any
+	Amount   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	Rewardee string                                   `protobuf:"bytes,9,opt,name=rewardee,proto3" json:"rewardee,omitempty"`
+	Reward   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"reward"`
+	// kick: the sequencer which kicked the proposer
+	Kicker string `protobuf:"bytes,11,opt,name=kicker,proto3" json:"kicker,omitempty"`
+	// opt in change: the new opt in status
+	OptedIn bool `protobuf:"varint,12,opt,name=opted_in,json=optedIn,proto3" json:"opted_in,omitempty"`
+}
+
+func (m *HistoryRecord) Reset()         { *m = HistoryRecord{} }
+func (m *HistoryRecord) String() string { return proto.CompactTextString(m) }
+func (*HistoryRecord) ProtoMessage()    {}
+func (*HistoryRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_59f900947975bf9b, []int{0}
+}
+func (m *HistoryRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoryRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoryRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoryRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoryRecord.Merge(m, src)
+}
+func (m *HistoryRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoryRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoryRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoryRecord proto.InternalMessageInfo
+
+func (m *HistoryRecord) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *HistoryRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *HistoryRecord) GetKind() HistoryRecordKind {
+	if m != nil {
+		return m.Kind
+	}
+	return HistoryUnspecified
+}
+
+func (m *HistoryRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HistoryRecord) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *HistoryRecord) GetStateIndex() uint64 {
+	if m != nil {
+		return m.StateIndex
+	}
+	return 0
+}
+
+func (m *HistoryRecord) GetNumBlocks() uint64 {
+	if m != nil {
+		return m.NumBlocks
+	}
+	return 0
+}
+
+func (m *HistoryRecord) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *HistoryRecord) GetRewardee() string {
+	if m != nil {
+		return m.Rewardee
+	}
+	return ""
+}
+
+func (m *HistoryRecord) GetReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Reward
+	}
+	return nil
+}
+
+func (m *HistoryRecord) GetKicker() string {
+	if m != nil {
+		return m.Kicker
+	}
+	return ""
+}
+
+func (m *HistoryRecord) GetOptedIn() bool {
+	if m != nil {
+		return m.OptedIn
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("dymensionxyz.dymension.sequencer.HistoryRecordKind", HistoryRecordKind_name, HistoryRecordKind_value)
+	proto.RegisterType((*HistoryRecord)(nil), "dymensionxyz.dymension.sequencer.HistoryRecord")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/history.proto", fileDescriptor_59f900947975bf9b)
+}
+
+var fileDescriptor_59f900947975bf9b = []byte{
+	// 727 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xf3, 0x44,
+	0x14, 0x8d, 0x93, 0x34, 0x4d, 0x26, 0x50, 0xc2, 0xa8, 0x54, 0xae, 0x25, 0x1c, 0xd3, 0x95, 0x85,
+	0x54, 0x9b, 0xb6, 0x52, 0x05, 0x02, 0x24, 0xf2, 0x63, 0x1a, 0x2b, 0x25, 0x89, 0xec, 0x04, 0x09,
+	0x36, 0x96, 0x63, 0x4f, 0x9d, 0x51, 0xea, 0x99, 0xe0, 0x99, 0x94, 0x86, 0x27, 0x40, 0x59, 0xf5,
+	0x05, 0xb2, 0x62, 0xc7, 0x9a, 0x87, 0xa8, 0xc4, 0xa6, 0x62, 0xc5, 0x8a, 0xa2, 0xf6, 0x41, 0x40,
+	0xfe, 0x69, 0xda, 0x8a, 0xa0, 0x6f, 0xf5, 0xad, 0x3c, 0xe7, 0xce, 0x3d, 0x77, 0xce, 0x3d, 0x77,
+	0x3c, 0x40, 0xf3, 0x17, 0x21, 0x22, 0x0c, 0x53, 0x72, 0xbd, 0xf8, 0x49, 0x5f, 0x03, 0x9d, 0xa1,
+	0x1f, 0xe6, 0x88, 0x78, 0x28, 0xd2, 0x27, 0x98, 0x71, 0x1a, 0x2d, 0xb4, 0x59, 0x44, 0x39, 0x85,
+	0xca, 0xcb, 0xfc, 0x67, 0xb2, 0xb6, 0xce, 0x97, 0xf6, 0x3d, 0xca, 0x42, 0xca, 0x9c, 0x24, 0x5f,
+	0x4f, 0x41, 0x4a, 0x96, 0x76, 0x03, 0x1a, 0xd0, 0x34, 0x1e, 0xaf, 0xb2, 0x68, 0x3d, 0xa0, 0x34,
+	0xb8, 0x44, 0x7a, 0x82, 0xc6, 0xf3, 0x0b, 0x9d, 0xe3, 0x10, 0x31, 0xee, 0x86, 0xb3, 0x2c, 0x41,
+	0x4e, 0x8b, 0xe8, 0x63, 0x97, 0x21, 0xfd, 0xea, 0x68, 0x8c, 0xb8, 0x7b, 0xa4, 0x7b, 0x14, 0x93,
+	0x74, 0xff, 0xe0, 0xf7, 0x22, 0x78, 0xb7, 0x93, 0xaa, 0xb4, 0x90, 0x47, 0x23, 0x1f, 0x9e, 0x82,
+	0xca, 0x5a, 0x90, 0x28, 0x28, 0x82, 0x5a, 0x69, 0x8a, 0x7f, 0xfc, 0x76, 0xb8, 0x9b, 0xa9, 0x69,
+	0xf8, 0x7e, 0x84, 0x18, 0xb3, 0x79, 0x84, 0x49, 0x60, 0x3d, 0xa7, 0xc2, 0x1d, 0x90, 0xc7, 0xbe,
+	0x98, 0x57, 0x04, 0xb5, 0x68, 0xe5, 0xb1, 0x0f, 0xcf, 0x40, 0x71, 0x8a, 0x89, 0x2f, 0x16, 0x14,
+	0x41, 0xdd, 0x39, 0x3e, 0xd1, 0xde, 0xd4, 0xbc, 0xf6, 0x4a, 0x46, 0x17, 0x13, 0xdf, 0x4a, 0x0a,
+	0xc0, 0x3d, 0x50, 0x9a, 0x20, 0x1c, 0x4c, 0xb8, 0x58, 0x54, 0x04, 0xb5, 0x60, 0x65, 0x08, 0x7e,
+	0x0a, 0x8a, 0x71, 0xb7, 0xe2, 0x96, 0x22, 0xa8, 0xd5, 0x63, 0x49, 0x4b, 0xad, 0xd0, 0x9e, 0xac,
+	0xd0, 0x86, 0x4f, 0x56, 0x34, 0xcb, 0xb7, 0x7f, 0xd5, 0x73, 0x37, 0xf7, 0x75, 0xc1, 0x4a, 0x18,
+	0xb0, 0x0e, 0xaa, 0x8c, 0xbb, 0x1c, 0x39, 0x98, 0xf8, 0xe8, 0x5a, 0x2c, 0x25, 0x9a, 0x41, 0x12,
+	0x32, 0xe3, 0x08, 0xfc, 0x10, 0x00, 0x32, 0x0f, 0x9d, 0xf1, 0x25, 0xf5, 0xa6, 0x4c, 0xdc, 0x4e,
+	0xf6, 0x2b, 0x64, 0x1e, 0x36, 0x93, 0x00, 0xf4, 0x40, 0xc9, 0x0d, 0xe9, 0x9c, 0x70, 0xb1, 0xac,
+	0x14, 0xd4, 0xea, 0xf1, 0xbe, 0x96, 0x99, 0x13, 0xbb, 0xac, 0x65, 0x2e, 0x6b, 0x2d, 0x8a, 0x49,
+	0xf3, 0x93, 0xf8, 0xe8, 0x5f, 0xef, 0xeb, 0x6a, 0x80, 0xf9, 0x64, 0x3e, 0xd6, 0x3c, 0x1a, 0x66,
+	0x73, 0xcd, 0x3e, 0x87, 0xcc, 0x9f, 0xea, 0x7c, 0x31, 0x43, 0x2c, 0x21, 0x30, 0x2b, 0x2b, 0x0d,
+	0x25, 0x50, 0x8e, 0xd0, 0x8f, 0x6e, 0xe4, 0x23, 0x24, 0x56, 0xe2, 0x31, 0x58, 0x6b, 0x1c, 0x0b,
+	0x48, 0xd7, 0x22, 0x78, 0x0b, 0x02, 0xd2, 0xd2, 0xb1, 0xef, 0x53, 0xec, 0x4d, 0x51, 0x24, 0x56,
+	0x93, 0xe3, 0x33, 0x04, 0xf7, 0x41, 0x99, 0xce, 0x38, 0xf2, 0x1d, 0x4c, 0xc4, 0x77, 0x14, 0x41,
+	0x2d, 0x5b, 0xdb, 0x09, 0x36, 0xc9, 0xc7, 0xff, 0xe4, 0xc1, 0xfb, 0xff, 0x19, 0x23, 0xfc, 0x1c,
+	0xd4, 0x3b, 0xa6, 0x3d, 0xec, 0x5b, 0xdf, 0x39, 0x96, 0xd1, 0xea, 0x5b, 0x6d, 0xa7, 0x6b, 0xf6,
+	0xda, 0xce, 0xa8, 0x67, 0x0f, 0x8c, 0x96, 0xf9, 0xb5, 0x69, 0xb4, 0x6b, 0x39, 0x69, 0x6f, 0xb9,
+	0x52, 0x60, 0xc6, 0x1d, 0x11, 0x36, 0x43, 0x1e, 0xbe, 0xc0, 0xc8, 0x87, 0x5f, 0x00, 0x65, 0x13,
+	0xd9, 0x1e, 0x36, 0x86, 0x86, 0x33, 0x1a, 0xb4, 0x1b, 0x43, 0xa3, 0x26, 0xbc, 0x62, 0xdb, 0xf1,
+	0x1c, 0x47, 0x33, 0xdf, 0xe5, 0x08, 0x7e, 0x05, 0x0e, 0x36, 0xb1, 0xcf, 0xcd, 0x6f, 0x8d, 0x9e,
+	0x61, 0xdb, 0x8e, 0x7d, 0xde, 0xb0, 0x3b, 0xb5, 0xbc, 0x24, 0x2e, 0x57, 0xca, 0x6e, 0xc6, 0x3f,
+	0xc7, 0x57, 0x88, 0xc4, 0x57, 0xfc, 0xd2, 0x65, 0x13, 0xf8, 0x19, 0x90, 0x37, 0x55, 0x18, 0x8c,
+	0x7a, 0xa6, 0xdd, 0xf9, 0xc6, 0xe8, 0x0d, 0x6b, 0x05, 0xe9, 0x83, 0xe5, 0x4a, 0x79, 0xea, 0x7b,
+	0x30, 0x27, 0x98, 0x4d, 0x42, 0x44, 0x38, 0x3c, 0x04, 0xe2, 0x26, 0x6a, 0xd7, 0x6c, 0x75, 0x6b,
+	0x45, 0xe9, 0xbd, 0xe5, 0x4a, 0xa9, 0x66, 0xa4, 0x2e, 0xf6, 0xa6, 0xf0, 0x4b, 0xf0, 0xd1, 0xa6,
+	0xf4, 0xfe, 0x60, 0xe8, 0x98, 0x3d, 0xa7, 0xd5, 0x69, 0xf4, 0xce, 0x8c, 0xda, 0xd6, 0xab, 0x56,
+	0xfb, 0x33, 0x6e, 0x92, 0xd6, 0xc4, 0x25, 0x01, 0x92, 0x8a, 0x3f, 0xff, 0x22, 0xe7, 0x9a, 0x83,
+	0xdb, 0x07, 0x59, 0xb8, 0x7b, 0x90, 0x85, 0xbf, 0x1f, 0x64, 0xe1, 0xe6, 0x51, 0xce, 0xdd, 0x3d,
+	0xca, 0xb9, 0x3f, 0x1f, 0xe5, 0xdc, 0xf7, 0xa7, 0x2f, 0x2e, 0xc0, 0xff, 0x3c, 0x5c, 0x57, 0x27,
+	0xfa, 0xf5, 0x8b, 0xd7, 0x2b, 0xb9, 0x14, 0xe3, 0x52, 0xf2, 0x43, 0x9d, 0xfc, 0x3b, 0x00, 0x38,
+	0x07, 0x30, 0xdc, 0xee, 0x04, 0x00, 0x00,
+}
+
+func (m *HistoryRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HistoryRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HistoryRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OptedIn {
+		i--
+		if m.OptedIn {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Kicker) > 0 {
+		i -= len(m.Kicker)
+		copy(dAtA[i:], m.Kicker)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Kicker)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Rewardee) > 0 {
+		i -= len(m.Rewardee)
+		copy(dAtA[i:], m.Rewardee)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Rewardee)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHistory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.NumBlocks != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.NumBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.StateIndex != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.StateIndex))
+		i--
+		dAtA[i] = 0x30
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintHistory(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Kind != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintHistory(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintHistory(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintHistory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovHistory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *HistoryRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovHistory(uint64(m.Id))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovHistory(uint64(m.Kind))
+	}
+	if m.Height != 0 {
+		n += 1 + sovHistory(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovHistory(uint64(l))
+	if m.StateIndex != 0 {
+		n += 1 + sovHistory(uint64(m.StateIndex))
+	}
+	if m.NumBlocks != 0 {
+		n += 1 + sovHistory(uint64(m.NumBlocks))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	l = len(m.Rewardee)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovHistory(uint64(l))
+		}
+	}
+	l = len(m.Kicker)
+	if l > 0 {
+		n += 1 + l + sovHistory(uint64(l))
+	}
+	if m.OptedIn {
+		n += 2
+	}
+	return n
+}
+
+func sovHistory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozHistory(x uint64) (n int) {
+	return sovHistory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *HistoryRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoryRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoryRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= HistoryRecordKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StateIndex", wireType)
+			}
+			m.StateIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StateIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumBlocks", wireType)
+			}
+			m.NumBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewardee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewardee = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kicker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHistory
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kicker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedIn", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OptedIn = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHistory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHistory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipHistory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowHistory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowHistory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthHistory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupHistory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthHistory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthHistory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowHistory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupHistory = fmt.Errorf("proto: unexpected end of group")
+)
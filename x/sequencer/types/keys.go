@@ -55,6 +55,18 @@ var (
 
 	DymintProposerAddrToAccAddrKeyPrefix = collections.NewPrefix([]byte{0x43})
 
+	PlannedRotationKeyPrefix = collections.NewPrefix([]byte{0x44}) // prefix/rollappId
+
+	DelegationsKeyPrefix            = collections.NewPrefix([]byte{0x45}) // prefix/seqAddr/delegator
+	DelegationsByDelegatorKeyPrefix = collections.NewPrefix([]byte{0x46}) // prefix/delegator/seqAddr
+
+	HistoryKeyPrefix     = collections.NewPrefix([]byte{0x47}) // prefix/seqAddr/id
+	HistoryNextKeyPrefix = collections.NewPrefix([]byte{0x48}) // prefix/seqAddr
+
+	UnbondingsKeyPrefix     = collections.NewPrefix([]byte{0x49}) // prefix/seqAddr/id
+	UnbondingQueueKeyPrefix = collections.NewPrefix([]byte{0x4a}) // prefix/completionTime/seqAddr/id
+	UnbondingIDKey          = collections.NewPrefix([]byte{0x4b})
+
 	// These keys were already used on mainnet. Don't reuse
 	_ = []byte{0xa3}
 	_ = []byte{0x41}
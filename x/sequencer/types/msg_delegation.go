@@ -0,0 +1,74 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	_ sdk.Msg = &MsgDelegate{}
+	_ sdk.Msg = &MsgUndelegate{}
+	_ sdk.Msg = &MsgDistributeRewards{}
+)
+
+func NewMsgDelegate(delegator, sequencer string, amount sdk.Coin) *MsgDelegate {
+	return &MsgDelegate{
+		Delegator: delegator,
+		Sequencer: sequencer,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgDelegate) ValidateBasic() error {
+	return validateDelegationMsg(msg.Delegator, msg.Sequencer, msg.Amount)
+}
+
+func NewMsgUndelegate(delegator, sequencer string, amount sdk.Coin) *MsgUndelegate {
+	return &MsgUndelegate{
+		Delegator: delegator,
+		Sequencer: sequencer,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgUndelegate) ValidateBasic() error {
+	return validateDelegationMsg(msg.Delegator, msg.Sequencer, msg.Amount)
+}
+
+func validateDelegationMsg(delegator, sequencer string, amount sdk.Coin) error {
+	_, err := sdk.AccAddressFromBech32(delegator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid delegator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(sequencer)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	if !amount.IsValid() || !amount.IsPositive() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid amount: %s", amount.String())
+	}
+	return nil
+}
+
+func NewMsgDistributeRewards(creator, sequencer string, amount sdk.Coins) *MsgDistributeRewards {
+	return &MsgDistributeRewards{
+		Creator:   creator,
+		Sequencer: sequencer,
+		Amount:    amount,
+	}
+}
+
+func (msg *MsgDistributeRewards) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Sequencer)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid sequencer address (%s)", err)
+	}
+	if !msg.Amount.IsValid() || msg.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrInvalidCoins, "invalid amount: %s", msg.Amount.String())
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/dymensionxyz/gerr-cosmos/gerrc"
+)
+
+var (
+	_ sdk.Msg = &MsgScheduleRotation{}
+	_ sdk.Msg = &MsgAcceptRotation{}
+	_ sdk.Msg = &MsgCancelRotation{}
+)
+
+func NewMsgScheduleRotation(creator, successor string, handoverHeight uint64) *MsgScheduleRotation {
+	return &MsgScheduleRotation{
+		Creator:        creator,
+		Successor:      successor,
+		HandoverHeight: handoverHeight,
+	}
+}
+
+func (msg *MsgScheduleRotation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+	_, err = sdk.AccAddressFromBech32(msg.Successor)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid successor address (%s)", err)
+	}
+	if msg.Creator == msg.Successor {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "successor is the creator")
+	}
+	// the proposer must be able to submit at least the first block before the handover
+	if msg.HandoverHeight < 2 {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, "handover height must be at least 2")
+	}
+	return nil
+}
+
+func NewMsgAcceptRotation(creator string) *MsgAcceptRotation {
+	return &MsgAcceptRotation{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgAcceptRotation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+func NewMsgCancelRotation(creator string) *MsgCancelRotation {
+	return &MsgCancelRotation{
+		Creator: creator,
+	}
+}
+
+func (msg *MsgCancelRotation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddr, "invalid creator address (%s)", err)
+	}
+	return nil
+}
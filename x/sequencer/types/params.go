@@ -22,6 +22,15 @@ var (
 	DefaultDishonorStateUpdate   = uint64(1)
 	DefaultDishonorLiveness      = uint64(300)
 	DefaultDishonorKickThreshold = uint64(900)
+
+	// DefaultHistoryLimit is the number of history records kept per sequencer
+	DefaultHistoryLimit = uint64(100)
+
+	// DefaultUnbondingTime is the time during which unbonded tokens can still be slashed
+	DefaultUnbondingTime = time.Hour * 24 * 21 // 3 weeks
+
+	// DefaultRewardsEpochIdentifier is the epoch at the end of which the reward pools are distributed
+	DefaultRewardsEpochIdentifier = "hour"
 )
 
 // NewParams creates a new Params instance
@@ -29,6 +38,8 @@ func NewParams(noticePeriod time.Duration, livenessSlashMul math.LegacyDec, live
 	dishonorStateUpdate uint64,
 	dishonorLiveness uint64,
 	dishonorKickThreshold uint64,
+	historyLimit uint64,
+	unbondingTime time.Duration,
 ) Params {
 	return Params{
 		NoticePeriod:               noticePeriod,
@@ -37,12 +48,16 @@ func NewParams(noticePeriod time.Duration, livenessSlashMul math.LegacyDec, live
 		DishonorStateUpdate:        dishonorStateUpdate,
 		DishonorLiveness:           dishonorLiveness,
 		DishonorKickThreshold:      dishonorKickThreshold,
+		HistoryLimit:               historyLimit,
+		UnbondingTime:              unbondingTime,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultNoticePeriod, DefaultLivenessSlashMultiplier, DefaultLivenessSlashMinAbsolute, DefaultDishonorStateUpdate, DefaultDishonorLiveness, DefaultDishonorKickThreshold)
+	p := NewParams(DefaultNoticePeriod, DefaultLivenessSlashMultiplier, DefaultLivenessSlashMinAbsolute, DefaultDishonorStateUpdate, DefaultDishonorLiveness, DefaultDishonorKickThreshold, DefaultHistoryLimit, DefaultUnbondingTime)
+	p.RewardsEpochIdentifier = DefaultRewardsEpochIdentifier
+	return p
 }
 
 func validateTime(v time.Duration) error {
@@ -81,9 +96,66 @@ func (p Params) ValidateBasic() error {
 		return err
 	}
 
+	if p.UnbondingTime < 0 {
+		return fmt.Errorf("unbonding time must not be negative: %d", p.UnbondingTime)
+	}
+
+	if err := validateBondDenoms(p.BondDenoms); err != nil {
+		return err
+	}
+
+	if p.RewardsEpochIdentifier == "" {
+		return fmt.Errorf("rewards epoch identifier must be set")
+	}
+
 	return nil
 }
 
+func validateBondDenoms(v []BondDenom) error {
+	seen := make(map[string]struct{}, len(v))
+	for _, d := range v {
+		if err := sdk.ValidateDenom(d.Denom); err != nil {
+			return fmt.Errorf("bond denom: %w", err)
+		}
+		if d.Denom == commontypes.DYMCoin.Denom {
+			return fmt.Errorf("bond denom: %s has a fixed weight", d.Denom)
+		}
+		if _, ok := seen[d.Denom]; ok {
+			return fmt.Errorf("duplicate bond denom: %s", d.Denom)
+		}
+		seen[d.Denom] = struct{}{}
+		if d.Weight.IsNil() || !d.Weight.IsPositive() {
+			return fmt.Errorf("bond denom weight must be positive: %s", d.Denom)
+		}
+	}
+	return nil
+}
+
+// BondWeight returns the weight of the denom for bonding: one for DYM, and false if the denom cannot be bonded
+func (p Params) BondWeight(denom string) (math.LegacyDec, bool) {
+	if denom == commontypes.DYMCoin.Denom {
+		return math.LegacyOneDec(), true
+	}
+	for _, d := range p.BondDenoms {
+		if d.Denom == denom {
+			return d.Weight, true
+		}
+	}
+	return math.LegacyDec{}, false
+}
+
+// BondValue returns the weighted value of the coins in adym, rounded down. Coins of denoms which cannot be
+// bonded are worth nothing.
+func (p Params) BondValue(coins sdk.Coins) math.Int {
+	v := math.ZeroInt()
+	for _, c := range coins {
+		if w, ok := p.BondWeight(c.Denom); ok {
+			v = v.Add(w.MulInt(c.Amount).TruncateInt())
+		}
+	}
+	return v
+}
+
 // String implements the Stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
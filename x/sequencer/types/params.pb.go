@@ -47,6 +47,20 @@ type Params struct {
 	DishonorStateUpdate uint64 `protobuf:"varint,8,opt,name=dishonor_state_update,json=dishonorStateUpdate,proto3" json:"dishonor_state_update,omitempty"`
 	// the minimum dishonor at which a sequencer can be kicked (<=)
 	DishonorKickThreshold uint64 `protobuf:"varint,9,opt,name=dishonor_kick_threshold,json=dishonorKickThreshold,proto3" json:"dishonor_kick_threshold,omitempty"`
+	// history_limit is the number of records kept in the history of each
+	// sequencer, the oldest ones being pruned. Zero disables the history.
+	HistoryLimit uint64 `protobuf:"varint,10,opt,name=history_limit,json=historyLimit,proto3" json:"history_limit,omitempty"`
+	// unbonding_time is the time during which unbonded tokens can still be
+	// slashed, before they are released
+	UnbondingTime time.Duration `protobuf:"bytes,11,opt,name=unbonding_time,json=unbondingTime,proto3,stdduration" json:"unbonding_time"`
+	// bond_denoms are the denoms, other than DYM, that sequencers can bond in,
+	// with their weight. Rollapps choose which of them are accepted for their
+	// sequencers.
+	BondDenoms []BondDenom `protobuf:"bytes,12,rep,name=bond_denoms,json=bondDenoms,proto3" json:"bond_denoms"`
+	// rewards_epoch_identifier is the epoch at the end of which the rewards
+	// collected in the reward pools of the sequencers are shared with their
+	// delegators
+	RewardsEpochIdentifier string `protobuf:"bytes,13,opt,name=rewards_epoch_identifier,json=rewardsEpochIdentifier,proto3" json:"rewards_epoch_identifier,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -116,8 +130,85 @@ func (m *Params) GetDishonorKickThreshold() uint64 {
 	return 0
 }
 
+func (m *Params) GetHistoryLimit() uint64 {
+	if m != nil {
+		return m.HistoryLimit
+	}
+	return 0
+}
+
+func (m *Params) GetUnbondingTime() time.Duration {
+	if m != nil {
+		return m.UnbondingTime
+	}
+	return 0
+}
+
+func (m *Params) GetBondDenoms() []BondDenom {
+	if m != nil {
+		return m.BondDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetRewardsEpochIdentifier() string {
+	if m != nil {
+		return m.RewardsEpochIdentifier
+	}
+	return ""
+}
+
+// BondDenom is a denom sequencers can bond in
+type BondDenom struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// weight is the value of one unit of the denom in adym. It is applied to the
+	// min bond, liveness slashing and the proposer choice.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *BondDenom) Reset()         { *m = BondDenom{} }
+func (m *BondDenom) String() string { return proto.CompactTextString(m) }
+func (*BondDenom) ProtoMessage()    {}
+func (*BondDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_599b0eefba99ee26, []int{1}
+}
+func (m *BondDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BondDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BondDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BondDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BondDenom.Merge(m, src)
+}
+func (m *BondDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *BondDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_BondDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BondDenom proto.InternalMessageInfo
+
+func (m *BondDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dymensionxyz.dymension.sequencer.Params")
+	proto.RegisterType((*BondDenom)(nil), "dymensionxyz.dymension.sequencer.BondDenom")
 }
 
 func init() {
@@ -125,41 +216,50 @@ func init() {
 }
 
 var fileDescriptor_599b0eefba99ee26 = []byte{
-	// 530 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x73, 0xad, 0x09, 0xae, 0x01, 0x29, 0x18, 0x10, 0x6e, 0x2a, 0x6c, 0x2b, 0x12, 0x52,
-	0x24, 0xc8, 0x9d, 0xda, 0x4a, 0x1d, 0xba, 0x11, 0x32, 0xa0, 0xd0, 0x4a, 0x51, 0x4a, 0x17, 0x16,
-	0xeb, 0x6c, 0x1f, 0xf6, 0x29, 0xb6, 0xcf, 0xf8, 0xce, 0x51, 0xcd, 0x17, 0x60, 0x65, 0xcc, 0xd8,
-	0x0f, 0xc0, 0xc8, 0x87, 0xe8, 0x58, 0x31, 0x21, 0x86, 0x80, 0x92, 0x05, 0x31, 0xf2, 0x09, 0x90,
-	0xff, 0xaa, 0xaa, 0xa0, 0x5b, 0x9e, 0x7b, 0x7e, 0xcf, 0x93, 0xf7, 0x5e, 0xf9, 0x94, 0x81, 0x9b,
-	0x85, 0x24, 0xe2, 0x94, 0x45, 0x67, 0xd9, 0x07, 0xd4, 0x08, 0xc4, 0xc9, 0xfb, 0x94, 0x44, 0x0e,
-	0x49, 0x50, 0x8c, 0x13, 0x1c, 0x72, 0x18, 0x27, 0x4c, 0x30, 0xd5, 0xbc, 0x8a, 0xc3, 0x46, 0xc0,
-	0x06, 0xef, 0x3e, 0xf4, 0x98, 0xc7, 0x0a, 0x18, 0xe5, 0xbf, 0xca, 0x5c, 0x77, 0xdb, 0x61, 0x3c,
-	0x64, 0xdc, 0x2a, 0x8d, 0x52, 0x54, 0x96, 0x5e, 0x2a, 0x64, 0x63, 0x4e, 0xd0, 0x7c, 0xd7, 0x26,
-	0x02, 0xef, 0x22, 0x87, 0xd1, 0xa8, 0xf6, 0x3d, 0xc6, 0xbc, 0x80, 0xa0, 0x42, 0xd9, 0xe9, 0x3b,
-	0xe4, 0xa6, 0x09, 0x16, 0xf9, 0x9f, 0x16, 0x27, 0xbd, 0xcf, 0x92, 0xd2, 0x9e, 0x14, 0x33, 0xaa,
-	0xaf, 0x94, 0x7b, 0x11, 0x13, 0xd4, 0x21, 0x56, 0x4c, 0x12, 0xca, 0x5c, 0x6d, 0xd3, 0x04, 0xfd,
-	0x3b, 0x7b, 0xdb, 0xb0, 0xac, 0x80, 0x75, 0x05, 0x1c, 0x55, 0x15, 0x43, 0xf9, 0x62, 0x69, 0xb4,
-	0x16, 0x3f, 0x0c, 0x30, 0xbd, 0x5b, 0x26, 0x27, 0x45, 0x50, 0x5d, 0x00, 0xe5, 0x49, 0x40, 0xe7,
-	0x24, 0x22, 0x9c, 0x5b, 0x3c, 0xc0, 0xdc, 0xb7, 0x42, 0x1a, 0x59, 0x61, 0x1a, 0x08, 0x1a, 0x07,
-	0x94, 0x24, 0x9a, 0x64, 0x82, 0xfe, 0xd6, 0xf0, 0x34, 0xcf, 0x7f, 0x5f, 0x1a, 0x3b, 0xe5, 0x25,
-	0xb8, 0x3b, 0x83, 0x94, 0xa1, 0x10, 0x0b, 0x1f, 0x1e, 0x11, 0x0f, 0x3b, 0xd9, 0x88, 0x38, 0x7f,
-	0x96, 0x86, 0x99, 0xe1, 0x30, 0x38, 0xec, 0x5d, 0x6f, 0x6c, 0xda, 0x7a, 0x5f, 0xbf, 0x0c, 0x94,
-	0x6a, 0x2b, 0x23, 0xe2, 0x4c, 0xbb, 0x35, 0x79, 0x92, 0x83, 0xc7, 0x34, 0x3a, 0x6e, 0x50, 0xf5,
-	0x23, 0x50, 0x76, 0xfe, 0x31, 0x1a, 0xb6, 0x39, 0x0b, 0x52, 0x41, 0xb4, 0x76, 0x75, 0xe7, 0xaa,
-	0x2e, 0x5f, 0x2b, 0xac, 0xd6, 0x0a, 0x5f, 0x32, 0x1a, 0x0d, 0x07, 0xf9, 0xcc, 0xbf, 0x97, 0xc6,
-	0xd3, 0x1b, 0x5a, 0x9e, 0xb3, 0x90, 0x0a, 0x12, 0xc6, 0x22, 0x9b, 0x6a, 0xd7, 0x67, 0x79, 0x51,
-	0x31, 0xea, 0x33, 0xe5, 0xbe, 0x4b, 0xb9, 0xcf, 0x22, 0x96, 0x58, 0x35, 0xa4, 0xdd, 0x36, 0x41,
-	0x5f, 0x9a, 0x76, 0x6a, 0xe3, 0xa8, 0x3a, 0x57, 0xf7, 0x94, 0x47, 0x0d, 0xcc, 0x05, 0x16, 0xc4,
-	0x4a, 0x63, 0x17, 0x0b, 0xa2, 0xc9, 0x45, 0xe0, 0x41, 0x6d, 0x9e, 0xe4, 0xde, 0x69, 0x61, 0xa9,
-	0x07, 0xca, 0xe3, 0x26, 0x33, 0xa3, 0xce, 0xcc, 0x12, 0x7e, 0x42, 0xb8, 0xcf, 0x02, 0x57, 0xdb,
-	0x2a, 0x52, 0x4d, 0xe5, 0x6b, 0xea, 0xcc, 0xde, 0xd4, 0xe6, 0xa1, 0xbc, 0x38, 0x37, 0x5a, 0xbf,
-	0xce, 0x0d, 0x30, 0x96, 0x64, 0xd0, 0xd9, 0x18, 0x4b, 0xf2, 0xad, 0x4e, 0x7b, 0x2c, 0xc9, 0x1b,
-	0x9d, 0xcd, 0xe1, 0xe4, 0x62, 0xa5, 0x83, 0xcb, 0x95, 0x0e, 0x7e, 0xae, 0x74, 0xf0, 0x69, 0xad,
-	0xb7, 0x2e, 0xd7, 0x7a, 0xeb, 0xdb, 0x5a, 0x6f, 0xbd, 0x3d, 0xf0, 0xa8, 0xf0, 0x53, 0x1b, 0x3a,
-	0x2c, 0x44, 0xff, 0x79, 0x15, 0xf3, 0x7d, 0x74, 0x76, 0xe5, 0x69, 0x88, 0x2c, 0x26, 0xdc, 0x6e,
-	0x17, 0x9f, 0xd5, 0xfe, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xe4, 0xe4, 0x9d, 0x4b, 0x03,
-	0x00, 0x00,
+	// 681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x4f, 0x13, 0x4f,
+	0x18, 0xef, 0x42, 0xe9, 0xbf, 0x4c, 0xe9, 0x3f, 0x75, 0x45, 0x5d, 0x20, 0xb6, 0x4d, 0x8d, 0x49,
+	0x13, 0x64, 0x37, 0x40, 0x42, 0x0c, 0x37, 0x6b, 0x4d, 0xa4, 0x42, 0x42, 0x0a, 0x5c, 0xbc, 0x6c,
+	0xf6, 0xe5, 0x61, 0x77, 0xd2, 0x9d, 0x99, 0x75, 0x67, 0x16, 0xa8, 0x5f, 0xc0, 0xab, 0x47, 0x8e,
+	0xdc, 0xbd, 0xfa, 0x21, 0x38, 0x12, 0x4f, 0xc6, 0x43, 0x35, 0x70, 0x31, 0x1e, 0xfd, 0x04, 0x66,
+	0xf6, 0x2d, 0x84, 0xf8, 0xc2, 0xad, 0xbf, 0xe7, 0xf7, 0x32, 0xcf, 0x3c, 0x7d, 0x76, 0xd0, 0x8a,
+	0x3b, 0x26, 0x40, 0x39, 0x66, 0xf4, 0x64, 0xfc, 0xd6, 0x28, 0x80, 0xc1, 0xe1, 0x4d, 0x0c, 0xd4,
+	0x81, 0xc8, 0x08, 0xad, 0xc8, 0x22, 0x5c, 0x0f, 0x23, 0x26, 0x98, 0xda, 0xbe, 0x2e, 0xd7, 0x0b,
+	0xa0, 0x17, 0xf2, 0xc5, 0x79, 0x8f, 0x79, 0x2c, 0x11, 0x1b, 0xf2, 0x57, 0xea, 0x5b, 0x5c, 0x70,
+	0x18, 0x27, 0x8c, 0x9b, 0x29, 0x91, 0x82, 0x8c, 0x6a, 0xa6, 0xc8, 0xb0, 0x2d, 0x0e, 0xc6, 0xd1,
+	0xaa, 0x0d, 0xc2, 0x5a, 0x35, 0x1c, 0x86, 0x69, 0xce, 0x7b, 0x8c, 0x79, 0x01, 0x18, 0x09, 0xb2,
+	0xe3, 0x43, 0xc3, 0x8d, 0x23, 0x4b, 0xc8, 0x43, 0x93, 0x4a, 0xe7, 0x43, 0x05, 0x55, 0x76, 0x93,
+	0x1e, 0xd5, 0x97, 0xa8, 0x4e, 0x99, 0xc0, 0x0e, 0x98, 0x21, 0x44, 0x98, 0xb9, 0xda, 0x74, 0x5b,
+	0xe9, 0xd6, 0xd6, 0x16, 0xf4, 0x34, 0x42, 0xcf, 0x23, 0xf4, 0x7e, 0x16, 0xd1, 0xab, 0x9e, 0x4f,
+	0x5a, 0xa5, 0xd3, 0xaf, 0x2d, 0x65, 0x38, 0x97, 0x3a, 0x77, 0x13, 0xa3, 0x7a, 0xaa, 0xa0, 0x87,
+	0x01, 0x3e, 0x02, 0x0a, 0x9c, 0x9b, 0x3c, 0xb0, 0xb8, 0x6f, 0x12, 0x4c, 0x4d, 0x12, 0x07, 0x02,
+	0x87, 0x01, 0x86, 0x48, 0x2b, 0xb7, 0x95, 0xee, 0x6c, 0xef, 0x40, 0xfa, 0xbf, 0x4c, 0x5a, 0x4b,
+	0xe9, 0x25, 0xb8, 0x3b, 0xd2, 0x31, 0x33, 0x88, 0x25, 0x7c, 0x7d, 0x1b, 0x3c, 0xcb, 0x19, 0xf7,
+	0xc1, 0xf9, 0x39, 0x69, 0xb5, 0xc7, 0x16, 0x09, 0x36, 0x3b, 0x37, 0x13, 0x8b, 0xb4, 0xce, 0xa7,
+	0x8f, 0x2b, 0x28, 0x9b, 0x4a, 0x1f, 0x9c, 0xe1, 0x62, 0xae, 0xdc, 0x93, 0xc2, 0x1d, 0x4c, 0x77,
+	0x0a, 0xa9, 0xfa, 0x4e, 0x41, 0x4b, 0xbf, 0x69, 0xcd, 0xb2, 0x39, 0x0b, 0x62, 0x01, 0x5a, 0x25,
+	0xbb, 0x73, 0x16, 0x27, 0xc7, 0xaa, 0x67, 0x63, 0xd5, 0x9f, 0x33, 0x4c, 0x7b, 0x2b, 0xb2, 0xe7,
+	0x1f, 0x93, 0xd6, 0xe3, 0xbf, 0xa4, 0x3c, 0x61, 0x04, 0x0b, 0x20, 0xa1, 0x18, 0x0f, 0xb5, 0x9b,
+	0xbd, 0x3c, 0xcb, 0x34, 0xea, 0x32, 0xba, 0xe3, 0x62, 0xee, 0x33, 0xca, 0x22, 0x33, 0x17, 0x69,
+	0xff, 0xb5, 0x95, 0x6e, 0x79, 0xd8, 0xc8, 0x89, 0xed, 0xac, 0xae, 0xae, 0xa1, 0x7b, 0x85, 0x98,
+	0x0b, 0x4b, 0x80, 0x19, 0x87, 0xae, 0x25, 0x40, 0xab, 0x26, 0x86, 0xbb, 0x39, 0xb9, 0x27, 0xb9,
+	0x83, 0x84, 0x52, 0x37, 0xd0, 0x83, 0xc2, 0x33, 0xc2, 0xce, 0xc8, 0x14, 0x7e, 0x04, 0xdc, 0x67,
+	0x81, 0xab, 0xcd, 0x26, 0xae, 0x22, 0xf2, 0x15, 0x76, 0x46, 0xfb, 0x39, 0xa9, 0x3e, 0x42, 0x75,
+	0x1f, 0x73, 0xc1, 0xa2, 0xb1, 0x19, 0x60, 0x82, 0x85, 0x86, 0x12, 0xf5, 0x5c, 0x56, 0xdc, 0x96,
+	0x35, 0x75, 0x80, 0xfe, 0x8f, 0xa9, 0xcd, 0xa8, 0x8b, 0xa9, 0x67, 0x0a, 0x4c, 0x40, 0xab, 0xdd,
+	0x7e, 0x5b, 0xea, 0x85, 0x75, 0x1f, 0x13, 0x50, 0x87, 0xa8, 0x26, 0xa1, 0xe9, 0x02, 0x65, 0x84,
+	0x6b, 0x73, 0xed, 0xe9, 0x6e, 0x6d, 0x6d, 0x59, 0xff, 0xd7, 0xc7, 0xa2, 0xf7, 0x18, 0x75, 0xfb,
+	0xd2, 0xd3, 0x2b, 0xcb, 0xe8, 0x21, 0xb2, 0xf3, 0x02, 0x57, 0x9f, 0x22, 0x2d, 0x82, 0x63, 0x2b,
+	0x72, 0xb9, 0x09, 0x21, 0x73, 0x7c, 0x13, 0xbb, 0x40, 0x05, 0x3e, 0x94, 0xcb, 0x57, 0x97, 0xcb,
+	0x37, 0xbc, 0x9f, 0xf1, 0x2f, 0x24, 0xbd, 0x55, 0xb0, 0x9b, 0xd5, 0xd3, 0xb3, 0x56, 0xe9, 0xfb,
+	0x59, 0x4b, 0x19, 0x94, 0xab, 0x4a, 0x63, 0x6a, 0x50, 0xae, 0xce, 0x34, 0x2a, 0x83, 0x72, 0x75,
+	0xaa, 0x31, 0xdd, 0x89, 0xd0, 0x6c, 0x71, 0xa8, 0x3a, 0x8f, 0x66, 0x92, 0x8e, 0x35, 0x25, 0xc9,
+	0x4b, 0x81, 0xba, 0x85, 0x2a, 0xc7, 0x80, 0x3d, 0x5f, 0x68, 0x53, 0xc9, 0x8e, 0xaf, 0xde, 0x62,
+	0xc7, 0x6f, 0xec, 0x6f, 0x16, 0xb0, 0x59, 0x96, 0x5d, 0xf4, 0x76, 0xcf, 0x2f, 0x9b, 0xca, 0xc5,
+	0x65, 0x53, 0xf9, 0x76, 0xd9, 0x54, 0xde, 0x5f, 0x35, 0x4b, 0x17, 0x57, 0xcd, 0xd2, 0xe7, 0xab,
+	0x66, 0xe9, 0xf5, 0x86, 0x87, 0x85, 0x1f, 0xdb, 0xba, 0xc3, 0x88, 0xf1, 0x87, 0x87, 0xe8, 0x68,
+	0xdd, 0x38, 0xb9, 0xf6, 0x1a, 0x89, 0x71, 0x08, 0xdc, 0xae, 0x24, 0xff, 0xcd, 0xfa, 0xaf, 0x01,
+	0x00, 0xd2, 0xaa, 0xa9, 0x29, 0xbe, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -199,6 +299,50 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.DishonorKickThreshold != that1.DishonorKickThreshold {
 		return false
 	}
+	if this.HistoryLimit != that1.HistoryLimit {
+		return false
+	}
+	if this.UnbondingTime != that1.UnbondingTime {
+		return false
+	}
+	if len(this.BondDenoms) != len(that1.BondDenoms) {
+		return false
+	}
+	for i := range this.BondDenoms {
+		if !this.BondDenoms[i].Equal(&that1.BondDenoms[i]) {
+			return false
+		}
+	}
+	if this.RewardsEpochIdentifier != that1.RewardsEpochIdentifier {
+		return false
+	}
+	return true
+}
+func (this *BondDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*BondDenom)
+	if !ok {
+		that2, ok := that.(BondDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -221,6 +365,40 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RewardsEpochIdentifier) > 0 {
+		i -= len(m.RewardsEpochIdentifier)
+		copy(dAtA[i:], m.RewardsEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.RewardsEpochIdentifier)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.BondDenoms) > 0 {
+		for iNdEx := len(m.BondDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BondDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	if m.HistoryLimit != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HistoryLimit))
+		i--
+		dAtA[i] = 0x50
+	}
 	if m.DishonorKickThreshold != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.DishonorKickThreshold))
 		i--
@@ -256,17 +434,57 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x22
-	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.NoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.NoticePeriod):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x1a
 	return len(dAtA) - i, nil
 }
 
+func (m *BondDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BondDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BondDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -299,6 +517,36 @@ func (m *Params) Size() (n int) {
 	if m.DishonorKickThreshold != 0 {
 		n += 1 + sovParams(uint64(m.DishonorKickThreshold))
 	}
+	if m.HistoryLimit != 0 {
+		n += 1 + sovParams(uint64(m.HistoryLimit))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime)
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.BondDenoms) > 0 {
+		for _, e := range m.BondDenoms {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.RewardsEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	return n
+}
+
+func (m *BondDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -494,6 +742,240 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryLimit", wireType)
+			}
+			m.HistoryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.UnbondingTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BondDenoms = append(m.BondDenoms, BondDenom{})
+			if err := m.BondDenoms[len(m.BondDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BondDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BondDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BondDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
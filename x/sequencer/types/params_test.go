@@ -38,6 +38,15 @@ func TestValidateBasic(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"missing rewards epoch identifier",
+			func() Params {
+				p := params
+				p.RewardsEpochIdentifier = ""
+				return p
+			}(),
+			true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,574 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/rotation.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PlannedRotation is a handover of a rollapp from its proposer to a named
+// successor, announced ahead of time by the proposer. Once the successor
+// accepts, the last state update of the proposer must end at the block right
+// before the handover height and name the successor as next proposer.
+type PlannedRotation struct {
+	RollappId string `protobuf:"bytes,1,opt,name=rollapp_id,json=rollappId,proto3" json:"rollapp_id,omitempty"`
+	// proposer is the bech32-encoded address of the proposer handing over
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// successor is the bech32-encoded address of the sequencer taking over
+	Successor string `protobuf:"bytes,3,opt,name=successor,proto3" json:"successor,omitempty"`
+	// handover_height is the first rollapp height produced by the successor
+	HandoverHeight uint64 `protobuf:"varint,4,opt,name=handover_height,json=handoverHeight,proto3" json:"handover_height,omitempty"`
+	// accepted is true once the successor committed to take over
+	Accepted bool `protobuf:"varint,5,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// scheduled_at is the time the rotation was announced
+	ScheduledAt time.Time `protobuf:"bytes,6,opt,name=scheduled_at,json=scheduledAt,proto3,stdtime" json:"scheduled_at"`
+}
+
+func (m *PlannedRotation) Reset()         { *m = PlannedRotation{} }
+func (m *PlannedRotation) String() string { return proto.CompactTextString(m) }
+func (*PlannedRotation) ProtoMessage()    {}
+func (*PlannedRotation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6616b3a662ae0646, []int{0}
+}
+func (m *PlannedRotation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlannedRotation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlannedRotation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlannedRotation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlannedRotation.Merge(m, src)
+}
+func (m *PlannedRotation) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlannedRotation) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlannedRotation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlannedRotation proto.InternalMessageInfo
+
+func (m *PlannedRotation) GetRollappId() string {
+	if m != nil {
+		return m.RollappId
+	}
+	return ""
+}
+
+func (m *PlannedRotation) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *PlannedRotation) GetSuccessor() string {
+	if m != nil {
+		return m.Successor
+	}
+	return ""
+}
+
+func (m *PlannedRotation) GetHandoverHeight() uint64 {
+	if m != nil {
+		return m.HandoverHeight
+	}
+	return 0
+}
+
+func (m *PlannedRotation) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
+	}
+	return false
+}
+
+func (m *PlannedRotation) GetScheduledAt() time.Time {
+	if m != nil {
+		return m.ScheduledAt
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PlannedRotation)(nil), "dymensionxyz.dymension.sequencer.PlannedRotation")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/rotation.proto", fileDescriptor_6616b3a662ae0646)
+}
+
+var fileDescriptor_6616b3a662ae0646 = []byte{
+	// 370 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x91, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0x86, 0xbd, 0xc7, 0x71, 0x72, 0xf6, 0x10, 0x27, 0x59, 0x57, 0x18, 0x4b, 0x38, 0x16, 0x0d,
+	0x6e, 0xb0, 0x25, 0x0e, 0xa5, 0x4f, 0x1a, 0xa0, 0x8b, 0x0c, 0x15, 0x8d, 0xe5, 0xec, 0x0e, 0xb6,
+	0x25, 0x7b, 0x67, 0xd9, 0x5d, 0x47, 0x09, 0x4f, 0x91, 0x07, 0xa1, 0xe4, 0x21, 0x52, 0x46, 0x54,
+	0x54, 0x80, 0x92, 0x17, 0x41, 0xb1, 0x1d, 0x27, 0x0d, 0xa2, 0xdb, 0xff, 0xdf, 0xff, 0xd3, 0x8c,
+	0xfe, 0xa1, 0x31, 0x5f, 0xd7, 0x20, 0x74, 0x89, 0x62, 0xb5, 0xfe, 0x7a, 0x16, 0xb1, 0x86, 0x2f,
+	0x0d, 0x08, 0x06, 0x2a, 0x56, 0x68, 0x32, 0x53, 0xa2, 0x88, 0xa4, 0x42, 0x83, 0x4e, 0x70, 0x09,
+	0x44, 0x83, 0x88, 0x06, 0xc0, 0x7b, 0xc6, 0x50, 0xd7, 0xa8, 0xd3, 0x36, 0x1f, 0x77, 0xa2, 0x83,
+	0xbd, 0xfb, 0x1c, 0x73, 0xec, 0xfc, 0xe3, 0xab, 0x77, 0xc7, 0x39, 0x62, 0x5e, 0x41, 0xdc, 0xaa,
+	0x45, 0xf3, 0x39, 0x36, 0x65, 0x0d, 0xda, 0x64, 0xb5, 0xec, 0x02, 0x2f, 0xbe, 0x5d, 0xd1, 0xbb,
+	0x79, 0x95, 0x09, 0x01, 0x3c, 0xe9, 0xb7, 0x71, 0x9e, 0x53, 0xaa, 0xb0, 0xaa, 0x32, 0x29, 0xd3,
+	0x92, 0xbb, 0x24, 0x20, 0xe1, 0x28, 0x19, 0xf5, 0xce, 0x7b, 0xee, 0xbc, 0xa1, 0xb6, 0x54, 0x28,
+	0x51, 0x83, 0x72, 0xaf, 0x8e, 0x9f, 0x33, 0xf7, 0xc7, 0xf7, 0x57, 0xf7, 0xfd, 0x36, 0x53, 0xce,
+	0x15, 0x68, 0xfd, 0xc1, 0xa8, 0x52, 0xe4, 0xc9, 0x90, 0x74, 0x26, 0x74, 0xa4, 0x1b, 0xc6, 0x40,
+	0x6b, 0x54, 0xee, 0xa3, 0xff, 0x60, 0xe7, 0xa8, 0xf3, 0x92, 0xde, 0x15, 0x99, 0xe0, 0xb8, 0x04,
+	0x95, 0x16, 0x50, 0xe6, 0x85, 0x71, 0xaf, 0x03, 0x12, 0x5e, 0x27, 0x4f, 0x4f, 0xf6, 0xbb, 0xd6,
+	0x75, 0x3c, 0x6a, 0x67, 0x8c, 0x81, 0x34, 0xc0, 0xdd, 0xc7, 0x01, 0x09, 0xed, 0x64, 0xd0, 0xce,
+	0x5b, 0xfa, 0x44, 0xb3, 0x02, 0x78, 0x53, 0x01, 0x4f, 0x33, 0xe3, 0xde, 0x04, 0x24, 0xbc, 0x7d,
+	0xed, 0x45, 0x5d, 0x3b, 0xd1, 0xa9, 0x9d, 0xe8, 0xe3, 0xa9, 0x9d, 0x99, 0xbd, 0xfd, 0x35, 0xb6,
+	0x36, 0xbf, 0xc7, 0x24, 0xb9, 0x1d, 0xc8, 0xa9, 0x99, 0xcd, 0xb7, 0x7b, 0x9f, 0xec, 0xf6, 0x3e,
+	0xf9, 0xb3, 0xf7, 0xc9, 0xe6, 0xe0, 0x5b, 0xbb, 0x83, 0x6f, 0xfd, 0x3c, 0xf8, 0xd6, 0xa7, 0x49,
+	0x5e, 0x9a, 0xa2, 0x59, 0x44, 0x0c, 0xeb, 0x7f, 0x1d, 0x7e, 0xf9, 0x10, 0xaf, 0x2e, 0xae, 0x6f,
+	0xd6, 0x12, 0xf4, 0xe2, 0xa6, 0x1d, 0xfe, 0xf0, 0x77, 0x00, 0xb4, 0xeb, 0xf6, 0xd1, 0x2e, 0x02,
+	0x00, 0x00,
+}
+
+func (m *PlannedRotation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlannedRotation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlannedRotation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ScheduledAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ScheduledAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRotation(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HandoverHeight != 0 {
+		i = encodeVarintRotation(dAtA, i, uint64(m.HandoverHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Successor) > 0 {
+		i -= len(m.Successor)
+		copy(dAtA[i:], m.Successor)
+		i = encodeVarintRotation(dAtA, i, uint64(len(m.Successor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintRotation(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RollappId) > 0 {
+		i -= len(m.RollappId)
+		copy(dAtA[i:], m.RollappId)
+		i = encodeVarintRotation(dAtA, i, uint64(len(m.RollappId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRotation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRotation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PlannedRotation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RollappId)
+	if l > 0 {
+		n += 1 + l + sovRotation(uint64(l))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovRotation(uint64(l))
+	}
+	l = len(m.Successor)
+	if l > 0 {
+		n += 1 + l + sovRotation(uint64(l))
+	}
+	if m.HandoverHeight != 0 {
+		n += 1 + sovRotation(uint64(m.HandoverHeight))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ScheduledAt)
+	n += 1 + l + sovRotation(uint64(l))
+	return n
+}
+
+func sovRotation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRotation(x uint64) (n int) {
+	return sovRotation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PlannedRotation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRotation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlannedRotation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlannedRotation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RollappId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RollappId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Successor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRotation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Successor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandoverHeight", wireType)
+			}
+			m.HandoverHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HandoverHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRotation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRotation
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRotation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ScheduledAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRotation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRotation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRotation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRotation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRotation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRotation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRotation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRotation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRotation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRotation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRotation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRotation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,14 +5,18 @@ import (
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	comettypes "github.com/cometbft/cometbft/types"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/dymensionxyz/gerr-cosmos/gerrc"
 	"github.com/dymensionxyz/sdk-utils/utils/uevent"
+
+	commontypes "github.com/dymensionxyz/dymension/v3/x/common/types"
 )
 
 const (
@@ -34,8 +38,11 @@ func NewTestSequencer(
 
 // ValidateBasic performs basic validation of the sequencer object
 func (seq Sequencer) ValidateBasic() error {
-	if seq.Tokens.Len() != 1 {
-		return gerrc.ErrInvalidArgument.Wrap("expect one coin")
+	if seq.Tokens.Len() < 1 || seq.Tokens[0].Denom != commontypes.DYMCoin.Denom {
+		return gerrc.ErrInvalidArgument.Wrap("expect dym coin first")
+	}
+	if err := seq.OtherTokens().Validate(); err != nil {
+		return errorsmod.Wrap(gerrc.ErrInvalidArgument, err.Error())
 	}
 	return nil
 }
@@ -65,6 +72,7 @@ func (seq Sequencer) IsPotentialProposer() bool {
 	return seq.Bonded() && seq.OptedIn
 }
 
+// TokensCoin returns the DYM part of the tokens, which is always first, even when zero
 func (seq Sequencer) TokensCoin() sdk.Coin {
 	return seq.Tokens[0]
 }
@@ -73,6 +81,91 @@ func (seq Sequencer) SetTokensCoin(c sdk.Coin) {
 	seq.Tokens[0] = c
 }
 
+// OtherTokens returns the tokens bonded in other denoms than DYM
+func (seq Sequencer) OtherTokens() sdk.Coins {
+	return seq.Tokens[1:]
+}
+
+// AllTokens returns the non zero tokens of all denoms
+func (seq Sequencer) AllTokens() sdk.Coins {
+	return sdk.NewCoins(seq.Tokens...)
+}
+
+func (seq *Sequencer) AddTokens(amt sdk.Coins) {
+	for _, c := range amt {
+		if c.Denom == seq.TokensCoin().Denom {
+			seq.SetTokensCoin(seq.TokensCoin().Add(c))
+		} else {
+			seq.Tokens = append(sdk.Coins{seq.TokensCoin()}, seq.OtherTokens().Add(c)...)
+		}
+	}
+}
+
+func (seq *Sequencer) SubTokens(amt sdk.Coins) {
+	for _, c := range amt {
+		if c.Denom == seq.TokensCoin().Denom {
+			seq.SetTokensCoin(seq.TokensCoin().Sub(c))
+		} else {
+			seq.Tokens = append(sdk.Coins{seq.TokensCoin()}, seq.OtherTokens().Sub(c)...)
+		}
+	}
+}
+
+// Delegated returns the part of the tokens delegated by third party stakers
+func (seq Sequencer) Delegated() math.Int {
+	if seq.DelegatedTokens == nil {
+		return math.ZeroInt()
+	}
+	return *seq.DelegatedTokens
+}
+
+// Shares returns the total of the shares issued to the delegators
+func (seq Sequencer) Shares() math.LegacyDec {
+	if seq.DelegatorShares == nil {
+		return math.LegacyZeroDec()
+	}
+	return *seq.DelegatorShares
+}
+
+func (seq *Sequencer) SetDelegated(tokens math.Int, shares math.LegacyDec) {
+	seq.DelegatedTokens = &tokens
+	seq.DelegatorShares = &shares
+}
+
+// SelfBondCoin returns the part of the tokens owned by the sequencer itself
+func (seq Sequencer) SelfBondCoin() sdk.Coin {
+	c := seq.TokensCoin()
+	return sdk.NewCoin(c.Denom, c.Amount.Sub(seq.Delegated()))
+}
+
+// SelfBond returns the non zero tokens of all denoms owned by the sequencer itself. Only DYM can be
+// delegated.
+func (seq Sequencer) SelfBond() sdk.Coins {
+	return sdk.NewCoins(seq.OtherTokens()...).Add(seq.SelfBondCoin())
+}
+
+// SharesToTokens returns what the shares are worth, rounded down
+func (seq Sequencer) SharesToTokens(shares math.LegacyDec) math.Int {
+	if !seq.Shares().IsPositive() {
+		return math.ZeroInt()
+	}
+	return shares.MulInt(seq.Delegated()).Quo(seq.Shares()).TruncateInt()
+}
+
+// RewardPoolAddr returns the account collecting the rewards of the sequencer, to be shared with its delegators
+func (seq Sequencer) RewardPoolAddr() sdk.AccAddress {
+	return address.Module(ModuleName, []byte(seq.Address))
+}
+
+// RewardRecipient returns where the rewards of the sequencer are to be paid: its reward pool if it has
+// delegators, for them to get their share, otherwise its reward address
+func (seq Sequencer) RewardRecipient() string {
+	if seq.Shares().IsPositive() {
+		return seq.RewardPoolAddr().String()
+	}
+	return seq.RewardAddr
+}
+
 func (seq Sequencer) AccAddr() sdk.AccAddress {
 	return sdk.MustAccAddressFromBech32(seq.Address)
 }
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
@@ -63,6 +64,12 @@ type Sequencer struct {
 	// how badly behaved sequencer is, can incur penalties (kicking) when high
 	// 0 is good/default, more is worse
 	Dishonor uint64 `protobuf:"varint,15,opt,name=dishonor,proto3" json:"dishonor,omitempty"`
+	// delegated_tokens is the part of tokens which was delegated by third party
+	// stakers. The rest is the self bond of the sequencer. Unset means zero.
+	DelegatedTokens *cosmossdk_io_math.Int `protobuf:"bytes,16,opt,name=delegated_tokens,json=delegatedTokens,proto3,customtype=cosmossdk.io/math.Int" json:"delegated_tokens,omitempty"`
+	// delegator_shares is the total of the shares issued to the delegators for
+	// delegated_tokens. Slashing reduces delegated_tokens, not the shares.
+	DelegatorShares *cosmossdk_io_math.LegacyDec `protobuf:"bytes,17,opt,name=delegator_shares,json=delegatorShares,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"delegator_shares,omitempty"`
 }
 
 func (m *Sequencer) Reset()         { *m = Sequencer{} }
@@ -184,44 +191,50 @@ func init() {
 }
 
 var fileDescriptor_997b8663a5fc0f58 = []byte{
-	// 588 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x6d, 0x58, 0xd6, 0xa6, 0xee, 0x18, 0x95, 0xe9, 0xc1, 0xad, 0x50, 0x1a, 0x71, 0xca, 0x65,
-	0xce, 0xba, 0x49, 0x70, 0x5e, 0x39, 0xad, 0x08, 0x31, 0x65, 0x70, 0xe1, 0x52, 0xb9, 0xb1, 0xe9,
-	0xac, 0x35, 0x76, 0xb0, 0xdd, 0x6d, 0xe1, 0x57, 0xec, 0xc2, 0x9f, 0xe0, 0xcc, 0x8f, 0x98, 0x38,
-	0xed, 0xc8, 0x89, 0xa1, 0xf5, 0x8f, 0xa0, 0x24, 0x6e, 0x57, 0x40, 0xa8, 0xa7, 0xf8, 0xf9, 0xfb,
-	0xde, 0xe7, 0xbc, 0xf7, 0x3e, 0xb0, 0x4f, 0xf3, 0x94, 0x09, 0xcd, 0xa5, 0xb8, 0xca, 0x3f, 0x47,
-	0x2b, 0x10, 0x69, 0xf6, 0x69, 0xce, 0x44, 0xc2, 0xd4, 0xc3, 0x09, 0x67, 0x4a, 0x1a, 0x09, 0x83,
-	0x75, 0x06, 0x5e, 0x01, 0xbc, 0xea, 0xeb, 0x75, 0x13, 0xa9, 0x53, 0xa9, 0xc7, 0x65, 0x7f, 0x54,
-	0x81, 0x8a, 0xdc, 0xeb, 0x4e, 0xa5, 0x9c, 0xce, 0x58, 0x54, 0xa2, 0xc9, 0xfc, 0x63, 0x44, 0x44,
-	0x6e, 0x4b, 0x9d, 0xa9, 0x9c, 0xca, 0x8a, 0x52, 0x9c, 0xec, 0x6d, 0xff, 0x6f, 0x82, 0xe1, 0x29,
-	0xd3, 0x86, 0xa4, 0x99, 0x6d, 0xf0, 0xab, 0xf9, 0xd1, 0x84, 0x68, 0x16, 0x5d, 0x0c, 0x26, 0xcc,
-	0x90, 0x41, 0x94, 0x48, 0x2e, 0x6c, 0x3d, 0xda, 0x28, 0x30, 0x65, 0x86, 0x50, 0x62, 0x88, 0x25,
-	0xbc, 0xdc, 0x48, 0x90, 0x19, 0x53, 0xc4, 0x70, 0x31, 0x1d, 0x6b, 0x43, 0xcc, 0xdc, 0x6a, 0x7b,
-	0xfe, 0x65, 0x1b, 0x34, 0x4f, 0x97, 0x4d, 0x10, 0x81, 0x06, 0xa1, 0x54, 0x31, 0xad, 0x91, 0x13,
-	0x38, 0x61, 0x33, 0x5e, 0x42, 0x18, 0x83, 0x1d, 0x9a, 0xa7, 0x5c, 0x98, 0x93, 0xf9, 0xe4, 0x35,
-	0xcb, 0xd1, 0xa3, 0xc0, 0x09, 0x5b, 0x07, 0x1d, 0x5c, 0x29, 0xc5, 0x4b, 0xa5, 0xf8, 0x48, 0xe4,
-	0x43, 0xf4, 0xfd, 0xdb, 0x5e, 0xc7, 0x3a, 0x98, 0xa8, 0x3c, 0x33, 0x12, 0x57, 0xac, 0xf8, 0x8f,
-	0x19, 0xf0, 0x19, 0x68, 0x2a, 0x39, 0x9b, 0x91, 0x2c, 0x3b, 0xa6, 0x68, 0xab, 0x7c, 0xef, 0xe1,
-	0x02, 0xbe, 0x07, 0xde, 0x52, 0x24, 0x72, 0xcb, 0xd7, 0x0e, 0xf1, 0xa6, 0x14, 0xf1, 0x4a, 0xca,
-	0x1b, 0x4b, 0x1d, 0xba, 0x37, 0x3f, 0xfb, 0xb5, 0x78, 0x35, 0x0a, 0x1e, 0x83, 0x7a, 0x65, 0x00,
-	0x6a, 0x04, 0x4e, 0xb8, 0x7b, 0x30, 0xd8, 0x3c, 0xf4, 0xed, 0xd2, 0xba, 0xd3, 0x92, 0x18, 0xdb,
-	0x01, 0xb0, 0x0b, 0x3c, 0x99, 0x19, 0x46, 0xc7, 0x5c, 0xa0, 0xdd, 0xc0, 0x09, 0xbd, 0xb8, 0x51,
-	0xe2, 0x63, 0x01, 0x13, 0x50, 0x37, 0xf2, 0x9c, 0x09, 0x8d, 0xbc, 0x60, 0x2b, 0x6c, 0x1d, 0x74,
-	0xb1, 0xf5, 0xa3, 0x48, 0x1c, 0xdb, 0xc4, 0xf1, 0x2b, 0xc9, 0xc5, 0x70, 0xbf, 0xf8, 0xc1, 0xaf,
-	0x77, 0xfd, 0x70, 0xca, 0xcd, 0xd9, 0x7c, 0x82, 0x13, 0x99, 0xda, 0xf5, 0xb3, 0x9f, 0x3d, 0x4d,
-	0xcf, 0x23, 0x93, 0x67, 0x4c, 0x97, 0x04, 0x1d, 0xdb, 0xd1, 0x30, 0x06, 0x50, 0x48, 0xc3, 0x13,
-	0x36, 0xce, 0x98, 0xe2, 0x92, 0x8e, 0x8b, 0x35, 0x43, 0xad, 0xd2, 0xab, 0xde, 0x3f, 0xc9, 0xbc,
-	0x5b, 0xee, 0xe0, 0xd0, 0x2b, 0x5e, 0xbc, 0xbe, 0xeb, 0x3b, 0x71, 0xbb, 0xe2, 0x9f, 0x94, 0xf4,
-	0xa2, 0x01, 0xf6, 0x41, 0x4b, 0xb1, 0x4b, 0xa2, 0xe8, 0xb8, 0x48, 0x1e, 0xed, 0x94, 0xa9, 0x80,
-	0xea, 0xea, 0x88, 0x52, 0x05, 0x07, 0xa0, 0x73, 0x79, 0xc6, 0x0d, 0x9b, 0x71, 0x5d, 0x48, 0x57,
-	0x6c, 0x46, 0x72, 0xa6, 0x34, 0x7a, 0x1c, 0x6c, 0x85, 0xcd, 0xf8, 0xe9, 0x5a, 0x2d, 0xb6, 0x25,
-	0xd8, 0x03, 0x1e, 0xe5, 0xfa, 0x4c, 0x0a, 0xa9, 0xd0, 0x93, 0xc0, 0x09, 0xdd, 0x78, 0x85, 0x47,
-	0xae, 0xb7, 0xdd, 0xae, 0x8f, 0x5c, 0xaf, 0xde, 0x6e, 0x8c, 0x5c, 0xaf, 0xd9, 0x06, 0x23, 0xd7,
-	0x03, 0xed, 0xd6, 0xf0, 0xe4, 0xe6, 0xde, 0x77, 0x6e, 0xef, 0x7d, 0xe7, 0xd7, 0xbd, 0xef, 0x5c,
-	0x2f, 0xfc, 0xda, 0xed, 0xc2, 0xaf, 0xfd, 0x58, 0xf8, 0xb5, 0x0f, 0x2f, 0xd6, 0x7c, 0xfa, 0xcf,
-	0xd6, 0x5f, 0x1c, 0x46, 0x57, 0x6b, 0xab, 0x5f, 0x7a, 0x37, 0xa9, 0x97, 0x4e, 0x1c, 0xfe, 0x0e,
-	0x00, 0x00, 0xff, 0xff, 0xaa, 0xee, 0xfa, 0x07, 0x3d, 0x04, 0x00, 0x00,
+	// 678 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xc1, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8d, 0x5f, 0xf3, 0x12, 0x67, 0xd2, 0xd7, 0xe6, 0xf9, 0xe5, 0x49, 0x4e, 0x40, 0x89, 0xc5,
+	0x06, 0x6f, 0x32, 0x6e, 0x5a, 0x09, 0xd6, 0x0d, 0xdd, 0x24, 0x80, 0xa8, 0xdc, 0x22, 0x21, 0x36,
+	0xd1, 0xc4, 0x33, 0x38, 0x56, 0xe3, 0x19, 0x33, 0x33, 0x69, 0x6b, 0xbe, 0xa2, 0xdf, 0xc1, 0xba,
+	0x7f, 0xc0, 0xa6, 0x62, 0x55, 0xb1, 0x42, 0x5d, 0xb4, 0xa8, 0xfd, 0x11, 0xe4, 0xf1, 0xc4, 0x0d,
+	0x54, 0x28, 0xab, 0xf8, 0xce, 0xbd, 0xe7, 0xdc, 0x9c, 0x73, 0x66, 0xc0, 0x16, 0x4e, 0x63, 0x42,
+	0x45, 0xc4, 0xe8, 0x69, 0xfa, 0xc9, 0x2b, 0x0a, 0x4f, 0x90, 0x8f, 0x73, 0x42, 0x03, 0xc2, 0xef,
+	0xbf, 0x60, 0xc2, 0x99, 0x64, 0x96, 0xb3, 0x8c, 0x80, 0x45, 0x01, 0x8b, 0xb9, 0x76, 0x2b, 0x60,
+	0x22, 0x66, 0x62, 0xac, 0xe6, 0xbd, 0xbc, 0xc8, 0xc1, 0xed, 0x56, 0xc8, 0x58, 0x38, 0x23, 0x9e,
+	0xaa, 0x26, 0xf3, 0x0f, 0x1e, 0xa2, 0xa9, 0x6e, 0x35, 0x43, 0x16, 0xb2, 0x1c, 0x92, 0x7d, 0xe9,
+	0xd3, 0xee, 0xef, 0x00, 0x19, 0xc5, 0x44, 0x48, 0x14, 0x27, 0x7a, 0xa0, 0x93, 0xf3, 0x7b, 0x13,
+	0x24, 0x88, 0x77, 0xdc, 0x9f, 0x10, 0x89, 0xfa, 0x5e, 0xc0, 0x22, 0xaa, 0xfb, 0xde, 0x4a, 0x81,
+	0x31, 0x91, 0x08, 0x23, 0x89, 0x34, 0xe0, 0xf9, 0x4a, 0x00, 0x4b, 0x08, 0x47, 0x32, 0xa2, 0xe1,
+	0x58, 0x48, 0x24, 0xe7, 0x5a, 0xdb, 0x93, 0x2f, 0x15, 0x50, 0x3b, 0x58, 0x0c, 0x59, 0x36, 0xa8,
+	0x22, 0x8c, 0x39, 0x11, 0xc2, 0x36, 0x1c, 0xc3, 0xad, 0xf9, 0x8b, 0xd2, 0xf2, 0xc1, 0x3a, 0x4e,
+	0xe3, 0x88, 0xca, 0xfd, 0xf9, 0xe4, 0x25, 0x49, 0xed, 0xbf, 0x1c, 0xc3, 0xad, 0x6f, 0x37, 0x61,
+	0xae, 0x14, 0x2e, 0x94, 0xc2, 0x5d, 0x9a, 0x0e, 0xec, 0xaf, 0xe7, 0xbd, 0xa6, 0x76, 0x30, 0xe0,
+	0x69, 0x22, 0x19, 0xcc, 0x51, 0xfe, 0x2f, 0x1c, 0xd6, 0x63, 0x50, 0xe3, 0x6c, 0x36, 0x43, 0x49,
+	0x32, 0xc4, 0xf6, 0x9a, 0xda, 0x77, 0x7f, 0x60, 0xbd, 0x05, 0xe6, 0x42, 0xa4, 0x5d, 0x56, 0xdb,
+	0x76, 0xe0, 0xaa, 0x14, 0x61, 0x21, 0xe5, 0xb5, 0x86, 0x0e, 0xca, 0x17, 0xd7, 0xdd, 0x92, 0x5f,
+	0x50, 0x59, 0x43, 0x50, 0xc9, 0x0d, 0xb0, 0xab, 0x8e, 0xe1, 0x6e, 0x6c, 0xf7, 0x57, 0x93, 0xbe,
+	0x59, 0x58, 0x77, 0xa0, 0x80, 0xbe, 0x26, 0xb0, 0x5a, 0xc0, 0x64, 0x89, 0x24, 0x78, 0x1c, 0x51,
+	0x7b, 0xc3, 0x31, 0x5c, 0xd3, 0xaf, 0xaa, 0x7a, 0x48, 0xad, 0x00, 0x54, 0x24, 0x3b, 0x22, 0x54,
+	0xd8, 0xa6, 0xb3, 0xe6, 0xd6, 0xb7, 0x5b, 0x50, 0xfb, 0x91, 0x25, 0x0e, 0x75, 0xe2, 0xf0, 0x05,
+	0x8b, 0xe8, 0x60, 0x2b, 0xfb, 0x83, 0x9f, 0x6f, 0xba, 0x6e, 0x18, 0xc9, 0xe9, 0x7c, 0x02, 0x03,
+	0x16, 0xeb, 0xeb, 0xa7, 0x7f, 0x7a, 0x02, 0x1f, 0x79, 0x32, 0x4d, 0x88, 0x50, 0x00, 0xe1, 0x6b,
+	0x6a, 0xcb, 0x07, 0x16, 0x65, 0x32, 0x0a, 0xc8, 0x38, 0x21, 0x3c, 0x62, 0x78, 0x9c, 0x5d, 0x33,
+	0xbb, 0xae, 0xbc, 0x6a, 0x3f, 0x48, 0xe6, 0x70, 0x71, 0x07, 0x07, 0x66, 0xb6, 0xf1, 0xec, 0xa6,
+	0x6b, 0xf8, 0x8d, 0x1c, 0xbf, 0xaf, 0xe0, 0xd9, 0x80, 0xd5, 0x05, 0x75, 0x4e, 0x4e, 0x10, 0xc7,
+	0xe3, 0x2c, 0x79, 0x7b, 0x5d, 0xa5, 0x02, 0xf2, 0xa3, 0x5d, 0x8c, 0xb9, 0xd5, 0x07, 0xcd, 0x93,
+	0x69, 0x24, 0xc9, 0x2c, 0x12, 0x99, 0x74, 0x4e, 0x66, 0x28, 0x25, 0x5c, 0xd8, 0xff, 0x38, 0x6b,
+	0x6e, 0xcd, 0xff, 0x6f, 0xa9, 0xe7, 0xeb, 0x96, 0xd5, 0x06, 0x26, 0x8e, 0xc4, 0x94, 0x51, 0xc6,
+	0xed, 0x4d, 0xc7, 0x70, 0xcb, 0x7e, 0x51, 0x5b, 0x3e, 0x68, 0x60, 0x32, 0x23, 0x21, 0xca, 0xc8,
+	0xb4, 0x65, 0x8d, 0x6c, 0xe9, 0xe0, 0xe9, 0xd5, 0x75, 0xf7, 0xff, 0xdc, 0x01, 0x81, 0x8f, 0x60,
+	0xc4, 0xbc, 0x18, 0xc9, 0x29, 0x1c, 0x52, 0xf9, 0xed, 0xbc, 0x07, 0xb4, 0x9d, 0x43, 0x2a, 0xfd,
+	0xcd, 0x82, 0xe0, 0x30, 0xf7, 0xe5, 0x5d, 0xc1, 0xc9, 0xf8, 0x58, 0x4c, 0x11, 0x27, 0xc2, 0xfe,
+	0x57, 0x71, 0xf6, 0xae, 0xae, 0xbb, 0x8f, 0x1e, 0x72, 0xbe, 0x22, 0x21, 0x0a, 0xd2, 0x3d, 0x12,
+	0x2c, 0x31, 0xef, 0x91, 0xa0, 0x60, 0x66, 0xfc, 0x40, 0xb1, 0x8c, 0xca, 0xe6, 0xdf, 0x8d, 0xca,
+	0xa8, 0x6c, 0x56, 0x1a, 0xd5, 0x51, 0xd9, 0xac, 0x35, 0xc0, 0xa8, 0x6c, 0x82, 0x46, 0x7d, 0xb0,
+	0x7f, 0x71, 0xdb, 0x31, 0x2e, 0x6f, 0x3b, 0xc6, 0x8f, 0xdb, 0x8e, 0x71, 0x76, 0xd7, 0x29, 0x5d,
+	0xde, 0x75, 0x4a, 0xdf, 0xef, 0x3a, 0xa5, 0xf7, 0xcf, 0x96, 0x52, 0xfd, 0xc3, 0x1b, 0x3d, 0xde,
+	0xf1, 0x4e, 0x97, 0x1e, 0xaa, 0x4a, 0x7a, 0x52, 0x51, 0xb9, 0xed, 0xfc, 0x1c, 0x00, 0xc0, 0xe0,
+	0xee, 0xb8, 0xeb, 0x04, 0x00, 0x00,
 }
 
 func (m *Sequencer) Marshal() (dAtA []byte, err error) {
@@ -244,6 +257,34 @@ func (m *Sequencer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DelegatorShares != nil {
+		{
+			size := m.DelegatorShares.Size()
+			i -= size
+			if _, err := m.DelegatorShares.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSequencer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.DelegatedTokens != nil {
+		{
+			size := m.DelegatedTokens.Size()
+			i -= size
+			if _, err := m.DelegatedTokens.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintSequencer(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
 	if m.Dishonor != 0 {
 		i = encodeVarintSequencer(dAtA, i, uint64(m.Dishonor))
 		i--
@@ -399,6 +440,14 @@ func (m *Sequencer) Size() (n int) {
 	if m.Dishonor != 0 {
 		n += 1 + sovSequencer(uint64(m.Dishonor))
 	}
+	if m.DelegatedTokens != nil {
+		l = m.DelegatedTokens.Size()
+		n += 2 + l + sovSequencer(uint64(l))
+	}
+	if m.DelegatorShares != nil {
+		l = m.DelegatorShares.Size()
+		n += 2 + l + sovSequencer(uint64(l))
+	}
 	return n
 }
 
@@ -759,6 +808,78 @@ func (m *Sequencer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedTokens", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.DelegatedTokens = &v
+			if err := m.DelegatedTokens.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatorShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSequencer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSequencer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.DelegatorShares = &v
+			if err := m.DelegatorShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSequencer(dAtA[iNdEx:])
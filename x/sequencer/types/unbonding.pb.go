@@ -0,0 +1,571 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dymensionxyz/dymension/sequencer/unbonding.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UnbondingEntry is an amount unbonded from a sequencer, either from its self
+// bond or from a delegation. It is released to the recipient at completion
+// time, and until then it is still slashed if the sequencer is punished.
+type UnbondingEntry struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sequencer is the bech32-encoded address of the sequencer unbonded from
+	Sequencer string `protobuf:"bytes,2,opt,name=sequencer,proto3" json:"sequencer,omitempty"`
+	// recipient is the bech32-encoded address receiving the amount, the
+	// sequencer itself or a delegator
+	Recipient string     `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+	// creation_height is the hub height at which the unbonding started
+	CreationHeight int64 `protobuf:"varint,5,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height,omitempty"`
+	// completion_time is the time at which the amount is released
+	CompletionTime time.Time `protobuf:"bytes,6,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time"`
+}
+
+func (m *UnbondingEntry) Reset()         { *m = UnbondingEntry{} }
+func (m *UnbondingEntry) String() string { return proto.CompactTextString(m) }
+func (*UnbondingEntry) ProtoMessage()    {}
+func (*UnbondingEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_875b33f7887a43fc, []int{0}
+}
+func (m *UnbondingEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingEntry.Merge(m, src)
+}
+func (m *UnbondingEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingEntry proto.InternalMessageInfo
+
+func (m *UnbondingEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *UnbondingEntry) GetSequencer() string {
+	if m != nil {
+		return m.Sequencer
+	}
+	return ""
+}
+
+func (m *UnbondingEntry) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *UnbondingEntry) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *UnbondingEntry) GetCreationHeight() int64 {
+	if m != nil {
+		return m.CreationHeight
+	}
+	return 0
+}
+
+func (m *UnbondingEntry) GetCompletionTime() time.Time {
+	if m != nil {
+		return m.CompletionTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*UnbondingEntry)(nil), "dymensionxyz.dymension.sequencer.UnbondingEntry")
+}
+
+func init() {
+	proto.RegisterFile("dymensionxyz/dymension/sequencer/unbonding.proto", fileDescriptor_875b33f7887a43fc)
+}
+
+var fileDescriptor_875b33f7887a43fc = []byte{
+	// 389 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x3d, 0x0f, 0xd3, 0x30,
+	0x10, 0x8d, 0xd3, 0x52, 0xd1, 0x20, 0xa5, 0x52, 0xd4, 0x21, 0xed, 0x90, 0x46, 0x2c, 0x64, 0xc1,
+	0xa6, 0x54, 0x2a, 0x33, 0x41, 0x48, 0x2c, 0x48, 0x28, 0xc0, 0xc2, 0x52, 0xe5, 0xc3, 0xb8, 0x96,
+	0x1a, 0x5f, 0x88, 0x9d, 0xaa, 0xe1, 0x57, 0xf4, 0xc7, 0xf0, 0x07, 0xd8, 0x3a, 0x56, 0x4c, 0x4c,
+	0x80, 0xda, 0x3f, 0x82, 0xf2, 0xd9, 0x2e, 0x88, 0xcd, 0xef, 0xdd, 0x7b, 0xbe, 0xbb, 0xa7, 0x33,
+	0x9e, 0x25, 0x65, 0x4a, 0x85, 0xe4, 0x20, 0x0e, 0xe5, 0x57, 0xd2, 0x03, 0x22, 0xe9, 0x97, 0x82,
+	0x8a, 0x98, 0xe6, 0xa4, 0x10, 0x11, 0x88, 0x84, 0x0b, 0x86, 0xb3, 0x1c, 0x14, 0x58, 0xee, 0xbd,
+	0x03, 0xf7, 0x00, 0xf7, 0x8e, 0xf9, 0x2c, 0x06, 0x99, 0x82, 0xdc, 0xd4, 0x7a, 0xd2, 0x80, 0xc6,
+	0x3c, 0x9f, 0x32, 0x60, 0xd0, 0xf0, 0xd5, 0xab, 0x65, 0x17, 0x0c, 0x80, 0xed, 0x28, 0xa9, 0x51,
+	0x54, 0x7c, 0x26, 0x8a, 0xa7, 0x54, 0xaa, 0x30, 0xcd, 0x5a, 0x81, 0xd3, 0x7c, 0x42, 0xa2, 0x50,
+	0x52, 0xb2, 0x5f, 0x46, 0x54, 0x85, 0x4b, 0x12, 0x03, 0x17, 0x4d, 0xfd, 0xf1, 0x77, 0xdd, 0x30,
+	0x3f, 0x76, 0x73, 0xbe, 0x16, 0x2a, 0x2f, 0x2d, 0xd3, 0xd0, 0x79, 0x62, 0x23, 0x17, 0x79, 0xc3,
+	0x40, 0xe7, 0x89, 0xb5, 0x36, 0xc6, 0xfd, 0x84, 0xb6, 0xee, 0x22, 0x6f, 0xec, 0xdb, 0x3f, 0xbe,
+	0x3d, 0x9d, 0xb6, 0xe3, 0xbd, 0x4c, 0x92, 0x9c, 0x4a, 0xf9, 0x5e, 0xe5, 0x5c, 0xb0, 0xe0, 0x26,
+	0xad, 0x7c, 0x39, 0x8d, 0x79, 0xc6, 0xa9, 0x50, 0xf6, 0xe0, 0x7f, 0xbe, 0x5e, 0x6a, 0xbd, 0x30,
+	0x46, 0x61, 0x0a, 0x85, 0x50, 0xf6, 0xd0, 0x45, 0xde, 0xa3, 0xe7, 0x33, 0xdc, 0x3a, 0xaa, 0x1d,
+	0x70, 0xbb, 0x03, 0x7e, 0x05, 0x5c, 0xf8, 0xc3, 0xd3, 0xaf, 0x85, 0x16, 0xb4, 0x72, 0xeb, 0x89,
+	0x31, 0x89, 0x73, 0x1a, 0x2a, 0x0e, 0x62, 0xb3, 0xa5, 0x9c, 0x6d, 0x95, 0xfd, 0xc0, 0x45, 0xde,
+	0x20, 0x30, 0x3b, 0xfa, 0x4d, 0xcd, 0x5a, 0x6f, 0x8d, 0x49, 0x0c, 0x69, 0xb6, 0xa3, 0xb5, 0xb4,
+	0x8a, 0xcc, 0x1e, 0xd5, 0xad, 0xe6, 0xb8, 0xc9, 0x13, 0x77, 0x79, 0xe2, 0x0f, 0x5d, 0x9e, 0xfe,
+	0xc3, 0xaa, 0xd7, 0xf1, 0xf7, 0x02, 0x05, 0xe6, 0xcd, 0x5c, 0x95, 0xfd, 0x77, 0xa7, 0x8b, 0x83,
+	0xce, 0x17, 0x07, 0xfd, 0xb9, 0x38, 0xe8, 0x78, 0x75, 0xb4, 0xf3, 0xd5, 0xd1, 0x7e, 0x5e, 0x1d,
+	0xed, 0xd3, 0x9a, 0x71, 0xb5, 0x2d, 0x22, 0x1c, 0x43, 0x4a, 0xfe, 0x71, 0x2e, 0xfb, 0x15, 0x39,
+	0xdc, 0xdd, 0x8c, 0x2a, 0x33, 0x2a, 0xa3, 0x51, 0xdd, 0x7f, 0xf5, 0x77, 0x00, 0x46, 0x4a, 0x8b,
+	0xcf, 0x64, 0x02, 0x00, 0x00,
+}
+
+func (m *UnbondingEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintUnbonding(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.CreationHeight != 0 {
+		i = encodeVarintUnbonding(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintUnbonding(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sequencer) > 0 {
+		i -= len(m.Sequencer)
+		copy(dAtA[i:], m.Sequencer)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Sequencer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintUnbonding(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUnbonding(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUnbonding(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UnbondingEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovUnbonding(uint64(m.Id))
+	}
+	l = len(m.Sequencer)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovUnbonding(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovUnbonding(uint64(m.CreationHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovUnbonding(uint64(l))
+	return n
+}
+
+func sovUnbonding(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUnbonding(x uint64) (n int) {
+	return sovUnbonding(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UnbondingEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequencer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sequencer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnbonding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUnbonding(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUnbonding
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUnbonding
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUnbonding
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUnbonding        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUnbonding          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUnbonding = fmt.Errorf("proto: unexpected end of group")
+)